@@ -0,0 +1,357 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/oklog/ulid/v2"
+
+	claudeagent "github.com/kazz187/claude-agent-sdk-go"
+	"github.com/kazz187/taskguild/pkg/clog"
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
+)
+
+const (
+	// checkpointRefPrefix is the hidden ref namespace checkpoint commits are
+	// stored under (refs/taskguild/checkpoints/<taskID>/<checkpointID>).
+	checkpointRefPrefix = "refs/taskguild/checkpoints/"
+
+	// maxCheckpointsPerTask is the number of checkpoints kept per task; older
+	// ones are pruned.
+	maxCheckpointsPerTask = 50
+)
+
+// checkpointGitEnv is the identity used for checkpoint commits, which never
+// appear on a branch.
+var checkpointGitEnv = []string{
+	"GIT_AUTHOR_NAME=TaskGuild",
+	"GIT_AUTHOR_EMAIL=taskguild@localhost",
+	"GIT_COMMITTER_NAME=TaskGuild",
+	"GIT_COMMITTER_EMAIL=taskguild@localhost",
+}
+
+// checkpoint is a snapshot of a worktree (including uncommitted and
+// untracked files) stored as a commit under a hidden ref.
+type checkpoint struct {
+	ID     string
+	Commit string
+	Ref    string
+}
+
+// checkpointer takes checkpoints of a task's worktree after each turn and
+// records them in the task log.
+type checkpointer struct {
+	taskID         string
+	agentManagerID string
+	worktreeName   string
+	tl             *taskLogger
+
+	lastTree string // tree of the last checkpoint; unchanged trees are skipped
+}
+
+func newCheckpointer(taskID, agentManagerID, worktreeName string, tl *taskLogger) *checkpointer {
+	return &checkpointer{
+		taskID:         taskID,
+		agentManagerID: agentManagerID,
+		worktreeName:   worktreeName,
+		tl:             tl,
+	}
+}
+
+// record checkpoints dir and logs a CHECKPOINT entry. sessionID and
+// statusName identify the session the checkpoint belongs to. Failures are
+// logged and otherwise ignored.
+func (c *checkpointer) record(ctx context.Context, dir, message string, turn int, sessionID, statusName string) {
+	logger := clog.LoggerFromContext(ctx)
+
+	cp, err := c.create(ctx, dir)
+	if err != nil {
+		logger.Warn("failed to create worktree checkpoint", "error", err)
+		return
+	}
+
+	if cp == nil {
+		return // nothing changed since the last checkpoint
+	}
+
+	meta := map[string]string{
+		"checkpoint_id":    cp.ID,
+		"commit":           cp.Commit,
+		"ref":              cp.Ref,
+		"worktree":         c.worktreeName,
+		"agent_manager_id": c.agentManagerID,
+		"session_id":       sessionID,
+		"status_name":      statusName,
+	}
+	if turn >= 0 {
+		meta["turn"] = strconv.Itoa(turn)
+	}
+
+	if sessionID != "" {
+		meta["message_uuid"] = lastTranscriptMessageUUID(sessionTranscriptPath(dir, sessionID))
+	}
+
+	c.tl.Log(v1.TaskLogCategory_TASK_LOG_CATEGORY_CHECKPOINT, v1.TaskLogLevel_TASK_LOG_LEVEL_INFO, message, meta)
+	logger.Debug("worktree checkpoint created", "checkpoint_id", cp.ID, "commit", cp.Commit)
+}
+
+// create snapshots the working tree of dir into a commit whose parent is
+// HEAD, without touching HEAD, the index or the working tree. Returns nil if
+// the tree is unchanged since the previous checkpoint.
+func (c *checkpointer) create(ctx context.Context, dir string) (*checkpoint, error) {
+	head, err := gitOutput(ctx, dir, "rev-parse", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("resolve HEAD: %w", err)
+	}
+
+	tree, err := writeWorkingTree(ctx, dir, head)
+	if err != nil {
+		return nil, err
+	}
+
+	if tree == c.lastTree {
+		return nil, nil
+	}
+
+	id := ulid.Make().String()
+
+	commit, err := gitOutputEnv(ctx, dir, checkpointGitEnv, "commit-tree", tree, "-p", head,
+		"-m", fmt.Sprintf("taskguild checkpoint %s (task %s)", id, c.taskID))
+	if err != nil {
+		return nil, fmt.Errorf("commit-tree: %w", err)
+	}
+
+	ref := checkpointRefPrefix + c.taskID + "/" + id
+	if _, err := gitOutput(ctx, dir, "update-ref", ref, commit); err != nil {
+		return nil, fmt.Errorf("update-ref: %w", err)
+	}
+
+	c.lastTree = tree
+	pruneCheckpoints(ctx, dir, c.taskID, maxCheckpointsPerTask)
+
+	return &checkpoint{ID: id, Commit: commit, Ref: ref}, nil
+}
+
+// writeWorkingTree writes the working tree of dir (including uncommitted
+// and untracked files) as a tree object on top of head and returns its ID.
+// HEAD, the index and the working tree are left untouched.
+func writeWorkingTree(ctx context.Context, dir, head string) (string, error) {
+	// Stage everything into a throwaway index so the real one is untouched.
+	indexFile, err := os.CreateTemp("", "taskguild-index-*")
+	if err != nil {
+		return "", err
+	}
+
+	indexPath := indexFile.Name()
+	indexFile.Close()
+	os.Remove(indexPath) // git expects a missing or valid index file
+	defer os.Remove(indexPath)
+
+	env := []string{"GIT_INDEX_FILE=" + indexPath}
+
+	if _, err := gitOutputEnv(ctx, dir, env, "read-tree", head); err != nil {
+		return "", fmt.Errorf("read-tree: %w", err)
+	}
+
+	if _, err := gitOutputEnv(ctx, dir, env, "add", "-A"); err != nil {
+		return "", fmt.Errorf("add: %w", err)
+	}
+
+	tree, err := gitOutputEnv(ctx, dir, env, "write-tree")
+	if err != nil {
+		return "", fmt.Errorf("write-tree: %w", err)
+	}
+
+	return tree, nil
+}
+
+// pruneCheckpoints deletes all but the newest keep checkpoint refs of a task.
+func pruneCheckpoints(ctx context.Context, dir, taskID string, keep int) {
+	out, err := gitOutput(ctx, dir, "for-each-ref", "--format=%(refname)", "--sort=refname", checkpointRefPrefix+taskID+"/")
+	if err != nil || out == "" {
+		return
+	}
+
+	refs := strings.Split(out, "\n")
+	for _, ref := range refs[:max(len(refs)-keep, 0)] {
+		if _, err := gitOutput(ctx, dir, "update-ref", "-d", ref); err != nil {
+			slog.Warn("failed to prune checkpoint", "ref", ref, "error", err)
+		}
+	}
+}
+
+// restoreCheckpoint resets dir to a checkpoint: the branch is moved back to
+// the checkpoint's parent and the working tree (including uncommitted and
+// untracked files) is restored to the snapshot. Ignored files are kept.
+func restoreCheckpoint(ctx context.Context, dir, commit string) error {
+	if _, err := gitOutput(ctx, dir, "rev-parse", "--verify", "--quiet", commit+"^{commit}"); err != nil {
+		return fmt.Errorf("checkpoint commit %s not found", commit)
+	}
+
+	parent, err := gitOutput(ctx, dir, "rev-parse", commit+"^1")
+	if err != nil {
+		return fmt.Errorf("resolve checkpoint parent: %w", err)
+	}
+
+	steps := [][]string{
+		{"reset", "-q", "--hard", parent},
+		{"clean", "-fdq"},
+		// Unlike checkout, read-tree also removes files that were deleted
+		// when the snapshot was taken.
+		{"read-tree", "-u", "--reset", commit},
+		{"reset", "-q"}, // unstage: uncommitted changes stay uncommitted
+	}
+	for _, args := range steps {
+		if _, err := gitOutput(ctx, dir, args...); err != nil {
+			return fmt.Errorf("git %s: %w", args[0], err)
+		}
+	}
+
+	return nil
+}
+
+// lastTranscriptMessageUUID returns the uuid of the last main-chain message
+// of a session transcript, or "" if unknown.
+func lastTranscriptMessageUUID(path string) string {
+	if path == "" {
+		return ""
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	last := ""
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	for scanner.Scan() {
+		var entry struct {
+			UUID        string `json:"uuid"`
+			Type        string `json:"type"`
+			IsSidechain bool   `json:"isSidechain"`
+		}
+
+		if json.Unmarshal(bytes.TrimSpace(scanner.Bytes()), &entry) != nil {
+			continue
+		}
+
+		if entry.UUID != "" && !entry.IsSidechain && (entry.Type == "user" || entry.Type == "assistant") {
+			last = entry.UUID
+		}
+	}
+
+	return last
+}
+
+func gitOutputEnv(ctx context.Context, dir string, env []string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), env...)
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(string(out), "\n"), nil
+}
+
+// handleRollbackTask restores a stopped task's worktree to a checkpoint and
+// points the task's session at a fork of the session as of that checkpoint.
+func handleRollbackTask(ctx context.Context, client taskguildv1connect.AgentManagerServiceClient, taskClient taskguildv1connect.TaskServiceClient, workDir, agentManagerID string, cmd *v1.RollbackTaskCommand) {
+	taskID := cmd.GetTaskId()
+	logger := slog.Default().With("task_id", taskID, "checkpoint_id", cmd.GetCheckpointId())
+	ctx = clog.ContextWithLogger(ctx, logger)
+
+	report := func(success bool, errMsg, sessionID string) {
+		_, err := client.ReportTaskRollbackResult(ctx, connect.NewRequest(&v1.ReportTaskRollbackResultRequest{
+			RequestId:    cmd.GetRequestId(),
+			TaskId:       taskID,
+			CheckpointId: cmd.GetCheckpointId(),
+			Success:      success,
+			ErrorMessage: errMsg,
+			SessionId:    sessionID,
+			Resume:       cmd.GetResume(),
+		}))
+		if err != nil {
+			logger.Error("failed to report rollback result", "error", err)
+		}
+	}
+
+	wtDir := filepath.Join(workDir, ".claude", "worktrees", cmd.GetWorktreeName())
+	if info, err := os.Stat(wtDir); err != nil || !info.IsDir() {
+		report(false, fmt.Sprintf("worktree %q does not exist", cmd.GetWorktreeName()), "")
+		return
+	}
+
+	tl := newTaskLogger(ctx, client, taskID)
+	defer tl.Close()
+
+	// Checkpoint the current state first so the rollback can be undone.
+	newCheckpointer(taskID, agentManagerID, cmd.GetWorktreeName(), tl).
+		record(ctx, wtDir, "Checkpoint before rollback", -1, "", cmd.GetStatusName())
+
+	if err := restoreCheckpoint(ctx, wtDir, cmd.GetCommit()); err != nil {
+		logger.Error("rollback failed", "error", err)
+		tl.Log(v1.TaskLogCategory_TASK_LOG_CATEGORY_ERROR, v1.TaskLogLevel_TASK_LOG_LEVEL_ERROR,
+			"Rollback failed: "+err.Error(), map[string]string{"checkpoint_id": cmd.GetCheckpointId()})
+		report(false, err.Error(), "")
+
+		return
+	}
+
+	sessionID := rewindSession(ctx, client, tl, taskID, wtDir, cmd.GetSessionId(), cmd.GetMessageUuid())
+	if sessionID != "" && cmd.GetStatusName() != "" {
+		saveSessionID(ctx, taskClient, taskID, sessionID, map[string]string{"_current_status_name": cmd.GetStatusName()})
+	}
+
+	logger.Info("task rolled back to checkpoint", "session_id", sessionID)
+	tl.Log(v1.TaskLogCategory_TASK_LOG_CATEGORY_SYSTEM, v1.TaskLogLevel_TASK_LOG_LEVEL_INFO,
+		"Rolled back worktree to checkpoint "+cmd.GetCheckpointId(),
+		map[string]string{"checkpoint_id": cmd.GetCheckpointId(), "session_id": sessionID})
+	report(true, "", sessionID)
+}
+
+// rewindSession forks the session as of messageUUID so that resuming it does
+// not carry the turns after the checkpoint. Falls back to the checkpoint's
+// session when the fork is not possible.
+func rewindSession(ctx context.Context, client taskguildv1connect.AgentManagerServiceClient, tl *taskLogger, taskID, dir, sessionID, messageUUID string) string {
+	logger := clog.LoggerFromContext(ctx)
+
+	if sessionID == "" || messageUUID == "" {
+		return sessionID
+	}
+
+	if _, err := restoreSessionTranscript(ctx, client, taskID, dir, sessionID); err != nil {
+		logger.Warn("failed to restore session transcript", "session_id", sessionID, "error", err)
+	}
+
+	forked, err := claudeagent.ForkSession(sessionID, dir, messageUUID, "")
+	if err != nil {
+		logger.Warn("failed to rewind session, resuming the checkpoint's session as is", "session_id", sessionID, "error", err)
+		tl.Log(v1.TaskLogCategory_TASK_LOG_CATEGORY_SYSTEM, v1.TaskLogLevel_TASK_LOG_LEVEL_WARN,
+			"Could not rewind the session to the checkpoint; it will resume with its full history",
+			map[string]string{"session_id": sessionID, "error": err.Error()})
+
+		return sessionID
+	}
+
+	newTranscriptUploader(client, taskID, tl).upload(ctx, dir, forked.SessionID)
+
+	return forked.SessionID
+}
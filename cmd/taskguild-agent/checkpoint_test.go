@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	return string(b)
+}
+
+func TestCheckpoint_CreateAndRestore(t *testing.T) {
+	dir := t.TempDir()
+	git := newTestGitRepo(t, dir)
+	ctx := context.Background()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a\n"), 0o644))
+	git("add", ".")
+	git("commit", "-q", "-m", "initial")
+	head := git("rev-parse", "HEAD")
+
+	// Turn 1: an uncommitted edit and an untracked file.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("turn1\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "new.txt"), []byte("new\n"), 0o644))
+	statusBefore := git("status", "--porcelain")
+
+	c := newCheckpointer("task-1", "am-1", "wt", nil)
+	cp, err := c.create(ctx, dir)
+	require.NoError(t, err)
+	require.NotNil(t, cp)
+	assert.Equal(t, cp.Commit, git("rev-parse", cp.Ref))
+	assert.Equal(t, statusBefore, git("status", "--porcelain"), "checkpoint must not touch the index")
+
+	// Unchanged tree: no new checkpoint.
+	again, err := c.create(ctx, dir)
+	require.NoError(t, err)
+	assert.Nil(t, again)
+
+	// Later turns wreck the branch.
+	git("add", "-A")
+	git("commit", "-q", "-m", "wreck")
+	require.NoError(t, os.Remove(filepath.Join(dir, "a.txt")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "junk.txt"), []byte("junk\n"), 0o644))
+
+	require.NoError(t, restoreCheckpoint(ctx, dir, cp.Commit))
+
+	assert.Equal(t, head, git("rev-parse", "HEAD"))
+	assert.Equal(t, "turn1\n", readFile(t, filepath.Join(dir, "a.txt")))
+	assert.Equal(t, "new\n", readFile(t, filepath.Join(dir, "new.txt")))
+	assert.NoFileExists(t, filepath.Join(dir, "junk.txt"))
+	assert.Equal(t, statusBefore, git("status", "--porcelain"))
+}
+
+func TestCheckpoint_RestoreKeepsDeletions(t *testing.T) {
+	dir := t.TempDir()
+	git := newTestGitRepo(t, dir)
+	ctx := context.Background()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "gone.txt"), []byte("gone\n"), 0o644))
+	git("add", ".")
+	git("commit", "-q", "-m", "initial")
+
+	// Turn 1 deletes a committed file without committing the deletion.
+	require.NoError(t, os.Remove(filepath.Join(dir, "gone.txt")))
+	statusBefore := git("status", "--porcelain")
+
+	c := newCheckpointer("task-1", "am-1", "wt", nil)
+	cp, err := c.create(ctx, dir)
+	require.NoError(t, err)
+	require.NotNil(t, cp)
+
+	// A later turn edits the remaining file.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("later\n"), 0o644))
+
+	require.NoError(t, restoreCheckpoint(ctx, dir, cp.Commit))
+
+	assert.NoFileExists(t, filepath.Join(dir, "gone.txt"))
+	assert.Equal(t, "a\n", readFile(t, filepath.Join(dir, "a.txt")))
+	assert.Equal(t, statusBefore, git("status", "--porcelain"))
+}
+
+func TestPruneCheckpoints(t *testing.T) {
+	dir := t.TempDir()
+	git := newTestGitRepo(t, dir)
+	ctx := context.Background()
+
+	git("commit", "-q", "--allow-empty", "-m", "initial")
+
+	c := newCheckpointer("task-1", "am-1", "wt", nil)
+	for i := range 3 {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "f.txt"), []byte(strings.Repeat("x", i+1)), 0o644))
+		_, err := c.create(ctx, dir)
+		require.NoError(t, err)
+	}
+
+	pruneCheckpoints(ctx, dir, "task-1", 2)
+
+	refs := git("for-each-ref", "--format=%(refname)", checkpointRefPrefix+"task-1/")
+	assert.Len(t, strings.Split(refs, "\n"), 2)
+}
+
+func TestLastTranscriptMessageUUID(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "s.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(
+		`{"type":"user","uuid":"u1"}`+"\n"+
+			`{"type":"assistant","uuid":"a1"}`+"\n"+
+			`{"type":"assistant","uuid":"side","isSidechain":true}`+"\n"+
+			`{"type":"progress","uuid":"p1"}`+"\n"), 0o644))
+
+	assert.Equal(t, "a1", lastTranscriptMessageUUID(path))
+	assert.Empty(t, lastTranscriptMessageUUID(filepath.Join(t.TempDir(), "missing.jsonl")))
+}
+
+func TestHandleRollbackTask(t *testing.T) {
+	tc := newTestClients()
+	defer tc.Close()
+
+	ctx := context.Background()
+	workDir := t.TempDir()
+	wtDir := filepath.Join(workDir, ".claude", "worktrees", "wt")
+	require.NoError(t, os.MkdirAll(wtDir, 0o755))
+
+	git := newTestGitRepo(t, wtDir)
+	require.NoError(t, os.WriteFile(filepath.Join(wtDir, "a.txt"), []byte("good\n"), 0o644))
+	git("add", ".")
+	git("commit", "-q", "-m", "initial")
+
+	cp, err := newCheckpointer("task-1", "am-1", "wt", nil).create(ctx, wtDir)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(wtDir, "a.txt"), []byte("bad\n"), 0o644))
+
+	handleRollbackTask(ctx, tc.agentClient, tc.taskClient, workDir, "am-1", &v1.RollbackTaskCommand{
+		RequestId:    "req-1",
+		TaskId:       "task-1",
+		CheckpointId: cp.ID,
+		Commit:       cp.Commit,
+		WorktreeName: "wt",
+		StatusName:   "Develop",
+	})
+
+	assert.Equal(t, "good\n", readFile(t, filepath.Join(wtDir, "a.txt")))
+
+	require.Len(t, tc.agentHandler.rollbackResults, 1)
+	assert.True(t, tc.agentHandler.rollbackResults[0].GetSuccess())
+	assert.Equal(t, "req-1", tc.agentHandler.rollbackResults[0].GetRequestId())
+
+	// The pre-rollback state was checkpointed so the rollback can be undone.
+	var checkpoints int
+
+	for _, l := range tc.agentHandler.reportTaskLogReqs {
+		if l.GetCategory() == v1.TaskLogCategory_TASK_LOG_CATEGORY_CHECKPOINT {
+			checkpoints++
+		}
+	}
+
+	assert.Equal(t, 1, checkpoints)
+}
@@ -15,6 +15,8 @@ import (
 	"time"
 
 	claudeagent "github.com/kazz187/claude-agent-sdk-go"
+
+	"github.com/kazz187/taskguild/pkg/redact"
 )
 
 // userInputMsg mirrors the SDK's internal userInputMessage for sending
@@ -37,11 +39,13 @@ type turnLog struct {
 	mu   sync.Mutex
 	file *os.File
 	idx  int // message counter
+	// redactor masks secrets before they are written.
+	redactor *redact.Redactor
 }
 
 // newTurnLog creates a new turn log file and writes the header.
 // If workDir is empty, logging is disabled (all methods become no-ops).
-func newTurnLog(workDir, projectID, taskID, label string) *turnLog {
+func newTurnLog(workDir, projectID, taskID, label string, redactor *redact.Redactor) *turnLog {
 	if workDir == "" {
 		return &turnLog{}
 	}
@@ -86,7 +90,7 @@ func newTurnLog(workDir, projectID, taskID, label string) *turnLog {
 		return &turnLog{}
 	}
 
-	tl := &turnLog{file: f}
+	tl := &turnLog{file: f, redactor: redactor}
 	tl.write(fmt.Sprintf("# Turn log: %s\n# Project: %s\n# Task: %s\n# Label: %s\n", ts, projectID, taskID, label))
 
 	return tl
@@ -109,7 +113,7 @@ func (tl *turnLog) write(s string) {
 		return
 	}
 
-	if _, err := tl.file.WriteString(s); err != nil {
+	if _, err := tl.file.WriteString(tl.redactor.String(s)); err != nil {
 		slog.Warn("failed to write to turn-log", "error", err)
 	}
 }
@@ -247,13 +251,14 @@ func runQuerySyncWithLog(
 	prompt any, // string or []map[string]any for image content blocks
 	options *claudeagent.ClaudeAgentOptions,
 	workDir, projectID, taskID, label string,
+	redactor *redact.Redactor,
 ) (*claudeagent.QueryResult, error) {
 	opts := claudeagent.ClaudeAgentOptions{}
 	if options != nil {
 		opts = *options
 	}
 
-	tl := newTurnLog(workDir, projectID, taskID, label)
+	tl := newTurnLog(workDir, projectID, taskID, label, redactor)
 	defer tl.Close()
 
 	// Configure permission prompt tool name (matching RunQuery behavior).
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// projectConfig is one project served by the agent manager.
+type projectConfig struct {
+	Name               string
+	WorkDir            string
+	MaxConcurrentTasks int // 0 = limited only by the global cap
+	Labels             []string
+}
+
+// fileConfig is the layout of the optional agent manager YAML config file.
+// Top-level settings can still be overridden by environment variables.
+//
+//	server_url: http://localhost:3100
+//	max_concurrent_tasks: 8   # global cap shared by all projects
+//	projects:
+//	  - name: api
+//	    work_dir: /src/api
+//	    max_concurrent_tasks: 3
+//	    labels: [go, backend]
+//	  - work_dir: /src/web  # name defaults to the directory basename
+type fileConfig struct {
+	ServerURL          string              `yaml:"server_url"`
+	APIKey             string              `yaml:"api_key"`
+	AgentManagerID     string              `yaml:"agent_manager_id"`
+	MaxConcurrentTasks int                 `yaml:"max_concurrent_tasks"`
+	Env                string              `yaml:"env"`
+	LogLevel           string              `yaml:"log_level"`
+	Projects           []fileProjectConfig `yaml:"projects"`
+}
+
+type fileProjectConfig struct {
+	Name               string   `yaml:"name"`
+	WorkDir            string   `yaml:"work_dir"`
+	MaxConcurrentTasks int      `yaml:"max_concurrent_tasks"`
+	Labels             []string `yaml:"labels"`
+}
+
+// applyConfigFile reads the YAML config file at path into cfg. Relative
+// project work dirs are resolved against the config file's directory.
+func applyConfigFile(cfg *config, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+
+	var fc fileConfig
+	if err := yaml.Unmarshal(data, &fc); err != nil {
+		return fmt.Errorf("parse config file %s: %w", path, err)
+	}
+
+	if fc.ServerURL != "" {
+		cfg.ServerURL = fc.ServerURL
+	}
+
+	if fc.APIKey != "" {
+		cfg.APIKey = fc.APIKey
+	}
+
+	if fc.AgentManagerID != "" {
+		cfg.AgentManagerID = fc.AgentManagerID
+	}
+
+	if fc.MaxConcurrentTasks > 0 {
+		cfg.MaxConcurrentTasks = fc.MaxConcurrentTasks
+	}
+
+	if fc.Env != "" {
+		cfg.Env = fc.Env
+	}
+
+	if fc.LogLevel != "" {
+		cfg.LogLevel = fc.LogLevel
+	}
+
+	baseDir := filepath.Dir(path)
+	seen := make(map[string]bool, len(fc.Projects))
+
+	for i, p := range fc.Projects {
+		if p.WorkDir == "" {
+			return fmt.Errorf("config file %s: projects[%d].work_dir is required", path, i)
+		}
+
+		workDir := p.WorkDir
+		if !filepath.IsAbs(workDir) {
+			workDir = filepath.Join(baseDir, workDir)
+		}
+
+		if abs, err := filepath.Abs(workDir); err == nil {
+			workDir = abs
+		}
+
+		name := p.Name
+		if name == "" {
+			name = filepath.Base(workDir)
+		}
+
+		if seen[name] {
+			return fmt.Errorf("config file %s: duplicate project %q", path, name)
+		}
+
+		seen[name] = true
+
+		cfg.Projects = append(cfg.Projects, projectConfig{
+			Name:               name,
+			WorkDir:            workDir,
+			MaxConcurrentTasks: p.MaxConcurrentTasks,
+			Labels:             p.Labels,
+		})
+	}
+
+	if len(cfg.Projects) == 0 {
+		return errors.New("config file " + path + ": at least one project is required")
+	}
+
+	return nil
+}
+
+// forProject returns a copy of cfg scoped to a single project, so handlers
+// that read cfg.WorkDir / cfg.ProjectName operate on that project.
+func (c *config) forProject(p projectConfig) *config {
+	pc := *c
+	pc.ProjectName = p.Name
+	pc.WorkDir = p.WorkDir
+	pc.Projects = []projectConfig{p}
+
+	if p.MaxConcurrentTasks > 0 {
+		pc.MaxConcurrentTasks = p.MaxConcurrentTasks
+	}
+
+	return &pc
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "agent.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+	return path
+}
+
+func TestApplyConfigFile(t *testing.T) {
+	path := writeConfigFile(t, `
+server_url: http://example.com:3100
+max_concurrent_tasks: 6
+projects:
+  - name: api
+    work_dir: /src/api
+    max_concurrent_tasks: 2
+    labels: [go]
+  - work_dir: web
+`)
+
+	cfg := &config{MaxConcurrentTasks: 1}
+	require.NoError(t, applyConfigFile(cfg, path))
+
+	assert.Equal(t, "http://example.com:3100", cfg.ServerURL)
+	assert.Equal(t, 6, cfg.MaxConcurrentTasks)
+	require.Len(t, cfg.Projects, 2)
+	assert.Equal(t, projectConfig{Name: "api", WorkDir: "/src/api", MaxConcurrentTasks: 2, Labels: []string{"go"}}, cfg.Projects[0])
+
+	// Relative work_dir resolves against the config file and the name
+	// defaults to the directory basename.
+	assert.Equal(t, "web", cfg.Projects[1].Name)
+	assert.Equal(t, filepath.Join(filepath.Dir(path), "web"), cfg.Projects[1].WorkDir)
+}
+
+func TestApplyConfigFile_Errors(t *testing.T) {
+	tests := map[string]string{
+		"no projects":       "server_url: http://localhost:3100\n",
+		"missing work_dir":  "projects:\n  - name: api\n",
+		"duplicate project": "projects:\n  - name: api\n    work_dir: /a\n  - name: api\n    work_dir: /b\n",
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, applyConfigFile(&config{}, writeConfigFile(t, content)))
+		})
+	}
+}
+
+func TestConfigForProject(t *testing.T) {
+	cfg := &config{ServerURL: "http://localhost:3100", MaxConcurrentTasks: 8}
+
+	pc := cfg.forProject(projectConfig{Name: "api", WorkDir: "/src/api", MaxConcurrentTasks: 3})
+	assert.Equal(t, "api", pc.ProjectName)
+	assert.Equal(t, "/src/api", pc.WorkDir)
+	assert.Equal(t, 3, pc.MaxConcurrentTasks)
+	assert.Equal(t, "http://localhost:3100", pc.ServerURL)
+
+	// Without a per-project cap the global cap applies.
+	pc = cfg.forProject(projectConfig{Name: "web", WorkDir: "/src/web"})
+	assert.Equal(t, 8, pc.MaxConcurrentTasks)
+	assert.Empty(t, cfg.ProjectName, "original config must not be modified")
+}
+
+func TestHasCapacity(t *testing.T) {
+	cfg := &config{
+		MaxConcurrentTasks: 3,
+		Projects: []projectConfig{
+			{Name: "api", WorkDir: "/src/api", MaxConcurrentTasks: 1},
+			{Name: "web", WorkDir: "/src/web"},
+		},
+	}
+	ps := newProjectSet(cfg, nil)
+	api, web := ps.resolve("api"), ps.resolve("web")
+
+	active := map[string]*activeTask{}
+	assert.True(t, hasCapacity(active, cfg, api))
+
+	active["t1"] = &activeTask{project: "api"}
+	assert.False(t, hasCapacity(active, cfg, api), "per-project cap reached")
+	assert.True(t, hasCapacity(active, cfg, web))
+
+	active["t2"] = &activeTask{project: "web"}
+	active["t3"] = &activeTask{project: "web"}
+	assert.False(t, hasCapacity(active, cfg, web), "global cap reached")
+}
+
+func TestProjectSetResolve(t *testing.T) {
+	cfg := &config{Projects: []projectConfig{{Name: "api"}, {Name: "web"}}}
+	ps := newProjectSet(cfg, nil)
+
+	assert.Equal(t, "api", ps.resolve("").cfg.ProjectName, "unstamped commands go to the first project")
+	assert.Equal(t, "web", ps.resolve("web").cfg.ProjectName)
+	assert.Nil(t, ps.resolve("other"))
+}
@@ -26,6 +26,8 @@ type createTaskDirective struct {
 	StatusID    string // could be status name, resolved from _workflow_statuses
 	UseWorktree *bool
 	Worktree    string
+	BaseRef     string // branch, tag or commit the new worktree starts from
+	BaseTask    string // task whose worktree to stack on; "self" = the creating task
 }
 
 // parseCreateTasks extracts all CREATE_TASK_START...CREATE_TASK_END blocks from the result text.
@@ -81,6 +83,10 @@ func parseCreateTasks(resultText string) []createTaskDirective {
 					d.UseWorktree = &b
 				case "worktree":
 					d.Worktree = value
+				case "base_ref":
+					d.BaseRef = value
+				case "base_task":
+					d.BaseTask = value
 				}
 			}
 
@@ -180,13 +186,20 @@ func createTaskFromDirective(
 		}
 	}
 
+	baseTask := directive.BaseTask
+	if strings.EqualFold(baseTask, "self") {
+		baseTask = sourceTaskID
+	}
+
 	req := &v1.CreateTaskRequest{
-		ProjectId:   projectID,
-		WorkflowId:  workflowID,
-		Title:       directive.Title,
-		Description: directive.Description,
-		UseWorktree: useWorktree,
-		Metadata:    taskMeta,
+		ProjectId:          projectID,
+		WorkflowId:         workflowID,
+		Title:              directive.Title,
+		Description:        directive.Description,
+		UseWorktree:        useWorktree,
+		Metadata:           taskMeta,
+		WorktreeBaseRef:    directive.BaseRef,
+		WorktreeBaseTaskId: baseTask,
 	}
 	if statusID != "" {
 		req.StatusId = &statusID
@@ -359,3 +359,21 @@ func TestBuildTransitionRetryPrompt(t *testing.T) {
 		}
 	})
 }
+
+func TestParseCreateTasks_WorktreeBase(t *testing.T) {
+	input := "CREATE_TASK_START\ntitle: Backport fix\nuse_worktree: true\nbase_ref: release/1.2\n\nApply the fix.\nCREATE_TASK_END\n" +
+		"CREATE_TASK_START\ntitle: Follow-up\nbase_task: self\n\nBuild on this.\nCREATE_TASK_END"
+
+	got := parseCreateTasks(input)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 directives, got %d", len(got))
+	}
+
+	if got[0].BaseRef != "release/1.2" || got[0].BaseTask != "" {
+		t.Errorf("unexpected base of first directive: %+v", got[0])
+	}
+
+	if got[1].BaseTask != "self" || got[1].Description != "Build on this." {
+		t.Errorf("unexpected second directive: %+v", got[1])
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
+)
+
+// heartbeatInterval is how often the agent manager reports its state.
+const heartbeatInterval = 30 * time.Second
+
+// drainState tracks a server-requested drain (DrainAgentManager). While
+// draining, no new tasks are claimed; running tasks finish normally and the
+// agent manager then reports itself idle through the heartbeat.
+type drainState struct {
+	draining atomic.Bool
+	wake     chan struct{} // requests an immediate heartbeat
+}
+
+func newDrainState() *drainState {
+	return &drainState{wake: make(chan struct{}, 1)}
+}
+
+// set starts (or lifts) the drain and reports the new state right away.
+func (d *drainState) set(draining bool) {
+	d.draining.Store(draining)
+	d.notify()
+}
+
+func (d *drainState) active() bool {
+	return d.draining.Load()
+}
+
+// notify triggers an immediate heartbeat without blocking.
+func (d *drainState) notify() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// heartbeat periodically reports the number of running tasks and the drain
+// state to the server. activeTasks must be safe for concurrent use.
+func heartbeat(ctx context.Context, client taskguildv1connect.AgentManagerServiceClient, agentManagerID string, drain *drainState, activeTasks func() int) {
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-drain.wake:
+		}
+
+		active := activeTasks()
+		if drain.active() && active == 0 {
+			slog.Info("drained: no running tasks, agent manager is idle")
+		}
+
+		_, err := client.Heartbeat(ctx, connect.NewRequest(&v1.HeartbeatRequest{
+			AgentManagerId: agentManagerID,
+			ActiveTasks:    int32(active),
+			Timestamp:      timestamppb.Now(),
+			Draining:       drain.active(),
+		}))
+		if err != nil {
+			slog.Warn("heartbeat error", "error", err)
+		}
+	}
+}
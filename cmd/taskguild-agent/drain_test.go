@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func TestHeartbeat_ReportsDrainImmediately(t *testing.T) {
+	tc := newTestClients()
+	defer tc.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var running atomic.Int32
+	running.Store(1)
+
+	drain := newDrainState()
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		heartbeat(ctx, tc.agentClient, "am-1", drain, func() int { return int(running.Load()) })
+	}()
+
+	lastHeartbeat := func() *v1.HeartbeatRequest {
+		tc.agentHandler.mu.Lock()
+		defer tc.agentHandler.mu.Unlock()
+
+		if len(tc.agentHandler.heartbeatReqs) == 0 {
+			return nil
+		}
+
+		return tc.agentHandler.heartbeatReqs[len(tc.agentHandler.heartbeatReqs)-1]
+	}
+
+	// Starting a drain reports the state without waiting for the ticker.
+	drain.set(true)
+	require.Eventually(t, func() bool {
+		hb := lastHeartbeat()
+		return hb != nil && hb.GetDraining() && hb.GetActiveTasks() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The last task finishing reports the agent manager as idle.
+	running.Store(0)
+	drain.notify()
+	require.Eventually(t, func() bool {
+		hb := lastHeartbeat()
+		return hb.GetDraining() && hb.GetActiveTasks() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+
+	assert.Equal(t, "am-1", lastHeartbeat().GetAgentManagerId())
+}
+
+func TestDrainState_NotifyDoesNotBlock(t *testing.T) {
+	drain := newDrainState()
+
+	drain.set(true)
+	drain.notify()
+	drain.notify()
+
+	assert.True(t, drain.active())
+	assert.Len(t, drain.wake, 1)
+
+	drain.set(false)
+	assert.False(t, drain.active())
+}
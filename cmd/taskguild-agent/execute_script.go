@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"maps"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"sync"
 	"syscall"
 	"time"
@@ -130,7 +132,7 @@ func handleExecuteScript(ctx context.Context, client taskguildv1connect.AgentMan
 
 	execCmd := exec.CommandContext(execCtx, "/bin/sh", scriptPath)
 	execCmd.Dir = cfg.WorkDir
-	execCmd.Env = append(os.Environ(),
+	execCmd.Env = append(secretEnv(os.Environ(), cmd.GetSecrets()),
 		"TASKGUILD_PROJECT_NAME="+cfg.ProjectName,
 		"TASKGUILD_SCRIPT_ID="+scriptID,
 		"TASKGUILD_SCRIPT_FILENAME="+filename,
@@ -317,8 +319,19 @@ func (c *chunkBuffer) drain() []*v1.ScriptLogEntry {
 	return entries
 }
 
+// secretEnv appends the project secrets to env as NAME=value entries,
+// sorted by name.
+func secretEnv(env []string, secrets map[string]string) []string {
+	for _, name := range slices.Sorted(maps.Keys(secrets)) {
+		env = append(env, name+"="+secrets[name])
+	}
+
+	return env
+}
+
 // streamOutput reads from stdout and stderr pipes concurrently, buffers the
 // output, and sends chunks to the server every outputFlushInterval (200ms).
+// Each line is redacted with the client's redactor before it is buffered.
 // It blocks until both pipes are closed (i.e., the child process has ended).
 func streamOutput(
 	ctx context.Context,
@@ -330,6 +343,8 @@ func streamOutput(
 ) {
 	var chunk chunkBuffer
 
+	redactor := redactorOf(client)
+
 	// Read pipes into buffers concurrently.
 	var pipeWg conc.WaitGroup
 
@@ -340,7 +355,7 @@ func streamOutput(
 		lineCount := 0
 
 		for scanner.Scan() {
-			line := scanner.Text() + "\n"
+			line := redactor.String(scanner.Text()) + "\n"
 			lineCount++
 			slog.Info("[STREAM-TRACE] agent: read stdout line", "request_id", requestID, "line_num", lineCount, "len", len(line))
 			chunk.append(v1.ScriptLogStream_SCRIPT_LOG_STREAM_STDOUT, line)
@@ -362,7 +377,7 @@ func streamOutput(
 		lineCount := 0
 
 		for scanner.Scan() {
-			line := scanner.Text() + "\n"
+			line := redactor.String(scanner.Text()) + "\n"
 			lineCount++
 			slog.Info("[STREAM-TRACE] agent: read stderr line", "request_id", requestID, "line_num", lineCount, "len", len(line))
 			chunk.append(v1.ScriptLogStream_SCRIPT_LOG_STREAM_STDERR, line)
@@ -874,6 +874,50 @@ func TestHandleExecuteScript_LocalFileNotFound(t *testing.T) {
 	}
 }
 
+func TestHandleExecuteScript_SecretsInjectedAndRedacted(t *testing.T) {
+	mock := &scriptMockClient{}
+	workDir := t.TempDir()
+	cfg := &config{
+		ProjectName: "test-proj",
+		WorkDir:     workDir,
+	}
+
+	writeTestScript(t, workDir, "deploy.sh", "#!/bin/sh\necho \"token=$DEPLOY_TOKEN\"\ntest \"$DEPLOY_TOKEN\" = \"s3cret-value\"")
+
+	cmd := &v1.ExecuteScriptCommand{
+		RequestId: "req-secret",
+		ScriptId:  "sc-8",
+		Filename:  "deploy.sh",
+		Secrets:   map[string]string{"DEPLOY_TOKEN": "s3cret-value"},
+	}
+
+	cache := newPermissionCache("test-proj", nil)
+	cache.UpdateSecrets(cmd.GetSecrets())
+
+	handleExecuteScript(context.Background(), withRedaction(mock, cache.Redactor), cfg, cmd)
+
+	result := mock.getResult()
+	if result == nil {
+		t.Fatal("expected result to be reported")
+	}
+
+	if !result.GetSuccess() {
+		t.Fatalf("expected the secret to be set in the script environment, exit code %d", result.GetExitCode())
+	}
+
+	for _, e := range result.GetLogEntries() {
+		if strings.Contains(e.GetText(), "s3cret-value") {
+			t.Errorf("expected secret value to be redacted, got: %q", e.GetText())
+		}
+	}
+
+	for _, e := range mock.allChunkEntries() {
+		if strings.Contains(e.GetText(), "s3cret-value") {
+			t.Errorf("expected secret value to be redacted in chunks, got: %q", e.GetText())
+		}
+	}
+}
+
 // --- streamOutput with slow producer (verifies periodic flushing) ---
 
 func TestStreamOutput_PeriodicFlush(t *testing.T) {
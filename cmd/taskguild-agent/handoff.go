@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/kazz187/taskguild/pkg/clog"
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
+)
+
+const (
+	// handoffAgentOutputs is the number of most recent agent outputs included
+	// in a handoff summary.
+	handoffAgentOutputs = 5
+
+	// maxHandoffEntrySize is the maximum number of characters kept for a
+	// single result or agent output in a handoff summary.
+	maxHandoffEntrySize = 4000
+
+	// maxHandoffDiffSize is the maximum number of characters of the worktree
+	// patch included in a handoff summary.
+	maxHandoffDiffSize = 20000
+)
+
+// compactRequests tracks running tasks whose next turn should start a fresh
+// session seeded with a handoff summary (requested via CompactTaskCommand).
+var compactRequests struct {
+	mu        sync.Mutex
+	requested map[string]bool
+}
+
+func init() {
+	compactRequests.requested = make(map[string]bool)
+}
+
+// requestCompaction marks taskID to be compacted before its next turn.
+func requestCompaction(taskID string) {
+	compactRequests.mu.Lock()
+	defer compactRequests.mu.Unlock()
+
+	compactRequests.requested[taskID] = true
+}
+
+// takeCompaction reports whether compaction was requested for taskID and
+// clears the request.
+func takeCompaction(taskID string) bool {
+	compactRequests.mu.Lock()
+	defer compactRequests.mu.Unlock()
+
+	requested := compactRequests.requested[taskID]
+	delete(compactRequests.requested, taskID)
+
+	return requested
+}
+
+// buildHandoff builds a compact summary of the task's history (results,
+// plans, directives, recent agent outputs and the worktree diff) so a fresh
+// session can pick up the work where the previous one left off. dir is the
+// directory the task runs in. Returns "" if there is nothing to hand off.
+func buildHandoff(ctx context.Context, client taskguildv1connect.AgentManagerServiceClient, taskID, dir string, metadata map[string]string) string {
+	logger := clog.LoggerFromContext(ctx)
+
+	var logs []*v1.TaskLog
+
+	resp, err := client.GetTaskHandoff(ctx, connect.NewRequest(&v1.GetTaskHandoffRequest{
+		TaskId:          taskID,
+		MaxAgentOutputs: handoffAgentOutputs,
+	}))
+	if err != nil {
+		logger.Warn("failed to fetch task history for handoff", "error", err)
+	} else {
+		logs = resp.Msg.GetLogs()
+	}
+
+	return formatHandoff(logs, resultHistoryTexts(metadata), collectWorktreeDiff(ctx, dir))
+}
+
+// resultHistoryTexts returns the results already included in the task prompt
+// via _result_history, so the handoff does not repeat them.
+func resultHistoryTexts(metadata map[string]string) map[string]bool {
+	known := make(map[string]bool)
+
+	var history []resultHistoryEntry
+	if json.Unmarshal([]byte(metadata["_result_history"]), &history) == nil {
+		for _, h := range history {
+			known[h.Text] = true
+		}
+	}
+
+	return known
+}
+
+// formatHandoff renders the handoff summary. Results listed in known are
+// skipped.
+func formatHandoff(logs []*v1.TaskLog, known map[string]bool, diff string) string {
+	var results, directives, outputs strings.Builder
+
+	for _, l := range logs {
+		at := l.GetCreatedAt().AsTime().Format(time.RFC3339)
+		text := l.GetMetadata()["full_text"]
+
+		if text == "" {
+			text = l.GetMessage()
+		}
+
+		switch l.GetCategory() {
+		case v1.TaskLogCategory_TASK_LOG_CATEGORY_RESULT:
+			resultType := l.GetMetadata()["result_type"]
+			// The current description is already part of the task prompt.
+			if resultType == "description" || known[text] {
+				continue
+			}
+
+			fmt.Fprintf(&results, "#### %s (%s)\n%s\n\n", resultType, at, truncateText(text, maxHandoffEntrySize))
+		case v1.TaskLogCategory_TASK_LOG_CATEGORY_DIRECTIVE:
+			fmt.Fprintf(&directives, "- %s (%s)\n", l.GetMessage(), at)
+		case v1.TaskLogCategory_TASK_LOG_CATEGORY_AGENT_OUTPUT:
+			fmt.Fprintf(&outputs, "#### Turn %s (%s)\n%s\n\n", l.GetMetadata()["turn"], at, truncateText(text, maxHandoffEntrySize))
+		}
+	}
+
+	if results.Len() == 0 && directives.Len() == 0 && outputs.Len() == 0 && diff == "" {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("## Handoff From Previous Session\n\n")
+	sb.WriteString("You are continuing this task in a new session; the previous session is no longer available. " +
+		"The summary below was reconstructed from the task history. " +
+		"Continue from where the work left off instead of starting over, and do not redo completed steps.\n\n")
+
+	if results.Len() > 0 {
+		sb.WriteString("### Results and Plans\n")
+		sb.WriteString(results.String())
+	}
+
+	if directives.Len() > 0 {
+		sb.WriteString("### Directives\n")
+		sb.WriteString(directives.String())
+		sb.WriteString("\n")
+	}
+
+	if outputs.Len() > 0 {
+		sb.WriteString("### Recent Agent Output\n")
+		sb.WriteString(outputs.String())
+	}
+
+	if diff != "" {
+		sb.WriteString("### Working Tree Changes\n")
+		sb.WriteString(diff)
+	}
+
+	return strings.TrimRight(sb.String(), "\n") + "\n"
+}
+
+// collectWorktreeDiff describes the changes in dir relative to the default
+// branch: commits, uncommitted files and the (truncated) patch. Returns ""
+// if dir is not a git work tree or has no changes.
+func collectWorktreeDiff(ctx context.Context, dir string) string {
+	if _, err := gitOutput(ctx, dir, "rev-parse", "--is-inside-work-tree"); err != nil {
+		return ""
+	}
+
+	base := "HEAD"
+	defaultBranch := detectDefaultBranch(ctx, dir)
+
+	for _, ref := range []string{"origin/" + defaultBranch, defaultBranch} {
+		if mb, err := gitOutput(ctx, dir, "merge-base", "HEAD", ref); err == nil && mb != "" {
+			base = mb
+			break
+		}
+	}
+
+	var sb strings.Builder
+
+	if commits, err := gitOutput(ctx, dir, "log", "--oneline", base+"..HEAD"); err == nil && commits != "" {
+		fmt.Fprintf(&sb, "Commits since %s:\n```\n%s\n```\n\n", defaultBranch, commits)
+	}
+
+	if status, err := gitOutput(ctx, dir, "status", "--short"); err == nil && status != "" {
+		fmt.Fprintf(&sb, "Uncommitted files:\n```\n%s\n```\n\n", status)
+	}
+
+	if stat, err := gitOutput(ctx, dir, "diff", "--stat", base); err == nil && stat != "" {
+		fmt.Fprintf(&sb, "Changed files:\n```\n%s\n```\n\n", stat)
+
+		if patch, err := gitOutput(ctx, dir, "diff", base); err == nil && patch != "" {
+			if len(patch) > maxHandoffDiffSize {
+				patch = patch[:maxHandoffDiffSize] + "\n... (diff truncated)"
+			}
+
+			fmt.Fprintf(&sb, "Diff:\n```diff\n%s\n```\n\n", patch)
+		}
+	}
+
+	return sb.String()
+}
+
+// clearCompactRequest resets the persisted compaction flag once the task has
+// been compacted.
+func clearCompactRequest(ctx context.Context, taskClient taskguildv1connect.TaskServiceClient, taskID string) {
+	_, err := taskClient.UpdateTask(ctx, connect.NewRequest(&v1.UpdateTaskRequest{
+		Id:       taskID,
+		Metadata: map[string]string{"_compact_requested": "false"},
+	}))
+	if err != nil {
+		clog.LoggerFromContext(ctx).Error("failed to clear compact request", "error", err)
+	}
+}
+
+func gitOutput(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(string(out), "\n"), nil
+}
+
+// withHandoff returns the prompt for a fresh session: the task prompt
+// followed by the handoff summary and, if the turn was continuing with a
+// different prompt (e.g. a user response), that prompt.
+func withHandoff(taskPrompt any, handoff, next string) any {
+	p := taskPrompt
+	if handoff != "" {
+		p = appendPromptText(p, handoff)
+	}
+
+	if next != "" {
+		p = appendPromptText(p, "## Continue\n\n"+next)
+	}
+
+	return p
+}
+
+// appendPromptText appends a text section to a prompt built by
+// buildUserPromptWithImages (a string or a list of content blocks).
+func appendPromptText(prompt any, text string) any {
+	switch p := prompt.(type) {
+	case string:
+		return strings.TrimRight(p, "\n") + "\n\n" + text
+	case []map[string]any:
+		blocks := append([]map[string]any(nil), p...)
+
+		return append(blocks, map[string]any{"type": "text", "text": text})
+	default:
+		return prompt
+	}
+}
+
+// continuationPrompt returns prompt if it differs from the task prompt the
+// session started with, and "" otherwise.
+func continuationPrompt(prompt, taskPrompt any) string {
+	s, ok := prompt.(string)
+	if !ok {
+		return ""
+	}
+
+	if ts, ok := taskPrompt.(string); ok && ts == s {
+		return ""
+	}
+
+	return s
+}
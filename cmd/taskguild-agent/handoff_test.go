@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func TestFormatHandoff(t *testing.T) {
+	logs := []*v1.TaskLog{
+		{
+			Category: v1.TaskLogCategory_TASK_LOG_CATEGORY_RESULT,
+			Message:  "plan preview",
+			Metadata: map[string]string{"result_type": "plan", "full_text": "1. Add the parser\n2. Wire it up"},
+		},
+		{
+			Category: v1.TaskLogCategory_TASK_LOG_CATEGORY_RESULT,
+			Metadata: map[string]string{"result_type": "summary", "full_text": "already in prompt"},
+		},
+		{
+			Category: v1.TaskLogCategory_TASK_LOG_CATEGORY_RESULT,
+			Metadata: map[string]string{"result_type": "description", "full_text": "old description"},
+		},
+		{
+			Category: v1.TaskLogCategory_TASK_LOG_CATEGORY_DIRECTIVE,
+			Message:  "Status transition: Develop",
+		},
+		{
+			Category: v1.TaskLogCategory_TASK_LOG_CATEGORY_AGENT_OUTPUT,
+			Message:  "preview",
+			Metadata: map[string]string{"turn": "3", "full_text": "Parser added, tests pending."},
+		},
+	}
+
+	got := formatHandoff(logs, map[string]bool{"already in prompt": true}, "")
+
+	assert.Contains(t, got, "## Handoff From Previous Session")
+	assert.Contains(t, got, "#### plan")
+	assert.Contains(t, got, "1. Add the parser")
+	assert.Contains(t, got, "- Status transition: Develop")
+	assert.Contains(t, got, "#### Turn 3")
+	assert.Contains(t, got, "Parser added, tests pending.")
+	assert.NotContains(t, got, "already in prompt")
+	assert.NotContains(t, got, "old description")
+	assert.NotContains(t, got, "### Working Tree Changes")
+
+	assert.Empty(t, formatHandoff(nil, nil, ""))
+}
+
+func TestCollectWorktreeDiff(t *testing.T) {
+	dir := t.TempDir()
+	git := newTestGitRepo(t, dir)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a\n"), 0o644))
+	git("add", ".")
+	git("commit", "-q", "-m", "initial")
+	git("checkout", "-q", "-b", "worktree-feature")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.txt"), []byte("b\n"), 0o644))
+	git("add", ".")
+	git("commit", "-q", "-m", "add b")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("changed\n"), 0o644))
+
+	got := collectWorktreeDiff(context.Background(), dir)
+
+	assert.Contains(t, got, "add b")
+	assert.Contains(t, got, "M a.txt")
+	assert.Contains(t, got, "b.txt")
+	assert.Contains(t, got, "+changed")
+
+	assert.Empty(t, collectWorktreeDiff(context.Background(), t.TempDir()))
+}
+
+// TestRunTask_CompactRequested verifies that a pending compaction starts a
+// fresh session whose prompt carries the handoff summary.
+func TestRunTask_CompactRequested(t *testing.T) {
+	tc := newTestClients()
+	defer tc.Close()
+
+	tc.agentHandler.handoffLogs = []*v1.TaskLog{{
+		Category: v1.TaskLogCategory_TASK_LOG_CATEGORY_AGENT_OUTPUT,
+		Metadata: map[string]string{"turn": "7", "full_text": "Halfway through the refactor."},
+	}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	metadata := baseMetadata("Develop", `[{"name":"Review"}]`)
+	metadata["session_id_Develop"] = "old-session"
+	metadata["_compact_requested"] = "true"
+
+	qr := &mockQueryRunner{
+		results: []mockQueryRunnerResult{
+			{Result: makeResult("NEXT_STATUS: Review")},
+		},
+	}
+
+	permCache := newPermissionCache("test", tc.agentClient)
+	scpCache := newSingleCommandPermissionCache("test", tc.agentClient)
+
+	runTask(ctx, tc.agentClient, tc.taskClient, tc.interClient,
+		"agent-mgr-1", "task-compact", "instructions", metadata,
+		t.TempDir(), permCache, scpCache, qr, func() bool { return false })
+
+	calls := qr.getCalls()
+	require.Len(t, calls, 1)
+	assert.Contains(t, calls[0].Prompt, "# Task: Test Task")
+	assert.Contains(t, calls[0].Prompt, "## Handoff From Previous Session")
+	assert.Contains(t, calls[0].Prompt, "Halfway through the refactor.")
+
+	tc.taskHandler.mu.Lock()
+	defer tc.taskHandler.mu.Unlock()
+
+	cleared := false
+
+	for _, req := range tc.taskHandler.updateTaskReqs {
+		if req.GetMetadata()["_compact_requested"] == "false" {
+			cleared = true
+		}
+	}
+
+	assert.True(t, cleared, "compact request should be cleared")
+}
+
+func TestWithHandoff(t *testing.T) {
+	assert.Equal(t, "task\n\nhandoff\n\n## Continue\n\nreply", withHandoff("task\n", "handoff", "reply"))
+
+	blocks := []map[string]any{{"type": "text", "text": "task"}}
+	got, ok := withHandoff(blocks, "handoff", "").([]map[string]any)
+	require.True(t, ok)
+	require.Len(t, got, 2)
+	assert.Equal(t, "handoff", got[1]["text"])
+	assert.Len(t, blocks, 1)
+
+	assert.Empty(t, continuationPrompt("task", "task"))
+	assert.Equal(t, "reply", continuationPrompt("reply", blocks))
+}
@@ -245,10 +245,37 @@ func slugifyASCII(s string) string {
 	return slugMultiHyphen.ReplaceAllString(slug, "-")
 }
 
+// worktreeBase selects the start point and branch name of a new worktree.
+// It is built from the _worktree_* metadata injected by the server.
+type worktreeBase struct {
+	ref            string // branch, tag or commit
+	worktree       string // worktree of another task to stack on
+	taskID         string // task owning worktree
+	branchTemplate string // e.g. "worktree-{name}"; empty = default
+}
+
+func worktreeBaseFromMetadata(metadata map[string]string) worktreeBase {
+	return worktreeBase{
+		ref:            metadata["_worktree_base_ref"],
+		worktree:       metadata["_worktree_base_worktree"],
+		taskID:         metadata["_worktree_base_task_id"],
+		branchTemplate: metadata["_worktree_branch_template"],
+	}
+}
+
+// worktreeBranchName renders the branch name template for a worktree.
+func worktreeBranchName(template, worktreeName, taskID string) string {
+	if template == "" {
+		template = "worktree-{name}"
+	}
+
+	return strings.NewReplacer("{name}", worktreeName, "{task_id}", taskID).Replace(template)
+}
+
 // ensureWorktree creates a git worktree if the directory does not already exist.
-// It fetches the latest default branch from origin and creates the worktree
-// from origin/<default-branch> to ensure it starts from the most recent code.
-func ensureWorktree(ctx context.Context, workDir, worktreeName, taskID string) (string, error) {
+// The worktree starts from base (see resolveWorktreeStartPoint) on a branch
+// named by base.branchTemplate.
+func ensureWorktree(ctx context.Context, workDir, worktreeName, taskID string, base worktreeBase) (string, error) {
 	logger := clog.LoggerFromContext(ctx)
 
 	wtDir := filepath.Join(workDir, ".claude", "worktrees", worktreeName)
@@ -264,22 +291,12 @@ func ensureWorktree(ctx context.Context, workDir, worktreeName, taskID string) (
 		return "", fmt.Errorf("mkdir: %w", err)
 	}
 
-	// Fetch the latest default branch from origin so the worktree starts
-	// from the most recent commit rather than a potentially stale local HEAD.
-	// If fetch fails (e.g. no network), fall back to creating from local HEAD.
-	defaultBranch := detectDefaultBranch(ctx, workDir)
-	startPoint := "" // empty means HEAD (fallback)
-	fetchCmd := exec.CommandContext(ctx, "git", "fetch", "origin", defaultBranch)
-
-	fetchCmd.Dir = workDir
-	if out, err := fetchCmd.CombinedOutput(); err != nil {
-		logger.Warn("git fetch origin failed, creating worktree from local HEAD", "error", err, "output", string(out))
-	} else {
-		logger.Info("fetched latest default branch from origin", "branch", defaultBranch)
-		startPoint = "origin/" + defaultBranch
+	startPoint, err := resolveWorktreeStartPoint(ctx, logger, workDir, base)
+	if err != nil {
+		return "", err
 	}
 
-	branchName := "worktree-" + worktreeName
+	branchName := worktreeBranchName(base.branchTemplate, worktreeName, taskID)
 
 	var cmd *exec.Cmd
 	if startPoint != "" {
@@ -299,7 +316,7 @@ func ensureWorktree(ctx context.Context, workDir, worktreeName, taskID string) (
 		}
 	}
 
-	logger.Info("created worktree", "worktree_dir", wtDir, "branch", branchName)
+	logger.Info("created worktree", "worktree_dir", wtDir, "branch", branchName, "start_point", startPoint)
 
 	// Copy .claude/ resources that are not carried over by git worktree add.
 	// These are typically .gitignored so they must be explicitly synced.
@@ -308,6 +325,76 @@ func ensureWorktree(ctx context.Context, workDir, worktreeName, taskID string) (
 	return wtDir, nil
 }
 
+// resolveWorktreeStartPoint returns the commit-ish a new worktree starts
+// from. Empty means the local HEAD.
+//
+//   - base.worktree: the branch (or HEAD) checked out in that worktree. If
+//     the worktree was removed (e.g. by the worktree policy), its branch.
+//   - base.ref: origin/<ref> if it exists after fetching it, otherwise the
+//     local ref (tag, commit or branch).
+//   - neither: origin/<default-branch> when it can be fetched, otherwise HEAD.
+func resolveWorktreeStartPoint(ctx context.Context, logger *slog.Logger, workDir string, base worktreeBase) (string, error) {
+	if base.worktree != "" {
+		baseDir := filepath.Join(workDir, ".claude", "worktrees", base.worktree)
+		if info, err := os.Stat(baseDir); err != nil || !info.IsDir() {
+			branch := worktreeBranchName(base.branchTemplate, base.worktree, base.taskID)
+			if _, err := gitOutput(ctx, workDir, "rev-parse", "--verify", "--quiet", "refs/heads/"+branch); err != nil {
+				return "", fmt.Errorf("base worktree %q not found and its branch %q does not exist", base.worktree, branch)
+			}
+
+			logger.Info("base worktree not found, using its branch", "worktree", base.worktree, "branch", branch)
+
+			return branch, nil
+		}
+
+		if branch, err := gitOutput(ctx, baseDir, "branch", "--show-current"); err == nil && branch != "" {
+			return branch, nil
+		}
+
+		head, err := gitOutput(ctx, baseDir, "rev-parse", "HEAD")
+		if err != nil {
+			return "", fmt.Errorf("resolve HEAD of base worktree %q: %w", base.worktree, err)
+		}
+
+		return head, nil
+	}
+
+	if base.ref != "" {
+		out, fetchErr := gitCombined(ctx, workDir, "fetch", "origin", base.ref)
+		if fetchErr != nil {
+			logger.Warn("git fetch origin failed, using local ref", "ref", base.ref, "error", fetchErr, "output", out)
+		} else if _, err := gitOutput(ctx, workDir, "rev-parse", "--verify", "--quiet", "refs/remotes/origin/"+base.ref); err == nil {
+			return "origin/" + base.ref, nil
+		}
+
+		if _, err := gitOutput(ctx, workDir, "rev-parse", "--verify", "--quiet", base.ref+"^{commit}"); err == nil {
+			return base.ref, nil
+		}
+
+		// A tag or commit fetched by name is only recorded in FETCH_HEAD.
+		if fetchErr == nil {
+			if sha, err := gitOutput(ctx, workDir, "rev-parse", "--verify", "--quiet", "FETCH_HEAD^{commit}"); err == nil {
+				return sha, nil
+			}
+		}
+
+		return "", fmt.Errorf("base ref %q not found", base.ref)
+	}
+
+	// Fetch the latest default branch from origin so the worktree starts
+	// from the most recent commit rather than a potentially stale local HEAD.
+	// If fetch fails (e.g. no network), fall back to creating from local HEAD.
+	defaultBranch := detectDefaultBranch(ctx, workDir)
+	if out, err := gitCombined(ctx, workDir, "fetch", "origin", defaultBranch); err != nil {
+		logger.Warn("git fetch origin failed, creating worktree from local HEAD", "error", err, "output", out)
+		return "", nil
+	}
+
+	logger.Info("fetched latest default branch from origin", "branch", defaultBranch)
+
+	return "origin/" + defaultBranch, nil
+}
+
 // syncClaudeDirToWorktree copies .claude/ resources from the main repo to the
 // worktree directory. git worktree add does not copy .gitignored files, so
 // agents/, skills/, and settings.json must be synced explicitly.
@@ -14,7 +14,7 @@ import (
 
 	claudeagent "github.com/kazz187/claude-agent-sdk-go"
 	"github.com/kazz187/taskguild/pkg/clog"
-	"github.com/kazz187/taskguild/pkg/shellparse"
+	"github.com/kazz187/taskguild/pkg/permcheck"
 	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
 	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
 )
@@ -261,22 +261,6 @@ func waitForUserResponse(
 	}
 }
 
-// readOnlyTools are always auto-allowed regardless of permission mode.
-var readOnlyTools = map[string]bool{
-	"Read":      true,
-	"Glob":      true,
-	"Grep":      true,
-	"WebSearch": true,
-	"WebFetch":  true,
-}
-
-// editTools are auto-allowed in acceptEdits and bypassPermissions modes.
-var editTools = map[string]bool{
-	"Edit":         true,
-	"Write":        true,
-	"NotebookEdit": true,
-}
-
 // handleAskUserQuestion processes the AskUserQuestion tool by presenting each question
 // as an INTERACTION_TYPE_QUESTION with selectable options. Returns PermissionResultAllow
 // with the user's answers injected into UpdatedInput.
@@ -436,6 +420,44 @@ func handleAskUserQuestion(
 	}, nil
 }
 
+// statusPermissions carries the permission inputs of the task's current
+// workflow status.
+type statusPermissions struct {
+	workflowID string
+	statusName string
+	paths      *permcheck.PathPolicy
+}
+
+// newStatusPermissions reads the status permission inputs from task metadata.
+func newStatusPermissions(metadata map[string]string) *statusPermissions {
+	return &statusPermissions{
+		workflowID: metadata["_workflow_id"],
+		statusName: metadata["_current_status_name"],
+		paths:      permcheck.ParsePathPolicy(metadata["_path_policy"]),
+	}
+}
+
+// pathPolicy returns the status path policy; nil-safe.
+func (s *statusPermissions) pathPolicy() *permcheck.PathPolicy {
+	if s == nil {
+		return nil
+	}
+
+	return s.paths
+}
+
+// grantScope returns the scope that task- and status-scoped grants are
+// matched against.
+func (s *statusPermissions) grantScope(taskID string) permcheck.Scope {
+	scope := permcheck.Scope{TaskID: taskID}
+	if s != nil {
+		scope.WorkflowID = s.workflowID
+		scope.StatusName = s.statusName
+	}
+
+	return scope
+}
+
 func handlePermissionRequest(
 	ctx context.Context,
 	client taskguildv1connect.AgentManagerServiceClient,
@@ -449,6 +471,8 @@ func handlePermissionRequest(
 	permCache *permissionCache,
 	scpCache *singleCommandPermissionCache,
 	statusSkills map[string]bool,
+	cwd string,
+	status *statusPermissions,
 ) (claudeagent.PermissionResult, error) {
 	logger := clog.LoggerFromContext(ctx)
 
@@ -462,74 +486,41 @@ func handlePermissionRequest(
 		return handleAskUserQuestion(ctx, client, taskID, agentID, input, waiter)
 	}
 
-	// bypassPermissions / auto / dontAsk: allow everything else
-	if permMode == claudeagent.PermissionModeBypassPermissions ||
-		permMode == claudeagent.PermissionModeAuto ||
-		permMode == claudeagent.PermissionModeDontAsk {
-		logger.Debug("auto-allowing tool", "tool", toolName, "mode", string(permMode))
-		return claudeagent.PermissionResultAllow{}, nil
-	}
-
-	// Read-only tools: always allowed
-	if readOnlyTools[toolName] {
-		logger.Debug("auto-allowing read-only tool", "tool", toolName)
-		return claudeagent.PermissionResultAllow{}, nil
-	}
-
-	// Edit tools: allowed in acceptEdits mode
-	if editTools[toolName] && permMode == claudeagent.PermissionModeAcceptEdits {
-		logger.Debug("auto-allowing edit tool (acceptEdits)", "tool", toolName)
-		return claudeagent.PermissionResultAllow{}, nil
+	rules := permcheck.Rules{PathPolicy: status.pathPolicy()}
+	if permCache != nil {
+		rules = permCache.Rules()
+		rules.PathPolicy = permcheck.MergePathPolicies(rules.PathPolicy, status.pathPolicy())
 	}
 
-	// Plan mode tools: ExitPlanMode approval is handled by PreToolUse hook,
-	// EnterPlanMode is a safe mode switch — both skip permission requests.
-	if toolName == "ExitPlanMode" || toolName == "EnterPlanMode" {
-		logger.Debug("auto-allowing plan mode tool", "tool", toolName)
-		return claudeagent.PermissionResultAllow{}, nil
-	}
-
-	// Skill tool: auto-allow when the requested skill is one of the current
-	// status's execution skills or a skill registered as a hook for the
-	// current status. These are skills TaskGuild itself has wired up for the
-	// task, so there is no user decision needed.
-	if toolName == "Skill" && len(statusSkills) > 0 {
-		if skillRaw, ok := input["skill"]; ok {
-			if skillName, ok := skillRaw.(string); ok && statusSkills[skillName] {
-				logger.Debug("auto-allowing Skill tool (configured for status)", "skill", skillName)
-				return claudeagent.PermissionResultAllow{}, nil
-			}
-		}
+	if scpCache != nil {
+		rules.Commands = scpCache.Rules()
 	}
 
-	logger.Debug("permission request", "tool", toolName, "agent_id", toolCtx.AgentID, "tool_use_id", toolCtx.ToolUseID)
-
-	// Check permission cache: auto-allow if a matching "always allow" rule exists.
-	if permCache != nil && permCache.Check(toolName, input) {
-		logger.Debug("auto-allowing tool (permission cache hit)", "tool", toolName)
+	decision := permcheck.Evaluate(rules, permcheck.Request{
+		ToolName:     toolName,
+		Input:        input,
+		Mode:         string(permMode),
+		Cwd:          cwd,
+		StatusSkills: statusSkills,
+		Scope:        status.grantScope(taskID),
+	})
+
+	switch decision.Outcome {
+	case permcheck.Deny:
+		logger.Info("permission denied", "tool", toolName, "source", decision.Source, "reason", decision.Reason)
+		return claudeagent.PermissionResultDeny{Message: decision.Reason}, nil
+	case permcheck.Allow:
+		logger.Debug("auto-allowing tool", "tool", toolName, "source", decision.Source, "rule", decision.Rule, "mode", string(permMode))
 		return claudeagent.PermissionResultAllow{}, nil
 	}
 
-	// Single-command permission check for Bash tool.
-	var bashMeta *bashPermissionMetadata
-
-	if toolName == "Bash" && scpCache != nil {
-		if cmdRaw, ok := input["command"]; ok {
-			if cmdStr, ok := cmdRaw.(string); ok && cmdStr != "" {
-				parsed := shellparse.Parse(cmdStr)
-
-				allMatched, meta := scpCache.CheckAllCommands(parsed)
-				if allMatched {
-					logger.Debug("auto-allowing Bash (all commands matched)", "command", cmdStr)
-					return claudeagent.PermissionResultAllow{}, nil
-				}
-
-				bashMeta = meta
-			}
-		}
-	}
+	logger.Debug("permission request", "tool", toolName, "source", decision.Source, "reason", decision.Reason, "agent_id", toolCtx.AgentID, "tool_use_id", toolCtx.ToolUseID)
 
 	description := formatToolDescription(toolName, input)
+	if decision.Source == permcheck.SourceEgressPolicy {
+		// Show the exact destination so the user can tell where data goes.
+		description += "\n\n**Network access:** " + decision.Reason
+	}
 
 	// Build interaction options based on tool type.
 	var options []*v1.InteractionOption
@@ -549,8 +540,8 @@ func handlePermissionRequest(
 	// Attach parsed command metadata for Bash interactions.
 	var metadataJSON string
 
-	if bashMeta != nil {
-		if data, err := json.Marshal(bashMeta); err == nil {
+	if decision.Bash != nil {
+		if data, err := json.Marshal(decision.Bash); err == nil {
 			metadataJSON = string(data)
 		}
 	}
@@ -588,7 +579,10 @@ func handlePermissionRequest(
 		// Try to parse the response as JSON (always_allow_command from frontend).
 		var aacResp alwaysAllowCommandResponse
 		if json.Unmarshal([]byte(responseStr), &aacResp) == nil && aacResp.Action == "always_allow_command" {
-			return handleAlwaysAllowCommand(ctx, client, scpCache, aacResp.Rules, toolName, logger)
+			return handleAlwaysAllowCommand(ctx, client, scpCache, aacResp, grantOrigin{
+				scope:         status.grantScope(taskID),
+				interactionID: interactionID,
+			}, toolName, logger)
 		}
 
 		switch responseStr {
@@ -607,6 +601,19 @@ func handlePermissionRequest(
 type alwaysAllowCommandResponse struct {
 	Action string                           `json:"action"`
 	Rules  []alwaysAllowCommandResponseRule `json:"rules"`
+	// Scope is "task", "status" or "project". Empty means a permanent
+	// project rule, as before scopes existed.
+	Scope string `json:"scope,omitempty"`
+	// TTLHours, when positive, makes the grant expire after that many hours.
+	TTLHours int `json:"ttl_hours,omitempty"`
+}
+
+// grantOrigin describes where an "always allow" grant comes from.
+type grantOrigin struct {
+	scope permcheck.Scope
+	// interactionID is the approved permission request; the server derives
+	// who granted the rule from it.
+	interactionID string
 }
 
 type alwaysAllowCommandResponseRule struct {
@@ -615,18 +622,30 @@ type alwaysAllowCommandResponseRule struct {
 }
 
 // handleAlwaysAllowCommand processes the "always_allow_command" response by
-// registering the user-edited wildcard rules via the AddSingleCommandPermission RPC.
+// registering the user-edited wildcard rules via the AddSingleCommandPermission
+// RPC, limited to the scope and lifetime the responder chose.
 func handleAlwaysAllowCommand(
 	ctx context.Context,
 	client taskguildv1connect.AgentManagerServiceClient,
 	scpCache *singleCommandPermissionCache,
-	rules []alwaysAllowCommandResponseRule,
+	resp alwaysAllowCommandResponse,
+	origin grantOrigin,
 	toolName string,
 	logger *slog.Logger,
 ) (claudeagent.PermissionResult, error) {
+	var taskScope, workflowID, statusName string
+
+	switch resp.Scope {
+	case "task":
+		taskScope = origin.scope.TaskID
+	case "status":
+		workflowID = origin.scope.WorkflowID
+		statusName = origin.scope.StatusName
+	}
+
 	var registered int
 
-	for _, rule := range rules {
+	for _, rule := range resp.Rules {
 		if rule.Pattern == "" {
 			continue
 		}
@@ -636,11 +655,22 @@ func handleAlwaysAllowCommand(
 			ruleType = "command"
 		}
 
-		_, err := client.AddSingleCommandPermission(ctx, connect.NewRequest(&v1.AddSingleCommandPermissionRequest{
-			ProjectName: scpCache.projectName,
-			Pattern:     rule.Pattern,
-			Type:        ruleType,
-		}))
+		req := &v1.AddSingleCommandPermissionRequest{
+			ProjectName:   scpCache.projectName,
+			Pattern:       rule.Pattern,
+			Type:          ruleType,
+			Scope:         resp.Scope,
+			TaskId:        taskScope,
+			WorkflowId:    workflowID,
+			StatusName:    statusName,
+			OriginTaskId:  origin.scope.TaskID,
+			InteractionId: origin.interactionID,
+		}
+		if resp.TTLHours > 0 {
+			req.TtlSeconds = int64(resp.TTLHours) * int64(time.Hour/time.Second)
+		}
+
+		_, err := client.AddSingleCommandPermission(ctx, connect.NewRequest(req))
 		if err != nil {
 			logger.Error("failed to add single command permission", "pattern", rule.Pattern, "error", err)
 			continue
@@ -649,7 +679,7 @@ func handleAlwaysAllowCommand(
 		registered++
 	}
 
-	logger.Info("permission granted (always allow command)", "tool", toolName, "rules_registered", registered)
+	logger.Info("permission granted (always allow command)", "tool", toolName, "rules_registered", registered, "scope", resp.Scope, "ttl_hours", resp.TTLHours)
 
 	// Immediately refresh the cache so subsequent calls are auto-allowed.
 	if scpCache != nil {
@@ -12,6 +12,7 @@ var (
 
 	runCmd              = app.Command("run", "Run the agent manager (connects to server, executes tasks)")
 	overrideAgentMDFlag = runCmd.Flag("override-agent-md", "Overwrite all local agent MD files from server on startup").Bool()
+	configFlag          = runCmd.Flag("config", "Path to a YAML config file listing the projects to serve").Envar("TASKGUILD_CONFIG").String()
 	sentinelCmd         = app.Command("sentinel", "Supervisor that manages 'run' with auto-restart and binary watching")
 )
 
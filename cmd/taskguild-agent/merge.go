@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
+)
+
+const (
+	// mergeVerifyTimeout limits a single merge verification command.
+	mergeVerifyTimeout = 30 * time.Minute
+
+	// maxMergeOutputSize is the maximum number of bytes of command output
+	// reported for a failed merge step (the tail is kept).
+	maxMergeOutputSize = 8000
+)
+
+// mergeFailure describes the step of a merge that failed.
+type mergeFailure struct {
+	step          string
+	err           error
+	conflictFiles []string
+	output        string
+}
+
+func (f *mergeFailure) Error() string {
+	return fmt.Sprintf("%s: %v", f.step, f.err)
+}
+
+// handleMergeWorktree runs a merge queue entry: it rebases the worktree
+// branch onto the base branch, runs the verification commands and
+// fast-forwards the base branch in workDir, then optionally pushes it.
+func handleMergeWorktree(ctx context.Context, client taskguildv1connect.AgentManagerServiceClient, workDir string, cmd *v1.MergeWorktreeCommand) {
+	report := &v1.ReportMergeResultRequest{
+		RequestId: cmd.GetRequestId(),
+		TaskId:    cmd.GetTaskId(),
+	}
+
+	wtDir := filepath.Join(workDir, ".claude", "worktrees", cmd.GetWorktreeName())
+	if info, err := os.Stat(wtDir); cmd.GetWorktreeName() == "" || err != nil || !info.IsDir() {
+		report.NotFound = true
+	} else {
+		baseBranch := cmd.GetBaseBranch()
+		if baseBranch == "" {
+			baseBranch = detectDefaultBranch(ctx, workDir)
+		}
+
+		report.BaseBranch = baseBranch
+
+		merged, err := mergeWorktree(ctx, workDir, wtDir, baseBranch, cmd.GetVerifyCommands(), cmd.GetPush())
+		report.MergedCommit = merged
+
+		var f *mergeFailure
+		if errors.As(err, &f) {
+			report.Step = f.step
+			report.ErrorMessage = f.err.Error()
+			report.ConflictFiles = f.conflictFiles
+			report.Output = f.output
+		} else {
+			report.Success = true
+		}
+	}
+
+	slog.Info("merge finished",
+		"task_id", cmd.GetTaskId(),
+		"worktree", cmd.GetWorktreeName(),
+		"success", report.GetSuccess(),
+		"not_found", report.GetNotFound(),
+		"step", report.GetStep(),
+		"error", report.GetErrorMessage(),
+	)
+
+	if _, err := client.ReportMergeResult(ctx, connect.NewRequest(report)); err != nil {
+		slog.Error("failed to report merge result", "task_id", cmd.GetTaskId(), "error", err)
+	}
+}
+
+// mergeWorktree rebases the branch checked out in wtDir onto baseBranch,
+// verifies it and fast-forwards baseBranch in workDir. Returns the new head
+// of baseBranch (also when only the push failed) or a *mergeFailure.
+func mergeWorktree(ctx context.Context, workDir, wtDir, baseBranch string, verifyCommands []string, push bool) (string, error) {
+	if push {
+		// Start from the latest remote state so the push fast-forwards.
+		if out, err := gitCombined(ctx, workDir, "fetch", "origin", baseBranch); err != nil {
+			return "", &mergeFailure{step: "prepare", err: fmt.Errorf("git fetch origin %s: %w", baseBranch, err), output: out}
+		}
+
+		remote, err := gitOutput(ctx, workDir, "rev-parse", "origin/"+baseBranch)
+		if err != nil {
+			return "", &mergeFailure{step: "prepare", err: fmt.Errorf("resolve origin/%s: %w", baseBranch, err)}
+		}
+
+		if err := fastForwardBranch(ctx, workDir, baseBranch, remote); err != nil {
+			return "", &mergeFailure{step: "prepare", err: err}
+		}
+	}
+
+	if status, err := gitOutput(ctx, wtDir, append([]string{"status", "--porcelain"}, taskDiffPathspec...)...); err != nil {
+		return "", &mergeFailure{step: "prepare", err: fmt.Errorf("git status: %w", err)}
+	} else if status != "" {
+		return "", &mergeFailure{step: "rebase", err: errors.New("worktree has uncommitted changes"), output: status}
+	}
+
+	if out, err := gitCombined(ctx, wtDir, "rebase", baseBranch); err != nil {
+		conflicts, _ := gitOutput(ctx, wtDir, "diff", "--name-only", "--diff-filter=U")
+		_, _ = gitCombined(ctx, wtDir, "rebase", "--abort")
+
+		f := &mergeFailure{step: "rebase", err: fmt.Errorf("git rebase %s: %w", baseBranch, err), output: tailText(out, maxMergeOutputSize)}
+		if conflicts != "" {
+			f.conflictFiles = strings.Split(conflicts, "\n")
+			f.err = fmt.Errorf("rebase onto %s has conflicts", baseBranch)
+		}
+
+		return "", f
+	}
+
+	for _, command := range verifyCommands {
+		if out, err := runVerifyCommand(ctx, wtDir, command); err != nil {
+			return "", &mergeFailure{step: "verify", err: fmt.Errorf("%q: %w", command, err), output: tailText(out, maxMergeOutputSize)}
+		}
+	}
+
+	head, err := gitOutput(ctx, wtDir, "rev-parse", "HEAD")
+	if err != nil {
+		return "", &mergeFailure{step: "merge", err: fmt.Errorf("resolve HEAD: %w", err)}
+	}
+
+	if err := fastForwardBranch(ctx, workDir, baseBranch, head); err != nil {
+		return "", &mergeFailure{step: "merge", err: err}
+	}
+
+	if push {
+		if out, err := gitCombined(ctx, workDir, "push", "origin", baseBranch); err != nil {
+			return head, &mergeFailure{step: "push", err: fmt.Errorf("git push origin %s: %w", baseBranch, err), output: tailText(out, maxMergeOutputSize)}
+		}
+	}
+
+	return head, nil
+}
+
+// fastForwardBranch moves branch in workDir to commit, which must contain
+// the current head of branch. If branch is checked out in workDir the
+// working tree is updated as well.
+func fastForwardBranch(ctx context.Context, workDir, branch, commit string) error {
+	current, _ := gitOutput(ctx, workDir, "branch", "--show-current")
+	if current == branch {
+		if out, err := gitCombined(ctx, workDir, "merge", "--ff-only", "-q", commit); err != nil {
+			return fmt.Errorf("git merge --ff-only: %w: %s", err, tailText(out, maxMergeOutputSize))
+		}
+
+		return nil
+	}
+
+	old, err := gitOutput(ctx, workDir, "rev-parse", "refs/heads/"+branch)
+	if err != nil {
+		return fmt.Errorf("branch %q not found: %w", branch, err)
+	}
+
+	if _, err := gitOutput(ctx, workDir, "merge-base", "--is-ancestor", old, commit); err != nil {
+		return fmt.Errorf("%s cannot be fast-forwarded to %s", branch, shortCommit(commit))
+	}
+
+	if _, err := gitOutput(ctx, workDir, "update-ref", "refs/heads/"+branch, commit, old); err != nil {
+		return fmt.Errorf("update %s: %w", branch, err)
+	}
+
+	return nil
+}
+
+// runVerifyCommand runs a merge verification command in dir and returns its
+// combined output.
+func runVerifyCommand(ctx context.Context, dir, command string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, mergeVerifyTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+
+	return string(out), err
+}
+
+func gitCombined(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+
+	return strings.TrimSpace(string(out)), err
+}
+
+// tailText returns the last maxLen bytes of s.
+func tailText(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+
+	return "... (truncated)\n" + s[len(s)-maxLen:]
+}
+
+func shortCommit(sha string) string {
+	if len(sha) > 12 {
+		return sha[:12]
+	}
+
+	return sha
+}
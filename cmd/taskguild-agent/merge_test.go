@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+// setupMergeRepo creates a repository with a worktree "feature" on branch
+// worktree-feature and returns the work dir, worktree dir and git helper.
+func setupMergeRepo(t *testing.T) (string, string, func(args ...string) string) {
+	t.Helper()
+
+	workDir := t.TempDir()
+	git := newTestGitRepo(t, workDir)
+
+	// The rebase creates commits through mergeWorktree, not the helper.
+	t.Setenv("GIT_AUTHOR_NAME", "test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "a.txt"), []byte("a\n"), 0o644))
+	git("add", ".")
+	git("commit", "-q", "-m", "initial")
+
+	wtDir := filepath.Join(workDir, ".claude", "worktrees", "feature")
+	git("worktree", "add", "-q", "-b", "worktree-feature", wtDir)
+
+	return workDir, wtDir, git
+}
+
+func commitFile(t *testing.T, git func(args ...string) string, dir, name, content, message string) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	git("-C", dir, "add", name)
+	git("-C", dir, "commit", "-q", "-m", message)
+}
+
+func TestMergeWorktree(t *testing.T) {
+	workDir, wtDir, git := setupMergeRepo(t)
+
+	commitFile(t, git, wtDir, "b.txt", "b\n", "add b")
+	commitFile(t, git, workDir, "c.txt", "c\n", "add c on main")
+
+	head, err := mergeWorktree(context.Background(), workDir, wtDir, "main", []string{"test -f b.txt && test -f c.txt"}, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, git("rev-parse", "main"), head)
+	assert.FileExists(t, filepath.Join(workDir, "b.txt"), "checked-out main should be updated")
+	assert.Equal(t, "add b", git("log", "-1", "--format=%s", "main"))
+	assert.Equal(t, "add c on main", git("log", "-1", "--format=%s", "main~1"))
+}
+
+func TestMergeWorktree_Conflict(t *testing.T) {
+	workDir, wtDir, git := setupMergeRepo(t)
+
+	commitFile(t, git, wtDir, "a.txt", "feature\n", "change a on feature")
+	commitFile(t, git, workDir, "a.txt", "main\n", "change a on main")
+
+	mainBefore := git("rev-parse", "main")
+
+	_, err := mergeWorktree(context.Background(), workDir, wtDir, "main", nil, false)
+
+	var f *mergeFailure
+	require.True(t, errors.As(err, &f))
+	assert.Equal(t, "rebase", f.step)
+	assert.Equal(t, []string{"a.txt"}, f.conflictFiles)
+
+	// The rebase is aborted and main is untouched.
+	assert.Equal(t, "change a on feature", git("-C", wtDir, "log", "-1", "--format=%s"))
+	assert.Empty(t, git("-C", wtDir, "status", "--porcelain", "--", ".", ":(exclude).claude"))
+	assert.Equal(t, mainBefore, git("rev-parse", "main"))
+}
+
+func TestMergeWorktree_VerifyFailure(t *testing.T) {
+	workDir, wtDir, git := setupMergeRepo(t)
+
+	commitFile(t, git, wtDir, "b.txt", "b\n", "add b")
+
+	mainBefore := git("rev-parse", "main")
+
+	_, err := mergeWorktree(context.Background(), workDir, wtDir, "main", []string{"echo broken build; exit 3"}, false)
+
+	var f *mergeFailure
+	require.True(t, errors.As(err, &f))
+	assert.Equal(t, "verify", f.step)
+	assert.Contains(t, f.output, "broken build")
+	assert.Equal(t, mainBefore, git("rev-parse", "main"))
+}
+
+func TestMergeWorktree_UncommittedChanges(t *testing.T) {
+	workDir, wtDir, _ := setupMergeRepo(t)
+
+	require.NoError(t, os.WriteFile(filepath.Join(wtDir, "a.txt"), []byte("dirty\n"), 0o644))
+
+	_, err := mergeWorktree(context.Background(), workDir, wtDir, "main", nil, false)
+
+	var f *mergeFailure
+	require.True(t, errors.As(err, &f))
+	assert.Equal(t, "rebase", f.step)
+	assert.Contains(t, f.output, "a.txt")
+}
+
+func TestFastForwardBranch_NotCheckedOut(t *testing.T) {
+	workDir, wtDir, git := setupMergeRepo(t)
+
+	commitFile(t, git, wtDir, "b.txt", "b\n", "add b")
+	git("branch", "release", "main")
+
+	ctx := context.Background()
+
+	require.NoError(t, fastForwardBranch(ctx, workDir, "release", git("-C", wtDir, "rev-parse", "HEAD")))
+	assert.Equal(t, git("-C", wtDir, "rev-parse", "HEAD"), git("rev-parse", "release"))
+
+	commitFile(t, git, workDir, "c.txt", "c\n", "diverge main")
+	assert.Error(t, fastForwardBranch(ctx, workDir, "release", git("rev-parse", "main")))
+}
+
+func TestHandleMergeWorktree_NotFound(t *testing.T) {
+	tc := newTestClients()
+	defer tc.Close()
+
+	handleMergeWorktree(context.Background(), tc.agentClient, t.TempDir(), &v1.MergeWorktreeCommand{
+		RequestId:    "req-1",
+		TaskId:       "task-1",
+		WorktreeName: "missing",
+		BaseBranch:   "main",
+	})
+
+	tc.agentHandler.mu.Lock()
+	defer tc.agentHandler.mu.Unlock()
+
+	require.Len(t, tc.agentHandler.mergeResults, 1)
+	assert.True(t, tc.agentHandler.mergeResults[0].GetNotFound())
+	assert.False(t, tc.agentHandler.mergeResults[0].GetSuccess())
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// modelFallbackCooldown is how long a task stays on a fallback model before
+// the primary model is tried again.
+const modelFallbackCooldown = 15 * time.Minute
+
+// modelFallback tracks which model of a task's fallback chain is active.
+// chain[0] is the primary model ("" means the model configured by the status
+// or agent is used as-is); later entries come from _fallback_models.
+type modelFallback struct {
+	chain      []string
+	index      int
+	switchedAt time.Time
+	cooldown   time.Duration
+}
+
+// newModelFallback builds the fallback chain from task metadata.
+func newModelFallback(metadata map[string]string) *modelFallback {
+	f := &modelFallback{
+		chain:    []string{metadata["_model"]},
+		cooldown: modelFallbackCooldown,
+	}
+
+	if raw := metadata["_fallback_models"]; raw != "" {
+		var models []string
+		if json.Unmarshal([]byte(raw), &models) == nil {
+			for _, m := range models {
+				if m = strings.TrimSpace(m); m != "" {
+					f.chain = append(f.chain, m)
+				}
+			}
+		}
+	}
+
+	return f
+}
+
+// override returns the model to force for the next turn, or "" when the
+// primary model is active.
+func (f *modelFallback) override() string {
+	if f.index == 0 {
+		return ""
+	}
+
+	return f.chain[f.index]
+}
+
+// current returns a human-readable name of the active model.
+func (f *modelFallback) current() string {
+	if m := f.chain[f.index]; m != "" {
+		return m
+	}
+
+	return "default"
+}
+
+// advance switches to the next model in the chain. Returns false when the
+// chain is exhausted.
+func (f *modelFallback) advance(now time.Time) (string, bool) {
+	if f.index+1 >= len(f.chain) {
+		return "", false
+	}
+
+	f.index++
+	f.switchedAt = now
+
+	return f.chain[f.index], true
+}
+
+// restorePrimary switches back to the primary model once the cool-down has
+// elapsed since the last fallback. Returns true if the model was switched.
+func (f *modelFallback) restorePrimary(now time.Time) bool {
+	if f.index == 0 || now.Sub(f.switchedAt) < f.cooldown {
+		return false
+	}
+
+	f.index = 0
+
+	return true
+}
+
+// isModelFallbackError checks whether an error message from Claude CLI
+// indicates that the model is overloaded or the request was rate-limited.
+// These errors are usually specific to one model, so switching to the next
+// model in the fallback chain lets the task make progress.
+func isModelFallbackError(errMsg string) bool {
+	lower := strings.ToLower(errMsg)
+
+	patterns := []string{
+		"overloaded_error",
+		"rate_limit_error",
+		"api error: 529",
+		"api error: 429",
+		"overloaded",
+	}
+	for _, pattern := range patterns {
+		if strings.Contains(lower, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
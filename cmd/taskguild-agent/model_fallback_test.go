@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func TestIsModelFallbackError(t *testing.T) {
+	tests := []struct {
+		errMsg string
+		want   bool
+	}{
+		{`API Error: 529 {"type":"error","error":{"type":"overloaded_error","message":"Overloaded"}}`, true},
+		{`API Error: 429 {"type":"error","error":{"type":"rate_limit_error","message":"Rate limited"}}`, true},
+		{"Claude returned an error", false},
+		{"authentication_error: OAuth token has expired", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, isModelFallbackError(tt.errMsg), tt.errMsg)
+	}
+}
+
+func TestModelFallback_AdvanceAndRestore(t *testing.T) {
+	f := newModelFallback(map[string]string{
+		"_model":           "opus",
+		"_fallback_models": `["sonnet","haiku"]`,
+	})
+
+	now := time.Now()
+
+	assert.Empty(t, f.override())
+	assert.Equal(t, "opus", f.current())
+
+	next, ok := f.advance(now)
+	require.True(t, ok)
+	assert.Equal(t, "sonnet", next)
+	assert.Equal(t, "sonnet", f.override())
+
+	next, ok = f.advance(now)
+	require.True(t, ok)
+	assert.Equal(t, "haiku", next)
+
+	_, ok = f.advance(now)
+	assert.False(t, ok, "chain should be exhausted")
+
+	assert.False(t, f.restorePrimary(now.Add(f.cooldown-time.Second)))
+	assert.True(t, f.restorePrimary(now.Add(f.cooldown)))
+	assert.Empty(t, f.override())
+	assert.Equal(t, "opus", f.current())
+}
+
+func TestModelFallback_NoChain(t *testing.T) {
+	f := newModelFallback(map[string]string{})
+
+	_, ok := f.advance(time.Now())
+	assert.False(t, ok)
+	assert.Equal(t, "default", f.current())
+}
+
+// TestRunTask_ModelFallbackOnOverload verifies that an overload error switches
+// the next attempt to the fallback model and logs a SYSTEM entry.
+func TestRunTask_ModelFallbackOnOverload(t *testing.T) {
+	tc := newTestClients()
+	defer tc.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	metadata := baseMetadata("Develop", `[{"name":"Review"}]`)
+	metadata["_skill_names"] = "develop"
+	metadata["_model"] = "opus"
+	metadata["_fallback_models"] = `["sonnet"]`
+
+	qr := &mockQueryRunner{
+		results: []mockQueryRunnerResult{
+			{Result: makeErrorResult(`API Error: 529 {"type":"error","error":{"type":"overloaded_error"}}`)},
+			{Result: makeResult("Done.\nNEXT_STATUS: Review")},
+		},
+	}
+
+	permCache := newPermissionCache("test", tc.agentClient)
+	scpCache := newSingleCommandPermissionCache("test", tc.agentClient)
+
+	runTask(ctx, tc.agentClient, tc.taskClient, tc.interClient,
+		"agent-mgr-1", "task-1", "system instructions", metadata,
+		t.TempDir(), permCache, scpCache, qr, func() bool { return false })
+
+	calls := qr.getCalls()
+	require.Len(t, calls, 2)
+	assert.Equal(t, "opus", calls[0].Model)
+	assert.Equal(t, "sonnet", calls[1].Model)
+
+	tc.agentHandler.mu.Lock()
+	defer tc.agentHandler.mu.Unlock()
+
+	var found bool
+
+	for _, l := range tc.agentHandler.reportTaskLogReqs {
+		if l.GetCategory() == v1.TaskLogCategory_TASK_LOG_CATEGORY_SYSTEM && l.GetMetadata()["to_model"] == "sonnet" {
+			found = true
+		}
+	}
+
+	assert.True(t, found, "expected a SYSTEM log recording the model fallback")
+}
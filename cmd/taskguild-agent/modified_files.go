@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"connectrpc.com/connect"
+
+	"github.com/kazz187/taskguild/pkg/clog"
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
+)
+
+// editedFiles tracks the absolute paths written by the Edit / Write /
+// NotebookEdit tools per task. Together with git diff it forms the set of
+// files reported to the server for overlap detection.
+var editedFiles struct {
+	mu    sync.Mutex
+	paths map[string]map[string]struct{} // task_id -> paths
+}
+
+func init() {
+	editedFiles.paths = make(map[string]map[string]struct{})
+}
+
+// recordEditedFile remembers a file written by a tool of taskID.
+func recordEditedFile(taskID, toolName string, toolInput map[string]any) {
+	var path string
+
+	switch toolName {
+	case "Edit", "Write":
+		path, _ = toolInput["file_path"].(string)
+	case "NotebookEdit":
+		path, _ = toolInput["notebook_path"].(string)
+	}
+
+	if path == "" {
+		return
+	}
+
+	editedFiles.mu.Lock()
+	defer editedFiles.mu.Unlock()
+
+	if editedFiles.paths[taskID] == nil {
+		editedFiles.paths[taskID] = make(map[string]struct{})
+	}
+
+	editedFiles.paths[taskID][path] = struct{}{}
+}
+
+// editedFilesIn returns the files recorded for taskID that lie inside dir,
+// relative to dir.
+func editedFilesIn(taskID, dir string) []string {
+	editedFiles.mu.Lock()
+	defer editedFiles.mu.Unlock()
+
+	var files []string
+
+	for path := range editedFiles.paths[taskID] {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+
+		rel = filepath.ToSlash(rel)
+		if !isClaudeInternalPath(rel) {
+			files = append(files, rel)
+		}
+	}
+
+	return files
+}
+
+func forgetEditedFiles(taskID string) {
+	editedFiles.mu.Lock()
+	delete(editedFiles.paths, taskID)
+	editedFiles.mu.Unlock()
+}
+
+// modifiedFiles returns the files of the worktree dir that differ from its
+// merge base with baseBranch (committed, uncommitted and untracked), sorted.
+func modifiedFiles(ctx context.Context, dir, baseBranch string) ([]string, error) {
+	if baseBranch == "" {
+		baseBranch = detectDefaultBranch(ctx, dir)
+	}
+
+	head, err := gitOutput(ctx, dir, "rev-parse", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("resolve HEAD: %w", err)
+	}
+
+	base := head
+
+	for _, ref := range []string{"origin/" + baseBranch, baseBranch} {
+		if mb, err := gitOutput(ctx, dir, "merge-base", "HEAD", ref); err == nil && mb != "" {
+			base = mb
+			break
+		}
+	}
+
+	tree, err := writeWorkingTree(ctx, dir, head)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := gitOutput(ctx, dir, append([]string{"diff", "--name-only", "--no-renames", base, tree}, taskDiffPathspec...)...)
+	if err != nil {
+		return nil, fmt.Errorf("diff: %w", err)
+	}
+
+	if out == "" {
+		return nil, nil
+	}
+
+	files := strings.Split(out, "\n")
+	slices.Sort(files)
+
+	return files, nil
+}
+
+// modifiedFilesReporter reports the files modified in a task's worktree
+// after each turn and logs a warning when another task starts touching the
+// same files.
+type modifiedFilesReporter struct {
+	client       taskguildv1connect.AgentManagerServiceClient
+	tl           *taskLogger
+	taskID       string
+	worktreeName string
+
+	warned map[string]string // other task_id -> files already warned about
+}
+
+func newModifiedFilesReporter(client taskguildv1connect.AgentManagerServiceClient, tl *taskLogger, taskID, worktreeName string) *modifiedFilesReporter {
+	return &modifiedFilesReporter{
+		client:       client,
+		tl:           tl,
+		taskID:       taskID,
+		worktreeName: worktreeName,
+		warned:       make(map[string]string),
+	}
+}
+
+// report sends the modified files of dir to the server. Failures are logged
+// and otherwise ignored.
+func (r *modifiedFilesReporter) report(ctx context.Context, dir, baseBranch string) {
+	logger := clog.LoggerFromContext(ctx)
+
+	files, err := modifiedFiles(ctx, dir, baseBranch)
+	if err != nil {
+		logger.Warn("failed to list modified files", "error", err)
+	}
+
+	files = append(files, editedFilesIn(r.taskID, dir)...)
+	slices.Sort(files)
+	files = slices.Compact(files)
+
+	resp, err := r.client.ReportModifiedFiles(ctx, connect.NewRequest(&v1.ReportModifiedFilesRequest{
+		TaskId:       r.taskID,
+		WorktreeName: r.worktreeName,
+		Files:        files,
+	}))
+	if err != nil {
+		logger.Warn("failed to report modified files", "error", err)
+		return
+	}
+
+	for _, o := range resp.Msg.GetOverlaps() {
+		joined := strings.Join(o.GetFiles(), ", ")
+		if r.warned[o.GetTaskId()] == joined {
+			continue
+		}
+
+		r.warned[o.GetTaskId()] = joined
+
+		if r.tl != nil {
+			r.tl.Log(v1.TaskLogCategory_TASK_LOG_CATEGORY_SYSTEM, v1.TaskLogLevel_TASK_LOG_LEVEL_WARN,
+				fmt.Sprintf("Task %q (worktree %s) also modifies: %s", o.GetTaskTitle(), o.GetWorktreeName(), joined),
+				map[string]string{"other_task_id": o.GetTaskId()})
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func TestModifiedFiles(t *testing.T) {
+	workDir, wtDir, git := setupMergeRepo(t)
+
+	commitFile(t, git, wtDir, "committed.txt", "c\n", "add committed")
+	commitFile(t, git, workDir, "main-only.txt", "m\n", "change on main")
+	require.NoError(t, os.WriteFile(filepath.Join(wtDir, "a.txt"), []byte("changed\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(wtDir, "untracked.txt"), []byte("u\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(wtDir, ".claude"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(wtDir, ".claude", "settings.json"), []byte("{}"), 0o644))
+
+	files, err := modifiedFiles(context.Background(), wtDir, "main")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.txt", "committed.txt", "untracked.txt"}, files)
+}
+
+func TestEditedFilesIn(t *testing.T) {
+	defer forgetEditedFiles("task-1")
+
+	dir := t.TempDir()
+
+	recordEditedFile("task-1", "Edit", map[string]any{"file_path": filepath.Join(dir, "src", "a.go")})
+	recordEditedFile("task-1", "NotebookEdit", map[string]any{"notebook_path": filepath.Join(dir, "nb.ipynb")})
+	recordEditedFile("task-1", "Write", map[string]any{"file_path": filepath.Join(dir, ".claude", "plans", "p.md")})
+	recordEditedFile("task-1", "Write", map[string]any{"file_path": "/elsewhere/b.go"})
+	recordEditedFile("task-1", "Read", map[string]any{"file_path": filepath.Join(dir, "read.go")})
+	recordEditedFile("task-2", "Edit", map[string]any{"file_path": filepath.Join(dir, "other.go")})
+
+	assert.ElementsMatch(t, []string{"src/a.go", "nb.ipynb"}, editedFilesIn("task-1", dir))
+
+	forgetEditedFiles("task-2")
+	assert.Empty(t, editedFilesIn("task-2", dir))
+}
+
+func TestModifiedFilesReporter(t *testing.T) {
+	tc := newTestClients()
+	defer tc.Close()
+
+	defer forgetEditedFiles("task-1")
+
+	_, wtDir, _ := setupMergeRepo(t)
+	require.NoError(t, os.WriteFile(filepath.Join(wtDir, "a.txt"), []byte("changed\n"), 0o644))
+	recordEditedFile("task-1", "Write", map[string]any{"file_path": filepath.Join(wtDir, "reverted.txt")})
+
+	tc.agentHandler.fileOverlaps = []*v1.FileOverlap{
+		{TaskId: "task-2", TaskTitle: "Other", WorktreeName: "other", Files: []string{"a.txt"}},
+	}
+
+	ctx := context.Background()
+	tl := newTaskLogger(ctx, tc.agentClient, "task-1")
+	r := newModifiedFilesReporter(tc.agentClient, tl, "task-1", "feature")
+
+	r.report(ctx, wtDir, "main")
+	r.report(ctx, wtDir, "main")
+	tl.Close()
+
+	tc.agentHandler.mu.Lock()
+	defer tc.agentHandler.mu.Unlock()
+
+	require.Len(t, tc.agentHandler.modifiedFilesReports, 2)
+	assert.Equal(t, "feature", tc.agentHandler.modifiedFilesReports[0].GetWorktreeName())
+	assert.Equal(t, []string{"a.txt", "reverted.txt"}, tc.agentHandler.modifiedFilesReports[0].GetFiles())
+
+	// The same overlap is only logged once.
+	var warnings int
+
+	for _, l := range tc.agentHandler.reportTaskLogReqs {
+		if l.GetMetadata()["other_task_id"] == "task-2" {
+			warnings++
+		}
+	}
+
+	assert.Equal(t, 1, warnings)
+}
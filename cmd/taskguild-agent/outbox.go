@@ -0,0 +1,385 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
+)
+
+// outboxFlushInterval is how often pending outbox entries are retried while
+// the agent manager is running.
+const outboxFlushInterval = 30 * time.Second
+
+// maxOutboxAttempts bounds the replays of an entry that the server keeps
+// failing with an internal error. The entry is then moved to the dead-letter
+// directory so that it no longer blocks the task's queue.
+const maxOutboxAttempts = 10
+
+// outboxDeadLetterDir is the subdirectory of the outbox that keeps entries
+// given up on, one JSONL file per task, for manual inspection.
+const outboxDeadLetterDir = "dead"
+
+// globalOutbox spools task logs and results that could not be delivered.
+// nil disables spooling (reports are then dropped on failure, as in tests).
+var globalOutbox *outbox
+
+const (
+	outboxKindLog    = "log"
+	outboxKindResult = "result"
+)
+
+// outboxEntry is one line of a task's outbox file.
+type outboxEntry struct {
+	Kind    string          `json:"kind"`
+	Request json.RawMessage `json:"request"` // protojson-encoded request
+	// Attempts counts the replays that failed with a server-side error.
+	Attempts int `json:"attempts,omitempty"`
+}
+
+// outbox is a disk-backed queue of task reports (logs and results) that
+// failed to reach the server, stored as one JSONL file per task. Entries are
+// replayed in order once the server is reachable again; log_id / result_id
+// make the replay idempotent on the server side.
+type outbox struct {
+	dir    string
+	client taskguildv1connect.AgentManagerServiceClient
+
+	mu       sync.Mutex // guards the files in dir
+	replayMu sync.Mutex // serializes replays
+}
+
+func newOutbox(dir string, client taskguildv1connect.AgentManagerServiceClient) *outbox {
+	return &outbox{dir: dir, client: client}
+}
+
+func (o *outbox) path(taskID string) string {
+	return filepath.Join(o.dir, taskID+".jsonl")
+}
+
+// hasPending reports whether taskID has undelivered entries. Callers must
+// hold o.mu.
+func (o *outbox) hasPending(taskID string) bool {
+	_, err := os.Stat(o.path(taskID))
+	return err == nil
+}
+
+// append spools an entry at the end of the task's outbox file. Callers must
+// hold o.mu.
+func (o *outbox) append(taskID, kind string, req []byte) error {
+	line, err := json.Marshal(outboxEntry{Kind: kind, Request: req})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(o.dir, 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(o.path(taskID), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.Write(append(line, '\n'))
+
+	return err
+}
+
+// deliver sends a report, spooling it to disk when the server is unreachable.
+// While a task has spooled entries, new reports are spooled behind them so
+// the server receives them in order.
+func (o *outbox) deliver(ctx context.Context, taskID, kind string, req proto.Message, send func(context.Context) error) error {
+	data, err := protojson.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	o.mu.Lock()
+	pending := o.hasPending(taskID)
+	o.mu.Unlock()
+
+	if !pending {
+		err := send(ctx)
+		if err == nil || !isRetryableReportError(err) {
+			return err
+		}
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if err := o.append(taskID, kind, data); err != nil {
+		return fmt.Errorf("spool to outbox: %w", err)
+	}
+
+	return nil
+}
+
+// replay sends all spooled entries in order. Delivery of a task's entries
+// stops at the first transient failure; the rest stay spooled for the next
+// attempt. An entry failing with a server-side error maxOutboxAttempts times
+// is dead-lettered instead.
+func (o *outbox) replay(ctx context.Context) {
+	o.replayMu.Lock()
+	defer o.replayMu.Unlock()
+
+	entries, err := os.ReadDir(o.dir)
+	if err != nil {
+		return
+	}
+
+	var taskIDs []string
+
+	for _, e := range entries {
+		if name, ok := strings.CutSuffix(e.Name(), ".jsonl"); ok && !e.IsDir() {
+			taskIDs = append(taskIDs, name)
+		}
+	}
+
+	sort.Strings(taskIDs)
+
+	for _, taskID := range taskIDs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		o.replayTask(ctx, taskID)
+	}
+}
+
+func (o *outbox) replayTask(ctx context.Context, taskID string) {
+	o.mu.Lock()
+	lines, err := readOutboxLines(o.path(taskID))
+	o.mu.Unlock()
+
+	if err != nil {
+		slog.Warn("failed to read outbox", "task_id", taskID, "error", err)
+		return
+	}
+
+	var (
+		sent int
+		head []byte // replacement for the first entry left spooled
+	)
+
+	for _, line := range lines {
+		err := o.send(ctx, line)
+		if err != nil && isRetryableReportError(err) && isServerFaultError(err) {
+			retried, attempts := countAttempt(line)
+			if attempts < maxOutboxAttempts {
+				slog.Debug("outbox replay deferred", "task_id", taskID, "attempts", attempts, "error", err)
+				head = retried
+
+				break
+			}
+
+			slog.Error("dead-lettering outbox entry after repeated server errors", "task_id", taskID, "attempts", attempts, "error", err)
+			o.deadLetter(taskID, retried)
+		} else if err != nil && isRetryableReportError(err) {
+			slog.Debug("outbox replay deferred", "task_id", taskID, "error", err)
+			break
+		} else if err != nil {
+			slog.Warn("dropping outbox entry rejected by server", "task_id", taskID, "error", err)
+		}
+
+		sent++
+	}
+
+	if sent == 0 && head == nil {
+		return
+	}
+
+	if sent > 0 {
+		slog.Info("replayed outbox entries", "task_id", taskID, "count", sent)
+	}
+
+	// Entries may have been appended while replaying: drop only the ones
+	// that were handled.
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	current, err := readOutboxLines(o.path(taskID))
+	if err != nil {
+		return
+	}
+
+	rest := current[min(sent, len(current)):]
+	if head != nil && len(rest) > 0 {
+		rest[0] = head
+	}
+
+	if len(rest) == 0 {
+		_ = os.Remove(o.path(taskID))
+		return
+	}
+
+	var buf bytes.Buffer
+	for _, line := range rest {
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	tmp := o.path(taskID) + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0o644); err != nil {
+		slog.Warn("failed to rewrite outbox", "task_id", taskID, "error", err)
+		return
+	}
+
+	_ = os.Rename(tmp, o.path(taskID))
+}
+
+// countAttempt records a failed replay in a spooled entry and returns the
+// updated line with its attempt count. Malformed lines are returned as is.
+func countAttempt(line []byte) ([]byte, int) {
+	var e outboxEntry
+	if err := json.Unmarshal(line, &e); err != nil {
+		return line, 0
+	}
+
+	e.Attempts++
+
+	updated, err := json.Marshal(e)
+	if err != nil {
+		return line, e.Attempts
+	}
+
+	return updated, e.Attempts
+}
+
+// deadLetter moves an entry the server keeps failing on out of the queue.
+func (o *outbox) deadLetter(taskID string, line []byte) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	dir := filepath.Join(o.dir, outboxDeadLetterDir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		slog.Warn("failed to create outbox dead-letter directory", "error", err)
+		return
+	}
+
+	f, err := os.OpenFile(filepath.Join(dir, taskID+".jsonl"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		slog.Warn("failed to open outbox dead-letter file", "task_id", taskID, "error", err)
+		return
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		slog.Warn("failed to write outbox dead-letter file", "task_id", taskID, "error", err)
+	}
+}
+
+// send delivers one spooled entry. Malformed entries are reported as
+// permanent errors so they are dropped instead of blocking the queue.
+func (o *outbox) send(ctx context.Context, line []byte) error {
+	var e outboxEntry
+	if err := json.Unmarshal(line, &e); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	switch e.Kind {
+	case outboxKindLog:
+		var req v1.ReportTaskLogRequest
+		if err := protojson.Unmarshal(e.Request, &req); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		_, err := o.client.ReportTaskLog(ctx, connect.NewRequest(&req))
+
+		return err
+	case outboxKindResult:
+		var req v1.ReportTaskResultRequest
+		if err := protojson.Unmarshal(e.Request, &req); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		_, err := o.client.ReportTaskResult(ctx, connect.NewRequest(&req))
+
+		return err
+	default:
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown outbox entry kind %q", e.Kind))
+	}
+}
+
+// run retries spooled entries periodically until ctx is done.
+func (o *outbox) run(ctx context.Context) {
+	ticker := time.NewTicker(outboxFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			o.replay(ctx)
+		}
+	}
+}
+
+func readOutboxLines(path string) ([][]byte, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines [][]byte
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	for scanner.Scan() {
+		if line := bytes.TrimSpace(scanner.Bytes()); len(line) > 0 {
+			lines = append(lines, bytes.Clone(line))
+		}
+	}
+
+	return lines, scanner.Err()
+}
+
+// isRetryableReportError reports whether a failed report should be kept for
+// a later retry (server unreachable, restarting or overloaded) rather than
+// dropped (request rejected by the server).
+func isRetryableReportError(err error) bool {
+	switch connect.CodeOf(err) {
+	case connect.CodeUnavailable, connect.CodeUnknown, connect.CodeDeadlineExceeded,
+		connect.CodeCanceled, connect.CodeAborted, connect.CodeResourceExhausted, connect.CodeInternal:
+		return true
+	default:
+		return false
+	}
+}
+
+// isServerFaultError reports whether a report failed on the server itself
+// (a bug or a persistent failure) rather than on the way to it. Such
+// failures may never clear, so their retries are capped.
+func isServerFaultError(err error) bool {
+	switch connect.CodeOf(err) {
+	case connect.CodeInternal, connect.CodeUnknown:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
+)
+
+// unreachableClient returns a client whose requests fail as if the server
+// were down.
+func unreachableClient() taskguildv1connect.AgentManagerServiceClient {
+	return taskguildv1connect.NewAgentManagerServiceClient(http.DefaultClient, "http://127.0.0.1:1")
+}
+
+func TestOutbox_SpoolAndReplayInOrder(t *testing.T) {
+	tc := newTestClients()
+	defer tc.Close()
+
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	// Server down: logs and the result are spooled.
+	globalOutbox = newOutbox(dir, unreachableClient())
+	defer func() { globalOutbox = nil }()
+
+	tl := newTaskLogger(ctx, unreachableClient(), "task-1")
+	tl.Log(v1.TaskLogCategory_TASK_LOG_CATEGORY_SYSTEM, v1.TaskLogLevel_TASK_LOG_LEVEL_INFO, "first", nil)
+	tl.Log(v1.TaskLogCategory_TASK_LOG_CATEGORY_SYSTEM, v1.TaskLogLevel_TASK_LOG_LEVEL_INFO, "second", nil)
+	tl.Close()
+	reportTaskResult(ctx, unreachableClient(), "task-1", "done", "")
+
+	lines, err := readOutboxLines(globalOutbox.path("task-1"))
+	require.NoError(t, err)
+	require.Len(t, lines, 3)
+
+	// Server back: everything is replayed in order and the file removed.
+	globalOutbox.client = tc.agentClient
+	globalOutbox.replay(ctx)
+
+	_, err = os.Stat(globalOutbox.path("task-1"))
+	assert.True(t, os.IsNotExist(err))
+
+	logs := tc.agentHandler.reportTaskLogReqs
+	require.Len(t, logs, 2)
+	assert.Equal(t, "first", logs[0].GetMessage())
+	assert.Equal(t, "second", logs[1].GetMessage())
+	assert.NotEmpty(t, logs[0].GetLogId())
+	assert.Less(t, logs[0].GetLogId(), logs[1].GetLogId())
+	assert.NotNil(t, logs[0].GetCreatedAt())
+
+	results := tc.agentHandler.reportTaskResultReqs
+	require.Len(t, results, 1)
+	assert.Equal(t, "done", results[0].GetSummary())
+	assert.NotEmpty(t, results[0].GetResultId())
+}
+
+func TestOutbox_QueuesBehindPendingEntries(t *testing.T) {
+	tc := newTestClients()
+	defer tc.Close()
+
+	ctx := context.Background()
+	ob := newOutbox(t.TempDir(), unreachableClient())
+
+	send := func(client taskguildv1connect.AgentManagerServiceClient, msg string) error {
+		req := &v1.ReportTaskLogRequest{TaskId: "task-1", Message: msg}
+
+		return ob.deliver(ctx, "task-1", outboxKindLog, req, func(ctx context.Context) error {
+			_, err := client.ReportTaskLog(ctx, connect.NewRequest(req))
+			return err
+		})
+	}
+
+	require.NoError(t, send(unreachableClient(), "first"))
+
+	// The server is reachable again, but "first" has not been replayed yet:
+	// "second" must queue behind it to keep the order.
+	require.NoError(t, send(tc.agentClient, "second"))
+	assert.Empty(t, tc.agentHandler.reportTaskLogReqs)
+
+	ob.client = tc.agentClient
+	ob.replay(ctx)
+
+	require.Len(t, tc.agentHandler.reportTaskLogReqs, 2)
+	assert.Equal(t, "first", tc.agentHandler.reportTaskLogReqs[0].GetMessage())
+	assert.Equal(t, "second", tc.agentHandler.reportTaskLogReqs[1].GetMessage())
+}
+
+func TestOutbox_DeadLettersEntriesFailingWithServerErrors(t *testing.T) {
+	tc := newTestClients()
+	defer tc.Close()
+
+	ctx := context.Background()
+	ob := newOutbox(t.TempDir(), unreachableClient())
+
+	for _, msg := range []string{"poison", "next"} {
+		req := &v1.ReportTaskLogRequest{TaskId: "task-1", Message: msg}
+		require.NoError(t, ob.deliver(ctx, "task-1", outboxKindLog, req, func(context.Context) error {
+			return connect.NewError(connect.CodeUnavailable, nil)
+		}))
+	}
+
+	tc.agentHandler.reportTaskLogErr = connect.NewError(connect.CodeInternal, nil)
+	ob.client = tc.agentClient
+
+	for range maxOutboxAttempts - 1 {
+		ob.replay(ctx)
+	}
+
+	lines, err := readOutboxLines(ob.path("task-1"))
+	require.NoError(t, err)
+	require.Len(t, lines, 2, "entries are kept until the attempts run out")
+
+	// The last attempt moves the first entry to the dead-letter file.
+	ob.replay(ctx)
+
+	dead, err := readOutboxLines(filepath.Join(ob.dir, outboxDeadLetterDir, "task-1.jsonl"))
+	require.NoError(t, err)
+	assert.Len(t, dead, 1)
+
+	// The entry behind it is no longer blocked.
+	tc.agentHandler.reportTaskLogErr = nil
+	ob.replay(ctx)
+
+	_, err = os.Stat(ob.path("task-1"))
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, "next", tc.agentHandler.reportTaskLogReqs[len(tc.agentHandler.reportTaskLogReqs)-1].GetMessage())
+}
+
+func TestIsRetryableReportError(t *testing.T) {
+	assert.True(t, isRetryableReportError(connect.NewError(connect.CodeUnavailable, nil)))
+	assert.True(t, isRetryableReportError(context.Canceled))
+	assert.False(t, isRetryableReportError(connect.NewError(connect.CodeNotFound, nil)))
+	assert.False(t, isRetryableReportError(connect.NewError(connect.CodeInvalidArgument, nil)))
+}
@@ -3,23 +3,45 @@ package main
 import (
 	"context"
 	"log/slog"
-	"strings"
+	"maps"
+	"slices"
 	"sync"
 
 	"connectrpc.com/connect"
 
+	"github.com/kazz187/taskguild/internal/permission"
+	"github.com/kazz187/taskguild/pkg/permcheck"
+	"github.com/kazz187/taskguild/pkg/redact"
+	"github.com/kazz187/taskguild/pkg/shellparse"
 	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
 	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
 )
 
-// permissionCache maintains an in-memory cache of project-level allow rules.
-// It is shared across all tasks within the same agent-manager, providing
-// immediate permission checks without backend round-trips. When new rules are
-// added (via "Always Allow"), they are persisted to the backend and broadcast
-// to all connected agent-managers.
+// permissionCache maintains an in-memory cache of project-level allow, ask
+// and deny rules. It is shared across all tasks within the same
+// agent-manager, providing immediate permission checks without backend
+// round-trips. When new rules are added (via "Always Allow"), they are
+// persisted to the backend and broadcast to all connected agent-managers.
 type permissionCache struct {
-	mu          sync.RWMutex
-	allowRules  []string
+	mu         sync.RWMutex
+	allowRules []string
+	askRules   []string
+	denyRules  []string
+	// autoAllowRisks holds the Bash risk categories allowed without
+	// confirmation.
+	autoAllowRisks map[shellparse.Risk]bool
+	// pathPolicy restricts where edit tools and shell redirects may write.
+	pathPolicy *permcheck.PathPolicy
+	// egressPolicy restricts the hosts WebFetch and shell network tools
+	// may contact.
+	egressPolicy *permcheck.EgressPolicy
+	// redactor masks secrets in task logs, interactions and turn logs. It is
+	// built from redactionPatterns and the values of secrets.
+	redactor          *redact.Redactor
+	redactionPatterns []string
+	// secrets are the project secrets delivered with claimed tasks and
+	// script executions, exposed to sessions as environment variables.
+	secrets     map[string]string
 	projectName string
 	client      taskguildv1connect.AgentManagerServiceClient
 }
@@ -42,18 +64,161 @@ func (c *permissionCache) Update(rules []string) {
 	slog.Info("permission cache updated", "allow_rules", len(rules))
 }
 
-// Check returns true if the given tool call is allowed by any cached rule.
-func (c *permissionCache) Check(toolName string, input map[string]any) bool {
+// UpdateAskDeny replaces the cached ask and deny rules.
+func (c *permissionCache) UpdateAskDeny(ask, deny []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.askRules = slices.Clone(ask)
+	c.denyRules = slices.Clone(deny)
+}
+
+// UpdateAutoAllowRisks replaces the cached auto-allowed risk categories.
+// Unknown names and categories that are not auto-allowable are ignored.
+func (c *permissionCache) UpdateAutoAllowRisks(names []string) {
+	risks := permcheck.ParseAutoAllowRisks(names)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.autoAllowRisks = risks
+}
+
+// UpdatePathPolicy replaces the cached project path policy.
+func (c *permissionCache) UpdatePathPolicy(pp *v1.PathPolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pathPolicy = permission.PathPolicyFromProto(pp)
+}
+
+// UpdateEgressPolicy replaces the cached project egress policy.
+func (c *permissionCache) UpdateEgressPolicy(ep *v1.EgressPolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.egressPolicy = permission.EgressPolicyFromProto(ep)
+}
+
+// UpdateRedactionPatterns rebuilds the secret redactor from the project's
+// redaction patterns. Invalid patterns keep the previous redactor.
+func (c *permissionCache) UpdateRedactionPatterns(patterns []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	r, err := redact.New(patterns, c.secrets)
+	if err != nil {
+		slog.Warn("permission cache: invalid redaction patterns", "error", err)
+		return
+	}
+
+	c.redactor = r
+	c.redactionPatterns = slices.Clone(patterns)
+}
+
+// UpdateSecrets replaces the project secrets and registers their values
+// with the redactor.
+func (c *permissionCache) UpdateSecrets(secrets map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if maps.Equal(c.secrets, secrets) {
+		return
+	}
+
+	r, err := redact.New(c.redactionPatterns, secrets)
+	if err != nil {
+		// Unreachable: the patterns were validated when they were stored.
+		slog.Warn("permission cache: invalid redaction patterns", "error", err)
+		return
+	}
+
+	c.redactor = r
+	c.secrets = maps.Clone(secrets)
+}
+
+// Secrets returns a copy of the project secrets keyed by environment
+// variable name. A nil cache yields nil.
+func (c *permissionCache) Secrets() map[string]string {
+	if c == nil {
+		return nil
+	}
+
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	for _, rule := range c.allowRules {
-		if matchPermissionRule(rule, toolName, input) {
-			return true
-		}
+	return maps.Clone(c.secrets)
+}
+
+// Redactor returns the project's secret redactor. A nil cache or a cache
+// that has not been synced yields nil, which applies the built-in
+// detectors only.
+func (c *permissionCache) Redactor() *redact.Redactor {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.redactor
+}
+
+// PathPolicy returns the project path policy, or nil when none is set.
+func (c *permissionCache) PathPolicy() *permcheck.PathPolicy {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.pathPolicy
+}
+
+// AutoAllowsRisk reports whether Bash commands of the given risk category
+// are allowed without confirmation.
+func (c *permissionCache) AutoAllowsRisk(r shellparse.Risk) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.autoAllowRisks[r]
+}
+
+// CheckDeny returns the first deny rule matching the tool call, or "".
+func (c *permissionCache) CheckDeny(toolName string, input map[string]any) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return permcheck.FirstRestrictiveMatch(c.denyRules, toolName, input)
+}
+
+// CheckAsk returns the first ask rule matching the tool call, or "".
+func (c *permissionCache) CheckAsk(toolName string, input map[string]any) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return permcheck.FirstRestrictiveMatch(c.askRules, toolName, input)
+}
+
+// Rules returns a snapshot of the cached rules for permcheck.Evaluate. The
+// slices are replaced, never modified, on update, so they may be shared.
+func (c *permissionCache) Rules() permcheck.Rules {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return permcheck.Rules{
+		Allow:          c.allowRules,
+		Ask:            c.askRules,
+		Deny:           c.denyRules,
+		AutoAllowRisks: c.autoAllowRisks,
+		PathPolicy:     c.pathPolicy,
+		EgressPolicy:   c.egressPolicy,
 	}
+}
 
-	return false
+// Check returns true if the given tool call is allowed by any cached rule.
+func (c *permissionCache) Check(toolName string, input map[string]any) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return permcheck.FirstMatch(c.allowRules, toolName, input) != ""
 }
 
 // AddAndSync adds new permission rules to the cache and synchronizes them
@@ -86,8 +251,15 @@ func (c *permissionCache) AddAndSync(ctx context.Context, newRules []string) {
 
 	c.mu.Lock()
 	c.allowRules = merged.GetAllow()
+	c.askRules = merged.GetAsk()
+	c.denyRules = merged.GetDeny()
 	c.mu.Unlock()
 
+	c.UpdateAutoAllowRisks(merged.GetAutoAllowRisks())
+	c.UpdatePathPolicy(merged.GetPathPolicy())
+	c.UpdateEgressPolicy(merged.GetEgressPolicy())
+	c.UpdateRedactionPatterns(merged.GetRedactionPatterns())
+
 	slog.Info("permission cache: backend sync complete", "allow", len(merged.GetAllow()))
 }
 
@@ -112,96 +284,3 @@ func unionDedupRules(a, b []string) []string {
 
 	return result
 }
-
-// matchPermissionRule checks whether a permission rule (e.g. "Read",
-// "Bash(git *)") matches the given tool call.
-func matchPermissionRule(rule string, toolName string, input map[string]any) bool {
-	// Parse rule: "ToolName" or "ToolName(pattern)".
-	rTool, rPattern, hasPattern := parsePermissionRule(rule)
-
-	if rTool != toolName {
-		return false
-	}
-
-	// No pattern → tool name match is sufficient (allow all invocations).
-	if !hasPattern {
-		return true
-	}
-
-	// For Bash tools, match the command input against the pattern.
-	if toolName == "Bash" {
-		cmd, _ := input["command"].(string)
-		return matchGlob(rPattern, cmd)
-	}
-
-	// For other tools with a pattern, attempt a generic match against a
-	// well-known input field (file_path for Read/Write/Edit, pattern for Glob, etc.).
-	for _, key := range []string{"file_path", "pattern", "path", "query", "url"} {
-		if val, ok := input[key].(string); ok {
-			if matchGlob(rPattern, val) {
-				return true
-			}
-		}
-	}
-
-	return false
-}
-
-// parsePermissionRule splits a rule string into its tool name, optional
-// pattern, and whether a pattern was present.
-//
-//	"Read"           → ("Read", "", false)
-//	"Bash(git *)"    → ("Bash", "git *", true)
-func parsePermissionRule(rule string) (toolName, pattern string, hasPattern bool) {
-	idx := strings.Index(rule, "(")
-	if idx < 0 {
-		return rule, "", false
-	}
-	// Ensure it ends with ")".
-	if !strings.HasSuffix(rule, ")") {
-		return rule, "", false
-	}
-
-	return rule[:idx], rule[idx+1 : len(rule)-1], true
-}
-
-// matchGlob performs simple glob matching where "*" matches any sequence of
-// characters. It supports multiple wildcards (e.g. "git * --*").
-func matchGlob(pattern, value string) bool {
-	// Fast paths.
-	if pattern == "*" {
-		return true
-	}
-
-	if pattern == "" {
-		return value == ""
-	}
-
-	if !strings.Contains(pattern, "*") {
-		return pattern == value
-	}
-
-	parts := strings.Split(pattern, "*")
-
-	// First segment must match as a prefix.
-	if !strings.HasPrefix(value, parts[0]) {
-		return false
-	}
-
-	remaining := value[len(parts[0]):]
-
-	// Middle segments must appear in order.
-	for i := 1; i < len(parts)-1; i++ {
-		idx := strings.Index(remaining, parts[i])
-		if idx < 0 {
-			return false
-		}
-
-		remaining = remaining[idx+len(parts[i]):]
-	}
-
-	// Last segment must match as a suffix.
-	last := parts[len(parts)-1]
-
-	return strings.HasSuffix(remaining, last)
-}
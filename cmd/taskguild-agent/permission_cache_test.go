@@ -2,134 +2,6 @@ package main
 
 import "testing"
 
-func TestMatchGlob(t *testing.T) {
-	tests := []struct {
-		pattern string
-		value   string
-		want    bool
-	}{
-		// Exact match
-		{"foo", "foo", true},
-		{"foo", "bar", false},
-		{"foo", "foobar", false},
-		{"foo", "", false},
-
-		// Wildcard only
-		{"*", "anything", true},
-		{"*", "", true},
-
-		// Trailing wildcard (prefix match)
-		{"git *", "git status", true},
-		{"git *", "git commit -m 'test'", true},
-		{"git *", "git", false}, // no space after "git"
-		{"npm test *", "npm test --watch", true},
-		{"npm test *", "npm install", false},
-
-		// Leading wildcard (suffix match)
-		{"*.go", "main.go", true},
-		{"*.go", "main.py", false},
-
-		// Middle wildcard
-		{"git * --force", "git push --force", true},
-		{"git * --force", "git push origin main --force", true},
-		{"git * --force", "git push", false},
-
-		// Multiple wildcards
-		{"*test*", "run test suite", true},
-		{"*test*", "testing", true},
-		{"*test*", "foo", false},
-
-		// Empty pattern
-		{"", "", true},
-		{"", "foo", false},
-
-		// No wildcard
-		{"npm test", "npm test", true},
-		{"npm test", "npm test --watch", false},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.pattern+"_"+tt.value, func(t *testing.T) {
-			got := matchGlob(tt.pattern, tt.value)
-			if got != tt.want {
-				t.Errorf("matchGlob(%q, %q) = %v, want %v", tt.pattern, tt.value, got, tt.want)
-			}
-		})
-	}
-}
-
-func TestParsePermissionRule(t *testing.T) {
-	tests := []struct {
-		rule       string
-		wantTool   string
-		wantPat    string
-		wantHasPat bool
-	}{
-		{"Read", "Read", "", false},
-		{"Write", "Write", "", false},
-		{"Bash(git *)", "Bash", "git *", true},
-		{"Bash(npm test --watch)", "Bash", "npm test --watch", true},
-		{"Edit", "Edit", "", false},
-		// Malformed: no closing paren → treated as no-pattern
-		{"Bash(git *", "Bash(git *", "", false},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.rule, func(t *testing.T) {
-			tool, pat, hasPat := parsePermissionRule(tt.rule)
-			if tool != tt.wantTool || pat != tt.wantPat || hasPat != tt.wantHasPat {
-				t.Errorf("parsePermissionRule(%q) = (%q, %q, %v), want (%q, %q, %v)",
-					tt.rule, tool, pat, hasPat, tt.wantTool, tt.wantPat, tt.wantHasPat)
-			}
-		})
-	}
-}
-
-func TestMatchPermissionRule(t *testing.T) {
-	tests := []struct {
-		rule     string
-		toolName string
-		input    map[string]any
-		want     bool
-	}{
-		// Simple tool name match
-		{"Read", "Read", nil, true},
-		{"Read", "Write", nil, false},
-
-		// Bash with glob pattern
-		{"Bash(git *)", "Bash", map[string]any{"command": "git status"}, true},
-		{"Bash(git *)", "Bash", map[string]any{"command": "git commit -m 'test'"}, true},
-		{"Bash(git *)", "Bash", map[string]any{"command": "npm install"}, false},
-		{"Bash(git *)", "Read", map[string]any{"command": "git status"}, false},
-
-		// Bash exact command
-		{"Bash(npm test)", "Bash", map[string]any{"command": "npm test"}, true},
-		{"Bash(npm test)", "Bash", map[string]any{"command": "npm test --watch"}, false},
-
-		// Bash without pattern (allow all bash)
-		{"Bash", "Bash", map[string]any{"command": "anything"}, true},
-		{"Bash", "Bash", nil, true},
-
-		// Write (no pattern — allows all)
-		{"Write", "Write", map[string]any{"file_path": "/tmp/foo.txt"}, true},
-	}
-
-	for _, tt := range tests {
-		name := tt.rule + "_" + tt.toolName
-		if cmd, ok := tt.input["command"].(string); ok {
-			name += "_" + cmd
-		}
-
-		t.Run(name, func(t *testing.T) {
-			got := matchPermissionRule(tt.rule, tt.toolName, tt.input)
-			if got != tt.want {
-				t.Errorf("matchPermissionRule(%q, %q, %v) = %v, want %v",
-					tt.rule, tt.toolName, tt.input, got, tt.want)
-			}
-		})
-	}
-}
-
 func TestRuleValueToString(t *testing.T) {
 	tests := []struct {
 		toolName    string
@@ -231,3 +103,36 @@ func TestUnionDedupRules(t *testing.T) {
 		})
 	}
 }
+
+func TestPermissionCacheCheckDenyAsk(t *testing.T) {
+	cache := newPermissionCache("test-project", nil)
+	cache.Update([]string{"Bash(git *)", "WebFetch"})
+	cache.UpdateAskDeny([]string{"WebFetch", "Bash(git push:*)"}, []string{"Bash(rm -rf *)", "Write(/etc/*)"})
+
+	tests := []struct {
+		name     string
+		toolName string
+		input    map[string]any
+		deny     bool
+		ask      bool
+	}{
+		{"deny rm", "Bash", map[string]any{"command": "rm -rf /"}, true, false},
+		{"deny rm in chain", "Bash", map[string]any{"command": "cd /tmp && rm -rf x"}, true, false},
+		{"deny write", "Write", map[string]any{"file_path": "/etc/hosts"}, true, false},
+		{"ask push prefix", "Bash", map[string]any{"command": "git push origin main"}, false, true},
+		{"ask tool", "WebFetch", map[string]any{"url": "https://example.com"}, false, true},
+		{"neither", "Bash", map[string]any{"command": "git status"}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cache.CheckDeny(tt.toolName, tt.input) != ""; got != tt.deny {
+				t.Errorf("CheckDeny() = %v, want %v", got, tt.deny)
+			}
+
+			if got := cache.CheckAsk(tt.toolName, tt.input) != ""; got != tt.ask {
+				t.Errorf("CheckAsk() = %v, want %v", got, tt.ask)
+			}
+		})
+	}
+}
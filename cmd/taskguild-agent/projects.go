@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+
+	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
+)
+
+// projectRuntime holds the per-project state shared by all tasks of one
+// project served by this agent manager.
+type projectRuntime struct {
+	cfg       *config // scoped to this project (see config.forProject)
+	permCache *permissionCache
+	scpCache  *singleCommandPermissionCache
+}
+
+// projectSet is the set of projects served by this agent manager.
+type projectSet struct {
+	list   []*projectRuntime
+	byName map[string]*projectRuntime
+}
+
+func newProjectSet(cfg *config, client taskguildv1connect.AgentManagerServiceClient) *projectSet {
+	ps := &projectSet{byName: make(map[string]*projectRuntime, len(cfg.Projects))}
+	for _, p := range cfg.Projects {
+		pr := &projectRuntime{
+			cfg:       cfg.forProject(p),
+			permCache: newPermissionCache(p.Name, client),
+			scpCache:  newSingleCommandPermissionCache(p.Name, client),
+		}
+		ps.list = append(ps.list, pr)
+		ps.byName[p.Name] = pr
+	}
+
+	return ps
+}
+
+// resolve returns the project a command was sent for. Commands without a
+// project name (older servers, direct commands) go to the first project.
+// Returns nil for projects this agent manager does not serve.
+func (ps *projectSet) resolve(projectName string) *projectRuntime {
+	if projectName == "" {
+		if len(ps.list) == 0 {
+			return nil
+		}
+
+		return ps.list[0]
+	}
+
+	return ps.byName[projectName]
+}
+
+// activeTask is a task currently running on this agent manager.
+type activeTask struct {
+	cancel  context.CancelFunc
+	project string
+}
+
+// hasCapacity reports whether another task may start for the project under
+// both the global cap (cfg.MaxConcurrentTasks) and the project's own cap.
+// The caller must hold the lock guarding activeTasks.
+func hasCapacity(activeTasks map[string]*activeTask, cfg *config, pr *projectRuntime) bool {
+	if len(activeTasks) >= cfg.MaxConcurrentTasks {
+		return false
+	}
+
+	limit := pr.cfg.MaxConcurrentTasks
+	if limit <= 0 || limit >= cfg.MaxConcurrentTasks {
+		return true
+	}
+
+	running := 0
+
+	for _, at := range activeTasks {
+		if at.project == pr.cfg.ProjectName {
+			running++
+		}
+	}
+
+	return running < limit
+}
@@ -287,7 +287,7 @@ func buildWorkflowContext(metadata map[string]string, workDir string) string {
 
 	// Task creation.
 	sb.WriteString("\n### Creating New Tasks\n")
-	sb.WriteString("```\nCREATE_TASK_START\ntitle: <required>\nstatus: <optional>\nuse_worktree: <optional, true/false>\nworktree: <optional, existing worktree name>\n\n<description>\nCREATE_TASK_END\n```\n")
+	sb.WriteString("```\nCREATE_TASK_START\ntitle: <required>\nstatus: <optional>\nuse_worktree: <optional, true/false>\nworktree: <optional, existing worktree name>\nbase_ref: <optional, branch/tag/commit the new worktree starts from>\nbase_task: <optional, task ID whose worktree to build on, or \"self\">\n\n<description>\nCREATE_TASK_END\n```\n")
 
 	// List available statuses for new tasks.
 	if statusesJSON := metadata["_workflow_statuses"]; statusesJSON != "" {
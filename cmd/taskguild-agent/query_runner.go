@@ -2,8 +2,11 @@ package main
 
 import (
 	"context"
+	"maps"
 
 	claudeagent "github.com/kazz187/claude-agent-sdk-go"
+
+	"github.com/kazz187/taskguild/pkg/redact"
 )
 
 // QueryRunner abstracts synchronous SDK query execution so that tests can
@@ -22,6 +25,11 @@ type QueryRunner interface {
 type subprocessQueryRunner struct {
 	projectID  string
 	projectDir string // main project directory, used as log base
+	// redactor returns the project's secret redactor for turn logs.
+	redactor func() *redact.Redactor
+	// secrets returns the project secrets, exposed to the Claude CLI (and
+	// the tools it runs) as environment variables.
+	secrets func() map[string]string
 }
 
 func (r subprocessQueryRunner) RunQuerySync(
@@ -38,5 +46,30 @@ func (r subprocessQueryRunner) RunQuerySync(
 		logBaseDir = r.projectDir
 	}
 
-	return runQuerySyncWithLog(ctx, prompt, options, logBaseDir, r.projectID, taskID, label)
+	var redactor *redact.Redactor
+	if r.redactor != nil {
+		redactor = r.redactor()
+	}
+
+	return runQuerySyncWithLog(ctx, prompt, withSecretEnv(options, r.secrets), logBaseDir, r.projectID, taskID, label, redactor)
+}
+
+// withSecretEnv returns a copy of options whose Env also holds the project
+// secrets. Variables already set in options.Env take precedence.
+func withSecretEnv(options *claudeagent.ClaudeAgentOptions, secrets func() map[string]string) *claudeagent.ClaudeAgentOptions {
+	if options == nil || secrets == nil {
+		return options
+	}
+
+	values := secrets()
+	if len(values) == 0 {
+		return options
+	}
+
+	opts := *options
+	opts.Env = make(map[string]string, len(values)+len(options.Env))
+	maps.Copy(opts.Env, values)
+	maps.Copy(opts.Env, options.Env)
+
+	return &opts
 }
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"github.com/kazz187/taskguild/pkg/redact"
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
+)
+
+// redactingClient masks secrets in the interactions it creates. Task logs
+// and results are redacted by taskLogger and reportTaskResult before they
+// are sent or spooled to the outbox.
+type redactingClient struct {
+	taskguildv1connect.AgentManagerServiceClient
+	redactor func() *redact.Redactor
+}
+
+// withRedaction wraps client so that interactions and task logs created
+// through it are redacted with the project's redactor.
+func withRedaction(client taskguildv1connect.AgentManagerServiceClient, redactor func() *redact.Redactor) taskguildv1connect.AgentManagerServiceClient {
+	return &redactingClient{AgentManagerServiceClient: client, redactor: redactor}
+}
+
+// redactorOf returns the redactor of a client wrapped by withRedaction.
+// Other clients yield nil, which applies the built-in detectors only.
+func redactorOf(client taskguildv1connect.AgentManagerServiceClient) *redact.Redactor {
+	if rc, ok := client.(*redactingClient); ok {
+		return rc.redactor()
+	}
+
+	return nil
+}
+
+// CreateInteraction redacts the request in place and creates the
+// interaction.
+func (c *redactingClient) CreateInteraction(ctx context.Context, req *connect.Request[v1.CreateInteractionRequest]) (*connect.Response[v1.CreateInteractionResponse], error) {
+	r := c.redactor()
+	msg := req.Msg
+
+	msg.Title = r.String(msg.GetTitle())
+	msg.Description = r.String(msg.GetDescription())
+	msg.Metadata = r.String(msg.GetMetadata())
+
+	for _, opt := range msg.GetOptions() {
+		opt.Label = r.String(opt.GetLabel())
+		opt.Description = r.String(opt.GetDescription())
+	}
+
+	return c.AgentManagerServiceClient.CreateInteraction(ctx, req)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/fs"
 	"log/slog"
 	"net/http"
 	"os"
@@ -20,6 +21,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/oklog/ulid/v2"
 	"github.com/sourcegraph/conc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/kazz187/taskguild/internal/version"
 	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
@@ -66,14 +68,22 @@ type config struct {
 	ServerURL          string
 	APIKey             string
 	AgentManagerID     string
-	MaxConcurrentTasks int
+	MaxConcurrentTasks int // global cap across all projects
 	WorkDir            string
 	ProjectName        string
 	Env                string
 	LogLevel           string
+
+	// Projects served by this process. Loaded from the config file, or a
+	// single entry derived from WorkDir / ProjectName.
+	Projects []projectConfig
 }
 
-func loadConfig() (*config, error) {
+// loadConfig builds the configuration from the optional YAML config file
+// (configPath) and environment variables. Environment variables override
+// top-level file settings; TASKGUILD_WORK_DIR / TASKGUILD_PROJECT_NAME are
+// only used when the config file does not list projects.
+func loadConfig(configPath string) (*config, error) {
 	cfg := &config{
 		ServerURL:          "http://localhost:3100",
 		AgentManagerID:     ulid.Make().String(),
@@ -83,11 +93,20 @@ func loadConfig() (*config, error) {
 		LogLevel:           "debug",
 	}
 
+	if configPath != "" {
+		if err := applyConfigFile(cfg, configPath); err != nil {
+			return nil, err
+		}
+	}
+
 	if v := os.Getenv("TASKGUILD_SERVER_URL"); v != "" {
 		cfg.ServerURL = v
 	}
 
-	cfg.APIKey = os.Getenv("TASKGUILD_API_KEY")
+	if v := os.Getenv("TASKGUILD_API_KEY"); v != "" {
+		cfg.APIKey = v
+	}
+
 	if cfg.APIKey == "" {
 		return nil, errors.New("TASKGUILD_API_KEY is required")
 	}
@@ -132,6 +151,18 @@ func loadConfig() (*config, error) {
 		cfg.LogLevel = v
 	}
 
+	if len(cfg.Projects) == 0 {
+		cfg.Projects = []projectConfig{{
+			Name:               cfg.ProjectName,
+			WorkDir:            cfg.WorkDir,
+			MaxConcurrentTasks: cfg.MaxConcurrentTasks,
+		}}
+	} else {
+		// The first project doubles as the legacy single-project identity.
+		cfg.ProjectName = cfg.Projects[0].Name
+		cfg.WorkDir = cfg.Projects[0].WorkDir
+	}
+
 	return cfg, nil
 }
 
@@ -139,7 +170,7 @@ func loadConfig() (*config, error) {
 // It contains the original main() logic: connects to the TaskGuild server,
 // subscribes for task assignments, and executes tasks.
 func runAgent() {
-	cfg, err := loadConfig()
+	cfg, err := loadConfig(*configFlag)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "configuration error: %v\n", err)
 		os.Exit(1)
@@ -167,6 +198,7 @@ func runAgent() {
 		"max_tasks", cfg.MaxConcurrentTasks,
 		"work_dir", cfg.WorkDir,
 		"project_name", cfg.ProjectName,
+		"projects", len(cfg.Projects),
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -181,6 +213,10 @@ func runAgent() {
 	// rejectTasks is set to true on SIGUSR1 to prevent new task claims.
 	var rejectTasks atomic.Bool
 
+	// drain is set by the server (DrainAgentManager) to stop new task claims
+	// while keeping the process running.
+	drain := newDrainState()
+
 	// taskWg tracks task goroutines separately so the SIGUSR1 handler can
 	// wait for tasks to drain without waiting for infrastructure goroutines
 	// (which depend on ctx and would deadlock).
@@ -262,22 +298,35 @@ func runAgent() {
 		return
 	}
 
-	// Permission cache: shared across all tasks within this agent-manager.
-	permCache := newPermissionCache(cfg.ProjectName, client)
+	// Task logs and results that could not be delivered (e.g. while the
+	// server was restarting) are spooled here and replayed in order.
+	globalOutbox = newOutbox(filepath.Join(cfg.WorkDir, ".taskguild", "outbox"), client)
+	globalOutbox.replay(ctx)
 
-	// Single-command permission cache: regex-based per-command rules.
-	scpCache := newSingleCommandPermissionCache(cfg.ProjectName, client)
-	scpCache.Sync(ctx)
+	// Per-project permission caches (allow rules and regex-based
+	// single-command rules), shared across all tasks of a project.
+	projects := newProjectSet(cfg, client)
+	for _, pr := range projects.list {
+		pr.scpCache.Sync(ctx)
+	}
 
 	// Task tracking
 	var (
 		mu          sync.Mutex
-		activeTasks = make(map[string]context.CancelFunc)
+		activeTasks = make(map[string]*activeTask)
 		wg          conc.WaitGroup // infrastructure goroutines (heartbeat, etc.)
 	)
 	// Start heartbeat goroutine
 	wg.Go(func() {
-		heartbeat(ctx, client, cfg.AgentManagerID)
+		heartbeat(ctx, client, cfg.AgentManagerID, drain, func() int {
+			mu.Lock()
+			defer mu.Unlock()
+
+			return len(activeTasks)
+		})
+	})
+	wg.Go(func() {
+		globalOutbox.run(ctx)
 	})
 
 	// Subscribe loop with reconnection and exponential backoff.
@@ -295,16 +344,21 @@ func runAgent() {
 	for ctx.Err() == nil {
 		// Re-sync agents, permissions, and scripts on each reconnection so local files stay up-to-date.
 		forceAll := firstSync && overrideAgentMDFlag != nil && *overrideAgentMDFlag
-		syncAgents(ctx, client, cfg, nil, forceAll)
+		for _, pr := range projects.list {
+			syncAgents(ctx, client, pr.cfg, nil, forceAll)
+			syncPermissions(ctx, client, pr.cfg, pr.permCache)
+			syncClaudeSettings(ctx, client, pr.cfg)
+			syncScripts(ctx, client, pr.cfg, nil) // nil = don't force-overwrite any existing files
+			syncSkills(ctx, client, pr.cfg, nil)
+		}
 
 		firstSync = false
 
-		syncPermissions(ctx, client, cfg, permCache)
-		syncClaudeSettings(ctx, client, cfg)
-		syncScripts(ctx, client, cfg, nil) // nil = don't force-overwrite any existing files
-		syncSkills(ctx, client, cfg, nil)
+		// Deliver spooled results before subscribing: on subscribe the server
+		// releases assigned tasks that are no longer running here.
+		globalOutbox.replay(ctx)
 
-		err := runSubscribeLoop(ctx, client, taskClient, interClient, cfg, &mu, activeTasks, &wg, &taskWg, taskRootCtx, &rejectTasks, permCache, scpCache, subscribeReceiveTimeout)
+		err := runSubscribeLoop(ctx, client, taskClient, interClient, cfg, projects, &mu, activeTasks, &wg, &taskWg, taskRootCtx, &rejectTasks, drain, subscribeReceiveTimeout)
 		if ctx.Err() != nil {
 			break
 		}
@@ -341,9 +395,9 @@ func runAgent() {
 	// On hot-reload (SIGUSR1), tasks already drained naturally.
 	if !rejectTasks.Load() {
 		mu.Lock()
-		for taskID, cancelFn := range activeTasks {
+		for taskID, at := range activeTasks {
 			slog.Info("canceling task", "task_id", taskID)
-			cancelFn()
+			at.cancel()
 		}
 		mu.Unlock()
 	}
@@ -360,14 +414,14 @@ func runSubscribeLoop(
 	taskClient taskguildv1connect.TaskServiceClient,
 	interClient taskguildv1connect.InteractionServiceClient,
 	cfg *config,
+	projects *projectSet,
 	mu *sync.Mutex,
-	activeTasks map[string]context.CancelFunc,
+	activeTasks map[string]*activeTask,
 	wg *conc.WaitGroup,
 	taskWg *conc.WaitGroup,
 	taskRootCtx context.Context,
 	rejectTasks *atomic.Bool,
-	permCache *permissionCache,
-	scpCache *singleCommandPermissionCache,
+	drain *drainState,
 	receiveTimeout time.Duration,
 ) error {
 	// Collect active task IDs so the server knows which tasks are still running
@@ -389,6 +443,16 @@ func runSubscribeLoop(
 	streamCtx, streamCancel := context.WithCancel(ctx)
 	defer streamCancel()
 
+	servedProjects := make([]*v1.ServedProject, 0, len(projects.list))
+	for _, pr := range projects.list {
+		servedProjects = append(servedProjects, &v1.ServedProject{
+			ProjectName:        pr.cfg.ProjectName,
+			WorkDir:            pr.cfg.WorkDir,
+			MaxConcurrentTasks: int32(pr.cfg.MaxConcurrentTasks),
+			Labels:             pr.cfg.Projects[0].Labels,
+		})
+	}
+
 	stream, err := client.Subscribe(streamCtx, connect.NewRequest(&v1.AgentManagerSubscribeRequest{
 		AgentManagerId:     cfg.AgentManagerID,
 		MaxConcurrentTasks: int32(cfg.MaxConcurrentTasks),
@@ -396,6 +460,8 @@ func runSubscribeLoop(
 		ActiveTaskIds:      activeTaskIDs,
 		AgentVersion:       version.Short(),
 		WorkDir:            cfg.WorkDir,
+		Projects:           servedProjects,
+		Draining:           drain.active(),
 	}))
 	if err != nil {
 		return fmt.Errorf("failed to subscribe: %w", err)
@@ -449,6 +515,15 @@ func runSubscribeLoop(
 			continue
 		}
 
+		// Route the command to the project it was sent for.
+		pr := projects.resolve(cmd.GetProjectName())
+		if pr == nil {
+			slog.Warn("ignoring command for project not served by this agent manager",
+				"project_name", cmd.GetProjectName(), "type", fmt.Sprintf("%T", cmd.GetCommand()))
+
+			continue
+		}
+
 		switch c := cmd.GetCommand().(type) {
 		case *v1.AgentCommand_TaskAvailable:
 			taskAvail := c.TaskAvailable
@@ -461,21 +536,26 @@ func runSubscribeLoop(
 				continue
 			}
 
+			if drain.active() {
+				slog.Info("rejecting task: agent manager is draining", "task_id", taskID)
+				continue
+			}
+
 			// Skip if this task is already running.
 			mu.Lock()
-			if prevCancel, ok := activeTasks[taskID]; ok {
+			if prev, ok := activeTasks[taskID]; ok {
 				mu.Unlock()
 				slog.Info("task already active, canceling previous run and re-claiming", "task_id", taskID)
-				prevCancel()
+				prev.cancel()
 			} else {
 				mu.Unlock()
 			}
 
-			// Check capacity using the activeTasks map.
+			// Check global and per-project capacity using the activeTasks map.
 			mu.Lock()
-			if len(activeTasks) >= cfg.MaxConcurrentTasks {
+			if !hasCapacity(activeTasks, cfg, pr) {
 				mu.Unlock()
-				slog.Info("at max capacity, skipping task", "task_id", taskID)
+				slog.Info("at max capacity, skipping task", "task_id", taskID, "project_name", pr.cfg.ProjectName)
 
 				continue
 			}
@@ -500,11 +580,12 @@ func runSubscribeLoop(
 
 			instructions := claimResp.Msg.GetInstructions()
 			metadata := claimResp.Msg.GetMetadata()
+			pr.permCache.UpdateSecrets(claimResp.Msg.GetSecrets())
 
 			taskCtx, taskCancel := context.WithCancel(taskRootCtx)
 
 			mu.Lock()
-			activeTasks[taskID] = taskCancel
+			activeTasks[taskID] = &activeTask{cancel: taskCancel, project: pr.cfg.ProjectName}
 			mu.Unlock()
 
 			taskWg.Go(func() {
@@ -514,6 +595,11 @@ func runSubscribeLoop(
 					mu.Lock()
 					delete(activeTasks, tID)
 					mu.Unlock()
+
+					if drain.active() {
+						drain.notify()
+					}
+
 					userStoppedTasks.mu.Lock()
 					delete(userStoppedTasks.stopped, tID)
 					userStoppedTasks.mu.Unlock()
@@ -533,14 +619,16 @@ func runSubscribeLoop(
 				}
 
 				slog.Info("launching runTask goroutine", "task_id", tID)
-				runTask(taskCtx, client, taskClient, interClient, cfg.AgentManagerID, tID, instructions, metadata, cfg.WorkDir, permCache, scpCache, subprocessQueryRunner{projectID: metadata["_project_id"], projectDir: cfg.WorkDir}, isUserStopped)
+				runTask(taskCtx, client, taskClient, interClient, cfg.AgentManagerID, tID, instructions, metadata, pr.cfg.WorkDir, pr.permCache, pr.scpCache, subprocessQueryRunner{projectID: metadata["_project_id"], projectDir: pr.cfg.WorkDir, redactor: pr.permCache.Redactor, secrets: pr.permCache.Secrets}, isUserStopped)
 				slog.Info("runTask goroutine finished", "task_id", tID)
 			})
 
 		case *v1.AgentCommand_ListWorktrees:
 			listCmd := c.ListWorktrees
 			slog.Info("received list worktrees command", "request_id", listCmd.GetRequestId())
-			safeGo("handleListWorktrees", func() { handleListWorktrees(ctx, client, cfg, listCmd.GetRequestId()) })
+			safeGo("handleListWorktrees", func() {
+				handleListWorktrees(ctx, client, pr.cfg, listCmd.GetRequestId(), listCmd.GetBaseBranch())
+			})
 
 		case *v1.AgentCommand_DeleteWorktree:
 			deleteCmd := c.DeleteWorktree
@@ -549,12 +637,12 @@ func runSubscribeLoop(
 				"worktree_name", deleteCmd.GetWorktreeName(),
 				"force", deleteCmd.GetForce(),
 			)
-			safeGo("handleDeleteWorktree", func() { handleDeleteWorktree(ctx, client, cfg, deleteCmd) })
+			safeGo("handleDeleteWorktree", func() { handleDeleteWorktree(ctx, client, pr.cfg, deleteCmd) })
 
 		case *v1.AgentCommand_GitPullMain:
 			pullCmd := c.GitPullMain
 			slog.Info("received git pull main command", "request_id", pullCmd.GetRequestId())
-			safeGo("handleGitPullMain", func() { handleGitPullMain(ctx, client, cfg, pullCmd.GetRequestId()) })
+			safeGo("handleGitPullMain", func() { handleGitPullMain(ctx, client, pr.cfg, pullCmd.GetRequestId()) })
 
 		case *v1.AgentCommand_SyncAgents:
 			syncCmd := c.SyncAgents
@@ -565,12 +653,12 @@ func runSubscribeLoop(
 			}
 
 			slog.Info("received sync agents command, re-syncing", "force_overwrite_count", len(forceNames))
-			syncAgents(ctx, client, cfg, forceNames, false)
+			syncAgents(ctx, client, pr.cfg, forceNames, false)
 
 		case *v1.AgentCommand_SyncPermissions:
 			slog.Info("received sync permissions command, re-syncing")
-			syncPermissions(ctx, client, cfg, permCache)
-			scpCache.Sync(ctx)
+			syncPermissions(ctx, client, pr.cfg, pr.permCache)
+			pr.scpCache.Sync(ctx)
 
 		case *v1.AgentCommand_SyncScripts:
 			syncCmd := c.SyncScripts
@@ -581,12 +669,12 @@ func runSubscribeLoop(
 			}
 
 			slog.Info("received sync scripts command, re-syncing", "force_overwrite_count", len(forceIDs))
-			syncScripts(ctx, client, cfg, forceIDs)
+			syncScripts(ctx, client, pr.cfg, forceIDs)
 
 		case *v1.AgentCommand_CompareScripts:
 			compareCmd := c.CompareScripts
 			slog.Info("received compare scripts command", "request_id", compareCmd.GetRequestId())
-			safeGo("handleCompareScripts", func() { handleCompareScripts(ctx, client, cfg, compareCmd) })
+			safeGo("handleCompareScripts", func() { handleCompareScripts(ctx, client, pr.cfg, compareCmd) })
 
 		case *v1.AgentCommand_ExecuteScript:
 			execCmd := c.ExecuteScript
@@ -595,7 +683,10 @@ func runSubscribeLoop(
 				"script_id", execCmd.GetScriptId(),
 				"filename", execCmd.GetFilename(),
 			)
-			safeGo("handleExecuteScript", func() { handleExecuteScript(ctx, client, cfg, execCmd) })
+			pr.permCache.UpdateSecrets(execCmd.GetSecrets())
+			safeGo("handleExecuteScript", func() {
+				handleExecuteScript(ctx, withRedaction(client, pr.permCache.Redactor), pr.cfg, execCmd)
+			})
 
 		case *v1.AgentCommand_StopScript:
 			stopCmd := c.StopScript
@@ -605,7 +696,7 @@ func runSubscribeLoop(
 		case *v1.AgentCommand_CompareAgents:
 			compareCmd := c.CompareAgents
 			slog.Info("received compare agents command", "request_id", compareCmd.GetRequestId())
-			safeGo("handleCompareAgents", func() { handleCompareAgents(ctx, client, cfg, compareCmd) })
+			safeGo("handleCompareAgents", func() { handleCompareAgents(ctx, client, pr.cfg, compareCmd) })
 
 		case *v1.AgentCommand_SyncSkills:
 			syncCmd := c.SyncSkills
@@ -616,16 +707,28 @@ func runSubscribeLoop(
 			}
 
 			slog.Info("received sync skills command, re-syncing", "force_overwrite_count", len(forceIDs))
-			syncSkills(ctx, client, cfg, forceIDs)
+			syncSkills(ctx, client, pr.cfg, forceIDs)
 
 		case *v1.AgentCommand_CompareSkills:
 			compareCmd := c.CompareSkills
 			slog.Info("received compare skills command", "request_id", compareCmd.GetRequestId())
-			safeGo("handleCompareSkills", func() { handleCompareSkills(ctx, client, cfg, compareCmd) })
+			safeGo("handleCompareSkills", func() { handleCompareSkills(ctx, client, pr.cfg, compareCmd) })
 
 		case *v1.AgentCommand_SyncClaudeSettings:
 			slog.Info("received sync claude settings command, re-syncing")
-			syncClaudeSettings(ctx, client, cfg)
+			syncClaudeSettings(ctx, client, pr.cfg)
+
+		case *v1.AgentCommand_Drain:
+			if c.Drain.GetResume() {
+				slog.Info("drain lifted, accepting new tasks again")
+			} else {
+				mu.Lock()
+				running := len(activeTasks)
+				mu.Unlock()
+				slog.Info("draining: no longer accepting new tasks", "running_tasks", running)
+			}
+
+			drain.set(!c.Drain.GetResume())
 
 		case *v1.AgentCommand_CancelTask:
 			cancelCmd := c.CancelTask
@@ -638,11 +741,69 @@ func runSubscribeLoop(
 			userStoppedTasks.mu.Unlock()
 
 			mu.Lock()
-			if cancelFn, ok := activeTasks[taskID]; ok {
-				cancelFn()
+			if at, ok := activeTasks[taskID]; ok {
+				at.cancel()
+			}
+			mu.Unlock()
+
+		case *v1.AgentCommand_CompactTask:
+			taskID := c.CompactTask.GetTaskId()
+
+			mu.Lock()
+			_, running := activeTasks[taskID]
+			mu.Unlock()
+
+			if !running {
+				slog.Info("ignoring compact request for task not running here", "task_id", taskID)
+				continue
 			}
+
+			slog.Info("compact request for task", "task_id", taskID)
+			requestCompaction(taskID)
+
+		case *v1.AgentCommand_RollbackTask:
+			rollbackCmd := c.RollbackTask
+			taskID := rollbackCmd.GetTaskId()
+			slog.Info("received rollback command", "task_id", taskID, "checkpoint_id", rollbackCmd.GetCheckpointId())
+
+			mu.Lock()
+			_, running := activeTasks[taskID]
 			mu.Unlock()
 
+			if running {
+				safeGo("reportRollbackRejected", func() {
+					_, err := client.ReportTaskRollbackResult(ctx, connect.NewRequest(&v1.ReportTaskRollbackResultRequest{
+						RequestId:    rollbackCmd.GetRequestId(),
+						TaskId:       taskID,
+						CheckpointId: rollbackCmd.GetCheckpointId(),
+						ErrorMessage: "task is running on this agent manager",
+					}))
+					if err != nil {
+						slog.Error("failed to report rollback result", "error", err)
+					}
+				})
+
+				continue
+			}
+
+			safeGo("handleRollbackTask", func() {
+				handleRollbackTask(ctx, withRedaction(client, pr.permCache.Redactor), taskClient, pr.cfg.WorkDir, cfg.AgentManagerID, rollbackCmd)
+			})
+
+		case *v1.AgentCommand_TaskDiff:
+			diffCmd := c.TaskDiff
+			slog.Info("received task diff command", "task_id", diffCmd.GetTaskId(), "request_id", diffCmd.GetRequestId())
+			safeGo("handleTaskDiff", func() { handleTaskDiff(ctx, client, pr.cfg.WorkDir, diffCmd) })
+
+		case *v1.AgentCommand_MergeWorktree:
+			mergeCmd := c.MergeWorktree
+			slog.Info("received merge worktree command",
+				"task_id", mergeCmd.GetTaskId(),
+				"worktree_name", mergeCmd.GetWorktreeName(),
+				"request_id", mergeCmd.GetRequestId(),
+			)
+			safeGo("handleMergeWorktree", func() { handleMergeWorktree(ctx, client, pr.cfg.WorkDir, mergeCmd) })
+
 		case *v1.AgentCommand_AssignTask:
 			assignCmd := c.AssignTask
 			taskID := assignCmd.GetTaskId()
@@ -654,12 +815,17 @@ func runSubscribeLoop(
 				continue
 			}
 
+			if drain.active() {
+				slog.Info("rejecting assigned task: agent manager is draining", "task_id", taskID)
+				continue
+			}
+
 			// Cancel previous run if the same task is re-assigned.
 			mu.Lock()
-			if prevCancel, ok := activeTasks[taskID]; ok {
+			if prev, ok := activeTasks[taskID]; ok {
 				mu.Unlock()
 				slog.Info("task already active, canceling previous run for re-assignment", "task_id", taskID)
-				prevCancel()
+				prev.cancel()
 			} else {
 				mu.Unlock()
 			}
@@ -667,11 +833,11 @@ func runSubscribeLoop(
 			instructions := assignCmd.GetInstructions()
 			metadata := assignCmd.GetMetadata()
 
-			// Check capacity using the activeTasks map.
+			// Check global and per-project capacity using the activeTasks map.
 			mu.Lock()
-			if len(activeTasks) >= cfg.MaxConcurrentTasks {
+			if !hasCapacity(activeTasks, cfg, pr) {
 				mu.Unlock()
-				slog.Warn("at max capacity, cannot run assigned task", "task_id", taskID)
+				slog.Warn("at max capacity, cannot run assigned task", "task_id", taskID, "project_name", pr.cfg.ProjectName)
 
 				continue
 			}
@@ -680,7 +846,7 @@ func runSubscribeLoop(
 			taskCtx, taskCancel := context.WithCancel(taskRootCtx)
 
 			mu.Lock()
-			activeTasks[taskID] = taskCancel
+			activeTasks[taskID] = &activeTask{cancel: taskCancel, project: pr.cfg.ProjectName}
 			mu.Unlock()
 
 			taskWg.Go(func() {
@@ -690,6 +856,11 @@ func runSubscribeLoop(
 					mu.Lock()
 					delete(activeTasks, tID)
 					mu.Unlock()
+
+					if drain.active() {
+						drain.notify()
+					}
+
 					userStoppedTasks.mu.Lock()
 					delete(userStoppedTasks.stopped, tID)
 					userStoppedTasks.mu.Unlock()
@@ -709,7 +880,7 @@ func runSubscribeLoop(
 				}
 
 				slog.Info("launching runTask goroutine (assigned)", "task_id", tID)
-				runTask(taskCtx, client, taskClient, interClient, cfg.AgentManagerID, tID, instructions, metadata, cfg.WorkDir, permCache, scpCache, subprocessQueryRunner{projectID: metadata["_project_id"], projectDir: cfg.WorkDir}, isUserStopped)
+				runTask(taskCtx, client, taskClient, interClient, cfg.AgentManagerID, tID, instructions, metadata, pr.cfg.WorkDir, pr.permCache, pr.scpCache, subprocessQueryRunner{projectID: metadata["_project_id"], projectDir: pr.cfg.WorkDir, redactor: pr.permCache.Redactor, secrets: pr.permCache.Secrets}, isUserStopped)
 				slog.Info("runTask goroutine finished (assigned)", "task_id", tID)
 			})
 
@@ -741,25 +912,6 @@ func runSubscribeLoop(
 	return nil
 }
 
-func heartbeat(ctx context.Context, client taskguildv1connect.AgentManagerServiceClient, agentManagerID string) {
-	ticker := time.NewTicker(30 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			_, err := client.Heartbeat(ctx, connect.NewRequest(&v1.HeartbeatRequest{
-				AgentManagerId: agentManagerID,
-			}))
-			if err != nil {
-				slog.Warn("heartbeat error", "error", err)
-			}
-		}
-	}
-}
-
 // waitForServer polls the server's /health endpoint until it returns 200 OK.
 // This prevents the agent from attempting RPC calls before the server is ready,
 // which is common during sentinel hot-reload restarts.
@@ -830,10 +982,15 @@ func isClaudeInternalPath(path string) bool {
 }
 
 // handleListWorktrees scans the .claude/worktrees/ directory and reports
-// available worktrees to the backend.
-func handleListWorktrees(ctx context.Context, client taskguildv1connect.AgentManagerServiceClient, cfg *config, requestID string) {
+// available worktrees to the backend. baseBranch (detected if empty) is used
+// to tell whether a worktree branch has been merged.
+func handleListWorktrees(ctx context.Context, client taskguildv1connect.AgentManagerServiceClient, cfg *config, requestID, baseBranch string) {
 	worktreesDir := filepath.Join(cfg.WorkDir, ".claude", "worktrees")
 
+	if baseBranch == "" {
+		baseBranch = detectDefaultBranch(ctx, cfg.WorkDir)
+	}
+
 	entries, err := os.ReadDir(worktreesDir)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -901,12 +1058,22 @@ func handleListWorktrees(ctx context.Context, client taskguildv1connect.AgentMan
 			}
 		}
 
-		worktrees = append(worktrees, &v1.WorktreeInfo{
+		info := &v1.WorktreeInfo{
 			Name:         name,
 			Branch:       branch,
 			HasChanges:   hasChanges,
 			ChangedFiles: changedFiles,
-		})
+			Merged:       branchMerged(ctx, wtDir, branch, baseBranch),
+		}
+
+		size, modTime := worktreeDiskUsage(wtDir)
+		info.SizeBytes = size
+
+		if !modTime.IsZero() {
+			info.LastModifiedAt = timestamppb.New(modTime)
+		}
+
+		worktrees = append(worktrees, info)
 	}
 
 	_, err = client.ReportWorktreeList(ctx, connect.NewRequest(&v1.ReportWorktreeListRequest{
@@ -921,7 +1088,56 @@ func handleListWorktrees(ctx context.Context, client taskguildv1connect.AgentMan
 	}
 }
 
-// handleDeleteWorktree removes a git worktree and its associated branch.
+// worktreeDiskUsage returns the total size of the files under dir and the
+// newest modification time among them. Symlinks are not followed.
+func worktreeDiskUsage(dir string) (int64, time.Time) {
+	var (
+		size    int64
+		modTime time.Time
+	)
+
+	_ = filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil // skip unreadable entries
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+
+		return nil
+	})
+
+	return size, modTime
+}
+
+// branchMerged reports whether branch is contained in baseBranch (or its
+// origin counterpart).
+func branchMerged(ctx context.Context, dir, branch, baseBranch string) bool {
+	if branch == "" || branch == baseBranch {
+		return false
+	}
+
+	for _, ref := range []string{baseBranch, "origin/" + baseBranch} {
+		if _, err := gitOutput(ctx, dir, "merge-base", "--is-ancestor", branch, ref); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handleDeleteWorktree removes a git worktree and, unless the command asks to
+// keep it, its associated branch.
 func handleDeleteWorktree(ctx context.Context, client taskguildv1connect.AgentManagerServiceClient, cfg *config, cmd *v1.DeleteWorktreeCommand) {
 	requestID := cmd.GetRequestId()
 	worktreeName := cmd.GetWorktreeName()
@@ -1010,6 +1226,13 @@ func handleDeleteWorktree(ctx context.Context, client taskguildv1connect.AgentMa
 		return
 	}
 
+	if cmd.GetKeepBranch() {
+		slog.Info("deleted worktree", "worktree_name", worktreeName, "kept_branch", branchName, "force", force)
+		reportResult(true, "")
+
+		return
+	}
+
 	// Delete the associated branch (best-effort).
 	deleteBranchCmd := exec.CommandContext(ctx, "git", "branch", "-D", branchName)
 
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/oklog/ulid/v2"
 	"github.com/sourcegraph/conc"
 
 	claudeagent "github.com/kazz187/claude-agent-sdk-go"
@@ -71,6 +72,9 @@ func runTask(
 	queryRunner QueryRunner,
 	isUserStopped func() bool,
 ) {
+	// Redact secrets from everything reported for the task.
+	client = withRedaction(client, permCache.Redactor)
+
 	// Create task-scoped logger and embed in context.
 	logger := slog.Default().With("task_id", taskID)
 	ctx = clog.ContextWithLogger(ctx, logger)
@@ -117,8 +121,10 @@ func runTask(
 	if metadata["_use_worktree"] == "true" && worktreeName != "" {
 		logger.Info("ensuring worktree directory", "worktree_name", worktreeName)
 
-		if _, err := ensureWorktree(ctx, workDir, worktreeName, taskID); err != nil {
+		if _, err := ensureWorktree(ctx, workDir, worktreeName, taskID, worktreeBaseFromMetadata(metadata)); err != nil {
 			logger.Warn("failed to ensure worktree", "error", err)
+			tl.Log(v1.TaskLogCategory_TASK_LOG_CATEGORY_SYSTEM, v1.TaskLogLevel_TASK_LOG_LEVEL_WARN,
+				fmt.Sprintf("Failed to create worktree %q: %v", worktreeName, err), nil)
 		}
 	}
 
@@ -137,6 +143,24 @@ func runTask(
 	// Resolve session early so the afterHooks closure can capture sessionID.
 	sessionID := resolveSession(metadata)
 
+	// The session may have been created on another machine: restore its
+	// transcript from the server so it can be resumed here.
+	if sessionID != "" {
+		if restored, err := restoreSessionTranscript(ctx, client, taskID, resolveHookDir(), sessionID); err != nil {
+			logger.Warn("failed to restore session transcript", "session_id", sessionID, "error", err)
+		} else if restored {
+			tl.Log(v1.TaskLogCategory_TASK_LOG_CATEGORY_SYSTEM, v1.TaskLogLevel_TASK_LOG_LEVEL_INFO,
+				"Restored session transcript from server",
+				map[string]string{"session_id": sessionID})
+		}
+	}
+
+	transcripts := newTranscriptUploader(client, taskID, tl)
+	checkpoints := newCheckpointer(taskID, agentManagerID, worktreeName, tl)
+	fileReporter := newModifiedFilesReporter(client, tl, taskID, worktreeName)
+
+	defer forgetEditedFiles(taskID)
+
 	// afterHooks runs after_task_execution hooks exactly once.
 	// It is called explicitly before status transitions and deferred as a
 	// safety-net for all other return paths so hooks always execute.
@@ -188,6 +212,30 @@ func runTask(
 		prompt = buildUserPrompt(metadata, workDir)
 	}
 
+	// taskPrompt is kept to seed a fresh session with a handoff summary.
+	taskPrompt := prompt
+
+	// startWithHandoff drops the current session: the next turn starts a
+	// fresh session whose prompt carries a summary of the task history.
+	startWithHandoff := func() {
+		handoff := buildHandoff(ctx, client, taskID, resolveHookDir(), metadata)
+		prompt = withHandoff(taskPrompt, handoff, continuationPrompt(prompt, taskPrompt))
+		sessionID = ""
+
+		tl.Log(v1.TaskLogCategory_TASK_LOG_CATEGORY_SYSTEM, v1.TaskLogLevel_TASK_LOG_LEVEL_INFO,
+			"Starting fresh session with handoff summary",
+			map[string]string{"handoff_size": strconv.Itoa(len(handoff))})
+	}
+
+	// A compaction requested while the task was not running is applied on
+	// the first turn.
+	compactRequested := metadata["_compact_requested"] == "true"
+	if compactRequested {
+		clearCompactRequest(ctx, taskClient, taskID)
+	}
+
+	defer takeCompaction(taskID)
+
 	hasTransitions := metadata["_available_transitions"] != "" && metadata["_available_transitions"] != "null"
 	logger.Info("task setup complete, entering turn loop", "has_session", sessionID != "", "has_transitions", hasTransitions)
 
@@ -206,7 +254,27 @@ func runTask(
 	// new turn would create a fresh guard.
 	loopGuard := newSkillLoopGuard()
 
+	// Model fallback chain for overload / rate-limit errors. Lives outside the
+	// turn loop so the cool-down spans turns.
+	fallback := newModelFallback(metadata)
+
 	for turn := 0; ; turn++ {
+		if takeCompaction(taskID) || compactRequested {
+			compactRequested = false
+
+			if sessionID != "" {
+				logger.Info("compacting task session", "session_id", sessionID)
+				startWithHandoff()
+			}
+		}
+
+		if fallback.restorePrimary(time.Now()) {
+			logger.Info("model fallback cool-down elapsed, returning to primary model", "model", fallback.current())
+			tl.Log(v1.TaskLogCategory_TASK_LOG_CATEGORY_SYSTEM, v1.TaskLogLevel_TASK_LOG_LEVEL_INFO,
+				"Returning to primary model "+fallback.current(),
+				map[string]string{"model": fallback.current()})
+		}
+
 		opts := buildClaudeOptions(instructions, workDir, metadata, sessionID, worktreeName, client, taskClient, interClient, ctx, taskID, agentManagerID, waiter, permCache, scpCache, tl, loopGuard, func(newMode string) {
 			modeMu.Lock()
 			old := currentMode
@@ -221,6 +289,9 @@ func runTask(
 				saveClaudeMode(ctx, taskClient, taskID, newMode)
 			}
 		})
+		if m := fallback.override(); m != "" {
+			opts.Model = m
+		}
 		// Override StderrCallback to also send to task logger.
 		opts.StderrCallback = func(line string) {
 			logger.Debug("claude-stderr", "line", line)
@@ -311,6 +382,16 @@ func runTask(
 			if statusName := metadata["_current_status_name"]; statusName != "" {
 				metadata["session_id_"+statusName] = sessionID
 			}
+
+			transcripts.upload(ctx, resolveHookDir(), sessionID)
+		}
+
+		// Snapshot the worktree so the task can be rolled back to this turn.
+		if metadata["_use_worktree"] == "true" && worktreeName != "" {
+			if dir := resolveHookDir(); dir != workDir {
+				checkpoints.record(ctx, dir, fmt.Sprintf("Checkpoint after turn %d", turn), turn, sessionID, sessionStatusName(metadata))
+				fileReporter.report(ctx, dir, metadata["_worktree_base_ref"])
+			}
 		}
 
 		// Handle errors with backoff retry.
@@ -345,16 +426,34 @@ func runTask(
 				return
 			}
 
+			// Overload and rate-limit errors switch to the next model of the
+			// fallback chain and retry the turn immediately.
+			if isModelFallbackError(errMsg) {
+				from := fallback.current()
+				if next, ok := fallback.advance(time.Now()); ok {
+					logger.Warn("model unavailable, falling back", "from_model", from, "to_model", next, "error", errMsg)
+					tl.Log(v1.TaskLogCategory_TASK_LOG_CATEGORY_SYSTEM, v1.TaskLogLevel_TASK_LOG_LEVEL_WARN,
+						fmt.Sprintf("Model %s unavailable, falling back to %s", from, next),
+						map[string]string{"from_model": from, "to_model": next, "error": errMsg})
+					reportAgentStatus(ctx, client, agentManagerID, taskID, v1.AgentStatus_AGENT_STATUS_RUNNING,
+						"falling back to model "+next)
+
+					continue
+				}
+			}
+
 			consecutiveErrors++
 			logger.Error("task error", "consecutive_errors", consecutiveErrors, "max_errors", maxConsecutiveErrors, "error", errMsg)
 
-			// If resume keeps failing, clear session and start fresh.
+			// If resume keeps failing, start a fresh session seeded with a
+			// handoff summary of the task history.
 			if sessionID != "" && consecutiveErrors >= maxResumeRetries {
 				logger.Warn("resume failed, clearing session to start fresh", "consecutive_errors", consecutiveErrors)
 				tl.Log(v1.TaskLogCategory_TASK_LOG_CATEGORY_STATUS_CHANGE, v1.TaskLogLevel_TASK_LOG_LEVEL_WARN,
 					"Resume failed, restarting with fresh session", nil)
 
-				sessionID = ""
+				startWithHandoff()
+
 				consecutiveErrors = 0
 				backoff = initialBackoff
 
@@ -755,6 +854,16 @@ func resolveSession(metadata map[string]string) string {
 	return ""
 }
 
+// sessionStatusName returns the status whose session_id_{status} key
+// resolveSession reads the session from.
+func sessionStatusName(metadata map[string]string) string {
+	if inheritFrom := metadata["_inherit_session_from"]; inheritFrom != "" && metadata["session_id_"+inheritFrom] != "" {
+		return inheritFrom
+	}
+
+	return metadata["_current_status_name"]
+}
+
 // extractSessionIDFromMessages scans intermediate messages (StreamEvent,
 // RateLimitEvent, etc.) in reverse for a session_id. Used as fallback when
 // ResultMessage is not available (e.g., user-stopped turn).
@@ -834,16 +943,37 @@ func buildClaudeOptions(
 	// the TaskGuild workflow definition.
 	statusSkills := collectStatusSkills(metadata)
 
+	sandbox := newBashSandbox(metadata, cwd, workDir)
+	statusPerms := newStatusPermissions(metadata)
+
 	opts := &claudeagent.ClaudeAgentOptions{
 		Cwd:            cwd,
 		PermissionMode: permMode,
 		CanUseTool: func(toolName string, input map[string]any, toolCtx claudeagent.ToolPermissionContext) (claudeagent.PermissionResult, error) {
-			return handlePermissionRequest(ctx, client, taskID, agentManagerID, toolName, input, waiter, permMode, toolCtx, permCache, scpCache, statusSkills)
+			if toolName != "Bash" || sandbox == nil {
+				return handlePermissionRequest(ctx, client, taskID, agentManagerID, toolName, input, waiter, permMode, toolCtx, permCache, scpCache, statusSkills, cwd, statusPerms)
+			}
+
+			// Evaluate permissions against the command the agent asked for,
+			// then make sure the approved command runs inside the sandbox.
+			orig := sandbox.unwrapInput(input)
+
+			res, err := handlePermissionRequest(ctx, client, taskID, agentManagerID, toolName, orig, waiter, permMode, toolCtx, permCache, scpCache, statusSkills, cwd, statusPerms)
+			if allow, ok := res.(claudeagent.PermissionResultAllow); ok && sandbox.available() {
+				if allow.UpdatedInput == nil {
+					allow.UpdatedInput = orig
+				}
+
+				allow.UpdatedInput = sandbox.wrapInput(allow.UpdatedInput)
+				res = allow
+			}
+
+			return res, err
 		},
 		StderrCallback: func(line string) {
 			logger.Debug("claude-stderr", "line", line)
 		},
-		Hooks: buildToolUseHooks(tl, taskID, onModeChange, client, interClient, agentManagerID, waiter, loopGuard),
+		Hooks: buildToolUseHooks(tl, taskID, onModeChange, client, interClient, agentManagerID, waiter, loopGuard, sandbox),
 	}
 
 	// Skill-based mode: set model/tools/disallowedTools from status metadata.
@@ -908,11 +1038,26 @@ func reportTaskResult(
 ) {
 	logger := clog.LoggerFromContext(ctx)
 
-	_, err := client.ReportTaskResult(ctx, connect.NewRequest(&v1.ReportTaskResultRequest{
+	// Redact before the request is sent or spooled to the outbox.
+	redactor := redactorOf(client)
+	req := &v1.ReportTaskResultRequest{
 		TaskId:       taskID,
-		Summary:      summary,
-		ErrorMessage: errMsg,
-	}))
+		Summary:      redactor.String(summary),
+		ErrorMessage: redactor.String(errMsg),
+		ResultId:     ulid.Make().String(),
+	}
+	send := func(ctx context.Context) error {
+		_, err := client.ReportTaskResult(ctx, connect.NewRequest(req))
+		return err
+	}
+
+	var err error
+	if globalOutbox != nil {
+		err = globalOutbox.deliver(ctx, taskID, outboxKindResult, req, send)
+	} else {
+		err = send(ctx)
+	}
+
 	if err != nil {
 		logger.Error("failed to report task result", "error", err)
 	}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+)
+
+// sandboxConfig mirrors the _sandbox metadata injected by the server on claim.
+type sandboxConfig struct {
+	AllowNetwork    bool     `json:"allow_network,omitempty"`
+	CPULimitSeconds int32    `json:"cpu_limit_seconds,omitempty"`
+	MemoryLimitMB   int32    `json:"memory_limit_mb,omitempty"`
+	TimeoutSeconds  int32    `json:"timeout_seconds,omitempty"`
+	WritablePaths   []string `json:"writable_paths,omitempty"`
+}
+
+// bashSandbox wraps Bash tool commands in a bubblewrap sandbox: the working
+// tree (and the repository's .git directory) is writable, the rest of the
+// filesystem is mounted read-only, and CPU, memory and wall-clock limits are
+// applied inside the sandbox.
+type bashSandbox struct {
+	cfg          sandboxConfig
+	writableDirs []string
+
+	// bwrapPath is the resolved bubblewrap binary. Empty means the sandbox
+	// is required but unavailable; commands are then blocked.
+	bwrapPath string
+}
+
+// newBashSandbox returns the sandbox for the current status, or nil when the
+// status does not request one. cwd is the session working directory (the
+// worktree for worktree tasks) and workDir the project root.
+func newBashSandbox(metadata map[string]string, cwd, workDir string) *bashSandbox {
+	raw := metadata["_sandbox"]
+	if raw == "" {
+		return nil
+	}
+
+	sb := &bashSandbox{}
+	// A malformed config still enables the sandbox with default limits:
+	// failing open would defeat forced sandboxing for bypassPermissions.
+	_ = json.Unmarshal([]byte(raw), &sb.cfg)
+
+	sb.writableDirs = append(sb.writableDirs, cwd)
+	if cwd != workDir {
+		// Worktrees keep their git metadata under the main repository's .git.
+		sb.writableDirs = append(sb.writableDirs, filepath.Join(workDir, ".git"))
+	}
+
+	for _, p := range sb.cfg.WritablePaths {
+		if p != "" && !slices.Contains(sb.writableDirs, p) {
+			sb.writableDirs = append(sb.writableDirs, p)
+		}
+	}
+
+	if runtime.GOOS == "linux" {
+		if p, err := exec.LookPath("bwrap"); err == nil {
+			sb.bwrapPath = p
+		}
+	}
+
+	return sb
+}
+
+// available reports whether commands can actually be sandboxed.
+func (sb *bashSandbox) available() bool {
+	return sb.bwrapPath != ""
+}
+
+// unavailableReason explains why sandboxed commands are blocked.
+func (sb *bashSandbox) unavailableReason() string {
+	if runtime.GOOS != "linux" {
+		return "this status requires a sandbox, but sandboxing is only supported on Linux"
+	}
+
+	return "this status requires a sandbox, but bubblewrap (bwrap) is not installed on the agent host"
+}
+
+// prefix returns the wrapper command line that precedes the quoted original
+// command. The original command is passed as $1 so it is quoted only once.
+func (sb *bashSandbox) prefix() string {
+	args := []string{
+		shellQuote(sb.bwrapPath),
+		"--ro-bind", "/", "/",
+		"--dev", "/dev",
+		"--proc", "/proc",
+		"--tmpfs", "/tmp",
+	}
+	for _, d := range sb.writableDirs {
+		args = append(args, "--bind-try", shellQuote(d), shellQuote(d))
+	}
+
+	if !sb.cfg.AllowNetwork {
+		args = append(args, "--unshare-net")
+	}
+
+	args = append(args, "--unshare-pid", "--die-with-parent", "--", "/bin/bash", "-c")
+
+	var script []string
+	if sb.cfg.CPULimitSeconds > 0 {
+		script = append(script, fmt.Sprintf("ulimit -t %d", sb.cfg.CPULimitSeconds))
+	}
+
+	if sb.cfg.MemoryLimitMB > 0 {
+		script = append(script, fmt.Sprintf("ulimit -v %d", int64(sb.cfg.MemoryLimitMB)*1024))
+	}
+
+	if sb.cfg.TimeoutSeconds > 0 {
+		script = append(script, fmt.Sprintf(`exec timeout %d /bin/bash -c "$1"`, sb.cfg.TimeoutSeconds))
+	} else {
+		script = append(script, `exec /bin/bash -c "$1"`)
+	}
+
+	args = append(args, shellQuote(strings.Join(script, "; ")), "taskguild-sandbox")
+
+	return strings.Join(args, " ")
+}
+
+// wrap returns command confined to the sandbox.
+func (sb *bashSandbox) wrap(command string) string {
+	return sb.prefix() + " " + shellQuote(command)
+}
+
+// unwrap returns the original command if command is exactly a wrapper
+// produced by wrap. Anything else is returned unchanged with ok=false.
+func (sb *bashSandbox) unwrap(command string) (string, bool) {
+	prefix := sb.prefix() + " "
+
+	rest, found := strings.CutPrefix(command, prefix)
+	if !found {
+		return command, false
+	}
+
+	orig, ok := shellUnquote(rest)
+	if !ok || sb.wrap(orig) != command {
+		return command, false
+	}
+
+	return orig, true
+}
+
+// wrapInput returns a copy of a Bash tool input with the command sandboxed.
+// Inputs that are already wrapped are returned as-is.
+func (sb *bashSandbox) wrapInput(input map[string]any) map[string]any {
+	cmd, _ := input["command"].(string)
+	if cmd == "" {
+		return input
+	}
+
+	if _, ok := sb.unwrap(cmd); ok {
+		return input
+	}
+
+	out := make(map[string]any, len(input))
+	for k, v := range input {
+		out[k] = v
+	}
+
+	out["command"] = sb.wrap(cmd)
+
+	return out
+}
+
+// unwrapInput returns a copy of a Bash tool input with the original command
+// restored, so permission checks and logs see what the agent asked to run.
+func (sb *bashSandbox) unwrapInput(input map[string]any) map[string]any {
+	cmd, _ := input["command"].(string)
+
+	orig, ok := sb.unwrap(cmd)
+	if !ok {
+		return input
+	}
+
+	out := make(map[string]any, len(input))
+	for k, v := range input {
+		out[k] = v
+	}
+
+	out["command"] = orig
+
+	return out
+}
+
+// sandboxViolation patterns, checked against Bash tool output.
+var sandboxViolationPatterns = []struct {
+	kind    string
+	pattern string
+}{
+	{"filesystem", "read-only file system"},
+	{"network", "network is unreachable"},
+	{"network", "could not resolve host"},
+	{"network", "temporary failure in name resolution"},
+	{"cpu", "cpu time limit exceeded"},
+	{"memory", "cannot allocate memory"},
+	{"memory", "out of memory"},
+}
+
+// detectViolation inspects Bash tool output for signs that the sandbox
+// blocked an operation. Returns the violation kind ("filesystem", "network",
+// "cpu", "memory", "timeout") or "" if none was detected.
+func (sb *bashSandbox) detectViolation(output string) string {
+	lower := strings.ToLower(output)
+	for _, p := range sandboxViolationPatterns {
+		if p.kind == "network" && sb.cfg.AllowNetwork {
+			continue
+		}
+
+		if strings.Contains(lower, p.pattern) {
+			return p.kind
+		}
+	}
+
+	if sb.cfg.TimeoutSeconds > 0 && strings.Contains(lower, "exit code 124") {
+		return "timeout"
+	}
+
+	return ""
+}
+
+// shellQuote quotes s for safe use as a single POSIX shell word.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// shellUnquote reverses shellQuote. Returns false if s is not a single word
+// in the exact form produced by shellQuote.
+func shellUnquote(s string) (string, bool) {
+	if len(s) < 2 || s[0] != '\'' || s[len(s)-1] != '\'' {
+		return "", false
+	}
+
+	orig := strings.ReplaceAll(s[1:len(s)-1], `'\''`, "'")
+	if shellQuote(orig) != s {
+		return "", false
+	}
+
+	return orig, true
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSandbox(cfg sandboxConfig) *bashSandbox {
+	return &bashSandbox{
+		cfg:          cfg,
+		writableDirs: []string{"/repo/.claude/worktrees/wt", "/repo/.git"},
+		bwrapPath:    "/usr/bin/bwrap",
+	}
+}
+
+func TestNewBashSandbox_Disabled(t *testing.T) {
+	assert.Nil(t, newBashSandbox(map[string]string{}, "/repo", "/repo"))
+}
+
+func TestNewBashSandbox_WritableDirs(t *testing.T) {
+	sb := newBashSandbox(map[string]string{
+		"_sandbox": `{"writable_paths":["/home/u/.cache","/repo/.git"]}`,
+	}, "/repo/.claude/worktrees/wt", "/repo")
+	require.NotNil(t, sb)
+	assert.Equal(t, []string{"/repo/.claude/worktrees/wt", "/repo/.git", "/home/u/.cache"}, sb.writableDirs)
+}
+
+func TestBashSandbox_WrapUnwrap(t *testing.T) {
+	sb := testSandbox(sandboxConfig{CPULimitSeconds: 60, MemoryLimitMB: 512, TimeoutSeconds: 300})
+
+	for _, cmd := range []string{
+		"go test ./...",
+		`echo 'it'"'"'s' && grep -r "x" .`,
+		"",
+	} {
+		wrapped := sb.wrap(cmd)
+		orig, ok := sb.unwrap(wrapped)
+		require.True(t, ok, cmd)
+		assert.Equal(t, cmd, orig)
+	}
+
+	wrapped := sb.wrap("ls")
+	assert.Contains(t, wrapped, "--ro-bind / /")
+	assert.Contains(t, wrapped, "--bind-try '/repo/.git' '/repo/.git'")
+	assert.Contains(t, wrapped, "--unshare-net")
+	assert.Contains(t, wrapped, "ulimit -t 60")
+	assert.Contains(t, wrapped, "ulimit -v 524288")
+	assert.Contains(t, wrapped, "timeout 300")
+}
+
+func TestBashSandbox_UnwrapRejectsForgedWrapper(t *testing.T) {
+	sb := testSandbox(sandboxConfig{})
+
+	_, ok := sb.unwrap("ls")
+	assert.False(t, ok)
+
+	// Appending a second command after a valid wrapper must not be unwrapped.
+	_, ok = sb.unwrap(sb.wrap("ls") + "; rm -rf /")
+	assert.False(t, ok)
+}
+
+func TestBashSandbox_WrapInputIdempotent(t *testing.T) {
+	sb := testSandbox(sandboxConfig{AllowNetwork: true})
+
+	in := map[string]any{"command": "make", "timeout": 1000}
+	once := sb.wrapInput(in)
+	twice := sb.wrapInput(once)
+
+	assert.Equal(t, once, twice)
+	assert.Equal(t, "make", in["command"], "input must not be mutated")
+	assert.NotContains(t, once["command"], "--unshare-net")
+	assert.Equal(t, in, sb.unwrapInput(once))
+}
+
+func TestBashSandbox_DetectViolation(t *testing.T) {
+	sb := testSandbox(sandboxConfig{TimeoutSeconds: 10})
+
+	assert.Equal(t, "filesystem", sb.detectViolation("touch: cannot touch '/etc/x': Read-only file system"))
+	assert.Equal(t, "network", sb.detectViolation("curl: (6) Could not resolve host: example.com"))
+	assert.Equal(t, "timeout", sb.detectViolation("Exit code 124"))
+	assert.Empty(t, sb.detectViolation("ok"))
+
+	netSB := testSandbox(sandboxConfig{AllowNetwork: true})
+	assert.Empty(t, netSB.detectViolation("curl: (6) Could not resolve host: example.com"))
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"connectrpc.com/connect"
+
+	"github.com/kazz187/taskguild/pkg/clog"
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
+)
+
+// claudeConfigDir returns the Claude CLI config directory (CLAUDE_CONFIG_DIR
+// or ~/.claude).
+func claudeConfigDir() string {
+	if dir := os.Getenv("CLAUDE_CONFIG_DIR"); dir != "" {
+		return dir
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".claude")
+}
+
+// sanitizeClaudeProjectPath converts a working directory to the directory
+// name Claude CLI uses under ~/.claude/projects (every character other than
+// an ASCII letter or digit becomes '-').
+func sanitizeClaudeProjectPath(cwd string) string {
+	b := []byte(cwd)
+	for i, c := range b {
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9') {
+			b[i] = '-'
+		}
+	}
+
+	return string(b)
+}
+
+// sessionTranscriptPath returns where Claude CLI keeps the transcript of a
+// session started in cwd.
+func sessionTranscriptPath(cwd, sessionID string) string {
+	dir := claudeConfigDir()
+	if dir == "" || sessionID == "" || filepath.Base(sessionID) != sessionID {
+		return ""
+	}
+
+	return filepath.Join(dir, "projects", sanitizeClaudeProjectPath(cwd), sessionID+".jsonl")
+}
+
+// transcriptUploader uploads session transcripts after each turn so another
+// agent manager can resume the session. Unchanged transcripts are skipped.
+type transcriptUploader struct {
+	client taskguildv1connect.AgentManagerServiceClient
+	taskID string
+	tl     *taskLogger
+
+	lastSize map[string]int64 // sessionID -> size of the last upload
+	disabled bool             // server has no transcript storage
+}
+
+func newTranscriptUploader(client taskguildv1connect.AgentManagerServiceClient, taskID string, tl *taskLogger) *transcriptUploader {
+	return &transcriptUploader{
+		client:   client,
+		taskID:   taskID,
+		tl:       tl,
+		lastSize: make(map[string]int64),
+	}
+}
+
+// upload sends the transcript of sessionID (started in cwd) to the server.
+// Failures are logged and otherwise ignored: resume on the same machine does
+// not depend on the upload.
+func (u *transcriptUploader) upload(ctx context.Context, cwd, sessionID string) {
+	logger := clog.LoggerFromContext(ctx)
+
+	path := sessionTranscriptPath(cwd, sessionID)
+	if u.disabled || path == "" {
+		return
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		logger.Debug("no local session transcript to upload", "session_id", sessionID, "error", err)
+		return
+	}
+
+	if size, ok := u.lastSize[sessionID]; ok && size == int64(len(raw)) {
+		return
+	}
+
+	var buf bytes.Buffer
+
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(raw); err != nil {
+		logger.Warn("failed to compress session transcript", "error", err)
+		return
+	}
+
+	if err := zw.Close(); err != nil {
+		logger.Warn("failed to compress session transcript", "error", err)
+		return
+	}
+
+	_, err = u.client.UploadSessionTranscript(ctx, connect.NewRequest(&v1.UploadSessionTranscriptRequest{
+		TaskId:           u.taskID,
+		SessionId:        sessionID,
+		Data:             buf.Bytes(),
+		UncompressedSize: int64(len(raw)),
+	}))
+
+	switch connect.CodeOf(err) {
+	case connect.CodeUnimplemented:
+		u.disabled = true
+		logger.Debug("server does not store session transcripts", "error", err)
+	case connect.CodeResourceExhausted:
+		// Remember the size so the warning is not repeated until it changes.
+		u.lastSize[sessionID] = int64(len(raw))
+		u.tl.Log(v1.TaskLogCategory_TASK_LOG_CATEGORY_SYSTEM, v1.TaskLogLevel_TASK_LOG_LEVEL_WARN,
+			"Session transcript is too large to upload; the session can only be resumed on this machine",
+			map[string]string{"session_id": sessionID, "size_bytes": strconv.Itoa(buf.Len())})
+	default:
+		if err != nil {
+			logger.Warn("failed to upload session transcript", "session_id", sessionID, "error", err)
+			return
+		}
+
+		u.lastSize[sessionID] = int64(len(raw))
+		logger.Debug("session transcript uploaded", "session_id", sessionID, "size_bytes", buf.Len())
+	}
+}
+
+// restoreSessionTranscript downloads the transcript of sessionID from the
+// server when it is missing locally (e.g. the task previously ran on another
+// machine), so the session can be resumed in cwd. Returns true if a
+// transcript was restored.
+func restoreSessionTranscript(ctx context.Context, client taskguildv1connect.AgentManagerServiceClient, taskID, cwd, sessionID string) (bool, error) {
+	path := sessionTranscriptPath(cwd, sessionID)
+	if path == "" {
+		return false, nil
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		return false, nil
+	}
+
+	resp, err := client.DownloadSessionTranscript(ctx, connect.NewRequest(&v1.DownloadSessionTranscriptRequest{
+		TaskId:    taskID,
+		SessionId: sessionID,
+	}))
+	if err != nil {
+		if code := connect.CodeOf(err); code == connect.CodeNotFound || code == connect.CodeUnimplemented {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("download session transcript: %w", err)
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(resp.Msg.GetData()))
+	if err != nil {
+		return false, fmt.Errorf("decompress session transcript: %w", err)
+	}
+
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return false, fmt.Errorf("decompress session transcript: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return false, fmt.Errorf("create session directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, raw, 0o600); err != nil {
+		return false, fmt.Errorf("write session transcript: %w", err)
+	}
+
+	return true, nil
+}
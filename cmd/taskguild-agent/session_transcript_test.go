@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitizeClaudeProjectPath(t *testing.T) {
+	assert.Equal(t, "-root-module", sanitizeClaudeProjectPath("/root/module"))
+	assert.Equal(t, "-src-my-app--claude-worktrees-fix-bug", sanitizeClaudeProjectPath("/src/my_app/.claude/worktrees/fix-bug"))
+}
+
+func TestSessionTranscriptPath(t *testing.T) {
+	t.Setenv("CLAUDE_CONFIG_DIR", "/home/u/.claude")
+
+	assert.Equal(t, "/home/u/.claude/projects/-src-app/sess-1.jsonl", sessionTranscriptPath("/src/app", "sess-1"))
+	assert.Empty(t, sessionTranscriptPath("/src/app", "../evil"))
+	assert.Empty(t, sessionTranscriptPath("/src/app", ""))
+}
+
+func TestSessionTranscript_UploadAndRestoreOnAnotherMachine(t *testing.T) {
+	tc := newTestClients()
+	defer tc.Close()
+
+	ctx := context.Background()
+	transcript := []byte(`{"type":"user","message":"hello"}` + "\n")
+
+	// Machine A: the session lives in its Claude config dir.
+	t.Setenv("CLAUDE_CONFIG_DIR", t.TempDir())
+
+	srcPath := sessionTranscriptPath("/work/a", "sess-1")
+	require.NoError(t, os.MkdirAll(filepath.Dir(srcPath), 0o700))
+	require.NoError(t, os.WriteFile(srcPath, transcript, 0o600))
+
+	tl := newTaskLogger(ctx, tc.agentClient, "task-1")
+	defer tl.Close()
+
+	newTranscriptUploader(tc.agentClient, "task-1", tl).upload(ctx, "/work/a", "sess-1")
+	require.Contains(t, tc.agentHandler.transcripts, "sess-1")
+
+	// Machine B: different config dir and working directory.
+	t.Setenv("CLAUDE_CONFIG_DIR", t.TempDir())
+
+	restored, err := restoreSessionTranscript(ctx, tc.agentClient, "task-1", "/work/b", "sess-1")
+	require.NoError(t, err)
+	assert.True(t, restored)
+
+	got, err := os.ReadFile(sessionTranscriptPath("/work/b", "sess-1"))
+	require.NoError(t, err)
+	assert.Equal(t, transcript, got)
+
+	// Already present locally: nothing to do.
+	restored, err = restoreSessionTranscript(ctx, tc.agentClient, "task-1", "/work/b", "sess-1")
+	require.NoError(t, err)
+	assert.False(t, restored)
+
+	// Unknown session: not an error.
+	restored, err = restoreSessionTranscript(ctx, tc.agentClient, "task-1", "/work/b", "sess-unknown")
+	require.NoError(t, err)
+	assert.False(t, restored)
+}
@@ -2,55 +2,24 @@ package main
 
 import (
 	"context"
-	"errors"
 	"log/slog"
-	"regexp"
-	"strings"
 	"sync"
+	"time"
 
 	"connectrpc.com/connect"
 
+	"github.com/kazz187/taskguild/pkg/permcheck"
 	"github.com/kazz187/taskguild/pkg/shellparse"
 	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
 	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
 )
 
-// wildcardToRegex converts a wildcard (glob-like) pattern to a Go regular expression.
-// The only wildcard character is `*`, which matches zero or more arbitrary characters.
-// All other characters are escaped so they are treated literally.
-func wildcardToRegex(pattern string) string {
-	parts := strings.Split(pattern, "*")
-	for i, p := range parts {
-		parts[i] = regexp.QuoteMeta(p)
-	}
-
-	return "(?s)^" + strings.Join(parts, ".*") + "$"
-}
-
-// compileWildcard converts a wildcard pattern string to a compiled *regexp.Regexp.
-// Returns an error if the pattern is empty or the resulting regex is invalid.
-func compileWildcard(pattern string) (*regexp.Regexp, error) {
-	if pattern == "" {
-		return nil, errors.New("empty pattern")
-	}
-
-	return regexp.Compile(wildcardToRegex(pattern))
-}
-
-// compiledPattern holds a pre-compiled wildcard pattern with its metadata.
-type compiledPattern struct {
-	id    string
-	raw   string
-	regex *regexp.Regexp
-	ptype string // "command" or "redirect"
-}
-
 // singleCommandPermissionCache maintains an in-memory cache of wildcard-based
 // permission rules for individual shell commands. It is used to check whether
 // each command in a parsed one-liner is allowed.
 type singleCommandPermissionCache struct {
 	mu          sync.RWMutex
-	patterns    []compiledPattern
+	patterns    permcheck.CommandRules
 	projectName string
 	client      taskguildv1connect.AgentManagerServiceClient
 }
@@ -68,20 +37,29 @@ func (c *singleCommandPermissionCache) Update(perms []*v1.SingleCommandPermissio
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	compiled := make([]compiledPattern, 0, len(perms))
+	compiled := make(permcheck.CommandRules, 0, len(perms))
 	for _, p := range perms {
-		re, err := compileWildcard(p.GetPattern())
-		if err != nil {
+		r := permcheck.CommandRule{
+			ID:         p.GetId(),
+			Pattern:    p.GetPattern(),
+			Type:       p.GetType(),
+			Deny:       p.GetAction() == "deny",
+			Reason:     p.GetReason(),
+			Scope:      p.GetScope(),
+			TaskID:     p.GetTaskId(),
+			WorkflowID: p.GetWorkflowId(),
+			StatusName: p.GetStatusName(),
+		}
+		if p.GetExpiresAt() != nil {
+			r.ExpiresAt = p.GetExpiresAt().AsTime()
+		}
+
+		if err := r.Compile(); err != nil {
 			slog.Warn("skipping invalid wildcard pattern", "pattern", p.GetPattern(), "error", err)
 			continue
 		}
 
-		compiled = append(compiled, compiledPattern{
-			id:    p.GetId(),
-			raw:   p.GetPattern(),
-			regex: re,
-			ptype: p.GetType(),
-		})
+		compiled = append(compiled, r)
 	}
 
 	c.patterns = compiled
@@ -102,127 +80,35 @@ func (c *singleCommandPermissionCache) Sync(ctx context.Context) {
 	c.Update(resp.Msg.GetPermissions())
 }
 
-// commandCheckResult holds the result of checking a single command.
-type commandCheckResult struct {
-	Command          string `json:"command"`
-	Matched          bool   `json:"matched"`
-	MatchedPattern   string `json:"matched_pattern,omitempty"`
-	SuggestedPattern string `json:"suggested_pattern,omitempty"`
-}
-
-// redirectCheckResult holds the result of checking a single redirect.
-type redirectCheckResult struct {
-	Operator         string `json:"operator"`
-	Path             string `json:"path"`
-	Matched          bool   `json:"matched"`
-	MatchedPattern   string `json:"matched_pattern,omitempty"`
-	SuggestedPattern string `json:"suggested_pattern,omitempty"`
-}
-
-// bashPermissionMetadata is the structured metadata attached to a Bash
-// permission request interaction.
-type bashPermissionMetadata struct {
-	ParsedCommands []commandCheckResult  `json:"parsed_commands"`
-	Redirects      []redirectCheckResult `json:"redirects"`
-}
-
-// CheckCommand checks a command string against all "command" type patterns.
-// Returns whether it matched and the matching pattern string.
-func (c *singleCommandPermissionCache) CheckCommand(command string) (matched bool, pattern string) {
+// Rules returns a snapshot of the cached rules for permcheck.Evaluate.
+func (c *singleCommandPermissionCache) Rules() permcheck.CommandRules {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	for _, p := range c.patterns {
-		if p.ptype != "command" {
-			continue
-		}
-
-		if p.regex.MatchString(command) {
-			return true, p.raw
-		}
-	}
-
-	return false, ""
+	return c.patterns
 }
 
-// CheckRedirect checks a redirect path against all "redirect" type patterns.
-func (c *singleCommandPermissionCache) CheckRedirect(path string) (matched bool, pattern string) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	for _, p := range c.patterns {
-		if p.ptype != "redirect" {
-			continue
-		}
-
-		if p.regex.MatchString(path) {
-			return true, p.raw
-		}
-	}
-
-	return false, ""
+// CheckCommand checks a command string against all project-wide "command"
+// type allow patterns. Returns whether it matched and the matching pattern
+// string.
+func (c *singleCommandPermissionCache) CheckCommand(command string) (matched bool, pattern string) {
+	return c.Rules().CheckAllow(permcheck.TypeCommand, command, permcheck.Scope{}, time.Now())
 }
 
-// CheckAllCommands checks all parsed commands and their redirects.
-// Returns true if ALL are matched (auto-allow), and the metadata for the
-// interaction UI.
-func (c *singleCommandPermissionCache) CheckAllCommands(parsed *shellparse.ParseResult) (allMatched bool, meta *bashPermissionMetadata) {
-	meta = &bashPermissionMetadata{}
-	allMatched = true
-
-	for _, cmd := range parsed.Commands {
-		matched, pattern := c.CheckCommand(cmd.Raw)
-
-		result := commandCheckResult{
-			Command: cmd.Raw,
-			Matched: matched,
-		}
-		if matched {
-			result.MatchedPattern = pattern
-		} else {
-			allMatched = false
-			result.SuggestedPattern = SuggestCommandPattern(cmd)
-		}
-
-		meta.ParsedCommands = append(meta.ParsedCommands, result)
-
-		// Check each redirect.
-		for _, redir := range cmd.Redirects {
-			if redir.Path == "" {
-				continue
-			}
-
-			rMatched, rPattern := c.CheckRedirect(redir.Path)
-
-			rResult := redirectCheckResult{
-				Operator: redir.Op,
-				Path:     redir.Path,
-				Matched:  rMatched,
-			}
-			if rMatched {
-				rResult.MatchedPattern = rPattern
-			} else {
-				allMatched = false
-				rResult.SuggestedPattern = SuggestRedirectPattern(redir.Path)
-			}
-
-			meta.Redirects = append(meta.Redirects, rResult)
-		}
-	}
-
-	return allMatched, meta
+// CheckRedirect checks a redirect path against all project-wide "redirect"
+// type allow patterns.
+func (c *singleCommandPermissionCache) CheckRedirect(path string) (matched bool, pattern string) {
+	return c.Rules().CheckAllow(permcheck.TypeRedirect, path, permcheck.Scope{}, time.Now())
 }
 
-// SuggestCommandPattern generates a suggested wildcard pattern for a command.
-// Returns the full command string as-is so users can see the exact command
-// and manually generalize it with wildcards (e.g. *) if desired.
-func SuggestCommandPattern(cmd shellparse.ParsedCommand) string {
-	return cmd.Raw
+// CheckDenied reports whether any parsed command or redirect matches a deny
+// rule, and the reason to give the agent. Deny rules win over allow rules.
+func (c *singleCommandPermissionCache) CheckDenied(parsed *shellparse.ParseResult) (denied bool, reason string) {
+	return c.Rules().CheckDenied(parsed)
 }
 
-// SuggestRedirectPattern generates a suggested wildcard pattern for a redirect path.
-// Returns the exact path so users can see the full path and manually generalize
-// it with wildcards (e.g. *) if desired.
-func SuggestRedirectPattern(path string) string {
-	return path
+// CheckAllCommands checks all parsed commands and their redirects against
+// the allow patterns that apply to scope. See permcheck.CommandRules.
+func (c *singleCommandPermissionCache) CheckAllCommands(parsed *shellparse.ParseResult, scope permcheck.Scope) (allMatched bool, meta *permcheck.BashMetadata) {
+	return c.Rules().CheckAllCommands(parsed, scope, time.Now())
 }
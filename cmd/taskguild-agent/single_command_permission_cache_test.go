@@ -1,66 +1,17 @@
 package main
 
 import (
+	"strings"
 	"testing"
+	"time"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kazz187/taskguild/pkg/permcheck"
 	"github.com/kazz187/taskguild/pkg/shellparse"
 	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
 )
 
-func TestWildcardToRegex(t *testing.T) {
-	tests := []struct {
-		pattern  string
-		input    string
-		expected bool
-	}{
-		// Exact match
-		{"git status", "git status", true},
-		{"git status", "git push", false},
-		// Trailing wildcard
-		{"git *", "git status", true},
-		{"git *", "git commit -m hello", true},
-		{"git *", "git", false}, // * matches zero or more chars after "git "
-		{"cd *", "cd /home/user/project", true},
-		// Middle wildcard
-		{"docker * build", "docker compose build", true},
-		{"docker * build", "docker build", false}, // "docker " + "" + " build" = "docker  build"
-		// Multiple wildcards
-		{"git * --* *", "git commit --amend foo", true},
-		// Wildcard only
-		{"*", "anything at all", true},
-		{"*", "", true},
-		// Multiline commands (heredoc-style)
-		{"git commit -m *", "git commit -m \"$(cat <<'EOF'\nfix: some message\n\nCo-Authored-By: Claude <noreply@anthropic.com>\nEOF\n)\"", true},
-		{"git *", "git commit -m \"$(cat <<'EOF'\nfix: message\nEOF\n)\"", true},
-		// Special regex characters in pattern are escaped
-		{"npm test (coverage)", "npm test (coverage)", true},
-		{"file.txt", "fileTtxt", false}, // dot is literal, not regex dot
-		{"a+b", "a+b", true},
-		{"a+b", "aab", false},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.pattern+"_vs_"+tt.input, func(t *testing.T) {
-			re, err := compileWildcard(tt.pattern)
-			if err != nil {
-				t.Fatalf("compileWildcard(%q) error: %v", tt.pattern, err)
-			}
-
-			matched := re.MatchString(tt.input)
-			if matched != tt.expected {
-				t.Errorf("compileWildcard(%q).MatchString(%q) = %v, want %v", tt.pattern, tt.input, matched, tt.expected)
-			}
-		})
-	}
-}
-
-func TestCompileWildcard_Empty(t *testing.T) {
-	_, err := compileWildcard("")
-	if err == nil {
-		t.Error("expected error for empty pattern")
-	}
-}
-
 func TestSingleCommandPermissionCache_CheckCommand(t *testing.T) {
 	cache := newSingleCommandPermissionCache("test-project", nil)
 	cache.Update([]*v1.SingleCommandPermission{
@@ -133,7 +84,7 @@ func TestSingleCommandPermissionCache_CheckAllCommands(t *testing.T) {
 	t.Run("all matched", func(t *testing.T) {
 		parsed := shellparse.Parse("cd /home/user && git status")
 
-		allMatched, meta := cache.CheckAllCommands(parsed)
+		allMatched, meta := cache.CheckAllCommands(parsed, permcheck.Scope{})
 		if !allMatched {
 			t.Error("expected all commands to match")
 		}
@@ -146,7 +97,7 @@ func TestSingleCommandPermissionCache_CheckAllCommands(t *testing.T) {
 	t.Run("partial match", func(t *testing.T) {
 		parsed := shellparse.Parse("cd /home/user && npm test")
 
-		allMatched, meta := cache.CheckAllCommands(parsed)
+		allMatched, meta := cache.CheckAllCommands(parsed, permcheck.Scope{})
 		if allMatched {
 			t.Error("expected not all commands to match")
 		}
@@ -171,7 +122,7 @@ func TestSingleCommandPermissionCache_CheckAllCommands(t *testing.T) {
 	t.Run("with redirect", func(t *testing.T) {
 		parsed := shellparse.Parse("cd /home/user && git status > /dev/null")
 
-		allMatched, meta := cache.CheckAllCommands(parsed)
+		allMatched, meta := cache.CheckAllCommands(parsed, permcheck.Scope{})
 		if !allMatched {
 			t.Error("expected all to match (including redirect)")
 		}
@@ -184,7 +135,7 @@ func TestSingleCommandPermissionCache_CheckAllCommands(t *testing.T) {
 	t.Run("unmatched redirect", func(t *testing.T) {
 		parsed := shellparse.Parse("echo hello > /etc/output.txt")
 
-		allMatched, meta := cache.CheckAllCommands(parsed)
+		allMatched, meta := cache.CheckAllCommands(parsed, permcheck.Scope{})
 		if allMatched {
 			t.Error("expected not all to match (unknown redirect)")
 		}
@@ -201,95 +152,145 @@ func TestSingleCommandPermissionCache_CheckAllCommands(t *testing.T) {
 			t.Error("expected at least one unmatched redirect")
 		}
 	})
+
+	t.Run("opaque command is never auto-allowed", func(t *testing.T) {
+		wide := newSingleCommandPermissionCache("test-project", nil)
+		wide.Update([]*v1.SingleCommandPermission{
+			{Id: "1", Pattern: "*", Type: "command"},
+		})
+
+		parsed := shellparse.Parse("X=rm; $X -rf build")
+
+		allMatched, meta := wide.CheckAllCommands(parsed, permcheck.Scope{})
+		if allMatched {
+			t.Error("expected opaque command not to be auto-allowed")
+		}
+
+		last := meta.ParsedCommands[len(meta.ParsedCommands)-1]
+		if last.Matched || !last.Opaque || last.OpaqueReason == "" {
+			t.Errorf("expected unmatched opaque result with reason, got %+v", last)
+		}
+	})
+
+	t.Run("nested command must match", func(t *testing.T) {
+		parsed := shellparse.Parse("cd /tmp && bash -c 'git status'")
+
+		withBash := newSingleCommandPermissionCache("test-project", nil)
+		withBash.Update([]*v1.SingleCommandPermission{
+			{Id: "1", Pattern: "cd *", Type: "command"},
+			{Id: "2", Pattern: "bash -c *", Type: "command"},
+		})
+
+		if allMatched, _ := withBash.CheckAllCommands(parsed, permcheck.Scope{}); allMatched {
+			t.Error("expected nested 'git status' to require its own rule")
+		}
+
+		withBash.Update([]*v1.SingleCommandPermission{
+			{Id: "1", Pattern: "cd *", Type: "command"},
+			{Id: "2", Pattern: "bash -c *", Type: "command"},
+			{Id: "3", Pattern: "git status", Type: "command"},
+		})
+
+		if allMatched, _ := withBash.CheckAllCommands(parsed, permcheck.Scope{}); !allMatched {
+			t.Error("expected all commands including nested one to match")
+		}
+	})
+}
+
+func TestSingleCommandPermissionCache_EmptyPattern(t *testing.T) {
+	cache := newSingleCommandPermissionCache("test-project", nil)
+	cache.Update([]*v1.SingleCommandPermission{
+		{Id: "1", Pattern: "", Type: "command"},      // empty - should be skipped
+		{Id: "2", Pattern: "git *", Type: "command"}, // valid
+	})
+
+	// Should only have 1 valid pattern.
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+
+	if len(cache.patterns) != 1 {
+		t.Errorf("expected 1 valid pattern, got %d", len(cache.patterns))
+	}
 }
 
-func TestSuggestCommandPattern(t *testing.T) {
+func TestSingleCommandPermissionCache_CheckDenied(t *testing.T) {
+	cache := newSingleCommandPermissionCache("test-project", nil)
+	cache.Update([]*v1.SingleCommandPermission{
+		{Id: "1", Pattern: "git *", Type: "command"},
+		{Id: "2", Pattern: "git push --force*", Type: "command", Action: "deny", Reason: "never rewrite shared history"},
+		{Id: "3", Pattern: "/etc/*", Type: "redirect", Action: "deny"},
+	})
+
 	tests := []struct {
-		name     string
-		cmd      shellparse.ParsedCommand
-		expected string
+		name    string
+		command string
+		denied  bool
 	}{
-		{
-			name:     "returns raw command as-is",
-			cmd:      shellparse.ParsedCommand{Raw: "git status", Executable: "git", Args: []string{"status"}},
-			expected: "git status",
-		},
-		{
-			name:     "returns full command with args",
-			cmd:      shellparse.ParsedCommand{Raw: "git checkout -b feature", Executable: "git", Args: []string{"checkout", "-b", "feature"}},
-			expected: "git checkout -b feature",
-		},
-		{
-			name:     "npm test with coverage",
-			cmd:      shellparse.ParsedCommand{Raw: "npm test --coverage", Executable: "npm", Args: []string{"test", "--coverage"}},
-			expected: "npm test --coverage",
-		},
-		{
-			name:     "no args",
-			cmd:      shellparse.ParsedCommand{Raw: "ls", Executable: "ls"},
-			expected: "ls",
-		},
-		{
-			name:     "unknown command with args",
-			cmd:      shellparse.ParsedCommand{Raw: "myapp serve --port 8080", Executable: "myapp", Args: []string{"serve", "--port", "8080"}},
-			expected: "myapp serve --port 8080",
-		},
-		{
-			name:     "empty executable falls back to raw",
-			cmd:      shellparse.ParsedCommand{Raw: "some raw command"},
-			expected: "some raw command",
-		},
-		{
-			name:     "command with command substitution",
-			cmd:      shellparse.ParsedCommand{Raw: "cd $(git rev-parse --show-toplevel)/.claude/worktrees/foo", Executable: "cd", Args: []string{"$(git rev-parse --show-toplevel)/.claude/worktrees/foo"}},
-			expected: "cd $(git rev-parse --show-toplevel)/.claude/worktrees/foo",
-		},
+		{"allowed", "git push origin main", false},
+		{"denied flag last", "git push origin main --force", true},
+		{"denied in chain", "cd /repo && git push --force", true},
+		{"denied redirect", "echo x > /etc/hosts", true},
+		{"other redirect", "echo x > /tmp/out", false},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := SuggestCommandPattern(tt.cmd)
-			if result != tt.expected {
-				t.Errorf("SuggestCommandPattern() = %q, want %q", result, tt.expected)
+			denied, reason := cache.CheckDenied(shellparse.Parse(tt.command))
+			if denied != tt.denied {
+				t.Errorf("CheckDenied(%q) = %v (%s), want %v", tt.command, denied, reason, tt.denied)
 			}
 		})
 	}
-}
 
-func TestSuggestRedirectPattern(t *testing.T) {
-	tests := []struct {
-		path     string
-		expected string
-	}{
-		{"/dev/null", "/dev/null"},
-		{"./output.txt", "./output.txt"},
-		{"../output.txt", "../output.txt"},
-		{"/tmp/foo", "/tmp/foo"},
-		{"/etc/passwd", "/etc/passwd"},
+	// Deny rules never count as allow matches.
+	if matched, _ := cache.CheckCommand("git push --force"); !matched {
+		t.Error("CheckCommand should still match the allow rule")
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.path, func(t *testing.T) {
-			result := SuggestRedirectPattern(tt.path)
-			if result != tt.expected {
-				t.Errorf("SuggestRedirectPattern(%q) = %q, want %q", tt.path, result, tt.expected)
-			}
-		})
+	if matched, _ := cache.CheckRedirect("/etc/hosts"); matched {
+		t.Error("CheckRedirect matched a deny rule")
+	}
+
+	_, reason := cache.CheckDenied(shellparse.Parse("git push --force"))
+	if !strings.Contains(reason, "never rewrite shared history") {
+		t.Errorf("reason = %q, want rule reason", reason)
 	}
 }
 
-func TestSingleCommandPermissionCache_EmptyPattern(t *testing.T) {
+func TestSingleCommandPermissionCache_ScopedGrants(t *testing.T) {
 	cache := newSingleCommandPermissionCache("test-project", nil)
 	cache.Update([]*v1.SingleCommandPermission{
-		{Id: "1", Pattern: "", Type: "command"},      // empty - should be skipped
-		{Id: "2", Pattern: "git *", Type: "command"}, // valid
+		{Id: "1", Pattern: "npm test", Type: "command", Scope: "task", TaskId: "task-1"},
+		{Id: "2", Pattern: "go test *", Type: "command", Scope: "status", WorkflowId: "wf", StatusName: "Develop"},
+		{Id: "3", Pattern: "make", Type: "command", ExpiresAt: timestamppb.New(time.Now().Add(-time.Minute))},
+		{Id: "4", Pattern: "ls", Type: "command", ExpiresAt: timestamppb.New(time.Now().Add(time.Hour))},
 	})
 
-	// Should only have 1 valid pattern.
-	cache.mu.RLock()
-	defer cache.mu.RUnlock()
+	develop := permcheck.Scope{TaskID: "task-1", WorkflowID: "wf", StatusName: "Develop"}
+	review := permcheck.Scope{TaskID: "task-2", WorkflowID: "wf", StatusName: "Review"}
 
-	if len(cache.patterns) != 1 {
-		t.Errorf("expected 1 valid pattern, got %d", len(cache.patterns))
+	tests := []struct {
+		command string
+		scope   permcheck.Scope
+		want    bool
+	}{
+		{"npm test", develop, true},
+		{"npm test", review, false},
+		{"go test ./...", develop, true},
+		{"go test ./...", review, false},
+		{"make", develop, false},
+		{"ls", review, true},
+	}
+
+	for _, tt := range tests {
+		allMatched, _ := cache.CheckAllCommands(shellparse.Parse(tt.command), tt.scope)
+		if allMatched != tt.want {
+			t.Errorf("CheckAllCommands(%q, %+v) = %v, want %v", tt.command, tt.scope, allMatched, tt.want)
+		}
+	}
+
+	// Project-wide checks ignore task and status grants.
+	if matched, _ := cache.CheckCommand("npm test"); matched {
+		t.Error("CheckCommand matched a task-scoped grant")
 	}
 }
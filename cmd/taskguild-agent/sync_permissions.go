@@ -50,9 +50,14 @@ func syncPermissions(ctx context.Context, client taskguildv1connect.AgentManager
 	// Write merged permissions back to settings.json.
 	writeLocalPermissions(settingsPath, rawSettings, merged)
 
-	// Update the in-memory permission cache with the merged allow rules.
+	// Update the in-memory permission cache with the merged rules.
 	if cache != nil {
 		cache.Update(merged.GetAllow())
+		cache.UpdateAskDeny(merged.GetAsk(), merged.GetDeny())
+		cache.UpdateAutoAllowRisks(merged.GetAutoAllowRisks())
+		cache.UpdatePathPolicy(merged.GetPathPolicy())
+		cache.UpdateEgressPolicy(merged.GetEgressPolicy())
+		cache.UpdateRedactionPatterns(merged.GetRedactionPatterns())
 	}
 }
 
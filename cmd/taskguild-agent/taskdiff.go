@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
+)
+
+// taskDiffPathspec limits task diffs to the project files; .claude/ is
+// managed by Claude Code.
+var taskDiffPathspec = []string{"--", ".", ":(exclude).claude"}
+
+// handleTaskDiff computes the diff of a task's worktree and reports it.
+// Agent managers without the worktree reply with not_found so the server
+// does not wait for them.
+func handleTaskDiff(ctx context.Context, client taskguildv1connect.AgentManagerServiceClient, workDir string, cmd *v1.TaskDiffCommand) {
+	report := &v1.ReportTaskDiffRequest{
+		RequestId: cmd.GetRequestId(),
+		TaskId:    cmd.GetTaskId(),
+	}
+
+	wtDir := filepath.Join(workDir, ".claude", "worktrees", cmd.GetWorktreeName())
+	if info, err := os.Stat(wtDir); cmd.GetWorktreeName() == "" || err != nil || !info.IsDir() {
+		report.NotFound = true
+	} else if diff, err := computeTaskDiff(ctx, wtDir, cmd.GetBaseBranch(), int(cmd.GetMaxPatchBytes())); err != nil {
+		report.ErrorMessage = err.Error()
+	} else {
+		report.Diff = diff
+	}
+
+	if _, err := client.ReportTaskDiff(ctx, connect.NewRequest(report)); err != nil {
+		slog.Error("failed to report task diff", "task_id", cmd.GetTaskId(), "error", err)
+	}
+}
+
+// computeTaskDiff returns the diff of the working tree of dir (committed,
+// uncommitted and untracked changes) against its merge base with
+// baseBranch. An empty baseBranch is detected from the repository. The
+// patch is truncated to maxPatchBytes if it is positive.
+func computeTaskDiff(ctx context.Context, dir, baseBranch string, maxPatchBytes int) (*v1.TaskDiff, error) {
+	if baseBranch == "" {
+		baseBranch = detectDefaultBranch(ctx, dir)
+	}
+
+	head, err := gitOutput(ctx, dir, "rev-parse", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("resolve HEAD: %w", err)
+	}
+
+	var base string
+
+	for _, ref := range []string{"origin/" + baseBranch, baseBranch} {
+		if mb, err := gitOutput(ctx, dir, "merge-base", "HEAD", ref); err == nil && mb != "" {
+			base = mb
+			break
+		}
+	}
+
+	if base == "" {
+		return nil, fmt.Errorf("no merge base with %q", baseBranch)
+	}
+
+	tree, err := writeWorkingTree(ctx, dir, head)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &v1.TaskDiff{
+		BaseBranch: baseBranch,
+		BaseCommit: base,
+		HeadCommit: head,
+		CapturedAt: timestamppb.Now(),
+	}
+
+	diff.Branch, _ = gitOutput(ctx, dir, "branch", "--show-current")
+
+	if diff.Files, err = taskDiffFiles(ctx, dir, base, tree); err != nil {
+		return nil, err
+	}
+
+	for _, f := range diff.GetFiles() {
+		diff.Additions += f.GetAdditions()
+		diff.Deletions += f.GetDeletions()
+	}
+
+	if diff.Commits, err = taskDiffCommits(ctx, dir, base); err != nil {
+		return nil, err
+	}
+
+	patch, err := gitOutput(ctx, dir, append([]string{"diff", "--no-renames", base, tree}, taskDiffPathspec...)...)
+	if err != nil {
+		return nil, fmt.Errorf("diff: %w", err)
+	}
+
+	if maxPatchBytes > 0 && len(patch) > maxPatchBytes {
+		patch = patch[:maxPatchBytes]
+		if i := strings.LastIndexByte(patch, '\n'); i > 0 {
+			patch = patch[:i+1]
+		}
+
+		diff.PatchTruncated = true
+	}
+
+	diff.Patch = patch
+
+	uncommitted, err := gitOutput(ctx, dir, append([]string{"diff", "--name-only", head, tree}, taskDiffPathspec...)...)
+	if err != nil {
+		return nil, fmt.Errorf("diff: %w", err)
+	}
+
+	diff.HasUncommittedChanges = uncommitted != ""
+
+	return diff, nil
+}
+
+// taskDiffFiles returns the per-file stats of the diff between base and tree.
+func taskDiffFiles(ctx context.Context, dir, base, tree string) ([]*v1.TaskDiffFile, error) {
+	nameStatus, err := gitOutput(ctx, dir, append([]string{"diff", "--no-renames", "--name-status", "-z", base, tree}, taskDiffPathspec...)...)
+	if err != nil {
+		return nil, fmt.Errorf("diff --name-status: %w", err)
+	}
+
+	numstat, err := gitOutput(ctx, dir, append([]string{"diff", "--no-renames", "--numstat", "-z", base, tree}, taskDiffPathspec...)...)
+	if err != nil {
+		return nil, fmt.Errorf("diff --numstat: %w", err)
+	}
+
+	// --name-status -z: "<status>\0<path>\0" per file.
+	var files []*v1.TaskDiffFile
+
+	byPath := make(map[string]*v1.TaskDiffFile)
+
+	fields := strings.Split(strings.TrimRight(nameStatus, "\x00"), "\x00")
+	for i := 0; i+1 < len(fields); i += 2 {
+		f := &v1.TaskDiffFile{Status: fields[i], Path: fields[i+1]}
+		files = append(files, f)
+		byPath[f.Path] = f
+	}
+
+	// --numstat -z: "<added>\t<deleted>\t<path>\0" per file; "-" for binary.
+	for entry := range strings.SplitSeq(strings.TrimRight(numstat, "\x00"), "\x00") {
+		parts := strings.SplitN(entry, "\t", 3)
+		if len(parts) != 3 {
+			continue
+		}
+
+		f, ok := byPath[parts[2]]
+		if !ok {
+			continue
+		}
+
+		if parts[0] == "-" && parts[1] == "-" {
+			f.Binary = true
+			continue
+		}
+
+		added, _ := strconv.Atoi(parts[0])
+		deleted, _ := strconv.Atoi(parts[1])
+		f.Additions = int32(added)
+		f.Deletions = int32(deleted)
+	}
+
+	return files, nil
+}
+
+// taskDiffCommits returns the commits on HEAD since base, newest first.
+func taskDiffCommits(ctx context.Context, dir, base string) ([]*v1.TaskDiffCommit, error) {
+	out, err := gitOutput(ctx, dir, "log", "--format=%H%x1f%an%x1f%ct%x1f%s", base+"..HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("log: %w", err)
+	}
+
+	if out == "" {
+		return nil, nil
+	}
+
+	var commits []*v1.TaskDiffCommit
+
+	for line := range strings.SplitSeq(out, "\n") {
+		parts := strings.SplitN(line, "\x1f", 4)
+		if len(parts) != 4 {
+			continue
+		}
+
+		c := &v1.TaskDiffCommit{Sha: parts[0], Author: parts[1], Subject: parts[3]}
+		if sec, err := strconv.ParseInt(parts[2], 10, 64); err == nil {
+			c.CommittedAt = timestamppb.New(time.Unix(sec, 0))
+		}
+
+		commits = append(commits, c)
+	}
+
+	return commits, nil
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func TestComputeTaskDiff(t *testing.T) {
+	dir := t.TempDir()
+	git := newTestGitRepo(t, dir)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a\nb\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "old.txt"), []byte("old\n"), 0o644))
+	git("add", ".")
+	git("commit", "-q", "-m", "initial")
+	git("checkout", "-q", "-b", "worktree-feature")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a\nchanged\nc\n"), 0o644))
+	git("rm", "-q", "old.txt")
+	git("commit", "-q", "-am", "change a")
+
+	// Uncommitted and untracked changes are part of the diff; .claude/ is not.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "new.txt"), []byte("new\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".claude"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".claude", "settings.local.json"), []byte("{}\n"), 0o644))
+
+	diff, err := computeTaskDiff(context.Background(), dir, "main", 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, "main", diff.GetBaseBranch())
+	assert.Equal(t, "worktree-feature", diff.GetBranch())
+	assert.True(t, diff.GetHasUncommittedChanges())
+
+	files := make(map[string]*v1.TaskDiffFile)
+	for _, f := range diff.GetFiles() {
+		files[f.GetPath()] = f
+	}
+
+	require.Len(t, files, 3)
+	assert.Equal(t, "M", files["a.txt"].GetStatus())
+	assert.Equal(t, int32(2), files["a.txt"].GetAdditions())
+	assert.Equal(t, int32(1), files["a.txt"].GetDeletions())
+	assert.Equal(t, "D", files["old.txt"].GetStatus())
+	assert.Equal(t, "A", files["new.txt"].GetStatus())
+	assert.Equal(t, int32(3), diff.GetAdditions())
+	assert.Equal(t, int32(2), diff.GetDeletions())
+
+	require.Len(t, diff.GetCommits(), 1)
+	assert.Equal(t, "change a", diff.GetCommits()[0].GetSubject())
+	assert.Equal(t, "test", diff.GetCommits()[0].GetAuthor())
+
+	assert.Contains(t, diff.GetPatch(), "+changed")
+	assert.Contains(t, diff.GetPatch(), "+new")
+	assert.NotContains(t, diff.GetPatch(), "settings.local.json")
+	assert.False(t, diff.GetPatchTruncated())
+
+	truncated, err := computeTaskDiff(context.Background(), dir, "main", 100)
+	require.NoError(t, err)
+	assert.True(t, truncated.GetPatchTruncated())
+	assert.LessOrEqual(t, len(truncated.GetPatch()), 100)
+	assert.True(t, strings.HasSuffix(truncated.GetPatch(), "\n"))
+
+	_, err = computeTaskDiff(context.Background(), dir, "no-such-branch", 0)
+	assert.Error(t, err)
+}
+
+func TestHandleTaskDiff(t *testing.T) {
+	tc := newTestClients()
+	defer tc.Close()
+
+	workDir := t.TempDir()
+	git := newTestGitRepo(t, workDir)
+
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "a.txt"), []byte("a\n"), 0o644))
+	git("add", ".")
+	git("commit", "-q", "-m", "initial")
+
+	wtDir := filepath.Join(workDir, ".claude", "worktrees", "feature")
+	git("worktree", "add", "-q", "-b", "worktree-feature", wtDir)
+	require.NoError(t, os.WriteFile(filepath.Join(wtDir, "a.txt"), []byte("b\n"), 0o644))
+
+	ctx := context.Background()
+
+	handleTaskDiff(ctx, tc.agentClient, workDir, &v1.TaskDiffCommand{RequestId: "req-1", TaskId: "task-1", WorktreeName: "feature"})
+	handleTaskDiff(ctx, tc.agentClient, workDir, &v1.TaskDiffCommand{RequestId: "req-2", TaskId: "task-2", WorktreeName: "missing"})
+
+	tc.agentHandler.mu.Lock()
+	defer tc.agentHandler.mu.Unlock()
+
+	require.Len(t, tc.agentHandler.diffReports, 2)
+
+	found := tc.agentHandler.diffReports[0]
+	assert.Equal(t, "req-1", found.GetRequestId())
+	assert.Empty(t, found.GetErrorMessage())
+	require.Len(t, found.GetDiff().GetFiles(), 1)
+	assert.Equal(t, "a.txt", found.GetDiff().GetFiles()[0].GetPath())
+
+	missing := tc.agentHandler.diffReports[1]
+	assert.Equal(t, "req-2", missing.GetRequestId())
+	assert.True(t, missing.GetNotFound())
+	assert.Nil(t, missing.GetDiff())
+}
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
 	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
@@ -47,14 +49,32 @@ func newTaskLogger(ctx context.Context, client taskguildv1connect.AgentManagerSe
 }
 
 // Log sends a structured log entry to the server.
+// Secrets are redacted first, so neither the server nor the outbox sees
+// them. When the server is unreachable the entry is spooled to the outbox
+// and replayed later.
 func (tl *taskLogger) Log(category v1.TaskLogCategory, level v1.TaskLogLevel, message string, metadata map[string]string) {
-	_, err := tl.client.ReportTaskLog(tl.ctx, connect.NewRequest(&v1.ReportTaskLogRequest{
-		TaskId:   tl.taskID,
-		Level:    level,
-		Category: category,
-		Message:  message,
-		Metadata: metadata,
-	}))
+	r := redactorOf(tl.client)
+	req := &v1.ReportTaskLogRequest{
+		TaskId:    tl.taskID,
+		Level:     level,
+		Category:  category,
+		Message:   r.String(message),
+		Metadata:  r.Map(metadata),
+		LogId:     ulid.Make().String(),
+		CreatedAt: timestamppb.Now(),
+	}
+	send := func(ctx context.Context) error {
+		_, err := tl.client.ReportTaskLog(ctx, connect.NewRequest(req))
+		return err
+	}
+
+	var err error
+	if globalOutbox != nil {
+		err = globalOutbox.deliver(tl.ctx, tl.taskID, outboxKindLog, req, send)
+	} else {
+		err = send(tl.ctx)
+	}
+
 	if err != nil {
 		tl.logger.Error("failed to report task log", "error", err)
 	}
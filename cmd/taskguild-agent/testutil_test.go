@@ -4,7 +4,11 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"os/exec"
+	"strings"
 	"sync"
+	"testing"
 
 	"connectrpc.com/connect"
 
@@ -22,6 +26,7 @@ type mockQueryRunnerCall struct {
 	Label   string
 	TaskID  string
 	WorkDir string
+	Model   string
 }
 
 type mockQueryRunnerResult struct {
@@ -46,12 +51,17 @@ func (m *mockQueryRunner) RunQuerySync(
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	m.calls = append(m.calls, mockQueryRunnerCall{
+	call := mockQueryRunnerCall{
 		Prompt:  prompt,
 		Label:   label,
 		TaskID:  taskID,
 		WorkDir: workDir,
-	})
+	}
+	if options != nil {
+		call.Model = options.Model
+	}
+
+	m.calls = append(m.calls, call)
 
 	idx := len(m.calls) - 1
 	if idx >= len(m.results) {
@@ -116,6 +126,91 @@ type testAgentManagerHandler struct {
 	reportTaskResultReqs  []*v1.ReportTaskResultRequest
 	reportTaskLogReqs     []*v1.ReportTaskLogRequest
 	createInteractionReqs []*v1.CreateInteractionRequest
+	heartbeatReqs         []*v1.HeartbeatRequest
+	transcripts           map[string][]byte // sessionID -> uploaded data
+	handoffLogs           []*v1.TaskLog     // returned by GetTaskHandoff
+	rollbackResults       []*v1.ReportTaskRollbackResultRequest
+	diffReports           []*v1.ReportTaskDiffRequest
+	mergeResults          []*v1.ReportMergeResultRequest
+	modifiedFilesReports  []*v1.ReportModifiedFilesRequest
+	fileOverlaps          []*v1.FileOverlap // returned by ReportModifiedFiles
+	reportTaskLogErr      error             // returned by ReportTaskLog when set
+}
+
+func (h *testAgentManagerHandler) ReportTaskRollbackResult(ctx context.Context, req *connect.Request[v1.ReportTaskRollbackResultRequest]) (*connect.Response[v1.ReportTaskRollbackResultResponse], error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.rollbackResults = append(h.rollbackResults, req.Msg)
+
+	return connect.NewResponse(&v1.ReportTaskRollbackResultResponse{}), nil
+}
+
+func (h *testAgentManagerHandler) ReportTaskDiff(ctx context.Context, req *connect.Request[v1.ReportTaskDiffRequest]) (*connect.Response[v1.ReportTaskDiffResponse], error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.diffReports = append(h.diffReports, req.Msg)
+
+	return connect.NewResponse(&v1.ReportTaskDiffResponse{}), nil
+}
+
+func (h *testAgentManagerHandler) ReportMergeResult(ctx context.Context, req *connect.Request[v1.ReportMergeResultRequest]) (*connect.Response[v1.ReportMergeResultResponse], error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.mergeResults = append(h.mergeResults, req.Msg)
+
+	return connect.NewResponse(&v1.ReportMergeResultResponse{}), nil
+}
+
+func (h *testAgentManagerHandler) ReportModifiedFiles(ctx context.Context, req *connect.Request[v1.ReportModifiedFilesRequest]) (*connect.Response[v1.ReportModifiedFilesResponse], error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.modifiedFilesReports = append(h.modifiedFilesReports, req.Msg)
+
+	return connect.NewResponse(&v1.ReportModifiedFilesResponse{Overlaps: h.fileOverlaps}), nil
+}
+
+func (h *testAgentManagerHandler) GetTaskHandoff(ctx context.Context, req *connect.Request[v1.GetTaskHandoffRequest]) (*connect.Response[v1.GetTaskHandoffResponse], error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return connect.NewResponse(&v1.GetTaskHandoffResponse{Logs: h.handoffLogs}), nil
+}
+
+func (h *testAgentManagerHandler) UploadSessionTranscript(ctx context.Context, req *connect.Request[v1.UploadSessionTranscriptRequest]) (*connect.Response[v1.UploadSessionTranscriptResponse], error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.transcripts == nil {
+		h.transcripts = make(map[string][]byte)
+	}
+
+	h.transcripts[req.Msg.GetSessionId()] = req.Msg.GetData()
+
+	return connect.NewResponse(&v1.UploadSessionTranscriptResponse{}), nil
+}
+
+func (h *testAgentManagerHandler) DownloadSessionTranscript(ctx context.Context, req *connect.Request[v1.DownloadSessionTranscriptRequest]) (*connect.Response[v1.DownloadSessionTranscriptResponse], error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	data, ok := h.transcripts[req.Msg.GetSessionId()]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, nil)
+	}
+
+	return connect.NewResponse(&v1.DownloadSessionTranscriptResponse{Data: data}), nil
+}
+
+func (h *testAgentManagerHandler) Heartbeat(ctx context.Context, req *connect.Request[v1.HeartbeatRequest]) (*connect.Response[v1.HeartbeatResponse], error) {
+	h.mu.Lock()
+	h.heartbeatReqs = append(h.heartbeatReqs, req.Msg)
+	h.mu.Unlock()
+
+	return connect.NewResponse(&v1.HeartbeatResponse{}), nil
 }
 
 func (h *testAgentManagerHandler) ReportAgentStatus(ctx context.Context, req *connect.Request[v1.ReportAgentStatusRequest]) (*connect.Response[v1.ReportAgentStatusResponse], error) {
@@ -137,8 +232,13 @@ func (h *testAgentManagerHandler) ReportTaskResult(ctx context.Context, req *con
 func (h *testAgentManagerHandler) ReportTaskLog(ctx context.Context, req *connect.Request[v1.ReportTaskLogRequest]) (*connect.Response[v1.ReportTaskLogResponse], error) {
 	h.mu.Lock()
 	h.reportTaskLogReqs = append(h.reportTaskLogReqs, req.Msg)
+	err := h.reportTaskLogErr
 	h.mu.Unlock()
 
+	if err != nil {
+		return nil, err
+	}
+
 	return connect.NewResponse(&v1.ReportTaskLogResponse{}), nil
 }
 
@@ -279,3 +379,35 @@ func baseMetadata(statusName string, transitions string) map[string]string {
 		"_workflow_statuses":     `[{"name":"Plan"},{"name":"Develop"},{"name":"Review"},{"name":"Done"}]`,
 	}
 }
+
+// newTestGitRepo initializes a git repository (default branch "main") in dir
+// and returns a helper that runs git there and returns its trimmed output.
+// Skips the test if git is not installed.
+func newTestGitRepo(t *testing.T, dir string) func(args ...string) string {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	git := func(args ...string) string {
+		t.Helper()
+
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+
+		return strings.TrimSpace(string(out))
+	}
+
+	git("init", "-q", "-b", "main")
+
+	return git
+}
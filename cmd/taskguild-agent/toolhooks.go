@@ -22,7 +22,9 @@ import (
 // The PreToolUse hook intercepts ExitPlanMode to require user approval before
 // the plan is accepted and the agent exits plan mode. It also runs the
 // per-task skill loop guard to block recursive / runaway Skill invocations
-// before they consume forked-session tokens.
+// before they consume forked-session tokens. When sandbox is non-nil, Bash
+// commands are rewritten to run inside it and sandbox violations are
+// reported as TaskLog errors.
 func buildToolUseHooks(
 	tl *taskLogger,
 	taskID string,
@@ -32,6 +34,7 @@ func buildToolUseHooks(
 	agentManagerID string,
 	waiter *interactionWaiter,
 	loopGuard *skillLoopGuard,
+	sandbox *bashSandbox,
 ) map[claudeagent.HookEvent][]*claudeagent.HookMatcher {
 	// Track the most recently written plan file path across hook invocations.
 	var planFilePath string
@@ -42,6 +45,10 @@ func buildToolUseHooks(
 				Matcher: "",
 				Hooks: []claudeagent.HookCallback{
 					func(input claudeagent.HookInput, toolUseID string, hookCtx claudeagent.HookContext) (claudeagent.HookOutput, error) {
+						if input.ToolName == "Bash" && sandbox != nil {
+							return sandboxBashCommand(tl, taskID, input, sandbox), nil
+						}
+
 						// Skill loop guard: block recursive / over-capped Skill
 						// invocations before they reach the permission UI or
 						// fork a new Claude session. This catches AI-side
@@ -91,7 +98,13 @@ func buildToolUseHooks(
 							onModeChange(input.PermissionMode)
 						}
 
+						if input.ToolName == "Bash" && sandbox != nil {
+							input.ToolInput = sandbox.unwrapInput(input.ToolInput)
+							reportSandboxViolation(tl, taskID, input, sandbox)
+						}
+
 						logToolUse(tl, taskID, input, false)
+						recordEditedFile(taskID, input.ToolName, input.ToolInput)
 
 						// Track plan file writes.
 						if input.ToolName == "Write" || input.ToolName == "Edit" {
@@ -161,6 +174,11 @@ func buildToolUseHooks(
 							onModeChange(input.PermissionMode)
 						}
 
+						if input.ToolName == "Bash" && sandbox != nil {
+							input.ToolInput = sandbox.unwrapInput(input.ToolInput)
+							reportSandboxViolation(tl, taskID, input, sandbox)
+						}
+
 						logToolUse(tl, taskID, input, true)
 
 						return claudeagent.HookOutput{}, nil
@@ -171,10 +189,63 @@ func buildToolUseHooks(
 	}
 }
 
+// sandboxBashCommand rewrites a Bash tool input so the command runs inside the
+// sandbox. When the sandbox is required but unavailable the command is
+// blocked and the failure is logged as a TaskLog error.
+func sandboxBashCommand(tl *taskLogger, taskID string, input claudeagent.HookInput, sandbox *bashSandbox) claudeagent.HookOutput {
+	if !sandbox.available() {
+		reason := sandbox.unavailableReason()
+		slog.Warn("sandbox unavailable, blocking Bash command", "task_id", taskID, "reason", reason)
+
+		if tl != nil {
+			tl.Log(v1.TaskLogCategory_TASK_LOG_CATEGORY_ERROR, v1.TaskLogLevel_TASK_LOG_LEVEL_ERROR,
+				"Sandbox unavailable: "+reason,
+				map[string]string{"tool_name": "Bash", "sandbox_violation": "unavailable"})
+		}
+
+		return claudeagent.HookOutput{
+			Decision: "block",
+			Reason:   reason,
+		}
+	}
+
+	return claudeagent.HookOutput{
+		HookSpecificOutput: map[string]any{
+			"hookEventName": string(claudeagent.HookEventPreToolUse),
+			"updatedInput":  sandbox.wrapInput(input.ToolInput),
+		},
+	}
+}
+
+// reportSandboxViolation logs a TaskLog error when the output of a sandboxed
+// Bash command shows that the sandbox blocked an operation.
+func reportSandboxViolation(tl *taskLogger, taskID string, input claudeagent.HookInput, sandbox *bashSandbox) {
+	output := input.Error
+	if input.ToolResponse != nil {
+		if b, err := json.Marshal(input.ToolResponse); err == nil {
+			output += "\n" + string(b)
+		}
+	}
+
+	kind := sandbox.detectViolation(output)
+	if kind == "" {
+		return
+	}
+
+	cmd, _ := input.ToolInput["command"].(string)
+	slog.Warn("sandbox violation", "task_id", taskID, "kind", kind, "command", cmd)
+
+	if tl != nil {
+		tl.Log(v1.TaskLogCategory_TASK_LOG_CATEGORY_ERROR, v1.TaskLogLevel_TASK_LOG_LEVEL_ERROR,
+			fmt.Sprintf("Sandbox violation (%s): %s", kind, truncateText(cmd, 200)),
+			map[string]string{"tool_name": "Bash", "sandbox_violation": kind, "command": cmd})
+	}
+}
+
 // logToolUse sends a TOOL_USE task log with the tool name, input parameters, and output/error.
 func logToolUse(tl *taskLogger, taskID string, input claudeagent.HookInput, isFail bool) {
 	toolName := input.ToolName
-	summary := formatToolSummary(toolName, input.ToolInput)
+	summary := redactorOf(tl.client).String(formatToolSummary(toolName, input.ToolInput))
 
 	level := v1.TaskLogLevel_TASK_LOG_LEVEL_INFO
 	if isFail {
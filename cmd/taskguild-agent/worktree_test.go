@@ -1,8 +1,12 @@
 package main
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestIsClaudeInternalPath(t *testing.T) {
@@ -67,3 +71,140 @@ func TestPorcelainParsing(t *testing.T) {
 		}
 	}
 }
+
+func TestWorktreeDiskUsage(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte("12345"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "sub", "b.txt"), []byte("123"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	newest := time.Now().Add(time.Hour).Truncate(time.Second)
+	if err := os.Chtimes(filepath.Join(dir, "sub", "b.txt"), newest, newest); err != nil {
+		t.Fatal(err)
+	}
+
+	size, modTime := worktreeDiskUsage(dir)
+	if size != 8 {
+		t.Errorf("size = %d, want 8", size)
+	}
+
+	if !modTime.Equal(newest) {
+		t.Errorf("modTime = %v, want %v", modTime, newest)
+	}
+}
+
+func TestBranchMerged(t *testing.T) {
+	workDir, wtDir, git := setupMergeRepo(t)
+	ctx := context.Background()
+
+	commitFile(t, git, wtDir, "b.txt", "b\n", "add b")
+
+	if branchMerged(ctx, wtDir, "worktree-feature", "main") {
+		t.Error("unmerged branch reported as merged")
+	}
+
+	git("merge", "-q", "--ff-only", "worktree-feature")
+
+	if !branchMerged(ctx, wtDir, "worktree-feature", "main") {
+		t.Error("merged branch reported as unmerged")
+	}
+
+	if branchMerged(ctx, workDir, "main", "main") {
+		t.Error("base branch reported as merged into itself")
+	}
+}
+
+func TestWorktreeBranchName(t *testing.T) {
+	tests := []struct {
+		template string
+		want     string
+	}{
+		{"", "worktree-fix-login"},
+		{"feature/{name}", "feature/fix-login"},
+		{"tg/{task_id}/{name}", "tg/TASK1/fix-login"},
+	}
+	for _, tt := range tests {
+		if got := worktreeBranchName(tt.template, "fix-login", "TASK1"); got != tt.want {
+			t.Errorf("worktreeBranchName(%q) = %q, want %q", tt.template, got, tt.want)
+		}
+	}
+}
+
+func TestEnsureWorktree_BaseRef(t *testing.T) {
+	workDir := t.TempDir()
+	git := newTestGitRepo(t, workDir)
+	ctx := context.Background()
+
+	commitFile(t, git, workDir, "a.txt", "v1\n", "release")
+	git("tag", "v1.0")
+	commitFile(t, git, workDir, "a.txt", "v2\n", "next")
+
+	wtDir, err := ensureWorktree(ctx, workDir, "backport", "TASK1", worktreeBase{ref: "v1.0", branchTemplate: "backport/{name}"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := git("-C", wtDir, "rev-parse", "HEAD"), git("rev-parse", "v1.0^{commit}"); got != want {
+		t.Errorf("worktree HEAD = %s, want v1.0 (%s)", got, want)
+	}
+
+	if got := git("-C", wtDir, "branch", "--show-current"); got != "backport/backport" {
+		t.Errorf("branch = %q, want backport/backport", got)
+	}
+
+	if _, err := ensureWorktree(ctx, workDir, "missing", "TASK2", worktreeBase{ref: "no-such-ref"}); err == nil {
+		t.Error("expected an error for an unknown base ref")
+	}
+}
+
+func TestEnsureWorktree_BaseWorktree(t *testing.T) {
+	workDir := t.TempDir()
+	git := newTestGitRepo(t, workDir)
+	ctx := context.Background()
+
+	commitFile(t, git, workDir, "a.txt", "a\n", "initial")
+
+	first, err := ensureWorktree(ctx, workDir, "first", "TASK1", worktreeBase{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commitFile(t, git, first, "b.txt", "b\n", "first change")
+
+	second, err := ensureWorktree(ctx, workDir, "second", "TASK2", worktreeBase{worktree: "first"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := git("-C", second, "log", "-1", "--format=%s"); got != "first change" {
+		t.Errorf("stacked worktree starts at %q, want the first worktree's commit", got)
+	}
+
+	if got := git("-C", second, "branch", "--show-current"); got != "worktree-second" {
+		t.Errorf("branch = %q, want worktree-second", got)
+	}
+
+	if _, err := ensureWorktree(ctx, workDir, "third", "TASK3", worktreeBase{worktree: "missing"}); err == nil {
+		t.Error("expected an error for a missing base worktree")
+	}
+
+	// A removed base worktree whose branch was kept is stacked on the branch.
+	git("worktree", "remove", first)
+
+	fourth, err := ensureWorktree(ctx, workDir, "fourth", "TASK4", worktreeBase{worktree: "first", taskID: "TASK1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := git("-C", fourth, "log", "-1", "--format=%s"); got != "first change" {
+		t.Errorf("worktree stacked on a removed worktree starts at %q, want its branch's commit", got)
+	}
+}
@@ -17,6 +17,17 @@ var (
 
 	seedUpsertCmd       = app.Command("seed-upsert", "Upsert default skills from the Seeder into an existing project (non-destructive — skills not in defaults are left alone).")
 	seedUpsertProjectID = seedUpsertCmd.Flag("project-id", "Project ID to upsert skills into").Required().String()
+
+	tokenCmd              = app.Command("token", "Manage API tokens")
+	tokenCreateCmd        = tokenCmd.Command("create", "Create an API token and print its secret")
+	tokenCreateName       = tokenCreateCmd.Flag("name", "Token name").Required().String()
+	tokenCreateScopes     = tokenCreateCmd.Flag("scope", "Scope to grant (read-only, respond-to-interactions, agent-manager, admin); repeatable").Required().Strings()
+	tokenCreateProjectIDs = tokenCreateCmd.Flag("project-id", "Restrict the token to this project; repeatable (default: all projects)").Strings()
+	tokenCreateExpiresIn  = tokenCreateCmd.Flag("expires-in", "Expire the token after this duration (e.g. 720h)").Duration()
+	tokenListCmd          = tokenCmd.Command("list", "List API tokens")
+	tokenListAll          = tokenListCmd.Flag("all", "Include revoked and expired tokens").Bool()
+	tokenRevokeCmd        = tokenCmd.Command("revoke", "Revoke an API token")
+	tokenRevokeID         = tokenRevokeCmd.Arg("id", "Token ID").Required().String()
 )
 
 func main() {
@@ -28,5 +39,11 @@ func main() {
 		runSentinel()
 	case seedUpsertCmd.FullCommand():
 		runSeedUpsert(*seedUpsertProjectID)
+	case tokenCreateCmd.FullCommand():
+		runTokenCreate(*tokenCreateName, *tokenCreateScopes, *tokenCreateProjectIDs, *tokenCreateExpiresIn)
+	case tokenListCmd.FullCommand():
+		runTokenList(*tokenListAll)
+	case tokenRevokeCmd.FullCommand():
+		runTokenRevoke(*tokenRevokeID)
 	}
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -9,6 +10,7 @@ import (
 	_ "net/http/pprof" // registers /debug/pprof/* handlers on DefaultServeMux
 	"os"
 	"os/signal"
+	"slices"
 	"syscall"
 	"time"
 
@@ -18,6 +20,10 @@ import (
 	"github.com/kazz187/taskguild/internal/agent"
 	agentrepo "github.com/kazz187/taskguild/internal/agent/repositoryimpl"
 	"github.com/kazz187/taskguild/internal/agentmanager"
+	"github.com/kazz187/taskguild/internal/apitoken"
+	apitokenrepo "github.com/kazz187/taskguild/internal/apitoken/repositoryimpl"
+	"github.com/kazz187/taskguild/internal/audit"
+	auditrepo "github.com/kazz187/taskguild/internal/audit/repositoryimpl"
 	"github.com/kazz187/taskguild/internal/chatnotifier"
 	"github.com/kazz187/taskguild/internal/claudesettings"
 	claudesettingsrepo "github.com/kazz187/taskguild/internal/claudesettings/repositoryimpl"
@@ -38,6 +44,8 @@ import (
 	"github.com/kazz187/taskguild/internal/scheduler"
 	"github.com/kazz187/taskguild/internal/script"
 	scriptrepo "github.com/kazz187/taskguild/internal/script/repositoryimpl"
+	"github.com/kazz187/taskguild/internal/secret"
+	secretrepo "github.com/kazz187/taskguild/internal/secret/repositoryimpl"
 	"github.com/kazz187/taskguild/internal/singlecommandpermission"
 	scprepo "github.com/kazz187/taskguild/internal/singlecommandpermission/repositoryimpl"
 	"github.com/kazz187/taskguild/internal/skill"
@@ -80,13 +88,17 @@ func (n *agentChangeNotifier) NotifyAgentChange(projectID string, changedAgentNa
 }
 
 // permissionChangeNotifier implements permission.ChangeNotifier by broadcasting
-// a SyncPermissionsCommand to connected agents in the same project.
+// a SyncPermissionsCommand to connected agents in the same project. It also
+// drops the server's cached redactor so that new redaction patterns apply.
 type permissionChangeNotifier struct {
-	registry    *agentmanager.Registry
-	projectRepo project.Repository
+	registry     *agentmanager.Registry
+	agentManager *agentmanager.Server
+	projectRepo  project.Repository
 }
 
 func (n *permissionChangeNotifier) NotifyPermissionChange(projectID string) {
+	n.agentManager.InvalidateRedactor(projectID)
+
 	p, err := n.projectRepo.Get(context.Background(), projectID)
 	if err != nil {
 		slog.Error("failed to look up project for permission change notification", "project_id", projectID, "error", err)
@@ -210,6 +222,216 @@ func (r *workDirResolver) ResolveWorkDir(projectID string) (string, error) {
 	return workDir, nil
 }
 
+// projectResolver implements apitoken.ProjectResolver on top of the
+// repositories so that project-restricted API tokens can be enforced.
+type projectResolver struct {
+	projectRepo     project.Repository
+	taskRepo        task.Repository
+	interactionRepo interaction.Repository
+	workflowRepo    workflow.Repository
+	scheduleRepo    schedule.Repository
+	agentRepo       agent.Repository
+	skillRepo       skill.Repository
+	scriptRepo      script.Repository
+	scpRepo         singlecommandpermission.Repository
+	scriptBroker    *script.ScriptExecutionBroker
+}
+
+func (r *projectResolver) ProjectIDByName(ctx context.Context, name string) (string, error) {
+	p, err := r.projectRepo.FindByName(ctx, name)
+	if err != nil {
+		return "", err
+	}
+
+	return p.ID, nil
+}
+
+func (r *projectResolver) ProjectIDByTask(ctx context.Context, taskID string) (string, error) {
+	t, err := r.taskRepo.Get(ctx, taskID)
+	if err != nil {
+		t, err = r.taskRepo.GetArchived(ctx, taskID)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return t.ProjectID, nil
+}
+
+func (r *projectResolver) ProjectIDByInteraction(ctx context.Context, interactionID string) (string, error) {
+	i, err := r.interactionRepo.Get(ctx, interactionID)
+	if err != nil {
+		return "", err
+	}
+
+	return i.ProjectID, nil
+}
+
+func (r *projectResolver) ProjectIDByWorkflow(ctx context.Context, workflowID string) (string, error) {
+	w, err := r.workflowRepo.Get(ctx, workflowID)
+	if err != nil {
+		return "", err
+	}
+
+	return w.ProjectID, nil
+}
+
+func (r *projectResolver) ProjectIDBySchedule(ctx context.Context, scheduleID string) (string, error) {
+	s, err := r.scheduleRepo.Get(ctx, scheduleID)
+	if err != nil {
+		return "", err
+	}
+
+	return s.ProjectID, nil
+}
+
+func (r *projectResolver) ProjectIDByAgent(ctx context.Context, agentID string) (string, error) {
+	a, err := r.agentRepo.Get(ctx, agentID)
+	if err != nil {
+		return "", err
+	}
+
+	return a.ProjectID, nil
+}
+
+func (r *projectResolver) ProjectIDBySkill(ctx context.Context, skillID string) (string, error) {
+	s, err := r.skillRepo.Get(ctx, skillID)
+	if err != nil {
+		return "", err
+	}
+
+	return s.ProjectID, nil
+}
+
+func (r *projectResolver) ProjectIDByScript(ctx context.Context, scriptID string) (string, error) {
+	s, err := r.scriptRepo.Get(ctx, scriptID)
+	if err != nil {
+		return "", err
+	}
+
+	return s.ProjectID, nil
+}
+
+func (r *projectResolver) ProjectIDByScriptExecution(_ context.Context, requestID string) (string, error) {
+	projectID := r.scriptBroker.GetProjectID(requestID)
+	if projectID == "" {
+		return "", fmt.Errorf("unknown script execution %s", requestID)
+	}
+
+	return projectID, nil
+}
+
+func (r *projectResolver) ProjectIDBySingleCommandPermission(ctx context.Context, permissionID string) (string, error) {
+	p, err := r.scpRepo.Get(ctx, permissionID)
+	if err != nil {
+		return "", err
+	}
+
+	return p.ProjectID, nil
+}
+
+// Permission history window: suggestions and unused-rule reports only look
+// at the most recently updated tasks, so that listing them does not load
+// every log and interaction a project ever had.
+const (
+	permissionHistoryWindow   = 30 * 24 * time.Hour
+	permissionHistoryMaxTasks = 200
+)
+
+// permissionHistory implements singlecommandpermission.History by reading
+// the responded permission requests and Bash tool logs of a project's
+// recently updated active and archived tasks.
+type permissionHistory struct {
+	taskRepo        task.Repository
+	interactionRepo interaction.Repository
+	taskLogRepo     tasklog.Repository
+}
+
+// projectTaskIDs returns the tasks updated within permissionHistoryWindow,
+// newest first, at most permissionHistoryMaxTasks of them.
+func (h *permissionHistory) projectTaskIDs(ctx context.Context, projectID string) ([]string, error) {
+	active, _, err := h.taskRepo.List(ctx, projectID, "", "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	archived, _, err := h.taskRepo.ListArchived(ctx, projectID, "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	since := time.Now().Add(-permissionHistoryWindow)
+
+	tasks := slices.DeleteFunc(append(active, archived...), func(t *task.Task) bool {
+		return t.UpdatedAt.Before(since)
+	})
+	slices.SortFunc(tasks, func(a, b *task.Task) int { return b.UpdatedAt.Compare(a.UpdatedAt) })
+
+	ids := make([]string, 0, min(len(tasks), permissionHistoryMaxTasks))
+	for _, t := range tasks[:min(len(tasks), permissionHistoryMaxTasks)] {
+		ids = append(ids, t.ID)
+	}
+
+	return ids, nil
+}
+
+func (h *permissionHistory) PermissionDecisions(ctx context.Context, projectID string) ([]singlecommandpermission.PermissionDecision, error) {
+	taskIDs, err := h.projectTaskIDs(ctx, projectID)
+	if err != nil || len(taskIDs) == 0 {
+		return nil, err
+	}
+
+	interactions, _, err := h.interactionRepo.List(ctx, "", taskIDs, interaction.StatusResponded, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var decisions []singlecommandpermission.PermissionDecision
+
+	for _, i := range interactions {
+		// Only Bash requests carry parsed-command metadata.
+		if i.Type != interaction.TypePermissionRequest || i.Metadata == "" {
+			continue
+		}
+
+		decisions = append(decisions, singlecommandpermission.PermissionDecision{
+			Metadata: i.Metadata,
+			Response: i.Response,
+		})
+	}
+
+	return decisions, nil
+}
+
+func (h *permissionHistory) BashCommands(ctx context.Context, projectID string) ([]string, error) {
+	taskIDs, err := h.projectTaskIDs(ctx, projectID)
+	if err != nil || len(taskIDs) == 0 {
+		return nil, err
+	}
+
+	logs, _, err := h.taskLogRepo.List(ctx, "", taskIDs, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var commands []string
+
+	for _, l := range logs {
+		if l.Category != int32(taskguildv1.TaskLogCategory_TASK_LOG_CATEGORY_TOOL_USE) || l.Metadata["tool_name"] != "Bash" {
+			continue
+		}
+
+		var input struct {
+			Command string `json:"command"`
+		}
+		if json.Unmarshal([]byte(l.Metadata["tool_input"]), &input) == nil && input.Command != "" {
+			commands = append(commands, input.Command)
+		}
+	}
+
+	return commands, nil
+}
+
 func runServer() {
 	env, err := config.LoadEnv()
 	if err != nil {
@@ -267,6 +489,9 @@ func runServer() {
 	templateRepo := tmplrepo.NewYAMLRepository(store)
 	claudeSettingsRepo := claudesettingsrepo.NewYAMLRepository(store)
 	scheduleRepo := schedulerepo.NewYAMLRepository(store)
+	apiTokenRepo := apitokenrepo.NewYAMLRepository(store)
+	secretRepo := secretrepo.NewYAMLRepository(store)
+	auditRepo := auditrepo.NewJSONLRepository(env.BaseDir)
 
 	// Setup agent-manager registry
 	agentManagerRegistry := agentmanager.NewRegistry()
@@ -280,9 +505,30 @@ func runServer() {
 	projectServer := project.NewServer(projectRepo, projectSeeder)
 	workflowServer := workflow.NewServer(workflowRepo)
 	agentManagerServer := agentmanager.NewServer(agentManagerRegistry, taskRepo, workflowRepo, agentRepo, interactionRepo, projectRepo, skillRepo, scriptRepo, taskLogRepo, permissionRepo, scpRepo, claudeSettingsRepo, bus, scriptBroker)
+	transcriptStore := task.NewTranscriptStore(store)
+	agentManagerServer.SetTranscriptStore(transcriptStore, env.SessionTranscriptMaxBytes)
 	descLogger := tasklog.NewDescriptionLoggerAdapter(taskLogRepo, bus)
 	taskServer := task.NewServer(taskRepo, workflowRepo, bus, agentManagerServer, agentManagerServer, []task.CascadeArchiver{interactionRepo}, descLogger, taskLogRepo, interactionRepo)
 	taskServer.SetImageStore(task.NewImageStore(store))
+	taskServer.SetTaskCompactor(agentManagerServer)
+	taskServer.SetTaskRollbacker(agentManagerServer)
+	taskServer.SetDiffSnapshotter(agentManagerServer)
+	taskServer.SetMergeEnqueuer(agentManagerServer)
+	agentManagerServer.SetTaskCreator(taskServer)
+
+	// Setup secret store. Without a master key secrets can be neither set
+	// nor delivered to agents.
+	var secretCipher *secret.Cipher
+	if env.SecretMasterKey != "" {
+		secretCipher, err = secret.NewCipher(env.SecretMasterKey)
+		if err != nil {
+			slog.Error("invalid TASKGUILD_SECRET_MASTER_KEY", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	secretServer := secret.NewServer(secretRepo, secretCipher)
+	agentManagerServer.SetSecretSource(secretServer)
 
 	interactionServer := interaction.NewServer(interactionRepo, taskRepo, bus)
 	agentChangeNotifier := &agentChangeNotifier{
@@ -307,15 +553,20 @@ func runServer() {
 	taskLogServer := tasklog.NewServer(taskLogRepo, taskRepo)
 	eventServer := event.NewServer(bus)
 	permissionChangeNotifier := &permissionChangeNotifier{
-		registry:    agentManagerRegistry,
-		projectRepo: projectRepo,
+		registry:     agentManagerRegistry,
+		agentManager: agentManagerServer,
+		projectRepo:  projectRepo,
 	}
-	permissionServer := permission.NewServer(permissionRepo, permissionChangeNotifier, wdResolver)
+	permissionServer := permission.NewServer(permissionRepo, permissionChangeNotifier, wdResolver, agentManagerServer)
 	scpChangeNotifier := &scpChangeNotifier{
 		registry:    agentManagerRegistry,
 		projectRepo: projectRepo,
 	}
-	scpServer := singlecommandpermission.NewServer(scpRepo, scpChangeNotifier)
+	scpServer := singlecommandpermission.NewServer(scpRepo, scpChangeNotifier, &permissionHistory{
+		taskRepo:        taskRepo,
+		interactionRepo: interactionRepo,
+		taskLogRepo:     taskLogRepo,
+	})
 	templateServer := tmpl.NewServer(templateRepo, agentRepo, skillRepo, scriptRepo)
 	csChangeNotifier := &claudeSettingsChangeNotifier{
 		registry:    agentManagerRegistry,
@@ -336,6 +587,27 @@ func runServer() {
 	baseEnv := config.BaseEnvFromEnv(env)
 	pushDispatcher := pushnotification.NewDispatcher(bus, interactionRepo, taskRepo, pushSender, baseEnv)
 
+	// Setup API token authentication
+	authenticator := apitoken.NewAuthenticator(apiTokenRepo, env.APIKey)
+	apiTokenServer := apitoken.NewServer(apiTokenRepo, authenticator)
+	resolver := &projectResolver{
+		projectRepo:     projectRepo,
+		taskRepo:        taskRepo,
+		interactionRepo: interactionRepo,
+		workflowRepo:    workflowRepo,
+		scheduleRepo:    scheduleRepo,
+		agentRepo:       agentRepo,
+		skillRepo:       skillRepo,
+		scriptRepo:      scriptRepo,
+		scpRepo:         scpRepo,
+		scriptBroker:    scriptBroker,
+	}
+	apiTokenAuthorizer := apitoken.NewAuthorizer(resolver)
+
+	// Setup audit log
+	auditServer := audit.NewServer(auditRepo)
+	auditInterceptor := audit.NewInterceptor(auditRepo, resolver)
+
 	srv := server.NewServer(
 		env,
 		projectServer,
@@ -354,6 +626,12 @@ func runServer() {
 		templateServer,
 		claudeSettingsServer,
 		scheduleServer,
+		secretServer,
+		apiTokenServer,
+		authenticator,
+		apiTokenAuthorizer,
+		auditServer,
+		auditInterceptor,
 	)
 
 	// Setup orchestrator
@@ -377,6 +655,15 @@ func runServer() {
 		slog.Info("startup task log cleanup", "deleted", cleaned)
 	}
 
+	cleanupTranscripts := func() {
+		if cleaned, err := transcriptStore.CleanupOlderThan(ctx, env.SessionTranscriptRetention); err != nil {
+			slog.Error("session transcript cleanup failed", "error", err)
+		} else if cleaned > 0 {
+			slog.Info("session transcript cleanup", "deleted", cleaned)
+		}
+	}
+	cleanupTranscripts()
+
 	// Handle SIGUSR1 for graceful hot-reload.
 	// When the sentinel detects a binary update it sends SIGUSR1 instead of
 	// SIGTERM. This handler stops accepting new script executions and waits
@@ -441,10 +728,44 @@ func runServer() {
 				} else if cleaned > 0 {
 					slog.Info("periodic task log cleanup", "deleted", cleaned)
 				}
+
+				cleanupTranscripts()
 			}
 		}
 	})
 
+	// Hourly sweep of expired single-command permission grants.
+	svcWg.Go(func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				projects, err := projectRepo.ListAll(ctx)
+				if err != nil {
+					slog.Error("permission grant sweep: failed to list projects", "error", err)
+					continue
+				}
+
+				ids := make([]string, 0, len(projects))
+				for _, p := range projects {
+					ids = append(ids, p.ID)
+				}
+
+				scpServer.PruneExpired(ctx, ids)
+			}
+		}
+	})
+
+	// Hourly worktree policy sweep (cleanup and disk quota).
+	svcWg.Go(func() { agentManagerServer.RunWorktreePolicies(ctx, time.Hour) })
+
+	// Restart merge queues stuck on a merge that never reported.
+	svcWg.Go(func() { agentManagerServer.RunMergeQueueSweep(ctx, 5*time.Minute) })
+
 	svcWg.Go(func() {
 		err := srv.ListenAndServe(ctx)
 		if err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/kazz187/taskguild/internal/apitoken"
+	apitokenrepo "github.com/kazz187/taskguild/internal/apitoken/repositoryimpl"
+	"github.com/kazz187/taskguild/internal/config"
+	"github.com/kazz187/taskguild/pkg/clog"
+	"github.com/kazz187/taskguild/pkg/storage"
+)
+
+// newTokenRepository opens the API token store configured by the
+// environment, the same storage the server uses.
+func newTokenRepository() apitoken.Repository {
+	env, err := config.LoadEnv()
+	if err != nil {
+		slog.Error("failed to load env", "error", err)
+		os.Exit(1)
+	}
+
+	handler := clog.NewConnectTextHandler(os.Stderr, clog.WithLevel(env.SlogLevel()))
+	slog.SetDefault(slog.New(clog.NewAttributesHandler(handler)))
+
+	var store storage.Storage
+
+	switch env.Type {
+	case "s3":
+		store, err = storage.NewS3Storage(context.Background(), env.S3Bucket, env.S3Prefix, env.S3Region)
+		if err != nil {
+			slog.Error("failed to create S3 storage", "error", err)
+			os.Exit(1)
+		}
+	default:
+		store, err = storage.NewLocalStorage(env.BaseDir)
+		if err != nil {
+			slog.Error("failed to create local storage", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	return apitokenrepo.NewYAMLRepository(store)
+}
+
+// runTokenCreate issues a token and prints its secret, which cannot be
+// retrieved later.
+func runTokenCreate(name string, scopeNames, projectIDs []string, expiresIn time.Duration) {
+	scopes := make([]apitoken.Scope, 0, len(scopeNames))
+
+	for _, n := range scopeNames {
+		s, err := apitoken.ParseScope(n)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		scopes = append(scopes, s)
+	}
+
+	now := time.Now()
+
+	var expiresAt *time.Time
+	if expiresIn > 0 {
+		t := now.Add(expiresIn)
+		expiresAt = &t
+	}
+
+	t, secret, err := apitoken.NewToken(name, scopes, projectIDs, expiresAt, now)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := newTokenRepository().Create(context.Background(), t); err != nil {
+		slog.Error("failed to create api token", "error", err)
+		os.Exit(1)
+	}
+
+	fmt.Fprintf(os.Stderr, "created token %s (%s); the secret is shown only once:\n", t.ID, t.Name)
+	fmt.Println(secret)
+}
+
+func runTokenList(includeRevoked bool) {
+	tokens, err := newTokenRepository().List(context.Background())
+	if err != nil {
+		slog.Error("failed to list api tokens", "error", err)
+		os.Exit(1)
+	}
+
+	now := time.Now()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tSCOPES\tPROJECTS\tEXPIRES\tSTATUS")
+
+	for _, t := range tokens {
+		if !includeRevoked && !t.Active(now) {
+			continue
+		}
+
+		scopes := make([]string, 0, len(t.Scopes))
+		for _, s := range t.Scopes {
+			scopes = append(scopes, string(s))
+		}
+
+		projects := "*"
+		if len(t.ProjectIDs) > 0 {
+			projects = strings.Join(t.ProjectIDs, ",")
+		}
+
+		expires := "-"
+		if t.ExpiresAt != nil {
+			expires = t.ExpiresAt.Local().Format(time.RFC3339)
+		}
+
+		status := "active"
+
+		switch {
+		case t.RevokedAt != nil:
+			status = "revoked"
+		case !t.Active(now):
+			status = "expired"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", t.ID, t.Name, strings.Join(scopes, ","), projects, expires, status)
+	}
+
+	w.Flush()
+}
+
+func runTokenRevoke(id string) {
+	t, err := apitoken.Revoke(context.Background(), newTokenRepository(), id)
+	if err != nil {
+		slog.Error("failed to revoke api token", "token_id", id, "error", err)
+		os.Exit(1)
+	}
+
+	fmt.Fprintf(os.Stderr, "revoked token %s (%s)\n", t.ID, t.Name)
+}
@@ -2,10 +2,11 @@ import { useState, useEffect } from 'react'
 import { useQuery, useMutation } from '@connectrpc/connect-query'
 import { getPermissions, updatePermissions, syncPermissionsFromDir } from '@taskguild/proto/taskguild/v1/permission-PermissionService_connectquery.ts'
 import { Shield, Plus, X, Save } from 'lucide-react'
-import { Button, Input, Select, Badge } from '../atoms/index.ts'
+import { Button, Input, Select, Badge, Checkbox } from '../atoms/index.ts'
 import { Card, PageHeading, EmptyState, SyncButton } from '../molecules/index.ts'
 
 type PermissionCategory = 'allow' | 'ask' | 'deny'
+type PathCategory = 'allow' | 'deny'
 
 const COMMON_RULES = [
   'Read', 'Write', 'Edit', 'Glob', 'Grep',
@@ -13,6 +14,12 @@ const COMMON_RULES = [
   'Bash(npm run *)', 'WebSearch', 'WebFetch', 'Task', 'NotebookEdit',
 ]
 
+// Bash risk categories a project may auto-allow (see shellparse.Risk.AutoAllowable).
+const AUTO_ALLOW_RISKS: { value: string; label: string; description: string }[] = [
+  { value: 'read_only', label: 'Read-only', description: 'Commands that only inspect files or state (ls, cat, git status, ...)' },
+  { value: 'worktree_write', label: 'Writes inside worktree', description: 'Commands that only modify files inside the working tree (builds, tests, git commit, ...)' },
+]
+
 const CATEGORY_CONFIG: Record<PermissionCategory, {
   label: string
   description: string
@@ -55,6 +62,17 @@ export function PermissionList({ projectId }: { projectId: string }) {
   const [allow, setAllow] = useState<string[]>([])
   const [ask, setAsk] = useState<string[]>([])
   const [deny, setDeny] = useState<string[]>([])
+  const [autoAllowRisks, setAutoAllowRisks] = useState<string[]>([])
+  const [pathAllow, setPathAllow] = useState<string[]>([])
+  const [pathDeny, setPathDeny] = useState<string[]>([])
+  const [newPath, setNewPath] = useState('')
+  const [newPathCategory, setNewPathCategory] = useState<PathCategory>('deny')
+  const [egressAllow, setEgressAllow] = useState<string[]>([])
+  const [egressDeny, setEgressDeny] = useState<string[]>([])
+  const [newDomain, setNewDomain] = useState('')
+  const [newDomainCategory, setNewDomainCategory] = useState<PathCategory>('allow')
+  const [redactionPatterns, setRedactionPatterns] = useState<string[]>([])
+  const [newPattern, setNewPattern] = useState('')
   const [dirty, setDirty] = useState(false)
   const [newRule, setNewRule] = useState('')
   const [newCategory, setNewCategory] = useState<PermissionCategory>('allow')
@@ -65,6 +83,12 @@ export function PermissionList({ projectId }: { projectId: string }) {
       setAllow([...(data.permissions.allow ?? [])])
       setAsk([...(data.permissions.ask ?? [])])
       setDeny([...(data.permissions.deny ?? [])])
+      setAutoAllowRisks([...(data.permissions.autoAllowRisks ?? [])])
+      setPathAllow([...(data.permissions.pathPolicy?.allow ?? [])])
+      setPathDeny([...(data.permissions.pathPolicy?.deny ?? [])])
+      setEgressAllow([...(data.permissions.egressPolicy?.allow ?? [])])
+      setEgressDeny([...(data.permissions.egressPolicy?.deny ?? [])])
+      setRedactionPatterns([...(data.permissions.redactionPatterns ?? [])])
       setDirty(false)
     }
   }, [data])
@@ -100,9 +124,72 @@ export function PermissionList({ projectId }: { projectId: string }) {
     setList(category, list.filter(r => r !== rule))
   }
 
+  const toggleAutoAllowRisk = (risk: string) => {
+    setAutoAllowRisks(autoAllowRisks.includes(risk)
+      ? autoAllowRisks.filter(r => r !== risk)
+      : [...autoAllowRisks, risk])
+    setDirty(true)
+  }
+
+  const addPath = (e: React.FormEvent) => {
+    e.preventDefault()
+    const trimmed = newPath.trim()
+    if (!trimmed) return
+    const list = newPathCategory === 'allow' ? pathAllow : pathDeny
+    if (!list.includes(trimmed)) {
+      const next = [...list, trimmed]
+      if (newPathCategory === 'allow') setPathAllow(next)
+      else setPathDeny(next)
+      setDirty(true)
+    }
+    setNewPath('')
+  }
+
+  const removePath = (category: PathCategory, path: string) => {
+    if (category === 'allow') setPathAllow(pathAllow.filter(p => p !== path))
+    else setPathDeny(pathDeny.filter(p => p !== path))
+    setDirty(true)
+  }
+
+  const addDomain = (e: React.FormEvent) => {
+    e.preventDefault()
+    const trimmed = newDomain.trim().toLowerCase()
+    if (!trimmed) return
+    const list = newDomainCategory === 'allow' ? egressAllow : egressDeny
+    if (!list.includes(trimmed)) {
+      const next = [...list, trimmed]
+      if (newDomainCategory === 'allow') setEgressAllow(next)
+      else setEgressDeny(next)
+      setDirty(true)
+    }
+    setNewDomain('')
+  }
+
+  const removeDomain = (category: PathCategory, domain: string) => {
+    if (category === 'allow') setEgressAllow(egressAllow.filter(d => d !== domain))
+    else setEgressDeny(egressDeny.filter(d => d !== domain))
+    setDirty(true)
+  }
+
+  const addPattern = (e: React.FormEvent) => {
+    e.preventDefault()
+    const trimmed = newPattern.trim()
+    if (!trimmed) return
+    if (!redactionPatterns.includes(trimmed)) {
+      setRedactionPatterns([...redactionPatterns, trimmed])
+      setDirty(true)
+    }
+    setNewPattern('')
+  }
+
+  const removePattern = (pattern: string) => {
+    setRedactionPatterns(redactionPatterns.filter(p => p !== pattern))
+    setDirty(true)
+  }
+
   const handleSave = () => {
     updateMut.mutate(
-      { projectId, allow, ask, deny },
+      { projectId, allow, ask, deny, autoAllowRisks, pathPolicy: { allow: pathAllow, deny: pathDeny }, redactionPatterns, egressPolicy: { allow: egressAllow, deny: egressDeny } },
       {
         onSuccess: () => {
           refetch()
@@ -233,6 +320,205 @@ export function PermissionList({ projectId }: { projectId: string }) {
         </div>
       </Card>
 
+      {/* Auto-allow by risk category */}
+      <Card className="space-y-3">
+        <div>
+          <h2 className="text-sm font-medium text-gray-300">Auto-allow Bash by Risk</h2>
+          <p className="text-xs text-gray-500 mt-0.5">
+            Bash commands whose highest risk falls in a checked category run without confirmation. Ask and deny rules still apply.
+          </p>
+        </div>
+        <div className="space-y-2">
+          {AUTO_ALLOW_RISKS.map((risk) => (
+            <div key={risk.value}>
+              <Checkbox
+                label={risk.label}
+                checked={autoAllowRisks.includes(risk.value)}
+                onChange={() => toggleAutoAllowRisk(risk.value)}
+              />
+              <p className="text-xs text-gray-600 ml-6">{risk.description}</p>
+            </div>
+          ))}
+        </div>
+      </Card>
+
+      {/* Write path policy */}
+      <Card className="space-y-3">
+        <div>
+          <h2 className="text-sm font-medium text-gray-300">Write Paths</h2>
+          <p className="text-xs text-gray-500 mt-0.5">
+            Restricts where Write, Edit, NotebookEdit and shell redirects may write. Relative paths are resolved against the task's worktree. Writes under a denied path are always blocked; writes outside the allowed paths require confirmation. Leave allowed paths empty to allow everywhere not denied.
+          </p>
+        </div>
+        <form onSubmit={addPath} className="flex gap-2">
+          <Input
+            value={newPath}
+            onChange={(e) => setNewPath(e.target.value)}
+            placeholder='e.g. ., .github/workflows, ~/.ssh'
+            className="flex-1 min-w-0"
+          />
+          <div className="shrink-0">
+            <Select
+              selectSize="md"
+              value={newPathCategory}
+              onChange={(e) => setNewPathCategory(e.target.value as PathCategory)}
+            >
+              <option value="allow">Allow</option>
+              <option value="deny">Deny</option>
+            </Select>
+          </div>
+          <Button
+            type="submit"
+            variant="secondary"
+            size="md"
+            icon={<Plus className="w-3.5 h-3.5" />}
+            disabled={!newPath.trim()}
+            className="bg-slate-700 hover:bg-slate-600 shrink-0"
+          >
+            Add
+          </Button>
+        </form>
+        {(['allow', 'deny'] as PathCategory[]).map((category) => {
+          const paths = category === 'allow' ? pathAllow : pathDeny
+          if (paths.length === 0) return null
+          return (
+            <div key={category} className="flex flex-wrap items-center gap-1.5">
+              <span className={`text-xs ${CATEGORY_CONFIG[category].text}`}>{CATEGORY_CONFIG[category].label}:</span>
+              {paths.map((path) => (
+                <Badge
+                  key={path}
+                  color={CATEGORY_CONFIG[category].badgeColor}
+                  size="sm"
+                  variant="outline"
+                  className="rounded-lg font-mono"
+                >
+                  {path}
+                  <button
+                    onClick={() => removePath(category, path)}
+                    className="hover:text-white transition-colors ml-0.5"
+                  >
+                    <X className="w-3 h-3" />
+                  </button>
+                </Badge>
+              ))}
+            </div>
+          )
+        })}
+      </Card>
+
+      {/* Egress domain policy */}
+      <Card className="space-y-3">
+        <div>
+          <h2 className="text-sm font-medium text-gray-300">Network Egress</h2>
+          <p className="text-xs text-gray-500 mt-0.5">
+            Restricts the hosts WebFetch, curl, wget, git remotes and package managers may contact. A domain also matches its subdomains. Requests to a denied domain are always blocked; requests to other domains require confirmation showing the exact URL, even in bypass modes. Leave allowed domains empty to allow everywhere not denied.
+          </p>
+        </div>
+        <form onSubmit={addDomain} className="flex gap-2">
+          <Input
+            value={newDomain}
+            onChange={(e) => setNewDomain(e.target.value)}
+            placeholder='e.g. github.com, registry.npmjs.org, *.internal.example.com'
+            className="flex-1 min-w-0"
+          />
+          <div className="shrink-0">
+            <Select
+              selectSize="md"
+              value={newDomainCategory}
+              onChange={(e) => setNewDomainCategory(e.target.value as PathCategory)}
+            >
+              <option value="allow">Allow</option>
+              <option value="deny">Deny</option>
+            </Select>
+          </div>
+          <Button
+            type="submit"
+            variant="secondary"
+            size="md"
+            icon={<Plus className="w-3.5 h-3.5" />}
+            disabled={!newDomain.trim()}
+            className="bg-slate-700 hover:bg-slate-600 shrink-0"
+          >
+            Add
+          </Button>
+        </form>
+        {(['allow', 'deny'] as PathCategory[]).map((category) => {
+          const domains = category === 'allow' ? egressAllow : egressDeny
+          if (domains.length === 0) return null
+          return (
+            <div key={category} className="flex flex-wrap items-center gap-1.5">
+              <span className={`text-xs ${CATEGORY_CONFIG[category].text}`}>{CATEGORY_CONFIG[category].label}:</span>
+              {domains.map((domain) => (
+                <Badge
+                  key={domain}
+                  color={CATEGORY_CONFIG[category].badgeColor}
+                  size="sm"
+                  variant="outline"
+                  className="rounded-lg font-mono"
+                >
+                  {domain}
+                  <button
+                    onClick={() => removeDomain(category, domain)}
+                    className="hover:text-white transition-colors ml-0.5"
+                  >
+                    <X className="w-3 h-3" />
+                  </button>
+                </Badge>
+              ))}
+            </div>
+          )
+        })}
+      </Card>
+
+      {/* Secret redaction patterns */}
+      <Card className="space-y-3">
+        <div>
+          <h2 className="text-sm font-medium text-gray-300">Secret Redaction</h2>
+          <p className="text-xs text-gray-500 mt-0.5">
+            Regular expressions masked in task logs, interactions and turn logs, in addition to the built-in detectors for tokens, keys and passwords. If a pattern has a capture group, only the group is masked.
+          </p>
+        </div>
+        <form onSubmit={addPattern} className="flex gap-2">
+          <Input
+            value={newPattern}
+            onChange={(e) => setNewPattern(e.target.value)}
+            placeholder='e.g. acme_[0-9a-f]{32}, session=(\w+)'
+            className="flex-1 min-w-0 font-mono"
+          />
+          <Button
+            type="submit"
+            variant="secondary"
+            size="md"
+            icon={<Plus className="w-3.5 h-3.5" />}
+            disabled={!newPattern.trim()}
+            className="bg-slate-700 hover:bg-slate-600 shrink-0"
+          >
+            Add
+          </Button>
+        </form>
+        {redactionPatterns.length > 0 && (
+          <div className="flex flex-wrap items-center gap-1.5">
+            {redactionPatterns.map((pattern) => (
+              <Badge
+                key={pattern}
+                color="gray"
+                size="sm"
+                variant="outline"
+                className="rounded-lg font-mono"
+              >
+                {pattern}
+                <button
+                  onClick={() => removePattern(pattern)}
+                  className="hover:text-white transition-colors ml-0.5"
+                >
+                  <X className="w-3 h-3" />
+                </button>
+              </Badge>
+            ))}
+          </div>
+        )}
+      </Card>
+
       {/* Loading */}
       {isLoading && (
         <p className="text-gray-400 text-sm">Loading permissions...</p>
@@ -0,0 +1,165 @@
+import { useState } from 'react'
+import { useQuery, useMutation } from '@connectrpc/connect-query'
+import { evaluatePermission } from '@taskguild/proto/taskguild/v1/permission-PermissionService_connectquery.ts'
+import { listWorkflows } from '@taskguild/proto/taskguild/v1/workflow-WorkflowService_connectquery.ts'
+import { FlaskConical, Play } from 'lucide-react'
+import { Button, Input, Select, Badge, MutationError } from '../atoms/index.ts'
+import { Card, FormField, PageHeading } from '../molecules/index.ts'
+import { RISK_COLORS } from './RequestItem.tsx'
+
+const TOOLS = ['Bash', 'Write', 'Edit', 'NotebookEdit', 'Read', 'WebFetch', 'Skill']
+
+const MODES = ['', 'default', 'acceptEdits', 'plan', 'bypassPermissions', 'auto', 'dontAsk']
+
+const DECISION_COLORS: Record<string, 'green' | 'amber' | 'red'> = {
+  allow: 'green',
+  ask: 'amber',
+  deny: 'red',
+}
+
+// inputFor builds the tool input JSON for the tools the simulator offers.
+function inputFor(tool: string, value: string): { command?: string, toolInput?: string } {
+  switch (tool) {
+    case 'Bash':
+      return { command: value }
+    case 'NotebookEdit':
+      return { toolInput: JSON.stringify({ notebook_path: value }) }
+    case 'WebFetch':
+      return { toolInput: JSON.stringify({ url: value }) }
+    case 'Skill':
+      return { toolInput: JSON.stringify({ skill: value }) }
+    default:
+      return { toolInput: JSON.stringify({ file_path: value }) }
+  }
+}
+
+export function PermissionSimulator({ projectId }: { projectId: string }) {
+  const { data: wfData } = useQuery(listWorkflows, { projectId })
+  const evalMut = useMutation(evaluatePermission)
+
+  const [tool, setTool] = useState('Bash')
+  const [value, setValue] = useState('')
+  const [workflowId, setWorkflowId] = useState('')
+  const [statusName, setStatusName] = useState('')
+  const [mode, setMode] = useState('')
+
+  const workflows = wfData?.workflows ?? []
+  const statuses = workflows.find(w => w.id === workflowId)?.statuses ?? []
+  const result = evalMut.data
+
+  const handleEvaluate = (e: React.FormEvent) => {
+    e.preventDefault()
+    evalMut.mutate({
+      projectId,
+      toolName: tool,
+      workflowId,
+      statusName,
+      permissionMode: mode,
+      ...inputFor(tool, value),
+    })
+  }
+
+  return (
+    <div className="space-y-4">
+      <PageHeading icon={FlaskConical} title="Permission Simulator" iconColor="text-emerald-400" />
+
+      <form onSubmit={handleEvaluate}>
+        <Card className="p-4">
+          <div className="grid grid-cols-1 sm:grid-cols-4 gap-3">
+            <FormField label="Tool">
+              <Select value={tool} onChange={e => setTool(e.target.value)} selectSize="md">
+                {TOOLS.map(t => <option key={t} value={t}>{t}</option>)}
+              </Select>
+            </FormField>
+            <div className="sm:col-span-3">
+              <FormField label={tool === 'Bash' ? 'Command' : tool === 'Skill' ? 'Skill' : tool === 'WebFetch' ? 'URL' : 'Path'}>
+                <Input
+                  type="text"
+                  value={value}
+                  onChange={e => setValue(e.target.value)}
+                  placeholder={tool === 'Bash' ? 'go test ./... && git push' : ''}
+                  className="focus:border-emerald-500 font-mono text-sm"
+                />
+              </FormField>
+            </div>
+            <FormField label="Workflow">
+              <Select value={workflowId} onChange={e => { setWorkflowId(e.target.value); setStatusName('') }} selectSize="md">
+                <option value="">(none)</option>
+                {workflows.map(w => <option key={w.id} value={w.id}>{w.name}</option>)}
+              </Select>
+            </FormField>
+            <FormField label="Status">
+              <Select value={statusName} onChange={e => setStatusName(e.target.value)} selectSize="md" disabled={!workflowId}>
+                <option value="">(none)</option>
+                {statuses.map(s => <option key={s.name} value={s.name}>{s.name}</option>)}
+              </Select>
+            </FormField>
+            <FormField label="Permission Mode">
+              <Select value={mode} onChange={e => setMode(e.target.value)} selectSize="md">
+                {MODES.map(m => <option key={m} value={m}>{m || '(from status)'}</option>)}
+              </Select>
+            </FormField>
+            <div className="flex items-end">
+              <Button
+                type="submit"
+                variant="primary"
+                size="sm"
+                disabled={evalMut.isPending || !value.trim()}
+                icon={<Play className="w-3.5 h-3.5" />}
+                className="bg-emerald-600 hover:bg-emerald-500 w-full"
+              >
+                {evalMut.isPending ? 'Evaluating...' : 'Evaluate'}
+              </Button>
+            </div>
+          </div>
+          <MutationError error={evalMut.error} className="text-xs" />
+
+          {result && (
+            <div className="mt-4 space-y-2 border-t border-slate-800 pt-3">
+              <div className="flex items-center gap-2 flex-wrap">
+                <Badge color={DECISION_COLORS[result.decision] ?? 'gray'} size="sm" pill>
+                  {result.decision}
+                </Badge>
+                <code className="text-xs text-gray-400">{result.source}</code>
+                {result.rule && <code className="text-xs text-white">{result.rule}</code>}
+                <span className="text-[11px] text-gray-500">mode: {result.permissionMode}</span>
+              </div>
+              <p className="text-xs text-gray-300">{result.reason}</p>
+              {result.risk && (
+                <div className="flex items-center gap-2">
+                  <Badge color={RISK_COLORS[result.risk] ?? 'gray'} size="xs" variant="outline" pill>
+                    {result.riskLabel}
+                  </Badge>
+                  <span className="text-[11px] text-gray-500">{result.riskReason}</span>
+                </div>
+              )}
+              {result.commands.length > 0 && (
+                <ul className="space-y-1">
+                  {result.commands.map((c, i) => (
+                    <li key={i} className="flex items-center gap-2 text-xs flex-wrap">
+                      <Badge color={c.matched ? 'green' : c.opaque ? 'red' : 'gray'} size="xs" variant="outline" pill>
+                        {c.matched ? 'matched' : c.opaque ? 'opaque' : 'unmatched'}
+                      </Badge>
+                      <code className="text-white font-mono">{c.command}</code>
+                      {c.matchedPattern && <span className="text-gray-500">by {c.matchedPattern}</span>}
+                      {c.opaqueReason && <span className="text-gray-500">{c.opaqueReason}</span>}
+                    </li>
+                  ))}
+                  {result.redirects.map((r, i) => (
+                    <li key={`r${i}`} className="flex items-center gap-2 text-xs flex-wrap">
+                      <Badge color={r.matched ? 'green' : 'gray'} size="xs" variant="outline" pill>
+                        {r.matched ? 'matched' : 'unmatched'}
+                      </Badge>
+                      <code className="text-white font-mono">{r.operator} {r.path}</code>
+                      {r.matchedPattern && <span className="text-gray-500">by {r.matchedPattern}</span>}
+                    </li>
+                  ))}
+                </ul>
+              )}
+            </div>
+          )}
+        </Card>
+      </form>
+    </div>
+  )
+}
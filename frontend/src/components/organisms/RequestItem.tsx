@@ -1,10 +1,10 @@
 import { memo, useState, useRef, useEffect, useMemo, useCallback } from 'react'
 import { InteractionType, InteractionStatus } from '@taskguild/proto/taskguild/v1/interaction_pb.ts'
 import type { Interaction } from '@taskguild/proto/taskguild/v1/interaction_pb.ts'
-import { Shield, MessageSquare, Bell, CheckCircle, X, Check, XCircle, FileText } from 'lucide-react'
+import { Shield, MessageSquare, Bell, CheckCircle, X, Check, XCircle, FileText, AlertTriangle } from 'lucide-react'
 import { formatTime } from './InputBar.tsx'
 import { MarkdownDescription } from './MarkdownDescription.tsx'
-import { Button, Input, Checkbox, AsciiArtPopover } from '../atoms/index.ts'
+import { Button, Input, Checkbox, AsciiArtPopover, Badge, Select } from '../atoms/index.ts'
 import { isAsciiArt } from '../../lib/asciiArt.ts'
 
 // --- Bash Permission Metadata Types ---
@@ -14,6 +14,8 @@ interface CommandCheckResult {
   matched: boolean
   matched_pattern?: string
   suggested_pattern?: string
+  opaque?: boolean
+  opaque_reason?: string
 }
 
 interface RedirectCheckResult {
@@ -27,6 +29,22 @@ interface RedirectCheckResult {
 interface BashPermissionMetadata {
   parsed_commands: CommandCheckResult[]
   redirects: RedirectCheckResult[]
+  risk?: string
+  risk_label?: string
+  risk_reason?: string
+}
+
+// Badge colors per risk category (see shellparse.Risk).
+export const RISK_COLORS: Record<string, 'green' | 'cyan' | 'gray' | 'amber' | 'orange' | 'red'> = {
+  read_only: 'green',
+  worktree_write: 'cyan',
+  unknown: 'gray',
+  network: 'amber',
+  package_install: 'amber',
+  outside_write: 'orange',
+  process_kill: 'red',
+  git_history_rewrite: 'red',
+  privilege_escalation: 'red',
 }
 
 // --- Pattern row state for editable form ---
@@ -37,6 +55,8 @@ interface PatternRow {
   matched: boolean
   pattern: string
   checked: boolean
+  // Set when the command cannot be resolved statically and is never auto-allowed.
+  opaqueReason?: string
 }
 
 function parseBashMetadata(metadata: string): BashPermissionMetadata | null {
@@ -62,7 +82,8 @@ function buildPatternRows(meta: BashPermissionMetadata): PatternRow[] {
       type: 'command',
       matched: cmd.matched,
       pattern: cmd.matched ? (cmd.matched_pattern ?? cmd.command) : (cmd.suggested_pattern ?? cmd.command),
-      checked: !cmd.matched,
+      checked: !cmd.matched && !cmd.opaque,
+      opaqueReason: cmd.opaque ? (cmd.opaque_reason ?? 'cannot be checked statically') : undefined,
     })
   }
 
@@ -95,8 +116,19 @@ function getPermissionShortcutLabel(value: string, isBash: boolean): string | nu
   }
 }
 
+// How long and where an "Always Allow Command" grant applies.
+// Values map to the scope / ttl_hours fields read by the agent.
+const GRANT_SCOPES: { value: string; label: string; scope: string; ttlHours?: number }[] = [
+  { value: 'permanent', label: 'Permanently (project)', scope: '' },
+  { value: 'task', label: 'This task only', scope: 'task' },
+  { value: 'status', label: 'This workflow status', scope: 'status' },
+  { value: 'project-1h', label: 'Project for 1 hour', scope: 'project', ttlHours: 1 },
+  { value: 'project-8h', label: 'Project for 8 hours', scope: 'project', ttlHours: 8 },
+  { value: 'project-24h', label: 'Project for 24 hours', scope: 'project', ttlHours: 24 },
+]
+
 // Build the JSON response for "always_allow_command"
-function buildAlwaysAllowCommandResponse(rows: PatternRow[]): string {
+function buildAlwaysAllowCommandResponse(rows: PatternRow[], grantScope: string): string {
   const rules = rows
     .filter((r) => r.checked)
     .map((r) => ({
@@ -104,9 +136,13 @@ function buildAlwaysAllowCommandResponse(rows: PatternRow[]): string {
       type: r.type === 'command' ? 'command' : 'redirect',
     }))
 
+  const grant = GRANT_SCOPES.find((g) => g.value === grantScope) ?? GRANT_SCOPES[0]
+
   return JSON.stringify({
     action: 'always_allow_command',
     rules,
+    scope: grant.scope || undefined,
+    ttl_hours: grant.ttlHours,
   })
 }
 
@@ -116,10 +152,14 @@ function BashPatternEditor({
   rows,
   onUpdatePattern,
   onToggleCheck,
+  grantScope,
+  onChangeGrantScope,
 }: {
   rows: PatternRow[]
   onUpdatePattern: (key: string, pattern: string) => void
   onToggleCheck: (key: string) => void
+  grantScope: string
+  onChangeGrantScope: (scope: string) => void
 }) {
   return (
     <div className="mt-2 ml-6 space-y-1.5">
@@ -132,8 +172,13 @@ function BashPatternEditor({
           className="flex items-center gap-2 group"
         >
           {/* Match status icon */}
-          <span className="shrink-0 w-4 flex justify-center" title={row.matched ? 'Matched existing rule' : 'New pattern'}>
-            {row.matched ? (
+          <span
+            className="shrink-0 w-4 flex justify-center"
+            title={row.opaqueReason ? `Always requires approval: ${row.opaqueReason}` : row.matched ? 'Matched existing rule' : 'New pattern'}
+          >
+            {row.opaqueReason ? (
+              <AlertTriangle className="w-3.5 h-3.5 text-red-400" />
+            ) : row.matched ? (
               <Check className="w-3.5 h-3.5 text-green-400" />
             ) : (
               <XCircle className="w-3.5 h-3.5 text-amber-400" />
@@ -164,6 +209,20 @@ function BashPatternEditor({
           />
         </div>
       ))}
+      <div className="flex items-center gap-2 pt-1">
+        <span className="text-[10px] text-gray-500 uppercase tracking-wide shrink-0">Always allow</span>
+        <Select
+          selectSize="xs"
+          value={grantScope}
+          onChange={(e) => onChangeGrantScope(e.target.value)}
+          onClick={(e) => e.stopPropagation()}
+          onKeyDown={(e) => e.stopPropagation()}
+        >
+          {GRANT_SCOPES.map((g) => (
+            <option key={g.value} value={g.value}>{g.label}</option>
+          ))}
+        </Select>
+      </div>
     </div>
   )
 }
@@ -201,6 +260,8 @@ function RequestItemImpl({
     bashMeta ? buildPatternRows(bashMeta) : [],
   )
 
+  const [grantScope, setGrantScope] = useState('permanent')
+
   // Re-initialize pattern rows when metadata changes
   useEffect(() => {
     if (bashMeta) {
@@ -231,13 +292,13 @@ function RequestItemImpl({
   const handleRespond = useCallback(
     (id: string, value: string) => {
       if (value === 'always_allow_command') {
-        const jsonResponse = buildAlwaysAllowCommandResponse(patternRows)
+        const jsonResponse = buildAlwaysAllowCommandResponse(patternRows, grantScope)
         onRespond(id, jsonResponse)
       } else {
         onRespond(id, value)
       }
     },
-    [onRespond, patternRows],
+    [onRespond, patternRows, grantScope],
   )
 
   // Auto-scroll into view when selected
@@ -293,6 +354,16 @@ function RequestItemImpl({
         )}
       </div>
 
+      {/* Highest command risk — only shown for pending Bash requests */}
+      {isPending && bashMeta?.risk && (
+        <div className="mt-1.5 ml-6" title={bashMeta.risk_reason}>
+          <Badge color={RISK_COLORS[bashMeta.risk] ?? 'gray'} size="xs" variant="outline">
+            <AlertTriangle className="w-3 h-3" />
+            {bashMeta.risk_label ?? bashMeta.risk}
+          </Badge>
+        </div>
+      )}
+
       {/* Description — only shown for pending */}
       {isPending && interaction.description && (
         <div className="mt-1.5 ml-6">
@@ -306,6 +377,8 @@ function RequestItemImpl({
           rows={patternRows}
           onUpdatePattern={handleUpdatePattern}
           onToggleCheck={handleToggleCheck}
+          grantScope={grantScope}
+          onChangeGrantScope={setGrantScope}
         />
       )}
 
@@ -0,0 +1,236 @@
+import { useState } from 'react'
+import { useQuery, useMutation } from '@connectrpc/connect-query'
+import {
+  listSecrets,
+  setSecret,
+  deleteSecret,
+} from '@taskguild/proto/taskguild/v1/secret-SecretService_connectquery.ts'
+import { KeyRound, Plus, Trash2, Edit2, X } from 'lucide-react'
+import { Button, Input, Badge, MutationError } from '../atoms/index.ts'
+import { Card, FormField, PageHeading, EmptyState } from '../molecules/index.ts'
+
+const NAME_PATTERN = /^[A-Z_][A-Z0-9_]*$/
+
+interface FormData {
+  name: string
+  value: string
+}
+
+const emptyForm: FormData = {
+  name: '',
+  value: '',
+}
+
+export function SecretList({ projectId }: { projectId: string }) {
+  const { data, refetch, isLoading } = useQuery(listSecrets, { projectId })
+  const setMut = useMutation(setSecret)
+  const deleteMut = useMutation(deleteSecret)
+
+  const [showForm, setShowForm] = useState(false)
+  const [form, setForm] = useState<FormData>(emptyForm)
+  // Name of the secret being replaced; null when adding a new one.
+  const [replacing, setReplacing] = useState<string | null>(null)
+  const [validationError, setValidationError] = useState<string | null>(null)
+
+  const secrets = data?.secrets ?? []
+  const enabled = data?.enabled ?? true
+
+  const closeForm = () => {
+    setShowForm(false)
+    setForm(emptyForm)
+    setReplacing(null)
+    setValidationError(null)
+  }
+
+  const openReplace = (name: string) => {
+    setForm({ name, value: '' })
+    setReplacing(name)
+    setShowForm(true)
+    setValidationError(null)
+  }
+
+  const handleSubmit = (e: React.FormEvent) => {
+    e.preventDefault()
+    const name = form.name.trim()
+    if (!NAME_PATTERN.test(name)) {
+      setValidationError('Use upper-case letters, digits and underscores (e.g. NPM_TOKEN)')
+      return
+    }
+    if (!replacing && secrets.some(s => s.name === name)) {
+      setValidationError('A secret with this name already exists')
+      return
+    }
+    setValidationError(null)
+    setMut.mutate(
+      { projectId, name, value: form.value },
+      {
+        onSuccess: () => {
+          closeForm()
+          refetch()
+        },
+      },
+    )
+  }
+
+  const handleDelete = (name: string) => {
+    if (!confirm(`Delete secret ${name}?`)) return
+    deleteMut.mutate({ projectId, name }, { onSuccess: () => refetch() })
+  }
+
+  return (
+    <div className="space-y-4">
+      {/* Header */}
+      <div className="flex items-center justify-between">
+        <PageHeading icon={KeyRound} title="Secrets" iconColor="text-amber-400">
+          <Badge color="gray" size="xs" pill variant="outline">
+            {secrets.length}
+          </Badge>
+        </PageHeading>
+        <Button
+          variant="primary"
+          size="sm"
+          onClick={() => { setShowForm(true); setReplacing(null); setForm(emptyForm); setValidationError(null) }}
+          icon={<Plus className="w-4 h-4" />}
+          className="bg-amber-600 hover:bg-amber-500"
+          disabled={showForm || !enabled}
+        >
+          Add Secret
+        </Button>
+      </div>
+
+      <p className="text-xs text-gray-500">
+        Secrets are encrypted at rest and exposed as environment variables to Claude sessions, hooks
+        and scripts. Their values are masked in task logs and are never shown again after saving.
+      </p>
+
+      {!enabled && (
+        <p className="text-xs text-amber-400">
+          The secret store is disabled. Set TASKGUILD_SECRET_MASTER_KEY on the server to enable it.
+        </p>
+      )}
+
+      {/* Add / Replace Form */}
+      {showForm && (
+        <form onSubmit={handleSubmit}>
+          <Card className="p-4">
+            <div className="flex items-center justify-between mb-3">
+              <h3 className="text-sm font-semibold text-white">
+                {replacing ? `Replace ${replacing}` : 'New Secret'}
+              </h3>
+              <Button
+                variant="ghost"
+                size="sm"
+                iconOnly
+                onClick={closeForm}
+                type="button"
+                icon={<X className="w-4 h-4" />}
+              />
+            </div>
+            <div className="grid grid-cols-1 sm:grid-cols-2 gap-3">
+              <FormField label="Name *" hint="Environment variable name">
+                <Input
+                  type="text"
+                  required
+                  value={form.name}
+                  disabled={replacing !== null}
+                  onChange={e => { setForm(prev => ({ ...prev, name: e.target.value.toUpperCase() })); setValidationError(null) }}
+                  placeholder="NPM_TOKEN"
+                  className="focus:border-amber-500 font-mono text-sm"
+                />
+              </FormField>
+              <FormField label="Value *">
+                <Input
+                  type="password"
+                  required
+                  autoComplete="off"
+                  value={form.value}
+                  onChange={e => setForm(prev => ({ ...prev, value: e.target.value }))}
+                  className="focus:border-amber-500 font-mono text-sm"
+                />
+              </FormField>
+            </div>
+
+            {validationError && (
+              <p className="text-red-400 text-xs mt-2">{validationError}</p>
+            )}
+            <MutationError error={setMut.error} className="text-xs" />
+
+            <div className="flex justify-end gap-2 mt-3">
+              <Button type="button" variant="secondary" size="sm" onClick={closeForm}>
+                Cancel
+              </Button>
+              <Button
+                type="submit"
+                variant="primary"
+                size="sm"
+                disabled={setMut.isPending || !form.name.trim() || !form.value}
+                icon={<KeyRound className="w-3.5 h-3.5" />}
+                className="bg-amber-600 hover:bg-amber-500"
+              >
+                {setMut.isPending ? 'Saving...' : 'Save'}
+              </Button>
+            </div>
+          </Card>
+        </form>
+      )}
+
+      {/* Loading */}
+      {isLoading && <p className="text-gray-400 text-sm">Loading secrets...</p>}
+
+      {/* Secrets List */}
+      {!isLoading && secrets.length > 0 && (
+        <div className="space-y-2">
+          {secrets.map(s => (
+            <Card key={s.name} className="hover:border-slate-700 transition-colors">
+              <div className="flex items-center justify-between">
+                <div className="flex items-center gap-3 flex-1 min-w-0">
+                  <KeyRound className="w-4 h-4 text-amber-400 shrink-0" />
+                  <div className="flex-1 min-w-0">
+                    <code className="text-sm text-white font-mono truncate">{s.name}</code>
+                    {s.updatedAt && (
+                      <p className="text-[11px] text-gray-500 mt-0.5">
+                        Updated {new Date(Number(s.updatedAt.seconds) * 1000).toLocaleString()}
+                      </p>
+                    )}
+                  </div>
+                </div>
+                <div className="flex items-center gap-1 shrink-0 ml-2">
+                  <Button
+                    variant="ghost"
+                    size="sm"
+                    iconOnly
+                    onClick={() => openReplace(s.name)}
+                    disabled={!enabled}
+                    title="Replace value"
+                    className="hover:text-amber-400"
+                    icon={<Edit2 className="w-3.5 h-3.5" />}
+                  />
+                  <Button
+                    variant="ghost"
+                    size="sm"
+                    iconOnly
+                    onClick={() => handleDelete(s.name)}
+                    disabled={deleteMut.isPending}
+                    title="Delete"
+                    className="hover:text-red-400"
+                    icon={<Trash2 className="w-3.5 h-3.5" />}
+                  />
+                </div>
+              </div>
+            </Card>
+          ))}
+        </div>
+      )}
+      <MutationError error={deleteMut.error} className="text-xs" />
+
+      {/* Empty State */}
+      {!isLoading && secrets.length === 0 && !showForm && (
+        <EmptyState
+          icon={KeyRound}
+          message="No secrets defined yet."
+          hint="Store tokens here instead of hard-coding them into scripts."
+        />
+      )}
+    </div>
+  )
+}
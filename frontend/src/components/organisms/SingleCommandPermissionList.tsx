@@ -5,22 +5,29 @@ import {
   createSingleCommandPermission,
   updateSingleCommandPermission,
   deleteSingleCommandPermission,
+  listPermissionSuggestions,
 } from '@taskguild/proto/taskguild/v1/single_command_permission-SingleCommandPermissionService_connectquery.ts'
 import type { SingleCommandPermission } from '@taskguild/proto/taskguild/v1/single_command_permission_pb.ts'
-import { Terminal, Plus, Trash2, Edit2, X, Check } from 'lucide-react'
+import { Terminal, Plus, Trash2, Edit2, X, Check, Lightbulb } from 'lucide-react'
 import { Button, Input, Select, Badge, MutationError } from '../atoms/index.ts'
 import { Card, FormField, PageHeading, EmptyState } from '../molecules/index.ts'
+import { RISK_COLORS } from './RequestItem.tsx'
 
 type PermissionType = 'command' | 'redirect'
+type PermissionAction = 'allow' | 'deny'
 
 interface FormData {
   pattern: string
   type: PermissionType
+  action: PermissionAction
+  reason: string
 }
 
 const emptyForm: FormData = {
   pattern: '',
   type: 'command',
+  action: 'allow',
+  reason: '',
 }
 
 export function SingleCommandPermissionList({ projectId }: { projectId: string }) {
@@ -28,6 +35,7 @@ export function SingleCommandPermissionList({ projectId }: { projectId: string }
   const createMut = useMutation(createSingleCommandPermission)
   const updateMut = useMutation(updateSingleCommandPermission)
   const deleteMut = useMutation(deleteSingleCommandPermission)
+  const { data: suggestionData, refetch: refetchSuggestions } = useQuery(listPermissionSuggestions, { projectId })
 
   const [showAddForm, setShowAddForm] = useState(false)
   const [form, setForm] = useState<FormData>(emptyForm)
@@ -36,6 +44,8 @@ export function SingleCommandPermissionList({ projectId }: { projectId: string }
   const [validationError, setValidationError] = useState<string | null>(null)
 
   const permissions = data?.permissions ?? []
+  const suggestions = suggestionData?.suggestions ?? []
+  const unusedRules = suggestionData?.unusedRules ?? []
 
   const validatePattern = (pattern: string): string | null => {
     if (!pattern.trim()) return 'Pattern is required'
@@ -59,12 +69,13 @@ export function SingleCommandPermissionList({ projectId }: { projectId: string }
     }
     setValidationError(null)
     createMut.mutate(
-      { projectId, pattern: form.pattern, type: form.type },
+      { projectId, pattern: form.pattern, type: form.type, action: form.action, reason: form.action === 'deny' ? form.reason : '' },
       {
         onSuccess: () => {
           setForm(emptyForm)
           setShowAddForm(false)
           refetch()
+          refetchSuggestions()
         },
       },
     )
@@ -72,7 +83,12 @@ export function SingleCommandPermissionList({ projectId }: { projectId: string }
 
   const openEdit = (p: SingleCommandPermission) => {
     setEditingId(p.id)
-    setEditForm({ pattern: p.pattern, type: p.type as PermissionType })
+    setEditForm({
+      pattern: p.pattern,
+      type: p.type as PermissionType,
+      action: (p.action || 'allow') as PermissionAction,
+      reason: p.reason,
+    })
     setValidationError(null)
   }
 
@@ -94,7 +110,13 @@ export function SingleCommandPermissionList({ projectId }: { projectId: string }
     }
     setValidationError(null)
     updateMut.mutate(
-      { id, pattern: editForm.pattern, type: editForm.type },
+      {
+        id,
+        pattern: editForm.pattern,
+        type: editForm.type,
+        action: editForm.action,
+        reason: editForm.action === 'deny' ? editForm.reason : '',
+      },
       {
         onSuccess: () => {
           cancelEdit()
@@ -106,7 +128,14 @@ export function SingleCommandPermissionList({ projectId }: { projectId: string }
 
   const handleDelete = (id: string) => {
     if (!confirm('Delete this permission rule?')) return
-    deleteMut.mutate({ id }, { onSuccess: () => refetch() })
+    deleteMut.mutate({ id }, { onSuccess: () => { refetch(); refetchSuggestions() } })
+  }
+
+  const acceptSuggestion = (pattern: string, type: string) => {
+    createMut.mutate(
+      { projectId, pattern, type, action: 'allow' },
+      { onSuccess: () => { refetch(); refetchSuggestions() } },
+    )
   }
 
   return (
@@ -166,6 +195,27 @@ export function SingleCommandPermissionList({ projectId }: { projectId: string }
                   <option value="redirect">redirect</option>
                 </Select>
               </FormField>
+              <FormField label="Action">
+                <Select
+                  value={form.action}
+                  onChange={e => setForm(prev => ({ ...prev, action: e.target.value as PermissionAction }))}
+                  selectSize="md"
+                >
+                  <option value="allow">allow</option>
+                  <option value="deny">deny</option>
+                </Select>
+              </FormField>
+              {form.action === 'deny' && (
+                <FormField label="Reason" hint="Shown to the agent when the rule blocks a command">
+                  <Input
+                    type="text"
+                    value={form.reason}
+                    onChange={e => setForm(prev => ({ ...prev, reason: e.target.value }))}
+                    placeholder="Use trash instead"
+                    className="focus:border-purple-500 text-sm"
+                  />
+                </FormField>
+              )}
             </div>
 
             {validationError && !editingId && (
@@ -228,6 +278,27 @@ export function SingleCommandPermissionList({ projectId }: { projectId: string }
                         <option value="redirect">redirect</option>
                       </Select>
                     </FormField>
+                    <FormField label="Action">
+                      <Select
+                        value={editForm.action}
+                        onChange={e => setEditForm(prev => ({ ...prev, action: e.target.value as PermissionAction }))}
+                        selectSize="md"
+                      >
+                        <option value="allow">allow</option>
+                        <option value="deny">deny</option>
+                      </Select>
+                    </FormField>
+                    {editForm.action === 'deny' && (
+                      <FormField label="Reason">
+                        <Input
+                          type="text"
+                          value={editForm.reason}
+                          onChange={e => setEditForm(prev => ({ ...prev, reason: e.target.value }))}
+                          placeholder="Use trash instead"
+                          className="focus:border-purple-500 text-sm"
+                        />
+                      </FormField>
+                    )}
                   </div>
                   {validationError && editingId && (
                     <p className="text-red-400 text-xs mt-2">{validationError}</p>
@@ -271,7 +342,36 @@ export function SingleCommandPermissionList({ projectId }: { projectId: string }
                         >
                           {perm.type}
                         </Badge>
+                        {perm.action === 'deny' && (
+                          <Badge color="red" size="xs" variant="outline" pill>
+                            deny
+                          </Badge>
+                        )}
+                        {perm.scope === 'task' && (
+                          <Badge color="cyan" size="xs" variant="outline" pill>
+                            task {perm.taskId.slice(-6)}
+                          </Badge>
+                        )}
+                        {perm.scope === 'status' && (
+                          <Badge color="cyan" size="xs" variant="outline" pill>
+                            status {perm.statusName}
+                          </Badge>
+                        )}
+                        {perm.expiresAt && (
+                          <Badge color="amber" size="xs" variant="outline" pill>
+                            expires {new Date(Number(perm.expiresAt.seconds) * 1000).toLocaleString()}
+                          </Badge>
+                        )}
                       </div>
+                      {perm.action === 'deny' && perm.reason && (
+                        <p className="text-[11px] text-gray-500 mt-0.5">{perm.reason}</p>
+                      )}
+                      {(perm.grantedBy || perm.originTaskId) && (
+                        <p className="text-[11px] text-gray-500 mt-0.5">
+                          Granted{perm.grantedBy && <> by {perm.grantedBy}</>}
+                          {perm.originTaskId && <> from task {perm.originTaskId}</>}
+                        </p>
+                      )}
                     </div>
                   </div>
                   <div className="flex items-center gap-1 shrink-0 ml-2">
@@ -310,6 +410,76 @@ export function SingleCommandPermissionList({ projectId }: { projectId: string }
           hint="Add wildcard-based rules to allow specific shell commands without confirmation."
         />
       )}
+
+      {/* Suggestions mined from approval history */}
+      {suggestions.length > 0 && (
+        <div className="space-y-2">
+          <h3 className="flex items-center gap-1.5 text-sm font-semibold text-white">
+            <Lightbulb className="w-4 h-4 text-amber-400" />
+            Suggested Rules
+          </h3>
+          {suggestions.map(s => (
+            <Card key={s.pattern} className="hover:border-slate-700 transition-colors">
+              <div className="flex items-center justify-between">
+                <div className="flex-1 min-w-0">
+                  <div className="flex items-center gap-2 flex-wrap">
+                    <code className="text-sm text-white font-mono truncate">{s.pattern}</code>
+                    <Badge color={RISK_COLORS[s.risk] ?? 'gray'} size="xs" variant="outline" pill>
+                      {s.risk}
+                    </Badge>
+                  </div>
+                  <p className="text-[11px] text-gray-500 mt-0.5 truncate">
+                    Approved {s.approvals} times: {s.examples.slice(0, 3).join(', ')}
+                    {s.examples.length > 3 && <>, +{s.examples.length - 3} more</>}
+                  </p>
+                </div>
+                <Button
+                  variant="primary"
+                  size="sm"
+                  onClick={() => acceptSuggestion(s.pattern, s.type)}
+                  disabled={createMut.isPending}
+                  icon={<Check className="w-3.5 h-3.5" />}
+                  className="bg-purple-600 hover:bg-purple-500 shrink-0 ml-2"
+                >
+                  Accept
+                </Button>
+              </div>
+            </Card>
+          ))}
+        </div>
+      )}
+
+      {/* Allow rules that matched nothing in the retained history */}
+      {unusedRules.length > 0 && (
+        <div className="space-y-2">
+          <h3 className="text-sm font-semibold text-white">Unused Rules</h3>
+          <p className="text-[11px] text-gray-500">
+            These allow rules matched no command in the retained history. Consider removing them.
+          </p>
+          {unusedRules.map(rule => (
+            <Card key={rule.id} className="hover:border-slate-700 transition-colors">
+              <div className="flex items-center justify-between">
+                <div className="flex items-center gap-2 flex-1 min-w-0">
+                  <code className="text-sm text-gray-300 font-mono truncate">{rule.pattern}</code>
+                  <Badge color={rule.type === 'command' ? 'blue' : 'orange'} size="xs" variant="outline" pill>
+                    {rule.type}
+                  </Badge>
+                </div>
+                <Button
+                  variant="ghost"
+                  size="sm"
+                  iconOnly
+                  onClick={() => handleDelete(rule.id)}
+                  disabled={deleteMut.isPending}
+                  title="Delete"
+                  className="hover:text-red-400"
+                  icon={<Trash2 className="w-3.5 h-3.5" />}
+                />
+              </div>
+            </Card>
+          ))}
+        </div>
+      )}
     </div>
   )
 }
@@ -32,6 +32,7 @@ interface BashCommandCheckResult {
   matched: boolean
   matched_pattern?: string
   suggested_pattern?: string
+  opaque?: boolean
 }
 
 interface BashRedirectCheckResult {
@@ -68,8 +69,9 @@ function buildDefaultAlwaysAllowResponse(meta: BashPermissionMeta): string {
   const rules: Array<{ pattern: string; type: string; label: string }> = []
 
   for (const cmd of meta.parsed_commands) {
-    // Skip already-matched commands — they are already allowed by existing rules
-    if (cmd.matched) continue
+    // Skip already-matched commands — they are already allowed by existing rules.
+    // Opaque commands are never auto-allowed, so a rule for them would be useless.
+    if (cmd.matched || cmd.opaque) continue
     const pattern = cmd.suggested_pattern ?? cmd.command
     rules.push({ pattern, type: 'command', label: cmd.command })
   }
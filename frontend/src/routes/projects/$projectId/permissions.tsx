@@ -1,5 +1,7 @@
 import { createFileRoute } from '@tanstack/react-router'
 import { PermissionList } from '@/components/organisms/PermissionList'
+import { PermissionSimulator } from '@/components/organisms/PermissionSimulator'
+import { SecretList } from '@/components/organisms/SecretList'
 import { SingleCommandPermissionList } from '@/components/organisms/SingleCommandPermissionList'
 import { useDocumentTitle } from '@/hooks/useDocumentTitle'
 
@@ -15,6 +17,10 @@ function PermissionsPage() {
       <PermissionList projectId={projectId} />
       <div className="border-t border-slate-800" />
       <SingleCommandPermissionList projectId={projectId} />
+      <div className="border-t border-slate-800" />
+      <PermissionSimulator projectId={projectId} />
+      <div className="border-t border-slate-800" />
+      <SecretList projectId={projectId} />
     </div>
   )
 }
@@ -14,6 +14,7 @@ type Agent struct {
 	PermissionMode  string    `yaml:"permission_mode"`
 	Skills          []string  `yaml:"skills"`
 	Memory          string    `yaml:"memory"`
+	FallbackModels  []string  `yaml:"fallback_models,omitempty"`
 	IsSynced        bool      `yaml:"is_synced"`
 	CreatedAt       time.Time `yaml:"created_at"`
 	UpdatedAt       time.Time `yaml:"updated_at"`
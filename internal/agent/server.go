@@ -64,6 +64,7 @@ func (s *Server) CreateAgent(ctx context.Context, req *connect.Request[taskguild
 		PermissionMode:  req.Msg.GetPermissionMode(),
 		Skills:          req.Msg.GetSkills(),
 		Memory:          req.Msg.GetMemory(),
+		FallbackModels:  req.Msg.GetFallbackModels(),
 		IsSynced:        false,
 		CreatedAt:       now,
 		UpdatedAt:       now,
@@ -165,6 +166,10 @@ func (s *Server) UpdateAgent(ctx context.Context, req *connect.Request[taskguild
 		a.Memory = req.Msg.GetMemory()
 	}
 
+	if req.Msg.FallbackModels != nil {
+		a.FallbackModels = req.Msg.GetFallbackModels()
+	}
+
 	a.UpdatedAt = time.Now()
 	if err := s.repo.Update(ctx, a); err != nil {
 		return nil, err
@@ -544,6 +549,7 @@ func toProto(a *Agent) *taskguildv1.AgentDefinition {
 		PermissionMode:  a.PermissionMode,
 		Skills:          a.Skills,
 		Memory:          a.Memory,
+		FallbackModels:  a.FallbackModels,
 		IsSynced:        a.IsSynced,
 		CreatedAt:       timestamppb.New(a.CreatedAt),
 		UpdatedAt:       timestamppb.New(a.UpdatedAt),
@@ -67,11 +67,15 @@ func (s *Server) SyncPermissions(ctx context.Context, req *connect.Request[taskg
 
 	return connect.NewResponse(&taskguildv1.SyncPermissionsResponse{
 		Permissions: &taskguildv1.PermissionSet{
-			ProjectId: proj.ID,
-			Allow:     merged.Allow,
-			Ask:       merged.Ask,
-			Deny:      merged.Deny,
-			UpdatedAt: timestamppb.New(merged.UpdatedAt),
+			ProjectId:         proj.ID,
+			Allow:             merged.Allow,
+			Ask:               merged.Ask,
+			Deny:              merged.Deny,
+			AutoAllowRisks:    merged.AutoAllowRisks,
+			PathPolicy:        permission.PathPolicyToProto(merged.PathPolicy),
+			RedactionPatterns: merged.RedactionPatterns,
+			EgressPolicy:      permission.EgressPolicyToProto(merged.EgressPolicy),
+			UpdatedAt:         timestamppb.New(merged.UpdatedAt),
 		},
 	}), nil
 }
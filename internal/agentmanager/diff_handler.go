@@ -0,0 +1,272 @@
+package agentmanager
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/kazz187/taskguild/internal/task"
+	"github.com/kazz187/taskguild/internal/tasklog"
+	"github.com/kazz187/taskguild/pkg/cerr"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+const (
+	// taskDiffTimeout is how long GetTaskDiff waits for an agent-manager to
+	// report the diff.
+	taskDiffTimeout = 30 * time.Second
+
+	// maxTaskDiffPatchBytes limits the patch returned by GetTaskDiff.
+	maxTaskDiffPatchBytes = 1 << 20
+
+	// maxSnapshotPatchBytes limits the patch stored in a DIFF task log.
+	maxSnapshotPatchBytes = 64 << 10
+)
+
+// GetTaskDiff asks the agent-managers serving the task's project for the
+// diff of its worktree and waits for the one holding the worktree to reply.
+// If none can, the latest diff snapshot from the task logs is returned.
+func (s *Server) GetTaskDiff(ctx context.Context, req *connect.Request[taskguildv1.GetTaskDiffRequest]) (*connect.Response[taskguildv1.GetTaskDiffResponse], error) {
+	if req.Msg.GetTaskId() == "" {
+		return nil, cerr.NewError(cerr.InvalidArgument, "task_id is required", nil).ConnectError()
+	}
+
+	t, err := s.taskRepo.Get(ctx, req.Msg.GetTaskId())
+	if err != nil {
+		return nil, cerr.ExtractConnectError(ctx, err)
+	}
+
+	if t.Metadata["worktree"] == "" {
+		return nil, cerr.NewError(cerr.FailedPrecondition, "task has no worktree", nil).ConnectError()
+	}
+
+	diff, fetchErr := s.fetchTaskDiff(ctx, t, maxTaskDiffPatchBytes)
+	if fetchErr == nil {
+		redactTaskDiff(s.redactor(ctx, t.ProjectID), diff)
+
+		return connect.NewResponse(&taskguildv1.GetTaskDiffResponse{Diff: diff}), nil
+	}
+
+	snapshot, err := s.latestDiffSnapshot(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot == nil {
+		return nil, fetchErr
+	}
+
+	slog.Info("serving task diff from snapshot", "task_id", t.ID, "reason", fetchErr)
+
+	// Snapshots recorded before the current patterns are redacted again.
+	redactTaskDiff(s.redactor(ctx, t.ProjectID), snapshot)
+
+	return connect.NewResponse(&taskguildv1.GetTaskDiffResponse{
+		Diff:         snapshot,
+		FromSnapshot: true,
+	}), nil
+}
+
+// diffWaiter receives the reports for one TaskDiffCommand. done is closed
+// when the requester stops waiting, releasing reporters blocked on ch.
+type diffWaiter struct {
+	ch   chan *taskguildv1.ReportTaskDiffRequest
+	done chan struct{}
+}
+
+// fetchTaskDiff broadcasts a TaskDiffCommand for the task's worktree and
+// waits for the first agent-manager that has the worktree to report it.
+func (s *Server) fetchTaskDiff(ctx context.Context, t *task.Task, maxPatchBytes int32) (*taskguildv1.TaskDiff, error) {
+	proj, err := s.projectRepo.Get(ctx, t.ProjectID)
+	if err != nil {
+		return nil, cerr.ExtractConnectError(ctx, err)
+	}
+
+	baseBranch := taskBaseBranch(t, proj.DefaultBranch)
+
+	requestID := ulid.Make().String()
+	w := &diffWaiter{
+		ch:   make(chan *taskguildv1.ReportTaskDiffRequest),
+		done: make(chan struct{}),
+	}
+
+	s.diffMu.Lock()
+	s.diffWaiters[requestID] = w
+	s.diffMu.Unlock()
+
+	defer func() {
+		s.diffMu.Lock()
+		delete(s.diffWaiters, requestID)
+		s.diffMu.Unlock()
+		close(w.done)
+	}()
+
+	pending := s.registry.BroadcastCommandToProject(proj.Name, &taskguildv1.AgentCommand{
+		Command: &taskguildv1.AgentCommand_TaskDiff{
+			TaskDiff: &taskguildv1.TaskDiffCommand{
+				RequestId:     requestID,
+				TaskId:        t.ID,
+				WorktreeName:  t.Metadata["worktree"],
+				BaseBranch:    baseBranch,
+				MaxPatchBytes: maxPatchBytes,
+			},
+		},
+	})
+	if pending == 0 {
+		return nil, cerr.NewError(cerr.Unavailable,
+			fmt.Sprintf("no agent manager connected for project %q", proj.Name), nil).ConnectError()
+	}
+
+	timer := time.NewTimer(taskDiffTimeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case report := <-w.ch:
+			if report.GetNotFound() {
+				pending--
+				if pending == 0 {
+					return nil, cerr.NewError(cerr.NotFound,
+						fmt.Sprintf("worktree %q not found on any connected agent manager", t.Metadata["worktree"]), nil).ConnectError()
+				}
+
+				continue
+			}
+
+			if report.GetErrorMessage() != "" {
+				return nil, cerr.NewError(cerr.Internal, "failed to compute task diff: "+report.GetErrorMessage(), nil).ConnectError()
+			}
+
+			return report.GetDiff(), nil
+		case <-timer.C:
+			return nil, cerr.NewError(cerr.DeadlineExceeded, "timed out waiting for the task diff", nil).ConnectError()
+		case <-ctx.Done():
+			return nil, cerr.ExtractConnectError(ctx, ctx.Err())
+		}
+	}
+}
+
+func (s *Server) ReportTaskDiff(ctx context.Context, req *connect.Request[taskguildv1.ReportTaskDiffRequest]) (*connect.Response[taskguildv1.ReportTaskDiffResponse], error) {
+	if req.Msg.GetRequestId() == "" {
+		return nil, cerr.NewError(cerr.InvalidArgument, "request_id is required", nil).ConnectError()
+	}
+
+	s.diffMu.Lock()
+	w, ok := s.diffWaiters[req.Msg.GetRequestId()]
+	s.diffMu.Unlock()
+
+	if !ok {
+		// The requester already got its answer or gave up.
+		return connect.NewResponse(&taskguildv1.ReportTaskDiffResponse{}), nil
+	}
+
+	// Every report counts (not-found replies decide when to give up), so
+	// wait until the requester takes it or stops waiting.
+	select {
+	case w.ch <- req.Msg:
+	case <-w.done:
+	case <-ctx.Done():
+		return nil, cerr.ExtractConnectError(ctx, ctx.Err())
+	}
+
+	return connect.NewResponse(&taskguildv1.ReportTaskDiffResponse{}), nil
+}
+
+// SnapshotTaskDiff records the diff of the task's worktree as a DIFF task log
+// in the background. Failures (e.g. the worktree does not exist yet) are
+// logged and otherwise ignored.
+func (s *Server) SnapshotTaskDiff(t *task.Task, fromStatus, toStatus string) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), taskDiffTimeout+5*time.Second)
+		defer cancel()
+
+		diff, err := s.fetchTaskDiff(ctx, t, maxSnapshotPatchBytes)
+		if err != nil {
+			slog.Info("skipping task diff snapshot", "task_id", t.ID, "error", err)
+			return
+		}
+
+		if err := s.recordDiffSnapshot(ctx, t, fromStatus, toStatus, diff); err != nil {
+			slog.Error("failed to record task diff snapshot", "task_id", t.ID, "error", err)
+		}
+	}()
+}
+
+// recordDiffSnapshot stores diff as a DIFF task log.
+func (s *Server) recordDiffSnapshot(ctx context.Context, t *task.Task, fromStatus, toStatus string, diff *taskguildv1.TaskDiff) error {
+	r := s.redactor(ctx, t.ProjectID)
+	redactTaskDiff(r, diff)
+
+	encoded, err := protojson.Marshal(diff)
+	if err != nil {
+		return err
+	}
+
+	l := &tasklog.TaskLog{
+		ID:        ulid.Make().String(),
+		ProjectID: t.ProjectID,
+		TaskID:    t.ID,
+		Level:     int32(taskguildv1.TaskLogLevel_TASK_LOG_LEVEL_INFO),
+		Category:  int32(taskguildv1.TaskLogCategory_TASK_LOG_CATEGORY_DIFF),
+		Message: fmt.Sprintf("Diff vs %s (%s → %s): %d files changed, +%d -%d",
+			diff.GetBaseBranch(), fromStatus, toStatus, len(diff.GetFiles()), diff.GetAdditions(), diff.GetDeletions()),
+		Metadata: map[string]string{
+			"from_status":   fromStatus,
+			"to_status":     toStatus,
+			"base_branch":   diff.GetBaseBranch(),
+			"base_commit":   diff.GetBaseCommit(),
+			"head_commit":   diff.GetHeadCommit(),
+			"files_changed": strconv.Itoa(len(diff.GetFiles())),
+			"additions":     strconv.Itoa(int(diff.GetAdditions())),
+			"deletions":     strconv.Itoa(int(diff.GetDeletions())),
+			"diff":          string(encoded),
+		},
+		CreatedAt: time.Now(),
+	}
+	redactTaskLog(r, l)
+
+	if err := s.taskLogRepo.Create(ctx, l); err != nil {
+		return err
+	}
+
+	s.eventBus.PublishNew(
+		taskguildv1.EventType_EVENT_TYPE_TASK_LOG,
+		l.ID,
+		"",
+		map[string]string{"task_id": t.ID, "project_id": t.ProjectID},
+	)
+
+	return nil
+}
+
+// latestDiffSnapshot returns the diff stored in the task's most recent DIFF
+// log, or nil if there is none.
+func (s *Server) latestDiffSnapshot(ctx context.Context, taskID string) (*taskguildv1.TaskDiff, error) {
+	logs, _, err := s.taskLogRepo.List(ctx, taskID, nil, 0, 0)
+	if err != nil {
+		return nil, cerr.ExtractConnectError(ctx, err)
+	}
+
+	for i := len(logs) - 1; i >= 0; i-- {
+		l := logs[i]
+		if l.Category != int32(taskguildv1.TaskLogCategory_TASK_LOG_CATEGORY_DIFF) {
+			continue
+		}
+
+		var diff taskguildv1.TaskDiff
+		if err := protojson.Unmarshal([]byte(l.Metadata["diff"]), &diff); err != nil {
+			slog.Warn("ignoring malformed diff snapshot", "task_id", taskID, "log_id", l.ID, "error", err)
+			continue
+		}
+
+		return &diff, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,50 @@
+package agentmanager
+
+import (
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func TestReportTaskDiff_DeliversEveryReport(t *testing.T) {
+	s := &Server{diffWaiters: make(map[string]*diffWaiter)}
+	w := &diffWaiter{
+		ch:   make(chan *taskguildv1.ReportTaskDiffRequest),
+		done: make(chan struct{}),
+	}
+	s.diffWaiters["req-1"] = w
+
+	const reporters = 20
+
+	var wg sync.WaitGroup
+	for range reporters {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := s.ReportTaskDiff(t.Context(), connect.NewRequest(&taskguildv1.ReportTaskDiffRequest{RequestId: "req-1", NotFound: true}))
+			if err != nil {
+				t.Errorf("ReportTaskDiff: %v", err)
+			}
+		}()
+	}
+
+	for range reporters {
+		if report := <-w.ch; !report.GetNotFound() {
+			t.Fatalf("unexpected report %v", report)
+		}
+	}
+
+	wg.Wait()
+
+	// Reports arriving after the requester stopped waiting do not block.
+	close(w.done)
+
+	if _, err := s.ReportTaskDiff(t.Context(), connect.NewRequest(&taskguildv1.ReportTaskDiffRequest{RequestId: "req-1"})); err != nil {
+		t.Fatalf("ReportTaskDiff after done: %v", err)
+	}
+}
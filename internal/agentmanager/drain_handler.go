@@ -0,0 +1,125 @@
+package agentmanager
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kazz187/taskguild/pkg/cerr"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+// DrainAgentManager marks an agent-manager as draining and tells it to stop
+// claiming new tasks. Running tasks finish normally; once none are left the
+// agent-manager reports itself idle via Heartbeat and can be stopped safely.
+func (s *Server) DrainAgentManager(ctx context.Context, req *connect.Request[taskguildv1.DrainAgentManagerRequest]) (*connect.Response[taskguildv1.DrainAgentManagerResponse], error) {
+	agentManagerID := req.Msg.GetAgentManagerId()
+	if agentManagerID == "" {
+		return nil, cerr.NewError(cerr.InvalidArgument, "agent_manager_id is required", nil).ConnectError()
+	}
+
+	prev, ok := s.registry.Get(agentManagerID)
+	if !ok {
+		return nil, cerr.NewError(cerr.NotFound, "agent-manager not connected", nil).ConnectError()
+	}
+
+	draining := !req.Msg.GetResume()
+	if !s.registry.SetDraining(agentManagerID, draining) {
+		return nil, cerr.NewError(cerr.NotFound, "agent-manager not connected", nil).ConnectError()
+	}
+
+	sent := s.registry.SendCommand(agentManagerID, &taskguildv1.AgentCommand{
+		Command: &taskguildv1.AgentCommand_Drain{
+			Drain: &taskguildv1.DrainCommand{Resume: !draining},
+		},
+	})
+	if !sent {
+		// The agent-manager never heard of the change: keep reporting the
+		// state it actually runs in.
+		s.registry.SetDraining(agentManagerID, prev.Draining)
+		return nil, cerr.NewError(cerr.Unavailable, "agent-manager command buffer full or disconnected", nil).ConnectError()
+	}
+
+	info, _ := s.registry.Get(agentManagerID)
+	slog.Info("agent-manager drain state changed",
+		"agent_manager_id", agentManagerID,
+		"draining", draining,
+		"active_tasks", info.ActiveTasks,
+	)
+	s.publishAgentManagerChanged(info)
+
+	// Lifting a drain makes the agent-manager available again: let it pick
+	// up tasks that were left pending while it was draining.
+	if !draining {
+		send := func(cmd *taskguildv1.AgentCommand) error {
+			if !s.registry.SendCommand(agentManagerID, cmd) {
+				return errors.New("agent-manager command buffer full or disconnected")
+			}
+
+			return nil
+		}
+		for _, p := range info.Projects {
+			s.sendPendingTasks(ctx, p.Name, send)
+		}
+	}
+
+	return connect.NewResponse(&taskguildv1.DrainAgentManagerResponse{
+		AgentManager: agentManagerInfoToProto(info),
+	}), nil
+}
+
+func (s *Server) ListAgentManagers(ctx context.Context, req *connect.Request[taskguildv1.ListAgentManagersRequest]) (*connect.Response[taskguildv1.ListAgentManagersResponse], error) {
+	infos := s.registry.List()
+
+	managers := make([]*taskguildv1.AgentManagerInfo, 0, len(infos))
+	for _, info := range infos {
+		managers = append(managers, agentManagerInfoToProto(info))
+	}
+
+	return connect.NewResponse(&taskguildv1.ListAgentManagersResponse{
+		AgentManagers: managers,
+	}), nil
+}
+
+func (s *Server) publishAgentManagerChanged(info AgentManagerInfo) {
+	s.eventBus.PublishNew(
+		taskguildv1.EventType_EVENT_TYPE_AGENT_MANAGER_CHANGED,
+		info.AgentManagerID,
+		"",
+		map[string]string{
+			"agent_manager_id": info.AgentManagerID,
+			"draining":         strconv.FormatBool(info.Draining),
+			"idle":             strconv.FormatBool(info.Idle()),
+		},
+	)
+}
+
+func agentManagerInfoToProto(info AgentManagerInfo) *taskguildv1.AgentManagerInfo {
+	projects := make([]*taskguildv1.ServedProject, 0, len(info.Projects))
+	for _, p := range info.Projects {
+		projects = append(projects, &taskguildv1.ServedProject{
+			ProjectName:        p.Name,
+			WorkDir:            p.WorkDir,
+			MaxConcurrentTasks: p.MaxConcurrentTasks,
+			Labels:             p.Labels,
+		})
+	}
+
+	pb := &taskguildv1.AgentManagerInfo{
+		AgentManagerId:     info.AgentManagerID,
+		MaxConcurrentTasks: info.MaxConcurrentTasks,
+		ActiveTasks:        info.ActiveTasks,
+		Projects:           projects,
+		Draining:           info.Draining,
+		Idle:               info.Idle(),
+	}
+	if !info.LastHeartbeat.IsZero() {
+		pb.LastHeartbeat = timestamppb.New(info.LastHeartbeat)
+	}
+
+	return pb
+}
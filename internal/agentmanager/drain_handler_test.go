@@ -0,0 +1,33 @@
+package agentmanager
+
+import (
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func TestDrainAgentManager_RollsBackWhenCommandNotDelivered(t *testing.T) {
+	r := NewRegistry()
+	ch := r.Register("agent-1", 2, "proj", "/src/proj")
+
+	// Fill the command buffer so the drain command cannot be delivered.
+	for len(ch) < cap(ch) {
+		ch <- &taskguildv1.AgentCommand{}
+	}
+
+	s := &Server{registry: r}
+
+	_, err := s.DrainAgentManager(t.Context(), connect.NewRequest(&taskguildv1.DrainAgentManagerRequest{AgentManagerId: "agent-1"}))
+
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeUnavailable {
+		t.Fatalf("expected Unavailable, got %v", err)
+	}
+
+	if info, _ := r.Get("agent-1"); info.Draining {
+		t.Fatal("expected the drain state to be rolled back")
+	}
+}
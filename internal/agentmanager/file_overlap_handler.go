@@ -0,0 +1,324 @@
+package agentmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/oklog/ulid/v2"
+
+	"github.com/kazz187/taskguild/internal/interaction"
+	"github.com/kazz187/taskguild/internal/task"
+	"github.com/kazz187/taskguild/pkg/cerr"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+// maxOverlapTitleFiles is the number of files named in an overlap
+// notification title.
+const maxOverlapTitleFiles = 3
+
+// modifiedFiles is the latest set of files a worktree task reported as
+// modified.
+type modifiedFiles struct {
+	projectID string
+	worktree  string
+	files     []string // sorted, unique
+}
+
+// fileOverlapEntry is the JSON shape of a task.MetaFileOverlaps entry.
+type fileOverlapEntry struct {
+	TaskID    string   `json:"task_id"`
+	TaskTitle string   `json:"task_title"`
+	Worktree  string   `json:"worktree"`
+	Files     []string `json:"files"`
+}
+
+func (s *Server) ReportModifiedFiles(ctx context.Context, req *connect.Request[taskguildv1.ReportModifiedFilesRequest]) (*connect.Response[taskguildv1.ReportModifiedFilesResponse], error) {
+	if req.Msg.GetTaskId() == "" {
+		return nil, cerr.NewError(cerr.InvalidArgument, "task_id is required", nil).ConnectError()
+	}
+
+	t, err := s.taskRepo.Get(ctx, req.Msg.GetTaskId())
+	if err != nil {
+		return nil, cerr.ExtractConnectError(ctx, err)
+	}
+
+	worktree := req.Msg.GetWorktreeName()
+	if worktree == "" {
+		worktree = t.Metadata["worktree"]
+	}
+
+	files := slices.Clone(req.Msg.GetFiles())
+	slices.Sort(files)
+
+	s.fileOverlapMu.Lock()
+	s.modifiedFiles[t.ID] = &modifiedFiles{
+		projectID: t.ProjectID,
+		worktree:  worktree,
+		files:     slices.Compact(files),
+	}
+
+	var others []string
+
+	for id, mf := range s.modifiedFiles {
+		if id != t.ID && mf.projectID == t.ProjectID {
+			others = append(others, id)
+		}
+	}
+	s.fileOverlapMu.Unlock()
+
+	// Drop tasks that are no longer active; their files cannot conflict.
+	// The repositories are read without holding fileOverlapMu.
+	active := map[string]*task.Task{t.ID: t}
+	terminal := make(map[string]bool)
+
+	var inactive []string
+
+	for _, id := range others {
+		other, err := s.taskRepo.Get(ctx, id)
+		if err != nil || s.isTerminalTask(ctx, other, terminal) {
+			inactive = append(inactive, id)
+			continue
+		}
+
+		active[id] = other
+	}
+
+	// Overlaps and notifications are computed from a consistent view.
+	s.fileOverlapMu.Lock()
+	for _, id := range inactive {
+		delete(s.modifiedFiles, id)
+	}
+
+	overlaps := make(map[string][]*taskguildv1.FileOverlap, len(active))
+	for id := range active {
+		overlaps[id] = findFileOverlaps(id, s.modifiedFiles, active)
+	}
+
+	reply := overlaps[t.ID]
+	notify := s.newFileOverlaps(t.ID, reply, active)
+	s.fileOverlapMu.Unlock()
+
+	for id, at := range active {
+		s.syncFileOverlapMetadata(ctx, at, overlaps[id])
+	}
+
+	for _, o := range notify {
+		s.createFileOverlapNotification(ctx, t, o)
+	}
+
+	return connect.NewResponse(&taskguildv1.ReportModifiedFilesResponse{
+		Overlaps: reply,
+	}), nil
+}
+
+// findFileOverlaps returns the tasks in active (other than taskID, and in a
+// different worktree) whose modified files intersect those of taskID,
+// ordered by task ID.
+func findFileOverlaps(taskID string, files map[string]*modifiedFiles, active map[string]*task.Task) []*taskguildv1.FileOverlap {
+	own, ok := files[taskID]
+	if !ok || len(own.files) == 0 {
+		return nil
+	}
+
+	var overlaps []*taskguildv1.FileOverlap
+
+	for _, id := range slices.Sorted(maps.Keys(active)) {
+		other, ok := files[id]
+		if id == taskID || !ok || other.worktree == own.worktree {
+			continue
+		}
+
+		var common []string
+
+		for _, f := range own.files {
+			if _, found := slices.BinarySearch(other.files, f); found {
+				common = append(common, f)
+			}
+		}
+
+		if len(common) > 0 {
+			overlaps = append(overlaps, &taskguildv1.FileOverlap{
+				TaskId:       id,
+				TaskTitle:    active[id].Title,
+				WorktreeName: other.worktree,
+				Files:        common,
+			})
+		}
+	}
+
+	return overlaps
+}
+
+// syncFileOverlapMetadata stores overlaps in the metadata of t, updating the
+// task only when the value differs from t.
+func (s *Server) syncFileOverlapMetadata(ctx context.Context, t *task.Task, overlaps []*taskguildv1.FileOverlap) {
+	var value string
+
+	if len(overlaps) > 0 {
+		entries := make([]fileOverlapEntry, 0, len(overlaps))
+		for _, o := range overlaps {
+			entries = append(entries, fileOverlapEntry{
+				TaskID:    o.GetTaskId(),
+				TaskTitle: o.GetTaskTitle(),
+				Worktree:  o.GetWorktreeName(),
+				Files:     o.GetFiles(),
+			})
+		}
+
+		b, err := json.Marshal(entries)
+		if err != nil {
+			return
+		}
+
+		value = string(b)
+	}
+
+	if t.Metadata[task.MetaFileOverlaps] == value {
+		return
+	}
+
+	// Only the overlap key is written so that concurrent updates of the
+	// task's other fields are kept.
+	if _, err := s.taskRepo.UpdateMetadata(ctx, t.ID, map[string]string{task.MetaFileOverlaps: value}); err != nil {
+		slog.Error("failed to update file overlap metadata", "task_id", t.ID, "error", err)
+		return
+	}
+
+	s.eventBus.PublishNew(
+		taskguildv1.EventType_EVENT_TYPE_TASK_UPDATED,
+		t.ID,
+		"",
+		map[string]string{
+			"project_id":  t.ProjectID,
+			"workflow_id": t.WorkflowID,
+			"reason":      "file_overlap",
+		},
+	)
+}
+
+// newFileOverlaps returns the overlaps of taskID that are new or involve
+// files not notified before, and records them as notified. Pairs that no
+// longer overlap are forgotten so that a later overlap is notified again.
+// Must be called with fileOverlapMu held.
+func (s *Server) newFileOverlaps(taskID string, overlaps []*taskguildv1.FileOverlap, active map[string]*task.Task) []*taskguildv1.FileOverlap {
+	current := make(map[string]*taskguildv1.FileOverlap, len(overlaps))
+	for _, o := range overlaps {
+		current[o.GetTaskId()] = o
+	}
+
+	var notify []*taskguildv1.FileOverlap
+
+	for id := range active {
+		if id == taskID {
+			continue
+		}
+
+		key := fileOverlapKey(taskID, id)
+
+		o, ok := current[id]
+		if !ok {
+			delete(s.notifiedOverlaps, key)
+			continue
+		}
+
+		if isSubset(o.GetFiles(), s.notifiedOverlaps[key]) {
+			continue
+		}
+
+		s.notifiedOverlaps[key] = o.GetFiles()
+		notify = append(notify, o)
+	}
+
+	return notify
+}
+
+func (s *Server) createFileOverlapNotification(ctx context.Context, t *task.Task, o *taskguildv1.FileOverlap) {
+	files := o.GetFiles()
+
+	named := strings.Join(files[:min(len(files), maxOverlapTitleFiles)], ", ")
+	if extra := len(files) - maxOverlapTitleFiles; extra > 0 {
+		named += fmt.Sprintf(" and %d more", extra)
+	}
+
+	now := time.Now()
+	inter := &interaction.Interaction{
+		ID:          ulid.Make().String(),
+		ProjectID:   t.ProjectID,
+		TaskID:      t.ID,
+		Type:        interaction.TypeNotification,
+		Status:      interaction.StatusResponded,
+		Title:       fmt.Sprintf("Possible conflict with %q: both tasks modify %s", o.GetTaskTitle(), named),
+		Description: "Overlapping files: " + strings.Join(files, ", "),
+		CreatedAt:   now,
+		RespondedAt: &now,
+	}
+
+	if err := s.interactionRepo.Create(ctx, inter); err != nil {
+		slog.Error("failed to create file overlap notification", "task_id", t.ID, "error", err)
+		return
+	}
+
+	s.eventBus.PublishNew(
+		taskguildv1.EventType_EVENT_TYPE_INTERACTION_CREATED,
+		inter.ID,
+		interaction.MarshalInteractionPayload(interaction.ToProto(inter)),
+		map[string]string{"task_id": t.ID, "project_id": t.ProjectID},
+	)
+
+	slog.Info("file overlap detected",
+		"task_id", t.ID,
+		"other_task_id", o.GetTaskId(),
+		"files", len(files),
+	)
+}
+
+// isTerminalTask reports whether t is in a terminal status of its workflow.
+// cache maps "workflow_id\x00status" to the result.
+func (s *Server) isTerminalTask(ctx context.Context, t *task.Task, cache map[string]bool) bool {
+	key := t.WorkflowID + "\x00" + t.StatusID
+	if v, ok := cache[key]; ok {
+		return v
+	}
+
+	terminal := false
+
+	if wf, err := s.workflowRepo.Get(ctx, t.WorkflowID); err == nil {
+		for _, st := range wf.Statuses {
+			if st.Name == t.StatusID {
+				terminal = st.IsTerminal
+				break
+			}
+		}
+	}
+
+	cache[key] = terminal
+
+	return terminal
+}
+
+// fileOverlapKey identifies a pair of tasks regardless of order.
+func fileOverlapKey(a, b string) string {
+	if a > b {
+		a, b = b, a
+	}
+
+	return a + "\x00" + b
+}
+
+// isSubset reports whether every element of sub is in set (both sorted).
+func isSubset(sub, set []string) bool {
+	for _, v := range sub {
+		if _, found := slices.BinarySearch(set, v); !found {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,102 @@
+package agentmanager
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kazz187/taskguild/internal/eventbus"
+	"github.com/kazz187/taskguild/internal/task"
+	taskrepo "github.com/kazz187/taskguild/internal/task/repositoryimpl"
+	"github.com/kazz187/taskguild/pkg/storage"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func TestFindFileOverlaps(t *testing.T) {
+	files := map[string]*modifiedFiles{
+		"a": {worktree: "wt-a", files: []string{"go.mod", "main.go", "parser.go"}},
+		"b": {worktree: "wt-b", files: []string{"lexer.go", "parser.go"}},
+		"c": {worktree: "wt-a", files: []string{"main.go"}}, // same worktree as a
+		"d": {worktree: "wt-d", files: []string{"README.md"}},
+		"e": {worktree: "wt-e", files: []string{"go.mod", "main.go"}}, // not active
+	}
+	active := map[string]*task.Task{
+		"a": {ID: "a", Title: "A"},
+		"b": {ID: "b", Title: "B"},
+		"c": {ID: "c", Title: "C"},
+		"d": {ID: "d", Title: "D"},
+	}
+
+	got := findFileOverlaps("a", files, active)
+	if len(got) != 1 {
+		t.Fatalf("expected 1 overlap, got %d", len(got))
+	}
+
+	if got[0].GetTaskId() != "b" || got[0].GetTaskTitle() != "B" || got[0].GetWorktreeName() != "wt-b" ||
+		!slices.Equal(got[0].GetFiles(), []string{"parser.go"}) {
+		t.Errorf("unexpected overlap: %v", got[0])
+	}
+
+	if got := findFileOverlaps("d", files, active); got != nil {
+		t.Errorf("expected no overlap for d, got %v", got)
+	}
+
+	if got := findFileOverlaps("missing", files, active); got != nil {
+		t.Errorf("expected no overlap for an unknown task, got %v", got)
+	}
+}
+
+func TestIsSubset(t *testing.T) {
+	if !isSubset([]string{"a", "c"}, []string{"a", "b", "c"}) {
+		t.Error("expected subset")
+	}
+
+	if isSubset([]string{"a", "d"}, []string{"a", "b", "c"}) {
+		t.Error("expected not a subset")
+	}
+
+	if fileOverlapKey("x", "y") != fileOverlapKey("y", "x") {
+		t.Error("fileOverlapKey should not depend on order")
+	}
+}
+
+func TestSyncFileOverlapMetadata_KeepsOtherFields(t *testing.T) {
+	st, err := storage.NewLocalStorage(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tasks := taskrepo.NewYAMLRepository(st)
+	if err := tasks.Create(t.Context(), &task.Task{ID: "a", ProjectID: "p", Title: "old"}); err != nil {
+		t.Fatal(err)
+	}
+
+	stale, err := tasks.Get(t.Context(), "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The task changes after the report read it.
+	current, _ := tasks.Get(t.Context(), "a")
+	current.Title = "new"
+	current.Metadata = map[string]string{task.MetaMergeStatus: task.MergeStatusQueued}
+
+	if err := tasks.Update(t.Context(), current); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &Server{taskRepo: tasks, eventBus: eventbus.New()}
+	s.syncFileOverlapMetadata(t.Context(), stale, []*taskguildv1.FileOverlap{{TaskId: "b", Files: []string{"main.go"}}})
+
+	got, err := tasks.Get(t.Context(), "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Title != "new" || got.Metadata[task.MetaMergeStatus] != task.MergeStatusQueued {
+		t.Errorf("expected concurrent changes to be kept, got title %q metadata %v", got.Title, got.Metadata)
+	}
+
+	if got.Metadata[task.MetaFileOverlaps] == "" {
+		t.Error("expected the overlap metadata to be set")
+	}
+}
@@ -0,0 +1,75 @@
+package agentmanager
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"github.com/kazz187/taskguild/internal/tasklog"
+	"github.com/kazz187/taskguild/pkg/cerr"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+// defaultHandoffAgentOutputs is the number of most recent agent outputs
+// included in a handoff when the request does not specify a limit.
+const defaultHandoffAgentOutputs = 5
+
+func (s *Server) GetTaskHandoff(ctx context.Context, req *connect.Request[taskguildv1.GetTaskHandoffRequest]) (*connect.Response[taskguildv1.GetTaskHandoffResponse], error) {
+	if req.Msg.GetTaskId() == "" {
+		return nil, cerr.NewError(cerr.InvalidArgument, "task_id is required", nil).ConnectError()
+	}
+
+	logs, _, err := s.taskLogRepo.List(ctx, req.Msg.GetTaskId(), nil, 0, 0)
+	if err != nil {
+		return nil, cerr.ExtractConnectError(ctx, err)
+	}
+
+	maxOutputs := int(req.Msg.GetMaxAgentOutputs())
+	if maxOutputs <= 0 {
+		maxOutputs = defaultHandoffAgentOutputs
+	}
+
+	selected := selectHandoffLogs(logs, maxOutputs)
+
+	protos := make([]*taskguildv1.TaskLog, len(selected))
+	for i, l := range selected {
+		protos[i] = tasklog.ToProto(l)
+	}
+
+	return connect.NewResponse(&taskguildv1.GetTaskHandoffResponse{
+		Logs: protos,
+	}), nil
+}
+
+// selectHandoffLogs keeps the RESULT and DIRECTIVE logs and the last
+// maxAgentOutputs AGENT_OUTPUT logs, preserving the original order.
+func selectHandoffLogs(logs []*tasklog.TaskLog, maxAgentOutputs int) []*tasklog.TaskLog {
+	outputs := 0
+
+	for _, l := range logs {
+		if l.Category == int32(taskguildv1.TaskLogCategory_TASK_LOG_CATEGORY_AGENT_OUTPUT) {
+			outputs++
+		}
+	}
+
+	skipOutputs := max(outputs-maxAgentOutputs, 0)
+
+	var selected []*tasklog.TaskLog
+
+	for _, l := range logs {
+		switch taskguildv1.TaskLogCategory(l.Category) {
+		case taskguildv1.TaskLogCategory_TASK_LOG_CATEGORY_RESULT,
+			taskguildv1.TaskLogCategory_TASK_LOG_CATEGORY_DIRECTIVE:
+			selected = append(selected, l)
+		case taskguildv1.TaskLogCategory_TASK_LOG_CATEGORY_AGENT_OUTPUT:
+			if skipOutputs > 0 {
+				skipOutputs--
+				continue
+			}
+
+			selected = append(selected, l)
+		}
+	}
+
+	return selected
+}
@@ -0,0 +1,36 @@
+package agentmanager
+
+import (
+	"testing"
+
+	"github.com/kazz187/taskguild/internal/tasklog"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func TestSelectHandoffLogs(t *testing.T) {
+	mk := func(id string, cat taskguildv1.TaskLogCategory) *tasklog.TaskLog {
+		return &tasklog.TaskLog{ID: id, Category: int32(cat)}
+	}
+
+	logs := []*tasklog.TaskLog{
+		mk("out1", taskguildv1.TaskLogCategory_TASK_LOG_CATEGORY_AGENT_OUTPUT),
+		mk("res1", taskguildv1.TaskLogCategory_TASK_LOG_CATEGORY_RESULT),
+		mk("tool", taskguildv1.TaskLogCategory_TASK_LOG_CATEGORY_TOOL_USE),
+		mk("out2", taskguildv1.TaskLogCategory_TASK_LOG_CATEGORY_AGENT_OUTPUT),
+		mk("dir1", taskguildv1.TaskLogCategory_TASK_LOG_CATEGORY_DIRECTIVE),
+		mk("out3", taskguildv1.TaskLogCategory_TASK_LOG_CATEGORY_AGENT_OUTPUT),
+	}
+
+	got := selectHandoffLogs(logs, 2)
+
+	want := []string{"res1", "out2", "dir1", "out3"}
+	if len(got) != len(want) {
+		t.Fatalf("selectHandoffLogs returned %d logs, want %d", len(got), len(want))
+	}
+
+	for i, l := range got {
+		if l.ID != want[i] {
+			t.Errorf("log[%d] = %q, want %q", i, l.ID, want[i])
+		}
+	}
+}
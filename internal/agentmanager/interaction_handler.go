@@ -42,6 +42,8 @@ func (s *Server) CreateInteraction(ctx context.Context, req *connect.Request[tas
 		})
 	}
 
+	redactInteraction(s.redactor(ctx, t.ProjectID), inter)
+
 	// Generate a single-use response token for push notification actions.
 	// This allows the Service Worker to respond to interactions without
 	// exposing the main API key.
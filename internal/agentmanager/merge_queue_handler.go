@@ -0,0 +1,492 @@
+package agentmanager
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/oklog/ulid/v2"
+
+	"github.com/kazz187/taskguild/internal/task"
+	"github.com/kazz187/taskguild/internal/tasklog"
+	"github.com/kazz187/taskguild/pkg/cerr"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+// mergeTimeout is how long a merge may run before the queue moves on. The
+// result of a merge that exceeded it is ignored.
+const mergeTimeout = time.Hour
+
+// TaskCreator creates tasks on behalf of the agent-manager server (e.g.
+// fix-up tasks for failed merges).
+type TaskCreator interface {
+	CreateTaskInternal(ctx context.Context, in task.CreateTaskInput) (*task.Task, error)
+}
+
+// SetTaskCreator sets the creator used for merge fix-up tasks. Without it,
+// failed merges are only recorded on the task.
+func (s *Server) SetTaskCreator(creator TaskCreator) {
+	s.taskCreator = creator
+}
+
+// activeMerge is the merge in progress for a project.
+type activeMerge struct {
+	taskID    string
+	requestID string
+	pending   int // agent-managers that have not reported yet
+	startedAt time.Time
+}
+
+// EnqueueMerge queues the worktree branch of a task that reached a terminal
+// status, if the project's merge queue is enabled.
+func (s *Server) EnqueueMerge(ctx context.Context, t *task.Task) {
+	proj, err := s.projectRepo.Get(ctx, t.ProjectID)
+	if err != nil || !proj.MergeQueueEnabled() {
+		return
+	}
+
+	taskID := t.ID
+
+	t, err = s.taskRepo.Get(ctx, taskID)
+	if err != nil {
+		slog.Error("failed to load task for merge queue", "task_id", taskID, "error", err)
+		return
+	}
+
+	switch t.Metadata[task.MetaMergeStatus] {
+	case task.MergeStatusQueued, task.MergeStatusMerging:
+		return
+	}
+
+	if t.Metadata == nil {
+		t.Metadata = make(map[string]string)
+	}
+
+	t.Metadata[task.MetaMergeStatus] = task.MergeStatusQueued
+	t.Metadata[task.MetaMergeQueuedAt] = time.Now().Format(time.RFC3339Nano)
+	delete(t.Metadata, task.MetaMergeError)
+
+	if err := s.updateMergeState(ctx, t, "merge_queued"); err != nil {
+		slog.Error("failed to queue task for merge", "task_id", t.ID, "error", err)
+		return
+	}
+
+	slog.Info("task queued for merge", "task_id", t.ID, "project_id", t.ProjectID)
+
+	s.kickMergeQueue(ctx, t.ProjectID)
+}
+
+// kickMergeQueue starts the next queued merge of the project unless one is
+// already running. Tasks still assigned to an agent are skipped until they
+// are released.
+func (s *Server) kickMergeQueue(ctx context.Context, projectID string) {
+	s.mergeMu.Lock()
+	defer s.mergeMu.Unlock()
+
+	if am := s.activeMerges[projectID]; am != nil {
+		if time.Since(am.startedAt) < mergeTimeout {
+			return
+		}
+
+		slog.Warn("merge timed out", "task_id", am.taskID, "request_id", am.requestID)
+		s.failStaleMerge(ctx, am)
+		delete(s.activeMerges, projectID)
+	}
+
+	proj, err := s.projectRepo.Get(ctx, projectID)
+	if err != nil || !proj.MergeQueueEnabled() {
+		return
+	}
+
+	tasks, _, err := s.taskRepo.List(ctx, projectID, "", "", 0, 0)
+	if err != nil {
+		slog.Error("failed to list tasks for merge queue", "project_id", projectID, "error", err)
+		return
+	}
+
+	next := nextQueuedMerge(tasks)
+	if next == nil {
+		return
+	}
+
+	requestID := ulid.Make().String()
+
+	pending := s.registry.BroadcastCommandToProject(proj.Name, &taskguildv1.AgentCommand{
+		Command: &taskguildv1.AgentCommand_MergeWorktree{
+			MergeWorktree: &taskguildv1.MergeWorktreeCommand{
+				RequestId:      requestID,
+				TaskId:         next.ID,
+				WorktreeName:   next.Metadata["worktree"],
+				BaseBranch:     taskBaseBranch(next, proj.DefaultBranch),
+				VerifyCommands: proj.MergeQueue.VerifyCommands,
+				Push:           proj.MergeQueue.Push,
+			},
+		},
+	})
+	if pending == 0 {
+		// Resumed when an agent-manager for the project connects.
+		slog.Info("merge queue waiting for an agent manager", "project_id", projectID)
+		return
+	}
+
+	next.Metadata[task.MetaMergeStatus] = task.MergeStatusMerging
+	if err := s.updateMergeState(ctx, next, "merge_started"); err != nil {
+		slog.Error("failed to mark task as merging", "task_id", next.ID, "error", err)
+	}
+
+	s.activeMerges[projectID] = &activeMerge{
+		taskID:    next.ID,
+		requestID: requestID,
+		pending:   pending,
+		startedAt: time.Now(),
+	}
+
+	slog.Info("merge started",
+		"task_id", next.ID,
+		"project_name", proj.Name,
+		"worktree", next.Metadata["worktree"],
+		"request_id", requestID,
+	)
+}
+
+// RunMergeQueueSweep periodically restarts the merge queues whose active
+// merge exceeded mergeTimeout without a report, so that a lost agent does
+// not block the queue until the next task is enqueued.
+func (s *Server) RunMergeQueueSweep(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, projectID := range s.staleMergeProjects(time.Now()) {
+				s.kickMergeQueue(ctx, projectID)
+			}
+		}
+	}
+}
+
+// staleMergeProjects returns the projects whose active merge started more
+// than mergeTimeout before now.
+func (s *Server) staleMergeProjects(now time.Time) []string {
+	s.mergeMu.Lock()
+	defer s.mergeMu.Unlock()
+
+	var projectIDs []string
+
+	for projectID, am := range s.activeMerges {
+		if now.Sub(am.startedAt) >= mergeTimeout {
+			projectIDs = append(projectIDs, projectID)
+		}
+	}
+
+	return projectIDs
+}
+
+// nextQueuedMerge returns the task queued for merge the longest among those
+// not currently assigned to an agent.
+func nextQueuedMerge(tasks []*task.Task) *task.Task {
+	var queued []*task.Task
+
+	for _, t := range tasks {
+		if t.Metadata[task.MetaMergeStatus] != task.MergeStatusQueued || t.Metadata["worktree"] == "" {
+			continue
+		}
+
+		if t.AssignmentStatus == task.AssignmentStatusAssigned {
+			continue
+		}
+
+		queued = append(queued, t)
+	}
+
+	if len(queued) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(queued, func(i, j int) bool {
+		return queued[i].Metadata[task.MetaMergeQueuedAt] < queued[j].Metadata[task.MetaMergeQueuedAt]
+	})
+
+	return queued[0]
+}
+
+// failStaleMerge marks the task of a merge that never reported as failed.
+// Must be called with mergeMu held.
+func (s *Server) failStaleMerge(ctx context.Context, am *activeMerge) {
+	t, err := s.taskRepo.Get(ctx, am.taskID)
+	if err != nil {
+		return
+	}
+
+	t.Metadata[task.MetaMergeStatus] = task.MergeStatusFailed
+	t.Metadata[task.MetaMergeError] = "merge timed out"
+
+	if err := s.updateMergeState(ctx, t, "merge_failed"); err != nil {
+		slog.Error("failed to mark merge as timed out", "task_id", t.ID, "error", err)
+	}
+}
+
+func (s *Server) ReportMergeResult(ctx context.Context, req *connect.Request[taskguildv1.ReportMergeResultRequest]) (*connect.Response[taskguildv1.ReportMergeResultResponse], error) {
+	if req.Msg.GetTaskId() == "" {
+		return nil, cerr.NewError(cerr.InvalidArgument, "task_id is required", nil).ConnectError()
+	}
+
+	t, err := s.taskRepo.Get(ctx, req.Msg.GetTaskId())
+	if err != nil {
+		return nil, cerr.ExtractConnectError(ctx, err)
+	}
+
+	s.mergeMu.Lock()
+
+	am := s.activeMerges[t.ProjectID]
+	if am == nil || am.requestID != req.Msg.GetRequestId() {
+		s.mergeMu.Unlock()
+		slog.Info("ignoring stale merge result", "task_id", t.ID, "request_id", req.Msg.GetRequestId())
+
+		return connect.NewResponse(&taskguildv1.ReportMergeResultResponse{}), nil
+	}
+
+	if req.Msg.GetNotFound() {
+		am.pending--
+		if am.pending > 0 {
+			s.mergeMu.Unlock()
+			return connect.NewResponse(&taskguildv1.ReportMergeResultResponse{}), nil
+		}
+	}
+
+	delete(s.activeMerges, t.ProjectID)
+	s.mergeMu.Unlock()
+
+	slog.Info("merge result reported",
+		"task_id", t.ID,
+		"success", req.Msg.GetSuccess(),
+		"not_found", req.Msg.GetNotFound(),
+		"step", req.Msg.GetStep(),
+		"error_message", req.Msg.GetErrorMessage(),
+	)
+
+	switch {
+	case req.Msg.GetNotFound():
+		s.finishMerge(ctx, t, task.MergeStatusFailed,
+			fmt.Sprintf("worktree %q not found on any connected agent manager", t.Metadata["worktree"]))
+	case req.Msg.GetSuccess() || req.Msg.GetStep() == "push":
+		s.completeMerge(ctx, t, req.Msg)
+	default:
+		s.failMerge(ctx, t, req.Msg)
+	}
+
+	s.kickMergeQueue(ctx, t.ProjectID)
+
+	return connect.NewResponse(&taskguildv1.ReportMergeResultResponse{}), nil
+}
+
+// completeMerge records a successful merge. A fix-up task's merge also
+// completes the merge of the task it repaired.
+func (s *Server) completeMerge(ctx context.Context, t *task.Task, r *taskguildv1.ReportMergeResultRequest) {
+	msg := fmt.Sprintf("Merged worktree branch into %s (%s)", r.GetBaseBranch(), shortSHA(r.GetMergedCommit()))
+	level := taskguildv1.TaskLogLevel_TASK_LOG_LEVEL_INFO
+
+	if r.GetStep() == "push" {
+		msg += "; push failed: " + r.GetErrorMessage()
+		level = taskguildv1.TaskLogLevel_TASK_LOG_LEVEL_WARN
+	}
+
+	t.Metadata[task.MetaMergedCommit] = r.GetMergedCommit()
+	s.finishMerge(ctx, t, task.MergeStatusMerged, "")
+	s.emitMergeLog(ctx, t, level, msg, map[string]string{
+		"merged_commit": r.GetMergedCommit(),
+		"base_branch":   r.GetBaseBranch(),
+	})
+
+	if origID := t.Metadata[task.MetaMergeFixupFor]; origID != "" {
+		if orig, err := s.taskRepo.Get(ctx, origID); err == nil && orig.Metadata[task.MetaMergeStatus] == task.MergeStatusFailed {
+			orig.Metadata[task.MetaMergedCommit] = r.GetMergedCommit()
+			s.finishMerge(ctx, orig, task.MergeStatusMerged, "")
+		}
+	}
+}
+
+// failMerge records a failed merge and creates a fix-up task that works in
+// the same worktree. The fix-up task is merged again once it completes.
+func (s *Server) failMerge(ctx context.Context, t *task.Task, r *taskguildv1.ReportMergeResultRequest) {
+	errMsg := fmt.Sprintf("%s failed: %s", r.GetStep(), r.GetErrorMessage())
+	s.finishMerge(ctx, t, task.MergeStatusFailed, errMsg)
+
+	meta := map[string]string{"step": r.GetStep()}
+	if len(r.GetConflictFiles()) > 0 {
+		meta["conflict_files"] = strings.Join(r.GetConflictFiles(), "\n")
+	}
+
+	if s.taskCreator == nil {
+		s.emitMergeLog(ctx, t, taskguildv1.TaskLogLevel_TASK_LOG_LEVEL_ERROR, "Merge "+errMsg, meta)
+		return
+	}
+
+	fixup, err := s.taskCreator.CreateTaskInternal(ctx, task.CreateTaskInput{
+		ProjectID:   t.ProjectID,
+		WorkflowID:  t.WorkflowID,
+		Title:       "Fix merge: " + t.Title,
+		Description: mergeFixupDescription(t, r),
+		UseWorktree: true,
+		Metadata: map[string]string{
+			"worktree":             t.Metadata["worktree"],
+			task.MetaMergeFixupFor: t.ID,
+		},
+	})
+	if err != nil {
+		slog.Error("failed to create merge fix-up task", "task_id", t.ID, "error", err)
+		s.emitMergeLog(ctx, t, taskguildv1.TaskLogLevel_TASK_LOG_LEVEL_ERROR, "Merge "+errMsg, meta)
+
+		return
+	}
+
+	meta["fixup_task_id"] = fixup.ID
+	s.emitMergeLog(ctx, t, taskguildv1.TaskLogLevel_TASK_LOG_LEVEL_ERROR,
+		fmt.Sprintf("Merge %s; created fix-up task %s", errMsg, fixup.ID), meta)
+
+	if latest, err := s.taskRepo.Get(ctx, t.ID); err == nil {
+		latest.Metadata[task.MetaMergeFixupTaskID] = fixup.ID
+		if err := s.updateMergeState(ctx, latest, "merge_failed"); err != nil {
+			slog.Error("failed to link merge fix-up task", "task_id", t.ID, "error", err)
+		}
+	}
+}
+
+// mergeFixupDescription describes a failed merge for the fix-up task.
+func mergeFixupDescription(t *task.Task, r *taskguildv1.ReportMergeResultRequest) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "The merge queue could not merge the worktree branch of task %q (%s) into %s.\n\n",
+		t.Title, t.ID, r.GetBaseBranch())
+	fmt.Fprintf(&sb, "Failed step: %s\n", r.GetStep())
+
+	if r.GetErrorMessage() != "" {
+		fmt.Fprintf(&sb, "Error: %s\n", r.GetErrorMessage())
+	}
+
+	if len(r.GetConflictFiles()) > 0 {
+		sb.WriteString("\nConflicting files:\n")
+
+		for _, f := range r.GetConflictFiles() {
+			fmt.Fprintf(&sb, "- %s\n", f)
+		}
+	}
+
+	if r.GetOutput() != "" {
+		fmt.Fprintf(&sb, "\nOutput:\n```\n%s\n```\n", r.GetOutput())
+	}
+
+	sb.WriteString("\n")
+
+	switch r.GetStep() {
+	case "rebase":
+		fmt.Fprintf(&sb, "Rebase this worktree branch onto %s, resolve the conflicts and commit the result. "+
+			"Commit any uncommitted changes first.", r.GetBaseBranch())
+	case "verify":
+		sb.WriteString("Fix the failing verification in this worktree and commit the fix.")
+	default:
+		sb.WriteString("Fix the problem in this worktree and commit the result.")
+	}
+
+	sb.WriteString(" The branch is merged again when this task completes.\n")
+
+	return sb.String()
+}
+
+// finishMerge sets the final merge status of a task.
+func (s *Server) finishMerge(ctx context.Context, t *task.Task, status, errMsg string) {
+	t.Metadata[task.MetaMergeStatus] = status
+	if errMsg != "" {
+		t.Metadata[task.MetaMergeError] = errMsg
+	} else {
+		delete(t.Metadata, task.MetaMergeError)
+	}
+
+	if err := s.updateMergeState(ctx, t, "merge_"+status); err != nil {
+		slog.Error("failed to update merge status", "task_id", t.ID, "status", status, "error", err)
+	}
+}
+
+func (s *Server) updateMergeState(ctx context.Context, t *task.Task, reason string) error {
+	t.UpdatedAt = time.Now()
+	if err := s.taskRepo.Update(ctx, t); err != nil {
+		return err
+	}
+
+	s.eventBus.PublishNew(
+		taskguildv1.EventType_EVENT_TYPE_TASK_UPDATED,
+		t.ID,
+		"",
+		map[string]string{
+			"project_id":  t.ProjectID,
+			"workflow_id": t.WorkflowID,
+			"reason":      reason,
+		},
+	)
+
+	return nil
+}
+
+func (s *Server) emitMergeLog(ctx context.Context, t *task.Task, level taskguildv1.TaskLogLevel, message string, meta map[string]string) {
+	l := &tasklog.TaskLog{
+		ID:        ulid.Make().String(),
+		ProjectID: t.ProjectID,
+		TaskID:    t.ID,
+		Level:     int32(level),
+		Category:  int32(taskguildv1.TaskLogCategory_TASK_LOG_CATEGORY_SYSTEM),
+		Message:   message,
+		Metadata:  meta,
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.taskLogRepo.Create(ctx, l); err != nil {
+		slog.Error("failed to create merge log", "task_id", t.ID, "error", err)
+		return
+	}
+
+	s.eventBus.PublishNew(
+		taskguildv1.EventType_EVENT_TYPE_TASK_LOG,
+		l.ID,
+		"",
+		map[string]string{"task_id": t.ID, "project_id": t.ProjectID},
+	)
+}
+
+// resumeMergeQueues restarts the merge queues of the projects an
+// agent-manager serves once it connects.
+func (s *Server) resumeMergeQueues(ctx context.Context, projectNames []string) {
+	for _, name := range projectNames {
+		proj, err := s.projectRepo.FindByName(ctx, name)
+		if err != nil || !proj.MergeQueueEnabled() {
+			continue
+		}
+
+		s.kickMergeQueue(ctx, proj.ID)
+	}
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 12 {
+		return sha[:12]
+	}
+
+	return sha
+}
+
+// taskBaseBranch returns the branch a task's worktree is diffed against and
+// merged into. Tasks started from another ref (e.g. a release branch) use it
+// rather than the default branch.
+func taskBaseBranch(t *task.Task, defaultBranch string) string {
+	if t.WorktreeBaseRef != "" {
+		return t.WorktreeBaseRef
+	}
+
+	return defaultBranch
+}
@@ -0,0 +1,93 @@
+package agentmanager
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kazz187/taskguild/internal/task"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func TestNextQueuedMerge(t *testing.T) {
+	queued := func(id, at string, assignment task.AssignmentStatus) *task.Task {
+		return &task.Task{
+			ID:               id,
+			AssignmentStatus: assignment,
+			Metadata: map[string]string{
+				"worktree":             id,
+				task.MetaMergeStatus:   task.MergeStatusQueued,
+				task.MetaMergeQueuedAt: at,
+			},
+		}
+	}
+
+	tasks := []*task.Task{
+		queued("newer", "2026-01-02T00:00:00Z", task.AssignmentStatusUnassigned),
+		queued("running", "2026-01-01T00:00:00Z", task.AssignmentStatusAssigned),
+		queued("older", "2026-01-01T12:00:00Z", task.AssignmentStatusUnassigned),
+		{ID: "merged", Metadata: map[string]string{"worktree": "merged", task.MetaMergeStatus: task.MergeStatusMerged}},
+		{ID: "no-worktree", Metadata: map[string]string{task.MetaMergeStatus: task.MergeStatusQueued}},
+	}
+
+	next := nextQueuedMerge(tasks)
+	if next == nil || next.ID != "older" {
+		t.Fatalf("expected older, got %v", next)
+	}
+
+	if next := nextQueuedMerge(tasks[3:]); next != nil {
+		t.Fatalf("expected no queued merge, got %s", next.ID)
+	}
+}
+
+func TestMergeFixupDescription(t *testing.T) {
+	orig := &task.Task{ID: "task-1", Title: "Add parser"}
+
+	got := mergeFixupDescription(orig, &taskguildv1.ReportMergeResultRequest{
+		Step:          "rebase",
+		ErrorMessage:  "rebase onto main has conflicts",
+		ConflictFiles: []string{"parser.go", "lexer.go"},
+		Output:        "CONFLICT (content): Merge conflict in parser.go",
+		BaseBranch:    "main",
+	})
+
+	for _, want := range []string{
+		`task "Add parser" (task-1) into main`,
+		"Failed step: rebase",
+		"- parser.go\n- lexer.go",
+		"CONFLICT (content)",
+		"resolve the conflicts",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("description missing %q:\n%s", want, got)
+		}
+	}
+
+	got = mergeFixupDescription(orig, &taskguildv1.ReportMergeResultRequest{Step: "verify", BaseBranch: "main"})
+	if strings.Contains(got, "Conflicting files") || !strings.Contains(got, "failing verification") {
+		t.Errorf("unexpected verify description:\n%s", got)
+	}
+}
+
+func TestTaskBaseBranch(t *testing.T) {
+	if got := taskBaseBranch(&task.Task{}, "main"); got != "main" {
+		t.Errorf("expected main, got %s", got)
+	}
+
+	if got := taskBaseBranch(&task.Task{WorktreeBaseRef: "release/1.2"}, "main"); got != "release/1.2" {
+		t.Errorf("expected release/1.2, got %s", got)
+	}
+}
+
+func TestStaleMergeProjects(t *testing.T) {
+	now := time.Now()
+	s := &Server{activeMerges: map[string]*activeMerge{
+		"stale":  {taskID: "t-1", startedAt: now.Add(-mergeTimeout - time.Minute)},
+		"active": {taskID: "t-2", startedAt: now.Add(-time.Minute)},
+	}}
+
+	if got := s.staleMergeProjects(now); !slices.Equal(got, []string{"stale"}) {
+		t.Errorf("expected only the stale project, got %v", got)
+	}
+}
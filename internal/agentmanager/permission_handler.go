@@ -6,11 +6,12 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
-	"github.com/oklog/ulid/v2"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kazz187/taskguild/internal/interaction"
+	"github.com/kazz187/taskguild/internal/permission"
 	scp "github.com/kazz187/taskguild/internal/singlecommandpermission"
 	"github.com/kazz187/taskguild/pkg/cerr"
+	"github.com/kazz187/taskguild/pkg/permcheck"
 	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
 )
 
@@ -30,20 +31,15 @@ func (s *Server) ListSingleCommandPermissions(ctx context.Context, req *connect.
 		return nil, fmt.Errorf("failed to resolve project: %w", err)
 	}
 
-	perms, err := s.scpRepo.List(ctx, proj.ID)
+	// Expired grants are left out so that agents never load them.
+	perms, err := scp.ListLive(ctx, s.scpRepo, proj.ID, time.Now())
 	if err != nil {
 		return nil, fmt.Errorf("failed to list single command permissions: %w", err)
 	}
 
 	var pbPerms []*taskguildv1.SingleCommandPermission
 	for _, p := range perms {
-		pbPerms = append(pbPerms, &taskguildv1.SingleCommandPermission{
-			Id:        p.ID,
-			ProjectId: p.ProjectID,
-			Pattern:   p.Pattern,
-			Type:      p.Type,
-			CreatedAt: timestamppb.New(p.CreatedAt),
-		})
+		pbPerms = append(pbPerms, scp.ToProto(p))
 	}
 
 	return connect.NewResponse(&taskguildv1.ListSingleCommandPermissionsAgentResponse{
@@ -52,60 +48,109 @@ func (s *Server) ListSingleCommandPermissions(ctx context.Context, req *connect.
 }
 
 // AddSingleCommandPermission adds a new wildcard permission rule from an agent.
-// If a rule with the same pattern+type already exists in the project, any extra
-// duplicates are removed. This makes the operation idempotent and cleans up
-// legacy duplicates.
+// The rule may be scoped to a task or a workflow status and may expire; see
+// scp.AddGrant for how it is merged with existing rules. Who granted the rule
+// is taken from the answered permission request, not from the agent.
 func (s *Server) AddSingleCommandPermission(ctx context.Context, req *connect.Request[taskguildv1.AddSingleCommandPermissionRequest]) (*connect.Response[taskguildv1.AddSingleCommandPermissionResponse], error) {
 	projectName := req.Msg.GetProjectName()
 	if projectName == "" {
 		return nil, cerr.NewError(cerr.InvalidArgument, "project_name is required", nil).ConnectError()
 	}
 
+	g := &scp.SingleCommandPermission{
+		Pattern:      req.Msg.GetPattern(),
+		Type:         req.Msg.GetType(),
+		Scope:        scp.NormalizeScope(req.Msg.GetScope()),
+		OriginTaskID: req.Msg.GetOriginTaskId(),
+	}
+
+	switch g.Scope {
+	case scp.ScopeProject:
+	case scp.ScopeTask:
+		if req.Msg.GetTaskId() == "" {
+			return nil, cerr.NewError(cerr.InvalidArgument, "task_id is required for task-scoped grants", nil).ConnectError()
+		}
+
+		g.TaskID = req.Msg.GetTaskId()
+	case scp.ScopeStatus:
+		if req.Msg.GetWorkflowId() == "" || req.Msg.GetStatusName() == "" {
+			return nil, cerr.NewError(cerr.InvalidArgument, "workflow_id and status_name are required for status-scoped grants", nil).ConnectError()
+		}
+
+		g.WorkflowID = req.Msg.GetWorkflowId()
+		g.StatusName = req.Msg.GetStatusName()
+	default:
+		return nil, cerr.NewError(cerr.InvalidArgument, fmt.Sprintf("unknown scope %q", req.Msg.GetScope()), nil).ConnectError()
+	}
+
+	if ttl := req.Msg.GetTtlSeconds(); ttl > 0 {
+		expiresAt := time.Now().Add(time.Duration(ttl) * time.Second)
+		g.ExpiresAt = &expiresAt
+	}
+
 	// Resolve project name to ID.
 	proj, err := s.projectRepo.FindByName(ctx, projectName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve project: %w", err)
 	}
 
-	// Check for existing duplicates (pattern + type within the same project).
-	existing, err := s.scpRepo.FindByPatternAndType(ctx, proj.ID, req.Msg.GetPattern(), req.Msg.GetType())
-	if err != nil {
-		return nil, fmt.Errorf("failed to check existing single command permissions: %w", err)
-	}
-
-	var p *scp.SingleCommandPermission
-
-	if len(existing) > 0 {
-		// Keep the oldest entry.
-		p = existing[0]
+	g.ProjectID = proj.ID
 
-		// Remove extra duplicates (index 1+).
-		for _, dup := range existing[1:] {
-			_ = s.scpRepo.Delete(ctx, dup.ID)
-		}
-	} else {
-		// No duplicate — create a new entry.
-		p = &scp.SingleCommandPermission{
-			ID:        ulid.Make().String(),
-			ProjectID: proj.ID,
-			Pattern:   req.Msg.GetPattern(),
-			Type:      req.Msg.GetType(),
-			CreatedAt: time.Now(),
+	if id := req.Msg.GetInteractionId(); id != "" {
+		inter, err := s.interactionRepo.Get(ctx, id)
+		if err != nil {
+			return nil, err
 		}
 
-		err := s.scpRepo.Create(ctx, p)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create single command permission: %w", err)
+		if inter.ProjectID != proj.ID || inter.Status != interaction.StatusResponded {
+			return nil, cerr.NewError(cerr.FailedPrecondition, "interaction is not an answered request of the project", nil).ConnectError()
 		}
+
+		g.OriginTaskID = inter.TaskID
+		g.GrantedBy = inter.RespondedBy
+	}
+
+	p, err := scp.AddGrant(ctx, s.scpRepo, g)
+	if err != nil {
+		return nil, err
 	}
 
 	return connect.NewResponse(&taskguildv1.AddSingleCommandPermissionResponse{
-		Permission: &taskguildv1.SingleCommandPermission{
-			Id:        p.ID,
-			ProjectId: p.ProjectID,
-			Pattern:   p.Pattern,
-			Type:      p.Type,
-			CreatedAt: timestamppb.New(p.CreatedAt),
-		},
+		Permission: scp.ToProto(p),
 	}), nil
 }
+
+// --- Permission evaluation ---
+
+var _ permission.EvaluationSource = (*Server)(nil)
+
+// CommandRules returns the project's live single-command rules for
+// permission simulation.
+func (s *Server) CommandRules(ctx context.Context, projectID string) (permcheck.CommandRules, error) {
+	perms, err := scp.ListLive(ctx, s.scpRepo, projectID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list single command permissions: %w", err)
+	}
+
+	return scp.CommandRules(perms), nil
+}
+
+// StatusSettings resolves the permission settings ClaimTask sends to the
+// agent for a workflow status.
+func (s *Server) StatusSettings(ctx context.Context, workflowID, statusName string) (*permission.StatusSettings, error) {
+	wf, err := s.workflowRepo.Get(ctx, workflowID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow: %w", err)
+	}
+
+	resolved := s.resolveStatus(ctx, wf, statusName)
+	if resolved.Status == nil {
+		return nil, fmt.Errorf("status %q not found in workflow %q", statusName, wf.Name)
+	}
+
+	return &permission.StatusSettings{
+		PermissionMode: resolved.PermissionMode,
+		PathPolicy:     resolved.Status.PathPolicy,
+		Skills:         resolved.allowedSkills(),
+	}, nil
+}
@@ -0,0 +1,80 @@
+package agentmanager
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/kazz187/taskguild/internal/permission"
+	permissionrepo "github.com/kazz187/taskguild/internal/permission/repositoryimpl"
+	scprepo "github.com/kazz187/taskguild/internal/singlecommandpermission/repositoryimpl"
+	"github.com/kazz187/taskguild/internal/skill"
+	skillrepo "github.com/kazz187/taskguild/internal/skill/repositoryimpl"
+	"github.com/kazz187/taskguild/internal/workflow"
+	workflowrepo "github.com/kazz187/taskguild/internal/workflow/repositoryimpl"
+	"github.com/kazz187/taskguild/pkg/storage"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func TestEvaluatePermission_UsesClaimTaskStatusResolution(t *testing.T) {
+	st, err := storage.NewLocalStorage(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	skills := skillrepo.NewYAMLRepository(st)
+	if err := skills.Create(t.Context(), &skill.Skill{ID: "sk-1", ProjectID: "p", Name: "review"}); err != nil {
+		t.Fatal(err)
+	}
+
+	workflows := workflowrepo.NewYAMLRepository(st)
+	wf := &workflow.Workflow{
+		ID:                    "wf-1",
+		ProjectID:             "p",
+		Name:                  "dev",
+		DefaultPermissionMode: "acceptEdits",
+		Statuses: []workflow.Status{{
+			Name:     "Review",
+			SkillIDs: []string{"sk-1"},
+			Hooks: []workflow.StatusHook{
+				{ID: "h-1", ActionType: workflow.HookActionTypeCustomSkill, SkillName: "lint", Trigger: workflow.HookTriggerAfterTaskExecution},
+			},
+		}},
+	}
+	if err := workflows.Create(t.Context(), wf); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &Server{workflowRepo: workflows, skillRepo: skills, scpRepo: scprepo.NewYAMLRepository(st)}
+	ps := permission.NewServer(permissionrepo.NewYAMLRepository(st), nil, nil, s)
+
+	// The metadata ClaimTask sends for the status.
+	resolved := s.resolveStatus(t.Context(), wf, "Review")
+	if resolved.PermissionMode != "acceptEdits" || len(resolved.SkillNames) != 1 || len(resolved.Hooks) != 1 || resolved.Hooks[0].Name != "lint" {
+		t.Fatalf("unexpected resolved status: %+v", resolved)
+	}
+
+	for _, name := range []string{"review", "lint"} {
+		resp, err := ps.EvaluatePermission(t.Context(), connect.NewRequest(&taskguildv1.EvaluatePermissionRequest{
+			ProjectId:  "p",
+			ToolName:   "Skill",
+			ToolInput:  `{"skill":"` + name + `"}`,
+			WorkflowId: "wf-1",
+			StatusName: "Review",
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := resp.Msg; got.GetDecision() != "allow" || got.GetSource() != "status_skill" || got.GetPermissionMode() != "acceptEdits" {
+			t.Errorf("skill %q: expected a status skill allow in acceptEdits, got %+v", name, got)
+		}
+	}
+
+	_, err = ps.EvaluatePermission(t.Context(), connect.NewRequest(&taskguildv1.EvaluatePermissionRequest{
+		ProjectId: "p", ToolName: "Skill", ToolInput: `{"skill":"review"}`, WorkflowId: "wf-1", StatusName: "Missing",
+	}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("expected NotFound for an unknown status, got %v", err)
+	}
+}
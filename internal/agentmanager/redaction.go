@@ -0,0 +1,108 @@
+package agentmanager
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"github.com/kazz187/taskguild/internal/interaction"
+	"github.com/kazz187/taskguild/internal/tasklog"
+	"github.com/kazz187/taskguild/pkg/redact"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+// projectRedactor is a compiled redactor and the patterns and secret values
+// it was built from.
+type projectRedactor struct {
+	patterns []string
+	secrets  map[string]string
+	redactor *redact.Redactor
+}
+
+// redactor returns the redactor for a project's task logs and interactions.
+// It masks the project's redaction patterns and secret values. Compiled
+// redactors are cached per project; the patterns are reloaded after
+// InvalidateRedactor and the redactor is rebuilt when the secret values
+// change. When the patterns cannot be loaded, only the built-in detectors
+// apply.
+func (s *Server) redactor(ctx context.Context, projectID string) *redact.Redactor {
+	secrets := s.projectSecrets(ctx, projectID)
+
+	s.redactMu.Lock()
+	cached, ok := s.redactors[projectID]
+	gen := s.redactGen
+	s.redactMu.Unlock()
+
+	if ok && maps.Equal(cached.secrets, secrets) {
+		return cached.redactor
+	}
+
+	var patterns []string
+	if ok {
+		patterns = cached.patterns
+	} else {
+		ps, err := s.permissionRepo.Get(ctx, projectID)
+		if err != nil {
+			slog.Warn("failed to load redaction patterns", "project_id", projectID, "error", err)
+			return nil
+		}
+
+		patterns = slices.Clone(ps.RedactionPatterns)
+	}
+
+	r, err := redact.New(patterns, secrets)
+	if err != nil {
+		slog.Warn("invalid redaction patterns", "project_id", projectID, "error", err)
+
+		r, _ = redact.New(nil, secrets)
+	}
+
+	s.redactMu.Lock()
+	if s.redactGen == gen {
+		s.redactors[projectID] = &projectRedactor{patterns: patterns, secrets: secrets, redactor: r}
+	}
+	s.redactMu.Unlock()
+
+	return r
+}
+
+// InvalidateRedactor drops the cached redactor of a project so that the
+// next task log or interaction reloads its redaction patterns. It is called
+// when the project's permissions are updated.
+func (s *Server) InvalidateRedactor(projectID string) {
+	s.redactMu.Lock()
+	delete(s.redactors, projectID)
+	s.redactGen++
+	s.redactMu.Unlock()
+}
+
+// redactTaskLog masks secrets in a task log before it is stored.
+func redactTaskLog(r *redact.Redactor, l *tasklog.TaskLog) {
+	l.Message = r.String(l.Message)
+	l.Metadata = r.Map(l.Metadata)
+}
+
+// redactTaskDiff masks secrets in the patch and commit subjects of a task
+// diff before it is stored or returned.
+func redactTaskDiff(r *redact.Redactor, diff *taskguildv1.TaskDiff) {
+	diff.Patch = r.String(diff.GetPatch())
+
+	for _, c := range diff.GetCommits() {
+		c.Subject = r.String(c.GetSubject())
+	}
+}
+
+// redactInteraction masks secrets in the user-visible text of an
+// interaction before it is stored and pushed to notifications. Option
+// values are machine-readable and left as sent.
+func redactInteraction(r *redact.Redactor, inter *interaction.Interaction) {
+	inter.Title = r.String(inter.Title)
+	inter.Description = r.String(inter.Description)
+	inter.Metadata = r.String(inter.Metadata)
+
+	for i := range inter.Options {
+		inter.Options[i].Label = r.String(inter.Options[i].Label)
+		inter.Options[i].Description = r.String(inter.Options[i].Description)
+	}
+}
@@ -1,22 +1,46 @@
 package agentmanager
 
 import (
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+
 	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
 )
 
+// ServedProject is a project served by a connected agent-manager.
+type ServedProject struct {
+	Name               string
+	WorkDir            string // absolute path to the project root on the agent host
+	MaxConcurrentTasks int32
+	Labels             []string
+}
+
 type connection struct {
 	agentManagerID     string
-	maxConcurrentTasks int32
+	maxConcurrentTasks int32 // global cap across all served projects
 	activeTasks        int32
-	projectName        string
-	workDir            string // absolute path to the agent's project root
+	projects           []ServedProject // empty for legacy agents that serve every project
 	lastHeartbeat      time.Time
+	draining           bool // stops claiming new tasks; see SetDraining
 	commandCh          chan *taskguildv1.AgentCommand
 }
 
+// servesProject reports whether the connection accepts commands for the
+// given project. Legacy agents without a project serve every project.
+func (c *connection) servesProject(projectName string) bool {
+	if len(c.projects) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(c.projects, func(p ServedProject) bool {
+		return p.Name == projectName
+	})
+}
+
 type Registry struct {
 	mu    sync.RWMutex
 	conns map[string]*connection // keyed by agentManagerID
@@ -28,7 +52,20 @@ func NewRegistry() *Registry {
 	}
 }
 
+// Register registers a single-project agent-manager. An empty projectName
+// registers a legacy agent that serves every project.
 func (r *Registry) Register(agentManagerID string, maxConcurrentTasks int32, projectName string, workDir string) chan *taskguildv1.AgentCommand {
+	var projects []ServedProject
+	if projectName != "" {
+		projects = []ServedProject{{Name: projectName, WorkDir: workDir, MaxConcurrentTasks: maxConcurrentTasks}}
+	}
+
+	return r.RegisterProjects(agentManagerID, maxConcurrentTasks, projects)
+}
+
+// RegisterProjects registers an agent-manager serving the given projects.
+// maxConcurrentTasks is the global cap shared across all of them.
+func (r *Registry) RegisterProjects(agentManagerID string, maxConcurrentTasks int32, projects []ServedProject) chan *taskguildv1.AgentCommand {
 	ch := make(chan *taskguildv1.AgentCommand, 64)
 
 	r.mu.Lock()
@@ -40,8 +77,7 @@ func (r *Registry) Register(agentManagerID string, maxConcurrentTasks int32, pro
 	r.conns[agentManagerID] = &connection{
 		agentManagerID:     agentManagerID,
 		maxConcurrentTasks: maxConcurrentTasks,
-		projectName:        projectName,
-		workDir:            workDir,
+		projects:           projects,
 		lastHeartbeat:      time.Now(),
 		commandCh:          ch,
 	}
@@ -105,7 +141,7 @@ func (r *Registry) FindAvailable() (string, bool) {
 	)
 
 	for _, conn := range r.conns {
-		if conn.activeTasks >= conn.maxConcurrentTasks {
+		if conn.draining || conn.activeTasks >= conn.maxConcurrentTasks {
 			continue
 		}
 
@@ -136,22 +172,34 @@ func (r *Registry) BroadcastCommand(cmd *taskguildv1.AgentCommand) {
 }
 
 // BroadcastCommandToProject sends a command only to agent-managers
-// whose projectName matches. Agents with an empty projectName (legacy)
-// also receive the command.
-func (r *Registry) BroadcastCommandToProject(projectName string, cmd *taskguildv1.AgentCommand) {
+// serving the given project. Agents without a project (legacy) also
+// receive the command. The command is stamped with the project name so
+// multi-project agents can route it. Returns the number of agent-managers
+// the command was queued for.
+func (r *Registry) BroadcastCommandToProject(projectName string, cmd *taskguildv1.AgentCommand) int {
+	if cmd.GetProjectName() != projectName {
+		cmd = proto.Clone(cmd).(*taskguildv1.AgentCommand)
+		cmd.ProjectName = projectName
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
+	sent := 0
+
 	for _, conn := range r.conns {
-		if conn.projectName != "" && conn.projectName != projectName {
+		if !conn.servesProject(projectName) {
 			continue
 		}
 
 		select {
 		case conn.commandCh <- cmd:
+			sent++
 		default:
 		}
 	}
+
+	return sent
 }
 
 // UnregisterIfMatch removes the connection for agentManagerID only if the
@@ -175,28 +223,41 @@ func (r *Registry) UnregisterIfMatch(agentManagerID string, ch chan *taskguildv1
 	return true
 }
 
-// GetProjectName returns the project name for a connected agent-manager.
-func (r *Registry) GetProjectName(agentManagerID string) (string, bool) {
+// GetProjects returns the projects served by a connected agent-manager.
+// An empty list means a legacy agent that serves every project.
+func (r *Registry) GetProjects(agentManagerID string) ([]ServedProject, bool) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	conn, ok := r.conns[agentManagerID]
 	if !ok {
-		return "", false
+		return nil, false
 	}
 
-	return conn.projectName, true
+	return slices.Clone(conn.projects), true
+}
+
+// ServesProject reports whether the connected agent-manager serves the given
+// project. Returns false if the agent-manager is not connected.
+func (r *Registry) ServesProject(agentManagerID, projectName string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	conn, ok := r.conns[agentManagerID]
+
+	return ok && conn.servesProject(projectName)
 }
 
 // HasConnectedAgentForProject returns true if at least one agent-manager is
-// connected for the given project name. Agents with an empty projectName
-// (legacy) are also considered matching.
+// connected for the given project name and accepting new tasks. Agents
+// without a project (legacy) are also considered matching; draining agents
+// are not.
 func (r *Registry) HasConnectedAgentForProject(projectName string) bool {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	for _, conn := range r.conns {
-		if conn.projectName == "" || conn.projectName == projectName {
+		if !conn.draining && conn.servesProject(projectName) {
 			return true
 		}
 	}
@@ -212,10 +273,85 @@ func (r *Registry) GetWorkDirForProject(projectName string) (string, bool) {
 	defer r.mu.RUnlock()
 
 	for _, conn := range r.conns {
-		if conn.projectName == projectName && conn.workDir != "" {
-			return conn.workDir, true
+		for _, p := range conn.projects {
+			if p.Name == projectName && p.WorkDir != "" {
+				return p.WorkDir, true
+			}
 		}
 	}
 
 	return "", false
 }
+
+// SetDraining marks a connected agent-manager as draining (or lifts the
+// drain). Draining agent-managers keep running their tasks but are no longer
+// considered available for new ones. Returns false if not connected.
+func (r *Registry) SetDraining(agentManagerID string, draining bool) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conn, ok := r.conns[agentManagerID]
+	if !ok {
+		return false
+	}
+
+	conn.draining = draining
+
+	return true
+}
+
+// AgentManagerInfo is a snapshot of a connected agent-manager's state.
+type AgentManagerInfo struct {
+	AgentManagerID     string
+	MaxConcurrentTasks int32
+	ActiveTasks        int32
+	Projects           []ServedProject
+	LastHeartbeat      time.Time
+	Draining           bool
+}
+
+// Idle reports whether a draining agent-manager has finished all its tasks.
+func (i AgentManagerInfo) Idle() bool {
+	return i.Draining && i.ActiveTasks == 0
+}
+
+func (c *connection) info() AgentManagerInfo {
+	return AgentManagerInfo{
+		AgentManagerID:     c.agentManagerID,
+		MaxConcurrentTasks: c.maxConcurrentTasks,
+		ActiveTasks:        c.activeTasks,
+		Projects:           slices.Clone(c.projects),
+		LastHeartbeat:      c.lastHeartbeat,
+		Draining:           c.draining,
+	}
+}
+
+// Get returns the state of a connected agent-manager.
+func (r *Registry) Get(agentManagerID string) (AgentManagerInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	conn, ok := r.conns[agentManagerID]
+	if !ok {
+		return AgentManagerInfo{}, false
+	}
+
+	return conn.info(), true
+}
+
+// List returns the state of all connected agent-managers, sorted by ID.
+func (r *Registry) List() []AgentManagerInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	infos := make([]AgentManagerInfo, 0, len(r.conns))
+	for _, conn := range r.conns {
+		infos = append(infos, conn.info())
+	}
+
+	slices.SortFunc(infos, func(a, b AgentManagerInfo) int {
+		return strings.Compare(a.AgentManagerID, b.AgentManagerID)
+	})
+
+	return infos
+}
@@ -179,3 +179,103 @@ func TestGetWorkDirForProject(t *testing.T) {
 		t.Fatal("expected no work_dir when agent has empty work_dir")
 	}
 }
+
+func TestRegisterProjects_MultipleProjects(t *testing.T) {
+	r := NewRegistry()
+	ch := r.RegisterProjects("agent-1", 4, []ServedProject{
+		{Name: "api", WorkDir: "/src/api", MaxConcurrentTasks: 2},
+		{Name: "web", WorkDir: "/src/web"},
+	})
+
+	for _, name := range []string{"api", "web"} {
+		if !r.ServesProject("agent-1", name) {
+			t.Fatalf("expected agent-1 to serve %s", name)
+		}
+
+		if !r.HasConnectedAgentForProject(name) {
+			t.Fatalf("expected a connected agent for %s", name)
+		}
+	}
+
+	if r.ServesProject("agent-1", "other") {
+		t.Fatal("expected agent-1 not to serve other")
+	}
+
+	if dir, ok := r.GetWorkDirForProject("web"); !ok || dir != "/src/web" {
+		t.Fatalf("expected /src/web, got %q (ok=%v)", dir, ok)
+	}
+
+	projects, ok := r.GetProjects("agent-1")
+	if !ok || len(projects) != 2 {
+		t.Fatalf("expected 2 projects, got %d (ok=%v)", len(projects), ok)
+	}
+
+	// Broadcast commands are stamped with the target project so the agent
+	// manager can route them.
+	cmd := &taskguildv1.AgentCommand{
+		Command: &taskguildv1.AgentCommand_SyncPermissions{
+			SyncPermissions: &taskguildv1.SyncPermissionsCommand{},
+		},
+	}
+	if sent := r.BroadcastCommandToProject("web", cmd); sent != 1 {
+		t.Fatalf("expected the command to be sent to 1 agent manager, got %d", sent)
+	}
+
+	received := <-ch
+	if received.GetProjectName() != "web" {
+		t.Fatalf("expected project_name web, got %q", received.GetProjectName())
+	}
+
+	if cmd.GetProjectName() != "" {
+		t.Fatal("expected the original command not to be modified")
+	}
+
+	if sent := r.BroadcastCommandToProject("other", cmd); sent != 0 {
+		t.Fatalf("expected no agent manager for other, got %d", sent)
+	}
+}
+
+func TestSetDraining(t *testing.T) {
+	r := NewRegistry()
+	r.Register("agent-1", 2, "proj", "/src/proj")
+
+	if !r.SetDraining("agent-1", true) {
+		t.Fatal("expected SetDraining to succeed for connected agent")
+	}
+
+	if r.HasConnectedAgentForProject("proj") {
+		t.Fatal("expected draining agent not to count as available")
+	}
+
+	if _, ok := r.FindAvailable(); ok {
+		t.Fatal("expected no available agent while draining")
+	}
+
+	// The work dir is still resolvable for SyncFromDir operations.
+	if _, ok := r.GetWorkDirForProject("proj"); !ok {
+		t.Fatal("expected work_dir of draining agent to stay resolvable")
+	}
+
+	r.UpdateHeartbeat("agent-1", 1)
+
+	info, ok := r.Get("agent-1")
+	if !ok || !info.Draining || info.Idle() {
+		t.Fatalf("expected draining, non-idle agent, got %+v", info)
+	}
+
+	r.UpdateHeartbeat("agent-1", 0)
+
+	if infos := r.List(); len(infos) != 1 || !infos[0].Idle() {
+		t.Fatalf("expected a single idle agent, got %+v", infos)
+	}
+
+	r.SetDraining("agent-1", false)
+
+	if !r.HasConnectedAgentForProject("proj") {
+		t.Fatal("expected agent to be available again after resume")
+	}
+
+	if r.SetDraining("unknown", true) {
+		t.Fatal("expected SetDraining to fail for unknown agent")
+	}
+}
@@ -0,0 +1,133 @@
+package agentmanager
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"github.com/oklog/ulid/v2"
+
+	"github.com/kazz187/taskguild/internal/task"
+	"github.com/kazz187/taskguild/internal/tasklog"
+	"github.com/kazz187/taskguild/pkg/cerr"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+// RequestTaskRollback sends a RollbackTaskCommand to the agent-manager that
+// took the checkpoint (worktrees and hidden refs live on its disk).
+func (s *Server) RequestTaskRollback(ctx context.Context, t *task.Task, checkpointID string, resume bool) (string, error) {
+	cp, err := s.findCheckpoint(ctx, t.ID, checkpointID)
+	if err != nil {
+		return "", err
+	}
+
+	agentID := cp.Metadata["agent_manager_id"]
+	if _, ok := s.registry.Get(agentID); !ok {
+		return "", cerr.NewError(cerr.FailedPrecondition,
+			fmt.Sprintf("agent manager %q that took the checkpoint is not connected", agentID), nil).ConnectError()
+	}
+
+	var projectName string
+	if p, pErr := s.projectRepo.Get(ctx, t.ProjectID); pErr == nil {
+		projectName = p.Name
+	}
+
+	requestID := ulid.Make().String()
+
+	sent := s.registry.SendCommand(agentID, &taskguildv1.AgentCommand{
+		Command: &taskguildv1.AgentCommand_RollbackTask{
+			RollbackTask: &taskguildv1.RollbackTaskCommand{
+				RequestId:    requestID,
+				TaskId:       t.ID,
+				CheckpointId: checkpointID,
+				Commit:       cp.Metadata["commit"],
+				WorktreeName: cp.Metadata["worktree"],
+				SessionId:    cp.Metadata["session_id"],
+				MessageUuid:  cp.Metadata["message_uuid"],
+				StatusName:   cp.Metadata["status_name"],
+				Resume:       resume,
+			},
+		},
+		ProjectName: projectName,
+	})
+	if !sent {
+		return "", cerr.NewError(cerr.Unavailable,
+			fmt.Sprintf("failed to send rollback command to agent manager %q", agentID), nil).ConnectError()
+	}
+
+	slog.Info("task rollback requested",
+		"task_id", t.ID,
+		"checkpoint_id", checkpointID,
+		"agent_manager_id", agentID,
+		"request_id", requestID,
+	)
+
+	return requestID, nil
+}
+
+// findCheckpoint returns the CHECKPOINT log of the task with the given ID.
+func (s *Server) findCheckpoint(ctx context.Context, taskID, checkpointID string) (*tasklog.TaskLog, error) {
+	logs, _, err := s.taskLogRepo.List(ctx, taskID, nil, 0, 0)
+	if err != nil {
+		return nil, cerr.ExtractConnectError(ctx, err)
+	}
+
+	for _, l := range logs {
+		if l.Category == int32(taskguildv1.TaskLogCategory_TASK_LOG_CATEGORY_CHECKPOINT) &&
+			l.Metadata["checkpoint_id"] == checkpointID {
+			if l.Metadata["commit"] == "" || l.Metadata["worktree"] == "" {
+				return nil, cerr.NewError(cerr.FailedPrecondition, "checkpoint is incomplete", nil).ConnectError()
+			}
+
+			return l, nil
+		}
+	}
+
+	return nil, cerr.NewError(cerr.NotFound, fmt.Sprintf("checkpoint %q not found", checkpointID), nil).ConnectError()
+}
+
+func (s *Server) ReportTaskRollbackResult(ctx context.Context, req *connect.Request[taskguildv1.ReportTaskRollbackResultRequest]) (*connect.Response[taskguildv1.ReportTaskRollbackResultResponse], error) {
+	if req.Msg.GetTaskId() == "" {
+		return nil, cerr.NewError(cerr.InvalidArgument, "task_id is required", nil).ConnectError()
+	}
+
+	t, err := s.taskRepo.Get(ctx, req.Msg.GetTaskId())
+	if err != nil {
+		return nil, cerr.ExtractConnectError(ctx, err)
+	}
+
+	slog.Info("task rollback result reported",
+		"task_id", t.ID,
+		"checkpoint_id", req.Msg.GetCheckpointId(),
+		"success", req.Msg.GetSuccess(),
+		"error_message", req.Msg.GetErrorMessage(),
+	)
+
+	s.eventBus.PublishNew(
+		taskguildv1.EventType_EVENT_TYPE_TASK_ROLLBACK_RESULT,
+		req.Msg.GetRequestId(),
+		"",
+		map[string]string{
+			"project_id":    t.ProjectID,
+			"task_id":       t.ID,
+			"request_id":    req.Msg.GetRequestId(),
+			"checkpoint_id": req.Msg.GetCheckpointId(),
+			"success":       strconv.FormatBool(req.Msg.GetSuccess()),
+			"error_message": req.Msg.GetErrorMessage(),
+		},
+	)
+
+	if req.Msg.GetSuccess() && req.Msg.GetResume() && t.AssignmentStatus == task.AssignmentStatusUnassigned {
+		delete(t.Metadata, "_stopped_by_user")
+		delete(t.Metadata, "_retry_count")
+		delete(t.Metadata, "result_error")
+
+		if err := s.RequestTaskResume(ctx, t); err != nil {
+			slog.Warn("failed to resume task after rollback", "task_id", t.ID, "error", err)
+		}
+	}
+
+	return connect.NewResponse(&taskguildv1.ReportTaskRollbackResultResponse{}), nil
+}
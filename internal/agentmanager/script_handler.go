@@ -126,6 +126,7 @@ func (s *Server) RequestScriptExecution(requestID string, projectID string, sc *
 				Filename:  sc.Filename,
 				// Content is no longer sent; the agent reads from the local
 				// .taskguild/scripts/{filename} file directly.
+				Secrets: s.projectSecrets(context.Background(), projectID),
 			},
 		},
 	})
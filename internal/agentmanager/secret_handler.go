@@ -0,0 +1,34 @@
+package agentmanager
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SecretSource supplies the decrypted secrets of a project keyed by name.
+type SecretSource interface {
+	SecretValues(ctx context.Context, projectID string) (map[string]string, error)
+}
+
+// SetSecretSource sets the source of project secrets delivered to agent
+// managers with claimed tasks and script executions.
+func (s *Server) SetSecretSource(source SecretSource) {
+	s.secretSource = source
+}
+
+// projectSecrets returns the secrets of a project, or nil when no source is
+// set or the secrets cannot be loaded. A failure is logged rather than
+// returned so that tasks and scripts still run without them.
+func (s *Server) projectSecrets(ctx context.Context, projectID string) map[string]string {
+	if s.secretSource == nil {
+		return nil
+	}
+
+	values, err := s.secretSource.SecretValues(ctx, projectID)
+	if err != nil {
+		slog.Warn("failed to load project secrets", "project_id", projectID, "error", err)
+		return nil
+	}
+
+	return values
+}
@@ -2,6 +2,7 @@ package agentmanager
 
 import (
 	"sync"
+	"time"
 
 	"github.com/kazz187/taskguild/internal/agent"
 	"github.com/kazz187/taskguild/internal/claudesettings"
@@ -39,6 +40,11 @@ type Server struct {
 	// scriptBroker manages streaming script execution output.
 	scriptBroker *script.ScriptExecutionBroker
 
+	// transcriptStore holds Claude session transcripts for cross-machine
+	// resume. nil disables upload/download.
+	transcriptStore        task.TranscriptStore
+	maxTranscriptSizeBytes int64
+
 	// worktreeClaimMu serializes ClaimTask calls per project+worktree pair,
 	// ensuring only one task per worktree can be ASSIGNED at a time.
 	// Key: "projectID\x00worktreeName" → value: *sync.Mutex
@@ -46,8 +52,30 @@ type Server struct {
 
 	// worktreeCache stores the latest worktree list per project_id,
 	// populated by ReportWorktreeList and read by GetWorktreeList.
-	worktreeMu    sync.RWMutex
-	worktreeCache map[string][]*taskguildv1.WorktreeInfo // project_id -> worktrees
+	// worktreeQuotaWarnedAt throttles disk quota notifications per project_id.
+	worktreeMu            sync.RWMutex
+	worktreeCache         map[string][]*taskguildv1.WorktreeInfo // project_id -> worktrees
+	worktreeQuotaWarnedAt map[string]time.Time
+
+	// diffWaiters routes ReportTaskDiff replies to the GetTaskDiff call (or
+	// diff snapshot) waiting on the request_id.
+	diffMu      sync.Mutex
+	diffWaiters map[string]*diffWaiter
+
+	// modifiedFiles tracks the files each worktree task modified (task_id
+	// -> files) to detect overlaps between concurrent tasks.
+	// notifiedOverlaps remembers the files already notified per task pair.
+	fileOverlapMu    sync.Mutex
+	modifiedFiles    map[string]*modifiedFiles
+	notifiedOverlaps map[string][]string
+
+	// taskCreator creates merge fix-up tasks. nil disables them.
+	taskCreator TaskCreator
+
+	// activeMerges holds the merge in progress per project_id; the merge
+	// queue runs one merge per project at a time.
+	mergeMu      sync.Mutex
+	activeMerges map[string]*activeMerge
 
 	// scriptDiffCache stores the latest script comparison per project_id,
 	// populated by ReportScriptComparison and read by GetScriptComparison.
@@ -63,27 +91,53 @@ type Server struct {
 	// populated by ReportSkillComparison and read by GetSkillComparison.
 	skillDiffMu    sync.RWMutex
 	skillDiffCache map[string][]*taskguildv1.SkillDiff // project_id -> diffs
+
+	// redactors caches the compiled secret redactor per project_id.
+	// redactGen is bumped by InvalidateRedactor so that a redactor built
+	// from patterns loaded before the invalidation is not cached.
+	redactMu  sync.Mutex
+	redactors map[string]*projectRedactor
+	redactGen uint64
+
+	// secretSource supplies project secrets for task claims and script
+	// executions. nil disables secret delivery.
+	secretSource SecretSource
 }
 
 func NewServer(registry *Registry, taskRepo task.Repository, workflowRepo workflow.Repository, agentRepo agent.Repository, interactionRepo interaction.Repository, projectRepo project.Repository, skillRepo skill.Repository, scriptRepo script.Repository, taskLogRepo tasklog.Repository, permissionRepo permission.Repository, scpRepo scp.Repository, claudeSettingsRepo claudesettings.Repository, eventBus *eventbus.Bus, scriptBroker *script.ScriptExecutionBroker) *Server {
 	return &Server{
-		registry:           registry,
-		taskRepo:           taskRepo,
-		workflowRepo:       workflowRepo,
-		agentRepo:          agentRepo,
-		interactionRepo:    interactionRepo,
-		projectRepo:        projectRepo,
-		skillRepo:          skillRepo,
-		scriptRepo:         scriptRepo,
-		taskLogRepo:        taskLogRepo,
-		permissionRepo:     permissionRepo,
-		scpRepo:            scpRepo,
-		claudeSettingsRepo: claudeSettingsRepo,
-		eventBus:           eventBus,
-		scriptBroker:       scriptBroker,
-		worktreeCache:      make(map[string][]*taskguildv1.WorktreeInfo),
-		scriptDiffCache:    make(map[string][]*taskguildv1.ScriptDiff),
-		agentDiffCache:     make(map[string][]*taskguildv1.AgentDiff),
-		skillDiffCache:     make(map[string][]*taskguildv1.SkillDiff),
+		registry:              registry,
+		taskRepo:              taskRepo,
+		workflowRepo:          workflowRepo,
+		agentRepo:             agentRepo,
+		interactionRepo:       interactionRepo,
+		projectRepo:           projectRepo,
+		skillRepo:             skillRepo,
+		scriptRepo:            scriptRepo,
+		taskLogRepo:           taskLogRepo,
+		permissionRepo:        permissionRepo,
+		scpRepo:               scpRepo,
+		claudeSettingsRepo:    claudeSettingsRepo,
+		eventBus:              eventBus,
+		scriptBroker:          scriptBroker,
+		worktreeCache:         make(map[string][]*taskguildv1.WorktreeInfo),
+		worktreeQuotaWarnedAt: make(map[string]time.Time),
+		diffWaiters:           make(map[string]*diffWaiter),
+		activeMerges:          make(map[string]*activeMerge),
+		scriptDiffCache:       make(map[string][]*taskguildv1.ScriptDiff),
+		agentDiffCache:        make(map[string][]*taskguildv1.AgentDiff),
+		skillDiffCache:        make(map[string][]*taskguildv1.SkillDiff),
+		redactors:             make(map[string]*projectRedactor),
 	}
 }
+
+// SetTranscriptStore enables session transcript upload/download. maxSizeBytes
+// limits a single stored (compressed) transcript; <= 0 uses the default.
+func (s *Server) SetTranscriptStore(store task.TranscriptStore, maxSizeBytes int64) {
+	if maxSizeBytes <= 0 {
+		maxSizeBytes = task.DefaultMaxTranscriptSizeBytes
+	}
+
+	s.transcriptStore = store
+	s.maxTranscriptSizeBytes = maxSizeBytes
+}
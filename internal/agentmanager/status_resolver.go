@@ -0,0 +1,158 @@
+package agentmanager
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/kazz187/taskguild/internal/workflow"
+)
+
+// statusHook is a status hook as sent to the agent in the _hooks metadata,
+// with its skill or script content resolved.
+type statusHook struct {
+	ID         string `json:"id"`
+	SkillID    string `json:"skill_id"`
+	ActionType string `json:"action_type"`
+	ActionID   string `json:"action_id"`
+	Trigger    string `json:"trigger"`
+	Order      int32  `json:"order"`
+	Name       string `json:"name"`
+	Content    string `json:"content"`
+	SkillName  string `json:"skill_name"`
+	Args       string `json:"args"`
+}
+
+// resolvedStatus is what a workflow status runs with. ClaimTask sends it to
+// the agent as metadata and EvaluatePermission simulates the agent's checks
+// with it, so both go through resolveStatus.
+type resolvedStatus struct {
+	// Status is the workflow status, or nil when it does not exist.
+	Status *workflow.Status
+	// SkillNames are the status's execution skills (skill_ids).
+	SkillNames []string
+	// PermissionMode is the status's mode, or the workflow default.
+	PermissionMode string
+	Hooks          []statusHook
+}
+
+// resolveStatus resolves the execution skills, hooks and permission mode of
+// a workflow status. Skills and hook actions that cannot be loaded are
+// logged and skipped.
+func (s *Server) resolveStatus(ctx context.Context, wf *workflow.Workflow, statusName string) resolvedStatus {
+	var r resolvedStatus
+
+	for i, st := range wf.Statuses {
+		if st.Name == statusName {
+			r.Status = &wf.Statuses[i]
+			break
+		}
+	}
+
+	r.PermissionMode = wf.DefaultPermissionMode
+	if r.Status == nil {
+		return r
+	}
+
+	if r.Status.PermissionMode != "" {
+		r.PermissionMode = r.Status.PermissionMode
+	}
+
+	for _, sid := range r.Status.SkillIDs {
+		if s.skillRepo == nil {
+			break
+		}
+
+		if sk, err := s.skillRepo.Get(ctx, sid); err == nil {
+			r.SkillNames = append(r.SkillNames, sk.Name)
+		} else {
+			slog.Warn("failed to resolve skill for status", "skill_id", sid, "status", statusName, "error", err)
+		}
+	}
+
+	for _, h := range r.Status.Hooks {
+		r.Hooks = append(r.Hooks, s.resolveHook(ctx, h))
+	}
+
+	return r
+}
+
+// resolveHook loads the content of a hook's skill or script action.
+func (s *Server) resolveHook(ctx context.Context, h workflow.StatusHook) statusHook {
+	entry := statusHook{
+		ID:         h.ID,
+		SkillID:    h.SkillID,
+		ActionType: string(h.ActionType),
+		ActionID:   h.ActionID,
+		Trigger:    string(h.Trigger),
+		Order:      h.Order,
+		Name:       h.Name,
+		SkillName:  h.SkillName,
+		Args:       h.Args,
+	}
+
+	// Resolve content based on action type.
+	// New approach: use action_type + action_id.
+	switch {
+	case h.ActionType == workflow.HookActionTypeCustomSkill && h.SkillName != "":
+		// Custom skill: do not look up DB; rely on Claude CLI's
+		// slash command resolution at execute time. Sync Name to
+		// SkillName so collectStatusSkills can auto-allow it.
+		if entry.Name == "" {
+			entry.Name = h.SkillName
+		}
+	case h.ActionType == workflow.HookActionTypeSkill && h.ActionID != "":
+		if s.skillRepo != nil {
+			if sk, err := s.skillRepo.Get(ctx, h.ActionID); err == nil {
+				entry.Content = sk.Content
+				if entry.SkillName == "" {
+					entry.SkillName = sk.Name
+				}
+			} else {
+				slog.Warn("failed to resolve hook skill", "hook_id", h.ID, "action_id", h.ActionID, "error", err)
+			}
+		}
+	case h.ActionType == workflow.HookActionTypeScript && h.ActionID != "":
+		if s.scriptRepo != nil {
+			if sc, err := s.scriptRepo.Get(ctx, h.ActionID); err == nil {
+				entry.Content = sc.Content
+			} else {
+				slog.Warn("failed to resolve hook script", "hook_id", h.ID, "action_id", h.ActionID, "error", err)
+			}
+		}
+	case h.SkillID != "":
+		// Legacy: use skill_id directly.
+		if s.skillRepo != nil {
+			if sk, err := s.skillRepo.Get(ctx, h.SkillID); err == nil {
+				entry.Content = sk.Content
+			} else {
+				slog.Warn("failed to resolve hook skill", "hook_id", h.ID, "skill_id", h.SkillID, "error", err)
+			}
+		}
+	}
+
+	return entry
+}
+
+// allowedSkills returns the skills the agent auto-allows for the Skill
+// tool: the execution skills and the skill hooks. It mirrors the agent's
+// collectStatusSkills over the _skill_names and _hooks metadata.
+func (r resolvedStatus) allowedSkills() map[string]bool {
+	out := map[string]bool{}
+
+	for _, n := range r.SkillNames {
+		out[n] = true
+	}
+
+	for _, h := range r.Hooks {
+		if h.Name == "" {
+			continue
+		}
+
+		switch workflow.HookActionType(h.ActionType) {
+		case workflow.HookActionTypeUnspecified, workflow.HookActionTypeSkill, workflow.HookActionTypeCustomSkill:
+			out[h.Name] = true
+		}
+	}
+
+	return out
+}
@@ -28,17 +28,22 @@ func (s *Server) Subscribe(ctx context.Context, req *connect.Request[taskguildv1
 		return cerr.NewError(cerr.InvalidArgument, "agent_manager_id is required", nil).ConnectError()
 	}
 
-	projectName := req.Msg.GetProjectName()
+	projects := servedProjectsFromRequest(req.Msg)
 	activeTaskIDs := req.Msg.GetActiveTaskIds()
 	agentVersion := req.Msg.GetAgentVersion()
 	serverVersion := version.Short()
 
+	projectNames := make([]string, 0, len(projects))
+	for _, p := range projects {
+		projectNames = append(projectNames, p.Name)
+	}
+
 	slog.Info("agent-manager connected",
 		"agent_manager_id", agentManagerID,
 		"agent_version", agentVersion,
 		"server_version", serverVersion,
 		"max_concurrent_tasks", req.Msg.GetMaxConcurrentTasks(),
-		"project_name", projectName,
+		"project_names", projectNames,
 		"active_tasks", len(activeTaskIDs),
 	)
 
@@ -56,7 +61,12 @@ func (s *Server) Subscribe(ctx context.Context, req *connect.Request[taskguildv1
 	// transient stream disconnection.
 	s.releaseAgentTasksExcept(ctx, agentManagerID, activeTaskIDs)
 
-	commandCh := s.registry.Register(agentManagerID, req.Msg.GetMaxConcurrentTasks(), projectName, req.Msg.GetWorkDir())
+	commandCh := s.registry.RegisterProjects(agentManagerID, req.Msg.GetMaxConcurrentTasks(), projects)
+
+	// Keep an in-progress drain across reconnects.
+	if req.Msg.GetDraining() {
+		s.registry.SetDraining(agentManagerID, true)
+	}
 
 	defer func() {
 		wasActive := s.registry.UnregisterIfMatch(agentManagerID, commandCh)
@@ -78,7 +88,11 @@ func (s *Server) Subscribe(ctx context.Context, req *connect.Request[taskguildv1
 	// immediately. This covers tasks that were pending before this agent
 	// connected and tasks released during reconnection whose broadcast
 	// was sent before the agent was registered.
-	s.sendPendingTasksToStream(ctx, projectName, stream)
+	for _, name := range projectNames {
+		s.sendPendingTasks(ctx, name, stream.Send)
+	}
+
+	s.resumeMergeQueues(ctx, projectNames)
 
 	// Server-side keepalive: send a PingCommand every 30 seconds to keep the
 	// HTTP/2 stream active and detect dead connections faster. This prevents
@@ -230,11 +244,11 @@ func (s *Server) handleReleasedTask(ctx context.Context, agentManagerID string,
 	)
 }
 
-// sendPendingTasksToStream scans for PENDING tasks in the given project and
-// sends TaskAvailableCommand for each directly on the agent's stream. This
-// ensures that tasks pending before an agent connects (or tasks released
+// sendPendingTasks scans for PENDING tasks in the given project and sends
+// TaskAvailableCommand for each directly to one agent (normally its stream).
+// This ensures that tasks pending before an agent connects (or tasks released
 // during reconnection before the agent was registered) are picked up.
-func (s *Server) sendPendingTasksToStream(ctx context.Context, projectName string, stream *connect.ServerStream[taskguildv1.AgentCommand]) {
+func (s *Server) sendPendingTasks(ctx context.Context, projectName string, send func(*taskguildv1.AgentCommand) error) {
 	if projectName == "" {
 		return
 	}
@@ -287,9 +301,10 @@ func (s *Server) sendPendingTasksToStream(ctx context.Context, projectName strin
 					Metadata:      t.Metadata,
 				},
 			},
+			ProjectName: projectName,
 		}
 
-		err := stream.Send(cmd)
+		err := send(cmd)
 		if err != nil {
 			slog.Error("sendPendingTasks: failed to send command",
 				"task_id", t.ID, "error", err)
@@ -311,10 +326,17 @@ func (s *Server) Heartbeat(ctx context.Context, req *connect.Request[taskguildv1
 		return nil, cerr.NewError(cerr.InvalidArgument, "agent_manager_id is required", nil).ConnectError()
 	}
 
+	prev, _ := s.registry.Get(req.Msg.GetAgentManagerId())
+
 	if !s.registry.UpdateHeartbeat(req.Msg.GetAgentManagerId(), req.Msg.GetActiveTasks()) {
 		return nil, cerr.NewError(cerr.NotFound, "agent-manager not connected", nil).ConnectError()
 	}
 
+	if cur, ok := s.registry.Get(req.Msg.GetAgentManagerId()); ok && cur.Idle() && !prev.Idle() {
+		slog.Info("agent-manager drained and idle", "agent_manager_id", cur.AgentManagerID)
+		s.publishAgentManagerChanged(cur)
+	}
+
 	return connect.NewResponse(&taskguildv1.HeartbeatResponse{}), nil
 }
 
@@ -325,12 +347,30 @@ const (
 	retryBaseDelay   = 30 * time.Second
 )
 
+// lastResultIDMetadataKey records the last processed result_id so a result
+// replayed from the agent's outbox is applied only once.
+const lastResultIDMetadataKey = "_last_result_id"
+
 func (s *Server) ReportTaskResult(ctx context.Context, req *connect.Request[taskguildv1.ReportTaskResultRequest]) (*connect.Response[taskguildv1.ReportTaskResultResponse], error) {
 	t, err := s.taskRepo.Get(ctx, req.Msg.GetTaskId())
 	if err != nil {
 		return nil, err
 	}
 
+	// Results replayed from the agent's outbox may already have been processed.
+	if resultID := req.Msg.GetResultId(); resultID != "" {
+		if t.Metadata[lastResultIDMetadataKey] == resultID {
+			slog.Info("ignoring duplicate task result", "task_id", t.ID, "result_id", resultID)
+			return connect.NewResponse(&taskguildv1.ReportTaskResultResponse{}), nil
+		}
+
+		if t.Metadata == nil {
+			t.Metadata = make(map[string]string)
+		}
+
+		t.Metadata[lastResultIDMetadataKey] = resultID
+	}
+
 	// If the task is already unassigned (e.g. stopped by user via StopTask),
 	// just emit the result log without triggering retry logic.
 	if t.AssignmentStatus == task.AssignmentStatusUnassigned && t.AssignedAgentID == "" {
@@ -467,6 +507,11 @@ func (s *Server) ReportTaskResult(ctx context.Context, req *connect.Request[task
 		s.rebroadcastWorktreeWaiters(ctx, t.ProjectID, worktreeName, t.ID)
 	}
 
+	// A task queued for merge while it was still running can be merged now.
+	if t.Metadata[task.MetaMergeStatus] == task.MergeStatusQueued {
+		s.kickMergeQueue(ctx, t.ProjectID)
+	}
+
 	return connect.NewResponse(&taskguildv1.ReportTaskResultResponse{}), nil
 }
 
@@ -582,6 +627,7 @@ func (s *Server) emitResultLog(ctx context.Context, t *task.Task, summary, errMs
 		Metadata:  meta,
 		CreatedAt: now,
 	}
+	redactTaskLog(s.redactor(ctx, t.ProjectID), l)
 
 	err := s.taskLogRepo.Create(ctx, l)
 	if err != nil {
@@ -602,6 +648,11 @@ func (s *Server) ClaimTask(ctx context.Context, req *connect.Request[taskguildv1
 		return nil, cerr.NewError(cerr.InvalidArgument, "task_id and agent_manager_id are required", nil).ConnectError()
 	}
 
+	// Draining agent-managers must not pick up new work.
+	if info, ok := s.registry.Get(req.Msg.GetAgentManagerId()); ok && info.Draining {
+		return nil, cerr.NewError(cerr.FailedPrecondition, "agent-manager is draining", nil).ConnectError()
+	}
+
 	// Pre-read the task to check worktree occupancy before claiming.
 	taskForCheck, err := s.taskRepo.Get(ctx, req.Msg.GetTaskId())
 	if err != nil {
@@ -657,14 +708,14 @@ func (s *Server) ClaimTask(ctx context.Context, req *connect.Request[taskguildv1
 		return nil, cerr.ExtractConnectError(ctx, err)
 	}
 
-	// Validate project name: if the agent declared a project, verify it matches.
-	if agentProject, ok := s.registry.GetProjectName(req.Msg.GetAgentManagerId()); ok && agentProject != "" {
+	// Validate project name: if the agent declared projects, verify it serves the task's project.
+	if agentProjects, ok := s.registry.GetProjects(req.Msg.GetAgentManagerId()); ok && len(agentProjects) > 0 {
 		var taskProjectName string
 		if p, pErr := s.projectRepo.Get(ctx, t.ProjectID); pErr == nil {
 			taskProjectName = p.Name
 		}
 
-		if taskProjectName != "" && agentProject != taskProjectName {
+		if taskProjectName != "" && !s.registry.ServesProject(req.Msg.GetAgentManagerId(), taskProjectName) {
 			// Mismatch: unclaim the task and reject.
 			t.AssignedAgentID = ""
 			t.AssignmentStatus = task.AssignmentStatusPending
@@ -672,7 +723,7 @@ func (s *Server) ClaimTask(ctx context.Context, req *connect.Request[taskguildv1
 			_ = s.taskRepo.Update(ctx, t)
 			slog.Warn("agent claimed task from wrong project",
 				"task_id", t.ID,
-				"agent_project", agentProject,
+				"agent_projects", len(agentProjects),
 				"task_project", taskProjectName,
 			)
 
@@ -689,34 +740,18 @@ func (s *Server) ClaimTask(ctx context.Context, req *connect.Request[taskguildv1
 	}
 
 	var (
-		instructions  string
-		agentConfigID string
-		agentName     string
-		skillNames    []string
+		instructions        string
+		agentConfigID       string
+		agentName           string
+		agentFallbackModels []string
 	)
 
 	// Resolve the current status to find execution configuration.
-	var currentStatus *workflow.Status
-
-	for i, st := range wf.Statuses {
-		if st.Name == t.StatusID {
-			currentStatus = &wf.Statuses[i]
-			break
-		}
-	}
+	resolved := s.resolveStatus(ctx, wf, t.StatusID)
+	currentStatus := resolved.Status
 
 	// Priority 1: Skill-based execution (skill_ids on status).
-	if currentStatus != nil && len(currentStatus.SkillIDs) > 0 {
-		for _, sid := range currentStatus.SkillIDs {
-			if s.skillRepo != nil {
-				if sk, err := s.skillRepo.Get(ctx, sid); err == nil {
-					skillNames = append(skillNames, sk.Name)
-				} else {
-					slog.Warn("failed to resolve skill for status", "skill_id", sid, "status", t.StatusID, "error", err)
-				}
-			}
-		}
-	}
+	skillNames := resolved.SkillNames
 
 	// Priority 2: Agent-based execution (agent_id on status) — fallback.
 	if len(skillNames) == 0 {
@@ -730,6 +765,7 @@ func (s *Server) ClaimTask(ctx context.Context, req *connect.Request[taskguildv1
 			if err == nil {
 				agentConfigID = ag.ID
 				agentName = ag.Name
+				agentFallbackModels = ag.FallbackModels
 			}
 		}
 
@@ -769,17 +805,11 @@ func (s *Server) ClaimTask(ctx context.Context, req *connect.Request[taskguildv1
 	enrichedMetadata["_workflow_id"] = t.WorkflowID
 	if t.UseWorktree {
 		enrichedMetadata["_use_worktree"] = "true"
+		s.addWorktreeBaseMetadata(ctx, t, enrichedMetadata)
 	}
 	// Resolve permission mode from workflow status, falling back to workflow default.
-	for _, st := range wf.Statuses {
-		if st.Name == t.StatusID && st.PermissionMode != "" {
-			enrichedMetadata["_permission_mode"] = st.PermissionMode
-			break
-		}
-	}
-
-	if _, ok := enrichedMetadata["_permission_mode"]; !ok && wf.DefaultPermissionMode != "" {
-		enrichedMetadata["_permission_mode"] = wf.DefaultPermissionMode
+	if resolved.PermissionMode != "" {
+		enrichedMetadata["_permission_mode"] = resolved.PermissionMode
 	}
 
 	if agentName != "" {
@@ -808,6 +838,37 @@ func (s *Server) ClaimTask(ctx context.Context, req *connect.Request[taskguildv1
 			}
 		}
 	}
+	// Resolve the model fallback chain: status configuration wins over agent.
+	fallbackModels := agentFallbackModels
+	if currentStatus != nil && len(currentStatus.FallbackModels) > 0 {
+		fallbackModels = currentStatus.FallbackModels
+	}
+
+	if len(fallbackModels) > 0 {
+		if b, err := json.Marshal(fallbackModels); err == nil {
+			enrichedMetadata["_fallback_models"] = string(b)
+		}
+	}
+
+	// Resolve the Bash sandbox; bypassPermissions statuses are always sandboxed.
+	if sb := resolveSandbox(currentStatus, enrichedMetadata["_permission_mode"]); sb != nil {
+		if b, err := json.Marshal(sandboxMetadata{
+			AllowNetwork:    sb.AllowNetwork,
+			CPULimitSeconds: sb.CPULimitSeconds,
+			MemoryLimitMB:   sb.MemoryLimitMB,
+			TimeoutSeconds:  sb.TimeoutSeconds,
+			WritablePaths:   sb.WritablePaths,
+		}); err == nil {
+			enrichedMetadata["_sandbox"] = string(b)
+		}
+	}
+
+	if currentStatus != nil && !currentStatus.PathPolicy.Empty() {
+		if b, err := json.Marshal(currentStatus.PathPolicy); err == nil {
+			enrichedMetadata["_path_policy"] = string(b)
+		}
+	}
+
 	// Resolve effort: task override wins over WorkflowStatus.
 	if effort := resolveEffort(t, currentStatus); effort != "" {
 		enrichedMetadata["_effort"] = effort
@@ -863,87 +924,10 @@ func (s *Server) ClaimTask(ctx context.Context, req *connect.Request[taskguildv1
 		}
 	}
 
-	// Resolve hooks for the current status and inject into metadata.
-	for _, st := range wf.Statuses {
-		if st.Name == t.StatusID && len(st.Hooks) > 0 {
-			type hookEntry struct {
-				ID         string `json:"id"`
-				SkillID    string `json:"skill_id"`
-				ActionType string `json:"action_type"`
-				ActionID   string `json:"action_id"`
-				Trigger    string `json:"trigger"`
-				Order      int32  `json:"order"`
-				Name       string `json:"name"`
-				Content    string `json:"content"`
-				SkillName  string `json:"skill_name"`
-				Args       string `json:"args"`
-			}
-
-			var hooks []hookEntry
-
-			for _, h := range st.Hooks {
-				entry := hookEntry{
-					ID:         h.ID,
-					SkillID:    h.SkillID,
-					ActionType: string(h.ActionType),
-					ActionID:   h.ActionID,
-					Trigger:    string(h.Trigger),
-					Order:      h.Order,
-					Name:       h.Name,
-					SkillName:  h.SkillName,
-					Args:       h.Args,
-				}
-
-				// Resolve content based on action type.
-				// New approach: use action_type + action_id.
-				switch {
-				case h.ActionType == workflow.HookActionTypeCustomSkill && h.SkillName != "":
-					// Custom skill: do not look up DB; rely on Claude CLI's
-					// slash command resolution at execute time. Sync Name to
-					// SkillName so collectStatusSkills can auto-allow it.
-					if entry.Name == "" {
-						entry.Name = h.SkillName
-					}
-				case h.ActionType == workflow.HookActionTypeSkill && h.ActionID != "":
-					if s.skillRepo != nil {
-						if sk, err := s.skillRepo.Get(ctx, h.ActionID); err == nil {
-							entry.Content = sk.Content
-							if entry.SkillName == "" {
-								entry.SkillName = sk.Name
-							}
-						} else {
-							slog.Warn("failed to resolve hook skill", "hook_id", h.ID, "action_id", h.ActionID, "error", err)
-						}
-					}
-				case h.ActionType == workflow.HookActionTypeScript && h.ActionID != "":
-					if s.scriptRepo != nil {
-						if sc, err := s.scriptRepo.Get(ctx, h.ActionID); err == nil {
-							entry.Content = sc.Content
-						} else {
-							slog.Warn("failed to resolve hook script", "hook_id", h.ID, "action_id", h.ActionID, "error", err)
-						}
-					}
-				case h.SkillID != "":
-					// Legacy: use skill_id directly.
-					if s.skillRepo != nil {
-						if sk, err := s.skillRepo.Get(ctx, h.SkillID); err == nil {
-							entry.Content = sk.Content
-						} else {
-							slog.Warn("failed to resolve hook skill", "hook_id", h.ID, "skill_id", h.SkillID, "error", err)
-						}
-					}
-				}
-
-				hooks = append(hooks, entry)
-			}
-
-			if len(hooks) > 0 {
-				if b, err := json.Marshal(hooks); err == nil {
-					enrichedMetadata["_hooks"] = string(b)
-				}
-			}
-
-			break
+	// Inject the resolved hooks for the current status.
+	if len(resolved.Hooks) > 0 {
+		if b, err := json.Marshal(resolved.Hooks); err == nil {
+			enrichedMetadata["_hooks"] = string(b)
 		}
 	}
 
@@ -1015,6 +999,7 @@ func (s *Server) ClaimTask(ctx context.Context, req *connect.Request[taskguildv1
 		Instructions:  instructions,
 		AgentConfigId: agentConfigID,
 		Metadata:      enrichedMetadata,
+		Secrets:       s.projectSecrets(ctx, t.ProjectID),
 	}), nil
 }
 
@@ -1118,6 +1103,28 @@ func (s *Server) RequestTaskStop(taskID string, assignedAgentID string) error {
 	return nil
 }
 
+// RequestTaskCompact sends a CompactTaskCommand to the agent running the
+// given task.
+func (s *Server) RequestTaskCompact(taskID string, assignedAgentID string) error {
+	sent := s.registry.SendCommand(assignedAgentID, &taskguildv1.AgentCommand{
+		Command: &taskguildv1.AgentCommand_CompactTask{
+			CompactTask: &taskguildv1.CompactTaskCommand{
+				TaskId: taskID,
+			},
+		},
+	})
+	if !sent {
+		return fmt.Errorf("agent %s not connected", assignedAgentID)
+	}
+
+	slog.Info("task compact command sent",
+		"task_id", taskID,
+		"agent_id", assignedAgentID,
+	)
+
+	return nil
+}
+
 // RequestTaskResume re-triggers orchestration for a stopped task by setting it
 // to PENDING and broadcasting a TaskAvailableCommand.
 func (s *Server) RequestTaskResume(ctx context.Context, t *task.Task) error {
@@ -1197,3 +1204,105 @@ func resolveEffort(t *task.Task, currentStatus *workflow.Status) string {
 
 	return effort
 }
+
+// sandboxMetadata is the JSON shape of the _sandbox metadata key read by the
+// agent manager. Its presence means sandboxing is enabled.
+type sandboxMetadata struct {
+	AllowNetwork    bool     `json:"allow_network,omitempty"`
+	CPULimitSeconds int32    `json:"cpu_limit_seconds,omitempty"`
+	MemoryLimitMB   int32    `json:"memory_limit_mb,omitempty"`
+	TimeoutSeconds  int32    `json:"timeout_seconds,omitempty"`
+	WritablePaths   []string `json:"writable_paths,omitempty"`
+}
+
+// resolveSandbox returns the sandbox configuration for the current status, or
+// nil when commands run unsandboxed. Statuses running in bypassPermissions
+// mode are forced into the sandbox (with the status limits, if any).
+func resolveSandbox(currentStatus *workflow.Status, permissionMode string) *workflow.SandboxConfig {
+	var sb *workflow.SandboxConfig
+	if currentStatus != nil && currentStatus.Sandbox != nil {
+		cp := *currentStatus.Sandbox
+		sb = &cp
+	}
+
+	if permissionMode == "bypassPermissions" {
+		if sb == nil {
+			sb = &workflow.SandboxConfig{}
+		}
+
+		sb.Enabled = true
+	}
+
+	if sb == nil || !sb.Enabled {
+		return nil
+	}
+
+	return sb
+}
+
+// addWorktreeBaseMetadata injects where a new worktree of t starts and how
+// its branch is named. A base task whose worktree does not exist yet is
+// skipped, so the worktree starts from the default branch.
+func (s *Server) addWorktreeBaseMetadata(ctx context.Context, t *task.Task, metadata map[string]string) {
+	if p, err := s.projectRepo.Get(ctx, t.ProjectID); err == nil && p.WorktreeBranchTemplate != "" {
+		metadata["_worktree_branch_template"] = p.WorktreeBranchTemplate
+	}
+
+	if t.WorktreeBaseRef != "" {
+		metadata["_worktree_base_ref"] = t.WorktreeBaseRef
+	}
+
+	if t.WorktreeBaseTaskID == "" {
+		return
+	}
+
+	base, err := s.taskRepo.Get(ctx, t.WorktreeBaseTaskID)
+	if err != nil {
+		base, err = s.taskRepo.GetArchived(ctx, t.WorktreeBaseTaskID)
+	}
+
+	switch {
+	case err != nil:
+		slog.Warn("worktree base task not found", "task_id", t.ID, "base_task_id", t.WorktreeBaseTaskID, "error", err)
+	case base.Metadata["worktree"] == "":
+		slog.Warn("worktree base task has no worktree yet", "task_id", t.ID, "base_task_id", base.ID)
+	default:
+		metadata["_worktree_base_worktree"] = base.Metadata["worktree"]
+		// Lets the agent find the base branch once the worktree is removed.
+		metadata["_worktree_base_task_id"] = base.ID
+	}
+}
+
+// servedProjectsFromRequest returns the projects an agent-manager serves.
+// Multi-project agents list them explicitly; single-project agents use the
+// legacy project_name / work_dir fields. An empty result means a legacy
+// agent that serves every project.
+func servedProjectsFromRequest(req *taskguildv1.AgentManagerSubscribeRequest) []ServedProject {
+	if len(req.GetProjects()) == 0 {
+		if req.GetProjectName() == "" {
+			return nil
+		}
+
+		return []ServedProject{{
+			Name:               req.GetProjectName(),
+			WorkDir:            req.GetWorkDir(),
+			MaxConcurrentTasks: req.GetMaxConcurrentTasks(),
+		}}
+	}
+
+	projects := make([]ServedProject, 0, len(req.GetProjects()))
+	for _, p := range req.GetProjects() {
+		if p.GetProjectName() == "" {
+			continue
+		}
+
+		projects = append(projects, ServedProject{
+			Name:               p.GetProjectName(),
+			WorkDir:            p.GetWorkDir(),
+			MaxConcurrentTasks: p.GetMaxConcurrentTasks(),
+			Labels:             p.GetLabels(),
+		})
+	}
+
+	return projects
+}
@@ -82,3 +82,67 @@ func TestResolveEffort(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveSandbox(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         *workflow.Status
+		permissionMode string
+		wantEnabled    bool
+		wantNetwork    bool
+	}{
+		{
+			name:   "no sandbox configured",
+			status: &workflow.Status{Name: "Develop"},
+		},
+		{
+			name:   "sandbox disabled",
+			status: &workflow.Status{Name: "Develop", Sandbox: &workflow.SandboxConfig{AllowNetwork: true}},
+		},
+		{
+			name:        "sandbox enabled",
+			status:      &workflow.Status{Name: "Develop", Sandbox: &workflow.SandboxConfig{Enabled: true, AllowNetwork: true}},
+			wantEnabled: true,
+			wantNetwork: true,
+		},
+		{
+			name:           "bypassPermissions forces sandbox",
+			status:         &workflow.Status{Name: "Develop"},
+			permissionMode: "bypassPermissions",
+			wantEnabled:    true,
+		},
+		{
+			name:           "bypassPermissions keeps status limits",
+			status:         &workflow.Status{Name: "Develop", Sandbox: &workflow.SandboxConfig{AllowNetwork: true}},
+			permissionMode: "bypassPermissions",
+			wantEnabled:    true,
+			wantNetwork:    true,
+		},
+		{
+			name:           "nil status with bypassPermissions",
+			permissionMode: "bypassPermissions",
+			wantEnabled:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resolveSandbox(tt.status, tt.permissionMode)
+			if !tt.wantEnabled {
+				if got != nil {
+					t.Errorf("resolveSandbox() = %+v, want nil", got)
+				}
+
+				return
+			}
+
+			if got == nil {
+				t.Fatal("resolveSandbox() = nil, want sandbox")
+			}
+
+			if got.AllowNetwork != tt.wantNetwork {
+				t.Errorf("AllowNetwork = %v, want %v", got.AllowNetwork, tt.wantNetwork)
+			}
+		})
+	}
+}
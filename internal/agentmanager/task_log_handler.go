@@ -23,9 +23,20 @@ func (s *Server) ReportTaskLog(ctx context.Context, req *connect.Request[taskgui
 		return nil, err
 	}
 
+	id := req.Msg.GetLogId()
+	if id == "" {
+		id = ulid.Make().String()
+	} else if _, err := ulid.ParseStrict(id); err != nil {
+		return nil, cerr.NewError(cerr.InvalidArgument, "log_id must be a ULID", err).ConnectError()
+	}
+
 	now := time.Now()
+	if req.Msg.GetCreatedAt() != nil {
+		now = req.Msg.GetCreatedAt().AsTime()
+	}
+
 	l := &tasklog.TaskLog{
-		ID:        ulid.Make().String(),
+		ID:        id,
 		ProjectID: t.ProjectID,
 		TaskID:    req.Msg.GetTaskId(),
 		Level:     int32(req.Msg.GetLevel()),
@@ -34,8 +45,17 @@ func (s *Server) ReportTaskLog(ctx context.Context, req *connect.Request[taskgui
 		Metadata:  req.Msg.GetMetadata(),
 		CreatedAt: now,
 	}
+	// The agent redacts before sending; redact again so that secrets sent by
+	// outdated agents or matched by newer patterns are never stored.
+	redactTaskLog(s.redactor(ctx, t.ProjectID), l)
 
 	if err := s.taskLogRepo.Create(ctx, l); err != nil {
+		// Replayed from the agent's outbox after an earlier attempt was
+		// stored: already done.
+		if cerr.IsCode(err, cerr.AlreadyExists) {
+			return connect.NewResponse(&taskguildv1.ReportTaskLogResponse{}), nil
+		}
+
 		return nil, err
 	}
 
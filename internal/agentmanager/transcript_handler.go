@@ -0,0 +1,76 @@
+package agentmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+
+	"github.com/kazz187/taskguild/pkg/cerr"
+	"github.com/kazz187/taskguild/pkg/storage"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func (s *Server) UploadSessionTranscript(ctx context.Context, req *connect.Request[taskguildv1.UploadSessionTranscriptRequest]) (*connect.Response[taskguildv1.UploadSessionTranscriptResponse], error) {
+	if req.Msg.GetTaskId() == "" || req.Msg.GetSessionId() == "" {
+		return nil, cerr.NewError(cerr.InvalidArgument, "task_id and session_id are required", nil).ConnectError()
+	}
+
+	if s.transcriptStore == nil {
+		return nil, cerr.NewError(cerr.Unimplemented, "session transcript storage is not configured", nil).ConnectError()
+	}
+
+	if size := int64(len(req.Msg.GetData())); size > s.maxTranscriptSizeBytes {
+		return nil, cerr.NewError(cerr.ResourceExhausted,
+			fmt.Sprintf("transcript too large: %d bytes (max %d)", size, s.maxTranscriptSizeBytes), nil).ConnectError()
+	}
+
+	t, err := s.taskRepo.Get(ctx, req.Msg.GetTaskId())
+	if err != nil {
+		return nil, cerr.ExtractConnectError(ctx, err)
+	}
+
+	meta, err := s.transcriptStore.Put(ctx, t.ProjectID, t.ID, req.Msg.GetSessionId(), req.Msg.GetData(), req.Msg.GetUncompressedSize())
+	if err != nil {
+		return nil, cerr.NewError(cerr.Internal, "failed to store session transcript", err).ConnectError()
+	}
+
+	slog.Debug("session transcript stored",
+		"task_id", t.ID,
+		"session_id", meta.SessionID,
+		"size_bytes", meta.SizeBytes,
+	)
+
+	return connect.NewResponse(&taskguildv1.UploadSessionTranscriptResponse{}), nil
+}
+
+func (s *Server) DownloadSessionTranscript(ctx context.Context, req *connect.Request[taskguildv1.DownloadSessionTranscriptRequest]) (*connect.Response[taskguildv1.DownloadSessionTranscriptResponse], error) {
+	if req.Msg.GetTaskId() == "" || req.Msg.GetSessionId() == "" {
+		return nil, cerr.NewError(cerr.InvalidArgument, "task_id and session_id are required", nil).ConnectError()
+	}
+
+	if s.transcriptStore == nil {
+		return nil, cerr.NewError(cerr.Unimplemented, "session transcript storage is not configured", nil).ConnectError()
+	}
+
+	t, err := s.taskRepo.Get(ctx, req.Msg.GetTaskId())
+	if err != nil {
+		return nil, cerr.ExtractConnectError(ctx, err)
+	}
+
+	meta, data, err := s.transcriptStore.Get(ctx, t.ProjectID, t.ID, req.Msg.GetSessionId())
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, cerr.NewError(cerr.NotFound, "session transcript not found", nil).ConnectError()
+	}
+
+	if err != nil {
+		return nil, cerr.NewError(cerr.Internal, "failed to read session transcript", err).ConnectError()
+	}
+
+	return connect.NewResponse(&taskguildv1.DownloadSessionTranscriptResponse{
+		Data:             data,
+		UncompressedSize: meta.UncompressedSize,
+	}), nil
+}
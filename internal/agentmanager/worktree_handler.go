@@ -30,7 +30,8 @@ func (s *Server) RequestWorktreeList(ctx context.Context, req *connect.Request[t
 	s.registry.BroadcastCommandToProject(proj.Name, &taskguildv1.AgentCommand{
 		Command: &taskguildv1.AgentCommand_ListWorktrees{
 			ListWorktrees: &taskguildv1.ListWorktreesCommand{
-				RequestId: requestID,
+				RequestId:  requestID,
+				BaseBranch: proj.DefaultBranch,
 			},
 		},
 	})
@@ -57,11 +58,24 @@ func (s *Server) ReportWorktreeList(ctx context.Context, req *connect.Request[ta
 		return nil, cerr.ExtractConnectError(ctx, err)
 	}
 
+	worktrees := req.Msg.GetWorktrees()
+
+	owners, err := s.findWorktreeOwners(ctx, proj.ID)
+	if err != nil {
+		slog.Warn("failed to resolve worktree owners", "project_id", proj.ID, "error", err)
+	} else {
+		annotateWorktrees(worktrees, owners)
+	}
+
 	// Cache the worktree list for this project.
 	s.worktreeMu.Lock()
-	s.worktreeCache[proj.ID] = req.Msg.GetWorktrees()
+	s.worktreeCache[proj.ID] = worktrees
 	s.worktreeMu.Unlock()
 
+	if err == nil {
+		s.applyWorktreePolicy(ctx, proj, worktrees, owners)
+	}
+
 	// Publish event so frontend can pick up the update.
 	s.eventBus.PublishNew(
 		taskguildv1.EventType_EVENT_TYPE_WORKTREE_LIST,
@@ -92,8 +106,15 @@ func (s *Server) GetWorktreeList(ctx context.Context, req *connect.Request[taskg
 	worktrees := s.worktreeCache[req.Msg.GetProjectId()]
 	s.worktreeMu.RUnlock()
 
+	var quota int64
+	if proj, err := s.projectRepo.Get(ctx, req.Msg.GetProjectId()); err == nil && proj.WorktreePolicy != nil {
+		quota = proj.WorktreePolicy.DiskQuotaBytes
+	}
+
 	return connect.NewResponse(&taskguildv1.GetWorktreeListResponse{
-		Worktrees: worktrees,
+		Worktrees:      worktrees,
+		TotalSizeBytes: totalWorktreeSize(worktrees),
+		DiskQuotaBytes: quota,
 	}), nil
 }
 
@@ -0,0 +1,295 @@
+package agentmanager
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/kazz187/taskguild/internal/interaction"
+	"github.com/kazz187/taskguild/internal/project"
+	"github.com/kazz187/taskguild/internal/task"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+// worktreeQuotaWarnInterval throttles the disk quota notification per project.
+const worktreeQuotaWarnInterval = 24 * time.Hour
+
+// worktreeOwner is the task that owns a worktree.
+type worktreeOwner struct {
+	task     *task.Task
+	archived bool
+	terminal bool
+}
+
+// RunWorktreePolicies periodically requests the worktree list of every
+// project with a worktree policy. The policy itself is applied when the
+// agent reports the list (see ReportWorktreeList).
+func (s *Server) RunWorktreePolicies(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			projects, err := s.projectRepo.ListAll(ctx)
+			if err != nil {
+				slog.Error("worktree policy: failed to list projects", "error", err)
+				continue
+			}
+
+			for _, p := range projects {
+				if p.WorktreePolicy == nil {
+					continue
+				}
+
+				s.registry.BroadcastCommandToProject(p.Name, &taskguildv1.AgentCommand{
+					Command: &taskguildv1.AgentCommand_ListWorktrees{
+						ListWorktrees: &taskguildv1.ListWorktreesCommand{
+							RequestId:  ulid.Make().String(),
+							BaseBranch: p.DefaultBranch,
+						},
+					},
+				})
+			}
+		}
+	}
+}
+
+// findWorktreeOwners maps worktree names to the task using them. Active
+// tasks win over archived ones; otherwise the most recently updated task wins.
+func (s *Server) findWorktreeOwners(ctx context.Context, projectID string) (map[string]worktreeOwner, error) {
+	tasks, _, err := s.taskRepo.List(ctx, projectID, "", "", 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("list tasks: %w", err)
+	}
+
+	archived, _, err := s.taskRepo.ListArchived(ctx, projectID, "", 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("list archived tasks: %w", err)
+	}
+
+	terminal := make(map[string]map[string]bool) // workflow_id -> status -> terminal
+
+	isTerminal := func(t *task.Task) bool {
+		statuses, ok := terminal[t.WorkflowID]
+		if !ok {
+			statuses = make(map[string]bool)
+
+			if wf, err := s.workflowRepo.Get(ctx, t.WorkflowID); err == nil {
+				for _, st := range wf.Statuses {
+					statuses[st.Name] = st.IsTerminal
+				}
+			}
+
+			terminal[t.WorkflowID] = statuses
+		}
+
+		return statuses[t.StatusID]
+	}
+
+	owners := make(map[string]worktreeOwner)
+
+	add := func(t *task.Task, isArchived bool) {
+		name := t.Metadata["worktree"]
+		if name == "" {
+			return
+		}
+
+		if cur, ok := owners[name]; ok {
+			if cur.archived != isArchived {
+				if !isArchived {
+					owners[name] = worktreeOwner{task: t, terminal: isTerminal(t)}
+				}
+
+				return
+			}
+
+			if !t.UpdatedAt.After(cur.task.UpdatedAt) {
+				return
+			}
+		}
+
+		owners[name] = worktreeOwner{task: t, archived: isArchived, terminal: isTerminal(t)}
+	}
+
+	for _, t := range tasks {
+		add(t, false)
+	}
+
+	for _, t := range archived {
+		add(t, true)
+	}
+
+	return owners, nil
+}
+
+// annotateWorktrees fills the owning task fields of the reported worktrees.
+func annotateWorktrees(worktrees []*taskguildv1.WorktreeInfo, owners map[string]worktreeOwner) {
+	for _, wt := range worktrees {
+		owner, ok := owners[wt.GetName()]
+		if !ok {
+			continue
+		}
+
+		wt.TaskId = owner.task.ID
+		wt.TaskTitle = owner.task.Title
+		wt.TaskStatus = owner.task.StatusID
+		wt.TaskArchived = owner.archived
+	}
+}
+
+// worktreesToDelete returns the names of the worktrees policy allows to be
+// removed. Worktrees with uncommitted changes or whose task is still queued
+// or running are always kept. Archived worktrees may be unmerged; their
+// branches are kept by applyWorktreePolicy.
+func worktreesToDelete(policy *project.WorktreePolicy, worktrees []*taskguildv1.WorktreeInfo, owners map[string]worktreeOwner, now time.Time) []string {
+	if policy == nil {
+		return nil
+	}
+
+	var names []string
+
+	for _, wt := range worktrees {
+		if wt.GetHasChanges() {
+			continue
+		}
+
+		owner, hasOwner := owners[wt.GetName()]
+		if hasOwner && owner.task.AssignmentStatus != task.AssignmentStatusUnassigned {
+			continue
+		}
+
+		if policy.DeleteArchived && hasOwner && owner.archived {
+			names = append(names, wt.GetName())
+			continue
+		}
+
+		if policy.DeleteMergedAfterDays > 0 && wt.GetMerged() && wt.GetLastModifiedAt() != nil {
+			finished := !hasOwner || owner.archived || owner.terminal
+			maxAge := time.Duration(policy.DeleteMergedAfterDays) * 24 * time.Hour
+
+			if finished && now.Sub(wt.GetLastModifiedAt().AsTime()) > maxAge {
+				names = append(names, wt.GetName())
+			}
+		}
+	}
+
+	return names
+}
+
+// applyWorktreePolicy deletes worktrees according to the project's policy
+// and warns when their total size exceeds the disk quota.
+func (s *Server) applyWorktreePolicy(ctx context.Context, proj *project.Project, worktrees []*taskguildv1.WorktreeInfo, owners map[string]worktreeOwner) {
+	policy := proj.WorktreePolicy
+	if policy == nil {
+		return
+	}
+
+	merged := make(map[string]bool, len(worktrees))
+	for _, wt := range worktrees {
+		merged[wt.GetName()] = wt.GetMerged()
+	}
+
+	for _, name := range worktreesToDelete(policy, worktrees, owners, time.Now()) {
+		requestID := ulid.Make().String()
+
+		s.registry.BroadcastCommandToProject(proj.Name, &taskguildv1.AgentCommand{
+			Command: &taskguildv1.AgentCommand_DeleteWorktree{
+				DeleteWorktree: &taskguildv1.DeleteWorktreeCommand{
+					RequestId:    requestID,
+					WorktreeName: name,
+					// Unmerged work stays reachable through its branch.
+					KeepBranch: !merged[name],
+				},
+			},
+		})
+
+		slog.Info("worktree policy: delete requested",
+			"project_id", proj.ID,
+			"worktree_name", name,
+			"request_id", requestID,
+			"keep_branch", !merged[name],
+		)
+	}
+
+	if policy.DiskQuotaBytes <= 0 {
+		return
+	}
+
+	total := totalWorktreeSize(worktrees)
+	if total <= policy.DiskQuotaBytes {
+		return
+	}
+
+	slog.Warn("worktree disk usage exceeds quota",
+		"project_id", proj.ID,
+		"total_bytes", total,
+		"quota_bytes", policy.DiskQuotaBytes,
+	)
+
+	s.worktreeMu.Lock()
+	last := s.worktreeQuotaWarnedAt[proj.ID]
+	throttled := time.Since(last) < worktreeQuotaWarnInterval
+
+	if !throttled {
+		s.worktreeQuotaWarnedAt[proj.ID] = time.Now()
+	}
+	s.worktreeMu.Unlock()
+
+	if throttled {
+		return
+	}
+
+	now := time.Now()
+	inter := &interaction.Interaction{
+		ID:          ulid.Make().String(),
+		ProjectID:   proj.ID,
+		Type:        interaction.TypeNotification,
+		Status:      interaction.StatusResponded,
+		Title:       fmt.Sprintf("Worktree disk usage %s exceeds quota %s", formatBytes(total), formatBytes(policy.DiskQuotaBytes)),
+		CreatedAt:   now,
+		RespondedAt: &now,
+	}
+
+	if err := s.interactionRepo.Create(ctx, inter); err != nil {
+		slog.Error("worktree policy: failed to create quota notification", "project_id", proj.ID, "error", err)
+		return
+	}
+
+	s.eventBus.PublishNew(
+		taskguildv1.EventType_EVENT_TYPE_INTERACTION_CREATED,
+		inter.ID,
+		interaction.MarshalInteractionPayload(interaction.ToProto(inter)),
+		map[string]string{"task_id": "", "project_id": proj.ID},
+	)
+}
+
+func totalWorktreeSize(worktrees []*taskguildv1.WorktreeInfo) int64 {
+	var total int64
+	for _, wt := range worktrees {
+		total += wt.GetSizeBytes()
+	}
+
+	return total
+}
+
+// formatBytes renders n in binary units (e.g. "1.5 GiB").
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,101 @@
+package agentmanager
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kazz187/taskguild/internal/project"
+	"github.com/kazz187/taskguild/internal/task"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func TestWorktreesToDelete(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	old := timestamppb.New(now.Add(-10 * 24 * time.Hour))
+	recent := timestamppb.New(now.Add(-time.Hour))
+
+	owner := func(assignment task.AssignmentStatus, archived, terminal bool) worktreeOwner {
+		return worktreeOwner{task: &task.Task{AssignmentStatus: assignment}, archived: archived, terminal: terminal}
+	}
+
+	worktrees := []*taskguildv1.WorktreeInfo{
+		{Name: "archived"},
+		{Name: "archived-dirty", HasChanges: true},
+		{Name: "archived-running"},
+		{Name: "merged-done", Merged: true, LastModifiedAt: old},
+		{Name: "merged-recent", Merged: true, LastModifiedAt: recent},
+		{Name: "merged-active", Merged: true, LastModifiedAt: old},
+		{Name: "merged-orphan", Merged: true, LastModifiedAt: old},
+		{Name: "unmerged-done", LastModifiedAt: old},
+	}
+
+	owners := map[string]worktreeOwner{
+		"archived":         owner(task.AssignmentStatusUnassigned, true, false),
+		"archived-dirty":   owner(task.AssignmentStatusUnassigned, true, false),
+		"archived-running": owner(task.AssignmentStatusAssigned, true, false),
+		"merged-done":      owner(task.AssignmentStatusUnassigned, false, true),
+		"merged-recent":    owner(task.AssignmentStatusUnassigned, false, true),
+		"merged-active":    owner(task.AssignmentStatusUnassigned, false, false),
+		"unmerged-done":    owner(task.AssignmentStatusUnassigned, false, true),
+	}
+
+	tests := []struct {
+		name   string
+		policy *project.WorktreePolicy
+		want   []string
+	}{
+		{"no policy", nil, nil},
+		{"archived", &project.WorktreePolicy{DeleteArchived: true}, []string{"archived"}},
+		{"merged", &project.WorktreePolicy{DeleteMergedAfterDays: 7}, []string{"merged-done", "merged-orphan"}},
+		{"both", &project.WorktreePolicy{DeleteArchived: true, DeleteMergedAfterDays: 7}, []string{"archived", "merged-done", "merged-orphan"}},
+	}
+	for _, tt := range tests {
+		got := worktreesToDelete(tt.policy, worktrees, owners, now)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{512, "512 B"},
+		{1536, "1.5 KiB"},
+		{5 << 30, "5.0 GiB"},
+	}
+	for _, tt := range tests {
+		if got := formatBytes(tt.n); got != tt.want {
+			t.Errorf("formatBytes(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestApplyWorktreePolicy_KeepsUnmergedBranches(t *testing.T) {
+	s := &Server{registry: NewRegistry()}
+	ch := s.registry.Register("am-1", 1, "proj", "")
+
+	proj := &project.Project{ID: "p", Name: "proj", WorktreePolicy: &project.WorktreePolicy{DeleteArchived: true}}
+	worktrees := []*taskguildv1.WorktreeInfo{{Name: "unmerged"}, {Name: "merged", Merged: true}}
+	owners := map[string]worktreeOwner{
+		"unmerged": {task: &task.Task{AssignmentStatus: task.AssignmentStatusUnassigned}, archived: true},
+		"merged":   {task: &task.Task{AssignmentStatus: task.AssignmentStatusUnassigned}, archived: true},
+	}
+
+	s.applyWorktreePolicy(t.Context(), proj, worktrees, owners)
+
+	keep := make(map[string]bool)
+	for range worktrees {
+		cmd := (<-ch).GetDeleteWorktree()
+		keep[cmd.GetWorktreeName()] = cmd.GetKeepBranch()
+	}
+
+	if !keep["unmerged"] || keep["merged"] {
+		t.Errorf("expected only the unmerged branch to be kept, got %v", keep)
+	}
+}
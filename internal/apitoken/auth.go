@@ -0,0 +1,113 @@
+package apitoken
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/kazz187/taskguild/pkg/storage"
+)
+
+// cacheTTL bounds how long a token read from the repository is reused.
+// Tokens revoked through the RPC are dropped immediately; changes made by the
+// `token` subcommand against the same storage take effect within cacheTTL.
+const cacheTTL = 30 * time.Second
+
+// legacyToken represents the shared TASKGUILD_API_KEY, which keeps full
+// access so existing deployments continue to work.
+var legacyToken = &Token{Name: "TASKGUILD_API_KEY", Scopes: []Scope{ScopeAdmin}}
+
+type cachedToken struct {
+	token     *Token
+	fetchedAt time.Time
+}
+
+// Authenticator resolves the secret presented by a client to its token.
+type Authenticator struct {
+	repo      Repository
+	legacyKey string
+
+	mu    sync.Mutex
+	cache map[string]cachedToken // token ID -> token
+}
+
+func NewAuthenticator(repo Repository, legacyKey string) *Authenticator {
+	return &Authenticator{
+		repo:      repo,
+		legacyKey: legacyKey,
+		cache:     make(map[string]cachedToken),
+	}
+}
+
+// Authenticate returns the active token matching secret, or nil when the
+// secret is unknown, revoked or expired.
+func (a *Authenticator) Authenticate(ctx context.Context, secret string) *Token {
+	if secret == "" {
+		return nil
+	}
+
+	if a.legacyKey != "" && subtle.ConstantTimeCompare([]byte(secret), []byte(a.legacyKey)) == 1 {
+		return legacyToken
+	}
+
+	id, ok := tokenIDFromSecret(secret)
+	if !ok {
+		return nil
+	}
+
+	t := a.lookup(ctx, id)
+	if t == nil || !t.secretMatches(secret) || !t.Active(time.Now()) {
+		return nil
+	}
+
+	return t
+}
+
+func (a *Authenticator) lookup(ctx context.Context, id string) *Token {
+	a.mu.Lock()
+	c, ok := a.cache[id]
+	a.mu.Unlock()
+
+	if ok && time.Since(c.fetchedAt) < cacheTTL {
+		return c.token
+	}
+
+	t, err := a.repo.Get(ctx, id)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			slog.Error("failed to load api token", "token_id", id, "error", err)
+			return nil
+		}
+
+		t = nil
+	}
+
+	a.mu.Lock()
+	a.cache[id] = cachedToken{token: t, fetchedAt: time.Now()}
+	a.mu.Unlock()
+
+	return t
+}
+
+// Invalidate drops the cached copy of token id.
+func (a *Authenticator) Invalidate(id string) {
+	a.mu.Lock()
+	delete(a.cache, id)
+	a.mu.Unlock()
+}
+
+type contextKey struct{}
+
+// WithToken returns a copy of ctx carrying the authenticated token.
+func WithToken(ctx context.Context, t *Token) context.Context {
+	return context.WithValue(ctx, contextKey{}, t)
+}
+
+// TokenFromContext returns the token stored by WithToken, or nil.
+func TokenFromContext(ctx context.Context) *Token {
+	t, _ := ctx.Value(contextKey{}).(*Token)
+	return t
+}
@@ -0,0 +1,148 @@
+package apitoken
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Scope is a permission granted to a token. See allowedScopes for which
+// RPCs each scope may call.
+type Scope string
+
+const (
+	ScopeReadOnly              Scope = "read_only"
+	ScopeRespondToInteractions Scope = "respond_to_interactions"
+	ScopeAgentManager          Scope = "agent_manager"
+	ScopeAdmin                 Scope = "admin"
+)
+
+// Scopes lists every valid scope.
+var Scopes = []Scope{ScopeReadOnly, ScopeRespondToInteractions, ScopeAgentManager, ScopeAdmin}
+
+// secretPrefix marks TaskGuild token secrets. The token ID follows it so that
+// a secret can be looked up without scanning every token.
+const secretPrefix = "tgk_"
+
+type Token struct {
+	ID         string     `yaml:"id"`
+	Name       string     `yaml:"name"`
+	SecretHash string     `yaml:"secret_hash"` // hex SHA-256 of the secret
+	Scopes     []Scope    `yaml:"scopes"`
+	ProjectIDs []string   `yaml:"project_ids,omitempty"` // empty = all projects
+	ExpiresAt  *time.Time `yaml:"expires_at,omitempty"`
+	RevokedAt  *time.Time `yaml:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `yaml:"created_at"`
+}
+
+// Active reports whether the token is neither revoked nor expired at now.
+func (t *Token) Active(now time.Time) bool {
+	if t.RevokedAt != nil {
+		return false
+	}
+
+	return t.ExpiresAt == nil || now.Before(*t.ExpiresAt)
+}
+
+func (t *Token) HasScope(s Scope) bool {
+	return slices.Contains(t.Scopes, s)
+}
+
+// AllowsProject reports whether the token may access projectID.
+func (t *Token) AllowsProject(projectID string) bool {
+	return len(t.ProjectIDs) == 0 || slices.Contains(t.ProjectIDs, projectID)
+}
+
+// ParseScope converts a scope name, accepting "-" in place of "_"
+// (e.g. "read-only").
+func ParseScope(s string) (Scope, error) {
+	scope := Scope(strings.ReplaceAll(strings.TrimSpace(s), "-", "_"))
+	if !slices.Contains(Scopes, scope) {
+		return "", fmt.Errorf("unknown scope %q", s)
+	}
+
+	return scope, nil
+}
+
+// newSecret returns a random secret for token id and its hash.
+func newSecret(id string) (secret, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("generate token secret: %w", err)
+	}
+
+	secret = secretPrefix + id + "_" + base64.RawURLEncoding.EncodeToString(b)
+
+	return secret, hashSecret(secret), nil
+}
+
+// tokenIDFromSecret extracts the token ID embedded in secret.
+func tokenIDFromSecret(secret string) (string, bool) {
+	rest, ok := strings.CutPrefix(secret, secretPrefix)
+	if !ok {
+		return "", false
+	}
+
+	id, _, ok := strings.Cut(rest, "_")
+
+	return id, ok && id != ""
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// secretMatches compares secret against the stored hash in constant time.
+func (t *Token) secretMatches(secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(t.SecretHash)) == 1
+}
+
+// NewToken builds a token and returns it with its secret. The secret is not
+// stored; only its hash is.
+func NewToken(name string, scopes []Scope, projectIDs []string, expiresAt *time.Time, now time.Time) (*Token, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", errors.New("name is required")
+	}
+
+	if len(scopes) == 0 {
+		return nil, "", errors.New("at least one scope is required")
+	}
+
+	for _, s := range scopes {
+		if !slices.Contains(Scopes, s) {
+			return nil, "", fmt.Errorf("unknown scope %q", s)
+		}
+	}
+
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, "", errors.New("expires_at must be in the future")
+	}
+
+	id := ulid.Make().String()
+
+	secret, hash, err := newSecret(id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &Token{
+		ID:         id,
+		Name:       name,
+		SecretHash: hash,
+		Scopes:     slices.Compact(slices.Sorted(slices.Values(scopes))),
+		ProjectIDs: projectIDs,
+		ExpiresAt:  expiresAt,
+		CreatedAt:  now,
+	}, secret, nil
+}
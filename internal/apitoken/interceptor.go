@@ -0,0 +1,399 @@
+package apitoken
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/kazz187/taskguild/pkg/cerr"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+// ProjectResolver maps the entities a request refers to onto their project,
+// so that project-restricted tokens can be enforced.
+type ProjectResolver interface {
+	ProjectIDByName(ctx context.Context, name string) (string, error)
+	ProjectIDByTask(ctx context.Context, taskID string) (string, error)
+	ProjectIDByInteraction(ctx context.Context, interactionID string) (string, error)
+	ProjectIDByWorkflow(ctx context.Context, workflowID string) (string, error)
+	ProjectIDBySchedule(ctx context.Context, scheduleID string) (string, error)
+	ProjectIDByAgent(ctx context.Context, agentID string) (string, error)
+	ProjectIDBySkill(ctx context.Context, skillID string) (string, error)
+	ProjectIDByScript(ctx context.Context, scriptID string) (string, error)
+	ProjectIDByScriptExecution(ctx context.Context, requestID string) (string, error)
+	ProjectIDBySingleCommandPermission(ctx context.Context, permissionID string) (string, error)
+}
+
+// filteredProcedures may be called by a project-restricted token without
+// naming a project; their responses (or streamed messages) are narrowed to
+// the token's projects instead.
+var filteredProcedures = []string{
+	"/taskguild.v1.ProjectService/ListProjects",
+	"/taskguild.v1.TaskService/ListTasks",
+	"/taskguild.v1.TaskService/ListArchivedTasks",
+	"/taskguild.v1.InteractionService/ListInteractions",
+	"/taskguild.v1.InteractionService/SubscribeInteractions",
+	"/taskguild.v1.AuditService/ListAuditEvents",
+	"/taskguild.v1.EventService/SubscribeEvents",
+}
+
+// globalProcedures neither read nor change project data and may be called
+// by a project-restricted token without naming a project. Every other
+// request that names no project is rejected for such tokens.
+var globalProcedures = []string{
+	"/taskguild.v1.PushNotificationService/GetVapidPublicKey",
+	"/taskguild.v1.PushNotificationService/SendTestNotification",
+	"/taskguild.v1.TemplateService/GetTemplate",
+	"/taskguild.v1.TemplateService/ListTemplates",
+	"/taskguild.v1.AgentManagerService/Heartbeat",
+}
+
+// Authorizer is a connect interceptor that checks the token stored in the
+// request context (see WithToken) against the scope and project
+// restrictions of the called procedure.
+type Authorizer struct {
+	resolver ProjectResolver
+}
+
+func NewAuthorizer(resolver ProjectResolver) *Authorizer {
+	return &Authorizer{resolver: resolver}
+}
+
+var _ connect.Interceptor = (*Authorizer)(nil)
+
+func (a *Authorizer) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		procedure := req.Spec().Procedure
+		if slices.Contains(unauthenticatedProcedures, procedure) {
+			return next(ctx, req)
+		}
+
+		t, err := authorizeProcedure(ctx, procedure)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := a.authorizeProjects(ctx, t, procedure, req.Any()); err != nil {
+			return nil, err
+		}
+
+		res, err := next(ctx, req)
+		if err == nil && len(t.ProjectIDs) > 0 {
+			a.filterResponse(ctx, t, res.Any())
+		}
+
+		return res, err
+	}
+}
+
+// filterResponse drops the entries of a list response that belong to
+// projects outside the token's restriction.
+func (a *Authorizer) filterResponse(ctx context.Context, t *Token, msg any) {
+	switch list := msg.(type) {
+	case *taskguildv1.ListProjectsResponse:
+		list.Projects = slices.DeleteFunc(list.Projects, func(p *taskguildv1.Project) bool {
+			return !t.AllowsProject(p.GetId())
+		})
+	case *taskguildv1.ListTasksResponse:
+		list.Tasks = slices.DeleteFunc(list.Tasks, func(task *taskguildv1.Task) bool {
+			return !t.AllowsProject(task.GetProjectId())
+		})
+	case *taskguildv1.ListArchivedTasksResponse:
+		list.Tasks = slices.DeleteFunc(list.Tasks, func(task *taskguildv1.Task) bool {
+			return !t.AllowsProject(task.GetProjectId())
+		})
+	case *taskguildv1.ListInteractionsResponse:
+		allowed := a.taskFilter(ctx, t)
+		list.Interactions = slices.DeleteFunc(list.Interactions, func(i *taskguildv1.Interaction) bool {
+			return !allowed(i.GetTaskId())
+		})
+	case *taskguildv1.ListAuditEventsResponse:
+		list.Events = slices.DeleteFunc(list.Events, func(e *taskguildv1.AuditEvent) bool {
+			return !t.AllowsProject(e.GetProjectId())
+		})
+	}
+}
+
+// taskFilter returns a function that reports whether a task belongs to one
+// of the token's projects, resolving each task once.
+func (a *Authorizer) taskFilter(ctx context.Context, t *Token) func(taskID string) bool {
+	seen := make(map[string]bool)
+
+	return func(taskID string) bool {
+		allowed, ok := seen[taskID]
+		if !ok {
+			projectID, err := a.resolver.ProjectIDByTask(ctx, taskID)
+			allowed = err == nil && t.AllowsProject(projectID)
+			seen[taskID] = allowed
+		}
+
+		return allowed
+	}
+}
+
+func (a *Authorizer) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (a *Authorizer) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		procedure := conn.Spec().Procedure
+		if slices.Contains(unauthenticatedProcedures, procedure) {
+			return next(ctx, conn)
+		}
+
+		t, err := authorizeProcedure(ctx, procedure)
+		if err != nil {
+			return err
+		}
+
+		if len(t.ProjectIDs) == 0 {
+			return next(ctx, conn)
+		}
+
+		return next(ctx, &authorizedConn{
+			StreamingHandlerConn: conn,
+			ctx:                  ctx,
+			authorizer:           a,
+			token:                t,
+			taskAllowed:          a.taskFilter(ctx, t),
+		})
+	}
+}
+
+// authorizedConn checks every received message against the token's project
+// restriction and drops sent events of other projects.
+type authorizedConn struct {
+	connect.StreamingHandlerConn
+
+	ctx         context.Context
+	authorizer  *Authorizer
+	token       *Token
+	taskAllowed func(taskID string) bool
+}
+
+func (c *authorizedConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+
+	return c.authorizer.authorizeProjects(c.ctx, c.token, c.Spec().Procedure, msg)
+}
+
+func (c *authorizedConn) Send(msg any) error {
+	switch ev := msg.(type) {
+	case *taskguildv1.Event:
+		if !c.token.AllowsProject(ev.GetMetadata()["project_id"]) {
+			return nil
+		}
+	case *taskguildv1.InteractionEvent:
+		if !c.taskAllowed(ev.GetInteraction().GetTaskId()) {
+			return nil
+		}
+	}
+
+	return c.StreamingHandlerConn.Send(msg)
+}
+
+func authorizeProcedure(ctx context.Context, procedure string) (*Token, error) {
+	t := TokenFromContext(ctx)
+	if t == nil {
+		return nil, cerr.NewError(cerr.Unauthenticated, "unauthorized", nil).ConnectError()
+	}
+
+	if !t.Allows(procedure) {
+		return nil, cerr.NewError(cerr.PermissionDenied,
+			fmt.Sprintf("api token %q is not allowed to call %s", t.Name, procedure), nil).ConnectError()
+	}
+
+	return t, nil
+}
+
+// authorizeProjects rejects msg when it refers to a project outside the
+// token's restriction. Requests that refer to no project are rejected too,
+// except for filtered and global procedures.
+func (a *Authorizer) authorizeProjects(ctx context.Context, t *Token, procedure string, msg any) error {
+	if len(t.ProjectIDs) == 0 {
+		return nil
+	}
+
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return cerr.NewError(cerr.PermissionDenied,
+			fmt.Sprintf("api token %q is not allowed to call %s", t.Name, procedure), nil).ConnectError()
+	}
+
+	service, _, _ := strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+
+	refs := requestRefs(service, m.ProtoReflect())
+	if len(refs) == 0 && !slices.Contains(filteredProcedures, procedure) && !slices.Contains(globalProcedures, procedure) {
+		return cerr.NewError(cerr.PermissionDenied,
+			fmt.Sprintf("api token %q is restricted to projects and %s names none", t.Name, procedure), nil).ConnectError()
+	}
+
+	for _, ref := range refs {
+		projectID, err := a.resolve(ctx, ref)
+		if err != nil || !t.AllowsProject(projectID) {
+			return cerr.NewError(cerr.PermissionDenied,
+				fmt.Sprintf("api token %q is not allowed to access this project", t.Name), err).ConnectError()
+		}
+	}
+
+	return nil
+}
+
+func (a *Authorizer) resolve(ctx context.Context, r ref) (string, error) {
+	return resolveRef(ctx, a.resolver, r)
+}
+
+// RequestProjectID returns the project msg refers to, or "" when it names no
+// project or project entity or the reference cannot be resolved.
+func RequestProjectID(ctx context.Context, resolver ProjectResolver, procedure string, msg any) string {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return ""
+	}
+
+	service, _, _ := strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+
+	for _, r := range requestRefs(service, m.ProtoReflect()) {
+		if id, err := resolveRef(ctx, resolver, r); err == nil && id != "" {
+			return id
+		}
+	}
+
+	return ""
+}
+
+func resolveRef(ctx context.Context, resolver ProjectResolver, r ref) (string, error) {
+	switch r.kind {
+	case refProjectName:
+		return resolver.ProjectIDByName(ctx, r.value)
+	case refTask:
+		return resolver.ProjectIDByTask(ctx, r.value)
+	case refInteraction:
+		return resolver.ProjectIDByInteraction(ctx, r.value)
+	case refWorkflow:
+		return resolver.ProjectIDByWorkflow(ctx, r.value)
+	case refSchedule:
+		return resolver.ProjectIDBySchedule(ctx, r.value)
+	case refAgent:
+		return resolver.ProjectIDByAgent(ctx, r.value)
+	case refSkill:
+		return resolver.ProjectIDBySkill(ctx, r.value)
+	case refScript:
+		return resolver.ProjectIDByScript(ctx, r.value)
+	case refScriptExecution:
+		return resolver.ProjectIDByScriptExecution(ctx, r.value)
+	case refSingleCommandPermission:
+		return resolver.ProjectIDBySingleCommandPermission(ctx, r.value)
+	default:
+		return r.value, nil
+	}
+}
+
+type refKind int
+
+const (
+	refProjectID refKind = iota
+	refProjectName
+	refTask
+	refInteraction
+	refWorkflow
+	refSchedule
+	refAgent
+	refSkill
+	refScript
+	refScriptExecution
+	refSingleCommandPermission
+)
+
+type ref struct {
+	kind  refKind
+	value string
+}
+
+// idKinds maps the generic "id" field of a service's requests to the entity
+// it identifies. Services without an entry have no project-scoped ids
+// (templates and API tokens are global).
+var idKinds = map[string]refKind{
+	"taskguild.v1.ProjectService":                 refProjectID,
+	"taskguild.v1.TaskService":                    refTask,
+	"taskguild.v1.InteractionService":             refInteraction,
+	"taskguild.v1.WorkflowService":                refWorkflow,
+	"taskguild.v1.ScheduleService":                refSchedule,
+	"taskguild.v1.AgentService":                   refAgent,
+	"taskguild.v1.SkillService":                   refSkill,
+	"taskguild.v1.ScriptService":                  refScript,
+	"taskguild.v1.SingleCommandPermissionService": refSingleCommandPermission,
+}
+
+// fieldKinds maps request fields that name a project entity, in any
+// service, to the entity they identify.
+var fieldKinds = map[protoreflect.Name]refKind{
+	"project_id":            refProjectID,
+	"project_name":          refProjectName,
+	"task_id":               refTask,
+	"worktree_base_task_id": refTask,
+	"interaction_id":        refInteraction,
+	"workflow_id":           refWorkflow,
+	"skill_id":              refSkill,
+	"script_id":             refScript,
+}
+
+// requestRefs returns the non-empty project, task and interaction references
+// among the top-level fields of m, including project references inside
+// repeated messages (e.g. the served projects of an agent manager).
+func requestRefs(service string, m protoreflect.Message) []ref {
+	var refs []ref
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Kind() == protoreflect.MessageKind:
+			list := v.List()
+			for i := range list.Len() {
+				list.Get(i).Message().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+					if r, ok := fieldRef("", fd, v); ok && (r.kind == refProjectID || r.kind == refProjectName) {
+						refs = append(refs, r)
+					}
+
+					return true
+				})
+			}
+		default:
+			if r, ok := fieldRef(service, fd, v); ok {
+				refs = append(refs, r)
+			}
+		}
+
+		return true
+	})
+
+	return refs
+}
+
+func fieldRef(service string, fd protoreflect.FieldDescriptor, v protoreflect.Value) (ref, bool) {
+	if fd.IsList() || fd.IsMap() || fd.Kind() != protoreflect.StringKind || v.String() == "" {
+		return ref{}, false
+	}
+
+	kind, ok := fieldKinds[fd.Name()]
+
+	switch {
+	case fd.Name() == "id":
+		kind, ok = idKinds[service]
+	case fd.Name() == "request_id" && service == "taskguild.v1.ScriptService":
+		kind, ok = refScriptExecution, true
+	}
+
+	if !ok {
+		return ref{}, false
+	}
+
+	return ref{kind: kind, value: v.String()}, true
+}
@@ -0,0 +1,191 @@
+package apitoken
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+// fakeResolver resolves every entity kind from one id → project map.
+type fakeResolver map[string]string
+
+func (r fakeResolver) lookup(id string) (string, error) {
+	if p, ok := r[id]; ok {
+		return p, nil
+	}
+
+	return "", errors.New("not found")
+}
+
+func (r fakeResolver) ProjectIDByName(_ context.Context, name string) (string, error) {
+	return r.lookup(name)
+}
+
+func (r fakeResolver) ProjectIDByTask(_ context.Context, id string) (string, error) {
+	return r.lookup(id)
+}
+
+func (r fakeResolver) ProjectIDByInteraction(_ context.Context, id string) (string, error) {
+	return r.lookup(id)
+}
+
+func (r fakeResolver) ProjectIDByWorkflow(_ context.Context, id string) (string, error) {
+	return r.lookup(id)
+}
+
+func (r fakeResolver) ProjectIDBySchedule(_ context.Context, id string) (string, error) {
+	return r.lookup(id)
+}
+
+func (r fakeResolver) ProjectIDByAgent(_ context.Context, id string) (string, error) {
+	return r.lookup(id)
+}
+
+func (r fakeResolver) ProjectIDBySkill(_ context.Context, id string) (string, error) {
+	return r.lookup(id)
+}
+
+func (r fakeResolver) ProjectIDByScript(_ context.Context, id string) (string, error) {
+	return r.lookup(id)
+}
+
+func (r fakeResolver) ProjectIDByScriptExecution(_ context.Context, id string) (string, error) {
+	return r.lookup(id)
+}
+
+func (r fakeResolver) ProjectIDBySingleCommandPermission(_ context.Context, id string) (string, error) {
+	return r.lookup(id)
+}
+
+func newTestAuthorizer() *Authorizer {
+	return NewAuthorizer(fakeResolver{
+		"T1": "P1", "T2": "P2",
+		"W2": "P2", "S2": "P2", "A2": "P2", "K2": "P2", "X2": "P2", "R2": "P2", "C2": "P2",
+		"W1": "P1",
+	})
+}
+
+func TestAuthorizeProjects(t *testing.T) {
+	a := newTestAuthorizer()
+	tok := &Token{Name: "ci", Scopes: []Scope{ScopeAdmin}, ProjectIDs: []string{"P1"}}
+
+	tests := []struct {
+		name      string
+		procedure string
+		msg       any
+		want      bool
+	}{
+		{"own task", "/taskguild.v1.TaskService/GetTask", &taskguildv1.GetTaskRequest{Id: "T1"}, true},
+		{"other task", "/taskguild.v1.TaskService/GetTask", &taskguildv1.GetTaskRequest{Id: "T2"}, false},
+		{"unknown task", "/taskguild.v1.TaskService/GetTask", &taskguildv1.GetTaskRequest{Id: "T9"}, false},
+		{"list tasks without project", "/taskguild.v1.TaskService/ListTasks", &taskguildv1.ListTasksRequest{}, true},
+		{"list interactions without project", "/taskguild.v1.InteractionService/ListInteractions", &taskguildv1.ListInteractionsRequest{}, true},
+		{"subscribe events without project", "/taskguild.v1.EventService/SubscribeEvents", &taskguildv1.SubscribeEventsRequest{}, true},
+		{"other workflow", "/taskguild.v1.WorkflowService/GetWorkflow", &taskguildv1.GetWorkflowRequest{Id: "W2"}, false},
+		{"own workflow", "/taskguild.v1.WorkflowService/DeleteWorkflow", &taskguildv1.DeleteWorkflowRequest{Id: "W1"}, true},
+		{"other schedule", "/taskguild.v1.ScheduleService/DeleteSchedule", &taskguildv1.DeleteScheduleRequest{Id: "S2"}, false},
+		{"other agent", "/taskguild.v1.AgentService/GetAgent", &taskguildv1.GetAgentRequest{Id: "A2"}, false},
+		{"other skill", "/taskguild.v1.SkillService/DeleteSkill", &taskguildv1.DeleteSkillRequest{Id: "K2"}, false},
+		{"other script", "/taskguild.v1.ScriptService/GetScript", &taskguildv1.GetScriptRequest{Id: "X2"}, false},
+		{"other script execution", "/taskguild.v1.ScriptService/StopScriptExecution", &taskguildv1.StopScriptExecutionRequest{RequestId: "R2"}, false},
+		{"other command rule", "/taskguild.v1.SingleCommandPermissionService/DeleteSingleCommandPermission", &taskguildv1.DeleteSingleCommandPermissionRequest{Id: "C2"}, false},
+		{"own project with other workflow", "/taskguild.v1.TaskService/CreateTask", &taskguildv1.CreateTaskRequest{ProjectId: "P1", WorkflowId: "W2"}, false},
+		{"base task of other project", "/taskguild.v1.TaskService/UpdateTask", &taskguildv1.UpdateTaskRequest{Id: "T1", WorktreeBaseTaskId: proto.String("T2")}, false},
+		{"no project", "/taskguild.v1.ProjectService/CreateProject", &taskguildv1.CreateProjectRequest{Name: "new"}, false},
+		{"agent managers", "/taskguild.v1.AgentManagerService/ListAgentManagers", &taskguildv1.ListAgentManagersRequest{}, false},
+		{"template write", "/taskguild.v1.TemplateService/DeleteTemplate", &taskguildv1.DeleteTemplateRequest{Id: "TPL"}, false},
+		{"global", "/taskguild.v1.PushNotificationService/GetVapidPublicKey", &taskguildv1.GetVapidPublicKeyRequest{}, true},
+	}
+
+	for _, tt := range tests {
+		err := a.authorizeProjects(context.Background(), tok, tt.procedure, tt.msg)
+		if got := err == nil; got != tt.want {
+			t.Errorf("%s: authorizeProjects() error = %v, want allowed = %v", tt.name, err, tt.want)
+		}
+	}
+
+	unrestricted := &Token{Name: "admin", Scopes: []Scope{ScopeAdmin}}
+	if err := a.authorizeProjects(context.Background(), unrestricted, "/taskguild.v1.ProjectService/CreateProject", &taskguildv1.CreateProjectRequest{}); err != nil {
+		t.Errorf("unrestricted token: authorizeProjects() error = %v, want nil", err)
+	}
+}
+
+func TestFilterResponse(t *testing.T) {
+	a := newTestAuthorizer()
+	tok := &Token{Name: "ci", ProjectIDs: []string{"P1"}}
+
+	tasks := &taskguildv1.ListTasksResponse{Tasks: []*taskguildv1.Task{
+		{Id: "T1", ProjectId: "P1"},
+		{Id: "T2", ProjectId: "P2"},
+	}}
+	a.filterResponse(context.Background(), tok, tasks)
+
+	if len(tasks.Tasks) != 1 || tasks.Tasks[0].GetId() != "T1" {
+		t.Errorf("ListTasks filtered to %v, want only T1", tasks.Tasks)
+	}
+
+	interactions := &taskguildv1.ListInteractionsResponse{Interactions: []*taskguildv1.Interaction{
+		{Id: "I1", TaskId: "T1"},
+		{Id: "I2", TaskId: "T2"},
+		{Id: "I3", TaskId: "T9"},
+	}}
+	a.filterResponse(context.Background(), tok, interactions)
+
+	if len(interactions.Interactions) != 1 || interactions.Interactions[0].GetId() != "I1" {
+		t.Errorf("ListInteractions filtered to %v, want only I1", interactions.Interactions)
+	}
+}
+
+// recordingConn is a streaming connection that records sent messages.
+type recordingConn struct {
+	connect.StreamingHandlerConn
+
+	sent []any
+}
+
+func (c *recordingConn) Send(msg any) error {
+	c.sent = append(c.sent, msg)
+	return nil
+}
+
+func TestAuthorizedConn_FiltersStreams(t *testing.T) {
+	a := newTestAuthorizer()
+	tok := &Token{Name: "ci", ProjectIDs: []string{"P1"}}
+	rec := &recordingConn{}
+	conn := &authorizedConn{
+		StreamingHandlerConn: rec,
+		ctx:                  context.Background(),
+		authorizer:           a,
+		token:                tok,
+		taskAllowed:          a.taskFilter(context.Background(), tok),
+	}
+
+	for _, msg := range []any{
+		&taskguildv1.Event{Id: "e1", Metadata: map[string]string{"project_id": "P1"}},
+		&taskguildv1.Event{Id: "e2", Metadata: map[string]string{"project_id": "P2"}},
+		&taskguildv1.Event{Id: "e3"},
+		&taskguildv1.InteractionEvent{Interaction: &taskguildv1.Interaction{Id: "I1", TaskId: "T1"}},
+		&taskguildv1.InteractionEvent{Interaction: &taskguildv1.Interaction{Id: "I2", TaskId: "T2"}},
+	} {
+		if err := conn.Send(msg); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+	}
+
+	if len(rec.sent) != 2 {
+		t.Fatalf("sent %d messages, want 2: %v", len(rec.sent), rec.sent)
+	}
+
+	if ev, ok := rec.sent[0].(*taskguildv1.Event); !ok || ev.GetId() != "e1" {
+		t.Errorf("sent[0] = %v, want event e1", rec.sent[0])
+	}
+
+	if ev, ok := rec.sent[1].(*taskguildv1.InteractionEvent); !ok || ev.GetInteraction().GetId() != "I1" {
+		t.Errorf("sent[1] = %v, want interaction I1", rec.sent[1])
+	}
+}
@@ -0,0 +1,10 @@
+package apitoken
+
+import "context"
+
+type Repository interface {
+	Create(ctx context.Context, t *Token) error
+	Get(ctx context.Context, id string) (*Token, error)
+	List(ctx context.Context) ([]*Token, error)
+	Update(ctx context.Context, t *Token) error
+}
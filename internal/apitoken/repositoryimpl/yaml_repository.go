@@ -0,0 +1,107 @@
+package repositoryimpl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/kazz187/taskguild/internal/apitoken"
+	"github.com/kazz187/taskguild/pkg/cerr"
+	"github.com/kazz187/taskguild/pkg/storage"
+)
+
+const apiTokensPrefix = "api_tokens"
+
+type YAMLRepository struct {
+	storage storage.Storage
+}
+
+func NewYAMLRepository(s storage.Storage) *YAMLRepository {
+	return &YAMLRepository{storage: s}
+}
+
+func path(id string) string {
+	return fmt.Sprintf("%s/%s.yaml", apiTokensPrefix, id)
+}
+
+func (r *YAMLRepository) Create(ctx context.Context, t *apitoken.Token) error {
+	exists, err := r.storage.Exists(ctx, path(t.ID))
+	if err != nil {
+		return cerr.WrapStorageWriteError("api_token", err)
+	}
+
+	if exists {
+		return cerr.NewError(cerr.AlreadyExists, "api token already exists", nil)
+	}
+
+	return r.write(ctx, t)
+}
+
+func (r *YAMLRepository) Get(ctx context.Context, id string) (*apitoken.Token, error) {
+	data, err := r.storage.Read(ctx, path(id))
+	if err != nil {
+		return nil, cerr.WrapStorageReadError("api_token", err)
+	}
+
+	var t apitoken.Token
+	if err := yaml.Unmarshal(data, &t); err != nil {
+		return nil, cerr.NewError(cerr.Internal, "server error", fmt.Errorf("failed to unmarshal api token: %w", err))
+	}
+
+	return &t, nil
+}
+
+func (r *YAMLRepository) List(ctx context.Context) ([]*apitoken.Token, error) {
+	paths, err := r.storage.List(ctx, apiTokensPrefix)
+	if err != nil {
+		return nil, cerr.WrapStorageReadError("api_tokens", err)
+	}
+
+	sort.Strings(paths)
+
+	var all []*apitoken.Token
+
+	for _, p := range paths {
+		data, err := r.storage.Read(ctx, p)
+		if err != nil {
+			continue
+		}
+
+		var t apitoken.Token
+		if err := yaml.Unmarshal(data, &t); err != nil {
+			continue
+		}
+
+		all = append(all, &t)
+	}
+
+	return all, nil
+}
+
+func (r *YAMLRepository) Update(ctx context.Context, t *apitoken.Token) error {
+	exists, err := r.storage.Exists(ctx, path(t.ID))
+	if err != nil {
+		return cerr.WrapStorageWriteError("api_token", err)
+	}
+
+	if !exists {
+		return cerr.NewError(cerr.NotFound, "api token not found", nil)
+	}
+
+	return r.write(ctx, t)
+}
+
+func (r *YAMLRepository) write(ctx context.Context, t *apitoken.Token) error {
+	data, err := yaml.Marshal(t)
+	if err != nil {
+		return cerr.NewError(cerr.Internal, "server error", fmt.Errorf("failed to marshal api token: %w", err))
+	}
+
+	if err := r.storage.Write(ctx, path(t.ID), data); err != nil {
+		return cerr.WrapStorageWriteError("api_token", err)
+	}
+
+	return nil
+}
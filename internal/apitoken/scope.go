@@ -0,0 +1,98 @@
+package apitoken
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	apiTokenService     = "taskguild.v1.ApiTokenService"
+	auditService        = "taskguild.v1.AuditService"
+	agentManagerService = "taskguild.v1.AgentManagerService"
+)
+
+// respondProcedures are the write RPCs a respond_to_interactions token may
+// call in addition to the read-only ones.
+var respondProcedures = []string{
+	"/taskguild.v1.InteractionService/RespondToInteraction",
+	"/taskguild.v1.InteractionService/SendMessage",
+	"/taskguild.v1.PushNotificationService/RegisterPushSubscription",
+	"/taskguild.v1.PushNotificationService/UnregisterPushSubscription",
+}
+
+// agentManagerProcedures are the RPCs outside AgentManagerService an agent
+// manager calls while running tasks.
+var agentManagerProcedures = []string{
+	"/taskguild.v1.TaskService/CreateTask",
+	"/taskguild.v1.TaskService/UpdateTask",
+	"/taskguild.v1.TaskService/UpdateTaskStatus",
+	"/taskguild.v1.InteractionService/ExpireInteraction",
+}
+
+// unauthenticatedProcedures authenticate the caller by other means and are
+// served without an API token.
+var unauthenticatedProcedures = []string{
+	"/taskguild.v1.InteractionService/RespondToInteractionByToken",
+}
+
+// allowedScopes returns the scopes that may call procedure
+// ("/package.Service/Method"). ScopeAdmin may call everything.
+func allowedScopes(procedure string) []Scope {
+	service, method, _ := strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+
+	switch {
+	case service == apiTokenService || service == auditService:
+		return []Scope{ScopeAdmin}
+	case isReadMethod(service, method):
+		return []Scope{ScopeReadOnly, ScopeRespondToInteractions, ScopeAgentManager, ScopeAdmin}
+	case slices.Contains(respondProcedures, procedure):
+		return []Scope{ScopeRespondToInteractions, ScopeAdmin}
+	case slices.Contains(agentManagerProcedures, procedure):
+		return []Scope{ScopeAgentManager, ScopeAdmin}
+	case service == agentManagerService && !isUserAction(method):
+		return []Scope{ScopeAgentManager, ScopeAdmin}
+	default:
+		return []Scope{ScopeAdmin}
+	}
+}
+
+// IsReadProcedure reports whether procedure ("/package.Service/Method") only
+// reads state.
+func IsReadProcedure(procedure string) bool {
+	service, method, _ := strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+	return isReadMethod(service, method)
+}
+
+// isReadMethod reports whether method only reads state. The agent-manager
+// command stream (AgentManagerService/Subscribe) is not a read: subscribing
+// makes the caller eligible to receive task assignments.
+func isReadMethod(service, method string) bool {
+	if strings.HasPrefix(method, "Get") || strings.HasPrefix(method, "List") {
+		return true
+	}
+
+	if service == agentManagerService {
+		return false
+	}
+
+	return strings.HasPrefix(method, "Subscribe") || strings.HasPrefix(method, "Stream")
+}
+
+// isUserAction reports whether an AgentManagerService method is triggered
+// from the UI rather than by an agent manager (e.g. RequestWorktreeDelete).
+func isUserAction(method string) bool {
+	return strings.HasPrefix(method, "Request") ||
+		strings.HasPrefix(method, "Resolve") ||
+		method == "DrainAgentManager"
+}
+
+// Allows reports whether the token's scopes permit calling procedure.
+func (t *Token) Allows(procedure string) bool {
+	for _, s := range allowedScopes(procedure) {
+		if t.HasScope(s) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,155 @@
+package apitoken
+
+import (
+	"testing"
+	"time"
+
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func TestTokenAllows(t *testing.T) {
+	tests := []struct {
+		scope     Scope
+		procedure string
+		want      bool
+	}{
+		{ScopeReadOnly, "/taskguild.v1.TaskService/ListTasks", true},
+		{ScopeReadOnly, "/taskguild.v1.EventService/SubscribeEvents", true},
+		{ScopeReadOnly, "/taskguild.v1.TaskService/CreateTask", false},
+		{ScopeReadOnly, "/taskguild.v1.InteractionService/RespondToInteraction", false},
+		{ScopeReadOnly, "/taskguild.v1.AgentManagerService/Subscribe", false},
+		{ScopeReadOnly, "/taskguild.v1.ApiTokenService/ListApiTokens", false},
+		{ScopeRespondToInteractions, "/taskguild.v1.InteractionService/RespondToInteraction", true},
+		{ScopeRespondToInteractions, "/taskguild.v1.InteractionService/SendMessage", true},
+		{ScopeRespondToInteractions, "/taskguild.v1.TaskService/UpdateTask", false},
+		{ScopeAgentManager, "/taskguild.v1.AgentManagerService/Subscribe", true},
+		{ScopeAgentManager, "/taskguild.v1.AgentManagerService/ReportTaskResult", true},
+		{ScopeAgentManager, "/taskguild.v1.TaskService/UpdateTaskStatus", true},
+		{ScopeAgentManager, "/taskguild.v1.ProjectService/DeleteProject", false},
+		{ScopeAgentManager, "/taskguild.v1.AgentManagerService/RequestWorktreeDelete", false},
+		{ScopeAgentManager, "/taskguild.v1.AgentManagerService/DrainAgentManager", false},
+		{ScopeAgentManager, "/taskguild.v1.InteractionService/RespondToInteraction", false},
+		{ScopeAdmin, "/taskguild.v1.ProjectService/DeleteProject", true},
+		{ScopeAdmin, "/taskguild.v1.ApiTokenService/CreateApiToken", true},
+		{ScopeReadOnly, "/taskguild.v1.AuditService/ListAuditEvents", false},
+		{ScopeAdmin, "/taskguild.v1.AuditService/ListAuditEvents", true},
+	}
+
+	for _, tt := range tests {
+		tok := &Token{Scopes: []Scope{tt.scope}}
+		if got := tok.Allows(tt.procedure); got != tt.want {
+			t.Errorf("%s.Allows(%s) = %v, want %v", tt.scope, tt.procedure, got, tt.want)
+		}
+	}
+}
+
+func TestTokenActive(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	tests := []struct {
+		name  string
+		token Token
+		want  bool
+	}{
+		{"no expiry", Token{}, true},
+		{"not yet expired", Token{ExpiresAt: &future}, true},
+		{"expired", Token{ExpiresAt: &past}, false},
+		{"revoked", Token{RevokedAt: &past}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.token.Active(now); got != tt.want {
+			t.Errorf("%s: Active() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNewToken(t *testing.T) {
+	now := time.Now()
+
+	tok, secret, err := NewToken("ci", []Scope{ScopeReadOnly}, nil, nil, now)
+	if err != nil {
+		t.Fatalf("NewToken() error = %v", err)
+	}
+
+	if id, ok := tokenIDFromSecret(secret); !ok || id != tok.ID {
+		t.Errorf("tokenIDFromSecret(%q) = %q, %v, want %q", secret, id, ok, tok.ID)
+	}
+
+	if !tok.secretMatches(secret) {
+		t.Error("secretMatches(secret) = false, want true")
+	}
+
+	if tok.secretMatches(secret + "x") {
+		t.Error("secretMatches(other) = true, want false")
+	}
+
+	past := now.Add(-time.Hour)
+
+	for _, tt := range []struct {
+		name      string
+		tokenName string
+		scopes    []Scope
+		expiresAt *time.Time
+	}{
+		{"empty name", " ", []Scope{ScopeAdmin}, nil},
+		{"no scopes", "ci", nil, nil},
+		{"unknown scope", "ci", []Scope{"owner"}, nil},
+		{"expired", "ci", []Scope{ScopeAdmin}, &past},
+	} {
+		if _, _, err := NewToken(tt.tokenName, tt.scopes, nil, tt.expiresAt, now); err == nil {
+			t.Errorf("%s: NewToken() error = nil, want error", tt.name)
+		}
+	}
+}
+
+func TestParseScope(t *testing.T) {
+	if s, err := ParseScope("respond-to-interactions"); err != nil || s != ScopeRespondToInteractions {
+		t.Errorf("ParseScope(respond-to-interactions) = %q, %v", s, err)
+	}
+
+	if _, err := ParseScope("owner"); err == nil {
+		t.Error("ParseScope(owner) error = nil, want error")
+	}
+}
+
+func TestRequestRefs(t *testing.T) {
+	check := func(name string, got []ref, want []ref) {
+		t.Helper()
+
+		if len(got) != len(want) {
+			t.Errorf("%s: requestRefs() = %v, want %v", name, got, want)
+			return
+		}
+
+		for i := range got {
+			if got[i] != want[i] {
+				t.Errorf("%s: requestRefs()[%d] = %v, want %v", name, i, got[i], want[i])
+			}
+		}
+	}
+
+	check("task id",
+		requestRefs("taskguild.v1.TaskService", (&taskguildv1.UpdateTaskRequest{Id: "T1"}).ProtoReflect()),
+		[]ref{{kind: refTask, value: "T1"}})
+	check("project id",
+		requestRefs("taskguild.v1.ProjectService", (&taskguildv1.DeleteProjectRequest{Id: "P1"}).ProtoReflect()),
+		[]ref{{kind: refProjectID, value: "P1"}})
+	check("interaction id",
+		requestRefs("taskguild.v1.InteractionService", (&taskguildv1.RespondToInteractionRequest{Id: "I1"}).ProtoReflect()),
+		[]ref{{kind: refInteraction, value: "I1"}})
+	check("empty filter",
+		requestRefs("taskguild.v1.InteractionService", (&taskguildv1.ListInteractionsRequest{}).ProtoReflect()),
+		nil)
+	check("served projects",
+		requestRefs("taskguild.v1.AgentManagerService", (&taskguildv1.AgentManagerSubscribeRequest{
+			AgentManagerId: "am",
+			Projects: []*taskguildv1.ServedProject{
+				{ProjectName: "a"},
+				{ProjectName: "b"},
+			},
+		}).ProtoReflect()),
+		[]ref{{kind: refProjectName, value: "a"}, {kind: refProjectName, value: "b"}})
+}
@@ -0,0 +1,168 @@
+package apitoken
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kazz187/taskguild/pkg/cerr"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
+)
+
+var _ taskguildv1connect.ApiTokenServiceHandler = (*Server)(nil)
+
+type Server struct {
+	repo          Repository
+	authenticator *Authenticator
+}
+
+func NewServer(repo Repository, authenticator *Authenticator) *Server {
+	return &Server{
+		repo:          repo,
+		authenticator: authenticator,
+	}
+}
+
+func (s *Server) CreateApiToken(ctx context.Context, req *connect.Request[taskguildv1.CreateApiTokenRequest]) (*connect.Response[taskguildv1.CreateApiTokenResponse], error) {
+	scopes := make([]Scope, 0, len(req.Msg.GetScopes()))
+	for _, pb := range req.Msg.GetScopes() {
+		scopes = append(scopes, scopeFromProto(pb))
+	}
+
+	var expiresAt *time.Time
+	if req.Msg.GetExpiresAt() != nil {
+		t := req.Msg.GetExpiresAt().AsTime()
+		expiresAt = &t
+	}
+
+	t, secret, err := NewToken(req.Msg.GetName(), scopes, req.Msg.GetProjectIds(), expiresAt, time.Now())
+	if err != nil {
+		return nil, cerr.NewError(cerr.InvalidArgument, err.Error(), nil).ConnectError()
+	}
+
+	if err := s.repo.Create(ctx, t); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&taskguildv1.CreateApiTokenResponse{
+		Token:  ToProto(t),
+		Secret: secret,
+	}), nil
+}
+
+func (s *Server) ListApiTokens(ctx context.Context, req *connect.Request[taskguildv1.ListApiTokensRequest]) (*connect.Response[taskguildv1.ListApiTokensResponse], error) {
+	tokens, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	res := make([]*taskguildv1.ApiToken, 0, len(tokens))
+
+	for _, t := range tokens {
+		if !req.Msg.GetIncludeRevoked() && !t.Active(now) {
+			continue
+		}
+
+		res = append(res, ToProto(t))
+	}
+
+	return connect.NewResponse(&taskguildv1.ListApiTokensResponse{
+		Tokens: res,
+	}), nil
+}
+
+func (s *Server) RevokeApiToken(ctx context.Context, req *connect.Request[taskguildv1.RevokeApiTokenRequest]) (*connect.Response[taskguildv1.RevokeApiTokenResponse], error) {
+	t, err := Revoke(ctx, s.repo, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	s.authenticator.Invalidate(t.ID)
+
+	return connect.NewResponse(&taskguildv1.RevokeApiTokenResponse{
+		Token: ToProto(t),
+	}), nil
+}
+
+// Revoke marks token id as revoked. Revoking an already revoked token keeps
+// the original revocation time.
+func Revoke(ctx context.Context, repo Repository, id string) (*Token, error) {
+	if id == "" {
+		return nil, cerr.NewError(cerr.InvalidArgument, "id is required", nil).ConnectError()
+	}
+
+	t, err := repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.RevokedAt != nil {
+		return t, nil
+	}
+
+	now := time.Now()
+	t.RevokedAt = &now
+
+	if err := repo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func ToProto(t *Token) *taskguildv1.ApiToken {
+	pb := &taskguildv1.ApiToken{
+		Id:         t.ID,
+		Name:       t.Name,
+		ProjectIds: t.ProjectIDs,
+		CreatedAt:  timestamppb.New(t.CreatedAt),
+	}
+
+	for _, s := range t.Scopes {
+		pb.Scopes = append(pb.Scopes, scopeToProto(s))
+	}
+
+	if t.ExpiresAt != nil {
+		pb.ExpiresAt = timestamppb.New(*t.ExpiresAt)
+	}
+
+	if t.RevokedAt != nil {
+		pb.RevokedAt = timestamppb.New(*t.RevokedAt)
+	}
+
+	return pb
+}
+
+func scopeToProto(s Scope) taskguildv1.ApiTokenScope {
+	switch s {
+	case ScopeReadOnly:
+		return taskguildv1.ApiTokenScope_API_TOKEN_SCOPE_READ_ONLY
+	case ScopeRespondToInteractions:
+		return taskguildv1.ApiTokenScope_API_TOKEN_SCOPE_RESPOND_TO_INTERACTIONS
+	case ScopeAgentManager:
+		return taskguildv1.ApiTokenScope_API_TOKEN_SCOPE_AGENT_MANAGER
+	case ScopeAdmin:
+		return taskguildv1.ApiTokenScope_API_TOKEN_SCOPE_ADMIN
+	default:
+		return taskguildv1.ApiTokenScope_API_TOKEN_SCOPE_UNSPECIFIED
+	}
+}
+
+func scopeFromProto(pb taskguildv1.ApiTokenScope) Scope {
+	switch pb {
+	case taskguildv1.ApiTokenScope_API_TOKEN_SCOPE_READ_ONLY:
+		return ScopeReadOnly
+	case taskguildv1.ApiTokenScope_API_TOKEN_SCOPE_RESPOND_TO_INTERACTIONS:
+		return ScopeRespondToInteractions
+	case taskguildv1.ApiTokenScope_API_TOKEN_SCOPE_AGENT_MANAGER:
+		return ScopeAgentManager
+	case taskguildv1.ApiTokenScope_API_TOKEN_SCOPE_ADMIN:
+		return ScopeAdmin
+	default:
+		return Scope(pb.String())
+	}
+}
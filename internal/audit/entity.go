@@ -0,0 +1,45 @@
+package audit
+
+import (
+	"strings"
+	"time"
+)
+
+// Event records one mutating API call.
+type Event struct {
+	ID             string    `json:"id"`
+	CreatedAt      time.Time `json:"created_at"`
+	TokenID        string    `json:"token_id,omitempty"`
+	Actor          string    `json:"actor"`
+	PeerAddr       string    `json:"peer_addr,omitempty"`
+	Procedure      string    `json:"procedure"`
+	ProjectID      string    `json:"project_id,omitempty"`
+	ResourceID     string    `json:"resource_id,omitempty"`
+	RequestSummary string    `json:"request_summary,omitempty"`
+	Code           string    `json:"code"`
+	ErrorMessage   string    `json:"error_message,omitempty"`
+}
+
+// Filter selects events in Repository.List. Empty fields match everything.
+type Filter struct {
+	ProjectID  string
+	ResourceID string
+	// Procedure matches the full procedure name or its method name.
+	Procedure string
+}
+
+func (f Filter) Match(e *Event) bool {
+	if f.ProjectID != "" && e.ProjectID != f.ProjectID {
+		return false
+	}
+
+	if f.ResourceID != "" && e.ResourceID != f.ResourceID {
+		return false
+	}
+
+	if f.Procedure != "" && e.Procedure != f.Procedure && !strings.HasSuffix(e.Procedure, "/"+f.Procedure) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,206 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/kazz187/taskguild/internal/apitoken"
+)
+
+// maxSummaryBytes caps the stored request summary.
+const maxSummaryBytes = 1024
+
+// actorInteractionToken identifies calls authenticated by an interaction's
+// one-time token instead of an API token.
+const actorInteractionToken = "interaction_token"
+
+// skippedProcedures are agent telemetry RPCs that do not change
+// user-visible configuration. They are high-frequency or carry agent output
+// (results, diffs, permission prompts) that may contain secrets the
+// redactor does not know about, and are kept out of the audit log.
+var skippedProcedures = []string{
+	"/taskguild.v1.AgentManagerService/Heartbeat",
+	"/taskguild.v1.AgentManagerService/ReportAgentStatus",
+	"/taskguild.v1.AgentManagerService/ReportTaskLog",
+	"/taskguild.v1.AgentManagerService/ReportTaskResult",
+	"/taskguild.v1.AgentManagerService/ReportTaskDiff",
+	"/taskguild.v1.AgentManagerService/CreateInteraction",
+	"/taskguild.v1.AgentManagerService/ReportScriptOutputChunk",
+	"/taskguild.v1.AgentManagerService/ReportModifiedFiles",
+	"/taskguild.v1.AgentManagerService/UploadSessionTranscript",
+}
+
+// sensitiveFields are removed from request summaries.
+var sensitiveFields = []protoreflect.Name{"token", "secret", "value", "api_key", "auth_key", "p256dh_key"}
+
+// Interceptor appends an audit event for every unary non-read RPC. Streaming
+// RPCs are subscriptions and are not recorded.
+type Interceptor struct {
+	repo     Repository
+	resolver apitoken.ProjectResolver
+}
+
+func NewInterceptor(repo Repository, resolver apitoken.ProjectResolver) *Interceptor {
+	return &Interceptor{repo: repo, resolver: resolver}
+}
+
+var _ connect.Interceptor = (*Interceptor)(nil)
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		procedure := req.Spec().Procedure
+		if apitoken.IsReadProcedure(procedure) || slices.Contains(skippedProcedures, procedure) {
+			return next(ctx, req)
+		}
+
+		// Resolve before the call so that deleted resources are still
+		// attributed to their project.
+		e := &Event{
+			ID:             ulid.Make().String(),
+			PeerAddr:       req.Peer().Addr,
+			Procedure:      procedure,
+			ProjectID:      apitoken.RequestProjectID(ctx, i.resolver, procedure, req.Any()),
+			ResourceID:     resourceID(req.Any()),
+			RequestSummary: summarize(req.Any()),
+		}
+
+		if t := apitoken.TokenFromContext(ctx); t != nil {
+			e.TokenID = t.ID
+			e.Actor = t.Name
+		} else {
+			e.Actor = actorInteractionToken
+		}
+
+		res, err := next(ctx, req)
+
+		e.CreatedAt = time.Now()
+		e.Code = "ok"
+
+		if err != nil {
+			e.Code = connect.CodeOf(err).String()
+
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				e.ErrorMessage = connectErr.Message()
+			} else {
+				e.ErrorMessage = err.Error()
+			}
+		}
+
+		// Requests are not failed because the audit store is unavailable;
+		// the event is still visible in the server log.
+		if aerr := i.repo.Append(context.WithoutCancel(ctx), e); aerr != nil {
+			slog.Error("failed to append audit event", "procedure", procedure, "actor", e.Actor, "code", e.Code, "error", aerr)
+		}
+
+		return res, err
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// resourceIDFields are the request fields that identify the target of a
+// call, in order of preference. Any other "*_id" field is used after these.
+var resourceIDFields = []protoreflect.Name{"id", "task_id", "interaction_id"}
+
+// nonResourceIDFields are "*_id" fields that describe the caller or the
+// scope rather than the target.
+var nonResourceIDFields = []protoreflect.Name{"project_id", "request_id", "agent_manager_id"}
+
+// resourceID returns the ID of the entity msg targets, falling back to the
+// project ID or name.
+func resourceID(msg any) string {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return ""
+	}
+
+	ids := make(map[protoreflect.Name]string)
+
+	var other string
+
+	m.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.IsList() || fd.IsMap() || fd.Kind() != protoreflect.StringKind || v.String() == "" {
+			return true
+		}
+
+		ids[fd.Name()] = v.String()
+
+		if other == "" && strings.HasSuffix(string(fd.Name()), "_id") &&
+			!slices.Contains(resourceIDFields, fd.Name()) && !slices.Contains(nonResourceIDFields, fd.Name()) {
+			other = v.String()
+		}
+
+		return true
+	})
+
+	for _, name := range resourceIDFields {
+		if id := ids[name]; id != "" {
+			return id
+		}
+	}
+
+	if other != "" {
+		return other
+	}
+
+	if id := ids["project_id"]; id != "" {
+		return id
+	}
+
+	return ids["project_name"]
+}
+
+// summarize renders msg as JSON without secrets or binary payloads,
+// truncated to maxSummaryBytes.
+func summarize(msg any) string {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return ""
+	}
+
+	m = proto.Clone(m)
+	r := m.ProtoReflect()
+
+	var redact []protoreflect.FieldDescriptor
+
+	r.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if slices.Contains(sensitiveFields, fd.Name()) || fd.Kind() == protoreflect.BytesKind {
+			redact = append(redact, fd)
+		}
+
+		return true
+	})
+
+	for _, fd := range redact {
+		r.Clear(fd)
+	}
+
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return ""
+	}
+
+	s := string(b)
+	if len(s) > maxSummaryBytes {
+		s = strings.ToValidUTF8(s[:maxSummaryBytes], "") + "…"
+	}
+
+	return s
+}
@@ -0,0 +1,54 @@
+package audit
+
+import (
+	"strings"
+	"testing"
+
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func TestResourceID(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  any
+		want string
+	}{
+		{"id", &taskguildv1.UpdateTaskStatusRequest{Id: "T1", StatusId: "Done"}, "T1"},
+		{"task_id", &taskguildv1.SendMessageRequest{TaskId: "T2", Message: "hi"}, "T2"},
+		{"other id", &taskguildv1.RequestWorktreeDeleteRequest{ProjectId: "P1", WorktreeName: "wt"}, "P1"},
+		{"project name", &taskguildv1.SyncAgentsRequest{ProjectName: "proj"}, "proj"},
+		{"none", &taskguildv1.SendTestNotificationRequest{}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := resourceID(tt.msg); got != tt.want {
+			t.Errorf("%s: resourceID() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	got := summarize(&taskguildv1.RespondToInteractionByTokenRequest{Token: "secret-token", Response: "yes"})
+	if strings.Contains(got, "secret-token") {
+		t.Errorf("summarize() = %s, want token removed", got)
+	}
+
+	if !strings.Contains(got, `"response":"yes"`) {
+		t.Errorf("summarize() = %s, want response kept", got)
+	}
+
+	got = summarize(&taskguildv1.UploadTaskImageRequest{TaskId: "T1", Data: []byte("binary")})
+	if strings.Contains(got, "data") {
+		t.Errorf("summarize() = %s, want bytes removed", got)
+	}
+
+	got = summarize(&taskguildv1.SetSecretRequest{ProjectId: "P1", Name: "NPM_TOKEN", Value: "npm_abc123"})
+	if strings.Contains(got, "npm_abc123") || !strings.Contains(got, "NPM_TOKEN") {
+		t.Errorf("summarize() = %s, want value removed and name kept", got)
+	}
+
+	got = summarize(&taskguildv1.SendMessageRequest{Message: strings.Repeat("x", 2*maxSummaryBytes)})
+	if len(got) > maxSummaryBytes+len("…") {
+		t.Errorf("len(summarize()) = %d, want <= %d", len(got), maxSummaryBytes+len("…"))
+	}
+}
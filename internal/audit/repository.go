@@ -0,0 +1,11 @@
+package audit
+
+import "context"
+
+// Repository is an append-only store of audit events.
+type Repository interface {
+	Append(ctx context.Context, e *Event) error
+	// List returns the events matching f, newest first, and the total number
+	// of matches.
+	List(ctx context.Context, f Filter, limit, offset int) ([]*Event, int, error)
+}
@@ -0,0 +1,171 @@
+package repositoryimpl
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/kazz187/taskguild/internal/audit"
+	"github.com/kazz187/taskguild/pkg/cerr"
+)
+
+const auditDir = "audit"
+
+// JSONLRepository implements audit.Repository with one append-only JSONL
+// file per UTC day under <baseDir>/audit. Existing lines are never rewritten.
+type JSONLRepository struct {
+	dir string
+
+	mu      sync.Mutex
+	file    *os.File
+	fileDay string // "2006-01-02" of file
+}
+
+func NewJSONLRepository(baseDir string) *JSONLRepository {
+	abs, err := filepath.Abs(baseDir)
+	if err != nil {
+		abs = baseDir
+	}
+
+	return &JSONLRepository{dir: filepath.Join(abs, auditDir)}
+}
+
+// Close closes the current day's file handle.
+func (r *JSONLRepository) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file != nil {
+		r.file.Close()
+		r.file = nil
+	}
+}
+
+func (r *JSONLRepository) Append(_ context.Context, e *audit.Event) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return cerr.NewError(cerr.Internal, "server error", fmt.Errorf("failed to marshal audit event: %w", err))
+	}
+
+	line = append(line, '\n')
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	day := e.CreatedAt.UTC().Format("2006-01-02")
+	if r.file == nil || r.fileDay != day {
+		if err := r.openDay(day); err != nil {
+			return cerr.NewError(cerr.Internal, "server error", err)
+		}
+	}
+
+	if _, err := r.file.Write(line); err != nil {
+		return cerr.NewError(cerr.Internal, "server error", fmt.Errorf("failed to write audit event: %w", err))
+	}
+
+	return nil
+}
+
+// openDay switches the append handle to the file of day. Caller must hold r.mu.
+func (r *JSONLRepository) openDay(day string) error {
+	if r.file != nil {
+		r.file.Close()
+		r.file = nil
+	}
+
+	if err := os.MkdirAll(r.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create audit dir: %w", err)
+	}
+
+	f, err := os.OpenFile(filepath.Join(r.dir, day+".jsonl"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file %s: %w", day, err)
+	}
+
+	r.file = f
+	r.fileDay = day
+
+	return nil
+}
+
+func (r *JSONLRepository) List(_ context.Context, f audit.Filter, limit, offset int) ([]*audit.Event, int, error) {
+	entries, err := os.ReadDir(r.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, 0, nil
+		}
+
+		return nil, 0, cerr.NewError(cerr.Internal, "server error", fmt.Errorf("failed to read audit dir: %w", err))
+	}
+
+	var days []string
+
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".jsonl") {
+			days = append(days, e.Name())
+		}
+	}
+
+	// Newest day first; within a day lines are appended in time order.
+	slices.Sort(days)
+	slices.Reverse(days)
+
+	var (
+		page  []*audit.Event
+		total int
+	)
+
+	for _, name := range days {
+		events, err := readEvents(filepath.Join(r.dir, name))
+		if err != nil {
+			slog.Warn("failed to read audit file", "file", name, "error", err)
+			continue
+		}
+
+		for i := len(events) - 1; i >= 0; i-- {
+			if !f.Match(events[i]) {
+				continue
+			}
+
+			if total >= offset && (limit <= 0 || len(page) < limit) {
+				page = append(page, events[i])
+			}
+
+			total++
+		}
+	}
+
+	return page, total, nil
+}
+
+func readEvents(path string) ([]*audit.Event, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var events []*audit.Event
+
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+
+	for sc.Scan() {
+		var e audit.Event
+		if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+			continue
+		}
+
+		events = append(events, &e)
+	}
+
+	return events, sc.Err()
+}
@@ -0,0 +1,93 @@
+package repositoryimpl
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kazz187/taskguild/internal/audit"
+)
+
+func TestAppendAndList(t *testing.T) {
+	dir := t.TempDir()
+
+	repo := NewJSONLRepository(dir)
+	defer repo.Close()
+
+	ctx := context.Background()
+	day1 := time.Date(2026, 1, 1, 23, 0, 0, 0, time.UTC)
+	day2 := day1.Add(2 * time.Hour)
+
+	events := []*audit.Event{
+		{ID: "1", CreatedAt: day1, Procedure: "/taskguild.v1.TaskService/UpdateTask", ProjectID: "P1", ResourceID: "T1", Code: "ok"},
+		{ID: "2", CreatedAt: day1.Add(time.Minute), Procedure: "/taskguild.v1.TaskService/UpdateTaskStatus", ProjectID: "P1", ResourceID: "T2", Code: "ok"},
+		{ID: "3", CreatedAt: day2, Procedure: "/taskguild.v1.TaskService/UpdateTask", ProjectID: "P2", ResourceID: "T3", Code: "permission_denied"},
+		{ID: "4", CreatedAt: day2.Add(time.Minute), Procedure: "/taskguild.v1.TaskService/UpdateTask", ProjectID: "P1", ResourceID: "T1", Code: "ok"},
+	}
+
+	for _, e := range events {
+		if err := repo.Append(ctx, e); err != nil {
+			t.Fatalf("Append(%s) error = %v", e.ID, err)
+		}
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "audit", "*.jsonl"))
+	if len(files) != 2 {
+		t.Errorf("audit files = %v, want one per day", files)
+	}
+
+	tests := []struct {
+		name   string
+		filter audit.Filter
+		limit  int
+		offset int
+		want   []string
+		total  int
+	}{
+		{"all newest first", audit.Filter{}, 0, 0, []string{"4", "3", "2", "1"}, 4},
+		{"project", audit.Filter{ProjectID: "P1"}, 0, 0, []string{"4", "2", "1"}, 3},
+		{"resource", audit.Filter{ResourceID: "T1"}, 0, 0, []string{"4", "1"}, 2},
+		{"method name", audit.Filter{Procedure: "UpdateTaskStatus"}, 0, 0, []string{"2"}, 1},
+		{"full procedure", audit.Filter{Procedure: "/taskguild.v1.TaskService/UpdateTask"}, 0, 0, []string{"4", "3", "1"}, 3},
+		{"paginated", audit.Filter{}, 2, 1, []string{"3", "2"}, 4},
+	}
+
+	for _, tt := range tests {
+		got, total, err := repo.List(ctx, tt.filter, tt.limit, tt.offset)
+		if err != nil {
+			t.Fatalf("%s: List() error = %v", tt.name, err)
+		}
+
+		var ids []string
+		for _, e := range got {
+			ids = append(ids, e.ID)
+		}
+
+		if total != tt.total || len(ids) != len(tt.want) {
+			t.Errorf("%s: List() = %v (total %d), want %v (total %d)", tt.name, ids, total, tt.want, tt.total)
+			continue
+		}
+
+		for i := range ids {
+			if ids[i] != tt.want[i] {
+				t.Errorf("%s: List() = %v, want %v", tt.name, ids, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestListWithoutAuditDir(t *testing.T) {
+	repo := NewJSONLRepository(filepath.Join(t.TempDir(), "missing"))
+
+	got, total, err := repo.List(context.Background(), audit.Filter{}, 0, 0)
+	if err != nil || total != 0 || len(got) != 0 {
+		t.Errorf("List() = %v, %d, %v, want empty", got, total, err)
+	}
+
+	if _, err := os.Stat(repo.dir); !os.IsNotExist(err) {
+		t.Errorf("List() created %s", repo.dir)
+	}
+}
@@ -0,0 +1,69 @@
+package audit
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
+)
+
+var _ taskguildv1connect.AuditServiceHandler = (*Server)(nil)
+
+type Server struct {
+	repo Repository
+}
+
+func NewServer(repo Repository) *Server {
+	return &Server{repo: repo}
+}
+
+func (s *Server) ListAuditEvents(ctx context.Context, req *connect.Request[taskguildv1.ListAuditEventsRequest]) (*connect.Response[taskguildv1.ListAuditEventsResponse], error) {
+	limit, offset := int32(0), int32(0)
+	if req.Msg.GetPagination() != nil {
+		limit = req.Msg.GetPagination().GetLimit()
+		offset = req.Msg.GetPagination().GetOffset()
+	}
+
+	events, total, err := s.repo.List(ctx, Filter{
+		ProjectID:  req.Msg.GetProjectId(),
+		ResourceID: req.Msg.GetResourceId(),
+		Procedure:  req.Msg.GetProcedure(),
+	}, int(limit), int(offset))
+	if err != nil {
+		return nil, err
+	}
+
+	protos := make([]*taskguildv1.AuditEvent, len(events))
+	for i, e := range events {
+		protos[i] = ToProto(e)
+	}
+
+	return connect.NewResponse(&taskguildv1.ListAuditEventsResponse{
+		Events: protos,
+		Pagination: &taskguildv1.PaginationResponse{
+			Total:  int32(total),
+			Limit:  limit,
+			Offset: offset,
+		},
+	}), nil
+}
+
+// ToProto converts an Event to its protobuf representation.
+func ToProto(e *Event) *taskguildv1.AuditEvent {
+	return &taskguildv1.AuditEvent{
+		Id:             e.ID,
+		CreatedAt:      timestamppb.New(e.CreatedAt),
+		TokenId:        e.TokenID,
+		Actor:          e.Actor,
+		PeerAddr:       e.PeerAddr,
+		Procedure:      e.Procedure,
+		ProjectId:      e.ProjectID,
+		ResourceId:     e.ResourceID,
+		RequestSummary: e.RequestSummary,
+		Code:           e.Code,
+		ErrorMessage:   e.ErrorMessage,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 )
@@ -15,6 +16,9 @@ type BaseEnv struct {
 	LogLevel  string `envconfig:"LOG_LEVEL" default:"debug"`
 	APIKey    string `envconfig:"API_KEY" required:"true"`
 	PublicURL string `envconfig:"PUBLIC_URL" default:""`
+	// SecretMasterKey is a base64-encoded 32-byte key that encrypts project
+	// secrets at rest. The secret store is disabled when it is empty.
+	SecretMasterKey string `envconfig:"SECRET_MASTER_KEY"`
 }
 
 // GetPublicURL returns the configured public URL, or builds a default from
@@ -39,6 +43,9 @@ type StorageEnv struct {
 	S3Bucket string `envconfig:"S3_BUCKET"`
 	S3Prefix string `envconfig:"S3_PREFIX" default:"taskguild/"`
 	S3Region string `envconfig:"S3_REGION" default:"ap-northeast-1"`
+	// Claude session transcripts uploaded by agents for cross-machine resume.
+	SessionTranscriptMaxBytes  int64         `envconfig:"SESSION_TRANSCRIPT_MAX_BYTES" default:"20971520"`
+	SessionTranscriptRetention time.Duration `envconfig:"SESSION_TRANSCRIPT_RETENTION" default:"336h"`
 }
 
 type VAPIDEnv struct {
@@ -36,8 +36,13 @@ type Interaction struct {
 	Metadata      string            `yaml:"metadata,omitempty"`
 	CreatedAt     time.Time         `yaml:"created_at"`
 	RespondedAt   *time.Time        `yaml:"responded_at"`
+	RespondedBy   string            `yaml:"responded_by,omitempty"`
 }
 
+// RespondedByResponseToken is recorded as the responder when an interaction
+// is answered through its one-time response token.
+const RespondedByResponseToken = "response_token"
+
 type Option struct {
 	Label       string `yaml:"label"`
 	Value       string `yaml:"value"`
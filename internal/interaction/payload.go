@@ -53,6 +53,7 @@ func FromProto(pb *taskguildv1.Interaction) *Interaction {
 		Title:       pb.GetTitle(),
 		Description: pb.GetDescription(),
 		Response:    pb.GetResponse(),
+		RespondedBy: pb.GetRespondedBy(),
 	}
 	if pb.GetCreatedAt() != nil {
 		inter.CreatedAt = pb.GetCreatedAt().AsTime()
@@ -78,6 +78,10 @@ func (f *fakeTaskRepo) Claim(context.Context, string, string) (*task.Task, error
 	panic("unused")
 }
 
+func (f *fakeTaskRepo) UpdateMetadata(context.Context, string, map[string]string) (*task.Task, error) {
+	panic("unused")
+}
+
 func (f *fakeTaskRepo) ReleaseByAgent(context.Context, string) ([]*task.Task, error) {
 	panic("unused")
 }
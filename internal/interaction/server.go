@@ -9,6 +9,7 @@ import (
 	"github.com/oklog/ulid/v2"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kazz187/taskguild/internal/apitoken"
 	"github.com/kazz187/taskguild/internal/eventbus"
 	"github.com/kazz187/taskguild/internal/task"
 	"github.com/kazz187/taskguild/pkg/cerr"
@@ -106,6 +107,10 @@ func (s *Server) RespondToInteraction(ctx context.Context, req *connect.Request[
 	inter.Status = StatusResponded
 	inter.RespondedAt = &now
 
+	if t := apitoken.TokenFromContext(ctx); t != nil {
+		inter.RespondedBy = t.Name
+	}
+
 	if err := s.repo.Update(ctx, inter); err != nil {
 		return nil, err
 	}
@@ -145,6 +150,7 @@ func (s *Server) RespondToInteractionByToken(ctx context.Context, req *connect.R
 	inter.Response = req.Msg.GetResponse()
 	inter.Status = StatusResponded
 	inter.RespondedAt = &now
+	inter.RespondedBy = RespondedByResponseToken
 	// Invalidate the token after use.
 	inter.ResponseToken = ""
 
@@ -300,6 +306,7 @@ func ToProto(i *Interaction) *taskguildv1.Interaction {
 		Response:    i.Response,
 		Metadata:    i.Metadata,
 		CreatedAt:   timestamppb.New(i.CreatedAt),
+		RespondedBy: i.RespondedBy,
 	}
 	for _, opt := range i.Options {
 		pb.Options = append(pb.Options, &taskguildv1.InteractionOption{
@@ -1,13 +1,28 @@
 package permission
 
-import "time"
+import (
+	"time"
+
+	"github.com/kazz187/taskguild/pkg/permcheck"
+)
 
 // PermissionSet represents project-scoped permission rules for Claude Code tools
 // and Bash command patterns. One PermissionSet per project.
 type PermissionSet struct {
-	ProjectID string    `yaml:"project_id"`
-	Allow     []string  `yaml:"allow"`
-	Ask       []string  `yaml:"ask"`
-	Deny      []string  `yaml:"deny"`
-	UpdatedAt time.Time `yaml:"updated_at"`
+	ProjectID string   `yaml:"project_id"`
+	Allow     []string `yaml:"allow"`
+	Ask       []string `yaml:"ask"`
+	Deny      []string `yaml:"deny"`
+	// AutoAllowRisks lists shellparse risk categories whose Bash commands
+	// are allowed without confirmation.
+	AutoAllowRisks []string `yaml:"auto_allow_risks,omitempty"`
+	// PathPolicy restricts where edit tools and shell redirects may write.
+	PathPolicy *permcheck.PathPolicy `yaml:"path_policy,omitempty"`
+	// RedactionPatterns are regular expressions masked in task logs,
+	// interactions and turn logs (see pkg/redact).
+	RedactionPatterns []string `yaml:"redaction_patterns,omitempty"`
+	// EgressPolicy restricts the hosts WebFetch and shell network tools
+	// may contact.
+	EgressPolicy *permcheck.EgressPolicy `yaml:"egress_policy,omitempty"`
+	UpdatedAt    time.Time               `yaml:"updated_at"`
 }
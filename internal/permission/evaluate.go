@@ -0,0 +1,153 @@
+package permission
+
+import (
+	"context"
+	"encoding/json"
+
+	"connectrpc.com/connect"
+
+	"github.com/kazz187/taskguild/pkg/cerr"
+	"github.com/kazz187/taskguild/pkg/permcheck"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+// EvaluationSource provides the inputs of EvaluatePermission that are not
+// part of the permission set.
+type EvaluationSource interface {
+	// CommandRules returns the project's live single-command rules.
+	CommandRules(ctx context.Context, projectID string) (permcheck.CommandRules, error)
+	// StatusSettings returns the permission settings the agent receives
+	// for a workflow status.
+	StatusSettings(ctx context.Context, workflowID, statusName string) (*StatusSettings, error)
+}
+
+// StatusSettings are the permission settings of a workflow status.
+type StatusSettings struct {
+	PermissionMode string
+	PathPolicy     *permcheck.PathPolicy
+	// Skills are the skills the status runs, which the Skill tool may
+	// invoke without confirmation.
+	Skills map[string]bool
+}
+
+// EvaluatePermission simulates the agent's permission check for a tool call.
+func (s *Server) EvaluatePermission(ctx context.Context, req *connect.Request[taskguildv1.EvaluatePermissionRequest]) (*connect.Response[taskguildv1.EvaluatePermissionResponse], error) {
+	msg := req.Msg
+	if msg.GetProjectId() == "" || msg.GetToolName() == "" {
+		return nil, cerr.NewError(cerr.InvalidArgument, "project_id and tool_name are required", nil).ConnectError()
+	}
+
+	input := map[string]any{}
+	if raw := msg.GetToolInput(); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &input); err != nil {
+			return nil, cerr.NewError(cerr.InvalidArgument, "tool_input must be a JSON object", err).ConnectError()
+		}
+	}
+
+	if msg.GetCommand() != "" {
+		input["command"] = msg.GetCommand()
+	}
+
+	ps, err := s.repo.Get(ctx, msg.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	rules := permcheck.Rules{
+		Allow:          ps.Allow,
+		Ask:            ps.Ask,
+		Deny:           ps.Deny,
+		AutoAllowRisks: permcheck.ParseAutoAllowRisks(ps.AutoAllowRisks),
+		PathPolicy:     ps.PathPolicy,
+		EgressPolicy:   ps.EgressPolicy,
+	}
+
+	evalReq := permcheck.Request{
+		ToolName: msg.GetToolName(),
+		Input:    input,
+		Mode:     msg.GetPermissionMode(),
+		Cwd:      msg.GetWorkDir(),
+		Scope: permcheck.Scope{
+			TaskID:     msg.GetTaskId(),
+			WorkflowID: msg.GetWorkflowId(),
+			StatusName: msg.GetStatusName(),
+		},
+	}
+
+	if s.source != nil {
+		if rules.Commands, err = s.source.CommandRules(ctx, msg.GetProjectId()); err != nil {
+			return nil, err
+		}
+
+		if msg.GetWorkflowId() != "" && msg.GetStatusName() != "" {
+			st, err := s.source.StatusSettings(ctx, msg.GetWorkflowId(), msg.GetStatusName())
+			if err != nil {
+				return nil, cerr.NewError(cerr.NotFound, "workflow status not found", err).ConnectError()
+			}
+
+			rules.PathPolicy = permcheck.MergePathPolicies(rules.PathPolicy, st.PathPolicy)
+			evalReq.StatusSkills = st.Skills
+
+			if evalReq.Mode == "" {
+				evalReq.Mode = st.PermissionMode
+			}
+		}
+	}
+
+	if evalReq.Mode == "" {
+		evalReq.Mode = permcheck.ModeDefault
+	}
+
+	if evalReq.Cwd == "" && s.resolver != nil {
+		// Without a connected agent, relative write targets stay unresolved
+		// and are reported as outside the allowed paths.
+		evalReq.Cwd, _ = s.resolver.ResolveWorkDir(msg.GetProjectId())
+	}
+
+	d := permcheck.Evaluate(rules, evalReq)
+
+	return connect.NewResponse(decisionToProto(d, evalReq.Mode)), nil
+}
+
+func decisionToProto(d permcheck.Decision, mode string) *taskguildv1.EvaluatePermissionResponse {
+	resp := &taskguildv1.EvaluatePermissionResponse{
+		Decision:       d.Outcome.String(),
+		Source:         d.Source,
+		Rule:           d.Rule,
+		Reason:         d.Reason,
+		PermissionMode: mode,
+	}
+
+	if d.Bash == nil {
+		return resp
+	}
+
+	resp.Risk = d.Bash.Risk
+	resp.RiskLabel = d.Bash.RiskLabel
+	resp.RiskReason = d.Bash.RiskReason
+
+	for _, c := range d.Bash.ParsedCommands {
+		resp.Commands = append(resp.Commands, &taskguildv1.CommandEvaluation{
+			Command:          c.Command,
+			Matched:          c.Matched,
+			MatchedPattern:   c.MatchedPattern,
+			SuggestedPattern: c.SuggestedPattern,
+			Opaque:           c.Opaque,
+			OpaqueReason:     c.OpaqueReason,
+			Risk:             c.Risk,
+			RiskReason:       c.RiskReason,
+		})
+	}
+
+	for _, r := range d.Bash.Redirects {
+		resp.Redirects = append(resp.Redirects, &taskguildv1.RedirectEvaluation{
+			Operator:         r.Operator,
+			Path:             r.Path,
+			Matched:          r.Matched,
+			MatchedPattern:   r.MatchedPattern,
+			SuggestedPattern: r.SuggestedPattern,
+		})
+	}
+
+	return resp
+}
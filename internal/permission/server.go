@@ -11,6 +11,10 @@ import (
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kazz187/taskguild/pkg/cerr"
+	"github.com/kazz187/taskguild/pkg/permcheck"
+	"github.com/kazz187/taskguild/pkg/redact"
+	"github.com/kazz187/taskguild/pkg/shellparse"
 	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
 	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
 )
@@ -34,11 +38,12 @@ type Server struct {
 	repo     Repository
 	notifier ChangeNotifier
 	resolver WorkDirResolver
+	source   EvaluationSource
 }
 
 // NewServer creates a new permission service server.
-func NewServer(repo Repository, notifier ChangeNotifier, resolver WorkDirResolver) *Server {
-	return &Server{repo: repo, notifier: notifier, resolver: resolver}
+func NewServer(repo Repository, notifier ChangeNotifier, resolver WorkDirResolver, source EvaluationSource) *Server {
+	return &Server{repo: repo, notifier: notifier, resolver: resolver, source: source}
 }
 
 func (s *Server) notifyChange(projectID string) {
@@ -61,12 +66,28 @@ func (s *Server) GetPermissions(ctx context.Context, req *connect.Request[taskgu
 
 // UpdatePermissions replaces the full permission set for a project.
 func (s *Server) UpdatePermissions(ctx context.Context, req *connect.Request[taskguildv1.UpdatePermissionsRequest]) (*connect.Response[taskguildv1.UpdatePermissionsResponse], error) {
+	if err := validateAutoAllowRisks(req.Msg.GetAutoAllowRisks()); err != nil {
+		return nil, cerr.NewError(cerr.InvalidArgument, err.Error(), nil).ConnectError()
+	}
+
+	if err := redact.ValidatePatterns(req.Msg.GetRedactionPatterns()); err != nil {
+		return nil, cerr.NewError(cerr.InvalidArgument, err.Error(), nil).ConnectError()
+	}
+
+	if err := validateEgressPolicy(req.Msg.GetEgressPolicy()); err != nil {
+		return nil, cerr.NewError(cerr.InvalidArgument, err.Error(), nil).ConnectError()
+	}
+
 	ps := &PermissionSet{
-		ProjectID: req.Msg.GetProjectId(),
-		Allow:     dedup(req.Msg.GetAllow()),
-		Ask:       dedup(req.Msg.GetAsk()),
-		Deny:      dedup(req.Msg.GetDeny()),
-		UpdatedAt: time.Now(),
+		ProjectID:         req.Msg.GetProjectId(),
+		Allow:             dedup(req.Msg.GetAllow()),
+		Ask:               dedup(req.Msg.GetAsk()),
+		Deny:              dedup(req.Msg.GetDeny()),
+		AutoAllowRisks:    dedup(req.Msg.GetAutoAllowRisks()),
+		PathPolicy:        PathPolicyFromProto(req.Msg.GetPathPolicy()),
+		RedactionPatterns: dedup(req.Msg.GetRedactionPatterns()),
+		EgressPolicy:      EgressPolicyFromProto(req.Msg.GetEgressPolicy()),
+		UpdatedAt:         time.Now(),
 	}
 
 	err := s.repo.Upsert(ctx, ps)
@@ -193,16 +214,52 @@ func toStringSlice(v any) []string {
 
 // Merge performs a union merge of local permissions into stored permissions.
 // Each category (allow, ask, deny) is independently merged with deduplication.
+// AutoAllowRisks, PathPolicy, RedactionPatterns and EgressPolicy have no
+// local counterpart and are kept as stored.
 func Merge(stored *PermissionSet, localAllow, localAsk, localDeny []string) *PermissionSet {
 	return &PermissionSet{
-		ProjectID: stored.ProjectID,
-		Allow:     unionDedup(stored.Allow, localAllow),
-		Ask:       unionDedup(stored.Ask, localAsk),
-		Deny:      unionDedup(stored.Deny, localDeny),
-		UpdatedAt: time.Now(),
+		ProjectID:         stored.ProjectID,
+		Allow:             unionDedup(stored.Allow, localAllow),
+		Ask:               unionDedup(stored.Ask, localAsk),
+		Deny:              unionDedup(stored.Deny, localDeny),
+		AutoAllowRisks:    stored.AutoAllowRisks,
+		PathPolicy:        stored.PathPolicy,
+		RedactionPatterns: stored.RedactionPatterns,
+		EgressPolicy:      stored.EgressPolicy,
+		UpdatedAt:         time.Now(),
 	}
 }
 
+// validateAutoAllowRisks checks that every entry names a risk category that
+// may be auto-allowed.
+func validateAutoAllowRisks(risks []string) error {
+	for _, name := range risks {
+		r, ok := shellparse.ParseRisk(name)
+		if !ok {
+			return fmt.Errorf("unknown risk category %q", name)
+		}
+
+		if !r.AutoAllowable() {
+			return fmt.Errorf("risk category %q cannot be auto-allowed", name)
+		}
+	}
+
+	return nil
+}
+
+// validateEgressPolicy checks that every entry is a domain pattern.
+func validateEgressPolicy(p *taskguildv1.EgressPolicy) error {
+	for _, list := range [][]string{p.GetAllow(), p.GetDeny()} {
+		for _, entry := range list {
+			if err := permcheck.ValidateDomain(entry); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // unionDedup merges two string slices, removing duplicates while preserving order.
 func unionDedup(a, b []string) []string {
 	seen := make(map[string]bool)
@@ -244,10 +301,63 @@ func dedup(items []string) []string {
 
 func toProto(ps *PermissionSet) *taskguildv1.PermissionSet {
 	return &taskguildv1.PermissionSet{
-		ProjectId: ps.ProjectID,
-		Allow:     ps.Allow,
-		Ask:       ps.Ask,
-		Deny:      ps.Deny,
-		UpdatedAt: timestamppb.New(ps.UpdatedAt),
+		ProjectId:         ps.ProjectID,
+		Allow:             ps.Allow,
+		Ask:               ps.Ask,
+		Deny:              ps.Deny,
+		AutoAllowRisks:    ps.AutoAllowRisks,
+		PathPolicy:        PathPolicyToProto(ps.PathPolicy),
+		RedactionPatterns: ps.RedactionPatterns,
+		EgressPolicy:      EgressPolicyToProto(ps.EgressPolicy),
+		UpdatedAt:         timestamppb.New(ps.UpdatedAt),
+	}
+}
+
+// PathPolicyToProto converts a path policy; nil stays nil.
+func PathPolicyToProto(p *permcheck.PathPolicy) *taskguildv1.PathPolicy {
+	if p == nil {
+		return nil
+	}
+
+	return &taskguildv1.PathPolicy{
+		Allow: p.Allow,
+		Deny:  p.Deny,
+	}
+}
+
+// PathPolicyFromProto converts a path policy. An empty policy becomes nil.
+func PathPolicyFromProto(p *taskguildv1.PathPolicy) *permcheck.PathPolicy {
+	if len(p.GetAllow()) == 0 && len(p.GetDeny()) == 0 {
+		return nil
+	}
+
+	return &permcheck.PathPolicy{
+		Allow: dedup(p.GetAllow()),
+		Deny:  dedup(p.GetDeny()),
+	}
+}
+
+// EgressPolicyToProto converts an egress policy; nil stays nil.
+func EgressPolicyToProto(p *permcheck.EgressPolicy) *taskguildv1.EgressPolicy {
+	if p == nil {
+		return nil
+	}
+
+	return &taskguildv1.EgressPolicy{
+		Allow: p.Allow,
+		Deny:  p.Deny,
+	}
+}
+
+// EgressPolicyFromProto converts an egress policy. An empty policy becomes
+// nil.
+func EgressPolicyFromProto(p *taskguildv1.EgressPolicy) *permcheck.EgressPolicy {
+	if len(p.GetAllow()) == 0 && len(p.GetDeny()) == 0 {
+		return nil
+	}
+
+	return &permcheck.EgressPolicy{
+		Allow: dedup(p.GetAllow()),
+		Deny:  dedup(p.GetDeny()),
 	}
 }
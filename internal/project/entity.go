@@ -1,15 +1,64 @@
 package project
 
-import "time"
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// DefaultWorktreeBranchTemplate is the branch name template used when a
+// project does not configure one.
+const DefaultWorktreeBranchTemplate = "worktree-{name}"
 
 type Project struct {
-	ID                string    `yaml:"id"`
-	Name              string    `yaml:"name"`
-	Description       string    `yaml:"description"`
-	RepositoryURL     string    `yaml:"repository_url"`
-	DefaultBranch     string    `yaml:"default_branch"`
-	Order             int32     `yaml:"order"`
-	HiddenFromSidebar bool      `yaml:"hidden_from_sidebar"`
-	CreatedAt         time.Time `yaml:"created_at"`
-	UpdatedAt         time.Time `yaml:"updated_at"`
+	ID                     string            `yaml:"id"`
+	Name                   string            `yaml:"name"`
+	Description            string            `yaml:"description"`
+	RepositoryURL          string            `yaml:"repository_url"`
+	DefaultBranch          string            `yaml:"default_branch"`
+	Order                  int32             `yaml:"order"`
+	HiddenFromSidebar      bool              `yaml:"hidden_from_sidebar"`
+	MergeQueue             *MergeQueueConfig `yaml:"merge_queue,omitempty"` // nil disables the merge queue
+	WorktreePolicy         *WorktreePolicy   `yaml:"worktree_policy,omitempty"`
+	WorktreeBranchTemplate string            `yaml:"worktree_branch_template,omitempty"` // {name}, {task_id}; empty = DefaultWorktreeBranchTemplate
+	CreatedAt              time.Time         `yaml:"created_at"`
+	UpdatedAt              time.Time         `yaml:"updated_at"`
+}
+
+// MergeQueueConfig configures how completed worktree branches are merged
+// into the default branch.
+type MergeQueueConfig struct {
+	Enabled        bool     `yaml:"enabled"`
+	VerifyCommands []string `yaml:"verify_commands,omitempty"`
+	Push           bool     `yaml:"push"`
+}
+
+// WorktreePolicy configures automatic cleanup of a project's worktrees.
+type WorktreePolicy struct {
+	DeleteArchived        bool  `yaml:"delete_archived"`
+	DeleteMergedAfterDays int32 `yaml:"delete_merged_after_days"`
+	DiskQuotaBytes        int64 `yaml:"disk_quota_bytes"`
+}
+
+// MergeQueueEnabled reports whether the project's merge queue is enabled.
+func (p *Project) MergeQueueEnabled() bool {
+	return p.MergeQueue != nil && p.MergeQueue.Enabled
+}
+
+// ValidateWorktreeBranchTemplate checks a worktree branch name template. The
+// template must contain {name} so that every worktree gets its own branch.
+func ValidateWorktreeBranchTemplate(tmpl string) error {
+	if tmpl == "" {
+		return nil
+	}
+
+	if !strings.Contains(tmpl, "{name}") {
+		return errors.New("worktree branch template must contain {name}")
+	}
+
+	if strings.HasPrefix(tmpl, "-") || strings.ContainsAny(tmpl, " \t\n~^:?*[\\") || strings.Contains(tmpl, "..") {
+		return errors.New("worktree branch template contains characters not allowed in a branch name")
+	}
+
+	return nil
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/oklog/ulid/v2"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kazz187/taskguild/pkg/cerr"
 	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
 	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
 )
@@ -37,17 +38,24 @@ func (s *Server) CreateProject(ctx context.Context, req *connect.Request[taskgui
 		}
 	}
 
+	if err := ValidateWorktreeBranchTemplate(req.Msg.GetWorktreeBranchTemplate()); err != nil {
+		return nil, cerr.NewError(cerr.InvalidArgument, err.Error(), err).ConnectError()
+	}
+
 	now := time.Now()
 
 	p := &Project{
-		ID:            ulid.Make().String(),
-		Name:          req.Msg.GetName(),
-		Description:   req.Msg.GetDescription(),
-		RepositoryURL: req.Msg.GetRepositoryUrl(),
-		DefaultBranch: req.Msg.GetDefaultBranch(),
-		Order:         maxOrder + 1,
-		CreatedAt:     now,
-		UpdatedAt:     now,
+		ID:                     ulid.Make().String(),
+		Name:                   req.Msg.GetName(),
+		Description:            req.Msg.GetDescription(),
+		RepositoryURL:          req.Msg.GetRepositoryUrl(),
+		DefaultBranch:          req.Msg.GetDefaultBranch(),
+		MergeQueue:             mergeQueueFromProto(req.Msg.GetMergeQueue()),
+		WorktreePolicy:         worktreePolicyFromProto(req.Msg.GetWorktreePolicy()),
+		WorktreeBranchTemplate: req.Msg.GetWorktreeBranchTemplate(),
+		Order:                  maxOrder + 1,
+		CreatedAt:              now,
+		UpdatedAt:              now,
 	}
 	if err := s.repo.Create(ctx, p); err != nil {
 		return nil, err
@@ -136,6 +144,22 @@ func (s *Server) UpdateProject(ctx context.Context, req *connect.Request[taskgui
 		p.HiddenFromSidebar = req.Msg.GetHiddenFromSidebar()
 	}
 
+	if req.Msg.GetMergeQueue() != nil {
+		p.MergeQueue = mergeQueueFromProto(req.Msg.GetMergeQueue())
+	}
+
+	if req.Msg.GetWorktreePolicy() != nil {
+		p.WorktreePolicy = worktreePolicyFromProto(req.Msg.GetWorktreePolicy())
+	}
+
+	if req.Msg.WorktreeBranchTemplate != nil {
+		if err := ValidateWorktreeBranchTemplate(req.Msg.GetWorktreeBranchTemplate()); err != nil {
+			return nil, cerr.NewError(cerr.InvalidArgument, err.Error(), err).ConnectError()
+		}
+
+		p.WorktreeBranchTemplate = req.Msg.GetWorktreeBranchTemplate()
+	}
+
 	p.UpdatedAt = time.Now()
 	if err := s.repo.Update(ctx, p); err != nil {
 		return nil, err
@@ -190,14 +214,65 @@ func (s *Server) ReorderProjects(ctx context.Context, req *connect.Request[taskg
 
 func toProto(p *Project) *taskguildv1.Project {
 	return &taskguildv1.Project{
-		Id:                p.ID,
-		Name:              p.Name,
-		Description:       p.Description,
-		RepositoryUrl:     p.RepositoryURL,
-		DefaultBranch:     p.DefaultBranch,
-		Order:             p.Order,
-		HiddenFromSidebar: p.HiddenFromSidebar,
-		CreatedAt:         timestamppb.New(p.CreatedAt),
-		UpdatedAt:         timestamppb.New(p.UpdatedAt),
+		Id:                     p.ID,
+		Name:                   p.Name,
+		Description:            p.Description,
+		RepositoryUrl:          p.RepositoryURL,
+		DefaultBranch:          p.DefaultBranch,
+		Order:                  p.Order,
+		HiddenFromSidebar:      p.HiddenFromSidebar,
+		MergeQueue:             mergeQueueToProto(p.MergeQueue),
+		WorktreePolicy:         worktreePolicyToProto(p.WorktreePolicy),
+		WorktreeBranchTemplate: p.WorktreeBranchTemplate,
+		CreatedAt:              timestamppb.New(p.CreatedAt),
+		UpdatedAt:              timestamppb.New(p.UpdatedAt),
+	}
+}
+
+func mergeQueueFromProto(mq *taskguildv1.MergeQueueConfig) *MergeQueueConfig {
+	if mq == nil {
+		return nil
+	}
+
+	return &MergeQueueConfig{
+		Enabled:        mq.GetEnabled(),
+		VerifyCommands: mq.GetVerifyCommands(),
+		Push:           mq.GetPush(),
+	}
+}
+
+func mergeQueueToProto(mq *MergeQueueConfig) *taskguildv1.MergeQueueConfig {
+	if mq == nil {
+		return nil
+	}
+
+	return &taskguildv1.MergeQueueConfig{
+		Enabled:        mq.Enabled,
+		VerifyCommands: mq.VerifyCommands,
+		Push:           mq.Push,
+	}
+}
+
+func worktreePolicyFromProto(wp *taskguildv1.WorktreePolicy) *WorktreePolicy {
+	if wp == nil {
+		return nil
+	}
+
+	return &WorktreePolicy{
+		DeleteArchived:        wp.GetDeleteArchived(),
+		DeleteMergedAfterDays: wp.GetDeleteMergedAfterDays(),
+		DiskQuotaBytes:        wp.GetDiskQuotaBytes(),
+	}
+}
+
+func worktreePolicyToProto(wp *WorktreePolicy) *taskguildv1.WorktreePolicy {
+	if wp == nil {
+		return nil
+	}
+
+	return &taskguildv1.WorktreePolicy{
+		DeleteArchived:        wp.DeleteArchived,
+		DeleteMergedAfterDays: wp.DeleteMergedAfterDays,
+		DiskQuotaBytes:        wp.DiskQuotaBytes,
 	}
 }
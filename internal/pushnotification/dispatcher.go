@@ -2,6 +2,7 @@ package pushnotification
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/kazz187/taskguild/internal/eventbus"
 	"github.com/kazz187/taskguild/internal/interaction"
 	"github.com/kazz187/taskguild/internal/task"
+	"github.com/kazz187/taskguild/pkg/shellparse"
 	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
 )
 
@@ -84,6 +86,10 @@ func (d *Dispatcher) handleInteractionCreated(ctx context.Context, event *taskgu
 	switch inter.Type {
 	case interaction.TypePermissionRequest:
 		title = "Permission Request"
+		if label := permissionRiskLabel(inter.Metadata); label != "" {
+			title += " (" + label + ")"
+		}
+
 		payloadType = "permission_request"
 	case interaction.TypeQuestion:
 		title = "Question from Agent"
@@ -115,6 +121,28 @@ func (d *Dispatcher) handleInteractionCreated(ctx context.Context, event *taskgu
 	})
 }
 
+// permissionRiskLabel extracts the highest command risk attached by the
+// agent to a Bash permission request, or "" when there is none.
+func permissionRiskLabel(metadata string) string {
+	if metadata == "" {
+		return ""
+	}
+
+	var meta struct {
+		Risk string `json:"risk"`
+	}
+	if err := json.Unmarshal([]byte(metadata), &meta); err != nil {
+		return ""
+	}
+
+	r, ok := shellparse.ParseRisk(meta.Risk)
+	if !ok {
+		return ""
+	}
+
+	return r.Label()
+}
+
 // buildActions constructs notification action buttons based on the interaction type.
 func (d *Dispatcher) buildActions(inter *interaction.Interaction) []NotificationAction {
 	switch inter.Type {
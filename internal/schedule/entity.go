@@ -20,6 +20,10 @@ type Schedule struct {
 	Effort          string            `yaml:"effort,omitempty"`
 	TaskMetadata    map[string]string `yaml:"task_metadata,omitempty"`
 
+	// Worktree start point of the created tasks (see task.Task).
+	WorktreeBaseRef    string `yaml:"worktree_base_ref,omitempty"`
+	WorktreeBaseTaskID string `yaml:"worktree_base_task_id,omitempty"`
+
 	// State updated by the scheduler.
 	LastRunAt time.Time `yaml:"last_run_at,omitempty"`
 	NextRunAt time.Time `yaml:"next_run_at,omitempty"`
@@ -11,6 +11,7 @@ import (
 	"github.com/robfig/cron/v3"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kazz187/taskguild/internal/task"
 	"github.com/kazz187/taskguild/internal/workflow"
 	"github.com/kazz187/taskguild/pkg/cerr"
 	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
@@ -124,23 +125,29 @@ func (s *Server) CreateSchedule(ctx context.Context, req *connect.Request[taskgu
 		return nil, err
 	}
 
+	if err := task.ValidateWorktreeBase(req.Msg.GetWorktreeBaseRef(), req.Msg.GetWorktreeBaseTaskId()); err != nil {
+		return nil, cerr.NewError(cerr.InvalidArgument, err.Error(), err).ConnectError()
+	}
+
 	now := time.Now()
 	sched := &Schedule{
-		ID:              ulid.Make().String(),
-		ProjectID:       req.Msg.GetProjectId(),
-		WorkflowID:      req.Msg.GetWorkflowId(),
-		Name:            req.Msg.GetName(),
-		Description:     req.Msg.GetDescription(),
-		CronExpression:  strings.TrimSpace(req.Msg.GetCronExpression()),
-		Enabled:         req.Msg.GetEnabled(),
-		TaskTitle:       req.Msg.GetTaskTitle(),
-		TaskDescription: req.Msg.GetTaskDescription(),
-		StatusID:        statusID,
-		UseWorktree:     req.Msg.GetUseWorktree(),
-		Effort:          req.Msg.GetEffort(),
-		TaskMetadata:    req.Msg.GetTaskMetadata(),
-		CreatedAt:       now,
-		UpdatedAt:       now,
+		ID:                 ulid.Make().String(),
+		ProjectID:          req.Msg.GetProjectId(),
+		WorkflowID:         req.Msg.GetWorkflowId(),
+		Name:               req.Msg.GetName(),
+		Description:        req.Msg.GetDescription(),
+		CronExpression:     strings.TrimSpace(req.Msg.GetCronExpression()),
+		Enabled:            req.Msg.GetEnabled(),
+		TaskTitle:          req.Msg.GetTaskTitle(),
+		TaskDescription:    req.Msg.GetTaskDescription(),
+		StatusID:           statusID,
+		UseWorktree:        req.Msg.GetUseWorktree(),
+		Effort:             req.Msg.GetEffort(),
+		TaskMetadata:       req.Msg.GetTaskMetadata(),
+		WorktreeBaseRef:    req.Msg.GetWorktreeBaseRef(),
+		WorktreeBaseTaskID: req.Msg.GetWorktreeBaseTaskId(),
+		CreatedAt:          now,
+		UpdatedAt:          now,
 	}
 
 	if sched.Enabled {
@@ -248,6 +255,18 @@ func (s *Server) UpdateSchedule(ctx context.Context, req *connect.Request[taskgu
 		sched.UseWorktree = req.Msg.GetUseWorktree()
 	}
 
+	if req.Msg.WorktreeBaseRef != nil {
+		sched.WorktreeBaseRef = req.Msg.GetWorktreeBaseRef()
+	}
+
+	if req.Msg.WorktreeBaseTaskId != nil {
+		sched.WorktreeBaseTaskID = req.Msg.GetWorktreeBaseTaskId()
+	}
+
+	if err := task.ValidateWorktreeBase(sched.WorktreeBaseRef, sched.WorktreeBaseTaskID); err != nil {
+		return nil, cerr.NewError(cerr.InvalidArgument, err.Error(), err).ConnectError()
+	}
+
 	sched.UpdatedAt = time.Now()
 
 	if sched.Enabled {
@@ -317,22 +336,24 @@ func (s *Server) SetScheduleEnabled(ctx context.Context, req *connect.Request[ta
 
 func toProto(s *Schedule) *taskguildv1.Schedule {
 	pb := &taskguildv1.Schedule{
-		Id:              s.ID,
-		ProjectId:       s.ProjectID,
-		WorkflowId:      s.WorkflowID,
-		Name:            s.Name,
-		Description:     s.Description,
-		CronExpression:  s.CronExpression,
-		Enabled:         s.Enabled,
-		TaskTitle:       s.TaskTitle,
-		TaskDescription: s.TaskDescription,
-		StatusId:        s.StatusID,
-		UseWorktree:     s.UseWorktree,
-		Effort:          s.Effort,
-		TaskMetadata:    s.TaskMetadata,
-		LastError:       s.LastError,
-		CreatedAt:       timestamppb.New(s.CreatedAt),
-		UpdatedAt:       timestamppb.New(s.UpdatedAt),
+		Id:                 s.ID,
+		ProjectId:          s.ProjectID,
+		WorkflowId:         s.WorkflowID,
+		Name:               s.Name,
+		Description:        s.Description,
+		CronExpression:     s.CronExpression,
+		Enabled:            s.Enabled,
+		TaskTitle:          s.TaskTitle,
+		TaskDescription:    s.TaskDescription,
+		StatusId:           s.StatusID,
+		UseWorktree:        s.UseWorktree,
+		Effort:             s.Effort,
+		TaskMetadata:       s.TaskMetadata,
+		WorktreeBaseRef:    s.WorktreeBaseRef,
+		WorktreeBaseTaskId: s.WorktreeBaseTaskID,
+		LastError:          s.LastError,
+		CreatedAt:          timestamppb.New(s.CreatedAt),
+		UpdatedAt:          timestamppb.New(s.UpdatedAt),
 	}
 	if !s.LastRunAt.IsZero() {
 		pb.LastRunAt = timestamppb.New(s.LastRunAt)
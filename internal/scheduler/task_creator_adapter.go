@@ -39,6 +39,9 @@ func (a *TaskCreatorAdapter) CreateTaskFromSchedule(ctx context.Context, s *sche
 		UseWorktree: s.UseWorktree,
 		Effort:      s.Effort,
 		Metadata:    metadata,
+
+		WorktreeBaseRef:    s.WorktreeBaseRef,
+		WorktreeBaseTaskID: s.WorktreeBaseTaskID,
 	})
 }
 
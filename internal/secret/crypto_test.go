@@ -0,0 +1,57 @@
+package secret
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCipher(t *testing.T) *Cipher {
+	t.Helper()
+
+	c, err := NewCipher(base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32))))
+	require.NoError(t, err)
+
+	return c
+}
+
+func TestCipher_RoundTrip(t *testing.T) {
+	c := testCipher(t)
+
+	ct, err := c.Encrypt("proj", "NPM_TOKEN", "npm_abc123")
+	require.NoError(t, err)
+	assert.NotContains(t, ct, "npm_abc123")
+
+	v, err := c.Decrypt("proj", "NPM_TOKEN", ct)
+	require.NoError(t, err)
+	assert.Equal(t, "npm_abc123", v)
+
+	// A ciphertext is bound to its project and name.
+	_, err = c.Decrypt("other", "NPM_TOKEN", ct)
+	require.Error(t, err)
+	_, err = c.Decrypt("proj", "GITHUB_TOKEN", ct)
+	require.Error(t, err)
+}
+
+func TestNewCipher_InvalidKey(t *testing.T) {
+	_, err := NewCipher("not base64!")
+	require.Error(t, err)
+
+	_, err = NewCipher(base64.StdEncoding.EncodeToString([]byte("short")))
+	require.Error(t, err)
+}
+
+func TestValidateName(t *testing.T) {
+	require.NoError(t, ValidateName("NPM_TOKEN"))
+	require.NoError(t, ValidateName("_X1"))
+
+	for _, name := range []string{
+		"", "npm_token", "1TOKEN", "MY-TOKEN", "PATH", "TASKGUILD_TASK_ID",
+		"LD_PRELOAD", "DYLD_INSERT_LIBRARIES", "BASH_ENV", "NODE_OPTIONS", "GIT_SSH_COMMAND", "GIT_CONFIG_COUNT",
+	} {
+		require.Error(t, ValidateName(name), name)
+	}
+}
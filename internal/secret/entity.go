@@ -0,0 +1,84 @@
+package secret
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Secret is an encrypted project secret. Its value is only ever held in
+// plaintext by Cipher.Decrypt; the stored form is Ciphertext.
+type Secret struct {
+	ProjectID  string    `yaml:"project_id"`
+	Name       string    `yaml:"name"`
+	Ciphertext string    `yaml:"ciphertext"` // base64 of nonce || AES-GCM sealed value
+	CreatedAt  time.Time `yaml:"created_at"`
+	UpdatedAt  time.Time `yaml:"updated_at"`
+}
+
+var namePattern = regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`)
+
+// reservedNames are environment variables a secret must not shadow: the
+// basic process environment, and variables that make the dynamic loader,
+// shells, interpreters or git run code when a session, hook or script
+// starts, which would bypass the permission checks.
+var reservedNames = []string{
+	"PATH", "HOME", "SHELL", "USER", "PWD", "IFS", "TMPDIR",
+	"BASH_ENV", "ENV", "ZDOTDIR", "PROMPT_COMMAND", "SHELLOPTS", "BASHOPTS", "PS4",
+	"NODE_OPTIONS", "NODE_PATH",
+	"PYTHONSTARTUP", "PYTHONPATH", "PYTHONHOME", "PYTHONINSPECT",
+	"PERL5OPT", "PERL5LIB", "PERLLIB", "RUBYOPT", "RUBYLIB",
+	"JAVA_TOOL_OPTIONS", "_JAVA_OPTIONS", "JDK_JAVA_OPTIONS",
+	"GIT_SSH", "GIT_SSH_COMMAND", "GIT_EXEC_PATH", "GIT_ASKPASS", "GIT_EDITOR",
+	"GIT_PAGER", "GIT_EXTERNAL_DIFF", "GIT_PROXY_COMMAND", "GIT_TEMPLATE_DIR",
+	"SSH_ASKPASS", "EDITOR", "VISUAL", "PAGER",
+}
+
+// reservedPrefixes are name prefixes a secret must not use. TASKGUILD_ is
+// set by the script runner; LD_ and DYLD_ configure the dynamic loader;
+// GIT_CONFIG_ injects git configuration (including aliases and hooks).
+var reservedPrefixes = []string{"TASKGUILD_", "LD_", "DYLD_", "GIT_CONFIG"}
+
+// maxValueBytes bounds a secret value so that the environment of spawned
+// processes stays well below the OS limit.
+const maxValueBytes = 32 * 1024
+
+// ValidateName reports whether name can be used as a secret, which is
+// exposed as an environment variable of the same name.
+func ValidateName(name string) error {
+	if name == "" {
+		return errors.New("name is required")
+	}
+
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("invalid name %q: use upper-case letters, digits and underscores (e.g. NPM_TOKEN)", name)
+	}
+
+	if slices.Contains(reservedNames, name) {
+		return fmt.Errorf("name %q is reserved", name)
+	}
+
+	for _, prefix := range reservedPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return fmt.Errorf("name %q is reserved: names starting with %s cannot be used", name, prefix)
+		}
+	}
+
+	return nil
+}
+
+// ValidateValue reports whether value can be stored as a secret.
+func ValidateValue(value string) error {
+	if value == "" {
+		return errors.New("value is required")
+	}
+
+	if len(value) > maxValueBytes {
+		return fmt.Errorf("value exceeds %d bytes", maxValueBytes)
+	}
+
+	return nil
+}
@@ -0,0 +1,12 @@
+package secret
+
+import "context"
+
+type Repository interface {
+	// Upsert creates or replaces a secret.
+	Upsert(ctx context.Context, s *Secret) error
+	Get(ctx context.Context, projectID, name string) (*Secret, error)
+	// List returns a project's secrets sorted by name.
+	List(ctx context.Context, projectID string) ([]*Secret, error)
+	Delete(ctx context.Context, projectID, name string) error
+}
@@ -0,0 +1,115 @@
+package repositoryimpl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/kazz187/taskguild/internal/secret"
+	"github.com/kazz187/taskguild/pkg/cerr"
+	"github.com/kazz187/taskguild/pkg/storage"
+)
+
+const (
+	projectsPrefix = "projects"
+	entityType     = "secrets"
+)
+
+// YAMLRepository stores encrypted secrets as individual YAML files, one per
+// secret, scoped under project directories.
+type YAMLRepository struct {
+	storage storage.Storage
+}
+
+// NewYAMLRepository creates a new YAML-backed secret repository.
+func NewYAMLRepository(s storage.Storage) *YAMLRepository {
+	return &YAMLRepository{storage: s}
+}
+
+func entityPrefix(projectID string) string {
+	return fmt.Sprintf("%s/%s/%s", projectsPrefix, projectID, entityType)
+}
+
+func entityPath(projectID, name string) string {
+	return fmt.Sprintf("%s/%s.yaml", entityPrefix(projectID), name)
+}
+
+func (r *YAMLRepository) Upsert(ctx context.Context, s *secret.Secret) error {
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return cerr.NewError(cerr.Internal, "server error", fmt.Errorf("failed to marshal secret: %w", err))
+	}
+
+	if err := r.storage.Write(ctx, entityPath(s.ProjectID, s.Name), data); err != nil {
+		return cerr.WrapStorageWriteError("secret", err)
+	}
+
+	return nil
+}
+
+func (r *YAMLRepository) Get(ctx context.Context, projectID, name string) (*secret.Secret, error) {
+	data, err := r.storage.Read(ctx, entityPath(projectID, name))
+	if err != nil {
+		return nil, cerr.WrapStorageReadError("secret", err)
+	}
+
+	var s secret.Secret
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		return nil, cerr.NewError(cerr.Internal, "server error", fmt.Errorf("failed to unmarshal secret: %w", err))
+	}
+
+	return &s, nil
+}
+
+func (r *YAMLRepository) List(ctx context.Context, projectID string) ([]*secret.Secret, error) {
+	keys, err := r.storage.List(ctx, entityPrefix(projectID))
+	if err != nil {
+		return nil, cerr.WrapStorageReadError("secrets", err)
+	}
+
+	var result []*secret.Secret
+
+	for _, key := range keys {
+		if !strings.HasSuffix(key, ".yaml") {
+			continue
+		}
+
+		data, err := r.storage.Read(ctx, key)
+		if err != nil {
+			return nil, cerr.WrapStorageReadError("secret", err)
+		}
+
+		var s secret.Secret
+		if err := yaml.Unmarshal(data, &s); err != nil {
+			continue
+		}
+
+		result = append(result, &s)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+func (r *YAMLRepository) Delete(ctx context.Context, projectID, name string) error {
+	exists, err := r.storage.Exists(ctx, entityPath(projectID, name))
+	if err != nil {
+		return cerr.WrapStorageReadError("secret", err)
+	}
+
+	if !exists {
+		return cerr.NewError(cerr.NotFound, "secret not found", nil)
+	}
+
+	if err := r.storage.Delete(ctx, entityPath(projectID, name)); err != nil {
+		return cerr.WrapStorageDeleteError("secret", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package secret
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kazz187/taskguild/pkg/cerr"
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
+)
+
+var _ taskguildv1connect.SecretServiceHandler = (*Server)(nil)
+
+// Server implements the SecretService RPC handlers and supplies decrypted
+// values to the agent manager service. Values never leave the server
+// through SecretService itself.
+type Server struct {
+	repo   Repository
+	cipher *Cipher // nil when no master key is configured
+
+	mu     sync.Mutex
+	values map[string]map[string]string // project ID -> name -> value
+	gen    uint64                       // bumped on every change; guards against caching stale reads
+}
+
+// NewServer creates a new secret service server. A nil cipher disables the
+// store: secrets can be listed and deleted but not set or delivered.
+func NewServer(repo Repository, cipher *Cipher) *Server {
+	return &Server{
+		repo:   repo,
+		cipher: cipher,
+		values: make(map[string]map[string]string),
+	}
+}
+
+func (s *Server) ListSecrets(ctx context.Context, req *connect.Request[taskguildv1.ListSecretsRequest]) (*connect.Response[taskguildv1.ListSecretsResponse], error) {
+	if req.Msg.GetProjectId() == "" {
+		return nil, cerr.NewError(cerr.InvalidArgument, "project_id is required", nil).ConnectError()
+	}
+
+	secrets, err := s.repo.List(ctx, req.Msg.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*taskguildv1.Secret, 0, len(secrets))
+	for _, sec := range secrets {
+		res = append(res, ToProto(sec))
+	}
+
+	return connect.NewResponse(&taskguildv1.ListSecretsResponse{
+		Secrets: res,
+		Enabled: s.cipher != nil,
+	}), nil
+}
+
+func (s *Server) SetSecret(ctx context.Context, req *connect.Request[taskguildv1.SetSecretRequest]) (*connect.Response[taskguildv1.SetSecretResponse], error) {
+	projectID, name, value := req.Msg.GetProjectId(), req.Msg.GetName(), req.Msg.GetValue()
+
+	if s.cipher == nil {
+		return nil, cerr.NewError(cerr.FailedPrecondition, "secret store is disabled: set TASKGUILD_SECRET_MASTER_KEY on the server", nil).ConnectError()
+	}
+
+	if projectID == "" {
+		return nil, cerr.NewError(cerr.InvalidArgument, "project_id is required", nil).ConnectError()
+	}
+
+	if err := ValidateName(name); err != nil {
+		return nil, cerr.NewError(cerr.InvalidArgument, err.Error(), nil).ConnectError()
+	}
+
+	if err := ValidateValue(value); err != nil {
+		return nil, cerr.NewError(cerr.InvalidArgument, err.Error(), nil).ConnectError()
+	}
+
+	ciphertext, err := s.cipher.Encrypt(projectID, name, value)
+	if err != nil {
+		return nil, cerr.NewError(cerr.Internal, "server error", err).ConnectError()
+	}
+
+	now := time.Now()
+	sec := &Secret{
+		ProjectID:  projectID,
+		Name:       name,
+		Ciphertext: ciphertext,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if existing, err := s.repo.Get(ctx, projectID, name); err == nil {
+		sec.CreatedAt = existing.CreatedAt
+	}
+
+	if err := s.repo.Upsert(ctx, sec); err != nil {
+		return nil, err
+	}
+
+	s.invalidate(projectID)
+
+	return connect.NewResponse(&taskguildv1.SetSecretResponse{
+		Secret: ToProto(sec),
+	}), nil
+}
+
+func (s *Server) DeleteSecret(ctx context.Context, req *connect.Request[taskguildv1.DeleteSecretRequest]) (*connect.Response[taskguildv1.DeleteSecretResponse], error) {
+	if req.Msg.GetProjectId() == "" || req.Msg.GetName() == "" {
+		return nil, cerr.NewError(cerr.InvalidArgument, "project_id and name are required", nil).ConnectError()
+	}
+
+	if err := s.repo.Delete(ctx, req.Msg.GetProjectId(), req.Msg.GetName()); err != nil {
+		return nil, err
+	}
+
+	s.invalidate(req.Msg.GetProjectId())
+
+	return connect.NewResponse(&taskguildv1.DeleteSecretResponse{}), nil
+}
+
+// SecretValues returns the decrypted secrets of a project keyed by name.
+// It returns nil when the store is disabled. Secrets that fail to decrypt
+// (e.g. after the master key was rotated) are skipped with a warning.
+func (s *Server) SecretValues(ctx context.Context, projectID string) (map[string]string, error) {
+	if s.cipher == nil {
+		return nil, nil
+	}
+
+	s.mu.Lock()
+	cached, ok := s.values[projectID]
+	gen := s.gen
+	s.mu.Unlock()
+
+	if ok {
+		return maps.Clone(cached), nil
+	}
+
+	secrets, err := s.repo.List(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(secrets))
+
+	for _, sec := range secrets {
+		// Secrets stored before a name was reserved are not delivered.
+		if err := ValidateName(sec.Name); err != nil {
+			slog.Warn("skipping secret with reserved name", "project_id", projectID, "name", sec.Name, "error", err)
+			continue
+		}
+
+		v, err := s.cipher.Decrypt(sec.ProjectID, sec.Name, sec.Ciphertext)
+		if err != nil {
+			slog.Warn("failed to decrypt secret", "project_id", projectID, "name", sec.Name, "error", err)
+			continue
+		}
+
+		values[sec.Name] = v
+	}
+
+	s.mu.Lock()
+	if s.gen == gen {
+		s.values[projectID] = values
+	}
+	s.mu.Unlock()
+
+	return maps.Clone(values), nil
+}
+
+func (s *Server) invalidate(projectID string) {
+	s.mu.Lock()
+	delete(s.values, projectID)
+	s.gen++
+	s.mu.Unlock()
+}
+
+func ToProto(s *Secret) *taskguildv1.Secret {
+	return &taskguildv1.Secret{
+		ProjectId: s.ProjectID,
+		Name:      s.Name,
+		CreatedAt: timestamppb.New(s.CreatedAt),
+		UpdatedAt: timestamppb.New(s.UpdatedAt),
+	}
+}
@@ -16,6 +16,8 @@ import (
 
 	"github.com/kazz187/taskguild/internal/agent"
 	"github.com/kazz187/taskguild/internal/agentmanager"
+	"github.com/kazz187/taskguild/internal/apitoken"
+	"github.com/kazz187/taskguild/internal/audit"
 	"github.com/kazz187/taskguild/internal/claudesettings"
 	"github.com/kazz187/taskguild/internal/config"
 	"github.com/kazz187/taskguild/internal/event"
@@ -25,6 +27,7 @@ import (
 	"github.com/kazz187/taskguild/internal/pushnotification"
 	"github.com/kazz187/taskguild/internal/schedule"
 	"github.com/kazz187/taskguild/internal/script"
+	"github.com/kazz187/taskguild/internal/secret"
 	"github.com/kazz187/taskguild/internal/singlecommandpermission"
 	"github.com/kazz187/taskguild/internal/skill"
 	"github.com/kazz187/taskguild/internal/task"
@@ -55,6 +58,12 @@ type Server struct {
 	templateServer                *tmpl.Server
 	claudeSettingsServer          *claudesettings.Server
 	scheduleServer                *schedule.Server
+	secretServer                  *secret.Server
+	apiTokenServer                *apitoken.Server
+	authenticator                 *apitoken.Authenticator
+	authorizer                    *apitoken.Authorizer
+	auditServer                   *audit.Server
+	auditInterceptor              *audit.Interceptor
 }
 
 func NewServer(
@@ -75,6 +84,12 @@ func NewServer(
 	templateServer *tmpl.Server,
 	claudeSettingsServer *claudesettings.Server,
 	scheduleServer *schedule.Server,
+	secretServer *secret.Server,
+	apiTokenServer *apitoken.Server,
+	authenticator *apitoken.Authenticator,
+	authorizer *apitoken.Authorizer,
+	auditServer *audit.Server,
+	auditInterceptor *audit.Interceptor,
 ) *Server {
 	return &Server{
 		env:                           env,
@@ -94,6 +109,12 @@ func NewServer(
 		templateServer:                templateServer,
 		claudeSettingsServer:          claudeSettingsServer,
 		scheduleServer:                scheduleServer,
+		secretServer:                  secretServer,
+		apiTokenServer:                apiTokenServer,
+		authenticator:                 authenticator,
+		authorizer:                    authorizer,
+		auditServer:                   auditServer,
+		auditInterceptor:              auditInterceptor,
 	}
 }
 
@@ -138,6 +159,9 @@ func (s *Server) ListenAndServe(ctx context.Context) error {
 	mux.Handle(taskguildv1connect.NewTemplateServiceHandler(s.templateServer, handlerOpts))
 	mux.Handle(taskguildv1connect.NewClaudeSettingsServiceHandler(s.claudeSettingsServer, handlerOpts))
 	mux.Handle(taskguildv1connect.NewScheduleServiceHandler(s.scheduleServer, handlerOpts))
+	mux.Handle(taskguildv1connect.NewSecretServiceHandler(s.secretServer, handlerOpts))
+	mux.Handle(taskguildv1connect.NewApiTokenServiceHandler(s.apiTokenServer, handlerOpts))
+	mux.Handle(taskguildv1connect.NewAuditServiceHandler(s.auditServer, handlerOpts))
 
 	addr := net.JoinHostPort(s.env.HTTPHost, s.env.HTTPPort)
 	slog.Info("starting server", "addr", addr)
@@ -184,7 +208,11 @@ func (hc *HealthChecker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 func (s *Server) interceptors() []connect.Interceptor {
 	return []connect.Interceptor{
 		clog.NewSlogConnectInterceptor(),
+		// The audit interceptor wraps the error conversion and authorization
+		// so that it records final result codes, including denied calls.
+		s.auditInterceptor,
 		cerr.NewConvertConnectErrorInterceptor(),
+		s.authorizer,
 	}
 }
 
@@ -205,11 +233,13 @@ func (s *Server) apiKeyMiddleware(next http.Handler) http.Handler {
 			}
 		}
 
-		if apiKey != s.env.APIKey {
+		// Scope and project restrictions are enforced per RPC by s.authorizer.
+		token := s.authenticator.Authenticate(r.Context(), apiKey)
+		if token == nil {
 			http.Error(w, "unauthorized", http.StatusUnauthorized)
 			return
 		}
 
-		next.ServeHTTP(w, r)
+		next.ServeHTTP(w, r.WithContext(apitoken.WithToken(r.Context(), token)))
 	})
 }
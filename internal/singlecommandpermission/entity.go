@@ -1,6 +1,10 @@
 package singlecommandpermission
 
-import "time"
+import (
+	"time"
+
+	"github.com/kazz187/taskguild/pkg/permcheck"
+)
 
 // SingleCommandPermission represents a wildcard-based permission rule that matches
 // against individual shell commands (not full one-liners). The pattern uses
@@ -8,9 +12,39 @@ import "time"
 type SingleCommandPermission struct {
 	ID        string    `yaml:"id"`
 	ProjectID string    `yaml:"project_id"`
-	Pattern   string    `yaml:"pattern"` // wildcard pattern (e.g. "git status" or "git *")
-	Type      string    `yaml:"type"`    // "command" or "redirect"
+	Pattern   string    `yaml:"pattern"`          // wildcard pattern (e.g. "git status" or "git *")
+	Type      string    `yaml:"type"`             // "command" or "redirect"
+	Action    string    `yaml:"action,omitempty"` // "allow" (default) or "deny"
+	Reason    string    `yaml:"reason,omitempty"` // shown to the agent when denied
 	CreatedAt time.Time `yaml:"created_at"`
+
+	// Grants created from permission requests may be limited to a task or a
+	// workflow status, and may expire.
+	Scope        string     `yaml:"scope,omitempty"` // "project" (default), "task" or "status"
+	TaskID       string     `yaml:"task_id,omitempty"`
+	WorkflowID   string     `yaml:"workflow_id,omitempty"`
+	StatusName   string     `yaml:"status_name,omitempty"`
+	ExpiresAt    *time.Time `yaml:"expires_at,omitempty"`
+	OriginTaskID string     `yaml:"origin_task_id,omitempty"` // task whose request created the grant
+	GrantedBy    string     `yaml:"granted_by,omitempty"`     // who approved the request
+}
+
+// Expired reports whether the rule has an expiry at or before now.
+func (p *SingleCommandPermission) Expired(now time.Time) bool {
+	return p.ExpiresAt != nil && !p.ExpiresAt.After(now)
+}
+
+// Permanent reports whether the rule applies project-wide without expiry.
+func (p *SingleCommandPermission) Permanent() bool {
+	return NormalizeScope(p.Scope) == ScopeProject && p.ExpiresAt == nil
+}
+
+// sameGrant reports whether p and o apply to the same task or status.
+func (p *SingleCommandPermission) sameGrant(o *SingleCommandPermission) bool {
+	return NormalizeScope(p.Scope) == NormalizeScope(o.Scope) &&
+		p.TaskID == o.TaskID &&
+		p.WorkflowID == o.WorkflowID &&
+		p.StatusName == o.StatusName
 }
 
 // Permission types.
@@ -18,3 +52,63 @@ const (
 	TypeCommand  = "command"
 	TypeRedirect = "redirect"
 )
+
+// Rule actions. Deny rules win over allow rules.
+const (
+	ActionAllow = "allow"
+	ActionDeny  = "deny"
+)
+
+// NormalizeAction returns ActionAllow for an empty action.
+func NormalizeAction(action string) string {
+	if action == "" {
+		return ActionAllow
+	}
+
+	return action
+}
+
+// Grant scopes.
+const (
+	ScopeProject = "project"
+	ScopeTask    = "task"
+	ScopeStatus  = "status"
+)
+
+// NormalizeScope returns ScopeProject for an empty scope.
+func NormalizeScope(scope string) string {
+	if scope == "" {
+		return ScopeProject
+	}
+
+	return scope
+}
+
+// CommandRules compiles rules for permcheck. Rules with an invalid pattern
+// are skipped.
+func CommandRules(perms []*SingleCommandPermission) permcheck.CommandRules {
+	rules := make(permcheck.CommandRules, 0, len(perms))
+
+	for _, p := range perms {
+		r := permcheck.CommandRule{
+			ID:         p.ID,
+			Pattern:    p.Pattern,
+			Type:       p.Type,
+			Deny:       NormalizeAction(p.Action) == ActionDeny,
+			Reason:     p.Reason,
+			Scope:      NormalizeScope(p.Scope),
+			TaskID:     p.TaskID,
+			WorkflowID: p.WorkflowID,
+			StatusName: p.StatusName,
+		}
+		if p.ExpiresAt != nil {
+			r.ExpiresAt = *p.ExpiresAt
+		}
+
+		if r.Compile() == nil {
+			rules = append(rules, r)
+		}
+	}
+
+	return rules
+}
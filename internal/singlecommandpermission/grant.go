@@ -0,0 +1,118 @@
+package singlecommandpermission
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// AddGrant stores an allow rule approved from a permission request. g needs
+// ProjectID, Pattern and Type; the scope, expiry and origin fields are kept
+// as given.
+//
+// A permanent project rule (allow or deny) with the same pattern and type
+// makes the grant redundant and is returned instead; agents must not be able
+// to lift a deny. Extra permanent duplicates are removed. Re-granting the
+// same scope replaces the previous grant's expiry, and a permanent grant
+// replaces all scoped grants of the pattern.
+func AddGrant(ctx context.Context, repo Repository, g *SingleCommandPermission) (*SingleCommandPermission, error) {
+	existing, err := repo.FindByPatternAndType(ctx, g.ProjectID, g.Pattern, g.Type)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing single command permissions: %w", err)
+	}
+
+	var permanent []*SingleCommandPermission
+
+	for _, e := range existing {
+		if e.Permanent() {
+			permanent = append(permanent, e)
+		}
+	}
+
+	if len(permanent) > 0 {
+		// Keep the oldest entry and remove extra duplicates.
+		for _, dup := range permanent[1:] {
+			_ = repo.Delete(ctx, dup.ID)
+		}
+
+		return permanent[0], nil
+	}
+
+	for _, e := range existing {
+		// A permanent grant supersedes every scoped grant of the pattern.
+		if g.Permanent() {
+			_ = repo.Delete(ctx, e.ID)
+			continue
+		}
+
+		if !e.sameGrant(g) {
+			continue
+		}
+
+		e.ExpiresAt = g.ExpiresAt
+		e.OriginTaskID = g.OriginTaskID
+		e.GrantedBy = g.GrantedBy
+
+		if err := repo.Update(ctx, e); err != nil {
+			return nil, fmt.Errorf("failed to update single command permission: %w", err)
+		}
+
+		return e, nil
+	}
+
+	g.ID = ulid.Make().String()
+	g.Action = ActionAllow
+	g.CreatedAt = time.Now()
+
+	if err := repo.Create(ctx, g); err != nil {
+		return nil, fmt.Errorf("failed to create single command permission: %w", err)
+	}
+
+	return g, nil
+}
+
+// ListLive returns the rules of a project that have not expired. Expired
+// rules are left for PruneExpired, so that reads never modify the store.
+func ListLive(ctx context.Context, repo Repository, projectID string, now time.Time) ([]*SingleCommandPermission, error) {
+	perms, err := repo.List(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var live []*SingleCommandPermission
+
+	for _, p := range perms {
+		if !p.Expired(now) {
+			live = append(live, p)
+		}
+	}
+
+	return live, nil
+}
+
+// PruneExpired deletes the expired rules of a project and returns how many
+// were deleted.
+func PruneExpired(ctx context.Context, repo Repository, projectID string, now time.Time) (int, error) {
+	perms, err := repo.List(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+
+	pruned := 0
+
+	for _, p := range perms {
+		if !p.Expired(now) {
+			continue
+		}
+
+		if err := repo.Delete(ctx, p.ID); err != nil {
+			return pruned, err
+		}
+
+		pruned++
+	}
+
+	return pruned, nil
+}
@@ -0,0 +1,165 @@
+package singlecommandpermission
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// memRepo is an in-memory Repository for tests.
+type memRepo struct {
+	perms map[string]*SingleCommandPermission
+}
+
+func newMemRepo(perms ...*SingleCommandPermission) *memRepo {
+	r := &memRepo{perms: make(map[string]*SingleCommandPermission)}
+	for _, p := range perms {
+		r.perms[p.ID] = p
+	}
+
+	return r
+}
+
+func (r *memRepo) Create(_ context.Context, p *SingleCommandPermission) error {
+	r.perms[p.ID] = p
+	return nil
+}
+
+func (r *memRepo) Get(_ context.Context, id string) (*SingleCommandPermission, error) {
+	p, ok := r.perms[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+
+	return p, nil
+}
+
+func (r *memRepo) List(_ context.Context, projectID string) ([]*SingleCommandPermission, error) {
+	var result []*SingleCommandPermission
+
+	for _, p := range r.perms {
+		if p.ProjectID == projectID {
+			result = append(result, p)
+		}
+	}
+
+	return result, nil
+}
+
+func (r *memRepo) FindByPatternAndType(ctx context.Context, projectID, pattern, permType string) ([]*SingleCommandPermission, error) {
+	all, _ := r.List(ctx, projectID)
+
+	var result []*SingleCommandPermission
+
+	for _, p := range all {
+		if p.Pattern == pattern && p.Type == permType {
+			result = append(result, p)
+		}
+	}
+
+	return result, nil
+}
+
+func (r *memRepo) Update(_ context.Context, p *SingleCommandPermission) error {
+	r.perms[p.ID] = p
+	return nil
+}
+
+func (r *memRepo) Delete(_ context.Context, id string) error {
+	delete(r.perms, id)
+	return nil
+}
+
+func TestAddGrant(t *testing.T) {
+	ctx := t.Context()
+	in := func(d time.Duration) *time.Time {
+		at := time.Now().Add(d)
+		return &at
+	}
+
+	t.Run("keeps existing deny rule", func(t *testing.T) {
+		repo := newMemRepo(&SingleCommandPermission{ID: "d", ProjectID: "p", Pattern: "rm *", Type: TypeCommand, Action: ActionDeny})
+
+		got, err := AddGrant(ctx, repo, &SingleCommandPermission{ProjectID: "p", Pattern: "rm *", Type: TypeCommand, Scope: ScopeTask, TaskID: "t1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got.ID != "d" || len(repo.perms) != 1 {
+			t.Errorf("expected the deny rule to be kept alone, got %+v (%d rules)", got, len(repo.perms))
+		}
+	})
+
+	t.Run("re-grant replaces expiry", func(t *testing.T) {
+		repo := newMemRepo()
+		grant := func(expires *time.Time) *SingleCommandPermission {
+			g, err := AddGrant(ctx, repo, &SingleCommandPermission{ProjectID: "p", Pattern: "go test *", Type: TypeCommand, Scope: ScopeStatus, WorkflowID: "wf", StatusName: "Develop", ExpiresAt: expires})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			return g
+		}
+
+		first := grant(in(time.Hour))
+		second := grant(in(8 * time.Hour))
+
+		if first.ID != second.ID || len(repo.perms) != 1 {
+			t.Fatalf("expected a single grant, got %d", len(repo.perms))
+		}
+
+		if !second.ExpiresAt.After(time.Now().Add(7 * time.Hour)) {
+			t.Errorf("expected the expiry to be extended, got %v", second.ExpiresAt)
+		}
+	})
+
+	t.Run("permanent grant replaces scoped grants", func(t *testing.T) {
+		repo := newMemRepo(
+			&SingleCommandPermission{ID: "a", ProjectID: "p", Pattern: "ls", Type: TypeCommand, Scope: ScopeTask, TaskID: "t1"},
+			&SingleCommandPermission{ID: "b", ProjectID: "p", Pattern: "ls", Type: TypeCommand, ExpiresAt: in(time.Hour)},
+		)
+
+		got, err := AddGrant(ctx, repo, &SingleCommandPermission{ProjectID: "p", Pattern: "ls", Type: TypeCommand})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !got.Permanent() || len(repo.perms) != 1 {
+			t.Errorf("expected a single permanent rule, got %d rules", len(repo.perms))
+		}
+	})
+}
+
+func TestListLiveAndPruneExpired(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+
+	repo := newMemRepo(
+		&SingleCommandPermission{ID: "expired", ProjectID: "p", ExpiresAt: &past},
+		&SingleCommandPermission{ID: "timed", ProjectID: "p", ExpiresAt: &future},
+		&SingleCommandPermission{ID: "permanent", ProjectID: "p"},
+	)
+
+	live, err := ListLive(t.Context(), repo, "p", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(live) != 2 {
+		t.Errorf("got %d live rules, want 2", len(live))
+	}
+
+	if _, ok := repo.perms["expired"]; !ok {
+		t.Error("expected listing not to delete the expired grant")
+	}
+
+	pruned, err := PruneExpired(t.Context(), repo, "p", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := repo.perms["expired"]; ok || pruned != 1 || len(repo.perms) != 2 {
+		t.Errorf("expected only the expired grant to be pruned, got %d pruned", pruned)
+	}
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"regexp"
-	"strings"
 	"time"
 
 	"connectrpc.com/connect"
@@ -13,6 +13,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/kazz187/taskguild/pkg/cerr"
+	"github.com/kazz187/taskguild/pkg/permcheck"
 	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
 	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
 )
@@ -24,7 +25,7 @@ func validateWildcardPattern(pattern string) error {
 		return errors.New("pattern must not be empty")
 	}
 
-	regex := wildcardToRegex(pattern)
+	regex := permcheck.WildcardToRegex(pattern)
 	if _, err := regexp.Compile(regex); err != nil {
 		return fmt.Errorf("invalid wildcard pattern: %w", err)
 	}
@@ -32,14 +33,43 @@ func validateWildcardPattern(pattern string) error {
 	return nil
 }
 
-// wildcardToRegex converts a wildcard pattern to a Go regular expression.
-func wildcardToRegex(pattern string) string {
-	parts := strings.Split(pattern, "*")
-	for i, p := range parts {
-		parts[i] = regexp.QuoteMeta(p)
+// validateAction checks a rule action and returns it normalized.
+func validateAction(action string) (string, error) {
+	action = NormalizeAction(action)
+	if action != ActionAllow && action != ActionDeny {
+		return "", fmt.Errorf("action must be %q or %q", ActionAllow, ActionDeny)
 	}
 
-	return "^" + strings.Join(parts, ".*") + "$"
+	return action, nil
+}
+
+// optionalAction validates an action given in a request. It returns "" when
+// the action is unset, so that the stored action is kept.
+func optionalAction(action *string) (string, error) {
+	if action == nil {
+		return "", nil
+	}
+
+	return validateAction(*action)
+}
+
+// applyActionAndReason sets the action and reason of p to the given
+// values, keeping the stored ones for an empty action or a nil reason. It
+// reports whether p changed.
+func applyActionAndReason(p *SingleCommandPermission, action string, reason *string) bool {
+	changed := false
+
+	if action != "" && NormalizeAction(p.Action) != action {
+		p.Action = action
+		changed = true
+	}
+
+	if reason != nil && p.Reason != *reason {
+		p.Reason = *reason
+		changed = true
+	}
+
+	return changed
 }
 
 var _ taskguildv1connect.SingleCommandPermissionServiceHandler = (*Server)(nil)
@@ -54,11 +84,12 @@ type ChangeNotifier interface {
 type Server struct {
 	repo     Repository
 	notifier ChangeNotifier
+	history  History
 }
 
 // NewServer creates a new single-command permission service server.
-func NewServer(repo Repository, notifier ChangeNotifier) *Server {
-	return &Server{repo: repo, notifier: notifier}
+func NewServer(repo Repository, notifier ChangeNotifier, history History) *Server {
+	return &Server{repo: repo, notifier: notifier, history: history}
 }
 
 func (s *Server) notifyChange(projectID string) {
@@ -72,14 +103,14 @@ func (s *Server) ListSingleCommandPermissions(
 	ctx context.Context,
 	req *connect.Request[taskguildv1.ListSingleCommandPermissionsRequest],
 ) (*connect.Response[taskguildv1.ListSingleCommandPermissionsResponse], error) {
-	perms, err := s.repo.List(ctx, req.Msg.GetProjectId())
+	perms, err := ListLive(ctx, s.repo, req.Msg.GetProjectId(), time.Now())
 	if err != nil {
 		return nil, err
 	}
 
 	var pbPerms []*taskguildv1.SingleCommandPermission
 	for _, p := range perms {
-		pbPerms = append(pbPerms, toProto(p))
+		pbPerms = append(pbPerms, ToProto(p))
 	}
 
 	return connect.NewResponse(&taskguildv1.ListSingleCommandPermissionsResponse{
@@ -88,9 +119,10 @@ func (s *Server) ListSingleCommandPermissions(
 }
 
 // CreateSingleCommandPermission adds a new wildcard permission rule.
-// If a rule with the same pattern+type already exists in the project, any extra
-// duplicates are removed. This makes the operation idempotent and cleans up
-// legacy duplicates.
+// If a permanent rule with the same pattern+type already exists in the
+// project, any extra duplicates are removed. This makes the operation
+// idempotent and cleans up legacy duplicates. Scoped and expiring grants of
+// the pattern are left alone.
 func (s *Server) CreateSingleCommandPermission(
 	ctx context.Context,
 	req *connect.Request[taskguildv1.CreateSingleCommandPermissionRequest],
@@ -105,12 +137,25 @@ func (s *Server) CreateSingleCommandPermission(
 		return nil, cerr.NewError(cerr.InvalidArgument, fmt.Sprintf("type must be %q or %q", TypeCommand, TypeRedirect), nil)
 	}
 
+	action, err := optionalAction(req.Msg.Action)
+	if err != nil {
+		return nil, cerr.NewError(cerr.InvalidArgument, err.Error(), nil)
+	}
+
 	// Check for existing duplicates (pattern + type within the same project).
-	existing, err := s.repo.FindByPatternAndType(ctx, req.Msg.GetProjectId(), req.Msg.GetPattern(), req.Msg.GetType())
+	found, err := s.repo.FindByPatternAndType(ctx, req.Msg.GetProjectId(), req.Msg.GetPattern(), req.Msg.GetType())
 	if err != nil {
 		return nil, err
 	}
 
+	var existing []*SingleCommandPermission
+
+	for _, e := range found {
+		if e.Permanent() {
+			existing = append(existing, e)
+		}
+	}
+
 	var p *SingleCommandPermission
 
 	if len(existing) > 0 {
@@ -121,13 +166,27 @@ func (s *Server) CreateSingleCommandPermission(
 		for _, dup := range existing[1:] {
 			_ = s.repo.Delete(ctx, dup.ID)
 		}
+
+		// Re-creating a rule with another action or reason replaces them;
+		// unset fields keep the stored values.
+		if applyActionAndReason(p, action, req.Msg.Reason) {
+			if err := s.repo.Update(ctx, p); err != nil {
+				return nil, err
+			}
+		}
 	} else {
+		if action == "" {
+			action = ActionAllow
+		}
+
 		// No duplicate — create a new entry.
 		p = &SingleCommandPermission{
 			ID:        ulid.Make().String(),
 			ProjectID: req.Msg.GetProjectId(),
 			Pattern:   req.Msg.GetPattern(),
 			Type:      req.Msg.GetType(),
+			Action:    action,
+			Reason:    req.Msg.GetReason(),
 			CreatedAt: time.Now(),
 		}
 
@@ -140,7 +199,7 @@ func (s *Server) CreateSingleCommandPermission(
 	s.notifyChange(p.ProjectID)
 
 	return connect.NewResponse(&taskguildv1.CreateSingleCommandPermissionResponse{
-		Permission: toProto(p),
+		Permission: ToProto(p),
 	}), nil
 }
 
@@ -159,6 +218,11 @@ func (s *Server) UpdateSingleCommandPermission(
 		return nil, cerr.NewError(cerr.InvalidArgument, fmt.Sprintf("type must be %q or %q", TypeCommand, TypeRedirect), nil)
 	}
 
+	action, err := optionalAction(req.Msg.Action)
+	if err != nil {
+		return nil, cerr.NewError(cerr.InvalidArgument, err.Error(), nil)
+	}
+
 	existing, err := s.repo.Get(ctx, req.Msg.GetId())
 	if err != nil {
 		return nil, err
@@ -166,6 +230,7 @@ func (s *Server) UpdateSingleCommandPermission(
 
 	existing.Pattern = req.Msg.GetPattern()
 	existing.Type = req.Msg.GetType()
+	applyActionAndReason(existing, action, req.Msg.Reason)
 
 	if err := s.repo.Update(ctx, existing); err != nil {
 		return nil, err
@@ -174,10 +239,29 @@ func (s *Server) UpdateSingleCommandPermission(
 	s.notifyChange(existing.ProjectID)
 
 	return connect.NewResponse(&taskguildv1.UpdateSingleCommandPermissionResponse{
-		Permission: toProto(existing),
+		Permission: ToProto(existing),
 	}), nil
 }
 
+// PruneExpired deletes the expired grants of the given projects and
+// notifies agents of the projects whose rules changed.
+func (s *Server) PruneExpired(ctx context.Context, projectIDs []string) {
+	now := time.Now()
+
+	for _, pid := range projectIDs {
+		pruned, err := PruneExpired(ctx, s.repo, pid, now)
+		if err != nil {
+			slog.Error("failed to prune expired single command permissions", "project_id", pid, "error", err)
+			continue
+		}
+
+		if pruned > 0 {
+			slog.Info("pruned expired single command permissions", "project_id", pid, "pruned", pruned)
+			s.notifyChange(pid)
+		}
+	}
+}
+
 // DeleteSingleCommandPermission removes a permission rule.
 func (s *Server) DeleteSingleCommandPermission(
 	ctx context.Context,
@@ -198,12 +282,78 @@ func (s *Server) DeleteSingleCommandPermission(
 	return connect.NewResponse(&taskguildv1.DeleteSingleCommandPermissionResponse{}), nil
 }
 
-func toProto(p *SingleCommandPermission) *taskguildv1.SingleCommandPermission {
-	return &taskguildv1.SingleCommandPermission{
-		Id:        p.ID,
-		ProjectId: p.ProjectID,
-		Pattern:   p.Pattern,
-		Type:      p.Type,
-		CreatedAt: timestamppb.New(p.CreatedAt),
+// ListPermissionSuggestions proposes rules for commands the user keeps
+// approving by hand and reports allow rules that no longer match anything.
+func (s *Server) ListPermissionSuggestions(
+	ctx context.Context,
+	req *connect.Request[taskguildv1.ListPermissionSuggestionsRequest],
+) (*connect.Response[taskguildv1.ListPermissionSuggestionsResponse], error) {
+	projectID := req.Msg.GetProjectId()
+	if projectID == "" {
+		return nil, cerr.NewError(cerr.InvalidArgument, "project_id is required", nil)
 	}
+
+	now := time.Now()
+
+	rules, err := ListLive(ctx, s.repo, projectID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		decisions []PermissionDecision
+		commands  []string
+	)
+
+	if s.history != nil {
+		if decisions, err = s.history.PermissionDecisions(ctx, projectID); err != nil {
+			return nil, err
+		}
+
+		if commands, err = s.history.BashCommands(ctx, projectID); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &taskguildv1.ListPermissionSuggestionsResponse{}
+
+	for _, sg := range Suggest(decisions, rules, int(req.Msg.GetMinApprovals())) {
+		resp.Suggestions = append(resp.Suggestions, &taskguildv1.PermissionSuggestion{
+			Pattern:   sg.Pattern,
+			Type:      sg.Type,
+			Approvals: int32(sg.Approvals),
+			Examples:  sg.Examples,
+			Risk:      sg.Risk.String(),
+		})
+	}
+
+	for _, r := range UnusedRules(rules, decisions, commands, now) {
+		resp.UnusedRules = append(resp.UnusedRules, ToProto(r))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// ToProto converts a rule to its protobuf representation.
+func ToProto(p *SingleCommandPermission) *taskguildv1.SingleCommandPermission {
+	pb := &taskguildv1.SingleCommandPermission{
+		Id:           p.ID,
+		ProjectId:    p.ProjectID,
+		Pattern:      p.Pattern,
+		Type:         p.Type,
+		CreatedAt:    timestamppb.New(p.CreatedAt),
+		Action:       NormalizeAction(p.Action),
+		Reason:       p.Reason,
+		Scope:        NormalizeScope(p.Scope),
+		TaskId:       p.TaskID,
+		WorkflowId:   p.WorkflowID,
+		StatusName:   p.StatusName,
+		OriginTaskId: p.OriginTaskID,
+		GrantedBy:    p.GrantedBy,
+	}
+	if p.ExpiresAt != nil {
+		pb.ExpiresAt = timestamppb.New(*p.ExpiresAt)
+	}
+
+	return pb
 }
@@ -0,0 +1,91 @@
+package singlecommandpermission
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
+)
+
+func TestUpdateSingleCommandPermission_KeepsActionWhenUnset(t *testing.T) {
+	repo := newMemRepo(&SingleCommandPermission{ID: "d", ProjectID: "p", Pattern: "rm *", Type: TypeCommand, Action: ActionDeny, Reason: "use trash"})
+	s := NewServer(repo, nil, nil)
+
+	_, err := s.UpdateSingleCommandPermission(t.Context(), connect.NewRequest(&taskguildv1.UpdateSingleCommandPermissionRequest{
+		Id: "d", Pattern: "rm -rf *", Type: TypeCommand,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := repo.perms["d"]
+	if got.Action != ActionDeny || got.Reason != "use trash" || got.Pattern != "rm -rf *" {
+		t.Errorf("expected the deny rule to keep its action and reason, got %+v", got)
+	}
+
+	_, err = s.UpdateSingleCommandPermission(t.Context(), connect.NewRequest(&taskguildv1.UpdateSingleCommandPermissionRequest{
+		Id: "d", Pattern: "rm -rf *", Type: TypeCommand, Action: proto.String(ActionAllow), Reason: proto.String(""),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := repo.perms["d"]; got.Action != ActionAllow || got.Reason != "" {
+		t.Errorf("expected an explicit action and reason to replace the stored ones, got %+v", got)
+	}
+}
+
+func TestCreateSingleCommandPermission_KeepsActionWhenUnset(t *testing.T) {
+	repo := newMemRepo(&SingleCommandPermission{ID: "d", ProjectID: "p", Pattern: "rm *", Type: TypeCommand, Action: ActionDeny, Reason: "use trash"})
+	s := NewServer(repo, nil, nil)
+
+	resp, err := s.CreateSingleCommandPermission(t.Context(), connect.NewRequest(&taskguildv1.CreateSingleCommandPermissionRequest{
+		ProjectId: "p", Pattern: "rm *", Type: TypeCommand,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := resp.Msg.GetPermission(); got.GetId() != "d" || got.GetAction() != ActionDeny || got.GetReason() != "use trash" {
+		t.Errorf("expected the existing deny rule to be kept, got %+v", got)
+	}
+
+	resp, err = s.CreateSingleCommandPermission(t.Context(), connect.NewRequest(&taskguildv1.CreateSingleCommandPermissionRequest{
+		ProjectId: "p", Pattern: "ls", Type: TypeCommand,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := resp.Msg.GetPermission().GetAction(); got != ActionAllow {
+		t.Errorf("expected a new rule to default to allow, got %q", got)
+	}
+}
+
+func TestCreateSingleCommandPermission_IgnoresScopedGrants(t *testing.T) {
+	expires := time.Now().Add(time.Hour)
+	repo := newMemRepo(
+		&SingleCommandPermission{ID: "task", ProjectID: "p", Pattern: "rm *", Type: TypeCommand, Scope: ScopeTask, TaskID: "t1", CreatedAt: time.Now().Add(-time.Hour)},
+		&SingleCommandPermission{ID: "timed", ProjectID: "p", Pattern: "rm *", Type: TypeCommand, ExpiresAt: &expires},
+	)
+	s := NewServer(repo, nil, nil)
+
+	resp, err := s.CreateSingleCommandPermission(t.Context(), connect.NewRequest(&taskguildv1.CreateSingleCommandPermissionRequest{
+		ProjectId: "p", Pattern: "rm *", Type: TypeCommand, Action: proto.String(ActionDeny),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := resp.Msg.GetPermission()
+	if got.GetId() == "task" || got.GetId() == "timed" || got.GetScope() != ScopeProject || got.GetExpiresAt() != nil || got.GetAction() != ActionDeny {
+		t.Errorf("expected a new permanent deny rule, got %+v", got)
+	}
+
+	if len(repo.perms) != 3 || repo.perms["task"].Action != "" || repo.perms["timed"].Action != "" {
+		t.Errorf("expected the scoped grants to be left alone, got %d rules", len(repo.perms))
+	}
+}
@@ -0,0 +1,269 @@
+package singlecommandpermission
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/kazz187/taskguild/pkg/permcheck"
+	"github.com/kazz187/taskguild/pkg/shellparse"
+)
+
+// DefaultMinApprovals is how many approvals a command needs before it is
+// suggested as a rule.
+const DefaultMinApprovals = 3
+
+// unusedRuleGrace keeps freshly created rules out of the unused list.
+const unusedRuleGrace = 7 * 24 * time.Hour
+
+// History provides the data permission suggestions are mined from.
+type History interface {
+	// PermissionDecisions returns the responded Bash permission requests of
+	// the project's recent tasks.
+	PermissionDecisions(ctx context.Context, projectID string) ([]PermissionDecision, error)
+	// BashCommands returns the command lines Bash tool calls ran in the
+	// project's recent tasks, as retained in the task logs.
+	BashCommands(ctx context.Context, projectID string) ([]string, error)
+}
+
+// PermissionDecision is a responded Bash permission request.
+type PermissionDecision struct {
+	// Metadata is the JSON metadata the agent attached to the request.
+	Metadata string
+	// Response is the user's response: "allow", "deny" or an
+	// always_allow_command JSON document.
+	Response string
+}
+
+// Suggestion is a rule proposed from repeated manual approvals.
+type Suggestion struct {
+	Pattern   string
+	Type      string
+	Approvals int
+	Examples  []string // distinct approved commands, most frequent first
+	Risk      shellparse.Risk
+}
+
+// requestMetadata is the subset of the agent's permission request metadata
+// used for mining.
+type requestMetadata struct {
+	ParsedCommands []struct {
+		Command string `json:"command"`
+		Matched bool   `json:"matched"`
+		Opaque  bool   `json:"opaque"`
+	} `json:"parsed_commands"`
+}
+
+func parseRequestMetadata(raw string) requestMetadata {
+	var m requestMetadata
+	_ = json.Unmarshal([]byte(raw), &m)
+
+	return m
+}
+
+// decisionApproved reports whether a response approved the request, and
+// whether it was a decision at all.
+func decisionApproved(response string) (approved, decided bool) {
+	switch response {
+	case "allow":
+		return true, true
+	case "deny":
+		return false, true
+	}
+
+	var aac struct {
+		Action string `json:"action"`
+	}
+	if json.Unmarshal([]byte(response), &aac) == nil && aac.Action == "always_allow_command" {
+		return true, true
+	}
+
+	return false, false
+}
+
+// commandGroup collects the variants of one command key.
+type commandGroup struct {
+	approvals int
+	denied    bool
+	counts    map[string]int
+	variants  map[string]shellparse.ParsedCommand
+}
+
+// Suggest proposes command rules for commands approved at least
+// minApprovals times and never denied. Variants of the same command are
+// generalized into one wildcard pattern. Opaque commands and commands
+// already covered by an existing rule are skipped.
+func Suggest(decisions []PermissionDecision, rules []*SingleCommandPermission, minApprovals int) []Suggestion {
+	if minApprovals <= 0 {
+		minApprovals = DefaultMinApprovals
+	}
+
+	groups := make(map[string]*commandGroup)
+
+	for _, d := range decisions {
+		approved, decided := decisionApproved(d.Response)
+		if !decided {
+			continue
+		}
+
+		for _, pc := range parseRequestMetadata(d.Metadata).ParsedCommands {
+			if pc.Matched || pc.Opaque {
+				continue
+			}
+
+			for _, cmd := range shellparse.Parse(pc.Command).Commands {
+				key := shellparse.CommandKey(cmd)
+				if cmd.Opaque || key == "" {
+					continue
+				}
+
+				g, ok := groups[key]
+				if !ok {
+					g = &commandGroup{
+						counts:   make(map[string]int),
+						variants: make(map[string]shellparse.ParsedCommand),
+					}
+					groups[key] = g
+				}
+
+				if !approved {
+					g.denied = true
+					continue
+				}
+
+				g.approvals++
+				g.counts[cmd.Raw]++
+				g.variants[cmd.Raw] = cmd
+			}
+		}
+	}
+
+	var suggestions []Suggestion
+
+	for _, g := range groups {
+		if g.denied || g.approvals < minApprovals {
+			continue
+		}
+
+		examples := make([]string, 0, len(g.counts))
+		for raw := range g.counts {
+			examples = append(examples, raw)
+		}
+
+		slices.SortFunc(examples, func(a, b string) int {
+			return cmp.Or(g.counts[b]-g.counts[a], cmp.Compare(a, b))
+		})
+
+		variants := make([]shellparse.ParsedCommand, 0, len(examples))
+		risk := shellparse.RiskReadOnly
+
+		for _, raw := range examples {
+			cmd := g.variants[raw]
+			variants = append(variants, cmd)
+			risk = max(risk, shellparse.Classify(cmd, "").Risk)
+		}
+
+		pattern := shellparse.GeneralizeCommandPattern(variants)
+		if ruleExists(rules, pattern) || allCovered(rules, examples) {
+			continue
+		}
+
+		suggestions = append(suggestions, Suggestion{
+			Pattern:   pattern,
+			Type:      TypeCommand,
+			Approvals: g.approvals,
+			Examples:  examples,
+			Risk:      risk,
+		})
+	}
+
+	slices.SortFunc(suggestions, func(a, b Suggestion) int {
+		return cmp.Or(b.Approvals-a.Approvals, cmp.Compare(a.Pattern, b.Pattern))
+	})
+
+	return suggestions
+}
+
+// ruleExists reports whether a command rule with the pattern exists.
+func ruleExists(rules []*SingleCommandPermission, pattern string) bool {
+	return slices.ContainsFunc(rules, func(r *SingleCommandPermission) bool {
+		return r.Type == TypeCommand && r.Pattern == pattern
+	})
+}
+
+// allCovered reports whether every command is already matched by a
+// permanent allow rule.
+func allCovered(rules []*SingleCommandPermission, commands []string) bool {
+	var res []*regexp.Regexp
+
+	for _, r := range rules {
+		if r.Type != TypeCommand || NormalizeAction(r.Action) != ActionAllow || !r.Permanent() {
+			continue
+		}
+
+		if re, err := permcheck.CompileWildcard(r.Pattern); err == nil {
+			res = append(res, re)
+		}
+	}
+
+	for _, c := range commands {
+		if !slices.ContainsFunc(res, func(re *regexp.Regexp) bool { return re.MatchString(c) }) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// UnusedRules returns the permanent allow rules that match none of the
+// commands seen in the permission history or the retained Bash logs.
+// Rules younger than a week are never reported.
+func UnusedRules(rules []*SingleCommandPermission, decisions []PermissionDecision, commandLines []string, now time.Time) []*SingleCommandPermission {
+	var commands, redirects []string
+
+	addLine := func(line string) {
+		for _, cmd := range shellparse.Parse(line).Commands {
+			commands = append(commands, cmd.Raw)
+			for _, r := range cmd.Redirects {
+				redirects = append(redirects, r.Path)
+			}
+		}
+	}
+
+	for _, line := range commandLines {
+		addLine(line)
+	}
+
+	for _, d := range decisions {
+		for _, pc := range parseRequestMetadata(d.Metadata).ParsedCommands {
+			addLine(pc.Command)
+		}
+	}
+
+	var unused []*SingleCommandPermission
+
+	for _, r := range rules {
+		if NormalizeAction(r.Action) != ActionAllow || !r.Permanent() || now.Sub(r.CreatedAt) < unusedRuleGrace {
+			continue
+		}
+
+		re, err := permcheck.CompileWildcard(r.Pattern)
+		if err != nil {
+			continue
+		}
+
+		candidates := commands
+		if r.Type == TypeRedirect {
+			candidates = redirects
+		}
+
+		if !slices.ContainsFunc(candidates, re.MatchString) {
+			unused = append(unused, r)
+		}
+	}
+
+	return unused
+}
@@ -0,0 +1,107 @@
+package singlecommandpermission
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+)
+
+// decision builds a permission decision for a single unmatched command.
+func decision(command, response string) PermissionDecision {
+	meta, _ := json.Marshal(map[string]any{
+		"parsed_commands": []map[string]any{{"command": command, "matched": false}},
+	})
+
+	return PermissionDecision{Metadata: string(meta), Response: response}
+}
+
+func TestSuggest(t *testing.T) {
+	decisions := []PermissionDecision{
+		decision("go test ./...", "allow"),
+		decision("go test ./...", "allow"),
+		decision("go test -v ./pkg/...", "allow"),
+		decision("make lint", `{"action":"always_allow_command","rules":[]}`),
+		decision("make lint", "allow"),
+		decision("make lint", "allow"),
+		decision("rm -rf build", "allow"),
+		decision("rm -rf build", "allow"),
+		decision("rm -rf build", "deny"),
+		decision("ls", "allow"),
+		decision("ls", "allow"),
+		decision("eval $CMD", "allow"),
+		decision("eval $CMD", "allow"),
+		decision("eval $CMD", "allow"),
+	}
+
+	got := Suggest(decisions, nil, 0)
+
+	var patterns []string
+	for _, s := range got {
+		patterns = append(patterns, s.Pattern)
+	}
+
+	if want := []string{"go test *", "make lint"}; !slices.Equal(patterns, want) {
+		t.Fatalf("patterns = %v, want %v", patterns, want)
+	}
+
+	if got[0].Approvals != 3 {
+		t.Errorf("approvals = %d, want 3", got[0].Approvals)
+	}
+
+	if want := []string{"go test ./...", "go test -v ./pkg/..."}; !slices.Equal(got[0].Examples, want) {
+		t.Errorf("examples = %v, want %v", got[0].Examples, want)
+	}
+
+	if got := Suggest(decisions, nil, 2); len(got) != 3 {
+		t.Errorf("min approvals 2: got %d suggestions, want 3", len(got))
+	}
+}
+
+func TestSuggest_SkipsExistingRules(t *testing.T) {
+	decisions := []PermissionDecision{
+		decision("go test ./...", "allow"),
+		decision("go test -v ./...", "allow"),
+		decision("go test -race ./...", "allow"),
+		decision("make lint", "allow"),
+		decision("make lint", "allow"),
+		decision("make lint", "allow"),
+	}
+	rules := []*SingleCommandPermission{
+		{Pattern: "go test *", Type: TypeCommand, Action: ActionDeny},
+		{Pattern: "make *", Type: TypeCommand},
+	}
+
+	if got := Suggest(decisions, rules, 0); len(got) != 0 {
+		t.Errorf("got %v, want no suggestions", got)
+	}
+}
+
+func TestUnusedRules(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	old := now.Add(-30 * 24 * time.Hour)
+	expires := now.Add(time.Hour)
+
+	rules := []*SingleCommandPermission{
+		{ID: "used", Pattern: "go test *", Type: TypeCommand, CreatedAt: old},
+		{ID: "from-request", Pattern: "make *", Type: TypeCommand, CreatedAt: old},
+		{ID: "unused", Pattern: "npm run *", Type: TypeCommand, CreatedAt: old},
+		{ID: "fresh", Pattern: "cargo *", Type: TypeCommand, CreatedAt: now.Add(-time.Hour)},
+		{ID: "deny", Pattern: "rm -rf *", Type: TypeCommand, Action: ActionDeny, CreatedAt: old},
+		{ID: "temporary", Pattern: "yarn *", Type: TypeCommand, CreatedAt: old, ExpiresAt: &expires},
+		{ID: "redirect-used", Pattern: "/tmp/*", Type: TypeRedirect, CreatedAt: old},
+		{ID: "redirect-unused", Pattern: "/var/*", Type: TypeRedirect, CreatedAt: old},
+	}
+
+	decisions := []PermissionDecision{decision("make build", "deny")}
+	commands := []string{"go test ./... > /tmp/out.txt"}
+
+	var ids []string
+	for _, r := range UnusedRules(rules, decisions, commands, now) {
+		ids = append(ids, r.ID)
+	}
+
+	if want := []string{"unused", "redirect-unused"}; !slices.Equal(ids, want) {
+		t.Errorf("unused = %v, want %v", ids, want)
+	}
+}
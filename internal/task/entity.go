@@ -1,6 +1,10 @@
 package task
 
-import "time"
+import (
+	"errors"
+	"strings"
+	"time"
+)
 
 type AssignmentStatus string
 
@@ -22,6 +26,29 @@ const (
 	PendingReasonRetryBackoff     = "retry_backoff"
 )
 
+// MetaCompactRequested marks a task whose next run should start a fresh
+// session seeded with a handoff summary instead of resuming its session.
+const MetaCompactRequested = "_compact_requested"
+
+// MetaFileOverlaps lists (as JSON) the other active tasks modifying some of
+// the same files as this task in a different worktree.
+const MetaFileOverlaps = "_file_overlaps"
+
+// Merge queue metadata keys and values.
+const (
+	MetaMergeStatus      = "_merge_status"
+	MetaMergeQueuedAt    = "_merge_queued_at"
+	MetaMergeError       = "_merge_error"
+	MetaMergedCommit     = "_merged_commit"
+	MetaMergeFixupTaskID = "_merge_fixup_task_id" // fix-up task created for a failed merge
+	MetaMergeFixupFor    = "_merge_fixup_for"     // task whose failed merge a fix-up task repairs
+
+	MergeStatusQueued  = "queued"
+	MergeStatusMerging = "merging"
+	MergeStatusMerged  = "merged"
+	MergeStatusFailed  = "failed"
+)
+
 // ClearPendingReason removes all pending-reason metadata keys from the map.
 func ClearPendingReason(metadata map[string]string) {
 	delete(metadata, MetaPendingReason)
@@ -43,7 +70,26 @@ type Task struct {
 	UseWorktree      bool              `yaml:"use_worktree"`
 	// Effort overrides WorkflowStatus.effort when non-empty.
 	// Valid values: "low", "medium", "high", "xhigh", "max". Empty = inherit from WorkflowStatus.
-	Effort    string    `yaml:"effort,omitempty"`
-	CreatedAt time.Time `yaml:"created_at"`
-	UpdatedAt time.Time `yaml:"updated_at"`
+	Effort string `yaml:"effort,omitempty"`
+	// WorktreeBaseRef (branch, tag or commit) or the worktree of
+	// WorktreeBaseTaskID is the start point of a new worktree. Both empty =
+	// the project's default branch.
+	WorktreeBaseRef    string    `yaml:"worktree_base_ref,omitempty"`
+	WorktreeBaseTaskID string    `yaml:"worktree_base_task_id,omitempty"`
+	CreatedAt          time.Time `yaml:"created_at"`
+	UpdatedAt          time.Time `yaml:"updated_at"`
+}
+
+// ValidateWorktreeBase checks the worktree base fields of a task. The ref is
+// passed to git, so it must not look like an option.
+func ValidateWorktreeBase(ref, baseTaskID string) error {
+	if ref != "" && baseTaskID != "" {
+		return errors.New("worktree_base_ref and worktree_base_task_id are mutually exclusive")
+	}
+
+	if strings.HasPrefix(ref, "-") || strings.ContainsAny(ref, " \t\n") {
+		return errors.New("invalid worktree_base_ref")
+	}
+
+	return nil
 }
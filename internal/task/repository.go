@@ -7,6 +7,9 @@ type Repository interface {
 	Get(ctx context.Context, id string) (*Task, error)
 	List(ctx context.Context, projectID, workflowID, statusID string, limit, offset int) ([]*Task, int, error)
 	Update(ctx context.Context, t *Task) error
+	// UpdateMetadata sets the given metadata keys on the stored task,
+	// leaving every other field as stored. An empty value deletes the key.
+	UpdateMetadata(ctx context.Context, id string, metadata map[string]string) (*Task, error)
 	Delete(ctx context.Context, id string) error
 	Claim(ctx context.Context, taskID string, agentID string) (*Task, error)
 	// ReleaseByAgent unassigns all tasks currently assigned to the given agent,
@@ -31,6 +31,7 @@ var knownSubdirs = map[string]bool{
 
 type YAMLRepository struct {
 	storage storage.Storage
+	// claimMu serializes the read-modify-write of Claim and UpdateMetadata.
 	claimMu sync.Mutex
 
 	// In-memory cache for active tasks, lazily loaded on first access.
@@ -228,6 +229,36 @@ func (r *YAMLRepository) Update(ctx context.Context, t *task.Task) error {
 	return nil
 }
 
+func (r *YAMLRepository) UpdateMetadata(ctx context.Context, id string, metadata map[string]string) (*task.Task, error) {
+	r.claimMu.Lock()
+	defer r.claimMu.Unlock()
+
+	t, err := r.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Metadata == nil {
+		t.Metadata = make(map[string]string, len(metadata))
+	}
+
+	for k, v := range metadata {
+		if v == "" {
+			delete(t.Metadata, k)
+		} else {
+			t.Metadata[k] = v
+		}
+	}
+
+	t.UpdatedAt = time.Now()
+
+	if err := r.Update(ctx, t); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
 func (r *YAMLRepository) Delete(ctx context.Context, id string) error {
 	r.ensureCache(ctx)
 
@@ -44,6 +44,30 @@ type TaskResumer interface {
 	RequestTaskResume(ctx context.Context, t *Task) error
 }
 
+// TaskCompactor asks the agent running a task to continue it in a fresh
+// session seeded with a handoff summary.
+type TaskCompactor interface {
+	RequestTaskCompact(taskID string, assignedAgentID string) error
+}
+
+// TaskRollbacker asks an agent to restore a task's worktree to a checkpoint.
+// Returns the request ID reported back with the result.
+type TaskRollbacker interface {
+	RequestTaskRollback(ctx context.Context, t *Task, checkpointID string, resume bool) (string, error)
+}
+
+// DiffSnapshotter records the diff of a task's worktree in the task logs when
+// the task changes status. It must not block.
+type DiffSnapshotter interface {
+	SnapshotTaskDiff(t *Task, fromStatus, toStatus string)
+}
+
+// MergeEnqueuer queues the worktree branch of a task that reached a terminal
+// status for merging into the project's default branch.
+type MergeEnqueuer interface {
+	EnqueueMerge(ctx context.Context, t *Task)
+}
+
 // DescriptionLogger records a snapshot when a task's description changes.
 type DescriptionLogger interface {
 	LogDescriptionChange(ctx context.Context, projectID, taskID, newDescription string) error
@@ -59,6 +83,10 @@ type Server struct {
 	resumer          TaskResumer
 	descLogger       DescriptionLogger
 	imageStore       ImageStore
+	compactor        TaskCompactor
+	rollbacker       TaskRollbacker
+	diffSnapshotter  DiffSnapshotter
+	mergeEnqueuer    MergeEnqueuer
 }
 
 func NewServer(repo Repository, workflowRepo workflow.Repository, eventBus *eventbus.Bus, stopper TaskStopper, resumer TaskResumer, cascadeArchivers []CascadeArchiver, descLogger DescriptionLogger, cascadeDeleters ...CascadeDeleter) *Server {
@@ -79,6 +107,28 @@ func (s *Server) SetImageStore(store ImageStore) {
 	s.imageStore = store
 }
 
+// SetTaskCompactor sets the compactor used by CompactTask for running tasks.
+func (s *Server) SetTaskCompactor(compactor TaskCompactor) {
+	s.compactor = compactor
+}
+
+// SetTaskRollbacker sets the rollbacker used by RollbackTask.
+func (s *Server) SetTaskRollbacker(rollbacker TaskRollbacker) {
+	s.rollbacker = rollbacker
+}
+
+// SetDiffSnapshotter sets the snapshotter called on every status transition
+// of a task with a worktree.
+func (s *Server) SetDiffSnapshotter(snapshotter DiffSnapshotter) {
+	s.diffSnapshotter = snapshotter
+}
+
+// SetMergeEnqueuer sets the merge queue fed by tasks with a worktree that
+// reach a terminal status.
+func (s *Server) SetMergeEnqueuer(enqueuer MergeEnqueuer) {
+	s.mergeEnqueuer = enqueuer
+}
+
 // CreateTaskInput is the proto-independent argument to CreateTaskInternal.
 // Allows scheduler / tests to invoke the create flow without constructing a
 // connect.Request.
@@ -92,6 +142,10 @@ type CreateTaskInput struct {
 	UseWorktree bool
 	Effort      string
 	Metadata    map[string]string
+	// WorktreeBaseRef / WorktreeBaseTaskID choose the start point of the
+	// task's worktree; at most one may be set.
+	WorktreeBaseRef    string
+	WorktreeBaseTaskID string
 }
 
 // CreateTaskInternal performs the same business logic as the CreateTask
@@ -103,6 +157,10 @@ func (s *Server) CreateTaskInternal(ctx context.Context, in CreateTaskInput) (*T
 		return nil, err
 	}
 
+	if err := s.validateWorktreeBase(ctx, in.ProjectID, in.WorktreeBaseRef, in.WorktreeBaseTaskID); err != nil {
+		return nil, err
+	}
+
 	var statusID string
 
 	if in.StatusID != "" {
@@ -137,18 +195,20 @@ func (s *Server) CreateTaskInternal(ctx context.Context, in CreateTaskInput) (*T
 	now := time.Now()
 
 	t := &Task{
-		ID:               ulid.Make().String(),
-		ProjectID:        in.ProjectID,
-		WorkflowID:       in.WorkflowID,
-		Title:            in.Title,
-		Description:      in.Description,
-		StatusID:         statusID,
-		AssignmentStatus: AssignmentStatusUnassigned,
-		Metadata:         in.Metadata,
-		UseWorktree:      in.UseWorktree,
-		Effort:           in.Effort,
-		CreatedAt:        now,
-		UpdatedAt:        now,
+		ID:                 ulid.Make().String(),
+		ProjectID:          in.ProjectID,
+		WorkflowID:         in.WorkflowID,
+		Title:              in.Title,
+		Description:        in.Description,
+		StatusID:           statusID,
+		AssignmentStatus:   AssignmentStatusUnassigned,
+		Metadata:           in.Metadata,
+		UseWorktree:        in.UseWorktree,
+		Effort:             in.Effort,
+		WorktreeBaseRef:    in.WorktreeBaseRef,
+		WorktreeBaseTaskID: in.WorktreeBaseTaskID,
+		CreatedAt:          now,
+		UpdatedAt:          now,
 	}
 	if err := s.repo.Create(ctx, t); err != nil {
 		return nil, err
@@ -173,6 +233,9 @@ func (s *Server) CreateTask(ctx context.Context, req *connect.Request[taskguildv
 		UseWorktree: req.Msg.GetUseWorktree(),
 		Effort:      req.Msg.GetEffort(),
 		Metadata:    req.Msg.GetMetadata(),
+
+		WorktreeBaseRef:    req.Msg.GetWorktreeBaseRef(),
+		WorktreeBaseTaskID: req.Msg.GetWorktreeBaseTaskId(),
 	}
 	if req.Msg.StatusId != nil {
 		in.StatusID = req.Msg.GetStatusId()
@@ -267,6 +330,23 @@ func (s *Server) UpdateTask(ctx context.Context, req *connect.Request[taskguildv
 		t.Effort = req.Msg.GetEffort()
 	}
 
+	if req.Msg.WorktreeBaseRef != nil || req.Msg.WorktreeBaseTaskId != nil {
+		ref, baseTaskID := t.WorktreeBaseRef, t.WorktreeBaseTaskID
+		if req.Msg.WorktreeBaseRef != nil {
+			ref = req.Msg.GetWorktreeBaseRef()
+		}
+
+		if req.Msg.WorktreeBaseTaskId != nil {
+			baseTaskID = req.Msg.GetWorktreeBaseTaskId()
+		}
+
+		if err := s.validateWorktreeBase(ctx, t.ProjectID, ref, baseTaskID); err != nil {
+			return nil, err
+		}
+
+		t.WorktreeBaseRef, t.WorktreeBaseTaskID = ref, baseTaskID
+	}
+
 	t.UpdatedAt = time.Now()
 	if err := s.repo.Update(ctx, t); err != nil {
 		return nil, err
@@ -353,11 +433,13 @@ func (s *Server) UpdateTaskStatus(ctx context.Context, req *connect.Request[task
 	}
 
 	// Validate target status exists in the workflow.
-	targetExists := false
+	targetExists, targetTerminal := false, false
 
 	for i := range wf.Statuses {
 		if wf.Statuses[i].Name == req.Msg.GetStatusId() {
 			targetExists = true
+			targetTerminal = wf.Statuses[i].IsTerminal
+
 			break
 		}
 	}
@@ -422,6 +504,14 @@ func (s *Server) UpdateTaskStatus(ctx context.Context, req *connect.Request[task
 		},
 	)
 
+	if s.diffSnapshotter != nil && t.Metadata["worktree"] != "" {
+		s.diffSnapshotter.SnapshotTaskDiff(t, currentStatus.Name, t.StatusID)
+	}
+
+	if s.mergeEnqueuer != nil && targetTerminal && t.Metadata["worktree"] != "" {
+		s.mergeEnqueuer.EnqueueMerge(ctx, t)
+	}
+
 	return connect.NewResponse(&taskguildv1.UpdateTaskStatusResponse{
 		Task: toProto(t),
 	}), nil
@@ -546,6 +636,89 @@ func (s *Server) ResumeTask(ctx context.Context, req *connect.Request[taskguildv
 	}), nil
 }
 
+func (s *Server) CompactTask(ctx context.Context, req *connect.Request[taskguildv1.CompactTaskRequest]) (*connect.Response[taskguildv1.CompactTaskResponse], error) {
+	t, err := s.repo.Get(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// A running task is compacted by its agent before the next turn.
+	if t.AssignmentStatus == AssignmentStatusAssigned && t.AssignedAgentID != "" && s.compactor != nil {
+		err := s.compactor.RequestTaskCompact(t.ID, t.AssignedAgentID)
+		if err == nil {
+			return connect.NewResponse(&taskguildv1.CompactTaskResponse{
+				Task: toProto(t),
+			}), nil
+		}
+
+		slog.Warn("failed to send compact command to agent, deferring to next run",
+			"task_id", t.ID,
+			"agent_id", t.AssignedAgentID,
+			"error", err,
+		)
+	}
+
+	// Otherwise the flag is picked up when the task runs next.
+	if t.Metadata == nil {
+		t.Metadata = make(map[string]string)
+	}
+
+	t.Metadata[MetaCompactRequested] = "true"
+
+	t.UpdatedAt = time.Now()
+	if err := s.repo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+
+	s.eventBus.PublishNew(
+		taskguildv1.EventType_EVENT_TYPE_TASK_UPDATED,
+		t.ID,
+		"",
+		map[string]string{
+			"project_id":  t.ProjectID,
+			"workflow_id": t.WorkflowID,
+			"reason":      "compact_requested",
+		},
+	)
+
+	return connect.NewResponse(&taskguildv1.CompactTaskResponse{
+		Task: toProto(t),
+	}), nil
+}
+
+func (s *Server) RollbackTask(ctx context.Context, req *connect.Request[taskguildv1.RollbackTaskRequest]) (*connect.Response[taskguildv1.RollbackTaskResponse], error) {
+	if req.Msg.GetCheckpointId() == "" {
+		return nil, cerr.NewError(cerr.InvalidArgument, "checkpoint_id is required", nil).ConnectError()
+	}
+
+	if s.rollbacker == nil {
+		return nil, cerr.NewError(cerr.Unimplemented, "rollback is not available", nil).ConnectError()
+	}
+
+	t, err := s.repo.Get(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// A pending task may be claimed and start running at any moment.
+	if t.AssignmentStatus != AssignmentStatusUnassigned {
+		return nil, cerr.NewError(
+			cerr.FailedPrecondition,
+			"task is queued or running; stop it before rolling back",
+			nil,
+		).ConnectError()
+	}
+
+	requestID, err := s.rollbacker.RequestTaskRollback(ctx, t, req.Msg.GetCheckpointId(), req.Msg.GetResume())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&taskguildv1.RollbackTaskResponse{
+		RequestId: requestID,
+	}), nil
+}
+
 func (s *Server) ArchiveTask(ctx context.Context, req *connect.Request[taskguildv1.ArchiveTaskRequest]) (*connect.Response[taskguildv1.ArchiveTaskResponse], error) {
 	// Get task before archiving for response and event metadata.
 	t, err := s.repo.Get(ctx, req.Msg.GetId())
@@ -706,20 +879,46 @@ func (s *Server) ListArchivedTasks(ctx context.Context, req *connect.Request[tas
 
 func toProto(t *Task) *taskguildv1.Task {
 	return &taskguildv1.Task{
-		Id:               t.ID,
-		ProjectId:        t.ProjectID,
-		WorkflowId:       t.WorkflowID,
-		Title:            t.Title,
-		Description:      t.Description,
-		StatusId:         t.StatusID,
-		AssignedAgentId:  t.AssignedAgentID,
-		AssignmentStatus: assignmentStatusToProto(t.AssignmentStatus),
-		Metadata:         t.Metadata,
-		UseWorktree:      t.UseWorktree,
-		Effort:           t.Effort,
-		CreatedAt:        timestamppb.New(t.CreatedAt),
-		UpdatedAt:        timestamppb.New(t.UpdatedAt),
+		Id:                 t.ID,
+		ProjectId:          t.ProjectID,
+		WorkflowId:         t.WorkflowID,
+		Title:              t.Title,
+		Description:        t.Description,
+		StatusId:           t.StatusID,
+		AssignedAgentId:    t.AssignedAgentID,
+		AssignmentStatus:   assignmentStatusToProto(t.AssignmentStatus),
+		Metadata:           t.Metadata,
+		UseWorktree:        t.UseWorktree,
+		Effort:             t.Effort,
+		WorktreeBaseRef:    t.WorktreeBaseRef,
+		WorktreeBaseTaskId: t.WorktreeBaseTaskID,
+		CreatedAt:          timestamppb.New(t.CreatedAt),
+		UpdatedAt:          timestamppb.New(t.UpdatedAt),
+	}
+}
+
+// validateWorktreeBase checks the worktree base of a task in projectID. The
+// base task may be archived but must belong to the same project.
+func (s *Server) validateWorktreeBase(ctx context.Context, projectID, ref, baseTaskID string) error {
+	if err := ValidateWorktreeBase(ref, baseTaskID); err != nil {
+		return cerr.NewError(cerr.InvalidArgument, err.Error(), err).ConnectError()
+	}
+
+	if baseTaskID == "" {
+		return nil
 	}
+
+	base, err := s.repo.Get(ctx, baseTaskID)
+	if err != nil {
+		base, err = s.repo.GetArchived(ctx, baseTaskID)
+	}
+
+	if err != nil || base.ProjectID != projectID {
+		return cerr.NewError(cerr.InvalidArgument,
+			fmt.Sprintf("worktree base task %q not found in project", baseTaskID), err).ConnectError()
+	}
+
+	return nil
 }
 
 // statusHasAgent returns true if the given status has an agent configured,
@@ -0,0 +1,164 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/kazz187/taskguild/pkg/storage"
+)
+
+// TranscriptMeta is the metadata for a stored Claude session transcript.
+type TranscriptMeta struct {
+	SessionID        string    `yaml:"session_id"`
+	SizeBytes        int64     `yaml:"size_bytes"`        // stored (gzip-compressed) size
+	UncompressedSize int64     `yaml:"uncompressed_size"` // size of the JSONL transcript
+	UpdatedAt        time.Time `yaml:"updated_at"`
+}
+
+// TranscriptStore stores Claude session transcripts uploaded by agents so a
+// task can resume its session on a different agent-manager.
+type TranscriptStore interface {
+	Put(ctx context.Context, projectID, taskID, sessionID string, data []byte, uncompressedSize int64) (*TranscriptMeta, error)
+	// Get returns storage.ErrNotFound if no transcript is stored.
+	Get(ctx context.Context, projectID, taskID, sessionID string) (*TranscriptMeta, []byte, error)
+	// CleanupOlderThan removes transcripts not updated within maxAge.
+	// Returns the number of deleted transcripts.
+	CleanupOlderThan(ctx context.Context, maxAge time.Duration) (int, error)
+}
+
+// storageTranscriptStore implements TranscriptStore using the storage.Storage interface.
+type storageTranscriptStore struct {
+	store storage.Storage
+}
+
+// NewTranscriptStore creates a TranscriptStore backed by the given storage.
+func NewTranscriptStore(store storage.Storage) TranscriptStore {
+	return &storageTranscriptStore{store: store}
+}
+
+// DefaultMaxTranscriptSizeBytes is the default limit for a single stored
+// (compressed) transcript.
+const DefaultMaxTranscriptSizeBytes = 20 * 1024 * 1024 // 20MB
+
+func transcriptDataPath(projectID, taskID, sessionID string) string {
+	return fmt.Sprintf("projects/%s/%s/sessions/%s.jsonl.gz", projectID, taskID, sessionID)
+}
+
+func transcriptMetaPath(projectID, taskID, sessionID string) string {
+	return fmt.Sprintf("projects/%s/%s/sessions/%s.meta.yaml", projectID, taskID, sessionID)
+}
+
+// validSessionID rejects IDs that could escape the sessions directory.
+func validSessionID(sessionID string) bool {
+	return sessionID != "" && !strings.ContainsAny(sessionID, `/\`) && !strings.Contains(sessionID, "..")
+}
+
+func (s *storageTranscriptStore) Put(ctx context.Context, projectID, taskID, sessionID string, data []byte, uncompressedSize int64) (*TranscriptMeta, error) {
+	if !validSessionID(sessionID) {
+		return nil, fmt.Errorf("invalid session id %q", sessionID)
+	}
+
+	meta := &TranscriptMeta{
+		SessionID:        sessionID,
+		SizeBytes:        int64(len(data)),
+		UncompressedSize: uncompressedSize,
+		UpdatedAt:        time.Now().UTC(),
+	}
+
+	if err := s.store.Write(ctx, transcriptDataPath(projectID, taskID, sessionID), data); err != nil {
+		return nil, fmt.Errorf("write transcript data: %w", err)
+	}
+
+	metaBytes, err := yaml.Marshal(meta)
+	if err != nil {
+		return nil, fmt.Errorf("marshal transcript meta: %w", err)
+	}
+
+	if err := s.store.Write(ctx, transcriptMetaPath(projectID, taskID, sessionID), metaBytes); err != nil {
+		return nil, fmt.Errorf("write transcript meta: %w", err)
+	}
+
+	return meta, nil
+}
+
+func (s *storageTranscriptStore) Get(ctx context.Context, projectID, taskID, sessionID string) (*TranscriptMeta, []byte, error) {
+	if !validSessionID(sessionID) {
+		return nil, nil, storage.ErrNotFound
+	}
+
+	metaBytes, err := s.store.Read(ctx, transcriptMetaPath(projectID, taskID, sessionID))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var meta TranscriptMeta
+	if err := yaml.Unmarshal(metaBytes, &meta); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal transcript meta: %w", err)
+	}
+
+	data, err := s.store.Read(ctx, transcriptDataPath(projectID, taskID, sessionID))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &meta, data, nil
+}
+
+func (s *storageTranscriptStore) CleanupOlderThan(ctx context.Context, maxAge time.Duration) (int, error) {
+	cutoff := time.Now().Add(-maxAge)
+
+	projectDirs, err := s.store.ListDirs(ctx, "projects/")
+	if err != nil {
+		return 0, fmt.Errorf("list projects: %w", err)
+	}
+
+	deleted := 0
+
+	for _, projectDir := range projectDirs {
+		taskDirs, err := s.store.ListDirs(ctx, strings.TrimSuffix(projectDir, "/")+"/")
+		if err != nil {
+			continue
+		}
+
+		for _, taskDir := range taskDirs {
+			files, err := s.store.List(ctx, strings.TrimSuffix(taskDir, "/")+"/sessions/")
+			if err != nil {
+				continue
+			}
+
+			for _, f := range files {
+				if !strings.HasSuffix(f, ".meta.yaml") {
+					continue
+				}
+
+				metaBytes, err := s.store.Read(ctx, f)
+				if err != nil {
+					continue
+				}
+
+				var meta TranscriptMeta
+				if yaml.Unmarshal(metaBytes, &meta) != nil || meta.UpdatedAt.After(cutoff) {
+					continue
+				}
+
+				dataPath := strings.TrimSuffix(f, ".meta.yaml") + ".jsonl.gz"
+				if err := s.store.Delete(ctx, dataPath); err != nil && !errors.Is(err, storage.ErrNotFound) {
+					continue
+				}
+
+				if err := s.store.Delete(ctx, f); err != nil && !errors.Is(err, storage.ErrNotFound) {
+					continue
+				}
+
+				deleted++
+			}
+		}
+	}
+
+	return deleted, nil
+}
@@ -42,6 +42,7 @@ var categoryNames = map[int32]string{
 	0: "unspecified", 1: "turn_start", 2: "turn_end", 3: "status_change",
 	4: "hook", 5: "stderr", 6: "error", 7: "system",
 	8: "tool_use", 9: "agent_output", 10: "directive", 11: "result",
+	12: "checkpoint", 13: "diff",
 }
 
 var categoryValues map[string]int32
@@ -375,3 +375,37 @@ func TestMissingJSONLEvictedFromIndex(t *testing.T) {
 		t.Fatalf("id %s still present in locationIndex after eviction", log.ID)
 	}
 }
+
+// TestCategoriesRoundTrip verifies that every category survives being
+// written to and read back from a JSONL file.
+func TestCategoriesRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	repo := NewJSONLRepository(dir, nil)
+
+	for v := range taskguildv1.TaskLogCategory_name {
+		if err := repo.Create(ctx, createLog("proj1", "task1", v, "entry")); err != nil {
+			t.Fatalf("create category %d: %v", v, err)
+		}
+	}
+
+	repo.Close()
+
+	// A fresh repository reads the categories back from disk.
+	logs, _, err := NewJSONLRepository(dir, nil).List(ctx, "task1", nil, 0, 0)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+
+	got := make(map[int32]bool, len(logs))
+	for _, l := range logs {
+		got[l.Category] = true
+	}
+
+	for v, name := range taskguildv1.TaskLogCategory_name {
+		if !got[v] {
+			t.Errorf("category %s was not read back", name)
+		}
+	}
+}
@@ -64,7 +64,7 @@ func (s *Server) ListTaskLogs(ctx context.Context, req *connect.Request[taskguil
 
 	protos := make([]*taskguildv1.TaskLog, len(logs))
 	for i, l := range logs {
-		protos[i] = toProto(l)
+		protos[i] = ToProto(l)
 	}
 
 	return connect.NewResponse(&taskguildv1.ListTaskLogsResponse{
@@ -79,7 +79,8 @@ func (s *Server) ListTaskLogs(ctx context.Context, req *connect.Request[taskguil
 	}), nil
 }
 
-func toProto(l *TaskLog) *taskguildv1.TaskLog {
+// ToProto converts a TaskLog to its protobuf representation.
+func ToProto(l *TaskLog) *taskguildv1.TaskLog {
 	return &taskguildv1.TaskLog{
 		Id:        l.ID,
 		TaskId:    l.TaskID,
@@ -1,6 +1,10 @@
 package workflow
 
-import "time"
+import (
+	"time"
+
+	"github.com/kazz187/taskguild/pkg/permcheck"
+)
 
 type Workflow struct {
 	ID           string        `yaml:"id"`
@@ -74,11 +78,33 @@ type Status struct {
 	SkillIDs        []string `yaml:"skill_ids,omitempty"`
 	Effort          string   `yaml:"effort,omitempty"` // "low" / "medium" / "high" / "xhigh" / "max"
 
+	// FallbackModels are tried in order when the primary model is
+	// overloaded or rate-limited.
+	FallbackModels []string `yaml:"fallback_models,omitempty"`
+
+	// Sandbox confines Bash commands spawned by the agent.
+	Sandbox *SandboxConfig `yaml:"sandbox,omitempty"`
+
+	// PathPolicy restricts where edit tools and shell redirects may write.
+	// A non-empty Allow replaces the project's allow list; Deny adds to the
+	// project's.
+	PathPolicy *permcheck.PathPolicy `yaml:"path_policy,omitempty"`
+
 	// Skill-based harness: appends failure patterns to Skill files.
 	EnableSkillHarness             bool `yaml:"enable_skill_harness"`
 	SkillHarnessExplicitlyDisabled bool `yaml:"skill_harness_explicitly_disabled,omitempty"`
 }
 
+// SandboxConfig configures the Linux sandbox for agent-spawned commands.
+type SandboxConfig struct {
+	Enabled         bool     `yaml:"enabled"`
+	AllowNetwork    bool     `yaml:"allow_network"`
+	CPULimitSeconds int32    `yaml:"cpu_limit_seconds,omitempty"`
+	MemoryLimitMB   int32    `yaml:"memory_limit_mb,omitempty"`
+	TimeoutSeconds  int32    `yaml:"timeout_seconds,omitempty"`
+	WritablePaths   []string `yaml:"writable_paths,omitempty"`
+}
+
 // FindAgentIDForStatus returns the agent ID configured for the given status.
 // It first checks the status-level AgentID field, then falls back to the
 // legacy AgentConfig list on the workflow. Returns "" if no agent is configured.
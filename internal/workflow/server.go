@@ -10,6 +10,7 @@ import (
 	"github.com/oklog/ulid/v2"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/kazz187/taskguild/internal/permission"
 	taskguildv1 "github.com/kazz187/taskguild/proto/gen/go/taskguild/v1"
 	"github.com/kazz187/taskguild/proto/gen/go/taskguild/v1/taskguildv1connect"
 )
@@ -202,6 +203,9 @@ func statusToProto(s Status) *taskguildv1.WorkflowStatus {
 		EnableSkillHarness:             s.EnableSkillHarness,
 		SkillHarnessExplicitlyDisabled: s.SkillHarnessExplicitlyDisabled,
 		Effort:                         s.Effort,
+		FallbackModels:                 s.FallbackModels,
+		Sandbox:                        sandboxToProto(s.Sandbox),
+		PathPolicy:                     permission.PathPolicyToProto(s.PathPolicy),
 	}
 	for _, h := range s.Hooks {
 		pb.Hooks = append(pb.Hooks, hookToProto(h))
@@ -210,6 +214,21 @@ func statusToProto(s Status) *taskguildv1.WorkflowStatus {
 	return pb
 }
 
+func sandboxToProto(c *SandboxConfig) *taskguildv1.SandboxConfig {
+	if c == nil {
+		return nil
+	}
+
+	return &taskguildv1.SandboxConfig{
+		Enabled:         c.Enabled,
+		AllowNetwork:    c.AllowNetwork,
+		CpuLimitSeconds: c.CPULimitSeconds,
+		MemoryLimitMb:   c.MemoryLimitMB,
+		TimeoutSeconds:  c.TimeoutSeconds,
+		WritablePaths:   c.WritablePaths,
+	}
+}
+
 func hookToProto(h StatusHook) *taskguildv1.StatusHook {
 	return &taskguildv1.StatusHook{
 		Id:         h.ID,
@@ -286,6 +305,9 @@ func statusFromProto(ps *taskguildv1.WorkflowStatus) Status {
 		EnableSkillHarness:             ps.GetEnableSkillHarness(),
 		SkillHarnessExplicitlyDisabled: ps.GetSkillHarnessExplicitlyDisabled(),
 		Effort:                         ps.GetEffort(),
+		FallbackModels:                 ps.GetFallbackModels(),
+		Sandbox:                        sandboxFromProto(ps.GetSandbox()),
+		PathPolicy:                     permission.PathPolicyFromProto(ps.GetPathPolicy()),
 	}
 	for _, ph := range ps.GetHooks() {
 		s.Hooks = append(s.Hooks, hookFromProto(ph))
@@ -294,6 +316,21 @@ func statusFromProto(ps *taskguildv1.WorkflowStatus) Status {
 	return s
 }
 
+func sandboxFromProto(pc *taskguildv1.SandboxConfig) *SandboxConfig {
+	if pc == nil {
+		return nil
+	}
+
+	return &SandboxConfig{
+		Enabled:         pc.GetEnabled(),
+		AllowNetwork:    pc.GetAllowNetwork(),
+		CPULimitSeconds: pc.GetCpuLimitSeconds(),
+		MemoryLimitMB:   pc.GetMemoryLimitMb(),
+		TimeoutSeconds:  pc.GetTimeoutSeconds(),
+		WritablePaths:   pc.GetWritablePaths(),
+	}
+}
+
 func hookFromProto(ph *taskguildv1.StatusHook) StatusHook {
 	id := ph.GetId()
 	if id == "" {
@@ -0,0 +1,377 @@
+package permcheck
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kazz187/taskguild/pkg/shellparse"
+)
+
+// WildcardToRegex converts a wildcard (glob-like) pattern to a Go regular expression.
+// The only wildcard character is `*`, which matches zero or more arbitrary characters.
+// All other characters are escaped so they are treated literally.
+func WildcardToRegex(pattern string) string {
+	parts := strings.Split(pattern, "*")
+	for i, p := range parts {
+		parts[i] = regexp.QuoteMeta(p)
+	}
+
+	return "(?s)^" + strings.Join(parts, ".*") + "$"
+}
+
+// CompileWildcard converts a wildcard pattern string to a compiled *regexp.Regexp.
+// Returns an error if the pattern is empty or the resulting regex is invalid.
+func CompileWildcard(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, errors.New("empty pattern")
+	}
+
+	return regexp.Compile(WildcardToRegex(pattern))
+}
+
+// Single-command rule types.
+const (
+	TypeCommand  = "command"
+	TypeRedirect = "redirect"
+)
+
+// CommandRule is a single-command permission rule: a wildcard pattern
+// matched against each command (or redirect target) of a one-liner.
+type CommandRule struct {
+	ID      string
+	Pattern string
+	Type    string // TypeCommand or TypeRedirect
+	Deny    bool
+	Reason  string
+
+	// Grants may be limited to a task or a workflow status and may expire.
+	Scope      string // "project" (default), "task" or "status"
+	TaskID     string
+	WorkflowID string
+	StatusName string
+	ExpiresAt  time.Time // zero means no expiry
+
+	regex *regexp.Regexp
+}
+
+// Compile compiles the rule's pattern so it can be matched.
+func (r *CommandRule) Compile() error {
+	re, err := CompileWildcard(r.Pattern)
+	if err != nil {
+		return err
+	}
+
+	r.regex = re
+
+	return nil
+}
+
+// Scope identifies the task and status a permission check runs for.
+type Scope struct {
+	TaskID     string
+	WorkflowID string
+	StatusName string
+}
+
+// appliesTo reports whether an allow rule applies to a check made for
+// scope at now.
+func (r *CommandRule) appliesTo(scope Scope, now time.Time) bool {
+	if !r.ExpiresAt.IsZero() && !now.Before(r.ExpiresAt) {
+		return false
+	}
+
+	switch r.Scope {
+	case "", "project":
+		return true
+	case "task":
+		return r.TaskID != "" && r.TaskID == scope.TaskID
+	case "status":
+		return r.WorkflowID != "" && r.WorkflowID == scope.WorkflowID &&
+			r.StatusName != "" && r.StatusName == scope.StatusName
+	default:
+		return false
+	}
+}
+
+// CommandRules is a set of compiled single-command rules. Rules that were
+// not compiled never match.
+type CommandRules []CommandRule
+
+// CommandCheckResult holds the result of checking a single command.
+type CommandCheckResult struct {
+	Command          string `json:"command"`
+	Matched          bool   `json:"matched"`
+	MatchedPattern   string `json:"matched_pattern,omitempty"`
+	SuggestedPattern string `json:"suggested_pattern,omitempty"`
+	// Opaque is set when the command cannot be resolved statically; such a
+	// command always needs the user's approval.
+	Opaque       bool   `json:"opaque,omitempty"`
+	OpaqueReason string `json:"opaque_reason,omitempty"`
+	// Risk is the shellparse risk category of the command, when classified.
+	Risk       string `json:"risk,omitempty"`
+	RiskReason string `json:"risk_reason,omitempty"`
+}
+
+// RedirectCheckResult holds the result of checking a single redirect.
+type RedirectCheckResult struct {
+	Operator         string `json:"operator"`
+	Path             string `json:"path"`
+	Matched          bool   `json:"matched"`
+	MatchedPattern   string `json:"matched_pattern,omitempty"`
+	SuggestedPattern string `json:"suggested_pattern,omitempty"`
+}
+
+// BashMetadata is the per-command breakdown of a Bash permission check. The
+// agent attaches it as JSON to Bash permission request interactions.
+type BashMetadata struct {
+	ParsedCommands []CommandCheckResult  `json:"parsed_commands"`
+	Redirects      []RedirectCheckResult `json:"redirects"`
+	// Risk, RiskLabel and RiskReason describe the highest risk category
+	// among the parsed commands.
+	Risk       string `json:"risk,omitempty"`
+	RiskLabel  string `json:"risk_label,omitempty"`
+	RiskReason string `json:"risk_reason,omitempty"`
+}
+
+// CheckAllow checks s against the unexpired allow rules of ptype that apply
+// to scope.
+func (rs CommandRules) CheckAllow(ptype, s string, scope Scope, now time.Time) (matched bool, pattern string) {
+	for i := range rs {
+		r := &rs[i]
+		if r.regex == nil || r.Type != ptype || r.Deny || !r.appliesTo(scope, now) {
+			continue
+		}
+
+		if r.regex.MatchString(s) {
+			return true, r.Pattern
+		}
+	}
+
+	return false, ""
+}
+
+// CheckDenied reports whether any parsed command or redirect matches a deny
+// rule, and the reason to give the agent. Deny rules win over allow rules.
+// Commands are matched after quote removal, so quoting a flag ("--force")
+// does not hide it.
+func (rs CommandRules) CheckDenied(parsed *shellparse.ParseResult) (denied bool, reason string) {
+	for _, cmd := range parsed.Commands {
+		literal := strings.Join(append([]string{cmd.Executable}, cmd.Words...), " ")
+
+		for i := range rs {
+			r := &rs[i]
+			if r.regex == nil || !r.Deny {
+				continue
+			}
+
+			switch r.Type {
+			case TypeCommand:
+				if r.regex.MatchString(cmd.Raw) || r.regex.MatchString(literal) || matchCommandArgs(r.Pattern, cmd) {
+					return true, denyReason(r, cmd.Raw)
+				}
+			case TypeRedirect:
+				for _, redir := range cmd.Redirects {
+					if redir.Path != "" && r.regex.MatchString(redir.Path) {
+						return true, denyReason(r, redir.Op+" "+redir.Path)
+					}
+				}
+			}
+		}
+	}
+
+	return false, ""
+}
+
+// CheckUnresolved reports whether a command whose arguments cannot be
+// resolved statically runs an executable that a deny rule targets. Such a
+// command may expand to a denied one, so it must not be allowed without the
+// user's confirmation.
+func (rs CommandRules) CheckUnresolved(parsed *shellparse.ParseResult) (unresolved bool, reason string) {
+	for _, cmd := range parsed.Commands {
+		if !cmd.Dynamic || cmd.Executable == "" {
+			continue
+		}
+
+		for i := range rs {
+			r := &rs[i]
+			if r.regex == nil || !r.Deny || r.Type != TypeCommand {
+				continue
+			}
+
+			if exe, _, _ := strings.Cut(r.Pattern, " "); MatchGlob(exe, cmd.Executable) || MatchGlob(exe, filepath.Base(cmd.Executable)) {
+				return true, fmt.Sprintf("%q has arguments that cannot be resolved statically and may match deny rule %q", cmd.Raw, r.Pattern)
+			}
+		}
+	}
+
+	return false, ""
+}
+
+func denyReason(r *CommandRule, target string) string {
+	if r.Reason != "" {
+		return fmt.Sprintf("%q is denied by rule %q: %s", target, r.Pattern, r.Reason)
+	}
+
+	return fmt.Sprintf("%q is denied by rule %q", target, r.Pattern)
+}
+
+// CheckAllCommands checks all parsed commands and their redirects against
+// the allow rules that apply to scope.
+// Returns true if ALL are matched (auto-allow), and the metadata for the
+// interaction UI. Opaque commands are never considered matched, so a
+// command line containing one is never auto-allowed.
+func (rs CommandRules) CheckAllCommands(parsed *shellparse.ParseResult, scope Scope, now time.Time) (allMatched bool, meta *BashMetadata) {
+	meta = &BashMetadata{}
+	allMatched = true
+
+	for _, cmd := range parsed.Commands {
+		matched, pattern := rs.CheckAllow(TypeCommand, cmd.Raw, scope, now)
+
+		result := CommandCheckResult{
+			Command:    cmd.Raw,
+			Matched:    matched,
+			RiskReason: cmd.RiskReason,
+		}
+		if cmd.RiskReason != "" {
+			result.Risk = cmd.Risk.String()
+		}
+
+		switch {
+		case cmd.Opaque:
+			allMatched = false
+			result.Matched = false
+			result.Opaque = true
+			result.OpaqueReason = cmd.OpaqueReason
+		case matched:
+			result.MatchedPattern = pattern
+		default:
+			allMatched = false
+			result.SuggestedPattern = shellparse.SuggestCommandPattern(cmd)
+		}
+
+		meta.ParsedCommands = append(meta.ParsedCommands, result)
+
+		// Check each redirect.
+		for _, redir := range cmd.Redirects {
+			if redir.Path == "" {
+				continue
+			}
+
+			rMatched, rPattern := rs.CheckAllow(TypeRedirect, redir.Path, scope, now)
+
+			rResult := RedirectCheckResult{
+				Operator: redir.Op,
+				Path:     redir.Path,
+				Matched:  rMatched,
+			}
+			if rMatched {
+				rResult.MatchedPattern = rPattern
+			} else {
+				allMatched = false
+				rResult.SuggestedPattern = shellparse.SuggestRedirectPattern(redir.Path)
+			}
+
+			meta.Redirects = append(meta.Redirects, rResult)
+		}
+	}
+
+	return allMatched, meta
+}
+
+// matchCommandArgs matches a command pattern against the parsed executable
+// and arguments so that flags may appear in any order: "git push --force*"
+// matches "git push origin main --force". Non-flag words of the pattern must
+// match the command's non-flag arguments in order, each flag word must match
+// one of its flags, and a trailing "*" on the last word allows further
+// arguments. A single-letter flag such as "-f" also matches combined short
+// flags ("-fu"). Arguments are matched after quote removal. Only deny rules use this looser matching; allow rules keep
+// matching the whole command string.
+func matchCommandArgs(pattern string, cmd shellparse.ParsedCommand) bool {
+	words := strings.Fields(pattern)
+	if len(words) == 0 || cmd.Executable == "" {
+		return false
+	}
+
+	if !MatchGlob(words[0], cmd.Executable) && !MatchGlob(words[0], filepath.Base(cmd.Executable)) {
+		return false
+	}
+
+	words = words[1:]
+	open := len(words) > 0 && strings.HasSuffix(words[len(words)-1], "*")
+
+	var patFlags, patArgs, flags, args []string
+
+	for _, w := range words {
+		switch {
+		case w == "*":
+		case strings.HasPrefix(w, "-"):
+			patFlags = append(patFlags, w)
+		default:
+			patArgs = append(patArgs, w)
+		}
+	}
+
+	for _, a := range cmd.Words {
+		if strings.HasPrefix(a, "-") && a != "-" {
+			flags = append(flags, a)
+		} else {
+			args = append(args, a)
+		}
+	}
+
+	if len(args) < len(patArgs) || (!open && len(args) != len(patArgs)) {
+		return false
+	}
+
+	// Non-flag words match in order but not necessarily adjacently, so that
+	// option values ("git -C dir push") do not hide a denied subcommand.
+	next := 0
+
+	for _, w := range patArgs {
+		for next < len(args) && !MatchGlob(w, args[next]) {
+			next++
+		}
+
+		if next == len(args) {
+			return false
+		}
+
+		next++
+	}
+
+	for _, w := range patFlags {
+		if !slices.ContainsFunc(flags, func(f string) bool { return matchFlag(w, f) }) {
+			return false
+		}
+	}
+
+	if !open {
+		for _, f := range flags {
+			if !slices.ContainsFunc(patFlags, func(w string) bool { return matchFlag(w, f) }) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// matchFlag matches a flag pattern against a flag argument, treating "-f"
+// as matching any combined short flag group containing f.
+func matchFlag(pattern, flag string) bool {
+	if MatchGlob(pattern, flag) {
+		return true
+	}
+
+	if len(pattern) == 2 && pattern[0] == '-' && pattern[1] != '-' &&
+		len(flag) > 2 && flag[0] == '-' && flag[1] != '-' && !strings.Contains(flag, "=") {
+		return strings.ContainsRune(flag[1:], rune(pattern[1]))
+	}
+
+	return false
+}
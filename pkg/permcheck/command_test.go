@@ -0,0 +1,95 @@
+package permcheck
+
+import (
+	"testing"
+
+	"github.com/kazz187/taskguild/pkg/shellparse"
+)
+
+func TestWildcardToRegex(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		input    string
+		expected bool
+	}{
+		// Exact match
+		{"git status", "git status", true},
+		{"git status", "git push", false},
+		// Trailing wildcard
+		{"git *", "git status", true},
+		{"git *", "git commit -m hello", true},
+		{"git *", "git", false}, // * matches zero or more chars after "git "
+		{"cd *", "cd /home/user/project", true},
+		// Middle wildcard
+		{"docker * build", "docker compose build", true},
+		{"docker * build", "docker build", false}, // "docker " + "" + " build" = "docker  build"
+		// Multiple wildcards
+		{"git * --* *", "git commit --amend foo", true},
+		// Wildcard only
+		{"*", "anything at all", true},
+		{"*", "", true},
+		// Multiline commands (heredoc-style)
+		{"git commit -m *", "git commit -m \"$(cat <<'EOF'\nfix: some message\n\nCo-Authored-By: Claude <noreply@anthropic.com>\nEOF\n)\"", true},
+		{"git *", "git commit -m \"$(cat <<'EOF'\nfix: message\nEOF\n)\"", true},
+		// Special regex characters in pattern are escaped
+		{"npm test (coverage)", "npm test (coverage)", true},
+		{"file.txt", "fileTtxt", false}, // dot is literal, not regex dot
+		{"a+b", "a+b", true},
+		{"a+b", "aab", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+"_vs_"+tt.input, func(t *testing.T) {
+			re, err := CompileWildcard(tt.pattern)
+			if err != nil {
+				t.Fatalf("CompileWildcard(%q) error: %v", tt.pattern, err)
+			}
+
+			matched := re.MatchString(tt.input)
+			if matched != tt.expected {
+				t.Errorf("CompileWildcard(%q).MatchString(%q) = %v, want %v", tt.pattern, tt.input, matched, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCompileWildcard_Empty(t *testing.T) {
+	_, err := CompileWildcard("")
+	if err == nil {
+		t.Error("expected error for empty pattern")
+	}
+}
+
+func TestMatchCommandArgs(t *testing.T) {
+	tests := []struct {
+		pattern string
+		command string
+		want    bool
+	}{
+		{"git push --force*", "git push --force", true},
+		{"git push --force*", "git push origin main --force", true},
+		{"git push --force*", "git push --force-with-lease origin main", true},
+		{"git push --force*", "git -C /repo push --force", true},
+		{"git push --force*", "git push origin main", false},
+		{"git push -f*", "git push -fu origin main", true},
+		{"git reset --hard*", "git reset HEAD~1 --hard", true},
+		{"git reset --hard*", "git reset --soft HEAD~1", false},
+		{"git reset --hard", "git reset --hard", true},
+		{"git reset --hard", "git reset --hard HEAD~1", false},
+		{"rm -r*", "/bin/rm -rf build", true},
+		{"git push --force*", "npm push --force", false},
+		{"git push --force*", `git push origin main "--force"`, true},
+		{"git push --force*", `git push origin main --for""ce`, true},
+		{"git push --force*", `git "push" --force`, true},
+		{"git reset --hard*", `git reset "--hard" HEAD~3`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+"_"+tt.command, func(t *testing.T) {
+			cmd := shellparse.Parse(tt.command).Commands[0]
+			if got := matchCommandArgs(tt.pattern, cmd); got != tt.want {
+				t.Errorf("matchCommandArgs(%q, %q) = %v, want %v", tt.pattern, tt.command, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package permcheck
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/kazz187/taskguild/pkg/shellparse"
+)
+
+// EgressPolicy restricts the hosts WebFetch and shell network tools (curl,
+// wget, git remotes, package registries) may contact. An entry matches the
+// domain itself and its subdomains ("example.com" matches
+// "api.example.com"); "*" wildcards are matched against the whole host. An
+// empty Allow list allows every host not denied.
+type EgressPolicy struct {
+	Allow []string `json:"allow,omitempty" yaml:"allow,omitempty"`
+	Deny  []string `json:"deny,omitempty" yaml:"deny,omitempty"`
+}
+
+// EgressVerdict is the outcome of checking a request against a policy.
+type EgressVerdict int
+
+const (
+	EgressAllowed EgressVerdict = iota
+	// EgressUnlisted means the host is not in the allow list, or the URL
+	// cannot be resolved statically. It always needs the user's
+	// confirmation.
+	EgressUnlisted
+	// EgressDenied means the host is in the deny list.
+	EgressDenied
+)
+
+// NewEgressPolicy returns a policy with the given domains, or nil when both
+// lists are empty.
+func NewEgressPolicy(allow, deny []string) *EgressPolicy {
+	if len(allow) == 0 && len(deny) == 0 {
+		return nil
+	}
+
+	return &EgressPolicy{Allow: allow, Deny: deny}
+}
+
+// Empty reports whether the policy has no domains; nil-safe.
+func (p *EgressPolicy) Empty() bool {
+	return p == nil || (len(p.Allow) == 0 && len(p.Deny) == 0)
+}
+
+// ValidateDomain reports whether entry can be used in an egress policy: a
+// host name, optionally with "*" wildcards, without scheme, port or path.
+func ValidateDomain(entry string) error {
+	if entry == "" {
+		return errors.New("empty domain")
+	}
+
+	if strings.ContainsAny(entry, "/:@ \t") {
+		return fmt.Errorf("invalid domain %q: use a host name such as example.com or *.example.com", entry)
+	}
+
+	return nil
+}
+
+// Check classifies a request to rawURL. The returned string names the URL
+// and explains the verdict for EgressUnlisted and EgressDenied.
+func (p *EgressPolicy) Check(rawURL string) (EgressVerdict, string) {
+	if p.Empty() {
+		return EgressAllowed, ""
+	}
+
+	host, ok := urlHost(rawURL)
+	if !ok {
+		if rawURL == "" {
+			return EgressUnlisted, "cannot determine the destination of the request"
+		}
+
+		return EgressUnlisted, "cannot determine the host of " + rawURL
+	}
+
+	for _, entry := range p.Deny {
+		if matchDomain(entry, host) {
+			return EgressDenied, fmt.Sprintf("%s is in denied domain %s", rawURL, entry)
+		}
+	}
+
+	if len(p.Allow) == 0 {
+		return EgressAllowed, ""
+	}
+
+	for _, entry := range p.Allow {
+		if matchDomain(entry, host) {
+			return EgressAllowed, ""
+		}
+	}
+
+	return EgressUnlisted, fmt.Sprintf("%s (%s) is not in the allowed domains", rawURL, host)
+}
+
+// CheckRequests checks every URL the tool call contacts and returns the
+// most restrictive verdict. Tools that make no requests are allowed.
+// WebSearch is answered by the search provider and is not checked.
+func (p *EgressPolicy) CheckRequests(toolName string, input map[string]any, parsedBash *shellparse.ParseResult) (EgressVerdict, string) {
+	if p.Empty() {
+		return EgressAllowed, ""
+	}
+
+	var urls []string
+
+	switch toolName {
+	case "WebFetch":
+		u, _ := input["url"].(string)
+		urls = append(urls, u)
+	case "Bash":
+		if parsedBash != nil {
+			for _, t := range parsedBash.NetworkTargets() {
+				urls = append(urls, t.URL)
+			}
+		}
+	}
+
+	verdict, reasons := EgressAllowed, []string(nil)
+
+	for _, u := range urls {
+		v, r := p.Check(u)
+
+		switch {
+		case v > verdict:
+			verdict, reasons = v, []string{r}
+		case v == verdict && v != EgressAllowed:
+			reasons = append(reasons, r)
+		}
+	}
+
+	return verdict, strings.Join(reasons, "; ")
+}
+
+// urlHost returns the lower-case host of rawURL. URLs without a scheme are
+// read as http URLs, as curl does.
+func urlHost(rawURL string) (string, bool) {
+	if rawURL == "" || strings.ContainsAny(rawURL, "$`") {
+		return "", false
+	}
+
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "http://" + rawURL
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Hostname() == "" {
+		return "", false
+	}
+
+	return strings.ToLower(strings.TrimSuffix(u.Hostname(), ".")), true
+}
+
+// matchDomain reports whether host matches a policy entry.
+func matchDomain(entry, host string) bool {
+	entry = strings.ToLower(strings.TrimSuffix(entry, "."))
+	if strings.Contains(entry, "*") {
+		return MatchGlob(entry, host)
+	}
+
+	return host == entry || strings.HasSuffix(host, "."+entry)
+}
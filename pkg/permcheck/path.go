@@ -0,0 +1,267 @@
+package permcheck
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/kazz187/taskguild/pkg/shellparse"
+)
+
+// PathPolicy restricts where Write, Edit, NotebookEdit and shell redirects
+// may write. Entries are glob roots: a path matches when the path itself or
+// one of its parent directories matches the pattern. Relative entries are
+// resolved against the session working directory and "~" expands to the
+// home directory. An empty Allow list allows every path not denied.
+type PathPolicy struct {
+	Allow []string `json:"allow,omitempty" yaml:"allow,omitempty"`
+	Deny  []string `json:"deny,omitempty" yaml:"deny,omitempty"`
+}
+
+// PathVerdict is the outcome of checking a write target against a policy.
+type PathVerdict int
+
+const (
+	PathAllowed PathVerdict = iota
+	// PathOutside means the target is not under any allowed root, or cannot
+	// be resolved statically. It always needs the user's confirmation.
+	PathOutside
+	// PathDenied means the target is under a denied root.
+	PathDenied
+)
+
+// ParsePathPolicy reads the JSON form of a policy, as injected into task
+// metadata for the current status. It returns nil when there is no policy.
+func ParsePathPolicy(raw string) *PathPolicy {
+	if raw == "" {
+		return nil
+	}
+
+	var p PathPolicy
+	if err := json.Unmarshal([]byte(raw), &p); err != nil || p.Empty() {
+		return nil
+	}
+
+	return &p
+}
+
+// NewPathPolicy returns a policy with the given roots, or nil when both
+// lists are empty.
+func NewPathPolicy(allow, deny []string) *PathPolicy {
+	if len(allow) == 0 && len(deny) == 0 {
+		return nil
+	}
+
+	return &PathPolicy{Allow: allow, Deny: deny}
+}
+
+// Empty reports whether the policy has no roots; nil-safe.
+func (p *PathPolicy) Empty() bool {
+	return p == nil || (len(p.Allow) == 0 && len(p.Deny) == 0)
+}
+
+// MergePathPolicies combines the project and status policies. Deny lists
+// are combined; a non-empty status allow list replaces the project's.
+func MergePathPolicies(project, status *PathPolicy) *PathPolicy {
+	if project.Empty() {
+		return status
+	}
+
+	if status.Empty() {
+		return project
+	}
+
+	merged := &PathPolicy{
+		Allow: project.Allow,
+		Deny:  union(project.Deny, status.Deny),
+	}
+	if len(status.Allow) > 0 {
+		merged.Allow = status.Allow
+	}
+
+	return merged
+}
+
+// Check classifies a write to target issued from dir, which differs from
+// the session working directory cwd after a cd. Relative targets resolve
+// against dir; relative policy entries always resolve against cwd. The
+// returned string explains the verdict for PathOutside and PathDenied.
+func (p *PathPolicy) Check(target, dir, cwd string) (PathVerdict, string) {
+	if p.Empty() {
+		return PathAllowed, ""
+	}
+
+	abs, ok := resolveTargetPath(target, dir)
+	if !ok {
+		return PathOutside, "cannot resolve write target " + target
+	}
+
+	// Match denied roots against both the lexical and the resolved path so
+	// a symlink cannot be used to reach a denied directory, nor a denied
+	// symlink to escape its own rule.
+	resolved := evalSymlinksPartial(abs)
+
+	for _, entry := range p.Deny {
+		if matchPathRoot(entry, cwd, abs) || matchPathRoot(entry, cwd, resolved) {
+			return PathDenied, target + " is under denied path " + entry
+		}
+	}
+
+	if len(p.Allow) == 0 {
+		return PathAllowed, ""
+	}
+
+	for _, entry := range p.Allow {
+		if matchPathRoot(entry, cwd, resolved) {
+			return PathAllowed, ""
+		}
+	}
+
+	return PathOutside, target + " is outside the allowed paths"
+}
+
+// CheckWritePaths checks every file the tool call writes to, including the
+// redirects and write operands of Bash commands, and returns the most
+// restrictive verdict. Tools that do not write files are allowed.
+func (p *PathPolicy) CheckWritePaths(toolName string, input map[string]any, parsedBash *shellparse.ParseResult, cwd string) (PathVerdict, string) {
+	if p.Empty() {
+		return PathAllowed, ""
+	}
+
+	var targets []shellparse.WriteTarget
+
+	switch toolName {
+	case "Write", "Edit":
+		if fp, _ := input["file_path"].(string); fp != "" {
+			targets = append(targets, shellparse.WriteTarget{Path: fp, Dir: cwd})
+		}
+	case "NotebookEdit":
+		if np, _ := input["notebook_path"].(string); np != "" {
+			targets = append(targets, shellparse.WriteTarget{Path: np, Dir: cwd})
+		}
+	case "Bash":
+		if parsedBash != nil {
+			targets = parsedBash.WriteTargets(cwd)
+		}
+	}
+
+	verdict, reason := PathAllowed, ""
+
+	for _, t := range targets {
+		v, r := p.Check(t.Path, t.Dir, cwd)
+		if v > verdict {
+			verdict, reason = v, r
+		}
+
+		if verdict == PathDenied {
+			break
+		}
+	}
+
+	return verdict, reason
+}
+
+// resolveTargetPath makes target absolute and clean. It reports false when
+// the path depends on runtime expansion or on an unknown directory.
+func resolveTargetPath(target, dir string) (string, bool) {
+	if target == "" || strings.ContainsAny(target, "$`") {
+		return "", false
+	}
+
+	target = expandHome(target)
+	if !filepath.IsAbs(target) {
+		if dir == "" {
+			return "", false
+		}
+
+		target = filepath.Join(dir, target)
+	}
+
+	return filepath.Clean(target), true
+}
+
+// matchPathRoot reports whether p or one of its parent directories matches
+// the policy entry. Relative entries are resolved against dir.
+func matchPathRoot(entry, dir, p string) bool {
+	pattern, ok := resolveTargetPath(entry, dir)
+	if !ok {
+		return false
+	}
+
+	patterns := []string{pattern}
+	if resolved := evalSymlinksPartial(pattern); resolved != pattern {
+		patterns = append(patterns, resolved)
+	}
+
+	for {
+		if slices.ContainsFunc(patterns, func(pat string) bool {
+			ok, _ := filepath.Match(pat, p)
+			return ok
+		}) {
+			return true
+		}
+
+		parent := filepath.Dir(p)
+		if parent == p {
+			return false
+		}
+
+		p = parent
+	}
+}
+
+// evalSymlinksPartial resolves symlinks in the longest existing prefix of
+// p, so files that do not exist yet still resolve through linked parents.
+func evalSymlinksPartial(p string) string {
+	var rest []string
+
+	cur := p
+	for {
+		if resolved, err := filepath.EvalSymlinks(cur); err == nil {
+			return filepath.Join(append([]string{resolved}, rest...)...)
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return p
+		}
+
+		parent := filepath.Dir(cur)
+		if parent == cur {
+			return p
+		}
+
+		rest = append([]string{filepath.Base(cur)}, rest...)
+		cur = parent
+	}
+}
+
+// expandHome replaces a leading "~" with the user's home directory.
+func expandHome(p string) string {
+	if p != "~" && !strings.HasPrefix(p, "~/") {
+		return p
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return p
+	}
+
+	return filepath.Join(home, p[1:])
+}
+
+// union merges two string slices, removing duplicates while preserving order.
+func union(a, b []string) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	result := make([]string, 0, len(a)+len(b))
+
+	for _, s := range slices.Concat(a, b) {
+		if !seen[s] {
+			seen[s] = true
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
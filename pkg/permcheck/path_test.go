@@ -0,0 +1,129 @@
+package permcheck
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kazz187/taskguild/pkg/shellparse"
+)
+
+func TestPathPolicy_Check(t *testing.T) {
+	root := t.TempDir()
+	wt := filepath.Join(root, "wt")
+	outside := filepath.Join(root, "outside")
+	require.NoError(t, os.MkdirAll(filepath.Join(wt, ".github", "workflows"), 0o755))
+	require.NoError(t, os.MkdirAll(outside, 0o755))
+	require.NoError(t, os.Symlink(outside, filepath.Join(wt, "escape")))
+	require.NoError(t, os.Symlink(filepath.Join(wt, ".github"), filepath.Join(wt, "gh")))
+
+	p := &PathPolicy{Allow: []string{"."}, Deny: []string{".github/workflows", "*.pem"}}
+
+	tests := []struct {
+		target string
+		want   PathVerdict
+	}{
+		{"main.go", PathAllowed},
+		{filepath.Join(wt, "pkg", "new", "file.go"), PathAllowed},
+		{"../outside/file.go", PathOutside},
+		{"sub/../../outside/file.go", PathOutside},
+		{"escape/file.go", PathOutside},
+		{".github/workflows/ci.yml", PathDenied},
+		{"gh/workflows/ci.yml", PathDenied},
+		{"server.pem", PathDenied},
+		{"$HOME/file", PathOutside},
+	}
+
+	for _, tt := range tests {
+		got, reason := p.Check(tt.target, wt, wt)
+		assert.Equal(t, tt.want, got, "check(%q): %s", tt.target, reason)
+	}
+}
+
+func TestPathPolicy_CheckUnknownDir(t *testing.T) {
+	p := &PathPolicy{Allow: []string{"/repo"}}
+
+	got, _ := p.Check("out.txt", "", "")
+	assert.Equal(t, PathOutside, got)
+
+	got, _ = p.Check("/repo/out.txt", "", "")
+	assert.Equal(t, PathAllowed, got)
+}
+
+func TestPathPolicy_CheckWritePaths(t *testing.T) {
+	p := &PathPolicy{Allow: []string{"/repo"}, Deny: []string{"/repo/secrets"}}
+
+	got, _ := p.CheckWritePaths("Write", map[string]any{"file_path": "/repo/a.go"}, nil, "/repo")
+	assert.Equal(t, PathAllowed, got)
+
+	got, _ = p.CheckWritePaths("NotebookEdit", map[string]any{"notebook_path": "/tmp/n.ipynb"}, nil, "/repo")
+	assert.Equal(t, PathOutside, got)
+
+	got, _ = p.CheckWritePaths("Read", map[string]any{"file_path": "/repo/secrets/key"}, nil, "/repo")
+	assert.Equal(t, PathAllowed, got)
+
+	var nilPolicy *PathPolicy
+
+	got, _ = nilPolicy.CheckWritePaths("Write", map[string]any{"file_path": "/etc/passwd"}, nil, "/repo")
+	assert.Equal(t, PathAllowed, got)
+}
+
+func TestPathPolicy_CheckWritePaths_Bash(t *testing.T) {
+	wt := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(wt, ".github", "workflows"), 0o755))
+
+	p := &PathPolicy{Allow: []string{"."}, Deny: []string{".github/workflows"}}
+
+	tests := []struct {
+		cmd  string
+		want PathVerdict
+	}{
+		{"echo x > out.txt", PathAllowed},
+		{"cp a.txt b.txt && mv b.txt pkg/c.txt", PathAllowed},
+		// Relative entries stay anchored to the worktree after a cd.
+		{"cd / && echo x > etc/cron.d/evil", PathOutside},
+		{"cd .github && echo x > workflows/ci.yml", PathDenied},
+		// Files written by commands are checked like redirects.
+		{"tee .github/workflows/ci.yml < payload", PathDenied},
+		{"cp payload .github/workflows/ci.yml", PathDenied},
+		{"sed -i s/a/b/ .github/workflows/ci.yml", PathDenied},
+		{"rm -rf .github/workflows", PathDenied},
+		{"mv payload ../outside.txt", PathOutside},
+		{"echo x | tee /dev/null", PathAllowed},
+		// Directory changes other than cd are followed too.
+		{"pushd /etc; touch x", PathOutside},
+		{"pushd /etc; popd; touch x", PathAllowed},
+		{"env -C /etc touch x", PathOutside},
+		{"env --chdir=.github/workflows touch ci.yml", PathDenied},
+		{"dd if=payload of=.github/workflows/a.yml", PathDenied},
+		{"curl -o .github/workflows/a.yml https://example.com/a.yml", PathDenied},
+		{"wget -O .github/workflows/a.yml https://example.com/a.yml", PathDenied},
+		{"git checkout other -- .github/workflows/a.yml", PathDenied},
+		{"git restore --source other .github/workflows/a.yml", PathDenied},
+		{"git checkout main", PathAllowed},
+	}
+
+	for _, tt := range tests {
+		got, reason := p.CheckWritePaths("Bash", map[string]any{"command": tt.cmd}, shellparse.Parse(tt.cmd), wt)
+		assert.Equal(t, tt.want, got, "%s: %s", tt.cmd, reason)
+	}
+}
+
+func TestMergePathPolicies(t *testing.T) {
+	project := &PathPolicy{Allow: []string{"."}, Deny: []string{"~/.ssh"}}
+
+	assert.Equal(t, project, MergePathPolicies(project, nil))
+	assert.Equal(t, &PathPolicy{Allow: []string{"."}, Deny: []string{"~/.ssh", ".github"}},
+		MergePathPolicies(project, &PathPolicy{Deny: []string{".github"}}))
+	assert.Equal(t, &PathPolicy{Allow: []string{"docs"}, Deny: []string{"~/.ssh"}},
+		MergePathPolicies(project, &PathPolicy{Allow: []string{"docs"}}))
+}
+
+func TestParsePathPolicy(t *testing.T) {
+	assert.Nil(t, ParsePathPolicy(""))
+	assert.Nil(t, ParsePathPolicy("{}"))
+	assert.Equal(t, &PathPolicy{Deny: []string{".env"}}, ParsePathPolicy(`{"deny":[".env"]}`))
+}
@@ -0,0 +1,304 @@
+// Package permcheck decides whether an agent tool call is allowed, needs the
+// user's confirmation, or is denied.
+//
+// The agent manager evaluates every tool call with it before asking the
+// user, and the server uses the same function to simulate decisions, so
+// the two cannot disagree about what a rule does.
+package permcheck
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kazz187/taskguild/pkg/shellparse"
+)
+
+// Outcome is the result of a permission check.
+type Outcome int
+
+const (
+	// Ask means the user must confirm the tool call.
+	Ask Outcome = iota
+	// Allow means the tool call runs without confirmation.
+	Allow
+	// Deny means the tool call is rejected without asking.
+	Deny
+)
+
+// String returns "ask", "allow" or "deny".
+func (o Outcome) String() string {
+	switch o {
+	case Allow:
+		return "allow"
+	case Deny:
+		return "deny"
+	default:
+		return "ask"
+	}
+}
+
+// Sources name what decided a tool call.
+const (
+	SourceQuestion        = "ask_user_question"
+	SourcePermissionDeny  = "permission_deny_rule"
+	SourceCommandDeny     = "single_command_deny_rule"
+	SourcePathPolicy      = "path_policy"
+	SourceEgressPolicy    = "egress_policy"
+	SourcePermissionMode  = "permission_mode"
+	SourceReadOnlyTool    = "read_only_tool"
+	SourceAcceptEdits     = "accept_edits"
+	SourcePlanModeTool    = "plan_mode_tool"
+	SourceStatusSkill     = "status_skill"
+	SourcePermissionAllow = "permission_allow_rule"
+	SourceCommandAllow    = "single_command_rules"
+	SourceRiskCategory    = "risk_category"
+	SourcePermissionAsk   = "permission_ask_rule"
+	SourceDefault         = "default"
+)
+
+// Permission modes, as used by the Claude Agent SDK.
+const (
+	ModeDefault           = "default"
+	ModeAcceptEdits       = "acceptEdits"
+	ModePlan              = "plan"
+	ModeBypassPermissions = "bypassPermissions"
+	ModeAuto              = "auto"
+	ModeDontAsk           = "dontAsk"
+)
+
+// ReadOnlyTools are always auto-allowed regardless of permission mode.
+var ReadOnlyTools = map[string]bool{
+	"Read":      true,
+	"Glob":      true,
+	"Grep":      true,
+	"WebSearch": true,
+	"WebFetch":  true,
+}
+
+// EditTools are auto-allowed in acceptEdits and bypassPermissions modes.
+var EditTools = map[string]bool{
+	"Edit":         true,
+	"Write":        true,
+	"NotebookEdit": true,
+}
+
+// Rules are the permission rules a tool call is checked against.
+type Rules struct {
+	// Allow, Ask and Deny are the project's PermissionSet rules
+	// (e.g. "Read", "Bash(git *)").
+	Allow []string
+	Ask   []string
+	Deny  []string
+	// AutoAllowRisks holds the Bash risk categories allowed without
+	// confirmation.
+	AutoAllowRisks map[shellparse.Risk]bool
+	// PathPolicy restricts where edit tools and shell redirects may write.
+	// Callers merge the project and status policies with MergePathPolicies.
+	PathPolicy *PathPolicy
+	// EgressPolicy restricts the hosts WebFetch and shell network tools
+	// may contact.
+	EgressPolicy *EgressPolicy
+	// Commands are the single-command rules.
+	Commands CommandRules
+}
+
+// ParseAutoAllowRisks converts risk names to the set used by Rules. Unknown
+// names and categories that are not auto-allowable are ignored.
+func ParseAutoAllowRisks(names []string) map[shellparse.Risk]bool {
+	risks := make(map[shellparse.Risk]bool, len(names))
+
+	for _, name := range names {
+		if r, ok := shellparse.ParseRisk(name); ok && r.AutoAllowable() {
+			risks[r] = true
+		}
+	}
+
+	return risks
+}
+
+// Request describes a tool call.
+type Request struct {
+	ToolName string
+	Input    map[string]any
+	// Mode is the session's permission mode; empty means ModeDefault.
+	Mode string
+	// Cwd is the session working directory, used to resolve write targets.
+	Cwd string
+	// StatusSkills are the skills the current workflow status runs; the
+	// Skill tool may invoke them without confirmation.
+	StatusSkills map[string]bool
+	// Scope selects the task- and status-scoped grants that apply.
+	Scope Scope
+	// Now is the time grants are checked against; zero means time.Now().
+	Now time.Time
+}
+
+// Decision is the outcome of Evaluate and what produced it.
+type Decision struct {
+	Outcome Outcome
+	// Source names the check that decided the outcome.
+	Source string
+	// Rule is the rule or pattern that matched, when one did.
+	Rule string
+	// Reason explains the decision. For Deny it is the message returned to
+	// the agent.
+	Reason string
+	// Bash is the per-command breakdown of a Bash call.
+	Bash *BashMetadata
+}
+
+// Evaluate decides a tool call. The checks run in this order:
+//
+//  1. AskUserQuestion always asks the user.
+//  2. Deny rules and single-command deny rules reject the call.
+//  3. Writes under a path policy's denied roots and requests to an egress
+//     policy's denied domains are rejected.
+//  4. bypassPermissions, auto and dontAsk allow everything else, except
+//     writes outside the allowed roots, requests to unlisted domains and
+//     commands that may expand to a denied one, which they cannot confirm.
+//  5. Ask rules, writes outside the allowed roots, requests to unlisted
+//     domains and commands that may expand to a denied one force a
+//     request.
+//  6. Otherwise read-only tools, edit tools in acceptEdits, plan mode
+//     tools, the status's skills, allow rules, fully matched single-command
+//     rules and auto-allowed risk categories allow the call.
+func Evaluate(rules Rules, req Request) Decision {
+	if req.ToolName == "AskUserQuestion" {
+		return Decision{Outcome: Ask, Source: SourceQuestion, Reason: "questions are always answered by the user"}
+	}
+
+	if req.Now.IsZero() {
+		req.Now = time.Now()
+	}
+
+	// Deny rules win over everything else, including the permission mode.
+	if rule := FirstRestrictiveMatch(rules.Deny, req.ToolName, req.Input); rule != "" {
+		return Decision{
+			Outcome: Deny,
+			Source:  SourcePermissionDeny,
+			Rule:    rule,
+			Reason:  fmt.Sprintf("denied by project permission rule %q", rule),
+		}
+	}
+
+	var (
+		parsedBash       *shellparse.ParseResult
+		unresolved       bool
+		unresolvedReason string
+	)
+
+	if req.ToolName == "Bash" {
+		if cmdStr, ok := req.Input["command"].(string); ok && cmdStr != "" {
+			parsedBash = shellparse.Parse(cmdStr)
+
+			if denied, reason := rules.Commands.CheckDenied(parsedBash); denied {
+				return Decision{Outcome: Deny, Source: SourceCommandDeny, Reason: reason}
+			}
+
+			unresolved, unresolvedReason = rules.Commands.CheckUnresolved(parsedBash)
+		}
+	}
+
+	// Path policies: writes under a denied root are always rejected; writes
+	// outside the allowed roots need confirmation, which the unattended
+	// modes below cannot give.
+	pathCheck, pathReason := rules.PathPolicy.CheckWritePaths(req.ToolName, req.Input, parsedBash, req.Cwd)
+	if pathCheck == PathDenied {
+		return Decision{Outcome: Deny, Source: SourcePathPolicy, Reason: "denied by path policy: " + pathReason}
+	}
+
+	// Egress policies: requests to denied domains are always rejected;
+	// requests to unlisted domains need confirmation, like writes outside
+	// the allowed paths.
+	egressCheck, egressReason := rules.EgressPolicy.CheckRequests(req.ToolName, req.Input, parsedBash)
+	if egressCheck == EgressDenied {
+		return Decision{Outcome: Deny, Source: SourceEgressPolicy, Reason: "denied by egress policy: " + egressReason}
+	}
+
+	// bypassPermissions / auto / dontAsk: allow everything else
+	if req.Mode == ModeBypassPermissions || req.Mode == ModeAuto || req.Mode == ModeDontAsk {
+		if pathCheck == PathOutside {
+			return Decision{Outcome: Deny, Source: SourcePathPolicy, Reason: "denied by path policy: " + pathReason}
+		}
+
+		if egressCheck == EgressUnlisted {
+			return Decision{Outcome: Deny, Source: SourceEgressPolicy, Reason: "denied by egress policy: " + egressReason}
+		}
+
+		if unresolved {
+			return Decision{Outcome: Deny, Source: SourceCommandDeny, Reason: unresolvedReason}
+		}
+
+		return Decision{Outcome: Allow, Source: SourcePermissionMode, Reason: "permission mode " + req.Mode + " allows all tool calls"}
+	}
+
+	// Ask rules force a permission request even when an allow rule or the
+	// tool's defaults would allow the call.
+	askRule := FirstRestrictiveMatch(rules.Ask, req.ToolName, req.Input)
+	mustAsk := askRule != "" || pathCheck == PathOutside || egressCheck == EgressUnlisted || unresolved
+
+	if ReadOnlyTools[req.ToolName] && !mustAsk {
+		return Decision{Outcome: Allow, Source: SourceReadOnlyTool, Reason: req.ToolName + " is read-only"}
+	}
+
+	if EditTools[req.ToolName] && req.Mode == ModeAcceptEdits && !mustAsk {
+		return Decision{Outcome: Allow, Source: SourceAcceptEdits, Reason: "acceptEdits mode allows edit tools"}
+	}
+
+	// Plan mode tools: ExitPlanMode approval is handled by a PreToolUse
+	// hook, EnterPlanMode is a safe mode switch — both skip permission
+	// requests.
+	if req.ToolName == "ExitPlanMode" || req.ToolName == "EnterPlanMode" {
+		return Decision{Outcome: Allow, Source: SourcePlanModeTool, Reason: "plan mode tools never need confirmation"}
+	}
+
+	// Skill tool: auto-allow the skills TaskGuild itself has wired up for
+	// the current status.
+	if req.ToolName == "Skill" {
+		if skillName, _ := req.Input["skill"].(string); skillName != "" && req.StatusSkills[skillName] {
+			return Decision{Outcome: Allow, Source: SourceStatusSkill, Rule: skillName, Reason: "skill is configured for the current status"}
+		}
+	}
+
+	if !mustAsk {
+		if rule := FirstMatch(rules.Allow, req.ToolName, req.Input); rule != "" {
+			return Decision{Outcome: Allow, Source: SourcePermissionAllow, Rule: rule, Reason: fmt.Sprintf("allowed by project permission rule %q", rule)}
+		}
+	}
+
+	// Single-command check for the Bash tool.
+	var bashMeta *BashMetadata
+
+	if parsedBash != nil {
+		risk := parsedBash.Classify(req.Cwd)
+
+		allMatched, meta := rules.Commands.CheckAllCommands(parsedBash, req.Scope, req.Now)
+		if allMatched && !mustAsk {
+			return Decision{Outcome: Allow, Source: SourceCommandAllow, Reason: "every command matches a single-command rule", Bash: meta}
+		}
+
+		meta.Risk = risk.Risk.String()
+		meta.RiskLabel = risk.Risk.Label()
+		meta.RiskReason = risk.Reason
+
+		// Projects may allow whole low-risk categories without confirmation.
+		if rules.AutoAllowRisks[risk.Risk] && !mustAsk {
+			return Decision{Outcome: Allow, Source: SourceRiskCategory, Rule: risk.Risk.String(), Reason: risk.Reason, Bash: meta}
+		}
+
+		bashMeta = meta
+	}
+
+	switch {
+	case askRule != "":
+		return Decision{Outcome: Ask, Source: SourcePermissionAsk, Rule: askRule, Reason: fmt.Sprintf("project permission rule %q requires confirmation", askRule), Bash: bashMeta}
+	case pathCheck == PathOutside:
+		return Decision{Outcome: Ask, Source: SourcePathPolicy, Reason: pathReason, Bash: bashMeta}
+	case egressCheck == EgressUnlisted:
+		return Decision{Outcome: Ask, Source: SourceEgressPolicy, Reason: egressReason, Bash: bashMeta}
+	case unresolved:
+		return Decision{Outcome: Ask, Source: SourceCommandDeny, Reason: unresolvedReason, Bash: bashMeta}
+	default:
+		return Decision{Outcome: Ask, Source: SourceDefault, Reason: "no rule allows this tool call", Bash: bashMeta}
+	}
+}
@@ -0,0 +1,151 @@
+package permcheck
+
+import (
+	"strings"
+
+	"github.com/kazz187/taskguild/pkg/shellparse"
+)
+
+// FirstMatch returns the first allow rule matching the tool call, or "".
+func FirstMatch(rules []string, toolName string, input map[string]any) string {
+	for _, rule := range rules {
+		if MatchRule(rule, toolName, input) {
+			return rule
+		}
+	}
+
+	return ""
+}
+
+// FirstRestrictiveMatch returns the first ask or deny rule matching the
+// tool call, or "".
+func FirstRestrictiveMatch(rules []string, toolName string, input map[string]any) string {
+	for _, rule := range rules {
+		if MatchRestrictiveRule(rule, toolName, input) {
+			return rule
+		}
+	}
+
+	return ""
+}
+
+// MatchRestrictiveRule matches an ask or deny rule. Unlike allow rules, a
+// Bash rule matches when any command of a one-liner matches, so that
+// "Bash(rm -rf *)" also catches "cd /tmp && rm -rf x". Claude's prefix
+// syntax "Bash(npm run test:*)" is accepted as "npm run test*".
+func MatchRestrictiveRule(rule, toolName string, input map[string]any) bool {
+	rTool, rPattern, hasPattern := ParseRule(rule)
+	if rTool != toolName || toolName != "Bash" || !hasPattern {
+		return MatchRule(rule, toolName, input)
+	}
+
+	if p, ok := strings.CutSuffix(rPattern, ":*"); ok {
+		rPattern = p + "*"
+	}
+
+	cmd, _ := input["command"].(string)
+	if MatchGlob(rPattern, strings.TrimSpace(cmd)) {
+		return true
+	}
+
+	for _, pc := range shellparse.Parse(cmd).Commands {
+		if MatchGlob(rPattern, pc.Raw) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MatchRule checks whether a permission rule (e.g. "Read", "Bash(git *)")
+// matches the given tool call.
+func MatchRule(rule string, toolName string, input map[string]any) bool {
+	// Parse rule: "ToolName" or "ToolName(pattern)".
+	rTool, rPattern, hasPattern := ParseRule(rule)
+
+	if rTool != toolName {
+		return false
+	}
+
+	// No pattern → tool name match is sufficient (allow all invocations).
+	if !hasPattern {
+		return true
+	}
+
+	// For Bash tools, match the command input against the pattern.
+	if toolName == "Bash" {
+		cmd, _ := input["command"].(string)
+		return MatchGlob(rPattern, cmd)
+	}
+
+	// For other tools with a pattern, attempt a generic match against a
+	// well-known input field (file_path for Read/Write/Edit, pattern for Glob, etc.).
+	for _, key := range []string{"file_path", "pattern", "path", "query", "url"} {
+		if val, ok := input[key].(string); ok {
+			if MatchGlob(rPattern, val) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ParseRule splits a rule string into its tool name, optional pattern, and
+// whether a pattern was present.
+//
+//	"Read"           → ("Read", "", false)
+//	"Bash(git *)"    → ("Bash", "git *", true)
+func ParseRule(rule string) (toolName, pattern string, hasPattern bool) {
+	idx := strings.Index(rule, "(")
+	if idx < 0 {
+		return rule, "", false
+	}
+	// Ensure it ends with ")".
+	if !strings.HasSuffix(rule, ")") {
+		return rule, "", false
+	}
+
+	return rule[:idx], rule[idx+1 : len(rule)-1], true
+}
+
+// MatchGlob performs simple glob matching where "*" matches any sequence of
+// characters. It supports multiple wildcards (e.g. "git * --*").
+func MatchGlob(pattern, value string) bool {
+	// Fast paths.
+	if pattern == "*" {
+		return true
+	}
+
+	if pattern == "" {
+		return value == ""
+	}
+
+	if !strings.Contains(pattern, "*") {
+		return pattern == value
+	}
+
+	parts := strings.Split(pattern, "*")
+
+	// First segment must match as a prefix.
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+
+	remaining := value[len(parts[0]):]
+
+	// Middle segments must appear in order.
+	for i := 1; i < len(parts)-1; i++ {
+		idx := strings.Index(remaining, parts[i])
+		if idx < 0 {
+			return false
+		}
+
+		remaining = remaining[idx+len(parts[i]):]
+	}
+
+	// Last segment must match as a suffix.
+	last := parts[len(parts)-1]
+
+	return strings.HasSuffix(remaining, last)
+}
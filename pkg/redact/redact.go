@@ -0,0 +1,235 @@
+// Package redact masks secrets in text before it leaves the agent or is
+// stored by the server.
+//
+// A Redactor combines three sources: built-in detectors for common
+// credential formats, per-project regular expressions, and the known values
+// of a project's secrets. The same Redactor runs in the agent manager before
+// task logs, interactions and turn logs are written, and again in the
+// server when they are stored, so a secret missed by an outdated agent is
+// still masked.
+package redact
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// minSecretLen is the shortest known secret value that is redacted. Shorter
+// values would mask unrelated text.
+const minSecretLen = 4
+
+// Placeholder returns the text a secret of the given kind is replaced with.
+func Placeholder(kind string) string {
+	return "[REDACTED:" + kind + "]"
+}
+
+// detector finds one kind of secret. When the expression has a capture
+// group, only the first group is replaced so that the surrounding key or
+// header stays readable.
+type detector struct {
+	kind string
+	re   *regexp.Regexp
+	// valid filters matches that only look like secrets; nil accepts all.
+	valid func(string) bool
+}
+
+// builtins detect common credential formats.
+var builtins = []detector{
+	{kind: "private_key", re: regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)},
+	{kind: "aws_access_key", re: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{kind: "github_token", re: regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`)},
+	{kind: "gitlab_token", re: regexp.MustCompile(`\bglpat-[A-Za-z0-9_-]{20,}`)},
+	{kind: "slack_token", re: regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`)},
+	{kind: "slack_webhook", re: regexp.MustCompile(`https://hooks\.slack\.com/services/[A-Za-z0-9/]+`)},
+	{kind: "stripe_key", re: regexp.MustCompile(`\b[rs]k_live_[A-Za-z0-9]{20,}`)},
+	{kind: "google_api_key", re: regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}`)},
+	{kind: "api_key", re: regexp.MustCompile(`\bsk-(?:ant-|proj-)?[A-Za-z0-9_-]{32,}`)},
+	{kind: "jwt", re: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}`)},
+	{kind: "authorization", re: regexp.MustCompile(`(?i)\b(?:authorization:\s*(?:bearer|basic|token)|bearer)\s+([A-Za-z0-9._~+/=-]{8,})`)},
+	{kind: "url_credentials", re: regexp.MustCompile(`[a-z][a-z0-9+.-]*://[^/\s:@"']+:([^@\s/"']+)@`)},
+	// KEY=value and "key": "value" assignments of secret-looking keys, as
+	// found in .env files, shell exports and JSON or YAML configs.
+	{
+		kind:  "assignment",
+		re:    regexp.MustCompile(`(?i)[a-z0-9_.-]*(?:secret|token|passw(?:or)?d|api[_-]?key|private[_-]?key|access[_-]?key|credentials?)[a-z0-9_.-]*["']?\s*[=:]\s*["']?([^\s"'\\,;&|)]+)`),
+		valid: looksSecret,
+	},
+}
+
+// looksSecret rejects assignment values that are placeholders or prose
+// rather than credentials: secrets are at least 8 characters long, contain
+// a digit, and are not variable references.
+func looksSecret(v string) bool {
+	if len(v) < 8 || strings.HasPrefix(v, "$") || strings.HasPrefix(v, "[REDACTED") {
+		return false
+	}
+
+	return strings.ContainsAny(v, "0123456789")
+}
+
+// secret is a known secret value and the forms it may appear in.
+type secret struct {
+	name  string
+	forms []string
+}
+
+// Redactor masks secrets in text. A nil Redactor applies the built-in
+// detectors only. A Redactor is safe for concurrent use.
+type Redactor struct {
+	patterns []detector
+	secrets  []secret
+}
+
+// New returns a Redactor that applies the built-in detectors, the given
+// regular expressions and the known secret values (name -> value).
+func New(patterns []string, secrets map[string]string) (*Redactor, error) {
+	r := &Redactor{}
+
+	for _, p := range patterns {
+		re, err := compilePattern(p)
+		if err != nil {
+			return nil, err
+		}
+
+		r.patterns = append(r.patterns, detector{kind: "custom", re: re})
+	}
+
+	for name, value := range secrets {
+		if len(value) < minSecretLen {
+			continue
+		}
+
+		forms := []string{value}
+		// Tool inputs are logged as JSON, where quotes, backslashes and
+		// newlines in the value appear escaped.
+		if b, err := json.Marshal(value); err == nil {
+			if escaped := string(b[1 : len(b)-1]); escaped != value {
+				forms = append(forms, escaped)
+			}
+		}
+
+		r.secrets = append(r.secrets, secret{name: name, forms: forms})
+	}
+
+	// Replace longer values first so that a secret containing another is
+	// masked as a whole.
+	slices.SortFunc(r.secrets, func(a, b secret) int {
+		return cmp.Or(len(b.forms[0])-len(a.forms[0]), cmp.Compare(a.name, b.name))
+	})
+
+	return r, nil
+}
+
+// ValidatePatterns checks that every pattern is a valid regular expression
+// that does not match the empty string.
+func ValidatePatterns(patterns []string) error {
+	for _, p := range patterns {
+		if _, err := compilePattern(p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func compilePattern(p string) (*regexp.Regexp, error) {
+	if p == "" {
+		return nil, errors.New("redaction pattern must not be empty")
+	}
+
+	re, err := regexp.Compile(p)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redaction pattern %q: %w", p, err)
+	}
+
+	// A pattern matching the empty string would insert placeholders between
+	// every character.
+	if re.MatchString("") {
+		return nil, fmt.Errorf("redaction pattern %q must not match the empty string", p)
+	}
+
+	return re, nil
+}
+
+// String returns s with every detected secret replaced by a placeholder.
+func (r *Redactor) String(s string) string {
+	if s == "" {
+		return s
+	}
+
+	if r != nil {
+		for _, sec := range r.secrets {
+			for _, f := range sec.forms {
+				s = strings.ReplaceAll(s, f, Placeholder(sec.name))
+			}
+		}
+
+		for _, d := range r.patterns {
+			s = d.apply(s)
+		}
+	}
+
+	for _, d := range builtins {
+		s = d.apply(s)
+	}
+
+	return s
+}
+
+// Map returns a copy of m with every value redacted. A nil map stays nil.
+func (r *Redactor) Map(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		out[k] = r.String(v)
+	}
+
+	return out
+}
+
+// apply replaces the detector's matches in s.
+func (d detector) apply(s string) string {
+	if !d.re.MatchString(s) {
+		return s
+	}
+
+	placeholder := Placeholder(d.kind)
+
+	if d.re.NumSubexp() == 0 {
+		return d.re.ReplaceAllStringFunc(s, func(m string) string {
+			if d.valid != nil && !d.valid(m) {
+				return m
+			}
+
+			return placeholder
+		})
+	}
+
+	var sb strings.Builder
+
+	last := 0
+
+	for _, loc := range d.re.FindAllStringSubmatchIndex(s, -1) {
+		start, end := loc[2], loc[3]
+		if start < 0 || (d.valid != nil && !d.valid(s[start:end])) {
+			continue
+		}
+
+		sb.WriteString(s[last:start])
+		sb.WriteString(placeholder)
+
+		last = end
+	}
+
+	sb.WriteString(s[last:])
+
+	return sb.String()
+}
@@ -0,0 +1,636 @@
+package shellparse
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+)
+
+// maxNestingDepth bounds how deeply nested scripts (eval, bash -c, ...) are
+// unwrapped. Anything deeper is reported as opaque.
+const maxNestingDepth = 8
+
+// shells are interpreters whose -c argument is a shell script that can be
+// parsed recursively.
+var shells = []string{"sh", "bash", "zsh", "dash", "ksh", "mksh", "ash"}
+
+// inlineCodeFlags lists, per interpreter, the options that take a program
+// text that cannot be inspected as shell.
+var inlineCodeFlags = map[string][]string{
+	"python":  {"-c"},
+	"python2": {"-c"},
+	"python3": {"-c"},
+	"node":    {"-e", "--eval", "-p", "--print"},
+	"perl":    {"-e", "-E"},
+	"ruby":    {"-e"},
+	"php":     {"-r"},
+}
+
+// wrapper describes a command that runs another command given in its
+// trailing arguments.
+type wrapper struct {
+	// valued lists options that consume the following word.
+	valued []string
+	// chdir lists options whose value is the directory the command runs
+	// in.
+	chdir []string
+	// inspect lists options that make the wrapper only look the command up
+	// instead of running it (command -v).
+	inspect []string
+	// split lists options whose value is a command line split at runtime.
+	split []string
+	// script lists options whose value is a shell script the wrapper runs
+	// (flock -c).
+	script []string
+	// joined is set when the command words are joined with spaces and run
+	// by a shell (watch).
+	joined bool
+	// skipAssigns skips NAME=VALUE words before the command (env).
+	skipAssigns bool
+	// positional is the number of non-option words before the command
+	// (the duration of timeout).
+	positional int
+}
+
+var wrappers = map[string]wrapper{
+	"builtin": {},
+	"busybox": {inspect: []string{"--list", "--list-full", "--help"}},
+	"chroot":  {valued: []string{"--userspec", "--groups"}, positional: 1},
+	"command": {inspect: []string{"-v", "-V"}},
+	"doas":    {valued: []string{"-u", "-C"}},
+	"env": {
+		valued:      []string{"-u", "--unset"},
+		chdir:       []string{"-C", "--chdir"},
+		split:       []string{"-S", "--split-string"},
+		skipAssigns: true,
+	},
+	"exec": {valued: []string{"-a"}},
+	"flock": {
+		valued:     []string{"-w", "--wait", "--timeout", "-E", "--conflict-exit-code"},
+		script:     []string{"-c", "--command"},
+		positional: 1,
+	},
+	"nice":   {valued: []string{"-n", "--adjustment"}},
+	"nohup":  {},
+	"setsid": {},
+	"stdbuf": {valued: []string{"-i", "-o", "-e"}},
+	"strace": {valued: []string{
+		"-a", "-b", "-e", "-E", "-I", "-o", "--output", "-O", "-p", "-P", "-s", "-S", "-u", "-X",
+	}},
+	"sudo": {
+		valued: []string{
+			"-u", "--user", "-g", "--group", "-C", "--close-from",
+			"-h", "--host", "-p", "--prompt", "-r", "--role", "-t", "--type",
+			"-U", "--other-user", "-T", "--command-timeout",
+		},
+		chdir: []string{"-D", "--chdir"},
+	},
+	"time":    {valued: []string{"-f", "--format", "-o", "--output"}},
+	"timeout": {valued: []string{"-s", "--signal", "-k", "--kill-after"}, positional: 1},
+	"watch":   {valued: []string{"-n", "--interval", "-q", "--equexit"}, joined: true},
+	"xargs": {valued: []string{
+		"-a", "--arg-file", "-d", "--delimiter", "-E", "-I", "-L", "--max-lines",
+		"-n", "--max-args", "-P", "--max-procs", "-s", "--max-chars",
+	}},
+}
+
+// walkNested reports the commands run by the command name with the given
+// arguments (the script of eval or bash -c, the command of xargs or
+// find -exec, ...). It returns a non-empty reason when the command runs
+// something that cannot be resolved statically.
+func (e *extractor) walkNested(name string, args []*syntax.Word) string {
+	base := path.Base(name)
+
+	switch {
+	case base == "eval":
+		return e.walkEval(args)
+	case slices.Contains(shells, base):
+		return e.walkShell(args)
+	case base == "source" || base == ".":
+		return "runs a script file"
+	case base == "alias":
+		if len(args) > 0 {
+			return "defines an alias"
+		}
+	case base == "trap":
+		return e.walkTrap(args)
+	case base == "find":
+		e.walkFindExec(args)
+	case base == "git":
+		return gitConfigOverride(args)
+	case base == "su" || base == "script":
+		return e.walkCommandOption(args, base == "su")
+	case base == "ssh":
+		return sshCommand(args)
+	case base == "parallel":
+		return "runs commands built from its input"
+	case inlineCodeFlags[base] != nil:
+		return inlineCode(inlineCodeFlags[base], args)
+	default:
+		if w, ok := wrappers[base]; ok {
+			return e.walkWrapped(w, args)
+		}
+	}
+
+	return ""
+}
+
+// walkScript parses src as a shell script and walks its commands.
+func (e *extractor) walkScript(src string) string {
+	parser := syntax.NewParser(
+		syntax.Variant(syntax.LangBash),
+		syntax.KeepComments(false),
+	)
+
+	prog, err := parser.Parse(strings.NewReader(src), "")
+	if err != nil {
+		return "nested script could not be parsed"
+	}
+
+	e.depth++
+	defer func() { e.depth-- }()
+
+	for _, stmt := range prog.Stmts {
+		e.walkStmt(stmt)
+	}
+
+	return ""
+}
+
+// walkEval walks the script formed by eval's arguments.
+func (e *extractor) walkEval(args []*syntax.Word) string {
+	parts := make([]string, 0, len(args))
+
+	for _, a := range args {
+		lit, ok := literalWord(a)
+		if !ok {
+			return "evaluates a string determined at runtime"
+		}
+
+		parts = append(parts, lit)
+	}
+
+	return e.walkScript(strings.Join(parts, " "))
+}
+
+// walkShell walks the -c script of a shell interpreter. Running a script
+// file or reading commands from standard input is opaque.
+func (e *extractor) walkShell(args []*syntax.Word) string {
+	for i := 0; i < len(args); i++ {
+		a, ok := literalWord(args[i])
+		if !ok {
+			return "interpreter arguments are determined at runtime"
+		}
+
+		switch {
+		case a == "--" || a == "-":
+			if i+1 < len(args) {
+				return "runs a script file"
+			}
+
+			return "reads commands from standard input"
+		case a == "--version" || a == "--help":
+			return ""
+		case a == "-o" || a == "+o" || a == "-O" || a == "+O":
+			i++
+		case strings.HasPrefix(a, "--"):
+			// Long options (--norc, --login, ...) take no value.
+		case strings.HasPrefix(a, "-") || strings.HasPrefix(a, "+"):
+			if !strings.Contains(a[1:], "c") {
+				continue
+			}
+
+			if i+1 >= len(args) {
+				return ""
+			}
+
+			script, ok := literalWord(args[i+1])
+			if !ok {
+				return "script is determined at runtime"
+			}
+
+			return e.walkScript(script)
+		default:
+			return "runs a script file"
+		}
+	}
+
+	return "reads commands from standard input"
+}
+
+// walkTrap walks the action of a trap builtin.
+func (e *extractor) walkTrap(args []*syntax.Word) string {
+	for _, w := range args {
+		a, ok := literalWord(w)
+		if !ok {
+			return "trap action is determined at runtime"
+		}
+
+		if a == "--" {
+			continue
+		}
+
+		if strings.HasPrefix(a, "-") {
+			// -p and -l only print.
+			return ""
+		}
+
+		return e.walkScript(a)
+	}
+
+	return ""
+}
+
+// walkFindExec reports the commands run by find's -exec family of actions.
+func (e *extractor) walkFindExec(args []*syntax.Word) {
+	for i := 0; i < len(args); i++ {
+		switch a, _ := literalWord(args[i]); a {
+		case "-exec", "-execdir", "-ok", "-okdir":
+		default:
+			continue
+		}
+
+		start := i + 1
+
+		end := start
+		for ; end < len(args); end++ {
+			if t, _ := literalWord(args[end]); t == ";" || t == "+" {
+				break
+			}
+		}
+
+		if end > start {
+			e.addNested(args[start:end])
+		}
+
+		i = end
+	}
+}
+
+// walkWrapped reports the command run by a wrapper such as env or xargs.
+func (e *extractor) walkWrapped(w wrapper, args []*syntax.Word) string {
+	i := 0
+
+	var dir string
+
+options:
+	for i < len(args) {
+		a, ok := literalWord(args[i])
+		if !ok {
+			return "wrapped command is determined at runtime"
+		}
+
+		if a == "--" {
+			i++
+			break
+		}
+
+		if slices.Contains(w.inspect, a) {
+			return ""
+		}
+
+		if slices.Contains(w.split, a) {
+			return "command line is split at runtime"
+		}
+
+		if slices.Contains(w.script, a) {
+			return e.walkScriptArg(args[i+1:])
+		}
+
+		if d, n, ok := chdirValue(w.chdir, a, args[i+1:]); ok {
+			if d == "" {
+				return "working directory is determined at runtime"
+			}
+
+			dir = path.Join(dir, d)
+			if path.IsAbs(d) {
+				dir = path.Clean(d)
+			}
+
+			i += n
+
+			continue
+		}
+
+		switch {
+		case slices.Contains(w.valued, a):
+			i += 2
+		case strings.HasPrefix(a, "-"):
+			i++
+		case w.skipAssigns && strings.Index(a, "=") > 0:
+			i++
+		default:
+			break options
+		}
+	}
+
+	i += w.positional
+	if i >= len(args) {
+		return ""
+	}
+
+	if a, _ := literalWord(args[i]); slices.Contains(w.script, a) {
+		return e.walkScriptArg(args[i+1:])
+	}
+
+	first := len(e.commands)
+
+	if w.joined {
+		parts := make([]string, 0, len(args)-i)
+
+		for _, arg := range args[i:] {
+			lit, ok := literalWord(arg)
+			if !ok {
+				return "wrapped command is determined at runtime"
+			}
+
+			parts = append(parts, lit)
+		}
+
+		if reason := e.walkScript(strings.Join(parts, " ")); reason != "" {
+			return reason
+		}
+	} else {
+		e.addNested(args[i:])
+	}
+
+	if dir != "" {
+		// The wrapped command and everything it runs in turn start in dir.
+		for j := first; j < len(e.commands); j++ {
+			if !path.IsAbs(e.commands[j].Dir) {
+				e.commands[j].Dir = path.Join(dir, e.commands[j].Dir)
+			}
+		}
+	}
+
+	return ""
+}
+
+// chdirValue reports whether a is one of the directory options flags
+// (-C dir, -Cdir, --chdir dir, --chdir=dir) and returns the directory and
+// the number of words consumed. The directory is "" when it is not a
+// literal.
+func chdirValue(flags []string, a string, next []*syntax.Word) (dir string, n int, ok bool) {
+	for _, f := range flags {
+		switch {
+		case a == f:
+			if len(next) == 0 {
+				return "", 1, true
+			}
+
+			d, _ := literalWord(next[0])
+
+			return d, 2, true
+		case strings.HasPrefix(f, "--") && strings.HasPrefix(a, f+"="):
+			return strings.TrimPrefix(a, f+"="), 1, true
+		case len(f) == 2 && !strings.HasPrefix(a, "--") && strings.HasPrefix(a, f) && len(a) > 2:
+			return a[2:], 1, true
+		}
+	}
+
+	return "", 0, false
+}
+
+// walkScriptArg walks the shell script given as the first of args, the
+// value of an option such as flock -c.
+func (e *extractor) walkScriptArg(args []*syntax.Word) string {
+	if len(args) == 0 {
+		return ""
+	}
+
+	script, ok := literalWord(args[0])
+	if !ok {
+		return "script is determined at runtime"
+	}
+
+	return e.walkScript(script)
+}
+
+// walkCommandOption walks the -c/--command script of su or script. Other
+// arguments after su's user name are passed to the user's shell, which
+// cannot be inspected.
+func (e *extractor) walkCommandOption(args []*syntax.Word, shellArgs bool) string {
+	var ops int
+
+	for i, w := range args {
+		a, ok := literalWord(w)
+		if !ok {
+			return "arguments are determined at runtime"
+		}
+
+		switch {
+		case a == "-c" || a == "--command":
+			return e.walkScriptArg(args[i+1:])
+		case strings.HasPrefix(a, "--command="):
+			return e.walkScript(strings.TrimPrefix(a, "--command="))
+		case !strings.HasPrefix(a, "-"):
+			ops++
+		}
+	}
+
+	if shellArgs && ops > 1 {
+		return "passes arguments to a shell"
+	}
+
+	return ""
+}
+
+// sshValueFlags are the ssh options that take a value.
+const sshValueFlags = "BbcDEeFIiJLlmOopQRSWw"
+
+// sshCommand reports whether ssh is given a command to run on the remote
+// host, which cannot be inspected.
+func sshCommand(args []*syntax.Word) string {
+	for i := 0; i < len(args); i++ {
+		a, ok := literalWord(args[i])
+		if !ok {
+			return "arguments are determined at runtime"
+		}
+
+		switch {
+		case a == "--":
+			if len(args) > i+2 {
+				return "runs a command on a remote host"
+			}
+
+			return ""
+		case strings.HasPrefix(a, "-") && len(a) > 1:
+			// A value flag consumes the next word when it ends the group.
+			if j := strings.IndexAny(a[1:], sshValueFlags); j >= 0 && j+2 == len(a) {
+				i++
+			}
+		default:
+			if len(args) > i+1 {
+				return "runs a command on a remote host"
+			}
+
+			return ""
+		}
+	}
+
+	return ""
+}
+
+// gitConfigOverride reports whether git is run with -c or --config-env,
+// which can set aliases, pagers and hooks that run arbitrary commands.
+func gitConfigOverride(args []*syntax.Word) string {
+	for i := 0; i < len(args); i++ {
+		a, ok := literalWord(args[i])
+		if !ok || !strings.HasPrefix(a, "-") {
+			return ""
+		}
+
+		switch {
+		case a == "-c" || a == "--config-env" || strings.HasPrefix(a, "--config-env="):
+			return "git " + strings.SplitN(a, "=", 2)[0] + " sets configuration that can run commands"
+		case slices.Contains([]string{"-C", "--git-dir", "--work-tree", "--namespace"}, a):
+			i++
+		}
+	}
+
+	return ""
+}
+
+// addNested records a command found inside the arguments of another one.
+func (e *extractor) addNested(words []*syntax.Word) {
+	e.depth++
+	defer func() { e.depth-- }()
+
+	e.addCall(words, nil, nil)
+}
+
+// inlineCode reports whether an interpreter is given program text on the
+// command line through one of flags, or reads its program from standard
+// input (no script operand, or "-").
+func inlineCode(flags []string, args []*syntax.Word) string {
+	for _, w := range args {
+		a, ok := literalWord(w)
+		if ok && a == "-" {
+			return "reads the program from standard input"
+		}
+
+		if !ok || !strings.HasPrefix(a, "-") || a == "-m" {
+			// Program text comes before the script or module name.
+			return ""
+		}
+
+		if slices.Contains([]string{"-V", "--version", "-v", "-h", "--help"}, a) {
+			return ""
+		}
+
+		for _, f := range flags {
+			if a == f || (len(f) == 2 && !strings.HasPrefix(a, "--") && strings.ContainsRune(a[1:], rune(f[1]))) {
+				return "runs inline program text"
+			}
+		}
+	}
+
+	return "reads the program from standard input"
+}
+
+// literalWord returns the value of w after quote removal when it contains no
+// expansions (parameters, command substitutions, globs, brace expansion, ...).
+func literalWord(w *syntax.Word) (string, bool) {
+	var sb strings.Builder
+
+	for _, part := range w.Parts {
+		switch p := part.(type) {
+		case *syntax.Lit:
+			if strings.ContainsAny(p.Value, "*?[") || isBraceExpansion(p.Value) {
+				return "", false
+			}
+
+			sb.WriteString(unescapeUnquoted(p.Value))
+		case *syntax.SglQuoted:
+			if p.Dollar {
+				return "", false
+			}
+
+			sb.WriteString(p.Value)
+		case *syntax.DblQuoted:
+			if p.Dollar {
+				return "", false
+			}
+
+			for _, dp := range p.Parts {
+				lit, ok := dp.(*syntax.Lit)
+				if !ok {
+					return "", false
+				}
+
+				sb.WriteString(unescapeDoubleQuoted(lit.Value))
+			}
+		default:
+			return "", false
+		}
+	}
+
+	return sb.String(), true
+}
+
+// isBraceExpansion reports whether an unquoted literal contains a brace
+// expansion such as {a,b} or {1..3}.
+func isBraceExpansion(s string) bool {
+	open := strings.Index(s, "{")
+	if open < 0 {
+		return false
+	}
+
+	inner := s[open:]
+
+	end := strings.Index(inner, "}")
+	if end < 0 {
+		return false
+	}
+
+	inner = inner[:end]
+
+	return strings.Contains(inner, ",") || strings.Contains(inner, "..")
+}
+
+// unescapeUnquoted removes backslash escapes outside quotes.
+func unescapeUnquoted(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var sb strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			if s[i] == '\n' {
+				continue
+			}
+		}
+
+		sb.WriteByte(s[i])
+	}
+
+	return sb.String()
+}
+
+// unescapeDoubleQuoted removes the backslash escapes recognized inside
+// double quotes.
+func unescapeDoubleQuoted(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var sb strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && strings.IndexByte("$`\"\\\n", s[i+1]) >= 0 {
+			i++
+			if s[i] == '\n' {
+				continue
+			}
+		}
+
+		sb.WriteByte(s[i])
+	}
+
+	return sb.String()
+}
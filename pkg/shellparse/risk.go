@@ -0,0 +1,1146 @@
+package shellparse
+
+import (
+	"path"
+	"slices"
+	"strings"
+)
+
+// Risk is the risk category of a command. Categories are ordered from the
+// least to the most dangerous, so the highest risk of a command line is the
+// maximum over its commands.
+type Risk int
+
+const (
+	// RiskReadOnly commands only inspect state.
+	RiskReadOnly Risk = iota
+	// RiskWorktreeWrite commands modify files inside the working tree.
+	RiskWorktreeWrite
+	// RiskUnknown commands are not recognized or cannot be resolved
+	// statically.
+	RiskUnknown
+	// RiskNetwork commands talk to remote hosts.
+	RiskNetwork
+	// RiskPackageInstall commands download and install packages.
+	RiskPackageInstall
+	// RiskOutsideWrite commands modify files outside the working tree.
+	RiskOutsideWrite
+	// RiskProcessKill commands signal or stop other processes.
+	RiskProcessKill
+	// RiskGitHistoryRewrite commands rewrite or discard git history.
+	RiskGitHistoryRewrite
+	// RiskPrivilegeEscalation commands run with elevated privileges.
+	RiskPrivilegeEscalation
+)
+
+var riskNames = map[Risk]string{
+	RiskReadOnly:            "read_only",
+	RiskWorktreeWrite:       "worktree_write",
+	RiskUnknown:             "unknown",
+	RiskNetwork:             "network",
+	RiskPackageInstall:      "package_install",
+	RiskOutsideWrite:        "outside_write",
+	RiskProcessKill:         "process_kill",
+	RiskGitHistoryRewrite:   "git_history_rewrite",
+	RiskPrivilegeEscalation: "privilege_escalation",
+}
+
+var riskLabels = map[Risk]string{
+	RiskReadOnly:            "Read-only",
+	RiskWorktreeWrite:       "Writes inside worktree",
+	RiskUnknown:             "Unknown",
+	RiskNetwork:             "Network access",
+	RiskPackageInstall:      "Package install",
+	RiskOutsideWrite:        "Writes outside worktree",
+	RiskProcessKill:         "Process kill",
+	RiskGitHistoryRewrite:   "Git history rewrite",
+	RiskPrivilegeEscalation: "Privilege escalation",
+}
+
+// String returns the snake_case name of the risk (e.g. "read_only").
+func (r Risk) String() string {
+	if name, ok := riskNames[r]; ok {
+		return name
+	}
+
+	return riskNames[RiskUnknown]
+}
+
+// Label returns a short human-readable description of the risk.
+func (r Risk) Label() string {
+	if label, ok := riskLabels[r]; ok {
+		return label
+	}
+
+	return riskLabels[RiskUnknown]
+}
+
+// AutoAllowable reports whether a project may allow every command of this
+// risk category without confirmation. Only the low-risk categories qualify.
+func (r Risk) AutoAllowable() bool {
+	return r == RiskReadOnly || r == RiskWorktreeWrite
+}
+
+// ParseRisk parses a risk name as returned by Risk.String.
+func ParseRisk(s string) (Risk, bool) {
+	for r, name := range riskNames {
+		if name == s {
+			return r, true
+		}
+	}
+
+	return RiskUnknown, false
+}
+
+// Classification is the risk assigned to a command with a short explanation.
+type Classification struct {
+	Risk   Risk
+	Reason string
+}
+
+// Classify labels every command with its risk category and returns the
+// highest classification across all commands. workDir is the directory the
+// command line runs in; paths resolving outside it count as outside writes.
+// Directory changes (cd, pushd, popd, env -C) are followed so relative
+// paths after them resolve correctly.
+func (r *ParseResult) Classify(workDir string) Classification {
+	highest := Classification{Risk: RiskReadOnly, Reason: "no commands"}
+
+	workDir = rootOrPlaceholder(workDir)
+	dirs := dirTracker{dir: workDir}
+
+	for i := range r.Commands {
+		cmd := &r.Commands[i]
+
+		c := classifyCommand(*cmd, dirs.commandDir(*cmd), workDir)
+		cmd.Risk = c.Risk
+		cmd.RiskReason = c.Reason
+
+		if i == 0 || c.Risk > highest.Risk {
+			highest = c
+		}
+
+		dirs.update(*cmd)
+	}
+
+	return highest
+}
+
+// dirTracker follows the working directory across the commands of a line.
+// dir is "" once it cannot be determined statically.
+type dirTracker struct {
+	dir   string
+	stack []string
+}
+
+// commandDir returns the directory cmd runs in, taking wrappers such as
+// env -C into account.
+func (t *dirTracker) commandDir(cmd ParsedCommand) string {
+	switch {
+	case cmd.Dir == "":
+		return t.dir
+	case path.IsAbs(cmd.Dir):
+		return path.Clean(cmd.Dir)
+	case t.dir == "":
+		return ""
+	default:
+		return path.Join(t.dir, cmd.Dir)
+	}
+}
+
+// update applies the directory change of cmd (cd, pushd, popd) to the
+// following commands.
+func (t *dirTracker) update(cmd ParsedCommand) {
+	if cmd.Opaque {
+		return
+	}
+
+	args := unquoteArgs(cmd.Args)
+
+	switch cmd.Executable {
+	case "cd":
+		t.dir = changeDir(t.commandDir(cmd), args)
+	case "pushd":
+		if hasFlag(args, "-n") {
+			return
+		}
+
+		t.stack = append(t.stack, t.dir)
+
+		if ops := operands(args); len(ops) == 0 || strings.HasPrefix(ops[0], "+") {
+			// Without a directory, pushd rotates the stack.
+			t.dir = ""
+		} else {
+			t.dir = changeDir(t.commandDir(cmd), args)
+		}
+	case "popd":
+		if len(t.stack) == 0 || len(args) > 0 {
+			t.dir = ""
+			return
+		}
+
+		t.dir, t.stack = t.stack[len(t.stack)-1], t.stack[:len(t.stack)-1]
+	}
+}
+
+// Classify returns the risk of a single command run in workDir.
+func Classify(cmd ParsedCommand, workDir string) Classification {
+	workDir = rootOrPlaceholder(workDir)
+
+	return classifyCommand(cmd, workDir, workDir)
+}
+
+// placeholderWorkDir stands in for an unknown working tree, so that relative
+// paths still resolve inside it and absolute paths outside.
+const placeholderWorkDir = "/\x00worktree"
+
+func rootOrPlaceholder(workDir string) string {
+	if workDir == "" {
+		return placeholderWorkDir
+	}
+
+	return path.Clean(workDir)
+}
+
+// classifyCommand classifies cmd run in dir, where workDir is the cleaned
+// root of the working tree.
+func classifyCommand(cmd ParsedCommand, dir, workDir string) Classification {
+	if cmd.Opaque {
+		return Classification{Risk: RiskUnknown, Reason: cmd.OpaqueReason}
+	}
+
+	c := classifyExecutable(cmd, dir, workDir)
+
+	// Output redirects write files regardless of the command.
+	for _, redir := range cmd.Redirects {
+		if !isWriteRedirect(redir) {
+			continue
+		}
+
+		rc := classifyWrite([]string{redir.Path}, dir, workDir, "redirects output to a file")
+		if rc.Risk > c.Risk {
+			c = rc
+		}
+	}
+
+	return c
+}
+
+// Command tables used by classifyExecutable.
+var (
+	readOnlyCommands = []string{
+		"[", "[[", "((", "basename", "cat", "cd", "cmp", "column", "comm", "cut",
+		"date", "df", "diff", "dirname", "du", "echo", "egrep", "env", "expr", "false",
+		"fgrep", "file", "grep", "head", "hexdump", "hostname", "id", "jq", "less", "let",
+		"ls", "md5sum", "more", "nl", "nproc", "od", "popd", "printenv", "printf", "ps",
+		"pushd", "pwd", "readlink", "realpath", "rg", "seq", "sha1sum", "sha256sum",
+		"sleep", "sort", "stat", "strings", "tail", "test", "tr", "tree", "true", "type",
+		"uname", "uniq", "wc", "which", "whoami", "xxd", "yq",
+		// Wrappers whose nested command is classified on its own.
+		"builtin", "busybox", "command", "eval", "exec", "nice", "nohup", "setsid",
+		"stdbuf", "strace", "time", "timeout", "watch", "xargs", "trap",
+		// Declarations only change shell variables.
+		"declare", "export", "local", "readonly", "set", "typeset", "unset",
+	}
+
+	// writeCommands modify the paths given as non-flag arguments. The value
+	// is the number of leading operands that are not paths (chmod's mode).
+	writeCommands = map[string]int{
+		"chgrp": 1, "chmod": 1, "chown": 1, "cp": 0, "install": 0, "ln": 0,
+		"mkdir": 0, "mv": 0, "rm": 0, "rmdir": 0, "shred": 0,
+		"tee": 0, "touch": 0, "truncate": 0, "unlink": 0,
+	}
+
+	// destinationCommands write only their last operand.
+	destinationCommands = []string{"cp", "install", "ln", "mv"}
+
+	buildCommands = []string{
+		"cargo", "cmake", "gofmt", "prettier", "tsc", "eslint", "black", "ruff",
+		"patch", "zip", "gzip", "gunzip",
+	}
+
+	// scriptRunners run commands defined in project files (Makefiles,
+	// build scripts) that the classifier cannot see.
+	scriptRunners = []string{"make", "gmake", "gradle", "gradlew", "mvn", "ninja"}
+
+	// scriptSubcommands maps tools to the subcommands that run
+	// project-defined scripts or arbitrary code (test binaries, build.rs).
+	scriptSubcommands = map[string][]string{
+		"npm":   {"run", "run-script", "rum", "urn", "test", "t", "tst", "start", "stop", "restart"},
+		"pnpm":  {"run", "test", "t", "start", "exec"},
+		"yarn":  {"run", "test", "start", "exec", "node"},
+		"bun":   {"run", "test"},
+		"go":    {"run", "generate", "tool", "test"},
+		"cargo": {"run", "test", "bench", "build", "b", "check", "c", "clippy"},
+	}
+
+	// goExecFlags make the go command run another program.
+	goExecFlags = []string{"exec", "toolexec", "vettool"}
+
+	networkCommands = []string{
+		"curl", "dig", "ftp", "gh", "host", "http", "https", "nc", "ncat", "nslookup",
+		"ping", "rsync", "scp", "sftp", "ssh", "telnet", "traceroute", "wget",
+	}
+
+	killCommands = []string{"kill", "killall", "pkill", "skill", "xkill"}
+
+	privilegeCommands = []string{"chroot", "doas", "pkexec", "su", "sudo"}
+
+	// packageManagers maps package managers to the subcommands that install
+	// or change dependencies.
+	packageManagers = map[string][]string{
+		"apk":      {"add", "del", "upgrade"},
+		"apt":      {"install", "remove", "purge", "upgrade", "dist-upgrade"},
+		"apt-get":  {"install", "remove", "purge", "upgrade", "dist-upgrade"},
+		"brew":     {"install", "reinstall", "uninstall", "upgrade", "tap"},
+		"bun":      {"add", "i", "install", "remove", "update", "x"},
+		"bundle":   {"add", "install", "update"},
+		"cargo":    {"add", "install", "remove", "update"},
+		"composer": {"install", "remove", "require", "update"},
+		"dnf":      {"install", "remove", "upgrade"},
+		"gem":      {"install", "uninstall", "update"},
+		"go":       {"get", "install"},
+		"npm":      {"add", "ci", "i", "install", "uninstall", "update", "upgrade", "exec"},
+		"pip":      {"install", "uninstall"},
+		"pip3":     {"install", "uninstall"},
+		"pipx":     {"install", "run", "upgrade"},
+		"pnpm":     {"add", "dlx", "i", "install", "remove", "update", "upgrade"},
+		"poetry":   {"add", "install", "remove", "update"},
+		"uv":       {"add", "pip", "remove", "sync", "tool"},
+		"yarn":     {"add", "dlx", "install", "remove", "upgrade"},
+		"yum":      {"install", "remove", "update"},
+	}
+
+	// packageRunners download and run packages on demand.
+	packageRunners = []string{"bunx", "npx", "pnpx", "uvx"}
+)
+
+// classifyExecutable classifies cmd by its executable, subcommands and flags.
+func classifyExecutable(cmd ParsedCommand, dir, workDir string) Classification {
+	name := path.Base(cmd.Executable)
+	args := unquoteArgs(cmd.Args)
+
+	switch {
+	case strings.Contains(cmd.Executable, "=") && len(cmd.Args) == 0:
+		return Classification{Risk: RiskReadOnly, Reason: "sets a shell variable"}
+	case slices.Contains(privilegeCommands, name):
+		return Classification{Risk: RiskPrivilegeEscalation, Reason: name + " runs commands with elevated privileges"}
+	case slices.Contains(killCommands, name):
+		return Classification{Risk: RiskProcessKill, Reason: name + " signals other processes"}
+	case name == "git":
+		return classifyGit(args, dir, workDir)
+	case slices.Contains(packageRunners, name):
+		return Classification{Risk: RiskPackageInstall, Reason: name + " downloads and runs packages"}
+	}
+
+	if subs, ok := packageManagers[name]; ok {
+		if sub := firstOperand(args); slices.Contains(subs, sub) {
+			return Classification{Risk: RiskPackageInstall, Reason: name + " " + sub + " changes installed packages"}
+		}
+
+		if name == "go" && slices.Contains([]string{"mod", "work"}, firstOperand(args)) {
+			return Classification{Risk: RiskNetwork, Reason: "go " + firstOperand(args) + " may download modules"}
+		}
+	}
+
+	if subs, ok := scriptSubcommands[name]; ok {
+		if sub := firstOperand(args); slices.Contains(subs, sub) {
+			return Classification{Risk: RiskUnknown, Reason: name + " " + sub + " runs project-defined scripts or code"}
+		}
+	}
+
+	if name == "go" && slices.ContainsFunc(args, isGoExecFlag) {
+		return Classification{Risk: RiskUnknown, Reason: "go runs the program given to -exec, -toolexec or -vettool"}
+	}
+
+	if slices.Contains(scriptRunners, name) {
+		return Classification{Risk: RiskUnknown, Reason: name + " runs commands defined in project files"}
+	}
+
+	if (name == "python" || name == "python3") && len(args) >= 3 && args[0] == "-m" && args[1] == "pip" && args[2] == "install" {
+		return Classification{Risk: RiskPackageInstall, Reason: "pip install changes installed packages"}
+	}
+
+	if name == "sed" {
+		if _, runs := sedEffects(args); runs {
+			return Classification{Risk: RiskUnknown, Reason: "sed script runs commands or cannot be inspected"}
+		}
+	}
+
+	if paths, reason, ok := writeOperands(name, args); ok {
+		c := classifyWrite(paths, dir, workDir, reason)
+		if slices.Contains(networkCommands, name) && c.Risk < RiskNetwork {
+			return Classification{Risk: RiskNetwork, Reason: name + " talks to remote hosts"}
+		}
+
+		return c
+	}
+
+	switch {
+	case slices.Contains(networkCommands, name):
+		return Classification{Risk: RiskNetwork, Reason: name + " talks to remote hosts"}
+	case name == "find":
+		return Classification{Risk: RiskReadOnly, Reason: "find only lists files"}
+	case name == "sed":
+		return Classification{Risk: RiskReadOnly, Reason: "sed without -i or w only prints"}
+	case name == "tar":
+		return Classification{Risk: RiskReadOnly, Reason: "tar without extract or create only lists archives"}
+	case name == "go":
+		switch firstOperand(args) {
+		case "doc", "env", "list", "version", "vet":
+			return Classification{Risk: RiskReadOnly, Reason: "go " + firstOperand(args) + " only inspects the module"}
+		}
+
+		return classifyWrite([]string{"."}, dir, workDir, "go builds code")
+	case name == "docker" || name == "podman" || name == "kubectl":
+		switch firstOperand(args) {
+		case "ps", "images", "inspect", "logs", "get", "describe", "version":
+			return Classification{Risk: RiskReadOnly, Reason: name + " " + firstOperand(args) + " only inspects state"}
+		case "kill", "stop", "rm", "delete":
+			return Classification{Risk: RiskProcessKill, Reason: name + " " + firstOperand(args) + " stops workloads"}
+		}
+
+		return Classification{Risk: RiskNetwork, Reason: name + " talks to a container runtime or cluster"}
+	case slices.Contains(buildCommands, name) || packageManagers[name] != nil:
+		return classifyWrite([]string{"."}, dir, workDir, name+" may modify files")
+	case slices.Contains(readOnlyCommands, name):
+		return Classification{Risk: RiskReadOnly, Reason: name + " does not modify anything"}
+	case slices.Contains(shells, name):
+		return Classification{Risk: RiskReadOnly, Reason: "the commands of the nested script are classified on their own"}
+	}
+
+	return Classification{Risk: RiskUnknown, Reason: "unrecognized command " + name}
+}
+
+// writeOperands returns the paths a file-writing command modifies through
+// its arguments (cp's destination, sed -i's files, find -delete's roots)
+// and a short reason. ok is false for commands that write no operands.
+func writeOperands(name string, args []string) (paths []string, reason string, ok bool) {
+	if skip, isWrite := writeCommands[name]; isWrite {
+		paths := operands(args)
+		if len(paths) > skip {
+			paths = paths[skip:]
+		}
+
+		if slices.Contains(destinationCommands, name) && len(paths) > 1 {
+			paths = paths[len(paths)-1:]
+		}
+
+		return paths, name + " modifies files", true
+	}
+
+	switch name {
+	case "find":
+		writes := findOutputs(args)
+		if slices.Contains(args, "-delete") {
+			writes = append(writes, findRoots(args)...)
+		}
+
+		if len(writes) > 0 {
+			return writes, "find -delete or -fprint modifies files", true
+		}
+	case "xxd":
+		if ops := operands(args); len(ops) > 1 {
+			return ops[1:], "xxd writes its output file", true
+		}
+	case "dd":
+		for _, a := range args {
+			if of, ok := strings.CutPrefix(a, "of="); ok {
+				return []string{of}, "dd writes its output file", true
+			}
+		}
+	case "curl":
+		return curlWrites(args)
+	case "wget":
+		return wgetWrites(args)
+	case "git":
+		return gitPathspecWrites(args)
+	case "sed":
+		if writes, _ := sedEffects(args); len(writes) > 0 {
+			return writes, "sed edits or writes files", true
+		}
+	case "sort":
+		if out, _ := flagValues(args, 'o', "--output"); len(out) > 0 {
+			return out, "sort -o writes a file", true
+		}
+	case "uniq":
+		if ops := operands(args); len(ops) > 1 {
+			return ops[1:2], "uniq writes its output file", true
+		}
+	case "yq":
+		if hasFlag(args, "-i", "--inplace") {
+			ops := operands(args)
+			if len(ops) > 0 && slices.Contains([]string{"eval", "e", "eval-all", "ea"}, ops[0]) {
+				ops = ops[1:]
+			}
+
+			if len(ops) > 0 {
+				ops = ops[1:]
+			}
+
+			return ops, "yq -i edits files in place", true
+		}
+	case "tar":
+		return tarWrites(args)
+	case "unzip":
+		dest, _ := flagValues(args, 'd', "")
+		if len(dest) == 0 {
+			dest = []string{"."}
+		}
+
+		if hasFlag(args, "-:") {
+			dest = append(dest, "/")
+		}
+
+		return dest, "unzip extracts files", true
+	}
+
+	return nil, "", false
+}
+
+// curlWrites returns the files curl saves: -o/--output files, and the
+// output directory (--output-dir, or the current directory) of remote
+// names (-O, --remote-name-all).
+func curlWrites(args []string) ([]string, string, bool) {
+	outs, _ := flagValues(args, 'o', "--output")
+	outs = slices.DeleteFunc(outs, func(o string) bool { return o == "-" })
+
+	if hasFlag(args, "-O", "--remote-name", "--remote-name-all") {
+		dirs, _ := flagValues(args, 0, "--output-dir")
+		if len(dirs) == 0 {
+			dirs = []string{"."}
+		}
+
+		outs = append(outs, dirs...)
+	}
+
+	if len(outs) == 0 {
+		return nil, "", false
+	}
+
+	return outs, "curl saves downloads", true
+}
+
+// wgetWrites returns the files wget saves: the -O document, or the
+// directory prefix (-P, or the current directory), and the -o log file.
+func wgetWrites(args []string) ([]string, string, bool) {
+	docs, _ := flagValues(args, 'O', "--output-document")
+	if len(docs) == 0 {
+		docs, _ = flagValues(args, 'P', "--directory-prefix")
+		if len(docs) == 0 {
+			docs = []string{"."}
+		}
+	}
+
+	logs, _ := flagValues(args, 'o', "--output-file")
+
+	outs := slices.DeleteFunc(append(docs, logs...), func(o string) bool { return o == "-" })
+	if len(outs) == 0 {
+		return nil, "", false
+	}
+
+	return outs, "wget saves downloads", true
+}
+
+// gitPathspecWrites returns the files that git checkout and git restore
+// overwrite from another commit or the index: the pathspecs after "--", or
+// every operand when there is no "--" (git checkout <branch> <file>). Paths
+// are resolved against git -C.
+func gitPathspecWrites(args []string) ([]string, string, bool) {
+	var dir string
+
+	i := 0
+	for i < len(args) && strings.HasPrefix(args[i], "-") {
+		switch args[i] {
+		case "-C":
+			if i+1 < len(args) {
+				dir = path.Join(dir, args[i+1])
+			}
+
+			i += 2
+		case "-c", "--git-dir", "--work-tree", "--namespace", "--config-env":
+			i += 2
+		default:
+			i++
+		}
+	}
+
+	if i >= len(args) {
+		return nil, "", false
+	}
+
+	sub, rest := args[i], args[i+1:]
+
+	var paths []string
+
+	switch sub {
+	case "checkout":
+		_, rest = flagValues(rest, 'b', "")
+		_, rest = flagValues(rest, 'B', "")
+		_, rest = flagValues(rest, 0, "--orphan")
+	case "restore":
+		_, rest = flagValues(rest, 's', "--source")
+	default:
+		return nil, "", false
+	}
+
+	if sep := slices.Index(rest, "--"); sep >= 0 {
+		paths = slices.Clone(rest[sep+1:])
+	} else {
+		paths = operands(rest)
+	}
+
+	for j, p := range paths {
+		if !path.IsAbs(p) {
+			paths[j] = path.Join(dir, p)
+		}
+	}
+
+	return paths, "git " + sub + " overwrites files", true
+}
+
+// tarWrites returns the paths a tar invocation writes: the extraction
+// directory (-C, or the current directory), "/" when absolute member names
+// are kept (-P), and the archive when creating or appending.
+func tarWrites(args []string) ([]string, string, bool) {
+	// Old-style options ("tar xzf a.tgz") are a bundle without the dash.
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		args = append([]string{"-" + args[0]}, args[1:]...)
+	}
+
+	switch {
+	case hasFlag(args, "-x", "--extract", "--get"):
+		dest, _ := flagValues(args, 'C', "--directory")
+		if len(dest) == 0 {
+			dest = []string{"."}
+		}
+
+		if hasFlag(args, "-P", "--absolute-names") {
+			dest = append(dest, "/")
+		}
+
+		return dest, "tar extracts files", true
+	case hasFlag(args, "-c", "--create", "-r", "--append", "-u", "--update"):
+		archive, _ := flagValues(args, 'f', "--file")
+		archive = slices.DeleteFunc(archive, func(a string) bool { return a == "-" })
+
+		return archive, "tar writes an archive", true
+	}
+
+	return nil, "", false
+}
+
+// sedEffects returns the files a sed invocation writes (the -i operands and
+// the targets of w commands and s///w flags) and whether its script runs
+// commands (e, s///e) or cannot be inspected (-f).
+func sedEffects(args []string) (writes []string, runs bool) {
+	scripts, rest := flagValues(args, 'e', "--expression")
+	if files, _ := flagValues(rest, 'f', "--file"); len(files) > 0 {
+		return nil, true
+	}
+
+	ops := operands(rest)
+	if len(scripts) == 0 && len(ops) > 0 {
+		scripts, ops = ops[:1], ops[1:]
+	}
+
+	if hasFlag(rest, "-i", "--in-place") {
+		writes = append(writes, ops...)
+	}
+
+	for _, script := range scripts {
+		w, r := sedScriptEffects(script)
+		writes = append(writes, w...)
+		runs = runs || r
+	}
+
+	return writes, runs
+}
+
+// sedScriptEffects scans a sed script for the w/W commands and the w flag
+// of s, which write files, and the e command and flag, which run commands.
+func sedScriptEffects(script string) (writes []string, runs bool) {
+	restOfLine := func(i int) (string, int) {
+		end := strings.IndexByte(script[i:], '\n')
+		if end < 0 {
+			return strings.TrimSpace(script[i:]), len(script)
+		}
+
+		return strings.TrimSpace(script[i : i+end]), i + end
+	}
+
+	// skipDelimited returns the index after the next unescaped delim.
+	skipDelimited := func(i int, delim byte) int {
+		for i < len(script) && script[i] != delim {
+			if script[i] == '\\' {
+				i++
+			}
+
+			i++
+		}
+
+		return i + 1
+	}
+
+	for i := 0; i < len(script); {
+		c := script[i]
+
+		switch {
+		case strings.IndexByte(" \t\n;{}!,", c) >= 0 || c == '$' || (c >= '0' && c <= '9') || c == '~':
+			i++
+		case c == '/':
+			i = skipDelimited(i+1, '/')
+		case c == '\\' && i+1 < len(script):
+			i = skipDelimited(i+2, script[i+1])
+		case c == 'w' || c == 'W':
+			var file string
+			file, i = restOfLine(i + 1)
+			writes = append(writes, file)
+		case c == 'e':
+			runs = true
+			_, i = restOfLine(i + 1)
+		case c == 'r' || c == 'R' || c == 'a' || c == 'i' || c == 'c':
+			_, i = restOfLine(i + 1)
+		case c == ':' || c == 'b' || c == 't' || c == 'T':
+			i++
+			for i < len(script) && script[i] != ';' && script[i] != '\n' {
+				i++
+			}
+		case (c == 's' || c == 'y') && i+1 < len(script):
+			delim := script[i+1]
+			i = skipDelimited(i+2, delim)
+			i = skipDelimited(i, delim)
+
+			if c == 'y' {
+				continue
+			}
+
+			for i < len(script) && strings.IndexByte(";\n}", script[i]) < 0 {
+				switch script[i] {
+				case 'e':
+					runs = true
+				case 'w':
+					var file string
+					file, i = restOfLine(i + 1)
+					writes = append(writes, file)
+
+					continue
+				}
+
+				i++
+			}
+		default:
+			i++
+		}
+	}
+
+	return writes, runs
+}
+
+// flagValues returns the values of a short option (possibly bundled, as
+// in -no FILE or -oFILE) and of its long form (--long=V or --long V),
+// together with the remaining arguments. short is 0 for options without a
+// short form.
+func flagValues(args []string, short byte, long string) (values, rest []string) {
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+
+		switch {
+		case a == "--":
+			return values, append(rest, args[i:]...)
+		case long != "" && a == long && i+1 < len(args):
+			values = append(values, args[i+1])
+			i++
+		case long != "" && strings.HasPrefix(a, long+"="):
+			values = append(values, strings.TrimPrefix(a, long+"="))
+		case len(a) > 1 && a[0] == '-' && a[1] != '-' && strings.IndexByte(a[1:], short) >= 0:
+			j := strings.IndexByte(a[1:], short) + 1
+			if j+1 < len(a) {
+				values = append(values, a[j+1:])
+			} else if i+1 < len(args) {
+				values = append(values, args[i+1])
+				i++
+			}
+
+			if j > 1 {
+				rest = append(rest, a[:j])
+			}
+		default:
+			rest = append(rest, a)
+		}
+	}
+
+	return values, rest
+}
+
+// classifyGit classifies a git invocation by its subcommand and flags.
+func classifyGit(args []string, dir, workDir string) Classification {
+	// Skip global options such as -C <dir> and -c <key=value>.
+	i := 0
+	for i < len(args) && strings.HasPrefix(args[i], "-") {
+		switch args[i] {
+		case "-C":
+			if i+1 < len(args) && !pathInside(args[i+1], dir, workDir) {
+				dir = ""
+			}
+
+			i += 2
+		case "-c", "--config-env", "--exec-path":
+			return Classification{Risk: RiskUnknown, Reason: "git " + args[i] + " sets configuration that can run commands"}
+		case "--git-dir", "--work-tree", "--namespace":
+			i += 2
+		default:
+			if strings.HasPrefix(args[i], "--config-env=") || strings.HasPrefix(args[i], "--exec-path=") {
+				return Classification{Risk: RiskUnknown, Reason: "git " + args[i] + " sets configuration that can run commands"}
+			}
+
+			i++
+		}
+	}
+
+	if i >= len(args) {
+		return Classification{Risk: RiskReadOnly, Reason: "git without a subcommand"}
+	}
+
+	sub, rest := args[i], args[i+1:]
+
+	rewrite := func(reason string) Classification {
+		return Classification{Risk: RiskGitHistoryRewrite, Reason: reason}
+	}
+
+	write := Classification{Risk: RiskWorktreeWrite, Reason: "git " + sub + " modifies the repository"}
+	if dir == "" {
+		write = Classification{Risk: RiskOutsideWrite, Reason: "git " + sub + " modifies a repository outside the worktree"}
+	}
+
+	switch sub {
+	case "push":
+		if hasFlag(rest, "-f", "--force", "--force-with-lease", "--force-if-includes", "--mirror", "--delete", "-d", "--prune") ||
+			slices.ContainsFunc(operands(rest), func(a string) bool { return strings.HasPrefix(a, "+") || strings.HasPrefix(a, ":") }) {
+			return rewrite("git push overwrites or deletes remote history")
+		}
+
+		return Classification{Risk: RiskNetwork, Reason: "git push sends commits to a remote"}
+	case "fetch", "pull", "clone", "ls-remote", "submodule":
+		return Classification{Risk: RiskNetwork, Reason: "git " + sub + " talks to a remote"}
+	case "reset":
+		if hasFlag(rest, "--hard", "--keep", "--merge") {
+			return rewrite("git reset discards commits or uncommitted changes")
+		}
+
+		return write
+	case "rebase", "filter-branch", "filter-repo", "replace":
+		return rewrite("git " + sub + " rewrites history")
+	case "commit":
+		if hasFlag(rest, "--amend") {
+			return rewrite("git commit --amend rewrites the last commit")
+		}
+
+		return write
+	case "branch":
+		if hasFlag(rest, "-D", "-M", "-C", "--force", "-f") {
+			return rewrite("git branch overwrites or deletes a branch")
+		}
+
+		if hasFlag(rest, "-d", "--delete", "-m", "--move", "-c", "--copy", "-u", "--set-upstream-to", "--unset-upstream") || len(operands(rest)) > 0 {
+			return write
+		}
+
+		return Classification{Risk: RiskReadOnly, Reason: "git branch only lists branches"}
+	case "reflog":
+		if op := firstOperand(rest); op == "expire" || op == "delete" {
+			return rewrite("git reflog " + op + " discards history")
+		}
+
+		return Classification{Risk: RiskReadOnly, Reason: "git reflog only shows history"}
+	case "gc", "prune":
+		return rewrite("git " + sub + " can discard unreachable commits")
+	case "update-ref":
+		return rewrite("git update-ref moves refs directly")
+	case "checkout", "switch":
+		if hasFlag(rest, "-f", "--force", "-B", "-C") {
+			return rewrite("git " + sub + " discards changes or overwrites a branch")
+		}
+
+		return write
+	case "tag":
+		if hasFlag(rest, "-f", "--force", "-d", "--delete") {
+			return rewrite("git tag overwrites or deletes a tag")
+		}
+
+		if len(operands(rest)) == 0 || hasFlag(rest, "-l", "--list", "-v", "--verify") {
+			return Classification{Risk: RiskReadOnly, Reason: "git tag only lists tags"}
+		}
+
+		return write
+	case "stash":
+		switch firstOperand(rest) {
+		case "list", "show":
+			return Classification{Risk: RiskReadOnly, Reason: "git stash " + firstOperand(rest) + " only shows stashes"}
+		case "drop", "clear":
+			return rewrite("git stash " + firstOperand(rest) + " discards stashed changes")
+		}
+
+		return write
+	case "remote":
+		switch firstOperand(rest) {
+		case "", "show", "get-url", "-v":
+			return Classification{Risk: RiskReadOnly, Reason: "git remote only lists remotes"}
+		case "update", "prune":
+			return Classification{Risk: RiskNetwork, Reason: "git remote " + firstOperand(rest) + " talks to a remote"}
+		}
+
+		return write
+	case "config":
+		if hasFlag(rest, "--get", "--get-all", "--get-regexp", "-l", "--list") || len(operands(rest)) <= 1 {
+			return Classification{Risk: RiskReadOnly, Reason: "git config only reads settings"}
+		}
+
+		if hasFlag(rest, "--global", "--system") {
+			return Classification{Risk: RiskOutsideWrite, Reason: "git config changes global settings"}
+		}
+
+		return Classification{Risk: RiskUnknown, Reason: "git config can set commands git runs later (aliases, hooks, fsmonitor)"}
+	case "status", "log", "diff", "show", "blame", "grep", "ls-files", "ls-tree", "rev-parse",
+		"rev-list", "describe", "shortlog", "cat-file", "merge-base", "show-ref", "show-branch",
+		"whatchanged", "name-rev", "for-each-ref", "check-ignore", "version", "help", "count-objects", "fsck":
+		return Classification{Risk: RiskReadOnly, Reason: "git " + sub + " only inspects the repository"}
+	case "add", "rm", "mv", "restore", "merge", "cherry-pick", "revert", "am", "apply", "init",
+		"worktree", "notes", "clean", "bisect", "sparse-checkout", "stage", "format-patch", "archive":
+		return write
+	}
+
+	return Classification{Risk: RiskUnknown, Reason: "unrecognized git subcommand " + sub + " (may be an alias)"}
+}
+
+// classifyWrite returns a write classification for paths: an outside write
+// when any of them resolves outside the working tree, otherwise a worktree
+// write.
+func classifyWrite(paths []string, dir, workDir, reason string) Classification {
+	for _, p := range paths {
+		if !pathInside(p, dir, workDir) {
+			return Classification{Risk: RiskOutsideWrite, Reason: reason + " outside the worktree (" + p + ")"}
+		}
+	}
+
+	return Classification{Risk: RiskWorktreeWrite, Reason: reason + " inside the worktree"}
+}
+
+// harmlessWriteTargets are redirect targets that do not persist anything.
+var harmlessWriteTargets = []string{"/dev/null", "/dev/stdout", "/dev/stderr", "/dev/tty"}
+
+// WriteTarget is a file written by a redirect or a file-writing command,
+// with the directory the command runs in. Dir is "" when it cannot be
+// determined statically.
+type WriteTarget struct {
+	Path string
+	Dir  string
+}
+
+// WriteTargets returns the files written by redirects and by file-writing
+// commands (tee, cp, mv, sed -i, ...) across all commands. workDir is the
+// directory the command line starts in; directory changes (cd, pushd,
+// popd, env -C) are followed so relative targets after them carry the right
+// directory. Harmless targets such as /dev/null are skipped.
+func (r *ParseResult) WriteTargets(workDir string) []WriteTarget {
+	var targets []WriteTarget
+
+	dirs := dirTracker{dir: workDir}
+
+	for _, cmd := range r.Commands {
+		dir := dirs.commandDir(cmd)
+
+		if !cmd.Opaque {
+			paths, _, _ := writeOperands(path.Base(cmd.Executable), unquoteArgs(cmd.Args))
+			for _, p := range paths {
+				if !slices.Contains(harmlessWriteTargets, p) {
+					targets = append(targets, WriteTarget{Path: p, Dir: dir})
+				}
+			}
+		}
+
+		for _, redir := range cmd.Redirects {
+			if redir.IsWrite() {
+				targets = append(targets, WriteTarget{Path: unquote(redir.Path), Dir: dir})
+			}
+		}
+
+		dirs.update(cmd)
+	}
+
+	return targets
+}
+
+// IsWrite reports whether the redirect writes to a file.
+func (r Redirect) IsWrite() bool {
+	return isWriteRedirect(r)
+}
+
+// isWriteRedirect reports whether redir writes to a file.
+func isWriteRedirect(redir Redirect) bool {
+	if !strings.Contains(redir.Op, ">") || redir.Path == "" {
+		return false
+	}
+
+	// Descriptor duplication (2>&1, >&2).
+	if strings.HasSuffix(redir.Op, ">&") && strings.Trim(redir.Path, "0123456789-") == "" {
+		return false
+	}
+
+	return !slices.Contains(harmlessWriteTargets, unquote(redir.Path))
+}
+
+// pathInside reports whether p, resolved against dir, lies inside workDir.
+// An empty dir means the current directory is unknown.
+func pathInside(p, dir, workDir string) bool {
+	p = unquote(p)
+	if p == "" || strings.ContainsAny(p, "$`~") {
+		return false
+	}
+
+	if !path.IsAbs(p) {
+		if dir == "" {
+			return false
+		}
+
+		p = path.Join(dir, p)
+	}
+
+	p = path.Clean(p)
+
+	return p == workDir || strings.HasPrefix(p, workDir+"/")
+}
+
+// changeDir returns the directory after running cd with unquoted args from
+// dir, or "" when it cannot be determined.
+func changeDir(dir string, args []string) string {
+	ops := operands(args)
+	if len(ops) == 0 || ops[0] == "-" || strings.ContainsAny(ops[0], "$`~") {
+		return ""
+	}
+
+	if path.IsAbs(ops[0]) {
+		return path.Clean(ops[0])
+	}
+
+	if dir == "" {
+		return ""
+	}
+
+	return path.Join(dir, ops[0])
+}
+
+// findRoots returns the starting points of a find invocation.
+func findRoots(args []string) []string {
+	var roots []string
+
+	for _, a := range args {
+		if strings.HasPrefix(a, "-") || a == "(" || a == "!" {
+			break
+		}
+
+		roots = append(roots, a)
+	}
+
+	if len(roots) == 0 {
+		return []string{"."}
+	}
+
+	return roots
+}
+
+// findOutputs returns the files find's -fprint, -fprint0, -fprintf and
+// -fls actions write.
+func findOutputs(args []string) []string {
+	var files []string
+
+	for i, a := range args {
+		switch a {
+		case "-fprint", "-fprint0", "-fprintf", "-fls":
+			if i+1 < len(args) {
+				files = append(files, args[i+1])
+			}
+		}
+	}
+
+	return files
+}
+
+// isGoExecFlag reports whether a is one of goExecFlags, with one or two
+// dashes and an optional =value.
+func isGoExecFlag(a string) bool {
+	if !strings.HasPrefix(a, "-") {
+		return false
+	}
+
+	flag, _, _ := strings.Cut(strings.TrimLeft(a, "-"), "=")
+
+	return slices.Contains(goExecFlags, flag)
+}
+
+// hasFlag reports whether args contain one of flags. Single-letter flags
+// also match when combined with others (-fu matches -f).
+func hasFlag(args []string, flags ...string) bool {
+	for _, a := range args {
+		if a == "--" {
+			return false
+		}
+
+		for _, f := range flags {
+			if a == f || strings.HasPrefix(a, f+"=") {
+				return true
+			}
+
+			if len(f) == 2 && f[0] == '-' && len(a) > 2 && a[0] == '-' && a[1] != '-' && strings.IndexByte(a[1:], f[1]) >= 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// operands returns the arguments that are not flags.
+func operands(args []string) []string {
+	var ops []string
+
+	for i, a := range args {
+		if a == "--" {
+			return append(ops, args[i+1:]...)
+		}
+
+		if !strings.HasPrefix(a, "-") || a == "-" {
+			ops = append(ops, a)
+		}
+	}
+
+	return ops
+}
+
+// firstOperand returns the first non-flag argument, or "".
+func firstOperand(args []string) string {
+	if ops := operands(args); len(ops) > 0 {
+		return ops[0]
+	}
+
+	return ""
+}
+
+// unquoteArgs strips surrounding quotes from rendered arguments.
+func unquoteArgs(args []string) []string {
+	out := make([]string, len(args))
+	for i, a := range args {
+		out[i] = unquote(a)
+	}
+
+	return out
+}
+
+// unquote strips one pair of surrounding single or double quotes.
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+
+	return s
+}
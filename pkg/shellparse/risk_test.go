@@ -0,0 +1,200 @@
+package shellparse
+
+import "testing"
+
+func TestClassify(t *testing.T) {
+	const workDir = "/work/repo"
+
+	tests := []struct {
+		input string
+		want  Risk
+	}{
+		{"ls -la", RiskReadOnly},
+		{"cat go.mod | grep module", RiskReadOnly},
+		{"git status && git log --oneline", RiskReadOnly},
+		{"ls 2>/dev/null", RiskReadOnly},
+		{"go test ./... 2>&1", RiskUnknown},
+		{"rm -rf build", RiskWorktreeWrite},
+		{"echo hi > out.txt", RiskWorktreeWrite},
+		{"sed -i 's/a/b/' main.go", RiskWorktreeWrite},
+		{"git commit -m 'fix'", RiskWorktreeWrite},
+		{"mytool --flag", RiskUnknown},
+		{"X=rm; $X -rf build", RiskUnknown},
+		{"curl https://example.com", RiskNetwork},
+		{"git push origin main", RiskNetwork},
+		{"npm install left-pad", RiskPackageInstall},
+		{"python3 -m pip install requests", RiskPackageInstall},
+		{"npx create-react-app app", RiskPackageInstall},
+		{"rm -rf /etc/nginx", RiskOutsideWrite},
+		{"cp config.yaml ../other/", RiskOutsideWrite},
+		{"echo x >> ~/.bashrc", RiskOutsideWrite},
+		{"cd /tmp && rm -rf cache", RiskOutsideWrite},
+		{"cd src && rm -rf gen", RiskWorktreeWrite},
+		{"git -C /other/repo commit -m x", RiskOutsideWrite},
+		{"pkill node", RiskProcessKill},
+		{"git push --force origin main", RiskGitHistoryRewrite},
+		{"git push origin +main", RiskGitHistoryRewrite},
+		{"git reset --hard HEAD~1", RiskGitHistoryRewrite},
+		{"git commit --amend --no-edit", RiskGitHistoryRewrite},
+		{"git rebase -i main", RiskGitHistoryRewrite},
+		{"git branch -D feature", RiskGitHistoryRewrite},
+		{"sudo apt-get install jq", RiskPrivilegeEscalation},
+		{"ls && sudo rm -rf / && git push -f", RiskPrivilegeEscalation},
+		{"bash -c 'git push --force'", RiskGitHistoryRewrite},
+		{`find . -name '*.tmp' -exec rm {} \;`, RiskWorktreeWrite},
+		{`awk 'BEGIN{system("rm -rf ~")}'`, RiskUnknown},
+		{"git -c core.fsmonitor='rm -rf ~' status", RiskUnknown},
+		{"git -c alias.x='!rm -rf ~' x", RiskUnknown},
+		{"git --config-env=core.pager=P log", RiskUnknown},
+		{"git config core.hooksPath hooks", RiskUnknown},
+		{"git add .", RiskWorktreeWrite},
+		{"sort -o /etc/passwd x", RiskOutsideWrite},
+		{"sort -o sorted.txt x", RiskWorktreeWrite},
+		{"sort x", RiskReadOnly},
+		{"yq -i '.a = 1' /etc/x.yaml", RiskOutsideWrite},
+		{"yq '.a' config.yaml", RiskReadOnly},
+		{"sed -n 'w /etc/x' in.txt", RiskOutsideWrite},
+		{"sed 's/a/b/w /etc/x' in.txt", RiskOutsideWrite},
+		{"sed -n '/re/p' in.txt", RiskReadOnly},
+		{"sed 's/a/b/e' in.txt", RiskUnknown},
+		{"sed -f script.sed in.txt", RiskUnknown},
+		{"tar -C / -xf p.tar", RiskOutsideWrite},
+		{"tar -xPf p.tar", RiskOutsideWrite},
+		{"tar xzf p.tgz", RiskWorktreeWrite},
+		{"tar -czf /tmp/p.tgz .", RiskOutsideWrite},
+		{"tar -tf p.tar", RiskReadOnly},
+		{"unzip -d /opt p.zip", RiskOutsideWrite},
+		{"unzip p.zip", RiskWorktreeWrite},
+		{"npm run anything", RiskUnknown},
+		{"make", RiskUnknown},
+		{"go run .", RiskUnknown},
+		{"go build ./...", RiskWorktreeWrite},
+		{"go test -exec rm ./...", RiskUnknown},
+		{"go build -toolexec=./x ./...", RiskUnknown},
+		{"go vet -vettool ./x ./...", RiskUnknown},
+		{"go vet ./...", RiskReadOnly},
+		{"cargo test", RiskUnknown},
+		{"cargo build --release", RiskUnknown},
+		{"find . -name '*.go'", RiskReadOnly},
+		{"find . -fprint /etc/x", RiskOutsideWrite},
+		{"find . -fprintf /etc/x '%p'", RiskOutsideWrite},
+		{"find . -fls out.txt", RiskWorktreeWrite},
+		{"xxd -r x /etc/y", RiskOutsideWrite},
+		{"xxd file", RiskReadOnly},
+		{"pushd /etc; touch x", RiskOutsideWrite},
+		{"pushd /etc; popd; touch x", RiskWorktreeWrite},
+		{"env -C /etc touch x", RiskOutsideWrite},
+		{"sudo -D /etc true; touch x", RiskPrivilegeEscalation},
+		{"dd if=/dev/zero of=/etc/x", RiskOutsideWrite},
+		{"curl -o /etc/x https://example.com", RiskOutsideWrite},
+		{"curl -o out https://example.com", RiskNetwork},
+		{"wget -O /etc/x https://example.com", RiskOutsideWrite},
+		{"cd / && go build ./...", RiskOutsideWrite},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got := Parse(tt.input).Classify(workDir)
+			if got.Risk != tt.want {
+				t.Errorf("Classify() = %v (%s), want %v", got.Risk, got.Reason, tt.want)
+			}
+
+			if got.Reason == "" {
+				t.Error("expected a reason")
+			}
+		})
+	}
+}
+
+func TestClassify_LabelsCommands(t *testing.T) {
+	result := Parse("git status && rm -rf /tmp/x")
+	result.Classify("/work/repo")
+
+	if result.Commands[0].Risk != RiskReadOnly {
+		t.Errorf("command[0]: expected read_only, got %v", result.Commands[0].Risk)
+	}
+
+	if result.Commands[1].Risk != RiskOutsideWrite {
+		t.Errorf("command[1]: expected outside_write, got %v", result.Commands[1].Risk)
+	}
+
+	if result.Commands[1].RiskReason == "" {
+		t.Error("command[1]: expected a reason")
+	}
+}
+
+func TestClassify_UnknownWorkDir(t *testing.T) {
+	if got := Parse("rm -rf build").Classify(""); got.Risk != RiskWorktreeWrite {
+		t.Errorf("relative path: expected worktree_write, got %v", got.Risk)
+	}
+
+	if got := Parse("rm -rf /work/repo/build").Classify(""); got.Risk != RiskOutsideWrite {
+		t.Errorf("absolute path: expected outside_write, got %v", got.Risk)
+	}
+}
+
+func TestParseRisk(t *testing.T) {
+	for r := RiskReadOnly; r <= RiskPrivilegeEscalation; r++ {
+		got, ok := ParseRisk(r.String())
+		if !ok || got != r {
+			t.Errorf("ParseRisk(%q) = %v, %v", r.String(), got, ok)
+		}
+
+		if r.Label() == "" {
+			t.Errorf("%v: expected a label", r)
+		}
+	}
+
+	if _, ok := ParseRisk("nope"); ok {
+		t.Error("expected unknown name to fail")
+	}
+}
+
+func TestRiskAutoAllowable(t *testing.T) {
+	for r := RiskReadOnly; r <= RiskPrivilegeEscalation; r++ {
+		want := r == RiskReadOnly || r == RiskWorktreeWrite
+		if got := r.AutoAllowable(); got != want {
+			t.Errorf("%v.AutoAllowable() = %v, want %v", r, got, want)
+		}
+	}
+}
+
+func TestWriteTargets(t *testing.T) {
+	got := Parse(`echo a > out.txt 2>&1 && cd sub && echo b >> "log.txt" 2>/dev/null; cd $X && echo c > x`).WriteTargets("/work/repo")
+
+	want := []WriteTarget{
+		{Path: "out.txt", Dir: "/work/repo"},
+		{Path: "log.txt", Dir: "/work/repo/sub"},
+		{Path: "x", Dir: ""},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("WriteTargets() = %+v, want %+v", got, want)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("WriteTargets()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWriteTargets_CommandOperands(t *testing.T) {
+	got := Parse(`tee -a log.txt < in && cd sub && cp a b dest && sed -i 's/a/b/' f.go && cat x`).WriteTargets("/work/repo")
+
+	want := []WriteTarget{
+		{Path: "log.txt", Dir: "/work/repo"},
+		{Path: "dest", Dir: "/work/repo/sub"},
+		{Path: "f.go", Dir: "/work/repo/sub"},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("WriteTargets() = %+v, want %+v", got, want)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("WriteTargets()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package shellparse
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+// SuggestCommandPattern generates a suggested wildcard pattern for a command.
+// Returns the full command string as-is so users can see the exact command
+// and manually generalize it with wildcards (e.g. *) if desired.
+func SuggestCommandPattern(cmd ParsedCommand) string {
+	return cmd.Raw
+}
+
+// SuggestRedirectPattern generates a suggested wildcard pattern for a redirect path.
+// Returns the exact path so users can see the full path and manually generalize
+// it with wildcards (e.g. *) if desired.
+func SuggestRedirectPattern(path string) string {
+	return path
+}
+
+// subcommandWord matches arguments that look like a subcommand ("test",
+// "run", "build:prod") rather than a path, value or flag.
+var subcommandWord = regexp.MustCompile(`^[a-z][a-z0-9_:-]*$`)
+
+// CommandKey groups variants of the same command: the executable's base
+// name followed by its first argument when that looks like a subcommand.
+// "go test ./..." and "go test -run X ./pkg" share the key "go test".
+func CommandKey(cmd ParsedCommand) string {
+	if cmd.Executable == "" {
+		return ""
+	}
+
+	key := path.Base(cmd.Executable)
+	if len(cmd.Args) > 0 && subcommandWord.MatchString(cmd.Args[0]) {
+		key += " " + cmd.Args[0]
+	}
+
+	return key
+}
+
+// GeneralizeCommandPattern returns a wildcard pattern covering all variants.
+// A single distinct command yields SuggestCommandPattern; otherwise the
+// words shared by all variants are kept and the rest becomes "*", e.g.
+// "go test ./..." and "go test -v ./pkg" become "go test *".
+func GeneralizeCommandPattern(variants []ParsedCommand) string {
+	if len(variants) == 0 {
+		return ""
+	}
+
+	common := commandWords(variants[0])
+	identical := true
+
+	for _, v := range variants[1:] {
+		if v.Raw != variants[0].Raw {
+			identical = false
+		}
+
+		words := commandWords(v)
+
+		n := 0
+		for n < len(common) && n < len(words) && common[n] == words[n] {
+			n++
+		}
+
+		common = common[:n]
+	}
+
+	if identical {
+		return SuggestCommandPattern(variants[0])
+	}
+
+	if len(common) == 0 {
+		return "*"
+	}
+
+	return strings.Join(common, " ") + " *"
+}
+
+func commandWords(cmd ParsedCommand) []string {
+	if cmd.Executable == "" {
+		return strings.Fields(cmd.Raw)
+	}
+
+	return append([]string{cmd.Executable}, cmd.Args...)
+}
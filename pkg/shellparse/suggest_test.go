@@ -0,0 +1,126 @@
+package shellparse
+
+import "testing"
+
+func TestSuggestCommandPattern(t *testing.T) {
+	tests := []struct {
+		name     string
+		cmd      ParsedCommand
+		expected string
+	}{
+		{
+			name:     "returns raw command as-is",
+			cmd:      ParsedCommand{Raw: "git status", Executable: "git", Args: []string{"status"}},
+			expected: "git status",
+		},
+		{
+			name:     "returns full command with args",
+			cmd:      ParsedCommand{Raw: "git checkout -b feature", Executable: "git", Args: []string{"checkout", "-b", "feature"}},
+			expected: "git checkout -b feature",
+		},
+		{
+			name:     "npm test with coverage",
+			cmd:      ParsedCommand{Raw: "npm test --coverage", Executable: "npm", Args: []string{"test", "--coverage"}},
+			expected: "npm test --coverage",
+		},
+		{
+			name:     "no args",
+			cmd:      ParsedCommand{Raw: "ls", Executable: "ls"},
+			expected: "ls",
+		},
+		{
+			name:     "unknown command with args",
+			cmd:      ParsedCommand{Raw: "myapp serve --port 8080", Executable: "myapp", Args: []string{"serve", "--port", "8080"}},
+			expected: "myapp serve --port 8080",
+		},
+		{
+			name:     "empty executable falls back to raw",
+			cmd:      ParsedCommand{Raw: "some raw command"},
+			expected: "some raw command",
+		},
+		{
+			name:     "command with command substitution",
+			cmd:      ParsedCommand{Raw: "cd $(git rev-parse --show-toplevel)/.claude/worktrees/foo", Executable: "cd", Args: []string{"$(git rev-parse --show-toplevel)/.claude/worktrees/foo"}},
+			expected: "cd $(git rev-parse --show-toplevel)/.claude/worktrees/foo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := SuggestCommandPattern(tt.cmd)
+			if result != tt.expected {
+				t.Errorf("SuggestCommandPattern() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSuggestRedirectPattern(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"/dev/null", "/dev/null"},
+		{"./output.txt", "./output.txt"},
+		{"../output.txt", "../output.txt"},
+		{"/tmp/foo", "/tmp/foo"},
+		{"/etc/passwd", "/etc/passwd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			result := SuggestRedirectPattern(tt.path)
+			if result != tt.expected {
+				t.Errorf("SuggestRedirectPattern(%q) = %q, want %q", tt.path, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCommandKey(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"go test ./...", "go test"},
+		{"/usr/bin/git push origin main", "git push"},
+		{"npm run build:prod", "npm run"},
+		{"ls -la", "ls"},
+		{"cat go.mod", "cat"},
+		{"X=1", "X=1"},
+	}
+
+	for _, tt := range tests {
+		if got := CommandKey(Parse(tt.input).Commands[0]); got != tt.want {
+			t.Errorf("CommandKey(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestGeneralizeCommandPattern(t *testing.T) {
+	variants := func(inputs ...string) []ParsedCommand {
+		var cmds []ParsedCommand
+		for _, in := range inputs {
+			cmds = append(cmds, Parse(in).Commands...)
+		}
+
+		return cmds
+	}
+
+	tests := []struct {
+		name     string
+		variants []ParsedCommand
+		want     string
+	}{
+		{"identical", variants("go test ./...", "go test ./..."), "go test ./..."},
+		{"shared subcommand", variants("go test ./...", "go test -v ./pkg/..."), "go test *"},
+		{"shared prefix", variants("git log --oneline -5", "git log --oneline -20"), "git log --oneline *"},
+		{"executable only", variants("ls -la", "ls src"), "ls *"},
+	}
+
+	for _, tt := range tests {
+		if got := GeneralizeCommandPattern(tt.variants); got != tt.want {
+			t.Errorf("%s: GeneralizeCommandPattern() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
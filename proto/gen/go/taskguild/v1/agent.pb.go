@@ -43,11 +43,13 @@ type AgentDefinition struct {
 	Skills []string `protobuf:"bytes,10,rep,name=skills,proto3" json:"skills,omitempty"` // skills to preload into agent context
 	Memory string   `protobuf:"bytes,11,opt,name=memory,proto3" json:"memory,omitempty"` // persistent memory scope: user, project, local
 	// metadata
-	IsSynced      bool                   `protobuf:"varint,12,opt,name=is_synced,json=isSynced,proto3" json:"is_synced,omitempty"` // true if synced from repository .claude/agents/ directory
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	IsSynced  bool                   `protobuf:"varint,12,opt,name=is_synced,json=isSynced,proto3" json:"is_synced,omitempty"` // true if synced from repository .claude/agents/ directory
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// models to fall back to, in order, on overload or rate-limit errors
+	FallbackModels []string `protobuf:"bytes,15,rep,name=fallback_models,json=fallbackModels,proto3" json:"fallback_models,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *AgentDefinition) Reset() {
@@ -178,6 +180,13 @@ func (x *AgentDefinition) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *AgentDefinition) GetFallbackModels() []string {
+	if x != nil {
+		return x.FallbackModels
+	}
+	return nil
+}
+
 type CreateAgentRequest struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
@@ -193,10 +202,12 @@ type CreateAgentRequest struct {
 	Model          string `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`
 	PermissionMode string `protobuf:"bytes,8,opt,name=permission_mode,json=permissionMode,proto3" json:"permission_mode,omitempty"`
 	// extensions
-	Skills        []string `protobuf:"bytes,9,rep,name=skills,proto3" json:"skills,omitempty"`
-	Memory        string   `protobuf:"bytes,10,opt,name=memory,proto3" json:"memory,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Skills []string `protobuf:"bytes,9,rep,name=skills,proto3" json:"skills,omitempty"`
+	Memory string   `protobuf:"bytes,10,opt,name=memory,proto3" json:"memory,omitempty"`
+	// models to fall back to, in order, on overload or rate-limit errors
+	FallbackModels []string `protobuf:"bytes,11,rep,name=fallback_models,json=fallbackModels,proto3" json:"fallback_models,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateAgentRequest) Reset() {
@@ -299,6 +310,13 @@ func (x *CreateAgentRequest) GetMemory() string {
 	return ""
 }
 
+func (x *CreateAgentRequest) GetFallbackModels() []string {
+	if x != nil {
+		return x.FallbackModels
+	}
+	return nil
+}
+
 type CreateAgentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Agent         *AgentDefinition       `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
@@ -550,10 +568,12 @@ type UpdateAgentRequest struct {
 	Model          string `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`
 	PermissionMode string `protobuf:"bytes,8,opt,name=permission_mode,json=permissionMode,proto3" json:"permission_mode,omitempty"`
 	// extensions
-	Skills        []string `protobuf:"bytes,9,rep,name=skills,proto3" json:"skills,omitempty"`
-	Memory        string   `protobuf:"bytes,10,opt,name=memory,proto3" json:"memory,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Skills []string `protobuf:"bytes,9,rep,name=skills,proto3" json:"skills,omitempty"`
+	Memory string   `protobuf:"bytes,10,opt,name=memory,proto3" json:"memory,omitempty"`
+	// models to fall back to, in order, on overload or rate-limit errors
+	FallbackModels []string `protobuf:"bytes,11,rep,name=fallback_models,json=fallbackModels,proto3" json:"fallback_models,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateAgentRequest) Reset() {
@@ -656,6 +676,13 @@ func (x *UpdateAgentRequest) GetMemory() string {
 	return ""
 }
 
+func (x *UpdateAgentRequest) GetFallbackModels() []string {
+	if x != nil {
+		return x.FallbackModels
+	}
+	return nil
+}
+
 type UpdateAgentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Agent         *AgentDefinition       `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
@@ -896,7 +923,7 @@ var File_taskguild_v1_agent_proto protoreflect.FileDescriptor
 
 const file_taskguild_v1_agent_proto_rawDesc = "" +
 	"\n" +
-	"\x18taskguild/v1/agent.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19taskguild/v1/common.proto\"\xd1\x03\n" +
+	"\x18taskguild/v1/agent.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19taskguild/v1/common.proto\"\xfa\x03\n" +
 	"\x0fAgentDefinition\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -915,7 +942,8 @@ const file_taskguild_v1_agent_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb1\x02\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12'\n" +
+	"\x0ffallback_models\x18\x0f \x03(\tR\x0efallbackModels\"\xda\x02\n" +
 	"\x12CreateAgentRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x12\n" +
@@ -928,7 +956,8 @@ const file_taskguild_v1_agent_proto_rawDesc = "" +
 	"\x0fpermission_mode\x18\b \x01(\tR\x0epermissionMode\x12\x16\n" +
 	"\x06skills\x18\t \x03(\tR\x06skills\x12\x16\n" +
 	"\x06memory\x18\n" +
-	" \x01(\tR\x06memory\"J\n" +
+	" \x01(\tR\x06memory\x12'\n" +
+	"\x0ffallback_models\x18\v \x03(\tR\x0efallbackModels\"J\n" +
 	"\x13CreateAgentResponse\x123\n" +
 	"\x05agent\x18\x01 \x01(\v2\x1d.taskguild.v1.AgentDefinitionR\x05agent\"!\n" +
 	"\x0fGetAgentRequest\x12\x0e\n" +
@@ -945,7 +974,7 @@ const file_taskguild_v1_agent_proto_rawDesc = "" +
 	"\x06agents\x18\x01 \x03(\v2\x1d.taskguild.v1.AgentDefinitionR\x06agents\x12@\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2 .taskguild.v1.PaginationResponseR\n" +
-	"pagination\"\xa2\x02\n" +
+	"pagination\"\xcb\x02\n" +
 	"\x12UpdateAgentRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -957,7 +986,8 @@ const file_taskguild_v1_agent_proto_rawDesc = "" +
 	"\x0fpermission_mode\x18\b \x01(\tR\x0epermissionMode\x12\x16\n" +
 	"\x06skills\x18\t \x03(\tR\x06skills\x12\x16\n" +
 	"\x06memory\x18\n" +
-	" \x01(\tR\x06memory\"J\n" +
+	" \x01(\tR\x06memory\x12'\n" +
+	"\x0ffallback_models\x18\v \x03(\tR\x0efallbackModels\"J\n" +
 	"\x13UpdateAgentResponse\x123\n" +
 	"\x05agent\x18\x01 \x01(\v2\x1d.taskguild.v1.AgentDefinitionR\x05agent\"$\n" +
 	"\x12DeleteAgentRequest\x12\x0e\n" +
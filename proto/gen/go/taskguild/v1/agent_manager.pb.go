@@ -395,7 +395,13 @@ type AgentManagerSubscribeRequest struct {
 	AgentVersion string `protobuf:"bytes,5,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
 	// work_dir is the absolute path to the agent's project root directory.
 	// The server uses this to resolve file paths for SyncFromDir operations.
-	WorkDir       string `protobuf:"bytes,6,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`
+	WorkDir string `protobuf:"bytes,6,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`
+	// projects lists every project served by a multi-project agent manager.
+	// When set, project_name and work_dir are ignored and max_concurrent_tasks
+	// is the global cap shared across all projects.
+	Projects []*ServedProject `protobuf:"bytes,7,rep,name=projects,proto3" json:"projects,omitempty"`
+	// draining is true when the agent-manager reconnects while still draining.
+	Draining      bool `protobuf:"varint,8,opt,name=draining,proto3" json:"draining,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -472,6 +478,20 @@ func (x *AgentManagerSubscribeRequest) GetWorkDir() string {
 	return ""
 }
 
+func (x *AgentManagerSubscribeRequest) GetProjects() []*ServedProject {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *AgentManagerSubscribeRequest) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
 type AgentCommand struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Command:
@@ -494,7 +514,15 @@ type AgentCommand struct {
 	//	*AgentCommand_SyncSkills
 	//	*AgentCommand_CompareSkills
 	//	*AgentCommand_SyncClaudeSettings
-	Command       isAgentCommand_Command `protobuf_oneof:"command"`
+	//	*AgentCommand_Drain
+	//	*AgentCommand_CompactTask
+	//	*AgentCommand_RollbackTask
+	//	*AgentCommand_TaskDiff
+	//	*AgentCommand_MergeWorktree
+	Command isAgentCommand_Command `protobuf_oneof:"command"`
+	// project_name is the project a broadcast command was sent for. Agent
+	// managers serving several projects use it to route the command.
+	ProjectName   string `protobuf:"bytes,100,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -698,6 +726,58 @@ func (x *AgentCommand) GetSyncClaudeSettings() *SyncClaudeSettingsCommand {
 	return nil
 }
 
+func (x *AgentCommand) GetDrain() *DrainCommand {
+	if x != nil {
+		if x, ok := x.Command.(*AgentCommand_Drain); ok {
+			return x.Drain
+		}
+	}
+	return nil
+}
+
+func (x *AgentCommand) GetCompactTask() *CompactTaskCommand {
+	if x != nil {
+		if x, ok := x.Command.(*AgentCommand_CompactTask); ok {
+			return x.CompactTask
+		}
+	}
+	return nil
+}
+
+func (x *AgentCommand) GetRollbackTask() *RollbackTaskCommand {
+	if x != nil {
+		if x, ok := x.Command.(*AgentCommand_RollbackTask); ok {
+			return x.RollbackTask
+		}
+	}
+	return nil
+}
+
+func (x *AgentCommand) GetTaskDiff() *TaskDiffCommand {
+	if x != nil {
+		if x, ok := x.Command.(*AgentCommand_TaskDiff); ok {
+			return x.TaskDiff
+		}
+	}
+	return nil
+}
+
+func (x *AgentCommand) GetMergeWorktree() *MergeWorktreeCommand {
+	if x != nil {
+		if x, ok := x.Command.(*AgentCommand_MergeWorktree); ok {
+			return x.MergeWorktree
+		}
+	}
+	return nil
+}
+
+func (x *AgentCommand) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
 type isAgentCommand_Command interface {
 	isAgentCommand_Command()
 }
@@ -784,6 +864,35 @@ type AgentCommand_SyncClaudeSettings struct {
 	SyncClaudeSettings *SyncClaudeSettingsCommand `protobuf:"bytes,18,opt,name=sync_claude_settings,json=syncClaudeSettings,proto3,oneof"`
 }
 
+type AgentCommand_Drain struct {
+	// DrainCommand tells the agent to stop claiming new tasks (or to resume).
+	Drain *DrainCommand `protobuf:"bytes,19,opt,name=drain,proto3,oneof"`
+}
+
+type AgentCommand_CompactTask struct {
+	// CompactTaskCommand tells the agent to continue a running task in a
+	// fresh session seeded with a handoff summary.
+	CompactTask *CompactTaskCommand `protobuf:"bytes,20,opt,name=compact_task,json=compactTask,proto3,oneof"`
+}
+
+type AgentCommand_RollbackTask struct {
+	// RollbackTaskCommand tells the agent to restore a task's worktree to a
+	// checkpoint.
+	RollbackTask *RollbackTaskCommand `protobuf:"bytes,21,opt,name=rollback_task,json=rollbackTask,proto3,oneof"`
+}
+
+type AgentCommand_TaskDiff struct {
+	// TaskDiffCommand tells the agent to compute the diff of a task's
+	// worktree and report it via ReportTaskDiff.
+	TaskDiff *TaskDiffCommand `protobuf:"bytes,22,opt,name=task_diff,json=taskDiff,proto3,oneof"`
+}
+
+type AgentCommand_MergeWorktree struct {
+	// MergeWorktreeCommand tells the agent to rebase, verify and merge a
+	// task's worktree branch into the default branch (merge queue).
+	MergeWorktree *MergeWorktreeCommand `protobuf:"bytes,23,opt,name=merge_worktree,json=mergeWorktree,proto3,oneof"`
+}
+
 func (*AgentCommand_TaskAvailable) isAgentCommand_Command() {}
 
 func (*AgentCommand_AssignTask) isAgentCommand_Command() {}
@@ -820,6 +929,85 @@ func (*AgentCommand_CompareSkills) isAgentCommand_Command() {}
 
 func (*AgentCommand_SyncClaudeSettings) isAgentCommand_Command() {}
 
+func (*AgentCommand_Drain) isAgentCommand_Command() {}
+
+func (*AgentCommand_CompactTask) isAgentCommand_Command() {}
+
+func (*AgentCommand_RollbackTask) isAgentCommand_Command() {}
+
+func (*AgentCommand_TaskDiff) isAgentCommand_Command() {}
+
+func (*AgentCommand_MergeWorktree) isAgentCommand_Command() {}
+
+// ServedProject describes one project served by an agent manager.
+type ServedProject struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ProjectName        string                 `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	WorkDir            string                 `protobuf:"bytes,2,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`
+	MaxConcurrentTasks int32                  `protobuf:"varint,3,opt,name=max_concurrent_tasks,json=maxConcurrentTasks,proto3" json:"max_concurrent_tasks,omitempty"`
+	Labels             []string               `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ServedProject) Reset() {
+	*x = ServedProject{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServedProject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServedProject) ProtoMessage() {}
+
+func (x *ServedProject) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServedProject.ProtoReflect.Descriptor instead.
+func (*ServedProject) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ServedProject) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *ServedProject) GetWorkDir() string {
+	if x != nil {
+		return x.WorkDir
+	}
+	return ""
+}
+
+func (x *ServedProject) GetMaxConcurrentTasks() int32 {
+	if x != nil {
+		return x.MaxConcurrentTasks
+	}
+	return 0
+}
+
+func (x *ServedProject) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 // PingCommand is a keepalive message. The client should silently ignore it.
 type PingCommand struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -829,7 +1017,7 @@ type PingCommand struct {
 
 func (x *PingCommand) Reset() {
 	*x = PingCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[2]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -841,7 +1029,7 @@ func (x *PingCommand) String() string {
 func (*PingCommand) ProtoMessage() {}
 
 func (x *PingCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[2]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,7 +1042,7 @@ func (x *PingCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PingCommand.ProtoReflect.Descriptor instead.
 func (*PingCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{2}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{3}
 }
 
 type TaskAvailableCommand struct {
@@ -869,7 +1057,7 @@ type TaskAvailableCommand struct {
 
 func (x *TaskAvailableCommand) Reset() {
 	*x = TaskAvailableCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[3]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -881,7 +1069,7 @@ func (x *TaskAvailableCommand) String() string {
 func (*TaskAvailableCommand) ProtoMessage() {}
 
 func (x *TaskAvailableCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[3]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -894,7 +1082,7 @@ func (x *TaskAvailableCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskAvailableCommand.ProtoReflect.Descriptor instead.
 func (*TaskAvailableCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{3}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TaskAvailableCommand) GetTaskId() string {
@@ -938,7 +1126,7 @@ type AssignTaskCommand struct {
 
 func (x *AssignTaskCommand) Reset() {
 	*x = AssignTaskCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[4]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -950,7 +1138,7 @@ func (x *AssignTaskCommand) String() string {
 func (*AssignTaskCommand) ProtoMessage() {}
 
 func (x *AssignTaskCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[4]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -963,7 +1151,7 @@ func (x *AssignTaskCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignTaskCommand.ProtoReflect.Descriptor instead.
 func (*AssignTaskCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{4}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AssignTaskCommand) GetTaskId() string {
@@ -1011,7 +1199,7 @@ type CancelTaskCommand struct {
 
 func (x *CancelTaskCommand) Reset() {
 	*x = CancelTaskCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[5]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1023,7 +1211,7 @@ func (x *CancelTaskCommand) String() string {
 func (*CancelTaskCommand) ProtoMessage() {}
 
 func (x *CancelTaskCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[5]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1036,7 +1224,7 @@ func (x *CancelTaskCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelTaskCommand.ProtoReflect.Descriptor instead.
 func (*CancelTaskCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{5}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CancelTaskCommand) GetTaskId() string {
@@ -1063,7 +1251,7 @@ type InteractionResponseCommand struct {
 
 func (x *InteractionResponseCommand) Reset() {
 	*x = InteractionResponseCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[6]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1075,7 +1263,7 @@ func (x *InteractionResponseCommand) String() string {
 func (*InteractionResponseCommand) ProtoMessage() {}
 
 func (x *InteractionResponseCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[6]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1088,7 +1276,7 @@ func (x *InteractionResponseCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InteractionResponseCommand.ProtoReflect.Descriptor instead.
 func (*InteractionResponseCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{6}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *InteractionResponseCommand) GetInteractionId() string {
@@ -1117,7 +1305,7 @@ type SyncAgentsCommand struct {
 
 func (x *SyncAgentsCommand) Reset() {
 	*x = SyncAgentsCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[7]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1129,7 +1317,7 @@ func (x *SyncAgentsCommand) String() string {
 func (*SyncAgentsCommand) ProtoMessage() {}
 
 func (x *SyncAgentsCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[7]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1142,7 +1330,7 @@ func (x *SyncAgentsCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncAgentsCommand.ProtoReflect.Descriptor instead.
 func (*SyncAgentsCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{7}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SyncAgentsCommand) GetForceOverwriteAgentNames() []string {
@@ -1162,7 +1350,7 @@ type SyncPermissionsCommand struct {
 
 func (x *SyncPermissionsCommand) Reset() {
 	*x = SyncPermissionsCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[8]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1174,7 +1362,7 @@ func (x *SyncPermissionsCommand) String() string {
 func (*SyncPermissionsCommand) ProtoMessage() {}
 
 func (x *SyncPermissionsCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[8]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1187,21 +1375,24 @@ func (x *SyncPermissionsCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncPermissionsCommand.ProtoReflect.Descriptor instead.
 func (*SyncPermissionsCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{8}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{9}
 }
 
 // ListWorktreesCommand requests the agent-manager to scan and report
 // available git worktrees in its working directory.
 type ListWorktreesCommand struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// base_branch is the project's default branch, used to detect merged
+	// worktree branches. Empty means detect it.
+	BaseBranch    string `protobuf:"bytes,2,opt,name=base_branch,json=baseBranch,proto3" json:"base_branch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListWorktreesCommand) Reset() {
 	*x = ListWorktreesCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[9]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1213,7 +1404,7 @@ func (x *ListWorktreesCommand) String() string {
 func (*ListWorktreesCommand) ProtoMessage() {}
 
 func (x *ListWorktreesCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[9]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1226,7 +1417,7 @@ func (x *ListWorktreesCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWorktreesCommand.ProtoReflect.Descriptor instead.
 func (*ListWorktreesCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{9}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListWorktreesCommand) GetRequestId() string {
@@ -1236,6 +1427,13 @@ func (x *ListWorktreesCommand) GetRequestId() string {
 	return ""
 }
 
+func (x *ListWorktreesCommand) GetBaseBranch() string {
+	if x != nil {
+		return x.BaseBranch
+	}
+	return ""
+}
+
 type ClaimTaskRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	TaskId         string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -1246,7 +1444,7 @@ type ClaimTaskRequest struct {
 
 func (x *ClaimTaskRequest) Reset() {
 	*x = ClaimTaskRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[10]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1258,7 +1456,7 @@ func (x *ClaimTaskRequest) String() string {
 func (*ClaimTaskRequest) ProtoMessage() {}
 
 func (x *ClaimTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[10]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1271,7 +1469,7 @@ func (x *ClaimTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClaimTaskRequest.ProtoReflect.Descriptor instead.
 func (*ClaimTaskRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{10}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ClaimTaskRequest) GetTaskId() string {
@@ -1294,13 +1492,16 @@ type ClaimTaskResponse struct {
 	AgentConfigId string                 `protobuf:"bytes,2,opt,name=agent_config_id,json=agentConfigId,proto3" json:"agent_config_id,omitempty"`
 	Instructions  string                 `protobuf:"bytes,3,opt,name=instructions,proto3" json:"instructions,omitempty"`
 	Metadata      map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// secrets are the project's secrets (name -> value), exposed to the Claude
+	// session and hooks as environment variables.
+	Secrets       map[string]string `protobuf:"bytes,5,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ClaimTaskResponse) Reset() {
 	*x = ClaimTaskResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[11]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1312,7 +1513,7 @@ func (x *ClaimTaskResponse) String() string {
 func (*ClaimTaskResponse) ProtoMessage() {}
 
 func (x *ClaimTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[11]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1325,7 +1526,7 @@ func (x *ClaimTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClaimTaskResponse.ProtoReflect.Descriptor instead.
 func (*ClaimTaskResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{11}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ClaimTaskResponse) GetSuccess() bool {
@@ -1356,18 +1557,28 @@ func (x *ClaimTaskResponse) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *ClaimTaskResponse) GetSecrets() map[string]string {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
 type ReportTaskResultRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Summary       string                 `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TaskId       string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Summary      string                 `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	ErrorMessage string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// result_id is a client-generated ID. A result replayed from the agent's
+	// outbox with an already-processed ID is ignored.
+	ResultId      string `protobuf:"bytes,5,opt,name=result_id,json=resultId,proto3" json:"result_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReportTaskResultRequest) Reset() {
 	*x = ReportTaskResultRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[12]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1379,7 +1590,7 @@ func (x *ReportTaskResultRequest) String() string {
 func (*ReportTaskResultRequest) ProtoMessage() {}
 
 func (x *ReportTaskResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[12]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1392,7 +1603,7 @@ func (x *ReportTaskResultRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportTaskResultRequest.ProtoReflect.Descriptor instead.
 func (*ReportTaskResultRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{12}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ReportTaskResultRequest) GetTaskId() string {
@@ -1416,6 +1627,13 @@ func (x *ReportTaskResultRequest) GetErrorMessage() string {
 	return ""
 }
 
+func (x *ReportTaskResultRequest) GetResultId() string {
+	if x != nil {
+		return x.ResultId
+	}
+	return ""
+}
+
 type ReportTaskResultResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1424,7 +1642,7 @@ type ReportTaskResultResponse struct {
 
 func (x *ReportTaskResultResponse) Reset() {
 	*x = ReportTaskResultResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[13]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1436,7 +1654,7 @@ func (x *ReportTaskResultResponse) String() string {
 func (*ReportTaskResultResponse) ProtoMessage() {}
 
 func (x *ReportTaskResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[13]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1449,7 +1667,7 @@ func (x *ReportTaskResultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportTaskResultResponse.ProtoReflect.Descriptor instead.
 func (*ReportTaskResultResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{13}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{14}
 }
 
 type ReportAgentStatusRequest struct {
@@ -1464,7 +1682,7 @@ type ReportAgentStatusRequest struct {
 
 func (x *ReportAgentStatusRequest) Reset() {
 	*x = ReportAgentStatusRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[14]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1476,7 +1694,7 @@ func (x *ReportAgentStatusRequest) String() string {
 func (*ReportAgentStatusRequest) ProtoMessage() {}
 
 func (x *ReportAgentStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[14]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1489,7 +1707,7 @@ func (x *ReportAgentStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportAgentStatusRequest.ProtoReflect.Descriptor instead.
 func (*ReportAgentStatusRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{14}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ReportAgentStatusRequest) GetAgentManagerId() string {
@@ -1528,7 +1746,7 @@ type ReportAgentStatusResponse struct {
 
 func (x *ReportAgentStatusResponse) Reset() {
 	*x = ReportAgentStatusResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[15]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1540,7 +1758,7 @@ func (x *ReportAgentStatusResponse) String() string {
 func (*ReportAgentStatusResponse) ProtoMessage() {}
 
 func (x *ReportAgentStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[15]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1553,7 +1771,7 @@ func (x *ReportAgentStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportAgentStatusResponse.ProtoReflect.Descriptor instead.
 func (*ReportAgentStatusResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{15}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{16}
 }
 
 type HeartbeatRequest struct {
@@ -1561,13 +1779,16 @@ type HeartbeatRequest struct {
 	AgentManagerId string                 `protobuf:"bytes,1,opt,name=agent_manager_id,json=agentManagerId,proto3" json:"agent_manager_id,omitempty"`
 	ActiveTasks    int32                  `protobuf:"varint,2,opt,name=active_tasks,json=activeTasks,proto3" json:"active_tasks,omitempty"`
 	Timestamp      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// draining is true while the agent-manager is draining. Together with
+	// active_tasks == 0 it means the agent-manager is idle and safe to stop.
+	Draining      bool `protobuf:"varint,4,opt,name=draining,proto3" json:"draining,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *HeartbeatRequest) Reset() {
 	*x = HeartbeatRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[16]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1579,7 +1800,7 @@ func (x *HeartbeatRequest) String() string {
 func (*HeartbeatRequest) ProtoMessage() {}
 
 func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[16]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1592,7 +1813,7 @@ func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
 func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{16}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *HeartbeatRequest) GetAgentManagerId() string {
@@ -1616,6 +1837,13 @@ func (x *HeartbeatRequest) GetTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *HeartbeatRequest) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
 type HeartbeatResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1624,7 +1852,7 @@ type HeartbeatResponse struct {
 
 func (x *HeartbeatResponse) Reset() {
 	*x = HeartbeatResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[17]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1636,7 +1864,7 @@ func (x *HeartbeatResponse) String() string {
 func (*HeartbeatResponse) ProtoMessage() {}
 
 func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[17]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1649,7 +1877,7 @@ func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
 func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{17}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{18}
 }
 
 type CreateInteractionRequest struct {
@@ -1667,7 +1895,7 @@ type CreateInteractionRequest struct {
 
 func (x *CreateInteractionRequest) Reset() {
 	*x = CreateInteractionRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[18]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1679,7 +1907,7 @@ func (x *CreateInteractionRequest) String() string {
 func (*CreateInteractionRequest) ProtoMessage() {}
 
 func (x *CreateInteractionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[18]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1692,7 +1920,7 @@ func (x *CreateInteractionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInteractionRequest.ProtoReflect.Descriptor instead.
 func (*CreateInteractionRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{18}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CreateInteractionRequest) GetTaskId() string {
@@ -1753,7 +1981,7 @@ type CreateInteractionResponse struct {
 
 func (x *CreateInteractionResponse) Reset() {
 	*x = CreateInteractionResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[19]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1765,7 +1993,7 @@ func (x *CreateInteractionResponse) String() string {
 func (*CreateInteractionResponse) ProtoMessage() {}
 
 func (x *CreateInteractionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[19]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1778,7 +2006,7 @@ func (x *CreateInteractionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInteractionResponse.ProtoReflect.Descriptor instead.
 func (*CreateInteractionResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{19}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CreateInteractionResponse) GetInteraction() *Interaction {
@@ -1797,7 +2025,7 @@ type GetInteractionResponseRequest struct {
 
 func (x *GetInteractionResponseRequest) Reset() {
 	*x = GetInteractionResponseRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[20]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1809,7 +2037,7 @@ func (x *GetInteractionResponseRequest) String() string {
 func (*GetInteractionResponseRequest) ProtoMessage() {}
 
 func (x *GetInteractionResponseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[20]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1822,7 +2050,7 @@ func (x *GetInteractionResponseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInteractionResponseRequest.ProtoReflect.Descriptor instead.
 func (*GetInteractionResponseRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{20}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetInteractionResponseRequest) GetInteractionId() string {
@@ -1841,7 +2069,7 @@ type GetInteractionResponseResponse struct {
 
 func (x *GetInteractionResponseResponse) Reset() {
 	*x = GetInteractionResponseResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[21]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1853,7 +2081,7 @@ func (x *GetInteractionResponseResponse) String() string {
 func (*GetInteractionResponseResponse) ProtoMessage() {}
 
 func (x *GetInteractionResponseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[21]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1866,7 +2094,7 @@ func (x *GetInteractionResponseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInteractionResponseResponse.ProtoReflect.Descriptor instead.
 func (*GetInteractionResponseResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{21}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetInteractionResponseResponse) GetInteraction() *Interaction {
@@ -1885,7 +2113,7 @@ type SyncAgentsRequest struct {
 
 func (x *SyncAgentsRequest) Reset() {
 	*x = SyncAgentsRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[22]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1897,7 +2125,7 @@ func (x *SyncAgentsRequest) String() string {
 func (*SyncAgentsRequest) ProtoMessage() {}
 
 func (x *SyncAgentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[22]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1910,7 +2138,7 @@ func (x *SyncAgentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncAgentsRequest.ProtoReflect.Descriptor instead.
 func (*SyncAgentsRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{22}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SyncAgentsRequest) GetProjectName() string {
@@ -1929,7 +2157,7 @@ type SyncAgentsResponse struct {
 
 func (x *SyncAgentsResponse) Reset() {
 	*x = SyncAgentsResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[23]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1941,7 +2169,7 @@ func (x *SyncAgentsResponse) String() string {
 func (*SyncAgentsResponse) ProtoMessage() {}
 
 func (x *SyncAgentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[23]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1954,7 +2182,7 @@ func (x *SyncAgentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncAgentsResponse.ProtoReflect.Descriptor instead.
 func (*SyncAgentsResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{23}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SyncAgentsResponse) GetAgents() []*AgentDefinition {
@@ -1976,7 +2204,7 @@ type SyncPermissionsRequest struct {
 
 func (x *SyncPermissionsRequest) Reset() {
 	*x = SyncPermissionsRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[24]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1988,7 +2216,7 @@ func (x *SyncPermissionsRequest) String() string {
 func (*SyncPermissionsRequest) ProtoMessage() {}
 
 func (x *SyncPermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[24]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2001,7 +2229,7 @@ func (x *SyncPermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncPermissionsRequest.ProtoReflect.Descriptor instead.
 func (*SyncPermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{24}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SyncPermissionsRequest) GetProjectName() string {
@@ -2041,7 +2269,7 @@ type SyncPermissionsResponse struct {
 
 func (x *SyncPermissionsResponse) Reset() {
 	*x = SyncPermissionsResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[25]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2053,7 +2281,7 @@ func (x *SyncPermissionsResponse) String() string {
 func (*SyncPermissionsResponse) ProtoMessage() {}
 
 func (x *SyncPermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[25]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2066,7 +2294,7 @@ func (x *SyncPermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncPermissionsResponse.ProtoReflect.Descriptor instead.
 func (*SyncPermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{25}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *SyncPermissionsResponse) GetPermissions() *PermissionSet {
@@ -2077,19 +2305,24 @@ func (x *SyncPermissionsResponse) GetPermissions() *PermissionSet {
 }
 
 type ReportTaskLogRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Level         TaskLogLevel           `protobuf:"varint,2,opt,name=level,proto3,enum=taskguild.v1.TaskLogLevel" json:"level,omitempty"`
-	Category      TaskLogCategory        `protobuf:"varint,3,opt,name=category,proto3,enum=taskguild.v1.TaskLogCategory" json:"category,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	Metadata      map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TaskId   string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Level    TaskLogLevel           `protobuf:"varint,2,opt,name=level,proto3,enum=taskguild.v1.TaskLogLevel" json:"level,omitempty"`
+	Category TaskLogCategory        `protobuf:"varint,3,opt,name=category,proto3,enum=taskguild.v1.TaskLogCategory" json:"category,omitempty"`
+	Message  string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Metadata map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// log_id is an optional client-generated ULID used as the TaskLog ID, so
+	// logs replayed from the agent's outbox are stored at most once.
+	LogId string `protobuf:"bytes,6,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	// created_at is when the agent produced the log (defaults to receipt time).
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReportTaskLogRequest) Reset() {
 	*x = ReportTaskLogRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[26]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2101,7 +2334,7 @@ func (x *ReportTaskLogRequest) String() string {
 func (*ReportTaskLogRequest) ProtoMessage() {}
 
 func (x *ReportTaskLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[26]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2114,7 +2347,7 @@ func (x *ReportTaskLogRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportTaskLogRequest.ProtoReflect.Descriptor instead.
 func (*ReportTaskLogRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{26}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ReportTaskLogRequest) GetTaskId() string {
@@ -2152,6 +2385,20 @@ func (x *ReportTaskLogRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *ReportTaskLogRequest) GetLogId() string {
+	if x != nil {
+		return x.LogId
+	}
+	return ""
+}
+
+func (x *ReportTaskLogRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 type ReportTaskLogResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -2160,7 +2407,7 @@ type ReportTaskLogResponse struct {
 
 func (x *ReportTaskLogResponse) Reset() {
 	*x = ReportTaskLogResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[27]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2172,7 +2419,7 @@ func (x *ReportTaskLogResponse) String() string {
 func (*ReportTaskLogResponse) ProtoMessage() {}
 
 func (x *ReportTaskLogResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[27]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2185,23 +2432,30 @@ func (x *ReportTaskLogResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportTaskLogResponse.ProtoReflect.Descriptor instead.
 func (*ReportTaskLogResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{27}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{28}
 }
 
 type WorktreeInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // worktree directory name (e.g., "y3cfp6_agent")
-	Branch        string                 `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`                                 // git branch name (e.g., "worktree-y3cfp6_agent")
-	TaskId        string                 `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`                   // associated task ID if known
-	HasChanges    bool                   `protobuf:"varint,4,opt,name=has_changes,json=hasChanges,proto3" json:"has_changes,omitempty"`      // true if worktree has uncommitted changes
-	ChangedFiles  []string               `protobuf:"bytes,5,rep,name=changed_files,json=changedFiles,proto3" json:"changed_files,omitempty"` // list of changed file paths (relative to worktree root)
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                             // worktree directory name (e.g., "y3cfp6_agent")
+	Branch         string                 `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`                                         // git branch name (e.g., "worktree-y3cfp6_agent")
+	TaskId         string                 `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`                           // associated task ID if known
+	HasChanges     bool                   `protobuf:"varint,4,opt,name=has_changes,json=hasChanges,proto3" json:"has_changes,omitempty"`              // true if worktree has uncommitted changes
+	ChangedFiles   []string               `protobuf:"bytes,5,rep,name=changed_files,json=changedFiles,proto3" json:"changed_files,omitempty"`         // list of changed file paths (relative to worktree root)
+	SizeBytes      int64                  `protobuf:"varint,6,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`                 // disk usage of the worktree directory
+	LastModifiedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_modified_at,json=lastModifiedAt,proto3" json:"last_modified_at,omitempty"` // newest file modification time in the worktree
+	Merged         bool                   `protobuf:"varint,8,opt,name=merged,proto3" json:"merged,omitempty"`                                        // branch is merged into the default branch
+	// The owning task is filled in by the server from task metadata.
+	TaskTitle     string `protobuf:"bytes,9,opt,name=task_title,json=taskTitle,proto3" json:"task_title,omitempty"`
+	TaskStatus    string `protobuf:"bytes,10,opt,name=task_status,json=taskStatus,proto3" json:"task_status,omitempty"`
+	TaskArchived  bool   `protobuf:"varint,11,opt,name=task_archived,json=taskArchived,proto3" json:"task_archived,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WorktreeInfo) Reset() {
 	*x = WorktreeInfo{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[28]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2213,7 +2467,7 @@ func (x *WorktreeInfo) String() string {
 func (*WorktreeInfo) ProtoMessage() {}
 
 func (x *WorktreeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[28]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2226,7 +2480,7 @@ func (x *WorktreeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorktreeInfo.ProtoReflect.Descriptor instead.
 func (*WorktreeInfo) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{28}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *WorktreeInfo) GetName() string {
@@ -2264,19 +2518,62 @@ func (x *WorktreeInfo) GetChangedFiles() []string {
 	return nil
 }
 
+func (x *WorktreeInfo) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *WorktreeInfo) GetLastModifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModifiedAt
+	}
+	return nil
+}
+
+func (x *WorktreeInfo) GetMerged() bool {
+	if x != nil {
+		return x.Merged
+	}
+	return false
+}
+
+func (x *WorktreeInfo) GetTaskTitle() string {
+	if x != nil {
+		return x.TaskTitle
+	}
+	return ""
+}
+
+func (x *WorktreeInfo) GetTaskStatus() string {
+	if x != nil {
+		return x.TaskStatus
+	}
+	return ""
+}
+
+func (x *WorktreeInfo) GetTaskArchived() bool {
+	if x != nil {
+		return x.TaskArchived
+	}
+	return false
+}
+
 // DeleteWorktreeCommand tells the agent to remove a git worktree and its branch.
 type DeleteWorktreeCommand struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	WorktreeName  string                 `protobuf:"bytes,2,opt,name=worktree_name,json=worktreeName,proto3" json:"worktree_name,omitempty"` // directory name under .claude/worktrees/
 	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`                                  // if true, delete even when uncommitted changes exist
+	KeepBranch    bool                   `protobuf:"varint,4,opt,name=keep_branch,json=keepBranch,proto3" json:"keep_branch,omitempty"`      // if true, keep the worktree's branch (used for unmerged work)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteWorktreeCommand) Reset() {
 	*x = DeleteWorktreeCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[29]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2288,7 +2585,7 @@ func (x *DeleteWorktreeCommand) String() string {
 func (*DeleteWorktreeCommand) ProtoMessage() {}
 
 func (x *DeleteWorktreeCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[29]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2301,7 +2598,7 @@ func (x *DeleteWorktreeCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteWorktreeCommand.ProtoReflect.Descriptor instead.
 func (*DeleteWorktreeCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{29}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *DeleteWorktreeCommand) GetRequestId() string {
@@ -2325,6 +2622,13 @@ func (x *DeleteWorktreeCommand) GetForce() bool {
 	return false
 }
 
+func (x *DeleteWorktreeCommand) GetKeepBranch() bool {
+	if x != nil {
+		return x.KeepBranch
+	}
+	return false
+}
+
 type ReportWorktreeListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
@@ -2336,7 +2640,7 @@ type ReportWorktreeListRequest struct {
 
 func (x *ReportWorktreeListRequest) Reset() {
 	*x = ReportWorktreeListRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[30]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2348,7 +2652,7 @@ func (x *ReportWorktreeListRequest) String() string {
 func (*ReportWorktreeListRequest) ProtoMessage() {}
 
 func (x *ReportWorktreeListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[30]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2361,7 +2665,7 @@ func (x *ReportWorktreeListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportWorktreeListRequest.ProtoReflect.Descriptor instead.
 func (*ReportWorktreeListRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{30}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ReportWorktreeListRequest) GetRequestId() string {
@@ -2393,7 +2697,7 @@ type ReportWorktreeListResponse struct {
 
 func (x *ReportWorktreeListResponse) Reset() {
 	*x = ReportWorktreeListResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[31]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2405,7 +2709,7 @@ func (x *ReportWorktreeListResponse) String() string {
 func (*ReportWorktreeListResponse) ProtoMessage() {}
 
 func (x *ReportWorktreeListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[31]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2418,7 +2722,7 @@ func (x *ReportWorktreeListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportWorktreeListResponse.ProtoReflect.Descriptor instead.
 func (*ReportWorktreeListResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{31}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{32}
 }
 
 type RequestWorktreeListRequest struct {
@@ -2430,7 +2734,7 @@ type RequestWorktreeListRequest struct {
 
 func (x *RequestWorktreeListRequest) Reset() {
 	*x = RequestWorktreeListRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[32]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2442,7 +2746,7 @@ func (x *RequestWorktreeListRequest) String() string {
 func (*RequestWorktreeListRequest) ProtoMessage() {}
 
 func (x *RequestWorktreeListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[32]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2455,7 +2759,7 @@ func (x *RequestWorktreeListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestWorktreeListRequest.ProtoReflect.Descriptor instead.
 func (*RequestWorktreeListRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{32}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *RequestWorktreeListRequest) GetProjectId() string {
@@ -2474,7 +2778,7 @@ type RequestWorktreeListResponse struct {
 
 func (x *RequestWorktreeListResponse) Reset() {
 	*x = RequestWorktreeListResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[33]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2486,7 +2790,7 @@ func (x *RequestWorktreeListResponse) String() string {
 func (*RequestWorktreeListResponse) ProtoMessage() {}
 
 func (x *RequestWorktreeListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[33]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2499,7 +2803,7 @@ func (x *RequestWorktreeListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestWorktreeListResponse.ProtoReflect.Descriptor instead.
 func (*RequestWorktreeListResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{33}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *RequestWorktreeListResponse) GetRequestId() string {
@@ -2518,7 +2822,7 @@ type GetWorktreeListRequest struct {
 
 func (x *GetWorktreeListRequest) Reset() {
 	*x = GetWorktreeListRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[34]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2530,7 +2834,7 @@ func (x *GetWorktreeListRequest) String() string {
 func (*GetWorktreeListRequest) ProtoMessage() {}
 
 func (x *GetWorktreeListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[34]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2543,7 +2847,7 @@ func (x *GetWorktreeListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorktreeListRequest.ProtoReflect.Descriptor instead.
 func (*GetWorktreeListRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{34}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetWorktreeListRequest) GetProjectId() string {
@@ -2554,15 +2858,18 @@ func (x *GetWorktreeListRequest) GetProjectId() string {
 }
 
 type GetWorktreeListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Worktrees     []*WorktreeInfo        `protobuf:"bytes,1,rep,name=worktrees,proto3" json:"worktrees,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Worktrees      []*WorktreeInfo        `protobuf:"bytes,1,rep,name=worktrees,proto3" json:"worktrees,omitempty"`
+	TotalSizeBytes int64                  `protobuf:"varint,2,opt,name=total_size_bytes,json=totalSizeBytes,proto3" json:"total_size_bytes,omitempty"`
+	// disk_quota_bytes is the project's worktree disk quota (0 if unset).
+	DiskQuotaBytes int64 `protobuf:"varint,3,opt,name=disk_quota_bytes,json=diskQuotaBytes,proto3" json:"disk_quota_bytes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetWorktreeListResponse) Reset() {
 	*x = GetWorktreeListResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[35]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2574,7 +2881,7 @@ func (x *GetWorktreeListResponse) String() string {
 func (*GetWorktreeListResponse) ProtoMessage() {}
 
 func (x *GetWorktreeListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[35]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2587,7 +2894,7 @@ func (x *GetWorktreeListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorktreeListResponse.ProtoReflect.Descriptor instead.
 func (*GetWorktreeListResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{35}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetWorktreeListResponse) GetWorktrees() []*WorktreeInfo {
@@ -2597,6 +2904,20 @@ func (x *GetWorktreeListResponse) GetWorktrees() []*WorktreeInfo {
 	return nil
 }
 
+func (x *GetWorktreeListResponse) GetTotalSizeBytes() int64 {
+	if x != nil {
+		return x.TotalSizeBytes
+	}
+	return 0
+}
+
+func (x *GetWorktreeListResponse) GetDiskQuotaBytes() int64 {
+	if x != nil {
+		return x.DiskQuotaBytes
+	}
+	return 0
+}
+
 type RequestWorktreeDeleteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
@@ -2608,7 +2929,7 @@ type RequestWorktreeDeleteRequest struct {
 
 func (x *RequestWorktreeDeleteRequest) Reset() {
 	*x = RequestWorktreeDeleteRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[36]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2620,7 +2941,7 @@ func (x *RequestWorktreeDeleteRequest) String() string {
 func (*RequestWorktreeDeleteRequest) ProtoMessage() {}
 
 func (x *RequestWorktreeDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[36]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2633,7 +2954,7 @@ func (x *RequestWorktreeDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestWorktreeDeleteRequest.ProtoReflect.Descriptor instead.
 func (*RequestWorktreeDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{36}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *RequestWorktreeDeleteRequest) GetProjectId() string {
@@ -2666,7 +2987,7 @@ type RequestWorktreeDeleteResponse struct {
 
 func (x *RequestWorktreeDeleteResponse) Reset() {
 	*x = RequestWorktreeDeleteResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[37]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2678,7 +2999,7 @@ func (x *RequestWorktreeDeleteResponse) String() string {
 func (*RequestWorktreeDeleteResponse) ProtoMessage() {}
 
 func (x *RequestWorktreeDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[37]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2691,7 +3012,7 @@ func (x *RequestWorktreeDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestWorktreeDeleteResponse.ProtoReflect.Descriptor instead.
 func (*RequestWorktreeDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{37}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *RequestWorktreeDeleteResponse) GetRequestId() string {
@@ -2714,7 +3035,7 @@ type ReportWorktreeDeleteResultRequest struct {
 
 func (x *ReportWorktreeDeleteResultRequest) Reset() {
 	*x = ReportWorktreeDeleteResultRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[38]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2726,7 +3047,7 @@ func (x *ReportWorktreeDeleteResultRequest) String() string {
 func (*ReportWorktreeDeleteResultRequest) ProtoMessage() {}
 
 func (x *ReportWorktreeDeleteResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[38]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2739,7 +3060,7 @@ func (x *ReportWorktreeDeleteResultRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ReportWorktreeDeleteResultRequest.ProtoReflect.Descriptor instead.
 func (*ReportWorktreeDeleteResultRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{38}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ReportWorktreeDeleteResultRequest) GetRequestId() string {
@@ -2785,7 +3106,7 @@ type ReportWorktreeDeleteResultResponse struct {
 
 func (x *ReportWorktreeDeleteResultResponse) Reset() {
 	*x = ReportWorktreeDeleteResultResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[39]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2797,7 +3118,7 @@ func (x *ReportWorktreeDeleteResultResponse) String() string {
 func (*ReportWorktreeDeleteResultResponse) ProtoMessage() {}
 
 func (x *ReportWorktreeDeleteResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[39]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2810,7 +3131,7 @@ func (x *ReportWorktreeDeleteResultResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ReportWorktreeDeleteResultResponse.ProtoReflect.Descriptor instead.
 func (*ReportWorktreeDeleteResultResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{39}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{40}
 }
 
 // GitPullMainCommand tells the agent to run `git pull origin main`
@@ -2824,7 +3145,7 @@ type GitPullMainCommand struct {
 
 func (x *GitPullMainCommand) Reset() {
 	*x = GitPullMainCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[40]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2836,7 +3157,7 @@ func (x *GitPullMainCommand) String() string {
 func (*GitPullMainCommand) ProtoMessage() {}
 
 func (x *GitPullMainCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[40]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2849,7 +3170,7 @@ func (x *GitPullMainCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitPullMainCommand.ProtoReflect.Descriptor instead.
 func (*GitPullMainCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{40}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GitPullMainCommand) GetRequestId() string {
@@ -2868,7 +3189,7 @@ type RequestGitPullMainRequest struct {
 
 func (x *RequestGitPullMainRequest) Reset() {
 	*x = RequestGitPullMainRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[41]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2880,7 +3201,7 @@ func (x *RequestGitPullMainRequest) String() string {
 func (*RequestGitPullMainRequest) ProtoMessage() {}
 
 func (x *RequestGitPullMainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[41]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2893,7 +3214,7 @@ func (x *RequestGitPullMainRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestGitPullMainRequest.ProtoReflect.Descriptor instead.
 func (*RequestGitPullMainRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{41}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *RequestGitPullMainRequest) GetProjectId() string {
@@ -2912,7 +3233,7 @@ type RequestGitPullMainResponse struct {
 
 func (x *RequestGitPullMainResponse) Reset() {
 	*x = RequestGitPullMainResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[42]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2924,7 +3245,7 @@ func (x *RequestGitPullMainResponse) String() string {
 func (*RequestGitPullMainResponse) ProtoMessage() {}
 
 func (x *RequestGitPullMainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[42]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2937,7 +3258,7 @@ func (x *RequestGitPullMainResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestGitPullMainResponse.ProtoReflect.Descriptor instead.
 func (*RequestGitPullMainResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{42}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *RequestGitPullMainResponse) GetRequestId() string {
@@ -2960,7 +3281,7 @@ type ReportGitPullMainResultRequest struct {
 
 func (x *ReportGitPullMainResultRequest) Reset() {
 	*x = ReportGitPullMainResultRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[43]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2972,7 +3293,7 @@ func (x *ReportGitPullMainResultRequest) String() string {
 func (*ReportGitPullMainResultRequest) ProtoMessage() {}
 
 func (x *ReportGitPullMainResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[43]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2985,7 +3306,7 @@ func (x *ReportGitPullMainResultRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportGitPullMainResultRequest.ProtoReflect.Descriptor instead.
 func (*ReportGitPullMainResultRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{43}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ReportGitPullMainResultRequest) GetRequestId() string {
@@ -3031,7 +3352,7 @@ type ReportGitPullMainResultResponse struct {
 
 func (x *ReportGitPullMainResultResponse) Reset() {
 	*x = ReportGitPullMainResultResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[44]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3043,7 +3364,7 @@ func (x *ReportGitPullMainResultResponse) String() string {
 func (*ReportGitPullMainResultResponse) ProtoMessage() {}
 
 func (x *ReportGitPullMainResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[44]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3056,7 +3377,7 @@ func (x *ReportGitPullMainResultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportGitPullMainResultResponse.ProtoReflect.Descriptor instead.
 func (*ReportGitPullMainResultResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{44}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{45}
 }
 
 // SyncScriptsCommand tells the agent to re-sync its local .taskguild/scripts/* files.
@@ -3071,7 +3392,7 @@ type SyncScriptsCommand struct {
 
 func (x *SyncScriptsCommand) Reset() {
 	*x = SyncScriptsCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[45]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3083,7 +3404,7 @@ func (x *SyncScriptsCommand) String() string {
 func (*SyncScriptsCommand) ProtoMessage() {}
 
 func (x *SyncScriptsCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[45]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3096,7 +3417,7 @@ func (x *SyncScriptsCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncScriptsCommand.ProtoReflect.Descriptor instead.
 func (*SyncScriptsCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{45}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *SyncScriptsCommand) GetForceOverwriteScriptIds() []string {
@@ -3118,7 +3439,7 @@ type CompareScriptsCommand struct {
 
 func (x *CompareScriptsCommand) Reset() {
 	*x = CompareScriptsCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[46]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3130,7 +3451,7 @@ func (x *CompareScriptsCommand) String() string {
 func (*CompareScriptsCommand) ProtoMessage() {}
 
 func (x *CompareScriptsCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[46]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3143,7 +3464,7 @@ func (x *CompareScriptsCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompareScriptsCommand.ProtoReflect.Descriptor instead.
 func (*CompareScriptsCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{46}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *CompareScriptsCommand) GetRequestId() string {
@@ -3163,18 +3484,21 @@ func (x *CompareScriptsCommand) GetScripts() []*ScriptDefinition {
 // ExecuteScriptCommand tells the agent to execute a specific script.
 // The agent reads the script from the local .taskguild/scripts/{filename} file.
 type ExecuteScriptCommand struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	ScriptId      string                 `protobuf:"bytes,2,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
-	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"` // script filename (e.g. deploy.sh)
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`   // deprecated: no longer sent; kept for backward compatibility
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ScriptId  string                 `protobuf:"bytes,2,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`
+	Filename  string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"` // script filename (e.g. deploy.sh)
+	Content   string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`   // deprecated: no longer sent; kept for backward compatibility
+	// secrets are the project's secrets (name -> value), exposed to the script
+	// as environment variables.
+	Secrets       map[string]string `protobuf:"bytes,5,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ExecuteScriptCommand) Reset() {
 	*x = ExecuteScriptCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[47]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3186,7 +3510,7 @@ func (x *ExecuteScriptCommand) String() string {
 func (*ExecuteScriptCommand) ProtoMessage() {}
 
 func (x *ExecuteScriptCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[47]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3199,7 +3523,7 @@ func (x *ExecuteScriptCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteScriptCommand.ProtoReflect.Descriptor instead.
 func (*ExecuteScriptCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{47}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ExecuteScriptCommand) GetRequestId() string {
@@ -3230,6 +3554,13 @@ func (x *ExecuteScriptCommand) GetContent() string {
 	return ""
 }
 
+func (x *ExecuteScriptCommand) GetSecrets() map[string]string {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
 type SyncScriptsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectName   string                 `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
@@ -3239,7 +3570,7 @@ type SyncScriptsRequest struct {
 
 func (x *SyncScriptsRequest) Reset() {
 	*x = SyncScriptsRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[48]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3251,7 +3582,7 @@ func (x *SyncScriptsRequest) String() string {
 func (*SyncScriptsRequest) ProtoMessage() {}
 
 func (x *SyncScriptsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[48]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3264,7 +3595,7 @@ func (x *SyncScriptsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncScriptsRequest.ProtoReflect.Descriptor instead.
 func (*SyncScriptsRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{48}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *SyncScriptsRequest) GetProjectName() string {
@@ -3283,7 +3614,7 @@ type SyncScriptsResponse struct {
 
 func (x *SyncScriptsResponse) Reset() {
 	*x = SyncScriptsResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[49]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3295,7 +3626,7 @@ func (x *SyncScriptsResponse) String() string {
 func (*SyncScriptsResponse) ProtoMessage() {}
 
 func (x *SyncScriptsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[49]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3308,7 +3639,7 @@ func (x *SyncScriptsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncScriptsResponse.ProtoReflect.Descriptor instead.
 func (*SyncScriptsResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{49}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *SyncScriptsResponse) GetScripts() []*ScriptDefinition {
@@ -3334,7 +3665,7 @@ type ReportScriptExecutionResultRequest struct {
 
 func (x *ReportScriptExecutionResultRequest) Reset() {
 	*x = ReportScriptExecutionResultRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[50]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3346,7 +3677,7 @@ func (x *ReportScriptExecutionResultRequest) String() string {
 func (*ReportScriptExecutionResultRequest) ProtoMessage() {}
 
 func (x *ReportScriptExecutionResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[50]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3359,7 +3690,7 @@ func (x *ReportScriptExecutionResultRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ReportScriptExecutionResultRequest.ProtoReflect.Descriptor instead.
 func (*ReportScriptExecutionResultRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{50}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ReportScriptExecutionResultRequest) GetRequestId() string {
@@ -3426,7 +3757,7 @@ type ReportScriptExecutionResultResponse struct {
 
 func (x *ReportScriptExecutionResultResponse) Reset() {
 	*x = ReportScriptExecutionResultResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[51]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3438,7 +3769,7 @@ func (x *ReportScriptExecutionResultResponse) String() string {
 func (*ReportScriptExecutionResultResponse) ProtoMessage() {}
 
 func (x *ReportScriptExecutionResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[51]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3451,7 +3782,7 @@ func (x *ReportScriptExecutionResultResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ReportScriptExecutionResultResponse.ProtoReflect.Descriptor instead.
 func (*ReportScriptExecutionResultResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{51}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{52}
 }
 
 // ReportScriptOutputChunk reports a chunk of real-time script output.
@@ -3466,7 +3797,7 @@ type ReportScriptOutputChunkRequest struct {
 
 func (x *ReportScriptOutputChunkRequest) Reset() {
 	*x = ReportScriptOutputChunkRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[52]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3478,7 +3809,7 @@ func (x *ReportScriptOutputChunkRequest) String() string {
 func (*ReportScriptOutputChunkRequest) ProtoMessage() {}
 
 func (x *ReportScriptOutputChunkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[52]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3491,7 +3822,7 @@ func (x *ReportScriptOutputChunkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportScriptOutputChunkRequest.ProtoReflect.Descriptor instead.
 func (*ReportScriptOutputChunkRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{52}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ReportScriptOutputChunkRequest) GetRequestId() string {
@@ -3523,7 +3854,7 @@ type ReportScriptOutputChunkResponse struct {
 
 func (x *ReportScriptOutputChunkResponse) Reset() {
 	*x = ReportScriptOutputChunkResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[53]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3535,7 +3866,7 @@ func (x *ReportScriptOutputChunkResponse) String() string {
 func (*ReportScriptOutputChunkResponse) ProtoMessage() {}
 
 func (x *ReportScriptOutputChunkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[53]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3548,7 +3879,7 @@ func (x *ReportScriptOutputChunkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportScriptOutputChunkResponse.ProtoReflect.Descriptor instead.
 func (*ReportScriptOutputChunkResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{53}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{54}
 }
 
 // StopScriptCommand tells the agent to stop a running script execution.
@@ -3561,7 +3892,7 @@ type StopScriptCommand struct {
 
 func (x *StopScriptCommand) Reset() {
 	*x = StopScriptCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[54]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3573,7 +3904,7 @@ func (x *StopScriptCommand) String() string {
 func (*StopScriptCommand) ProtoMessage() {}
 
 func (x *StopScriptCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[54]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3586,7 +3917,7 @@ func (x *StopScriptCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopScriptCommand.ProtoReflect.Descriptor instead.
 func (*StopScriptCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{54}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *StopScriptCommand) GetRequestId() string {
@@ -3610,7 +3941,7 @@ type ScriptDiff struct {
 
 func (x *ScriptDiff) Reset() {
 	*x = ScriptDiff{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[55]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3622,7 +3953,7 @@ func (x *ScriptDiff) String() string {
 func (*ScriptDiff) ProtoMessage() {}
 
 func (x *ScriptDiff) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[55]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3635,7 +3966,7 @@ func (x *ScriptDiff) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScriptDiff.ProtoReflect.Descriptor instead.
 func (*ScriptDiff) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{55}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *ScriptDiff) GetScriptId() string {
@@ -3690,7 +4021,7 @@ type RequestScriptComparisonRequest struct {
 
 func (x *RequestScriptComparisonRequest) Reset() {
 	*x = RequestScriptComparisonRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[56]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3702,7 +4033,7 @@ func (x *RequestScriptComparisonRequest) String() string {
 func (*RequestScriptComparisonRequest) ProtoMessage() {}
 
 func (x *RequestScriptComparisonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[56]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3715,7 +4046,7 @@ func (x *RequestScriptComparisonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestScriptComparisonRequest.ProtoReflect.Descriptor instead.
 func (*RequestScriptComparisonRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{56}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *RequestScriptComparisonRequest) GetProjectId() string {
@@ -3734,7 +4065,7 @@ type RequestScriptComparisonResponse struct {
 
 func (x *RequestScriptComparisonResponse) Reset() {
 	*x = RequestScriptComparisonResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[57]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3746,7 +4077,7 @@ func (x *RequestScriptComparisonResponse) String() string {
 func (*RequestScriptComparisonResponse) ProtoMessage() {}
 
 func (x *RequestScriptComparisonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[57]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3759,7 +4090,7 @@ func (x *RequestScriptComparisonResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestScriptComparisonResponse.ProtoReflect.Descriptor instead.
 func (*RequestScriptComparisonResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{57}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *RequestScriptComparisonResponse) GetRequestId() string {
@@ -3781,7 +4112,7 @@ type ReportScriptComparisonRequest struct {
 
 func (x *ReportScriptComparisonRequest) Reset() {
 	*x = ReportScriptComparisonRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[58]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3793,7 +4124,7 @@ func (x *ReportScriptComparisonRequest) String() string {
 func (*ReportScriptComparisonRequest) ProtoMessage() {}
 
 func (x *ReportScriptComparisonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[58]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3806,7 +4137,7 @@ func (x *ReportScriptComparisonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportScriptComparisonRequest.ProtoReflect.Descriptor instead.
 func (*ReportScriptComparisonRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{58}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *ReportScriptComparisonRequest) GetRequestId() string {
@@ -3838,7 +4169,7 @@ type ReportScriptComparisonResponse struct {
 
 func (x *ReportScriptComparisonResponse) Reset() {
 	*x = ReportScriptComparisonResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[59]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3850,7 +4181,7 @@ func (x *ReportScriptComparisonResponse) String() string {
 func (*ReportScriptComparisonResponse) ProtoMessage() {}
 
 func (x *ReportScriptComparisonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[59]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3863,7 +4194,7 @@ func (x *ReportScriptComparisonResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportScriptComparisonResponse.ProtoReflect.Descriptor instead.
 func (*ReportScriptComparisonResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{59}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{60}
 }
 
 // GetScriptComparison returns the cached comparison result for a project.
@@ -3876,7 +4207,7 @@ type GetScriptComparisonRequest struct {
 
 func (x *GetScriptComparisonRequest) Reset() {
 	*x = GetScriptComparisonRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[60]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3888,7 +4219,7 @@ func (x *GetScriptComparisonRequest) String() string {
 func (*GetScriptComparisonRequest) ProtoMessage() {}
 
 func (x *GetScriptComparisonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[60]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3901,7 +4232,7 @@ func (x *GetScriptComparisonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetScriptComparisonRequest.ProtoReflect.Descriptor instead.
 func (*GetScriptComparisonRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{60}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetScriptComparisonRequest) GetProjectId() string {
@@ -3920,7 +4251,7 @@ type GetScriptComparisonResponse struct {
 
 func (x *GetScriptComparisonResponse) Reset() {
 	*x = GetScriptComparisonResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[61]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3932,7 +4263,7 @@ func (x *GetScriptComparisonResponse) String() string {
 func (*GetScriptComparisonResponse) ProtoMessage() {}
 
 func (x *GetScriptComparisonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[61]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3945,7 +4276,7 @@ func (x *GetScriptComparisonResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetScriptComparisonResponse.ProtoReflect.Descriptor instead.
 func (*GetScriptComparisonResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{61}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *GetScriptComparisonResponse) GetDiffs() []*ScriptDiff {
@@ -3970,7 +4301,7 @@ type ResolveScriptConflictRequest struct {
 
 func (x *ResolveScriptConflictRequest) Reset() {
 	*x = ResolveScriptConflictRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[62]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3982,7 +4313,7 @@ func (x *ResolveScriptConflictRequest) String() string {
 func (*ResolveScriptConflictRequest) ProtoMessage() {}
 
 func (x *ResolveScriptConflictRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[62]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3995,7 +4326,7 @@ func (x *ResolveScriptConflictRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveScriptConflictRequest.ProtoReflect.Descriptor instead.
 func (*ResolveScriptConflictRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{62}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *ResolveScriptConflictRequest) GetProjectId() string {
@@ -4049,7 +4380,7 @@ type ResolveScriptConflictResponse struct {
 
 func (x *ResolveScriptConflictResponse) Reset() {
 	*x = ResolveScriptConflictResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[63]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4061,7 +4392,7 @@ func (x *ResolveScriptConflictResponse) String() string {
 func (*ResolveScriptConflictResponse) ProtoMessage() {}
 
 func (x *ResolveScriptConflictResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[63]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4074,7 +4405,7 @@ func (x *ResolveScriptConflictResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveScriptConflictResponse.ProtoReflect.Descriptor instead.
 func (*ResolveScriptConflictResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{63}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *ResolveScriptConflictResponse) GetScript() *ScriptDefinition {
@@ -4096,7 +4427,7 @@ type CompareAgentsCommand struct {
 
 func (x *CompareAgentsCommand) Reset() {
 	*x = CompareAgentsCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[64]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4108,7 +4439,7 @@ func (x *CompareAgentsCommand) String() string {
 func (*CompareAgentsCommand) ProtoMessage() {}
 
 func (x *CompareAgentsCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[64]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4121,7 +4452,7 @@ func (x *CompareAgentsCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompareAgentsCommand.ProtoReflect.Descriptor instead.
 func (*CompareAgentsCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{64}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *CompareAgentsCommand) GetRequestId() string {
@@ -4152,7 +4483,7 @@ type AgentDiff struct {
 
 func (x *AgentDiff) Reset() {
 	*x = AgentDiff{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[65]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4164,7 +4495,7 @@ func (x *AgentDiff) String() string {
 func (*AgentDiff) ProtoMessage() {}
 
 func (x *AgentDiff) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[65]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4177,7 +4508,7 @@ func (x *AgentDiff) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentDiff.ProtoReflect.Descriptor instead.
 func (*AgentDiff) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{65}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *AgentDiff) GetAgentId() string {
@@ -4232,7 +4563,7 @@ type RequestAgentComparisonRequest struct {
 
 func (x *RequestAgentComparisonRequest) Reset() {
 	*x = RequestAgentComparisonRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[66]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4244,7 +4575,7 @@ func (x *RequestAgentComparisonRequest) String() string {
 func (*RequestAgentComparisonRequest) ProtoMessage() {}
 
 func (x *RequestAgentComparisonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[66]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4257,7 +4588,7 @@ func (x *RequestAgentComparisonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestAgentComparisonRequest.ProtoReflect.Descriptor instead.
 func (*RequestAgentComparisonRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{66}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *RequestAgentComparisonRequest) GetProjectId() string {
@@ -4276,7 +4607,7 @@ type RequestAgentComparisonResponse struct {
 
 func (x *RequestAgentComparisonResponse) Reset() {
 	*x = RequestAgentComparisonResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[67]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4288,7 +4619,7 @@ func (x *RequestAgentComparisonResponse) String() string {
 func (*RequestAgentComparisonResponse) ProtoMessage() {}
 
 func (x *RequestAgentComparisonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[67]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4301,7 +4632,7 @@ func (x *RequestAgentComparisonResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestAgentComparisonResponse.ProtoReflect.Descriptor instead.
 func (*RequestAgentComparisonResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{67}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *RequestAgentComparisonResponse) GetRequestId() string {
@@ -4323,7 +4654,7 @@ type ReportAgentComparisonRequest struct {
 
 func (x *ReportAgentComparisonRequest) Reset() {
 	*x = ReportAgentComparisonRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[68]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4335,7 +4666,7 @@ func (x *ReportAgentComparisonRequest) String() string {
 func (*ReportAgentComparisonRequest) ProtoMessage() {}
 
 func (x *ReportAgentComparisonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[68]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4348,7 +4679,7 @@ func (x *ReportAgentComparisonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportAgentComparisonRequest.ProtoReflect.Descriptor instead.
 func (*ReportAgentComparisonRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{68}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *ReportAgentComparisonRequest) GetRequestId() string {
@@ -4380,7 +4711,7 @@ type ReportAgentComparisonResponse struct {
 
 func (x *ReportAgentComparisonResponse) Reset() {
 	*x = ReportAgentComparisonResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[69]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4392,7 +4723,7 @@ func (x *ReportAgentComparisonResponse) String() string {
 func (*ReportAgentComparisonResponse) ProtoMessage() {}
 
 func (x *ReportAgentComparisonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[69]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4405,7 +4736,7 @@ func (x *ReportAgentComparisonResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportAgentComparisonResponse.ProtoReflect.Descriptor instead.
 func (*ReportAgentComparisonResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{69}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{70}
 }
 
 // GetAgentComparison returns the cached comparison result for a project.
@@ -4418,7 +4749,7 @@ type GetAgentComparisonRequest struct {
 
 func (x *GetAgentComparisonRequest) Reset() {
 	*x = GetAgentComparisonRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[70]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4430,7 +4761,7 @@ func (x *GetAgentComparisonRequest) String() string {
 func (*GetAgentComparisonRequest) ProtoMessage() {}
 
 func (x *GetAgentComparisonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[70]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4443,7 +4774,7 @@ func (x *GetAgentComparisonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAgentComparisonRequest.ProtoReflect.Descriptor instead.
 func (*GetAgentComparisonRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{70}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *GetAgentComparisonRequest) GetProjectId() string {
@@ -4462,7 +4793,7 @@ type GetAgentComparisonResponse struct {
 
 func (x *GetAgentComparisonResponse) Reset() {
 	*x = GetAgentComparisonResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[71]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4474,7 +4805,7 @@ func (x *GetAgentComparisonResponse) String() string {
 func (*GetAgentComparisonResponse) ProtoMessage() {}
 
 func (x *GetAgentComparisonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[71]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4487,7 +4818,7 @@ func (x *GetAgentComparisonResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAgentComparisonResponse.ProtoReflect.Descriptor instead.
 func (*GetAgentComparisonResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{71}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *GetAgentComparisonResponse) GetDiffs() []*AgentDiff {
@@ -4512,7 +4843,7 @@ type ResolveAgentConflictRequest struct {
 
 func (x *ResolveAgentConflictRequest) Reset() {
 	*x = ResolveAgentConflictRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[72]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4524,7 +4855,7 @@ func (x *ResolveAgentConflictRequest) String() string {
 func (*ResolveAgentConflictRequest) ProtoMessage() {}
 
 func (x *ResolveAgentConflictRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[72]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4537,7 +4868,7 @@ func (x *ResolveAgentConflictRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveAgentConflictRequest.ProtoReflect.Descriptor instead.
 func (*ResolveAgentConflictRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{72}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *ResolveAgentConflictRequest) GetProjectId() string {
@@ -4591,7 +4922,7 @@ type ResolveAgentConflictResponse struct {
 
 func (x *ResolveAgentConflictResponse) Reset() {
 	*x = ResolveAgentConflictResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[73]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4603,7 +4934,7 @@ func (x *ResolveAgentConflictResponse) String() string {
 func (*ResolveAgentConflictResponse) ProtoMessage() {}
 
 func (x *ResolveAgentConflictResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[73]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4616,7 +4947,7 @@ func (x *ResolveAgentConflictResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveAgentConflictResponse.ProtoReflect.Descriptor instead.
 func (*ResolveAgentConflictResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{73}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *ResolveAgentConflictResponse) GetAgent() *AgentDefinition {
@@ -4638,7 +4969,7 @@ type SyncSkillsCommand struct {
 
 func (x *SyncSkillsCommand) Reset() {
 	*x = SyncSkillsCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[74]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4650,7 +4981,7 @@ func (x *SyncSkillsCommand) String() string {
 func (*SyncSkillsCommand) ProtoMessage() {}
 
 func (x *SyncSkillsCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[74]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4663,7 +4994,7 @@ func (x *SyncSkillsCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncSkillsCommand.ProtoReflect.Descriptor instead.
 func (*SyncSkillsCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{74}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *SyncSkillsCommand) GetForceOverwriteSkillIds() []string {
@@ -4685,7 +5016,7 @@ type CompareSkillsCommand struct {
 
 func (x *CompareSkillsCommand) Reset() {
 	*x = CompareSkillsCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[75]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4697,7 +5028,7 @@ func (x *CompareSkillsCommand) String() string {
 func (*CompareSkillsCommand) ProtoMessage() {}
 
 func (x *CompareSkillsCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[75]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4710,7 +5041,7 @@ func (x *CompareSkillsCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompareSkillsCommand.ProtoReflect.Descriptor instead.
 func (*CompareSkillsCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{75}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *CompareSkillsCommand) GetRequestId() string {
@@ -4736,7 +5067,7 @@ type SyncSkillsRequest struct {
 
 func (x *SyncSkillsRequest) Reset() {
 	*x = SyncSkillsRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[76]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4748,7 +5079,7 @@ func (x *SyncSkillsRequest) String() string {
 func (*SyncSkillsRequest) ProtoMessage() {}
 
 func (x *SyncSkillsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[76]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4761,7 +5092,7 @@ func (x *SyncSkillsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncSkillsRequest.ProtoReflect.Descriptor instead.
 func (*SyncSkillsRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{76}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *SyncSkillsRequest) GetProjectName() string {
@@ -4780,7 +5111,7 @@ type SyncSkillsResponse struct {
 
 func (x *SyncSkillsResponse) Reset() {
 	*x = SyncSkillsResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[77]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4792,7 +5123,7 @@ func (x *SyncSkillsResponse) String() string {
 func (*SyncSkillsResponse) ProtoMessage() {}
 
 func (x *SyncSkillsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[77]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4805,7 +5136,7 @@ func (x *SyncSkillsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncSkillsResponse.ProtoReflect.Descriptor instead.
 func (*SyncSkillsResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{77}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *SyncSkillsResponse) GetSkills() []*SkillDefinition {
@@ -4829,7 +5160,7 @@ type SkillDiff struct {
 
 func (x *SkillDiff) Reset() {
 	*x = SkillDiff{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[78]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4841,7 +5172,7 @@ func (x *SkillDiff) String() string {
 func (*SkillDiff) ProtoMessage() {}
 
 func (x *SkillDiff) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[78]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4854,7 +5185,7 @@ func (x *SkillDiff) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SkillDiff.ProtoReflect.Descriptor instead.
 func (*SkillDiff) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{78}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *SkillDiff) GetSkillId() string {
@@ -4909,7 +5240,7 @@ type RequestSkillComparisonRequest struct {
 
 func (x *RequestSkillComparisonRequest) Reset() {
 	*x = RequestSkillComparisonRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[79]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4921,7 +5252,7 @@ func (x *RequestSkillComparisonRequest) String() string {
 func (*RequestSkillComparisonRequest) ProtoMessage() {}
 
 func (x *RequestSkillComparisonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[79]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4934,7 +5265,7 @@ func (x *RequestSkillComparisonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestSkillComparisonRequest.ProtoReflect.Descriptor instead.
 func (*RequestSkillComparisonRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{79}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *RequestSkillComparisonRequest) GetProjectId() string {
@@ -4953,7 +5284,7 @@ type RequestSkillComparisonResponse struct {
 
 func (x *RequestSkillComparisonResponse) Reset() {
 	*x = RequestSkillComparisonResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[80]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4965,7 +5296,7 @@ func (x *RequestSkillComparisonResponse) String() string {
 func (*RequestSkillComparisonResponse) ProtoMessage() {}
 
 func (x *RequestSkillComparisonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[80]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4978,7 +5309,7 @@ func (x *RequestSkillComparisonResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestSkillComparisonResponse.ProtoReflect.Descriptor instead.
 func (*RequestSkillComparisonResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{80}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *RequestSkillComparisonResponse) GetRequestId() string {
@@ -5000,7 +5331,7 @@ type ReportSkillComparisonRequest struct {
 
 func (x *ReportSkillComparisonRequest) Reset() {
 	*x = ReportSkillComparisonRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[81]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5012,7 +5343,7 @@ func (x *ReportSkillComparisonRequest) String() string {
 func (*ReportSkillComparisonRequest) ProtoMessage() {}
 
 func (x *ReportSkillComparisonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[81]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5025,7 +5356,7 @@ func (x *ReportSkillComparisonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportSkillComparisonRequest.ProtoReflect.Descriptor instead.
 func (*ReportSkillComparisonRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{81}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *ReportSkillComparisonRequest) GetRequestId() string {
@@ -5057,7 +5388,7 @@ type ReportSkillComparisonResponse struct {
 
 func (x *ReportSkillComparisonResponse) Reset() {
 	*x = ReportSkillComparisonResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[82]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5069,7 +5400,7 @@ func (x *ReportSkillComparisonResponse) String() string {
 func (*ReportSkillComparisonResponse) ProtoMessage() {}
 
 func (x *ReportSkillComparisonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[82]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5082,7 +5413,7 @@ func (x *ReportSkillComparisonResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportSkillComparisonResponse.ProtoReflect.Descriptor instead.
 func (*ReportSkillComparisonResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{82}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{83}
 }
 
 // GetSkillComparison returns the cached comparison result for a project.
@@ -5095,7 +5426,7 @@ type GetSkillComparisonRequest struct {
 
 func (x *GetSkillComparisonRequest) Reset() {
 	*x = GetSkillComparisonRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[83]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5107,7 +5438,7 @@ func (x *GetSkillComparisonRequest) String() string {
 func (*GetSkillComparisonRequest) ProtoMessage() {}
 
 func (x *GetSkillComparisonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[83]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5120,7 +5451,7 @@ func (x *GetSkillComparisonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSkillComparisonRequest.ProtoReflect.Descriptor instead.
 func (*GetSkillComparisonRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{83}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *GetSkillComparisonRequest) GetProjectId() string {
@@ -5139,7 +5470,7 @@ type GetSkillComparisonResponse struct {
 
 func (x *GetSkillComparisonResponse) Reset() {
 	*x = GetSkillComparisonResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[84]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5151,7 +5482,7 @@ func (x *GetSkillComparisonResponse) String() string {
 func (*GetSkillComparisonResponse) ProtoMessage() {}
 
 func (x *GetSkillComparisonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[84]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5164,7 +5495,7 @@ func (x *GetSkillComparisonResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSkillComparisonResponse.ProtoReflect.Descriptor instead.
 func (*GetSkillComparisonResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{84}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *GetSkillComparisonResponse) GetDiffs() []*SkillDiff {
@@ -5189,7 +5520,7 @@ type ResolveSkillConflictRequest struct {
 
 func (x *ResolveSkillConflictRequest) Reset() {
 	*x = ResolveSkillConflictRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[85]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5201,7 +5532,7 @@ func (x *ResolveSkillConflictRequest) String() string {
 func (*ResolveSkillConflictRequest) ProtoMessage() {}
 
 func (x *ResolveSkillConflictRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[85]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5214,7 +5545,7 @@ func (x *ResolveSkillConflictRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveSkillConflictRequest.ProtoReflect.Descriptor instead.
 func (*ResolveSkillConflictRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{85}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *ResolveSkillConflictRequest) GetProjectId() string {
@@ -5268,7 +5599,7 @@ type ResolveSkillConflictResponse struct {
 
 func (x *ResolveSkillConflictResponse) Reset() {
 	*x = ResolveSkillConflictResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[86]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5280,7 +5611,7 @@ func (x *ResolveSkillConflictResponse) String() string {
 func (*ResolveSkillConflictResponse) ProtoMessage() {}
 
 func (x *ResolveSkillConflictResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[86]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5293,7 +5624,7 @@ func (x *ResolveSkillConflictResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveSkillConflictResponse.ProtoReflect.Descriptor instead.
 func (*ResolveSkillConflictResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{86}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *ResolveSkillConflictResponse) GetSkill() *SkillDefinition {
@@ -5312,7 +5643,7 @@ type ListSingleCommandPermissionsAgentRequest struct {
 
 func (x *ListSingleCommandPermissionsAgentRequest) Reset() {
 	*x = ListSingleCommandPermissionsAgentRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[87]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5324,7 +5655,7 @@ func (x *ListSingleCommandPermissionsAgentRequest) String() string {
 func (*ListSingleCommandPermissionsAgentRequest) ProtoMessage() {}
 
 func (x *ListSingleCommandPermissionsAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[87]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5337,7 +5668,7 @@ func (x *ListSingleCommandPermissionsAgentRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use ListSingleCommandPermissionsAgentRequest.ProtoReflect.Descriptor instead.
 func (*ListSingleCommandPermissionsAgentRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{87}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *ListSingleCommandPermissionsAgentRequest) GetProjectName() string {
@@ -5356,7 +5687,7 @@ type ListSingleCommandPermissionsAgentResponse struct {
 
 func (x *ListSingleCommandPermissionsAgentResponse) Reset() {
 	*x = ListSingleCommandPermissionsAgentResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[88]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5368,7 +5699,7 @@ func (x *ListSingleCommandPermissionsAgentResponse) String() string {
 func (*ListSingleCommandPermissionsAgentResponse) ProtoMessage() {}
 
 func (x *ListSingleCommandPermissionsAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[88]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5381,7 +5712,7 @@ func (x *ListSingleCommandPermissionsAgentResponse) ProtoReflect() protoreflect.
 
 // Deprecated: Use ListSingleCommandPermissionsAgentResponse.ProtoReflect.Descriptor instead.
 func (*ListSingleCommandPermissionsAgentResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{88}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *ListSingleCommandPermissionsAgentResponse) GetPermissions() []*SingleCommandPermission {
@@ -5392,17 +5723,30 @@ func (x *ListSingleCommandPermissionsAgentResponse) GetPermissions() []*SingleCo
 }
 
 type AddSingleCommandPermissionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectName   string                 `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	Pattern       string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // "command" or "redirect"
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProjectName string                 `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Pattern     string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Type        string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // "command" or "redirect"
+	// scope is "project" (default), "task" or "status". See
+	// SingleCommandPermission for the meaning of the scope fields.
+	Scope      string `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`
+	TaskId     string `protobuf:"bytes,6,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	WorkflowId string `protobuf:"bytes,7,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	StatusName string `protobuf:"bytes,8,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
+	// ttl_seconds, when positive, makes the grant expire after that long.
+	TtlSeconds   int64  `protobuf:"varint,9,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	OriginTaskId string `protobuf:"bytes,10,opt,name=origin_task_id,json=originTaskId,proto3" json:"origin_task_id,omitempty"`
+	// interaction_id is the permission request the grant was approved from.
+	// The server records its responder as granted_by and its task as
+	// origin_task_id.
+	InteractionId string `protobuf:"bytes,12,opt,name=interaction_id,json=interactionId,proto3" json:"interaction_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddSingleCommandPermissionRequest) Reset() {
 	*x = AddSingleCommandPermissionRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[89]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5414,7 +5758,7 @@ func (x *AddSingleCommandPermissionRequest) String() string {
 func (*AddSingleCommandPermissionRequest) ProtoMessage() {}
 
 func (x *AddSingleCommandPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[89]
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5427,7 +5771,7 @@ func (x *AddSingleCommandPermissionRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AddSingleCommandPermissionRequest.ProtoReflect.Descriptor instead.
 func (*AddSingleCommandPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{89}
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *AddSingleCommandPermissionRequest) GetProjectName() string {
@@ -5451,30 +5795,1724 @@ func (x *AddSingleCommandPermissionRequest) GetType() string {
 	return ""
 }
 
-type AddSingleCommandPermissionResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Permission    *SingleCommandPermission `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AddSingleCommandPermissionRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
 }
 
-func (x *AddSingleCommandPermissionResponse) Reset() {
-	*x = AddSingleCommandPermissionResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[90]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AddSingleCommandPermissionRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
 }
 
-func (x *AddSingleCommandPermissionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AddSingleCommandPermissionRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
 }
 
-func (*AddSingleCommandPermissionResponse) ProtoMessage() {}
-
-func (x *AddSingleCommandPermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[90]
+func (x *AddSingleCommandPermissionRequest) GetStatusName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		return x.StatusName
+	}
+	return ""
+}
+
+func (x *AddSingleCommandPermissionRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *AddSingleCommandPermissionRequest) GetOriginTaskId() string {
+	if x != nil {
+		return x.OriginTaskId
+	}
+	return ""
+}
+
+func (x *AddSingleCommandPermissionRequest) GetInteractionId() string {
+	if x != nil {
+		return x.InteractionId
+	}
+	return ""
+}
+
+type AddSingleCommandPermissionResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Permission    *SingleCommandPermission `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSingleCommandPermissionResponse) Reset() {
+	*x = AddSingleCommandPermissionResponse{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSingleCommandPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSingleCommandPermissionResponse) ProtoMessage() {}
+
+func (x *AddSingleCommandPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSingleCommandPermissionResponse.ProtoReflect.Descriptor instead.
+func (*AddSingleCommandPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AddSingleCommandPermissionResponse) GetPermission() *SingleCommandPermission {
+	if x != nil {
+		return x.Permission
+	}
+	return nil
+}
+
+// SyncClaudeSettingsCommand tells the agent to re-sync its local
+// .claude/settings.json settings (language, etc.).
+type SyncClaudeSettingsCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncClaudeSettingsCommand) Reset() {
+	*x = SyncClaudeSettingsCommand{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncClaudeSettingsCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncClaudeSettingsCommand) ProtoMessage() {}
+
+func (x *SyncClaudeSettingsCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncClaudeSettingsCommand.ProtoReflect.Descriptor instead.
+func (*SyncClaudeSettingsCommand) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{92}
+}
+
+type SyncClaudeSettingsAgentRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProjectName      string                 `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	LocalLanguage    *string                `protobuf:"bytes,2,opt,name=local_language,json=localLanguage,proto3,oneof" json:"local_language,omitempty"`
+	LocalAttribution *Attribution           `protobuf:"bytes,3,opt,name=local_attribution,json=localAttribution,proto3" json:"local_attribution,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SyncClaudeSettingsAgentRequest) Reset() {
+	*x = SyncClaudeSettingsAgentRequest{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncClaudeSettingsAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncClaudeSettingsAgentRequest) ProtoMessage() {}
+
+func (x *SyncClaudeSettingsAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncClaudeSettingsAgentRequest.ProtoReflect.Descriptor instead.
+func (*SyncClaudeSettingsAgentRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *SyncClaudeSettingsAgentRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *SyncClaudeSettingsAgentRequest) GetLocalLanguage() string {
+	if x != nil && x.LocalLanguage != nil {
+		return *x.LocalLanguage
+	}
+	return ""
+}
+
+func (x *SyncClaudeSettingsAgentRequest) GetLocalAttribution() *Attribution {
+	if x != nil {
+		return x.LocalAttribution
+	}
+	return nil
+}
+
+type SyncClaudeSettingsAgentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *ClaudeSettings        `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncClaudeSettingsAgentResponse) Reset() {
+	*x = SyncClaudeSettingsAgentResponse{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncClaudeSettingsAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncClaudeSettingsAgentResponse) ProtoMessage() {}
+
+func (x *SyncClaudeSettingsAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncClaudeSettingsAgentResponse.ProtoReflect.Descriptor instead.
+func (*SyncClaudeSettingsAgentResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SyncClaudeSettingsAgentResponse) GetSettings() *ClaudeSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// DrainCommand tells the agent to stop claiming new tasks and let running
+// tasks finish. resume = true lifts the drain.
+type DrainCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resume        bool                   `protobuf:"varint,1,opt,name=resume,proto3" json:"resume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrainCommand) Reset() {
+	*x = DrainCommand{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainCommand) ProtoMessage() {}
+
+func (x *DrainCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainCommand.ProtoReflect.Descriptor instead.
+func (*DrainCommand) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DrainCommand) GetResume() bool {
+	if x != nil {
+		return x.Resume
+	}
+	return false
+}
+
+type CompactTaskCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompactTaskCommand) Reset() {
+	*x = CompactTaskCommand{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompactTaskCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactTaskCommand) ProtoMessage() {}
+
+func (x *CompactTaskCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactTaskCommand.ProtoReflect.Descriptor instead.
+func (*CompactTaskCommand) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CompactTaskCommand) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// RollbackTaskCommand restores the worktree of a task to a checkpoint taken
+// after one of its turns and rewinds the Claude session to that turn.
+type RollbackTaskCommand struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	RequestId    string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	TaskId       string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	CheckpointId string                 `protobuf:"bytes,3,opt,name=checkpoint_id,json=checkpointId,proto3" json:"checkpoint_id,omitempty"`
+	// commit is the checkpoint commit (stored under a hidden ref).
+	Commit       string `protobuf:"bytes,4,opt,name=commit,proto3" json:"commit,omitempty"`
+	WorktreeName string `protobuf:"bytes,5,opt,name=worktree_name,json=worktreeName,proto3" json:"worktree_name,omitempty"`
+	// session_id and message_uuid identify the session state at the
+	// checkpoint. Empty if unknown.
+	SessionId   string `protobuf:"bytes,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	MessageUuid string `protobuf:"bytes,7,opt,name=message_uuid,json=messageUuid,proto3" json:"message_uuid,omitempty"`
+	// status_name is the workflow status the session belongs to.
+	StatusName    string `protobuf:"bytes,8,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
+	Resume        bool   `protobuf:"varint,9,opt,name=resume,proto3" json:"resume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackTaskCommand) Reset() {
+	*x = RollbackTaskCommand{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackTaskCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackTaskCommand) ProtoMessage() {}
+
+func (x *RollbackTaskCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackTaskCommand.ProtoReflect.Descriptor instead.
+func (*RollbackTaskCommand) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *RollbackTaskCommand) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *RollbackTaskCommand) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *RollbackTaskCommand) GetCheckpointId() string {
+	if x != nil {
+		return x.CheckpointId
+	}
+	return ""
+}
+
+func (x *RollbackTaskCommand) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *RollbackTaskCommand) GetWorktreeName() string {
+	if x != nil {
+		return x.WorktreeName
+	}
+	return ""
+}
+
+func (x *RollbackTaskCommand) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RollbackTaskCommand) GetMessageUuid() string {
+	if x != nil {
+		return x.MessageUuid
+	}
+	return ""
+}
+
+func (x *RollbackTaskCommand) GetStatusName() string {
+	if x != nil {
+		return x.StatusName
+	}
+	return ""
+}
+
+func (x *RollbackTaskCommand) GetResume() bool {
+	if x != nil {
+		return x.Resume
+	}
+	return false
+}
+
+type ReportTaskRollbackResultRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	RequestId    string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	TaskId       string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	CheckpointId string                 `protobuf:"bytes,3,opt,name=checkpoint_id,json=checkpointId,proto3" json:"checkpoint_id,omitempty"`
+	Success      bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage string                 `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// session_id is the session the task resumes from after the rollback.
+	SessionId string `protobuf:"bytes,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// resume echoes RollbackTaskCommand.resume.
+	Resume        bool `protobuf:"varint,7,opt,name=resume,proto3" json:"resume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportTaskRollbackResultRequest) Reset() {
+	*x = ReportTaskRollbackResultRequest{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportTaskRollbackResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportTaskRollbackResultRequest) ProtoMessage() {}
+
+func (x *ReportTaskRollbackResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportTaskRollbackResultRequest.ProtoReflect.Descriptor instead.
+func (*ReportTaskRollbackResultRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ReportTaskRollbackResultRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ReportTaskRollbackResultRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ReportTaskRollbackResultRequest) GetCheckpointId() string {
+	if x != nil {
+		return x.CheckpointId
+	}
+	return ""
+}
+
+func (x *ReportTaskRollbackResultRequest) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReportTaskRollbackResultRequest) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ReportTaskRollbackResultRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ReportTaskRollbackResultRequest) GetResume() bool {
+	if x != nil {
+		return x.Resume
+	}
+	return false
+}
+
+type ReportTaskRollbackResultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportTaskRollbackResultResponse) Reset() {
+	*x = ReportTaskRollbackResultResponse{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportTaskRollbackResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportTaskRollbackResultResponse) ProtoMessage() {}
+
+func (x *ReportTaskRollbackResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportTaskRollbackResultResponse.ProtoReflect.Descriptor instead.
+func (*ReportTaskRollbackResultResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{99}
+}
+
+// TaskDiffCommand asks the agent-manager to compute the diff of a task's
+// worktree against base_branch. Agents without the worktree reply with
+// not_found.
+type TaskDiffCommand struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	RequestId    string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	TaskId       string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	WorktreeName string                 `protobuf:"bytes,3,opt,name=worktree_name,json=worktreeName,proto3" json:"worktree_name,omitempty"`
+	// base_branch is the project's default branch. Empty means detect it.
+	BaseBranch string `protobuf:"bytes,4,opt,name=base_branch,json=baseBranch,proto3" json:"base_branch,omitempty"`
+	// max_patch_bytes limits the size of TaskDiff.patch. 0 means no limit.
+	MaxPatchBytes int32 `protobuf:"varint,5,opt,name=max_patch_bytes,json=maxPatchBytes,proto3" json:"max_patch_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskDiffCommand) Reset() {
+	*x = TaskDiffCommand{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskDiffCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskDiffCommand) ProtoMessage() {}
+
+func (x *TaskDiffCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskDiffCommand.ProtoReflect.Descriptor instead.
+func (*TaskDiffCommand) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *TaskDiffCommand) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *TaskDiffCommand) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskDiffCommand) GetWorktreeName() string {
+	if x != nil {
+		return x.WorktreeName
+	}
+	return ""
+}
+
+func (x *TaskDiffCommand) GetBaseBranch() string {
+	if x != nil {
+		return x.BaseBranch
+	}
+	return ""
+}
+
+func (x *TaskDiffCommand) GetMaxPatchBytes() int32 {
+	if x != nil {
+		return x.MaxPatchBytes
+	}
+	return 0
+}
+
+// TaskDiff is the diff of a worktree against the merge base with the base
+// branch. It includes uncommitted changes in the worktree.
+type TaskDiff struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	BaseBranch            string                 `protobuf:"bytes,1,opt,name=base_branch,json=baseBranch,proto3" json:"base_branch,omitempty"`
+	BaseCommit            string                 `protobuf:"bytes,2,opt,name=base_commit,json=baseCommit,proto3" json:"base_commit,omitempty"` // merge base the diff is computed against
+	HeadCommit            string                 `protobuf:"bytes,3,opt,name=head_commit,json=headCommit,proto3" json:"head_commit,omitempty"`
+	Branch                string                 `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"` // worktree branch name
+	Files                 []*TaskDiffFile        `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	Patch                 string                 `protobuf:"bytes,6,opt,name=patch,proto3" json:"patch,omitempty"` // unified diff
+	PatchTruncated        bool                   `protobuf:"varint,7,opt,name=patch_truncated,json=patchTruncated,proto3" json:"patch_truncated,omitempty"`
+	Commits               []*TaskDiffCommit      `protobuf:"bytes,8,rep,name=commits,proto3" json:"commits,omitempty"` // commits since the merge base, newest first
+	Additions             int32                  `protobuf:"varint,9,opt,name=additions,proto3" json:"additions,omitempty"`
+	Deletions             int32                  `protobuf:"varint,10,opt,name=deletions,proto3" json:"deletions,omitempty"`
+	HasUncommittedChanges bool                   `protobuf:"varint,11,opt,name=has_uncommitted_changes,json=hasUncommittedChanges,proto3" json:"has_uncommitted_changes,omitempty"`
+	CapturedAt            *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=captured_at,json=capturedAt,proto3" json:"captured_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *TaskDiff) Reset() {
+	*x = TaskDiff{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskDiff) ProtoMessage() {}
+
+func (x *TaskDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskDiff.ProtoReflect.Descriptor instead.
+func (*TaskDiff) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *TaskDiff) GetBaseBranch() string {
+	if x != nil {
+		return x.BaseBranch
+	}
+	return ""
+}
+
+func (x *TaskDiff) GetBaseCommit() string {
+	if x != nil {
+		return x.BaseCommit
+	}
+	return ""
+}
+
+func (x *TaskDiff) GetHeadCommit() string {
+	if x != nil {
+		return x.HeadCommit
+	}
+	return ""
+}
+
+func (x *TaskDiff) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *TaskDiff) GetFiles() []*TaskDiffFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *TaskDiff) GetPatch() string {
+	if x != nil {
+		return x.Patch
+	}
+	return ""
+}
+
+func (x *TaskDiff) GetPatchTruncated() bool {
+	if x != nil {
+		return x.PatchTruncated
+	}
+	return false
+}
+
+func (x *TaskDiff) GetCommits() []*TaskDiffCommit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+func (x *TaskDiff) GetAdditions() int32 {
+	if x != nil {
+		return x.Additions
+	}
+	return 0
+}
+
+func (x *TaskDiff) GetDeletions() int32 {
+	if x != nil {
+		return x.Deletions
+	}
+	return 0
+}
+
+func (x *TaskDiff) GetHasUncommittedChanges() bool {
+	if x != nil {
+		return x.HasUncommittedChanges
+	}
+	return false
+}
+
+func (x *TaskDiff) GetCapturedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CapturedAt
+	}
+	return nil
+}
+
+type TaskDiffFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // git status letter: "A", "M", "D" or "T"
+	Additions     int32                  `protobuf:"varint,3,opt,name=additions,proto3" json:"additions,omitempty"`
+	Deletions     int32                  `protobuf:"varint,4,opt,name=deletions,proto3" json:"deletions,omitempty"`
+	Binary        bool                   `protobuf:"varint,5,opt,name=binary,proto3" json:"binary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskDiffFile) Reset() {
+	*x = TaskDiffFile{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskDiffFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskDiffFile) ProtoMessage() {}
+
+func (x *TaskDiffFile) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskDiffFile.ProtoReflect.Descriptor instead.
+func (*TaskDiffFile) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *TaskDiffFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *TaskDiffFile) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TaskDiffFile) GetAdditions() int32 {
+	if x != nil {
+		return x.Additions
+	}
+	return 0
+}
+
+func (x *TaskDiffFile) GetDeletions() int32 {
+	if x != nil {
+		return x.Deletions
+	}
+	return 0
+}
+
+func (x *TaskDiffFile) GetBinary() bool {
+	if x != nil {
+		return x.Binary
+	}
+	return false
+}
+
+type TaskDiffCommit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sha           string                 `protobuf:"bytes,1,opt,name=sha,proto3" json:"sha,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Author        string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	CommittedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=committed_at,json=committedAt,proto3" json:"committed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskDiffCommit) Reset() {
+	*x = TaskDiffCommit{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskDiffCommit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskDiffCommit) ProtoMessage() {}
+
+func (x *TaskDiffCommit) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskDiffCommit.ProtoReflect.Descriptor instead.
+func (*TaskDiffCommit) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *TaskDiffCommit) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *TaskDiffCommit) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *TaskDiffCommit) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *TaskDiffCommit) GetCommittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommittedAt
+	}
+	return nil
+}
+
+type GetTaskDiffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskDiffRequest) Reset() {
+	*x = GetTaskDiffRequest{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskDiffRequest) ProtoMessage() {}
+
+func (x *GetTaskDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskDiffRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskDiffRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetTaskDiffRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type GetTaskDiffResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Diff  *TaskDiff              `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
+	// from_snapshot is true when no agent-manager could compute the diff and
+	// it was taken from the latest diff snapshot in the task logs.
+	FromSnapshot  bool `protobuf:"varint,2,opt,name=from_snapshot,json=fromSnapshot,proto3" json:"from_snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskDiffResponse) Reset() {
+	*x = GetTaskDiffResponse{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskDiffResponse) ProtoMessage() {}
+
+func (x *GetTaskDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskDiffResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskDiffResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetTaskDiffResponse) GetDiff() *TaskDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *GetTaskDiffResponse) GetFromSnapshot() bool {
+	if x != nil {
+		return x.FromSnapshot
+	}
+	return false
+}
+
+type ReportTaskDiffRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	TaskId    string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Diff      *TaskDiff              `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+	// not_found is true if the worktree does not exist on this agent-manager.
+	NotFound      bool   `protobuf:"varint,4,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	ErrorMessage  string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportTaskDiffRequest) Reset() {
+	*x = ReportTaskDiffRequest{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportTaskDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportTaskDiffRequest) ProtoMessage() {}
+
+func (x *ReportTaskDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportTaskDiffRequest.ProtoReflect.Descriptor instead.
+func (*ReportTaskDiffRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ReportTaskDiffRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ReportTaskDiffRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ReportTaskDiffRequest) GetDiff() *TaskDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *ReportTaskDiffRequest) GetNotFound() bool {
+	if x != nil {
+		return x.NotFound
+	}
+	return false
+}
+
+func (x *ReportTaskDiffRequest) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ReportTaskDiffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportTaskDiffResponse) Reset() {
+	*x = ReportTaskDiffResponse{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportTaskDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportTaskDiffResponse) ProtoMessage() {}
+
+func (x *ReportTaskDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportTaskDiffResponse.ProtoReflect.Descriptor instead.
+func (*ReportTaskDiffResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{107}
+}
+
+// MergeWorktreeCommand asks the agent-manager holding a task's worktree to
+// rebase its branch onto base_branch, run the verification commands and
+// fast-forward base_branch to it. Agents without the worktree reply with
+// not_found.
+type MergeWorktreeCommand struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	RequestId    string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	TaskId       string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	WorktreeName string                 `protobuf:"bytes,3,opt,name=worktree_name,json=worktreeName,proto3" json:"worktree_name,omitempty"`
+	// base_branch is the task's worktree base ref, or the project's default
+	// branch when the task has none. Empty means detect it.
+	BaseBranch     string   `protobuf:"bytes,4,opt,name=base_branch,json=baseBranch,proto3" json:"base_branch,omitempty"`
+	VerifyCommands []string `protobuf:"bytes,5,rep,name=verify_commands,json=verifyCommands,proto3" json:"verify_commands,omitempty"`
+	Push           bool     `protobuf:"varint,6,opt,name=push,proto3" json:"push,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MergeWorktreeCommand) Reset() {
+	*x = MergeWorktreeCommand{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeWorktreeCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeWorktreeCommand) ProtoMessage() {}
+
+func (x *MergeWorktreeCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeWorktreeCommand.ProtoReflect.Descriptor instead.
+func (*MergeWorktreeCommand) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *MergeWorktreeCommand) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *MergeWorktreeCommand) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *MergeWorktreeCommand) GetWorktreeName() string {
+	if x != nil {
+		return x.WorktreeName
+	}
+	return ""
+}
+
+func (x *MergeWorktreeCommand) GetBaseBranch() string {
+	if x != nil {
+		return x.BaseBranch
+	}
+	return ""
+}
+
+func (x *MergeWorktreeCommand) GetVerifyCommands() []string {
+	if x != nil {
+		return x.VerifyCommands
+	}
+	return nil
+}
+
+func (x *MergeWorktreeCommand) GetPush() bool {
+	if x != nil {
+		return x.Push
+	}
+	return false
+}
+
+type ReportMergeResultRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	TaskId    string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	NotFound  bool                   `protobuf:"varint,3,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	Success   bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// step is the step that failed: "prepare", "rebase", "verify", "merge" or
+	// "push". A push failure leaves the branch merged locally.
+	Step         string `protobuf:"bytes,5,opt,name=step,proto3" json:"step,omitempty"`
+	ErrorMessage string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// conflict_files lists the conflicting files when the rebase failed.
+	ConflictFiles []string `protobuf:"bytes,7,rep,name=conflict_files,json=conflictFiles,proto3" json:"conflict_files,omitempty"`
+	// output is the (truncated) output of the failed step.
+	Output string `protobuf:"bytes,8,opt,name=output,proto3" json:"output,omitempty"`
+	// merged_commit is the new head of the base branch.
+	MergedCommit  string `protobuf:"bytes,9,opt,name=merged_commit,json=mergedCommit,proto3" json:"merged_commit,omitempty"`
+	BaseBranch    string `protobuf:"bytes,10,opt,name=base_branch,json=baseBranch,proto3" json:"base_branch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportMergeResultRequest) Reset() {
+	*x = ReportMergeResultRequest{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportMergeResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportMergeResultRequest) ProtoMessage() {}
+
+func (x *ReportMergeResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportMergeResultRequest.ProtoReflect.Descriptor instead.
+func (*ReportMergeResultRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ReportMergeResultRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ReportMergeResultRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ReportMergeResultRequest) GetNotFound() bool {
+	if x != nil {
+		return x.NotFound
+	}
+	return false
+}
+
+func (x *ReportMergeResultRequest) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReportMergeResultRequest) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *ReportMergeResultRequest) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ReportMergeResultRequest) GetConflictFiles() []string {
+	if x != nil {
+		return x.ConflictFiles
+	}
+	return nil
+}
+
+func (x *ReportMergeResultRequest) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *ReportMergeResultRequest) GetMergedCommit() string {
+	if x != nil {
+		return x.MergedCommit
+	}
+	return ""
+}
+
+func (x *ReportMergeResultRequest) GetBaseBranch() string {
+	if x != nil {
+		return x.BaseBranch
+	}
+	return ""
+}
+
+type ReportMergeResultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportMergeResultResponse) Reset() {
+	*x = ReportMergeResultResponse{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportMergeResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportMergeResultResponse) ProtoMessage() {}
+
+func (x *ReportMergeResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportMergeResultResponse.ProtoReflect.Descriptor instead.
+func (*ReportMergeResultResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{110}
+}
+
+// ReportModifiedFilesRequest lists the files (relative to the worktree root)
+// a task has changed compared to its base, including files written by the
+// Edit / Write tools.
+type ReportModifiedFilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	WorktreeName  string                 `protobuf:"bytes,2,opt,name=worktree_name,json=worktreeName,proto3" json:"worktree_name,omitempty"`
+	Files         []string               `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportModifiedFilesRequest) Reset() {
+	*x = ReportModifiedFilesRequest{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportModifiedFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportModifiedFilesRequest) ProtoMessage() {}
+
+func (x *ReportModifiedFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportModifiedFilesRequest.ProtoReflect.Descriptor instead.
+func (*ReportModifiedFilesRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ReportModifiedFilesRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ReportModifiedFilesRequest) GetWorktreeName() string {
+	if x != nil {
+		return x.WorktreeName
+	}
+	return ""
+}
+
+func (x *ReportModifiedFilesRequest) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type ReportModifiedFilesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// overlaps lists the other active tasks modifying some of the same files.
+	Overlaps      []*FileOverlap `protobuf:"bytes,1,rep,name=overlaps,proto3" json:"overlaps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportModifiedFilesResponse) Reset() {
+	*x = ReportModifiedFilesResponse{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportModifiedFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportModifiedFilesResponse) ProtoMessage() {}
+
+func (x *ReportModifiedFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportModifiedFilesResponse.ProtoReflect.Descriptor instead.
+func (*ReportModifiedFilesResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ReportModifiedFilesResponse) GetOverlaps() []*FileOverlap {
+	if x != nil {
+		return x.Overlaps
+	}
+	return nil
+}
+
+type FileOverlap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskTitle     string                 `protobuf:"bytes,2,opt,name=task_title,json=taskTitle,proto3" json:"task_title,omitempty"`
+	WorktreeName  string                 `protobuf:"bytes,3,opt,name=worktree_name,json=worktreeName,proto3" json:"worktree_name,omitempty"`
+	Files         []string               `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileOverlap) Reset() {
+	*x = FileOverlap{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileOverlap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileOverlap) ProtoMessage() {}
+
+func (x *FileOverlap) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileOverlap.ProtoReflect.Descriptor instead.
+func (*FileOverlap) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *FileOverlap) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *FileOverlap) GetTaskTitle() string {
+	if x != nil {
+		return x.TaskTitle
+	}
+	return ""
+}
+
+func (x *FileOverlap) GetWorktreeName() string {
+	if x != nil {
+		return x.WorktreeName
+	}
+	return ""
+}
+
+func (x *FileOverlap) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type DrainAgentManagerRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AgentManagerId string                 `protobuf:"bytes,1,opt,name=agent_manager_id,json=agentManagerId,proto3" json:"agent_manager_id,omitempty"`
+	Resume         bool                   `protobuf:"varint,2,opt,name=resume,proto3" json:"resume,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DrainAgentManagerRequest) Reset() {
+	*x = DrainAgentManagerRequest{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainAgentManagerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainAgentManagerRequest) ProtoMessage() {}
+
+func (x *DrainAgentManagerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainAgentManagerRequest.ProtoReflect.Descriptor instead.
+func (*DrainAgentManagerRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *DrainAgentManagerRequest) GetAgentManagerId() string {
+	if x != nil {
+		return x.AgentManagerId
+	}
+	return ""
+}
+
+func (x *DrainAgentManagerRequest) GetResume() bool {
+	if x != nil {
+		return x.Resume
+	}
+	return false
+}
+
+type DrainAgentManagerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgentManager  *AgentManagerInfo      `protobuf:"bytes,1,opt,name=agent_manager,json=agentManager,proto3" json:"agent_manager,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrainAgentManagerResponse) Reset() {
+	*x = DrainAgentManagerResponse{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainAgentManagerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainAgentManagerResponse) ProtoMessage() {}
+
+func (x *DrainAgentManagerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainAgentManagerResponse.ProtoReflect.Descriptor instead.
+func (*DrainAgentManagerResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *DrainAgentManagerResponse) GetAgentManager() *AgentManagerInfo {
+	if x != nil {
+		return x.AgentManager
+	}
+	return nil
+}
+
+type ListAgentManagersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentManagersRequest) Reset() {
+	*x = ListAgentManagersRequest{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentManagersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentManagersRequest) ProtoMessage() {}
+
+func (x *ListAgentManagersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentManagersRequest.ProtoReflect.Descriptor instead.
+func (*ListAgentManagersRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{116}
+}
+
+type ListAgentManagersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgentManagers []*AgentManagerInfo    `protobuf:"bytes,1,rep,name=agent_managers,json=agentManagers,proto3" json:"agent_managers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentManagersResponse) Reset() {
+	*x = ListAgentManagersResponse{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentManagersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentManagersResponse) ProtoMessage() {}
+
+func (x *ListAgentManagersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
@@ -5483,41 +7521,50 @@ func (x *AddSingleCommandPermissionResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSingleCommandPermissionResponse.ProtoReflect.Descriptor instead.
-func (*AddSingleCommandPermissionResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use ListAgentManagersResponse.ProtoReflect.Descriptor instead.
+func (*ListAgentManagersResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *AddSingleCommandPermissionResponse) GetPermission() *SingleCommandPermission {
+func (x *ListAgentManagersResponse) GetAgentManagers() []*AgentManagerInfo {
 	if x != nil {
-		return x.Permission
+		return x.AgentManagers
 	}
 	return nil
 }
 
-// SyncClaudeSettingsCommand tells the agent to re-sync its local
-// .claude/settings.json settings (language, etc.).
-type SyncClaudeSettingsCommand struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// AgentManagerInfo describes a connected agent-manager.
+type AgentManagerInfo struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	AgentManagerId     string                 `protobuf:"bytes,1,opt,name=agent_manager_id,json=agentManagerId,proto3" json:"agent_manager_id,omitempty"`
+	MaxConcurrentTasks int32                  `protobuf:"varint,2,opt,name=max_concurrent_tasks,json=maxConcurrentTasks,proto3" json:"max_concurrent_tasks,omitempty"`
+	ActiveTasks        int32                  `protobuf:"varint,3,opt,name=active_tasks,json=activeTasks,proto3" json:"active_tasks,omitempty"`
+	Projects           []*ServedProject       `protobuf:"bytes,4,rep,name=projects,proto3" json:"projects,omitempty"`
+	LastHeartbeat      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_heartbeat,json=lastHeartbeat,proto3" json:"last_heartbeat,omitempty"`
+	// draining is true once a drain was requested and until it is resumed.
+	Draining bool `protobuf:"varint,6,opt,name=draining,proto3" json:"draining,omitempty"`
+	// idle is true when a draining agent-manager has no running tasks left
+	// and can be stopped safely.
+	Idle          bool `protobuf:"varint,7,opt,name=idle,proto3" json:"idle,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncClaudeSettingsCommand) Reset() {
-	*x = SyncClaudeSettingsCommand{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[91]
+func (x *AgentManagerInfo) Reset() {
+	*x = AgentManagerInfo{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncClaudeSettingsCommand) String() string {
+func (x *AgentManagerInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncClaudeSettingsCommand) ProtoMessage() {}
+func (*AgentManagerInfo) ProtoMessage() {}
 
-func (x *SyncClaudeSettingsCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[91]
+func (x *AgentManagerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5528,35 +7575,86 @@ func (x *SyncClaudeSettingsCommand) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncClaudeSettingsCommand.ProtoReflect.Descriptor instead.
-func (*SyncClaudeSettingsCommand) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use AgentManagerInfo.ProtoReflect.Descriptor instead.
+func (*AgentManagerInfo) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{118}
 }
 
-type SyncClaudeSettingsAgentRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ProjectName      string                 `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	LocalLanguage    *string                `protobuf:"bytes,2,opt,name=local_language,json=localLanguage,proto3,oneof" json:"local_language,omitempty"`
-	LocalAttribution *Attribution           `protobuf:"bytes,3,opt,name=local_attribution,json=localAttribution,proto3" json:"local_attribution,omitempty"`
+func (x *AgentManagerInfo) GetAgentManagerId() string {
+	if x != nil {
+		return x.AgentManagerId
+	}
+	return ""
+}
+
+func (x *AgentManagerInfo) GetMaxConcurrentTasks() int32 {
+	if x != nil {
+		return x.MaxConcurrentTasks
+	}
+	return 0
+}
+
+func (x *AgentManagerInfo) GetActiveTasks() int32 {
+	if x != nil {
+		return x.ActiveTasks
+	}
+	return 0
+}
+
+func (x *AgentManagerInfo) GetProjects() []*ServedProject {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *AgentManagerInfo) GetLastHeartbeat() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHeartbeat
+	}
+	return nil
+}
+
+func (x *AgentManagerInfo) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *AgentManagerInfo) GetIdle() bool {
+	if x != nil {
+		return x.Idle
+	}
+	return false
+}
+
+type UploadSessionTranscriptRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TaskId    string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	SessionId string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// data is the gzip-compressed JSONL transcript.
+	Data             []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	UncompressedSize int64  `protobuf:"varint,4,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressed_size,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *SyncClaudeSettingsAgentRequest) Reset() {
-	*x = SyncClaudeSettingsAgentRequest{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[92]
+func (x *UploadSessionTranscriptRequest) Reset() {
+	*x = UploadSessionTranscriptRequest{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncClaudeSettingsAgentRequest) String() string {
+func (x *UploadSessionTranscriptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncClaudeSettingsAgentRequest) ProtoMessage() {}
+func (*UploadSessionTranscriptRequest) ProtoMessage() {}
 
-func (x *SyncClaudeSettingsAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[92]
+func (x *UploadSessionTranscriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5567,54 +7665,60 @@ func (x *SyncClaudeSettingsAgentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncClaudeSettingsAgentRequest.ProtoReflect.Descriptor instead.
-func (*SyncClaudeSettingsAgentRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use UploadSessionTranscriptRequest.ProtoReflect.Descriptor instead.
+func (*UploadSessionTranscriptRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *SyncClaudeSettingsAgentRequest) GetProjectName() string {
+func (x *UploadSessionTranscriptRequest) GetTaskId() string {
 	if x != nil {
-		return x.ProjectName
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *SyncClaudeSettingsAgentRequest) GetLocalLanguage() string {
-	if x != nil && x.LocalLanguage != nil {
-		return *x.LocalLanguage
+func (x *UploadSessionTranscriptRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *SyncClaudeSettingsAgentRequest) GetLocalAttribution() *Attribution {
+func (x *UploadSessionTranscriptRequest) GetData() []byte {
 	if x != nil {
-		return x.LocalAttribution
+		return x.Data
 	}
 	return nil
 }
 
-type SyncClaudeSettingsAgentResponse struct {
+func (x *UploadSessionTranscriptRequest) GetUncompressedSize() int64 {
+	if x != nil {
+		return x.UncompressedSize
+	}
+	return 0
+}
+
+type UploadSessionTranscriptResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Settings      *ClaudeSettings        `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncClaudeSettingsAgentResponse) Reset() {
-	*x = SyncClaudeSettingsAgentResponse{}
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[93]
+func (x *UploadSessionTranscriptResponse) Reset() {
+	*x = UploadSessionTranscriptResponse{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncClaudeSettingsAgentResponse) String() string {
+func (x *UploadSessionTranscriptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncClaudeSettingsAgentResponse) ProtoMessage() {}
+func (*UploadSessionTranscriptResponse) ProtoMessage() {}
 
-func (x *SyncClaudeSettingsAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[93]
+func (x *UploadSessionTranscriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5625,14 +7729,212 @@ func (x *SyncClaudeSettingsAgentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncClaudeSettingsAgentResponse.ProtoReflect.Descriptor instead.
-func (*SyncClaudeSettingsAgentResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use UploadSessionTranscriptResponse.ProtoReflect.Descriptor instead.
+func (*UploadSessionTranscriptResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *SyncClaudeSettingsAgentResponse) GetSettings() *ClaudeSettings {
+type DownloadSessionTranscriptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadSessionTranscriptRequest) Reset() {
+	*x = DownloadSessionTranscriptRequest{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadSessionTranscriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadSessionTranscriptRequest) ProtoMessage() {}
+
+func (x *DownloadSessionTranscriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[121]
 	if x != nil {
-		return x.Settings
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadSessionTranscriptRequest.ProtoReflect.Descriptor instead.
+func (*DownloadSessionTranscriptRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *DownloadSessionTranscriptRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *DownloadSessionTranscriptRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type DownloadSessionTranscriptResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// data is the gzip-compressed JSONL transcript.
+	Data             []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	UncompressedSize int64  `protobuf:"varint,2,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressed_size,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DownloadSessionTranscriptResponse) Reset() {
+	*x = DownloadSessionTranscriptResponse{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadSessionTranscriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadSessionTranscriptResponse) ProtoMessage() {}
+
+func (x *DownloadSessionTranscriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadSessionTranscriptResponse.ProtoReflect.Descriptor instead.
+func (*DownloadSessionTranscriptResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *DownloadSessionTranscriptResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DownloadSessionTranscriptResponse) GetUncompressedSize() int64 {
+	if x != nil {
+		return x.UncompressedSize
+	}
+	return 0
+}
+
+type GetTaskHandoffRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// max_agent_outputs limits the number of most recent agent outputs
+	// returned. Defaults to 5.
+	MaxAgentOutputs int32 `protobuf:"varint,2,opt,name=max_agent_outputs,json=maxAgentOutputs,proto3" json:"max_agent_outputs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTaskHandoffRequest) Reset() {
+	*x = GetTaskHandoffRequest{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskHandoffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskHandoffRequest) ProtoMessage() {}
+
+func (x *GetTaskHandoffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskHandoffRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskHandoffRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *GetTaskHandoffRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *GetTaskHandoffRequest) GetMaxAgentOutputs() int32 {
+	if x != nil {
+		return x.MaxAgentOutputs
+	}
+	return 0
+}
+
+type GetTaskHandoffResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// logs are the RESULT and DIRECTIVE logs of the task plus its most recent
+	// AGENT_OUTPUT logs, in chronological order.
+	Logs          []*TaskLog `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskHandoffResponse) Reset() {
+	*x = GetTaskHandoffResponse{}
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskHandoffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskHandoffResponse) ProtoMessage() {}
+
+func (x *GetTaskHandoffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_agent_manager_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskHandoffResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskHandoffResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_agent_manager_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetTaskHandoffResponse) GetLogs() []*TaskLog {
+	if x != nil {
+		return x.Logs
 	}
 	return nil
 }
@@ -5641,15 +7943,16 @@ var File_taskguild_v1_agent_manager_proto protoreflect.FileDescriptor
 
 const file_taskguild_v1_agent_manager_proto_rawDesc = "" +
 	"\n" +
-	" taskguild/v1/agent_manager.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18taskguild/v1/agent.proto\x1a\x1etaskguild/v1/interaction.proto\x1a\x1dtaskguild/v1/permission.proto\x1a\x19taskguild/v1/script.proto\x1a,taskguild/v1/single_command_permission.proto\x1a\x18taskguild/v1/skill.proto\x1a\"taskguild/v1/claude_settings.proto\x1a\x1btaskguild/v1/task_log.proto\"\x85\x02\n" +
+	" taskguild/v1/agent_manager.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18taskguild/v1/agent.proto\x1a\x1etaskguild/v1/interaction.proto\x1a\x1dtaskguild/v1/permission.proto\x1a\x19taskguild/v1/script.proto\x1a,taskguild/v1/single_command_permission.proto\x1a\x18taskguild/v1/skill.proto\x1a\"taskguild/v1/claude_settings.proto\x1a\x1btaskguild/v1/task_log.proto\"\xda\x02\n" +
 	"\x1cAgentManagerSubscribeRequest\x12(\n" +
 	"\x10agent_manager_id\x18\x01 \x01(\tR\x0eagentManagerId\x12!\n" +
 	"\fproject_name\x18\x02 \x01(\tR\vprojectName\x120\n" +
 	"\x14max_concurrent_tasks\x18\x03 \x01(\x05R\x12maxConcurrentTasks\x12&\n" +
 	"\x0factive_task_ids\x18\x04 \x03(\tR\ractiveTaskIds\x12#\n" +
 	"\ragent_version\x18\x05 \x01(\tR\fagentVersion\x12\x19\n" +
-	"\bwork_dir\x18\x06 \x01(\tR\aworkDir\"\xdd\n" +
-	"\n" +
+	"\bwork_dir\x18\x06 \x01(\tR\aworkDir\x127\n" +
+	"\bprojects\x18\a \x03(\v2\x1b.taskguild.v1.ServedProjectR\bprojects\x12\x1a\n" +
+	"\bdraining\x18\b \x01(\bR\bdraining\"\xd0\r\n" +
 	"\fAgentCommand\x12K\n" +
 	"\x0etask_available\x18\x01 \x01(\v2\".taskguild.v1.TaskAvailableCommandH\x00R\rtaskAvailable\x12B\n" +
 	"\vassign_task\x18\x02 \x01(\v2\x1f.taskguild.v1.AssignTaskCommandH\x00R\n" +
@@ -5674,8 +7977,19 @@ const file_taskguild_v1_agent_manager_proto_rawDesc = "" +
 	"\vsync_skills\x18\x10 \x01(\v2\x1f.taskguild.v1.SyncSkillsCommandH\x00R\n" +
 	"syncSkills\x12K\n" +
 	"\x0ecompare_skills\x18\x11 \x01(\v2\".taskguild.v1.CompareSkillsCommandH\x00R\rcompareSkills\x12[\n" +
-	"\x14sync_claude_settings\x18\x12 \x01(\v2'.taskguild.v1.SyncClaudeSettingsCommandH\x00R\x12syncClaudeSettingsB\t\n" +
-	"\acommand\"\r\n" +
+	"\x14sync_claude_settings\x18\x12 \x01(\v2'.taskguild.v1.SyncClaudeSettingsCommandH\x00R\x12syncClaudeSettings\x122\n" +
+	"\x05drain\x18\x13 \x01(\v2\x1a.taskguild.v1.DrainCommandH\x00R\x05drain\x12E\n" +
+	"\fcompact_task\x18\x14 \x01(\v2 .taskguild.v1.CompactTaskCommandH\x00R\vcompactTask\x12H\n" +
+	"\rrollback_task\x18\x15 \x01(\v2!.taskguild.v1.RollbackTaskCommandH\x00R\frollbackTask\x12<\n" +
+	"\ttask_diff\x18\x16 \x01(\v2\x1d.taskguild.v1.TaskDiffCommandH\x00R\btaskDiff\x12K\n" +
+	"\x0emerge_worktree\x18\x17 \x01(\v2\".taskguild.v1.MergeWorktreeCommandH\x00R\rmergeWorktree\x12!\n" +
+	"\fproject_name\x18d \x01(\tR\vprojectNameB\t\n" +
+	"\acommand\"\x97\x01\n" +
+	"\rServedProject\x12!\n" +
+	"\fproject_name\x18\x01 \x01(\tR\vprojectName\x12\x19\n" +
+	"\bwork_dir\x18\x02 \x01(\tR\aworkDir\x120\n" +
+	"\x14max_concurrent_tasks\x18\x03 \x01(\x05R\x12maxConcurrentTasks\x12\x16\n" +
+	"\x06labels\x18\x04 \x03(\tR\x06labels\"\r\n" +
 	"\vPingCommand\"\xf8\x01\n" +
 	"\x14TaskAvailableCommand\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x14\n" +
@@ -5702,36 +8016,44 @@ const file_taskguild_v1_agent_manager_proto_rawDesc = "" +
 	"\bresponse\x18\x02 \x01(\tR\bresponse\"R\n" +
 	"\x11SyncAgentsCommand\x12=\n" +
 	"\x1bforce_overwrite_agent_names\x18\x01 \x03(\tR\x18forceOverwriteAgentNames\"\x18\n" +
-	"\x16SyncPermissionsCommand\"5\n" +
+	"\x16SyncPermissionsCommand\"V\n" +
 	"\x14ListWorktreesCommand\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x01 \x01(\tR\trequestId\"U\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1f\n" +
+	"\vbase_branch\x18\x02 \x01(\tR\n" +
+	"baseBranch\"U\n" +
 	"\x10ClaimTaskRequest\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12(\n" +
-	"\x10agent_manager_id\x18\x02 \x01(\tR\x0eagentManagerId\"\x81\x02\n" +
+	"\x10agent_manager_id\x18\x02 \x01(\tR\x0eagentManagerId\"\x85\x03\n" +
 	"\x11ClaimTaskResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12&\n" +
 	"\x0fagent_config_id\x18\x02 \x01(\tR\ragentConfigId\x12\"\n" +
 	"\finstructions\x18\x03 \x01(\tR\finstructions\x12I\n" +
-	"\bmetadata\x18\x04 \x03(\v2-.taskguild.v1.ClaimTaskResponse.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\x04 \x03(\v2-.taskguild.v1.ClaimTaskResponse.MetadataEntryR\bmetadata\x12F\n" +
+	"\asecrets\x18\x05 \x03(\v2,.taskguild.v1.ClaimTaskResponse.SecretsEntryR\asecrets\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x7f\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a:\n" +
+	"\fSecretsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9c\x01\n" +
 	"\x17ReportTaskResultRequest\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x18\n" +
 	"\asummary\x18\x03 \x01(\tR\asummary\x12#\n" +
-	"\rerror_message\x18\x04 \x01(\tR\ferrorMessageJ\x04\b\x02\x10\x03R\x06status\"\x1a\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\x12\x1b\n" +
+	"\tresult_id\x18\x05 \x01(\tR\bresultIdJ\x04\b\x02\x10\x03R\x06status\"\x1a\n" +
 	"\x18ReportTaskResultResponse\"\xaa\x01\n" +
 	"\x18ReportAgentStatusRequest\x12(\n" +
 	"\x10agent_manager_id\x18\x01 \x01(\tR\x0eagentManagerId\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\tR\x06taskId\x121\n" +
 	"\x06status\x18\x03 \x01(\x0e2\x19.taskguild.v1.AgentStatusR\x06status\x12\x18\n" +
 	"\amessage\x18\x04 \x01(\tR\amessage\"\x1b\n" +
-	"\x19ReportAgentStatusResponse\"\x99\x01\n" +
+	"\x19ReportAgentStatusResponse\"\xb5\x01\n" +
 	"\x10HeartbeatRequest\x12(\n" +
 	"\x10agent_manager_id\x18\x01 \x01(\tR\x0eagentManagerId\x12!\n" +
 	"\factive_tasks\x18\x02 \x01(\x05R\vactiveTasks\x128\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x13\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1a\n" +
+	"\bdraining\x18\x04 \x01(\bR\bdraining\"\x13\n" +
 	"\x11HeartbeatResponse\"\x90\x02\n" +
 	"\x18CreateInteractionRequest\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x19\n" +
@@ -5759,29 +8081,44 @@ const file_taskguild_v1_agent_manager_proto_rawDesc = "" +
 	"\n" +
 	"local_deny\x18\x04 \x03(\tR\tlocalDeny\"X\n" +
 	"\x17SyncPermissionsResponse\x12=\n" +
-	"\vpermissions\x18\x01 \x01(\v2\x1b.taskguild.v1.PermissionSetR\vpermissions\"\xc1\x02\n" +
+	"\vpermissions\x18\x01 \x01(\v2\x1b.taskguild.v1.PermissionSetR\vpermissions\"\x93\x03\n" +
 	"\x14ReportTaskLogRequest\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x120\n" +
 	"\x05level\x18\x02 \x01(\x0e2\x1a.taskguild.v1.TaskLogLevelR\x05level\x129\n" +
 	"\bcategory\x18\x03 \x01(\x0e2\x1d.taskguild.v1.TaskLogCategoryR\bcategory\x12\x18\n" +
 	"\amessage\x18\x04 \x01(\tR\amessage\x12L\n" +
-	"\bmetadata\x18\x05 \x03(\v20.taskguild.v1.ReportTaskLogRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\x05 \x03(\v20.taskguild.v1.ReportTaskLogRequest.MetadataEntryR\bmetadata\x12\x15\n" +
+	"\x06log_id\x18\x06 \x01(\tR\x05logId\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x17\n" +
-	"\x15ReportTaskLogResponse\"\x99\x01\n" +
+	"\x15ReportTaskLogResponse\"\xfb\x02\n" +
 	"\fWorktreeInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06branch\x18\x02 \x01(\tR\x06branch\x12\x17\n" +
 	"\atask_id\x18\x03 \x01(\tR\x06taskId\x12\x1f\n" +
 	"\vhas_changes\x18\x04 \x01(\bR\n" +
 	"hasChanges\x12#\n" +
-	"\rchanged_files\x18\x05 \x03(\tR\fchangedFiles\"q\n" +
+	"\rchanged_files\x18\x05 \x03(\tR\fchangedFiles\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x06 \x01(\x03R\tsizeBytes\x12D\n" +
+	"\x10last_modified_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0elastModifiedAt\x12\x16\n" +
+	"\x06merged\x18\b \x01(\bR\x06merged\x12\x1d\n" +
+	"\n" +
+	"task_title\x18\t \x01(\tR\ttaskTitle\x12\x1f\n" +
+	"\vtask_status\x18\n" +
+	" \x01(\tR\n" +
+	"taskStatus\x12#\n" +
+	"\rtask_archived\x18\v \x01(\bR\ftaskArchived\"\x92\x01\n" +
 	"\x15DeleteWorktreeCommand\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12#\n" +
 	"\rworktree_name\x18\x02 \x01(\tR\fworktreeName\x12\x14\n" +
-	"\x05force\x18\x03 \x01(\bR\x05force\"\x97\x01\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\x12\x1f\n" +
+	"\vkeep_branch\x18\x04 \x01(\bR\n" +
+	"keepBranch\"\x97\x01\n" +
 	"\x19ReportWorktreeListRequest\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12!\n" +
@@ -5796,9 +8133,11 @@ const file_taskguild_v1_agent_manager_proto_rawDesc = "" +
 	"request_id\x18\x01 \x01(\tR\trequestId\"7\n" +
 	"\x16GetWorktreeListRequest\x12\x1d\n" +
 	"\n" +
-	"project_id\x18\x01 \x01(\tR\tprojectId\"S\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\"\xa7\x01\n" +
 	"\x17GetWorktreeListResponse\x128\n" +
-	"\tworktrees\x18\x01 \x03(\v2\x1a.taskguild.v1.WorktreeInfoR\tworktrees\"x\n" +
+	"\tworktrees\x18\x01 \x03(\v2\x1a.taskguild.v1.WorktreeInfoR\tworktrees\x12(\n" +
+	"\x10total_size_bytes\x18\x02 \x01(\x03R\x0etotalSizeBytes\x12(\n" +
+	"\x10disk_quota_bytes\x18\x03 \x01(\x03R\x0ediskQuotaBytes\"x\n" +
 	"\x1cRequestWorktreeDeleteRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12#\n" +
@@ -5837,13 +8176,17 @@ const file_taskguild_v1_agent_manager_proto_rawDesc = "" +
 	"\x15CompareScriptsCommand\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x128\n" +
-	"\ascripts\x18\x02 \x03(\v2\x1e.taskguild.v1.ScriptDefinitionR\ascripts\"\x88\x01\n" +
+	"\ascripts\x18\x02 \x03(\v2\x1e.taskguild.v1.ScriptDefinitionR\ascripts\"\x8f\x02\n" +
 	"\x14ExecuteScriptCommand\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1b\n" +
 	"\tscript_id\x18\x02 \x01(\tR\bscriptId\x12\x1a\n" +
 	"\bfilename\x18\x03 \x01(\tR\bfilename\x12\x18\n" +
-	"\acontent\x18\x04 \x01(\tR\acontent\"7\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12I\n" +
+	"\asecrets\x18\x05 \x03(\v2/.taskguild.v1.ExecuteScriptCommand.SecretsEntryR\asecrets\x1a:\n" +
+	"\fSecretsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"7\n" +
 	"\x12SyncScriptsRequest\x12!\n" +
 	"\fproject_name\x18\x01 \x01(\tR\vprojectName\"O\n" +
 	"\x13SyncScriptsResponse\x128\n" +
@@ -5996,11 +8339,22 @@ const file_taskguild_v1_agent_manager_proto_rawDesc = "" +
 	"(ListSingleCommandPermissionsAgentRequest\x12!\n" +
 	"\fproject_name\x18\x01 \x01(\tR\vprojectName\"t\n" +
 	")ListSingleCommandPermissionsAgentResponse\x12G\n" +
-	"\vpermissions\x18\x01 \x03(\v2%.taskguild.v1.SingleCommandPermissionR\vpermissions\"z\n" +
+	"\vpermissions\x18\x01 \x03(\v2%.taskguild.v1.SingleCommandPermissionR\vpermissions\"\xdf\x02\n" +
 	"!AddSingleCommandPermissionRequest\x12!\n" +
 	"\fproject_name\x18\x01 \x01(\tR\vprojectName\x12\x18\n" +
 	"\apattern\x18\x02 \x01(\tR\apattern\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04typeJ\x04\b\x04\x10\x05\"k\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05scope\x18\x05 \x01(\tR\x05scope\x12\x17\n" +
+	"\atask_id\x18\x06 \x01(\tR\x06taskId\x12\x1f\n" +
+	"\vworkflow_id\x18\a \x01(\tR\n" +
+	"workflowId\x12\x1f\n" +
+	"\vstatus_name\x18\b \x01(\tR\n" +
+	"statusName\x12\x1f\n" +
+	"\vttl_seconds\x18\t \x01(\x03R\n" +
+	"ttlSeconds\x12$\n" +
+	"\x0eorigin_task_id\x18\n" +
+	" \x01(\tR\foriginTaskId\x12%\n" +
+	"\x0einteraction_id\x18\f \x01(\tR\rinteractionIdJ\x04\b\x04\x10\x05J\x04\b\v\x10\f\"k\n" +
 	"\"AddSingleCommandPermissionResponse\x12E\n" +
 	"\n" +
 	"permission\x18\x01 \x01(\v2%.taskguild.v1.SingleCommandPermissionR\n" +
@@ -6012,7 +8366,156 @@ const file_taskguild_v1_agent_manager_proto_rawDesc = "" +
 	"\x11local_attribution\x18\x03 \x01(\v2\x19.taskguild.v1.AttributionR\x10localAttributionB\x11\n" +
 	"\x0f_local_language\"[\n" +
 	"\x1fSyncClaudeSettingsAgentResponse\x128\n" +
-	"\bsettings\x18\x01 \x01(\v2\x1c.taskguild.v1.ClaudeSettingsR\bsettings*\x8e\x01\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1c.taskguild.v1.ClaudeSettingsR\bsettings\"&\n" +
+	"\fDrainCommand\x12\x16\n" +
+	"\x06resume\x18\x01 \x01(\bR\x06resume\"-\n" +
+	"\x12CompactTaskCommand\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"\xaa\x02\n" +
+	"\x13RollbackTaskCommand\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12#\n" +
+	"\rcheckpoint_id\x18\x03 \x01(\tR\fcheckpointId\x12\x16\n" +
+	"\x06commit\x18\x04 \x01(\tR\x06commit\x12#\n" +
+	"\rworktree_name\x18\x05 \x01(\tR\fworktreeName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x06 \x01(\tR\tsessionId\x12!\n" +
+	"\fmessage_uuid\x18\a \x01(\tR\vmessageUuid\x12\x1f\n" +
+	"\vstatus_name\x18\b \x01(\tR\n" +
+	"statusName\x12\x16\n" +
+	"\x06resume\x18\t \x01(\bR\x06resume\"\xf4\x01\n" +
+	"\x1fReportTaskRollbackResultRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12#\n" +
+	"\rcheckpoint_id\x18\x03 \x01(\tR\fcheckpointId\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x06 \x01(\tR\tsessionId\x12\x16\n" +
+	"\x06resume\x18\a \x01(\bR\x06resume\"\"\n" +
+	" ReportTaskRollbackResultResponse\"\xb7\x01\n" +
+	"\x0fTaskDiffCommand\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12#\n" +
+	"\rworktree_name\x18\x03 \x01(\tR\fworktreeName\x12\x1f\n" +
+	"\vbase_branch\x18\x04 \x01(\tR\n" +
+	"baseBranch\x12&\n" +
+	"\x0fmax_patch_bytes\x18\x05 \x01(\x05R\rmaxPatchBytes\"\xdf\x03\n" +
+	"\bTaskDiff\x12\x1f\n" +
+	"\vbase_branch\x18\x01 \x01(\tR\n" +
+	"baseBranch\x12\x1f\n" +
+	"\vbase_commit\x18\x02 \x01(\tR\n" +
+	"baseCommit\x12\x1f\n" +
+	"\vhead_commit\x18\x03 \x01(\tR\n" +
+	"headCommit\x12\x16\n" +
+	"\x06branch\x18\x04 \x01(\tR\x06branch\x120\n" +
+	"\x05files\x18\x05 \x03(\v2\x1a.taskguild.v1.TaskDiffFileR\x05files\x12\x14\n" +
+	"\x05patch\x18\x06 \x01(\tR\x05patch\x12'\n" +
+	"\x0fpatch_truncated\x18\a \x01(\bR\x0epatchTruncated\x126\n" +
+	"\acommits\x18\b \x03(\v2\x1c.taskguild.v1.TaskDiffCommitR\acommits\x12\x1c\n" +
+	"\tadditions\x18\t \x01(\x05R\tadditions\x12\x1c\n" +
+	"\tdeletions\x18\n" +
+	" \x01(\x05R\tdeletions\x126\n" +
+	"\x17has_uncommitted_changes\x18\v \x01(\bR\x15hasUncommittedChanges\x12;\n" +
+	"\vcaptured_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"capturedAt\"\x8e\x01\n" +
+	"\fTaskDiffFile\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1c\n" +
+	"\tadditions\x18\x03 \x01(\x05R\tadditions\x12\x1c\n" +
+	"\tdeletions\x18\x04 \x01(\x05R\tdeletions\x12\x16\n" +
+	"\x06binary\x18\x05 \x01(\bR\x06binary\"\x93\x01\n" +
+	"\x0eTaskDiffCommit\x12\x10\n" +
+	"\x03sha\x18\x01 \x01(\tR\x03sha\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12=\n" +
+	"\fcommitted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcommittedAt\"-\n" +
+	"\x12GetTaskDiffRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"f\n" +
+	"\x13GetTaskDiffResponse\x12*\n" +
+	"\x04diff\x18\x01 \x01(\v2\x16.taskguild.v1.TaskDiffR\x04diff\x12#\n" +
+	"\rfrom_snapshot\x18\x02 \x01(\bR\ffromSnapshot\"\xbd\x01\n" +
+	"\x15ReportTaskDiffRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12*\n" +
+	"\x04diff\x18\x03 \x01(\v2\x16.taskguild.v1.TaskDiffR\x04diff\x12\x1b\n" +
+	"\tnot_found\x18\x04 \x01(\bR\bnotFound\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"\x18\n" +
+	"\x16ReportTaskDiffResponse\"\xd1\x01\n" +
+	"\x14MergeWorktreeCommand\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12#\n" +
+	"\rworktree_name\x18\x03 \x01(\tR\fworktreeName\x12\x1f\n" +
+	"\vbase_branch\x18\x04 \x01(\tR\n" +
+	"baseBranch\x12'\n" +
+	"\x0fverify_commands\x18\x05 \x03(\tR\x0everifyCommands\x12\x12\n" +
+	"\x04push\x18\x06 \x01(\bR\x04push\"\xc7\x02\n" +
+	"\x18ReportMergeResultRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1b\n" +
+	"\tnot_found\x18\x03 \x01(\bR\bnotFound\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x12\n" +
+	"\x04step\x18\x05 \x01(\tR\x04step\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\x12%\n" +
+	"\x0econflict_files\x18\a \x03(\tR\rconflictFiles\x12\x16\n" +
+	"\x06output\x18\b \x01(\tR\x06output\x12#\n" +
+	"\rmerged_commit\x18\t \x01(\tR\fmergedCommit\x12\x1f\n" +
+	"\vbase_branch\x18\n" +
+	" \x01(\tR\n" +
+	"baseBranch\"\x1b\n" +
+	"\x19ReportMergeResultResponse\"p\n" +
+	"\x1aReportModifiedFilesRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12#\n" +
+	"\rworktree_name\x18\x02 \x01(\tR\fworktreeName\x12\x14\n" +
+	"\x05files\x18\x03 \x03(\tR\x05files\"T\n" +
+	"\x1bReportModifiedFilesResponse\x125\n" +
+	"\boverlaps\x18\x01 \x03(\v2\x19.taskguild.v1.FileOverlapR\boverlaps\"\x80\x01\n" +
+	"\vFileOverlap\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1d\n" +
+	"\n" +
+	"task_title\x18\x02 \x01(\tR\ttaskTitle\x12#\n" +
+	"\rworktree_name\x18\x03 \x01(\tR\fworktreeName\x12\x14\n" +
+	"\x05files\x18\x04 \x03(\tR\x05files\"\\\n" +
+	"\x18DrainAgentManagerRequest\x12(\n" +
+	"\x10agent_manager_id\x18\x01 \x01(\tR\x0eagentManagerId\x12\x16\n" +
+	"\x06resume\x18\x02 \x01(\bR\x06resume\"`\n" +
+	"\x19DrainAgentManagerResponse\x12C\n" +
+	"\ragent_manager\x18\x01 \x01(\v2\x1e.taskguild.v1.AgentManagerInfoR\fagentManager\"\x1a\n" +
+	"\x18ListAgentManagersRequest\"b\n" +
+	"\x19ListAgentManagersResponse\x12E\n" +
+	"\x0eagent_managers\x18\x01 \x03(\v2\x1e.taskguild.v1.AgentManagerInfoR\ragentManagers\"\xbd\x02\n" +
+	"\x10AgentManagerInfo\x12(\n" +
+	"\x10agent_manager_id\x18\x01 \x01(\tR\x0eagentManagerId\x120\n" +
+	"\x14max_concurrent_tasks\x18\x02 \x01(\x05R\x12maxConcurrentTasks\x12!\n" +
+	"\factive_tasks\x18\x03 \x01(\x05R\vactiveTasks\x127\n" +
+	"\bprojects\x18\x04 \x03(\v2\x1b.taskguild.v1.ServedProjectR\bprojects\x12A\n" +
+	"\x0elast_heartbeat\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rlastHeartbeat\x12\x1a\n" +
+	"\bdraining\x18\x06 \x01(\bR\bdraining\x12\x12\n" +
+	"\x04idle\x18\a \x01(\bR\x04idle\"\x99\x01\n" +
+	"\x1eUploadSessionTranscriptRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12+\n" +
+	"\x11uncompressed_size\x18\x04 \x01(\x03R\x10uncompressedSize\"!\n" +
+	"\x1fUploadSessionTranscriptResponse\"Z\n" +
+	" DownloadSessionTranscriptRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"d\n" +
+	"!DownloadSessionTranscriptResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12+\n" +
+	"\x11uncompressed_size\x18\x02 \x01(\x03R\x10uncompressedSize\"\\\n" +
+	"\x15GetTaskHandoffRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12*\n" +
+	"\x11max_agent_outputs\x18\x02 \x01(\x05R\x0fmaxAgentOutputs\"C\n" +
+	"\x16GetTaskHandoffResponse\x12)\n" +
+	"\x04logs\x18\x01 \x03(\v2\x15.taskguild.v1.TaskLogR\x04logs*\x8e\x01\n" +
 	"\vAgentStatus\x12\x1c\n" +
 	"\x18AGENT_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11AGENT_STATUS_IDLE\x10\x01\x12\x18\n" +
@@ -6045,7 +8548,7 @@ const file_taskguild_v1_agent_manager_proto_rawDesc = "" +
 	"\x15SkillResolutionChoice\x12'\n" +
 	"#SKILL_RESOLUTION_CHOICE_UNSPECIFIED\x10\x00\x12\"\n" +
 	"\x1eSKILL_RESOLUTION_CHOICE_SERVER\x10\x01\x12!\n" +
-	"\x1dSKILL_RESOLUTION_CHOICE_AGENT\x10\x022\xb1\x1e\n" +
+	"\x1dSKILL_RESOLUTION_CHOICE_AGENT\x10\x022\xce&\n" +
 	"\x13AgentManagerService\x12U\n" +
 	"\tSubscribe\x12*.taskguild.v1.AgentManagerSubscribeRequest\x1a\x1a.taskguild.v1.AgentCommand0\x01\x12L\n" +
 	"\tClaimTask\x12\x1e.taskguild.v1.ClaimTaskRequest\x1a\x1f.taskguild.v1.ClaimTaskResponse\x12a\n" +
@@ -6084,7 +8587,17 @@ const file_taskguild_v1_agent_manager_proto_rawDesc = "" +
 	"\x15ReportSkillComparison\x12*.taskguild.v1.ReportSkillComparisonRequest\x1a+.taskguild.v1.ReportSkillComparisonResponse\x12g\n" +
 	"\x12GetSkillComparison\x12'.taskguild.v1.GetSkillComparisonRequest\x1a(.taskguild.v1.GetSkillComparisonResponse\x12m\n" +
 	"\x14ResolveSkillConflict\x12).taskguild.v1.ResolveSkillConflictRequest\x1a*.taskguild.v1.ResolveSkillConflictResponse\x12q\n" +
-	"\x12SyncClaudeSettings\x12,.taskguild.v1.SyncClaudeSettingsAgentRequest\x1a-.taskguild.v1.SyncClaudeSettingsAgentResponseB\xba\x01\n" +
+	"\x12SyncClaudeSettings\x12,.taskguild.v1.SyncClaudeSettingsAgentRequest\x1a-.taskguild.v1.SyncClaudeSettingsAgentResponse\x12d\n" +
+	"\x11DrainAgentManager\x12&.taskguild.v1.DrainAgentManagerRequest\x1a'.taskguild.v1.DrainAgentManagerResponse\x12d\n" +
+	"\x11ListAgentManagers\x12&.taskguild.v1.ListAgentManagersRequest\x1a'.taskguild.v1.ListAgentManagersResponse\x12v\n" +
+	"\x17UploadSessionTranscript\x12,.taskguild.v1.UploadSessionTranscriptRequest\x1a-.taskguild.v1.UploadSessionTranscriptResponse\x12|\n" +
+	"\x19DownloadSessionTranscript\x12..taskguild.v1.DownloadSessionTranscriptRequest\x1a/.taskguild.v1.DownloadSessionTranscriptResponse\x12[\n" +
+	"\x0eGetTaskHandoff\x12#.taskguild.v1.GetTaskHandoffRequest\x1a$.taskguild.v1.GetTaskHandoffResponse\x12y\n" +
+	"\x18ReportTaskRollbackResult\x12-.taskguild.v1.ReportTaskRollbackResultRequest\x1a..taskguild.v1.ReportTaskRollbackResultResponse\x12R\n" +
+	"\vGetTaskDiff\x12 .taskguild.v1.GetTaskDiffRequest\x1a!.taskguild.v1.GetTaskDiffResponse\x12[\n" +
+	"\x0eReportTaskDiff\x12#.taskguild.v1.ReportTaskDiffRequest\x1a$.taskguild.v1.ReportTaskDiffResponse\x12d\n" +
+	"\x11ReportMergeResult\x12&.taskguild.v1.ReportMergeResultRequest\x1a'.taskguild.v1.ReportMergeResultResponse\x12j\n" +
+	"\x13ReportModifiedFiles\x12(.taskguild.v1.ReportModifiedFilesRequest\x1a).taskguild.v1.ReportModifiedFilesResponseB\xba\x01\n" +
 	"\x10com.taskguild.v1B\x11AgentManagerProtoP\x01ZBgithub.com/kazz187/taskguild/proto/gen/go/taskguild/v1;taskguildv1\xa2\x02\x03TXX\xaa\x02\fTaskguild.V1\xca\x02\fTaskguild\\V1\xe2\x02\x18Taskguild\\V1\\GPBMetadata\xea\x02\rTaskguild::V1b\x06proto3"
 
 var (
@@ -6100,7 +8613,7 @@ func file_taskguild_v1_agent_manager_proto_rawDescGZIP() []byte {
 }
 
 var file_taskguild_v1_agent_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_taskguild_v1_agent_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
+var file_taskguild_v1_agent_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 131)
 var file_taskguild_v1_agent_manager_proto_goTypes = []any{
 	(AgentStatus)(0),                                  // 0: taskguild.v1.AgentStatus
 	(ScriptDiffType)(0),                               // 1: taskguild.v1.ScriptDiffType
@@ -6111,255 +8624,331 @@ var file_taskguild_v1_agent_manager_proto_goTypes = []any{
 	(SkillResolutionChoice)(0),                        // 6: taskguild.v1.SkillResolutionChoice
 	(*AgentManagerSubscribeRequest)(nil),              // 7: taskguild.v1.AgentManagerSubscribeRequest
 	(*AgentCommand)(nil),                              // 8: taskguild.v1.AgentCommand
-	(*PingCommand)(nil),                               // 9: taskguild.v1.PingCommand
-	(*TaskAvailableCommand)(nil),                      // 10: taskguild.v1.TaskAvailableCommand
-	(*AssignTaskCommand)(nil),                         // 11: taskguild.v1.AssignTaskCommand
-	(*CancelTaskCommand)(nil),                         // 12: taskguild.v1.CancelTaskCommand
-	(*InteractionResponseCommand)(nil),                // 13: taskguild.v1.InteractionResponseCommand
-	(*SyncAgentsCommand)(nil),                         // 14: taskguild.v1.SyncAgentsCommand
-	(*SyncPermissionsCommand)(nil),                    // 15: taskguild.v1.SyncPermissionsCommand
-	(*ListWorktreesCommand)(nil),                      // 16: taskguild.v1.ListWorktreesCommand
-	(*ClaimTaskRequest)(nil),                          // 17: taskguild.v1.ClaimTaskRequest
-	(*ClaimTaskResponse)(nil),                         // 18: taskguild.v1.ClaimTaskResponse
-	(*ReportTaskResultRequest)(nil),                   // 19: taskguild.v1.ReportTaskResultRequest
-	(*ReportTaskResultResponse)(nil),                  // 20: taskguild.v1.ReportTaskResultResponse
-	(*ReportAgentStatusRequest)(nil),                  // 21: taskguild.v1.ReportAgentStatusRequest
-	(*ReportAgentStatusResponse)(nil),                 // 22: taskguild.v1.ReportAgentStatusResponse
-	(*HeartbeatRequest)(nil),                          // 23: taskguild.v1.HeartbeatRequest
-	(*HeartbeatResponse)(nil),                         // 24: taskguild.v1.HeartbeatResponse
-	(*CreateInteractionRequest)(nil),                  // 25: taskguild.v1.CreateInteractionRequest
-	(*CreateInteractionResponse)(nil),                 // 26: taskguild.v1.CreateInteractionResponse
-	(*GetInteractionResponseRequest)(nil),             // 27: taskguild.v1.GetInteractionResponseRequest
-	(*GetInteractionResponseResponse)(nil),            // 28: taskguild.v1.GetInteractionResponseResponse
-	(*SyncAgentsRequest)(nil),                         // 29: taskguild.v1.SyncAgentsRequest
-	(*SyncAgentsResponse)(nil),                        // 30: taskguild.v1.SyncAgentsResponse
-	(*SyncPermissionsRequest)(nil),                    // 31: taskguild.v1.SyncPermissionsRequest
-	(*SyncPermissionsResponse)(nil),                   // 32: taskguild.v1.SyncPermissionsResponse
-	(*ReportTaskLogRequest)(nil),                      // 33: taskguild.v1.ReportTaskLogRequest
-	(*ReportTaskLogResponse)(nil),                     // 34: taskguild.v1.ReportTaskLogResponse
-	(*WorktreeInfo)(nil),                              // 35: taskguild.v1.WorktreeInfo
-	(*DeleteWorktreeCommand)(nil),                     // 36: taskguild.v1.DeleteWorktreeCommand
-	(*ReportWorktreeListRequest)(nil),                 // 37: taskguild.v1.ReportWorktreeListRequest
-	(*ReportWorktreeListResponse)(nil),                // 38: taskguild.v1.ReportWorktreeListResponse
-	(*RequestWorktreeListRequest)(nil),                // 39: taskguild.v1.RequestWorktreeListRequest
-	(*RequestWorktreeListResponse)(nil),               // 40: taskguild.v1.RequestWorktreeListResponse
-	(*GetWorktreeListRequest)(nil),                    // 41: taskguild.v1.GetWorktreeListRequest
-	(*GetWorktreeListResponse)(nil),                   // 42: taskguild.v1.GetWorktreeListResponse
-	(*RequestWorktreeDeleteRequest)(nil),              // 43: taskguild.v1.RequestWorktreeDeleteRequest
-	(*RequestWorktreeDeleteResponse)(nil),             // 44: taskguild.v1.RequestWorktreeDeleteResponse
-	(*ReportWorktreeDeleteResultRequest)(nil),         // 45: taskguild.v1.ReportWorktreeDeleteResultRequest
-	(*ReportWorktreeDeleteResultResponse)(nil),        // 46: taskguild.v1.ReportWorktreeDeleteResultResponse
-	(*GitPullMainCommand)(nil),                        // 47: taskguild.v1.GitPullMainCommand
-	(*RequestGitPullMainRequest)(nil),                 // 48: taskguild.v1.RequestGitPullMainRequest
-	(*RequestGitPullMainResponse)(nil),                // 49: taskguild.v1.RequestGitPullMainResponse
-	(*ReportGitPullMainResultRequest)(nil),            // 50: taskguild.v1.ReportGitPullMainResultRequest
-	(*ReportGitPullMainResultResponse)(nil),           // 51: taskguild.v1.ReportGitPullMainResultResponse
-	(*SyncScriptsCommand)(nil),                        // 52: taskguild.v1.SyncScriptsCommand
-	(*CompareScriptsCommand)(nil),                     // 53: taskguild.v1.CompareScriptsCommand
-	(*ExecuteScriptCommand)(nil),                      // 54: taskguild.v1.ExecuteScriptCommand
-	(*SyncScriptsRequest)(nil),                        // 55: taskguild.v1.SyncScriptsRequest
-	(*SyncScriptsResponse)(nil),                       // 56: taskguild.v1.SyncScriptsResponse
-	(*ReportScriptExecutionResultRequest)(nil),        // 57: taskguild.v1.ReportScriptExecutionResultRequest
-	(*ReportScriptExecutionResultResponse)(nil),       // 58: taskguild.v1.ReportScriptExecutionResultResponse
-	(*ReportScriptOutputChunkRequest)(nil),            // 59: taskguild.v1.ReportScriptOutputChunkRequest
-	(*ReportScriptOutputChunkResponse)(nil),           // 60: taskguild.v1.ReportScriptOutputChunkResponse
-	(*StopScriptCommand)(nil),                         // 61: taskguild.v1.StopScriptCommand
-	(*ScriptDiff)(nil),                                // 62: taskguild.v1.ScriptDiff
-	(*RequestScriptComparisonRequest)(nil),            // 63: taskguild.v1.RequestScriptComparisonRequest
-	(*RequestScriptComparisonResponse)(nil),           // 64: taskguild.v1.RequestScriptComparisonResponse
-	(*ReportScriptComparisonRequest)(nil),             // 65: taskguild.v1.ReportScriptComparisonRequest
-	(*ReportScriptComparisonResponse)(nil),            // 66: taskguild.v1.ReportScriptComparisonResponse
-	(*GetScriptComparisonRequest)(nil),                // 67: taskguild.v1.GetScriptComparisonRequest
-	(*GetScriptComparisonResponse)(nil),               // 68: taskguild.v1.GetScriptComparisonResponse
-	(*ResolveScriptConflictRequest)(nil),              // 69: taskguild.v1.ResolveScriptConflictRequest
-	(*ResolveScriptConflictResponse)(nil),             // 70: taskguild.v1.ResolveScriptConflictResponse
-	(*CompareAgentsCommand)(nil),                      // 71: taskguild.v1.CompareAgentsCommand
-	(*AgentDiff)(nil),                                 // 72: taskguild.v1.AgentDiff
-	(*RequestAgentComparisonRequest)(nil),             // 73: taskguild.v1.RequestAgentComparisonRequest
-	(*RequestAgentComparisonResponse)(nil),            // 74: taskguild.v1.RequestAgentComparisonResponse
-	(*ReportAgentComparisonRequest)(nil),              // 75: taskguild.v1.ReportAgentComparisonRequest
-	(*ReportAgentComparisonResponse)(nil),             // 76: taskguild.v1.ReportAgentComparisonResponse
-	(*GetAgentComparisonRequest)(nil),                 // 77: taskguild.v1.GetAgentComparisonRequest
-	(*GetAgentComparisonResponse)(nil),                // 78: taskguild.v1.GetAgentComparisonResponse
-	(*ResolveAgentConflictRequest)(nil),               // 79: taskguild.v1.ResolveAgentConflictRequest
-	(*ResolveAgentConflictResponse)(nil),              // 80: taskguild.v1.ResolveAgentConflictResponse
-	(*SyncSkillsCommand)(nil),                         // 81: taskguild.v1.SyncSkillsCommand
-	(*CompareSkillsCommand)(nil),                      // 82: taskguild.v1.CompareSkillsCommand
-	(*SyncSkillsRequest)(nil),                         // 83: taskguild.v1.SyncSkillsRequest
-	(*SyncSkillsResponse)(nil),                        // 84: taskguild.v1.SyncSkillsResponse
-	(*SkillDiff)(nil),                                 // 85: taskguild.v1.SkillDiff
-	(*RequestSkillComparisonRequest)(nil),             // 86: taskguild.v1.RequestSkillComparisonRequest
-	(*RequestSkillComparisonResponse)(nil),            // 87: taskguild.v1.RequestSkillComparisonResponse
-	(*ReportSkillComparisonRequest)(nil),              // 88: taskguild.v1.ReportSkillComparisonRequest
-	(*ReportSkillComparisonResponse)(nil),             // 89: taskguild.v1.ReportSkillComparisonResponse
-	(*GetSkillComparisonRequest)(nil),                 // 90: taskguild.v1.GetSkillComparisonRequest
-	(*GetSkillComparisonResponse)(nil),                // 91: taskguild.v1.GetSkillComparisonResponse
-	(*ResolveSkillConflictRequest)(nil),               // 92: taskguild.v1.ResolveSkillConflictRequest
-	(*ResolveSkillConflictResponse)(nil),              // 93: taskguild.v1.ResolveSkillConflictResponse
-	(*ListSingleCommandPermissionsAgentRequest)(nil),  // 94: taskguild.v1.ListSingleCommandPermissionsAgentRequest
-	(*ListSingleCommandPermissionsAgentResponse)(nil), // 95: taskguild.v1.ListSingleCommandPermissionsAgentResponse
-	(*AddSingleCommandPermissionRequest)(nil),         // 96: taskguild.v1.AddSingleCommandPermissionRequest
-	(*AddSingleCommandPermissionResponse)(nil),        // 97: taskguild.v1.AddSingleCommandPermissionResponse
-	(*SyncClaudeSettingsCommand)(nil),                 // 98: taskguild.v1.SyncClaudeSettingsCommand
-	(*SyncClaudeSettingsAgentRequest)(nil),            // 99: taskguild.v1.SyncClaudeSettingsAgentRequest
-	(*SyncClaudeSettingsAgentResponse)(nil),           // 100: taskguild.v1.SyncClaudeSettingsAgentResponse
-	nil,                                               // 101: taskguild.v1.TaskAvailableCommand.MetadataEntry
-	nil,                                               // 102: taskguild.v1.AssignTaskCommand.MetadataEntry
-	nil,                                               // 103: taskguild.v1.ClaimTaskResponse.MetadataEntry
-	nil,                                               // 104: taskguild.v1.ReportTaskLogRequest.MetadataEntry
-	(*timestamppb.Timestamp)(nil),                     // 105: google.protobuf.Timestamp
-	(InteractionType)(0),                              // 106: taskguild.v1.InteractionType
-	(*InteractionOption)(nil),                         // 107: taskguild.v1.InteractionOption
-	(*Interaction)(nil),                               // 108: taskguild.v1.Interaction
-	(*AgentDefinition)(nil),                           // 109: taskguild.v1.AgentDefinition
-	(*PermissionSet)(nil),                             // 110: taskguild.v1.PermissionSet
-	(TaskLogLevel)(0),                                 // 111: taskguild.v1.TaskLogLevel
-	(TaskLogCategory)(0),                              // 112: taskguild.v1.TaskLogCategory
-	(*ScriptDefinition)(nil),                          // 113: taskguild.v1.ScriptDefinition
-	(*ScriptLogEntry)(nil),                            // 114: taskguild.v1.ScriptLogEntry
-	(*SkillDefinition)(nil),                           // 115: taskguild.v1.SkillDefinition
-	(*SingleCommandPermission)(nil),                   // 116: taskguild.v1.SingleCommandPermission
-	(*Attribution)(nil),                               // 117: taskguild.v1.Attribution
-	(*ClaudeSettings)(nil),                            // 118: taskguild.v1.ClaudeSettings
+	(*ServedProject)(nil),                             // 9: taskguild.v1.ServedProject
+	(*PingCommand)(nil),                               // 10: taskguild.v1.PingCommand
+	(*TaskAvailableCommand)(nil),                      // 11: taskguild.v1.TaskAvailableCommand
+	(*AssignTaskCommand)(nil),                         // 12: taskguild.v1.AssignTaskCommand
+	(*CancelTaskCommand)(nil),                         // 13: taskguild.v1.CancelTaskCommand
+	(*InteractionResponseCommand)(nil),                // 14: taskguild.v1.InteractionResponseCommand
+	(*SyncAgentsCommand)(nil),                         // 15: taskguild.v1.SyncAgentsCommand
+	(*SyncPermissionsCommand)(nil),                    // 16: taskguild.v1.SyncPermissionsCommand
+	(*ListWorktreesCommand)(nil),                      // 17: taskguild.v1.ListWorktreesCommand
+	(*ClaimTaskRequest)(nil),                          // 18: taskguild.v1.ClaimTaskRequest
+	(*ClaimTaskResponse)(nil),                         // 19: taskguild.v1.ClaimTaskResponse
+	(*ReportTaskResultRequest)(nil),                   // 20: taskguild.v1.ReportTaskResultRequest
+	(*ReportTaskResultResponse)(nil),                  // 21: taskguild.v1.ReportTaskResultResponse
+	(*ReportAgentStatusRequest)(nil),                  // 22: taskguild.v1.ReportAgentStatusRequest
+	(*ReportAgentStatusResponse)(nil),                 // 23: taskguild.v1.ReportAgentStatusResponse
+	(*HeartbeatRequest)(nil),                          // 24: taskguild.v1.HeartbeatRequest
+	(*HeartbeatResponse)(nil),                         // 25: taskguild.v1.HeartbeatResponse
+	(*CreateInteractionRequest)(nil),                  // 26: taskguild.v1.CreateInteractionRequest
+	(*CreateInteractionResponse)(nil),                 // 27: taskguild.v1.CreateInteractionResponse
+	(*GetInteractionResponseRequest)(nil),             // 28: taskguild.v1.GetInteractionResponseRequest
+	(*GetInteractionResponseResponse)(nil),            // 29: taskguild.v1.GetInteractionResponseResponse
+	(*SyncAgentsRequest)(nil),                         // 30: taskguild.v1.SyncAgentsRequest
+	(*SyncAgentsResponse)(nil),                        // 31: taskguild.v1.SyncAgentsResponse
+	(*SyncPermissionsRequest)(nil),                    // 32: taskguild.v1.SyncPermissionsRequest
+	(*SyncPermissionsResponse)(nil),                   // 33: taskguild.v1.SyncPermissionsResponse
+	(*ReportTaskLogRequest)(nil),                      // 34: taskguild.v1.ReportTaskLogRequest
+	(*ReportTaskLogResponse)(nil),                     // 35: taskguild.v1.ReportTaskLogResponse
+	(*WorktreeInfo)(nil),                              // 36: taskguild.v1.WorktreeInfo
+	(*DeleteWorktreeCommand)(nil),                     // 37: taskguild.v1.DeleteWorktreeCommand
+	(*ReportWorktreeListRequest)(nil),                 // 38: taskguild.v1.ReportWorktreeListRequest
+	(*ReportWorktreeListResponse)(nil),                // 39: taskguild.v1.ReportWorktreeListResponse
+	(*RequestWorktreeListRequest)(nil),                // 40: taskguild.v1.RequestWorktreeListRequest
+	(*RequestWorktreeListResponse)(nil),               // 41: taskguild.v1.RequestWorktreeListResponse
+	(*GetWorktreeListRequest)(nil),                    // 42: taskguild.v1.GetWorktreeListRequest
+	(*GetWorktreeListResponse)(nil),                   // 43: taskguild.v1.GetWorktreeListResponse
+	(*RequestWorktreeDeleteRequest)(nil),              // 44: taskguild.v1.RequestWorktreeDeleteRequest
+	(*RequestWorktreeDeleteResponse)(nil),             // 45: taskguild.v1.RequestWorktreeDeleteResponse
+	(*ReportWorktreeDeleteResultRequest)(nil),         // 46: taskguild.v1.ReportWorktreeDeleteResultRequest
+	(*ReportWorktreeDeleteResultResponse)(nil),        // 47: taskguild.v1.ReportWorktreeDeleteResultResponse
+	(*GitPullMainCommand)(nil),                        // 48: taskguild.v1.GitPullMainCommand
+	(*RequestGitPullMainRequest)(nil),                 // 49: taskguild.v1.RequestGitPullMainRequest
+	(*RequestGitPullMainResponse)(nil),                // 50: taskguild.v1.RequestGitPullMainResponse
+	(*ReportGitPullMainResultRequest)(nil),            // 51: taskguild.v1.ReportGitPullMainResultRequest
+	(*ReportGitPullMainResultResponse)(nil),           // 52: taskguild.v1.ReportGitPullMainResultResponse
+	(*SyncScriptsCommand)(nil),                        // 53: taskguild.v1.SyncScriptsCommand
+	(*CompareScriptsCommand)(nil),                     // 54: taskguild.v1.CompareScriptsCommand
+	(*ExecuteScriptCommand)(nil),                      // 55: taskguild.v1.ExecuteScriptCommand
+	(*SyncScriptsRequest)(nil),                        // 56: taskguild.v1.SyncScriptsRequest
+	(*SyncScriptsResponse)(nil),                       // 57: taskguild.v1.SyncScriptsResponse
+	(*ReportScriptExecutionResultRequest)(nil),        // 58: taskguild.v1.ReportScriptExecutionResultRequest
+	(*ReportScriptExecutionResultResponse)(nil),       // 59: taskguild.v1.ReportScriptExecutionResultResponse
+	(*ReportScriptOutputChunkRequest)(nil),            // 60: taskguild.v1.ReportScriptOutputChunkRequest
+	(*ReportScriptOutputChunkResponse)(nil),           // 61: taskguild.v1.ReportScriptOutputChunkResponse
+	(*StopScriptCommand)(nil),                         // 62: taskguild.v1.StopScriptCommand
+	(*ScriptDiff)(nil),                                // 63: taskguild.v1.ScriptDiff
+	(*RequestScriptComparisonRequest)(nil),            // 64: taskguild.v1.RequestScriptComparisonRequest
+	(*RequestScriptComparisonResponse)(nil),           // 65: taskguild.v1.RequestScriptComparisonResponse
+	(*ReportScriptComparisonRequest)(nil),             // 66: taskguild.v1.ReportScriptComparisonRequest
+	(*ReportScriptComparisonResponse)(nil),            // 67: taskguild.v1.ReportScriptComparisonResponse
+	(*GetScriptComparisonRequest)(nil),                // 68: taskguild.v1.GetScriptComparisonRequest
+	(*GetScriptComparisonResponse)(nil),               // 69: taskguild.v1.GetScriptComparisonResponse
+	(*ResolveScriptConflictRequest)(nil),              // 70: taskguild.v1.ResolveScriptConflictRequest
+	(*ResolveScriptConflictResponse)(nil),             // 71: taskguild.v1.ResolveScriptConflictResponse
+	(*CompareAgentsCommand)(nil),                      // 72: taskguild.v1.CompareAgentsCommand
+	(*AgentDiff)(nil),                                 // 73: taskguild.v1.AgentDiff
+	(*RequestAgentComparisonRequest)(nil),             // 74: taskguild.v1.RequestAgentComparisonRequest
+	(*RequestAgentComparisonResponse)(nil),            // 75: taskguild.v1.RequestAgentComparisonResponse
+	(*ReportAgentComparisonRequest)(nil),              // 76: taskguild.v1.ReportAgentComparisonRequest
+	(*ReportAgentComparisonResponse)(nil),             // 77: taskguild.v1.ReportAgentComparisonResponse
+	(*GetAgentComparisonRequest)(nil),                 // 78: taskguild.v1.GetAgentComparisonRequest
+	(*GetAgentComparisonResponse)(nil),                // 79: taskguild.v1.GetAgentComparisonResponse
+	(*ResolveAgentConflictRequest)(nil),               // 80: taskguild.v1.ResolveAgentConflictRequest
+	(*ResolveAgentConflictResponse)(nil),              // 81: taskguild.v1.ResolveAgentConflictResponse
+	(*SyncSkillsCommand)(nil),                         // 82: taskguild.v1.SyncSkillsCommand
+	(*CompareSkillsCommand)(nil),                      // 83: taskguild.v1.CompareSkillsCommand
+	(*SyncSkillsRequest)(nil),                         // 84: taskguild.v1.SyncSkillsRequest
+	(*SyncSkillsResponse)(nil),                        // 85: taskguild.v1.SyncSkillsResponse
+	(*SkillDiff)(nil),                                 // 86: taskguild.v1.SkillDiff
+	(*RequestSkillComparisonRequest)(nil),             // 87: taskguild.v1.RequestSkillComparisonRequest
+	(*RequestSkillComparisonResponse)(nil),            // 88: taskguild.v1.RequestSkillComparisonResponse
+	(*ReportSkillComparisonRequest)(nil),              // 89: taskguild.v1.ReportSkillComparisonRequest
+	(*ReportSkillComparisonResponse)(nil),             // 90: taskguild.v1.ReportSkillComparisonResponse
+	(*GetSkillComparisonRequest)(nil),                 // 91: taskguild.v1.GetSkillComparisonRequest
+	(*GetSkillComparisonResponse)(nil),                // 92: taskguild.v1.GetSkillComparisonResponse
+	(*ResolveSkillConflictRequest)(nil),               // 93: taskguild.v1.ResolveSkillConflictRequest
+	(*ResolveSkillConflictResponse)(nil),              // 94: taskguild.v1.ResolveSkillConflictResponse
+	(*ListSingleCommandPermissionsAgentRequest)(nil),  // 95: taskguild.v1.ListSingleCommandPermissionsAgentRequest
+	(*ListSingleCommandPermissionsAgentResponse)(nil), // 96: taskguild.v1.ListSingleCommandPermissionsAgentResponse
+	(*AddSingleCommandPermissionRequest)(nil),         // 97: taskguild.v1.AddSingleCommandPermissionRequest
+	(*AddSingleCommandPermissionResponse)(nil),        // 98: taskguild.v1.AddSingleCommandPermissionResponse
+	(*SyncClaudeSettingsCommand)(nil),                 // 99: taskguild.v1.SyncClaudeSettingsCommand
+	(*SyncClaudeSettingsAgentRequest)(nil),            // 100: taskguild.v1.SyncClaudeSettingsAgentRequest
+	(*SyncClaudeSettingsAgentResponse)(nil),           // 101: taskguild.v1.SyncClaudeSettingsAgentResponse
+	(*DrainCommand)(nil),                              // 102: taskguild.v1.DrainCommand
+	(*CompactTaskCommand)(nil),                        // 103: taskguild.v1.CompactTaskCommand
+	(*RollbackTaskCommand)(nil),                       // 104: taskguild.v1.RollbackTaskCommand
+	(*ReportTaskRollbackResultRequest)(nil),           // 105: taskguild.v1.ReportTaskRollbackResultRequest
+	(*ReportTaskRollbackResultResponse)(nil),          // 106: taskguild.v1.ReportTaskRollbackResultResponse
+	(*TaskDiffCommand)(nil),                           // 107: taskguild.v1.TaskDiffCommand
+	(*TaskDiff)(nil),                                  // 108: taskguild.v1.TaskDiff
+	(*TaskDiffFile)(nil),                              // 109: taskguild.v1.TaskDiffFile
+	(*TaskDiffCommit)(nil),                            // 110: taskguild.v1.TaskDiffCommit
+	(*GetTaskDiffRequest)(nil),                        // 111: taskguild.v1.GetTaskDiffRequest
+	(*GetTaskDiffResponse)(nil),                       // 112: taskguild.v1.GetTaskDiffResponse
+	(*ReportTaskDiffRequest)(nil),                     // 113: taskguild.v1.ReportTaskDiffRequest
+	(*ReportTaskDiffResponse)(nil),                    // 114: taskguild.v1.ReportTaskDiffResponse
+	(*MergeWorktreeCommand)(nil),                      // 115: taskguild.v1.MergeWorktreeCommand
+	(*ReportMergeResultRequest)(nil),                  // 116: taskguild.v1.ReportMergeResultRequest
+	(*ReportMergeResultResponse)(nil),                 // 117: taskguild.v1.ReportMergeResultResponse
+	(*ReportModifiedFilesRequest)(nil),                // 118: taskguild.v1.ReportModifiedFilesRequest
+	(*ReportModifiedFilesResponse)(nil),               // 119: taskguild.v1.ReportModifiedFilesResponse
+	(*FileOverlap)(nil),                               // 120: taskguild.v1.FileOverlap
+	(*DrainAgentManagerRequest)(nil),                  // 121: taskguild.v1.DrainAgentManagerRequest
+	(*DrainAgentManagerResponse)(nil),                 // 122: taskguild.v1.DrainAgentManagerResponse
+	(*ListAgentManagersRequest)(nil),                  // 123: taskguild.v1.ListAgentManagersRequest
+	(*ListAgentManagersResponse)(nil),                 // 124: taskguild.v1.ListAgentManagersResponse
+	(*AgentManagerInfo)(nil),                          // 125: taskguild.v1.AgentManagerInfo
+	(*UploadSessionTranscriptRequest)(nil),            // 126: taskguild.v1.UploadSessionTranscriptRequest
+	(*UploadSessionTranscriptResponse)(nil),           // 127: taskguild.v1.UploadSessionTranscriptResponse
+	(*DownloadSessionTranscriptRequest)(nil),          // 128: taskguild.v1.DownloadSessionTranscriptRequest
+	(*DownloadSessionTranscriptResponse)(nil),         // 129: taskguild.v1.DownloadSessionTranscriptResponse
+	(*GetTaskHandoffRequest)(nil),                     // 130: taskguild.v1.GetTaskHandoffRequest
+	(*GetTaskHandoffResponse)(nil),                    // 131: taskguild.v1.GetTaskHandoffResponse
+	nil,                                               // 132: taskguild.v1.TaskAvailableCommand.MetadataEntry
+	nil,                                               // 133: taskguild.v1.AssignTaskCommand.MetadataEntry
+	nil,                                               // 134: taskguild.v1.ClaimTaskResponse.MetadataEntry
+	nil,                                               // 135: taskguild.v1.ClaimTaskResponse.SecretsEntry
+	nil,                                               // 136: taskguild.v1.ReportTaskLogRequest.MetadataEntry
+	nil,                                               // 137: taskguild.v1.ExecuteScriptCommand.SecretsEntry
+	(*timestamppb.Timestamp)(nil),                     // 138: google.protobuf.Timestamp
+	(InteractionType)(0),                              // 139: taskguild.v1.InteractionType
+	(*InteractionOption)(nil),                         // 140: taskguild.v1.InteractionOption
+	(*Interaction)(nil),                               // 141: taskguild.v1.Interaction
+	(*AgentDefinition)(nil),                           // 142: taskguild.v1.AgentDefinition
+	(*PermissionSet)(nil),                             // 143: taskguild.v1.PermissionSet
+	(TaskLogLevel)(0),                                 // 144: taskguild.v1.TaskLogLevel
+	(TaskLogCategory)(0),                              // 145: taskguild.v1.TaskLogCategory
+	(*ScriptDefinition)(nil),                          // 146: taskguild.v1.ScriptDefinition
+	(*ScriptLogEntry)(nil),                            // 147: taskguild.v1.ScriptLogEntry
+	(*SkillDefinition)(nil),                           // 148: taskguild.v1.SkillDefinition
+	(*SingleCommandPermission)(nil),                   // 149: taskguild.v1.SingleCommandPermission
+	(*Attribution)(nil),                               // 150: taskguild.v1.Attribution
+	(*ClaudeSettings)(nil),                            // 151: taskguild.v1.ClaudeSettings
+	(*TaskLog)(nil),                                   // 152: taskguild.v1.TaskLog
 }
 var file_taskguild_v1_agent_manager_proto_depIdxs = []int32{
-	10,  // 0: taskguild.v1.AgentCommand.task_available:type_name -> taskguild.v1.TaskAvailableCommand
-	11,  // 1: taskguild.v1.AgentCommand.assign_task:type_name -> taskguild.v1.AssignTaskCommand
-	12,  // 2: taskguild.v1.AgentCommand.cancel_task:type_name -> taskguild.v1.CancelTaskCommand
-	13,  // 3: taskguild.v1.AgentCommand.interaction_response:type_name -> taskguild.v1.InteractionResponseCommand
-	14,  // 4: taskguild.v1.AgentCommand.sync_agents:type_name -> taskguild.v1.SyncAgentsCommand
-	15,  // 5: taskguild.v1.AgentCommand.sync_permissions:type_name -> taskguild.v1.SyncPermissionsCommand
-	16,  // 6: taskguild.v1.AgentCommand.list_worktrees:type_name -> taskguild.v1.ListWorktreesCommand
-	36,  // 7: taskguild.v1.AgentCommand.delete_worktree:type_name -> taskguild.v1.DeleteWorktreeCommand
-	47,  // 8: taskguild.v1.AgentCommand.git_pull_main:type_name -> taskguild.v1.GitPullMainCommand
-	52,  // 9: taskguild.v1.AgentCommand.sync_scripts:type_name -> taskguild.v1.SyncScriptsCommand
-	54,  // 10: taskguild.v1.AgentCommand.execute_script:type_name -> taskguild.v1.ExecuteScriptCommand
-	9,   // 11: taskguild.v1.AgentCommand.ping:type_name -> taskguild.v1.PingCommand
-	53,  // 12: taskguild.v1.AgentCommand.compare_scripts:type_name -> taskguild.v1.CompareScriptsCommand
-	61,  // 13: taskguild.v1.AgentCommand.stop_script:type_name -> taskguild.v1.StopScriptCommand
-	71,  // 14: taskguild.v1.AgentCommand.compare_agents:type_name -> taskguild.v1.CompareAgentsCommand
-	81,  // 15: taskguild.v1.AgentCommand.sync_skills:type_name -> taskguild.v1.SyncSkillsCommand
-	82,  // 16: taskguild.v1.AgentCommand.compare_skills:type_name -> taskguild.v1.CompareSkillsCommand
-	98,  // 17: taskguild.v1.AgentCommand.sync_claude_settings:type_name -> taskguild.v1.SyncClaudeSettingsCommand
-	101, // 18: taskguild.v1.TaskAvailableCommand.metadata:type_name -> taskguild.v1.TaskAvailableCommand.MetadataEntry
-	102, // 19: taskguild.v1.AssignTaskCommand.metadata:type_name -> taskguild.v1.AssignTaskCommand.MetadataEntry
-	103, // 20: taskguild.v1.ClaimTaskResponse.metadata:type_name -> taskguild.v1.ClaimTaskResponse.MetadataEntry
-	0,   // 21: taskguild.v1.ReportAgentStatusRequest.status:type_name -> taskguild.v1.AgentStatus
-	105, // 22: taskguild.v1.HeartbeatRequest.timestamp:type_name -> google.protobuf.Timestamp
-	106, // 23: taskguild.v1.CreateInteractionRequest.type:type_name -> taskguild.v1.InteractionType
-	107, // 24: taskguild.v1.CreateInteractionRequest.options:type_name -> taskguild.v1.InteractionOption
-	108, // 25: taskguild.v1.CreateInteractionResponse.interaction:type_name -> taskguild.v1.Interaction
-	108, // 26: taskguild.v1.GetInteractionResponseResponse.interaction:type_name -> taskguild.v1.Interaction
-	109, // 27: taskguild.v1.SyncAgentsResponse.agents:type_name -> taskguild.v1.AgentDefinition
-	110, // 28: taskguild.v1.SyncPermissionsResponse.permissions:type_name -> taskguild.v1.PermissionSet
-	111, // 29: taskguild.v1.ReportTaskLogRequest.level:type_name -> taskguild.v1.TaskLogLevel
-	112, // 30: taskguild.v1.ReportTaskLogRequest.category:type_name -> taskguild.v1.TaskLogCategory
-	104, // 31: taskguild.v1.ReportTaskLogRequest.metadata:type_name -> taskguild.v1.ReportTaskLogRequest.MetadataEntry
-	35,  // 32: taskguild.v1.ReportWorktreeListRequest.worktrees:type_name -> taskguild.v1.WorktreeInfo
-	35,  // 33: taskguild.v1.GetWorktreeListResponse.worktrees:type_name -> taskguild.v1.WorktreeInfo
-	113, // 34: taskguild.v1.CompareScriptsCommand.scripts:type_name -> taskguild.v1.ScriptDefinition
-	113, // 35: taskguild.v1.SyncScriptsResponse.scripts:type_name -> taskguild.v1.ScriptDefinition
-	114, // 36: taskguild.v1.ReportScriptExecutionResultRequest.log_entries:type_name -> taskguild.v1.ScriptLogEntry
-	114, // 37: taskguild.v1.ReportScriptOutputChunkRequest.entries:type_name -> taskguild.v1.ScriptLogEntry
-	1,   // 38: taskguild.v1.ScriptDiff.diff_type:type_name -> taskguild.v1.ScriptDiffType
-	62,  // 39: taskguild.v1.ReportScriptComparisonRequest.diffs:type_name -> taskguild.v1.ScriptDiff
-	62,  // 40: taskguild.v1.GetScriptComparisonResponse.diffs:type_name -> taskguild.v1.ScriptDiff
-	2,   // 41: taskguild.v1.ResolveScriptConflictRequest.choice:type_name -> taskguild.v1.ScriptResolutionChoice
-	113, // 42: taskguild.v1.ResolveScriptConflictResponse.script:type_name -> taskguild.v1.ScriptDefinition
-	109, // 43: taskguild.v1.CompareAgentsCommand.agents:type_name -> taskguild.v1.AgentDefinition
-	3,   // 44: taskguild.v1.AgentDiff.diff_type:type_name -> taskguild.v1.AgentDiffType
-	72,  // 45: taskguild.v1.ReportAgentComparisonRequest.diffs:type_name -> taskguild.v1.AgentDiff
-	72,  // 46: taskguild.v1.GetAgentComparisonResponse.diffs:type_name -> taskguild.v1.AgentDiff
-	4,   // 47: taskguild.v1.ResolveAgentConflictRequest.choice:type_name -> taskguild.v1.AgentResolutionChoice
-	109, // 48: taskguild.v1.ResolveAgentConflictResponse.agent:type_name -> taskguild.v1.AgentDefinition
-	115, // 49: taskguild.v1.CompareSkillsCommand.skills:type_name -> taskguild.v1.SkillDefinition
-	115, // 50: taskguild.v1.SyncSkillsResponse.skills:type_name -> taskguild.v1.SkillDefinition
-	5,   // 51: taskguild.v1.SkillDiff.diff_type:type_name -> taskguild.v1.SkillDiffType
-	85,  // 52: taskguild.v1.ReportSkillComparisonRequest.diffs:type_name -> taskguild.v1.SkillDiff
-	85,  // 53: taskguild.v1.GetSkillComparisonResponse.diffs:type_name -> taskguild.v1.SkillDiff
-	6,   // 54: taskguild.v1.ResolveSkillConflictRequest.choice:type_name -> taskguild.v1.SkillResolutionChoice
-	115, // 55: taskguild.v1.ResolveSkillConflictResponse.skill:type_name -> taskguild.v1.SkillDefinition
-	116, // 56: taskguild.v1.ListSingleCommandPermissionsAgentResponse.permissions:type_name -> taskguild.v1.SingleCommandPermission
-	116, // 57: taskguild.v1.AddSingleCommandPermissionResponse.permission:type_name -> taskguild.v1.SingleCommandPermission
-	117, // 58: taskguild.v1.SyncClaudeSettingsAgentRequest.local_attribution:type_name -> taskguild.v1.Attribution
-	118, // 59: taskguild.v1.SyncClaudeSettingsAgentResponse.settings:type_name -> taskguild.v1.ClaudeSettings
-	7,   // 60: taskguild.v1.AgentManagerService.Subscribe:input_type -> taskguild.v1.AgentManagerSubscribeRequest
-	17,  // 61: taskguild.v1.AgentManagerService.ClaimTask:input_type -> taskguild.v1.ClaimTaskRequest
-	19,  // 62: taskguild.v1.AgentManagerService.ReportTaskResult:input_type -> taskguild.v1.ReportTaskResultRequest
-	21,  // 63: taskguild.v1.AgentManagerService.ReportAgentStatus:input_type -> taskguild.v1.ReportAgentStatusRequest
-	23,  // 64: taskguild.v1.AgentManagerService.Heartbeat:input_type -> taskguild.v1.HeartbeatRequest
-	25,  // 65: taskguild.v1.AgentManagerService.CreateInteraction:input_type -> taskguild.v1.CreateInteractionRequest
-	27,  // 66: taskguild.v1.AgentManagerService.GetInteractionResponse:input_type -> taskguild.v1.GetInteractionResponseRequest
-	29,  // 67: taskguild.v1.AgentManagerService.SyncAgents:input_type -> taskguild.v1.SyncAgentsRequest
-	33,  // 68: taskguild.v1.AgentManagerService.ReportTaskLog:input_type -> taskguild.v1.ReportTaskLogRequest
-	31,  // 69: taskguild.v1.AgentManagerService.SyncPermissions:input_type -> taskguild.v1.SyncPermissionsRequest
-	37,  // 70: taskguild.v1.AgentManagerService.ReportWorktreeList:input_type -> taskguild.v1.ReportWorktreeListRequest
-	39,  // 71: taskguild.v1.AgentManagerService.RequestWorktreeList:input_type -> taskguild.v1.RequestWorktreeListRequest
-	41,  // 72: taskguild.v1.AgentManagerService.GetWorktreeList:input_type -> taskguild.v1.GetWorktreeListRequest
-	43,  // 73: taskguild.v1.AgentManagerService.RequestWorktreeDelete:input_type -> taskguild.v1.RequestWorktreeDeleteRequest
-	45,  // 74: taskguild.v1.AgentManagerService.ReportWorktreeDeleteResult:input_type -> taskguild.v1.ReportWorktreeDeleteResultRequest
-	48,  // 75: taskguild.v1.AgentManagerService.RequestGitPullMain:input_type -> taskguild.v1.RequestGitPullMainRequest
-	50,  // 76: taskguild.v1.AgentManagerService.ReportGitPullMainResult:input_type -> taskguild.v1.ReportGitPullMainResultRequest
-	55,  // 77: taskguild.v1.AgentManagerService.SyncScripts:input_type -> taskguild.v1.SyncScriptsRequest
-	57,  // 78: taskguild.v1.AgentManagerService.ReportScriptExecutionResult:input_type -> taskguild.v1.ReportScriptExecutionResultRequest
-	59,  // 79: taskguild.v1.AgentManagerService.ReportScriptOutputChunk:input_type -> taskguild.v1.ReportScriptOutputChunkRequest
-	63,  // 80: taskguild.v1.AgentManagerService.RequestScriptComparison:input_type -> taskguild.v1.RequestScriptComparisonRequest
-	65,  // 81: taskguild.v1.AgentManagerService.ReportScriptComparison:input_type -> taskguild.v1.ReportScriptComparisonRequest
-	67,  // 82: taskguild.v1.AgentManagerService.GetScriptComparison:input_type -> taskguild.v1.GetScriptComparisonRequest
-	69,  // 83: taskguild.v1.AgentManagerService.ResolveScriptConflict:input_type -> taskguild.v1.ResolveScriptConflictRequest
-	73,  // 84: taskguild.v1.AgentManagerService.RequestAgentComparison:input_type -> taskguild.v1.RequestAgentComparisonRequest
-	75,  // 85: taskguild.v1.AgentManagerService.ReportAgentComparison:input_type -> taskguild.v1.ReportAgentComparisonRequest
-	77,  // 86: taskguild.v1.AgentManagerService.GetAgentComparison:input_type -> taskguild.v1.GetAgentComparisonRequest
-	79,  // 87: taskguild.v1.AgentManagerService.ResolveAgentConflict:input_type -> taskguild.v1.ResolveAgentConflictRequest
-	94,  // 88: taskguild.v1.AgentManagerService.ListSingleCommandPermissions:input_type -> taskguild.v1.ListSingleCommandPermissionsAgentRequest
-	96,  // 89: taskguild.v1.AgentManagerService.AddSingleCommandPermission:input_type -> taskguild.v1.AddSingleCommandPermissionRequest
-	83,  // 90: taskguild.v1.AgentManagerService.SyncSkills:input_type -> taskguild.v1.SyncSkillsRequest
-	86,  // 91: taskguild.v1.AgentManagerService.RequestSkillComparison:input_type -> taskguild.v1.RequestSkillComparisonRequest
-	88,  // 92: taskguild.v1.AgentManagerService.ReportSkillComparison:input_type -> taskguild.v1.ReportSkillComparisonRequest
-	90,  // 93: taskguild.v1.AgentManagerService.GetSkillComparison:input_type -> taskguild.v1.GetSkillComparisonRequest
-	92,  // 94: taskguild.v1.AgentManagerService.ResolveSkillConflict:input_type -> taskguild.v1.ResolveSkillConflictRequest
-	99,  // 95: taskguild.v1.AgentManagerService.SyncClaudeSettings:input_type -> taskguild.v1.SyncClaudeSettingsAgentRequest
-	8,   // 96: taskguild.v1.AgentManagerService.Subscribe:output_type -> taskguild.v1.AgentCommand
-	18,  // 97: taskguild.v1.AgentManagerService.ClaimTask:output_type -> taskguild.v1.ClaimTaskResponse
-	20,  // 98: taskguild.v1.AgentManagerService.ReportTaskResult:output_type -> taskguild.v1.ReportTaskResultResponse
-	22,  // 99: taskguild.v1.AgentManagerService.ReportAgentStatus:output_type -> taskguild.v1.ReportAgentStatusResponse
-	24,  // 100: taskguild.v1.AgentManagerService.Heartbeat:output_type -> taskguild.v1.HeartbeatResponse
-	26,  // 101: taskguild.v1.AgentManagerService.CreateInteraction:output_type -> taskguild.v1.CreateInteractionResponse
-	28,  // 102: taskguild.v1.AgentManagerService.GetInteractionResponse:output_type -> taskguild.v1.GetInteractionResponseResponse
-	30,  // 103: taskguild.v1.AgentManagerService.SyncAgents:output_type -> taskguild.v1.SyncAgentsResponse
-	34,  // 104: taskguild.v1.AgentManagerService.ReportTaskLog:output_type -> taskguild.v1.ReportTaskLogResponse
-	32,  // 105: taskguild.v1.AgentManagerService.SyncPermissions:output_type -> taskguild.v1.SyncPermissionsResponse
-	38,  // 106: taskguild.v1.AgentManagerService.ReportWorktreeList:output_type -> taskguild.v1.ReportWorktreeListResponse
-	40,  // 107: taskguild.v1.AgentManagerService.RequestWorktreeList:output_type -> taskguild.v1.RequestWorktreeListResponse
-	42,  // 108: taskguild.v1.AgentManagerService.GetWorktreeList:output_type -> taskguild.v1.GetWorktreeListResponse
-	44,  // 109: taskguild.v1.AgentManagerService.RequestWorktreeDelete:output_type -> taskguild.v1.RequestWorktreeDeleteResponse
-	46,  // 110: taskguild.v1.AgentManagerService.ReportWorktreeDeleteResult:output_type -> taskguild.v1.ReportWorktreeDeleteResultResponse
-	49,  // 111: taskguild.v1.AgentManagerService.RequestGitPullMain:output_type -> taskguild.v1.RequestGitPullMainResponse
-	51,  // 112: taskguild.v1.AgentManagerService.ReportGitPullMainResult:output_type -> taskguild.v1.ReportGitPullMainResultResponse
-	56,  // 113: taskguild.v1.AgentManagerService.SyncScripts:output_type -> taskguild.v1.SyncScriptsResponse
-	58,  // 114: taskguild.v1.AgentManagerService.ReportScriptExecutionResult:output_type -> taskguild.v1.ReportScriptExecutionResultResponse
-	60,  // 115: taskguild.v1.AgentManagerService.ReportScriptOutputChunk:output_type -> taskguild.v1.ReportScriptOutputChunkResponse
-	64,  // 116: taskguild.v1.AgentManagerService.RequestScriptComparison:output_type -> taskguild.v1.RequestScriptComparisonResponse
-	66,  // 117: taskguild.v1.AgentManagerService.ReportScriptComparison:output_type -> taskguild.v1.ReportScriptComparisonResponse
-	68,  // 118: taskguild.v1.AgentManagerService.GetScriptComparison:output_type -> taskguild.v1.GetScriptComparisonResponse
-	70,  // 119: taskguild.v1.AgentManagerService.ResolveScriptConflict:output_type -> taskguild.v1.ResolveScriptConflictResponse
-	74,  // 120: taskguild.v1.AgentManagerService.RequestAgentComparison:output_type -> taskguild.v1.RequestAgentComparisonResponse
-	76,  // 121: taskguild.v1.AgentManagerService.ReportAgentComparison:output_type -> taskguild.v1.ReportAgentComparisonResponse
-	78,  // 122: taskguild.v1.AgentManagerService.GetAgentComparison:output_type -> taskguild.v1.GetAgentComparisonResponse
-	80,  // 123: taskguild.v1.AgentManagerService.ResolveAgentConflict:output_type -> taskguild.v1.ResolveAgentConflictResponse
-	95,  // 124: taskguild.v1.AgentManagerService.ListSingleCommandPermissions:output_type -> taskguild.v1.ListSingleCommandPermissionsAgentResponse
-	97,  // 125: taskguild.v1.AgentManagerService.AddSingleCommandPermission:output_type -> taskguild.v1.AddSingleCommandPermissionResponse
-	84,  // 126: taskguild.v1.AgentManagerService.SyncSkills:output_type -> taskguild.v1.SyncSkillsResponse
-	87,  // 127: taskguild.v1.AgentManagerService.RequestSkillComparison:output_type -> taskguild.v1.RequestSkillComparisonResponse
-	89,  // 128: taskguild.v1.AgentManagerService.ReportSkillComparison:output_type -> taskguild.v1.ReportSkillComparisonResponse
-	91,  // 129: taskguild.v1.AgentManagerService.GetSkillComparison:output_type -> taskguild.v1.GetSkillComparisonResponse
-	93,  // 130: taskguild.v1.AgentManagerService.ResolveSkillConflict:output_type -> taskguild.v1.ResolveSkillConflictResponse
-	100, // 131: taskguild.v1.AgentManagerService.SyncClaudeSettings:output_type -> taskguild.v1.SyncClaudeSettingsAgentResponse
-	96,  // [96:132] is the sub-list for method output_type
-	60,  // [60:96] is the sub-list for method input_type
-	60,  // [60:60] is the sub-list for extension type_name
-	60,  // [60:60] is the sub-list for extension extendee
-	0,   // [0:60] is the sub-list for field type_name
+	9,   // 0: taskguild.v1.AgentManagerSubscribeRequest.projects:type_name -> taskguild.v1.ServedProject
+	11,  // 1: taskguild.v1.AgentCommand.task_available:type_name -> taskguild.v1.TaskAvailableCommand
+	12,  // 2: taskguild.v1.AgentCommand.assign_task:type_name -> taskguild.v1.AssignTaskCommand
+	13,  // 3: taskguild.v1.AgentCommand.cancel_task:type_name -> taskguild.v1.CancelTaskCommand
+	14,  // 4: taskguild.v1.AgentCommand.interaction_response:type_name -> taskguild.v1.InteractionResponseCommand
+	15,  // 5: taskguild.v1.AgentCommand.sync_agents:type_name -> taskguild.v1.SyncAgentsCommand
+	16,  // 6: taskguild.v1.AgentCommand.sync_permissions:type_name -> taskguild.v1.SyncPermissionsCommand
+	17,  // 7: taskguild.v1.AgentCommand.list_worktrees:type_name -> taskguild.v1.ListWorktreesCommand
+	37,  // 8: taskguild.v1.AgentCommand.delete_worktree:type_name -> taskguild.v1.DeleteWorktreeCommand
+	48,  // 9: taskguild.v1.AgentCommand.git_pull_main:type_name -> taskguild.v1.GitPullMainCommand
+	53,  // 10: taskguild.v1.AgentCommand.sync_scripts:type_name -> taskguild.v1.SyncScriptsCommand
+	55,  // 11: taskguild.v1.AgentCommand.execute_script:type_name -> taskguild.v1.ExecuteScriptCommand
+	10,  // 12: taskguild.v1.AgentCommand.ping:type_name -> taskguild.v1.PingCommand
+	54,  // 13: taskguild.v1.AgentCommand.compare_scripts:type_name -> taskguild.v1.CompareScriptsCommand
+	62,  // 14: taskguild.v1.AgentCommand.stop_script:type_name -> taskguild.v1.StopScriptCommand
+	72,  // 15: taskguild.v1.AgentCommand.compare_agents:type_name -> taskguild.v1.CompareAgentsCommand
+	82,  // 16: taskguild.v1.AgentCommand.sync_skills:type_name -> taskguild.v1.SyncSkillsCommand
+	83,  // 17: taskguild.v1.AgentCommand.compare_skills:type_name -> taskguild.v1.CompareSkillsCommand
+	99,  // 18: taskguild.v1.AgentCommand.sync_claude_settings:type_name -> taskguild.v1.SyncClaudeSettingsCommand
+	102, // 19: taskguild.v1.AgentCommand.drain:type_name -> taskguild.v1.DrainCommand
+	103, // 20: taskguild.v1.AgentCommand.compact_task:type_name -> taskguild.v1.CompactTaskCommand
+	104, // 21: taskguild.v1.AgentCommand.rollback_task:type_name -> taskguild.v1.RollbackTaskCommand
+	107, // 22: taskguild.v1.AgentCommand.task_diff:type_name -> taskguild.v1.TaskDiffCommand
+	115, // 23: taskguild.v1.AgentCommand.merge_worktree:type_name -> taskguild.v1.MergeWorktreeCommand
+	132, // 24: taskguild.v1.TaskAvailableCommand.metadata:type_name -> taskguild.v1.TaskAvailableCommand.MetadataEntry
+	133, // 25: taskguild.v1.AssignTaskCommand.metadata:type_name -> taskguild.v1.AssignTaskCommand.MetadataEntry
+	134, // 26: taskguild.v1.ClaimTaskResponse.metadata:type_name -> taskguild.v1.ClaimTaskResponse.MetadataEntry
+	135, // 27: taskguild.v1.ClaimTaskResponse.secrets:type_name -> taskguild.v1.ClaimTaskResponse.SecretsEntry
+	0,   // 28: taskguild.v1.ReportAgentStatusRequest.status:type_name -> taskguild.v1.AgentStatus
+	138, // 29: taskguild.v1.HeartbeatRequest.timestamp:type_name -> google.protobuf.Timestamp
+	139, // 30: taskguild.v1.CreateInteractionRequest.type:type_name -> taskguild.v1.InteractionType
+	140, // 31: taskguild.v1.CreateInteractionRequest.options:type_name -> taskguild.v1.InteractionOption
+	141, // 32: taskguild.v1.CreateInteractionResponse.interaction:type_name -> taskguild.v1.Interaction
+	141, // 33: taskguild.v1.GetInteractionResponseResponse.interaction:type_name -> taskguild.v1.Interaction
+	142, // 34: taskguild.v1.SyncAgentsResponse.agents:type_name -> taskguild.v1.AgentDefinition
+	143, // 35: taskguild.v1.SyncPermissionsResponse.permissions:type_name -> taskguild.v1.PermissionSet
+	144, // 36: taskguild.v1.ReportTaskLogRequest.level:type_name -> taskguild.v1.TaskLogLevel
+	145, // 37: taskguild.v1.ReportTaskLogRequest.category:type_name -> taskguild.v1.TaskLogCategory
+	136, // 38: taskguild.v1.ReportTaskLogRequest.metadata:type_name -> taskguild.v1.ReportTaskLogRequest.MetadataEntry
+	138, // 39: taskguild.v1.ReportTaskLogRequest.created_at:type_name -> google.protobuf.Timestamp
+	138, // 40: taskguild.v1.WorktreeInfo.last_modified_at:type_name -> google.protobuf.Timestamp
+	36,  // 41: taskguild.v1.ReportWorktreeListRequest.worktrees:type_name -> taskguild.v1.WorktreeInfo
+	36,  // 42: taskguild.v1.GetWorktreeListResponse.worktrees:type_name -> taskguild.v1.WorktreeInfo
+	146, // 43: taskguild.v1.CompareScriptsCommand.scripts:type_name -> taskguild.v1.ScriptDefinition
+	137, // 44: taskguild.v1.ExecuteScriptCommand.secrets:type_name -> taskguild.v1.ExecuteScriptCommand.SecretsEntry
+	146, // 45: taskguild.v1.SyncScriptsResponse.scripts:type_name -> taskguild.v1.ScriptDefinition
+	147, // 46: taskguild.v1.ReportScriptExecutionResultRequest.log_entries:type_name -> taskguild.v1.ScriptLogEntry
+	147, // 47: taskguild.v1.ReportScriptOutputChunkRequest.entries:type_name -> taskguild.v1.ScriptLogEntry
+	1,   // 48: taskguild.v1.ScriptDiff.diff_type:type_name -> taskguild.v1.ScriptDiffType
+	63,  // 49: taskguild.v1.ReportScriptComparisonRequest.diffs:type_name -> taskguild.v1.ScriptDiff
+	63,  // 50: taskguild.v1.GetScriptComparisonResponse.diffs:type_name -> taskguild.v1.ScriptDiff
+	2,   // 51: taskguild.v1.ResolveScriptConflictRequest.choice:type_name -> taskguild.v1.ScriptResolutionChoice
+	146, // 52: taskguild.v1.ResolveScriptConflictResponse.script:type_name -> taskguild.v1.ScriptDefinition
+	142, // 53: taskguild.v1.CompareAgentsCommand.agents:type_name -> taskguild.v1.AgentDefinition
+	3,   // 54: taskguild.v1.AgentDiff.diff_type:type_name -> taskguild.v1.AgentDiffType
+	73,  // 55: taskguild.v1.ReportAgentComparisonRequest.diffs:type_name -> taskguild.v1.AgentDiff
+	73,  // 56: taskguild.v1.GetAgentComparisonResponse.diffs:type_name -> taskguild.v1.AgentDiff
+	4,   // 57: taskguild.v1.ResolveAgentConflictRequest.choice:type_name -> taskguild.v1.AgentResolutionChoice
+	142, // 58: taskguild.v1.ResolveAgentConflictResponse.agent:type_name -> taskguild.v1.AgentDefinition
+	148, // 59: taskguild.v1.CompareSkillsCommand.skills:type_name -> taskguild.v1.SkillDefinition
+	148, // 60: taskguild.v1.SyncSkillsResponse.skills:type_name -> taskguild.v1.SkillDefinition
+	5,   // 61: taskguild.v1.SkillDiff.diff_type:type_name -> taskguild.v1.SkillDiffType
+	86,  // 62: taskguild.v1.ReportSkillComparisonRequest.diffs:type_name -> taskguild.v1.SkillDiff
+	86,  // 63: taskguild.v1.GetSkillComparisonResponse.diffs:type_name -> taskguild.v1.SkillDiff
+	6,   // 64: taskguild.v1.ResolveSkillConflictRequest.choice:type_name -> taskguild.v1.SkillResolutionChoice
+	148, // 65: taskguild.v1.ResolveSkillConflictResponse.skill:type_name -> taskguild.v1.SkillDefinition
+	149, // 66: taskguild.v1.ListSingleCommandPermissionsAgentResponse.permissions:type_name -> taskguild.v1.SingleCommandPermission
+	149, // 67: taskguild.v1.AddSingleCommandPermissionResponse.permission:type_name -> taskguild.v1.SingleCommandPermission
+	150, // 68: taskguild.v1.SyncClaudeSettingsAgentRequest.local_attribution:type_name -> taskguild.v1.Attribution
+	151, // 69: taskguild.v1.SyncClaudeSettingsAgentResponse.settings:type_name -> taskguild.v1.ClaudeSettings
+	109, // 70: taskguild.v1.TaskDiff.files:type_name -> taskguild.v1.TaskDiffFile
+	110, // 71: taskguild.v1.TaskDiff.commits:type_name -> taskguild.v1.TaskDiffCommit
+	138, // 72: taskguild.v1.TaskDiff.captured_at:type_name -> google.protobuf.Timestamp
+	138, // 73: taskguild.v1.TaskDiffCommit.committed_at:type_name -> google.protobuf.Timestamp
+	108, // 74: taskguild.v1.GetTaskDiffResponse.diff:type_name -> taskguild.v1.TaskDiff
+	108, // 75: taskguild.v1.ReportTaskDiffRequest.diff:type_name -> taskguild.v1.TaskDiff
+	120, // 76: taskguild.v1.ReportModifiedFilesResponse.overlaps:type_name -> taskguild.v1.FileOverlap
+	125, // 77: taskguild.v1.DrainAgentManagerResponse.agent_manager:type_name -> taskguild.v1.AgentManagerInfo
+	125, // 78: taskguild.v1.ListAgentManagersResponse.agent_managers:type_name -> taskguild.v1.AgentManagerInfo
+	9,   // 79: taskguild.v1.AgentManagerInfo.projects:type_name -> taskguild.v1.ServedProject
+	138, // 80: taskguild.v1.AgentManagerInfo.last_heartbeat:type_name -> google.protobuf.Timestamp
+	152, // 81: taskguild.v1.GetTaskHandoffResponse.logs:type_name -> taskguild.v1.TaskLog
+	7,   // 82: taskguild.v1.AgentManagerService.Subscribe:input_type -> taskguild.v1.AgentManagerSubscribeRequest
+	18,  // 83: taskguild.v1.AgentManagerService.ClaimTask:input_type -> taskguild.v1.ClaimTaskRequest
+	20,  // 84: taskguild.v1.AgentManagerService.ReportTaskResult:input_type -> taskguild.v1.ReportTaskResultRequest
+	22,  // 85: taskguild.v1.AgentManagerService.ReportAgentStatus:input_type -> taskguild.v1.ReportAgentStatusRequest
+	24,  // 86: taskguild.v1.AgentManagerService.Heartbeat:input_type -> taskguild.v1.HeartbeatRequest
+	26,  // 87: taskguild.v1.AgentManagerService.CreateInteraction:input_type -> taskguild.v1.CreateInteractionRequest
+	28,  // 88: taskguild.v1.AgentManagerService.GetInteractionResponse:input_type -> taskguild.v1.GetInteractionResponseRequest
+	30,  // 89: taskguild.v1.AgentManagerService.SyncAgents:input_type -> taskguild.v1.SyncAgentsRequest
+	34,  // 90: taskguild.v1.AgentManagerService.ReportTaskLog:input_type -> taskguild.v1.ReportTaskLogRequest
+	32,  // 91: taskguild.v1.AgentManagerService.SyncPermissions:input_type -> taskguild.v1.SyncPermissionsRequest
+	38,  // 92: taskguild.v1.AgentManagerService.ReportWorktreeList:input_type -> taskguild.v1.ReportWorktreeListRequest
+	40,  // 93: taskguild.v1.AgentManagerService.RequestWorktreeList:input_type -> taskguild.v1.RequestWorktreeListRequest
+	42,  // 94: taskguild.v1.AgentManagerService.GetWorktreeList:input_type -> taskguild.v1.GetWorktreeListRequest
+	44,  // 95: taskguild.v1.AgentManagerService.RequestWorktreeDelete:input_type -> taskguild.v1.RequestWorktreeDeleteRequest
+	46,  // 96: taskguild.v1.AgentManagerService.ReportWorktreeDeleteResult:input_type -> taskguild.v1.ReportWorktreeDeleteResultRequest
+	49,  // 97: taskguild.v1.AgentManagerService.RequestGitPullMain:input_type -> taskguild.v1.RequestGitPullMainRequest
+	51,  // 98: taskguild.v1.AgentManagerService.ReportGitPullMainResult:input_type -> taskguild.v1.ReportGitPullMainResultRequest
+	56,  // 99: taskguild.v1.AgentManagerService.SyncScripts:input_type -> taskguild.v1.SyncScriptsRequest
+	58,  // 100: taskguild.v1.AgentManagerService.ReportScriptExecutionResult:input_type -> taskguild.v1.ReportScriptExecutionResultRequest
+	60,  // 101: taskguild.v1.AgentManagerService.ReportScriptOutputChunk:input_type -> taskguild.v1.ReportScriptOutputChunkRequest
+	64,  // 102: taskguild.v1.AgentManagerService.RequestScriptComparison:input_type -> taskguild.v1.RequestScriptComparisonRequest
+	66,  // 103: taskguild.v1.AgentManagerService.ReportScriptComparison:input_type -> taskguild.v1.ReportScriptComparisonRequest
+	68,  // 104: taskguild.v1.AgentManagerService.GetScriptComparison:input_type -> taskguild.v1.GetScriptComparisonRequest
+	70,  // 105: taskguild.v1.AgentManagerService.ResolveScriptConflict:input_type -> taskguild.v1.ResolveScriptConflictRequest
+	74,  // 106: taskguild.v1.AgentManagerService.RequestAgentComparison:input_type -> taskguild.v1.RequestAgentComparisonRequest
+	76,  // 107: taskguild.v1.AgentManagerService.ReportAgentComparison:input_type -> taskguild.v1.ReportAgentComparisonRequest
+	78,  // 108: taskguild.v1.AgentManagerService.GetAgentComparison:input_type -> taskguild.v1.GetAgentComparisonRequest
+	80,  // 109: taskguild.v1.AgentManagerService.ResolveAgentConflict:input_type -> taskguild.v1.ResolveAgentConflictRequest
+	95,  // 110: taskguild.v1.AgentManagerService.ListSingleCommandPermissions:input_type -> taskguild.v1.ListSingleCommandPermissionsAgentRequest
+	97,  // 111: taskguild.v1.AgentManagerService.AddSingleCommandPermission:input_type -> taskguild.v1.AddSingleCommandPermissionRequest
+	84,  // 112: taskguild.v1.AgentManagerService.SyncSkills:input_type -> taskguild.v1.SyncSkillsRequest
+	87,  // 113: taskguild.v1.AgentManagerService.RequestSkillComparison:input_type -> taskguild.v1.RequestSkillComparisonRequest
+	89,  // 114: taskguild.v1.AgentManagerService.ReportSkillComparison:input_type -> taskguild.v1.ReportSkillComparisonRequest
+	91,  // 115: taskguild.v1.AgentManagerService.GetSkillComparison:input_type -> taskguild.v1.GetSkillComparisonRequest
+	93,  // 116: taskguild.v1.AgentManagerService.ResolveSkillConflict:input_type -> taskguild.v1.ResolveSkillConflictRequest
+	100, // 117: taskguild.v1.AgentManagerService.SyncClaudeSettings:input_type -> taskguild.v1.SyncClaudeSettingsAgentRequest
+	121, // 118: taskguild.v1.AgentManagerService.DrainAgentManager:input_type -> taskguild.v1.DrainAgentManagerRequest
+	123, // 119: taskguild.v1.AgentManagerService.ListAgentManagers:input_type -> taskguild.v1.ListAgentManagersRequest
+	126, // 120: taskguild.v1.AgentManagerService.UploadSessionTranscript:input_type -> taskguild.v1.UploadSessionTranscriptRequest
+	128, // 121: taskguild.v1.AgentManagerService.DownloadSessionTranscript:input_type -> taskguild.v1.DownloadSessionTranscriptRequest
+	130, // 122: taskguild.v1.AgentManagerService.GetTaskHandoff:input_type -> taskguild.v1.GetTaskHandoffRequest
+	105, // 123: taskguild.v1.AgentManagerService.ReportTaskRollbackResult:input_type -> taskguild.v1.ReportTaskRollbackResultRequest
+	111, // 124: taskguild.v1.AgentManagerService.GetTaskDiff:input_type -> taskguild.v1.GetTaskDiffRequest
+	113, // 125: taskguild.v1.AgentManagerService.ReportTaskDiff:input_type -> taskguild.v1.ReportTaskDiffRequest
+	116, // 126: taskguild.v1.AgentManagerService.ReportMergeResult:input_type -> taskguild.v1.ReportMergeResultRequest
+	118, // 127: taskguild.v1.AgentManagerService.ReportModifiedFiles:input_type -> taskguild.v1.ReportModifiedFilesRequest
+	8,   // 128: taskguild.v1.AgentManagerService.Subscribe:output_type -> taskguild.v1.AgentCommand
+	19,  // 129: taskguild.v1.AgentManagerService.ClaimTask:output_type -> taskguild.v1.ClaimTaskResponse
+	21,  // 130: taskguild.v1.AgentManagerService.ReportTaskResult:output_type -> taskguild.v1.ReportTaskResultResponse
+	23,  // 131: taskguild.v1.AgentManagerService.ReportAgentStatus:output_type -> taskguild.v1.ReportAgentStatusResponse
+	25,  // 132: taskguild.v1.AgentManagerService.Heartbeat:output_type -> taskguild.v1.HeartbeatResponse
+	27,  // 133: taskguild.v1.AgentManagerService.CreateInteraction:output_type -> taskguild.v1.CreateInteractionResponse
+	29,  // 134: taskguild.v1.AgentManagerService.GetInteractionResponse:output_type -> taskguild.v1.GetInteractionResponseResponse
+	31,  // 135: taskguild.v1.AgentManagerService.SyncAgents:output_type -> taskguild.v1.SyncAgentsResponse
+	35,  // 136: taskguild.v1.AgentManagerService.ReportTaskLog:output_type -> taskguild.v1.ReportTaskLogResponse
+	33,  // 137: taskguild.v1.AgentManagerService.SyncPermissions:output_type -> taskguild.v1.SyncPermissionsResponse
+	39,  // 138: taskguild.v1.AgentManagerService.ReportWorktreeList:output_type -> taskguild.v1.ReportWorktreeListResponse
+	41,  // 139: taskguild.v1.AgentManagerService.RequestWorktreeList:output_type -> taskguild.v1.RequestWorktreeListResponse
+	43,  // 140: taskguild.v1.AgentManagerService.GetWorktreeList:output_type -> taskguild.v1.GetWorktreeListResponse
+	45,  // 141: taskguild.v1.AgentManagerService.RequestWorktreeDelete:output_type -> taskguild.v1.RequestWorktreeDeleteResponse
+	47,  // 142: taskguild.v1.AgentManagerService.ReportWorktreeDeleteResult:output_type -> taskguild.v1.ReportWorktreeDeleteResultResponse
+	50,  // 143: taskguild.v1.AgentManagerService.RequestGitPullMain:output_type -> taskguild.v1.RequestGitPullMainResponse
+	52,  // 144: taskguild.v1.AgentManagerService.ReportGitPullMainResult:output_type -> taskguild.v1.ReportGitPullMainResultResponse
+	57,  // 145: taskguild.v1.AgentManagerService.SyncScripts:output_type -> taskguild.v1.SyncScriptsResponse
+	59,  // 146: taskguild.v1.AgentManagerService.ReportScriptExecutionResult:output_type -> taskguild.v1.ReportScriptExecutionResultResponse
+	61,  // 147: taskguild.v1.AgentManagerService.ReportScriptOutputChunk:output_type -> taskguild.v1.ReportScriptOutputChunkResponse
+	65,  // 148: taskguild.v1.AgentManagerService.RequestScriptComparison:output_type -> taskguild.v1.RequestScriptComparisonResponse
+	67,  // 149: taskguild.v1.AgentManagerService.ReportScriptComparison:output_type -> taskguild.v1.ReportScriptComparisonResponse
+	69,  // 150: taskguild.v1.AgentManagerService.GetScriptComparison:output_type -> taskguild.v1.GetScriptComparisonResponse
+	71,  // 151: taskguild.v1.AgentManagerService.ResolveScriptConflict:output_type -> taskguild.v1.ResolveScriptConflictResponse
+	75,  // 152: taskguild.v1.AgentManagerService.RequestAgentComparison:output_type -> taskguild.v1.RequestAgentComparisonResponse
+	77,  // 153: taskguild.v1.AgentManagerService.ReportAgentComparison:output_type -> taskguild.v1.ReportAgentComparisonResponse
+	79,  // 154: taskguild.v1.AgentManagerService.GetAgentComparison:output_type -> taskguild.v1.GetAgentComparisonResponse
+	81,  // 155: taskguild.v1.AgentManagerService.ResolveAgentConflict:output_type -> taskguild.v1.ResolveAgentConflictResponse
+	96,  // 156: taskguild.v1.AgentManagerService.ListSingleCommandPermissions:output_type -> taskguild.v1.ListSingleCommandPermissionsAgentResponse
+	98,  // 157: taskguild.v1.AgentManagerService.AddSingleCommandPermission:output_type -> taskguild.v1.AddSingleCommandPermissionResponse
+	85,  // 158: taskguild.v1.AgentManagerService.SyncSkills:output_type -> taskguild.v1.SyncSkillsResponse
+	88,  // 159: taskguild.v1.AgentManagerService.RequestSkillComparison:output_type -> taskguild.v1.RequestSkillComparisonResponse
+	90,  // 160: taskguild.v1.AgentManagerService.ReportSkillComparison:output_type -> taskguild.v1.ReportSkillComparisonResponse
+	92,  // 161: taskguild.v1.AgentManagerService.GetSkillComparison:output_type -> taskguild.v1.GetSkillComparisonResponse
+	94,  // 162: taskguild.v1.AgentManagerService.ResolveSkillConflict:output_type -> taskguild.v1.ResolveSkillConflictResponse
+	101, // 163: taskguild.v1.AgentManagerService.SyncClaudeSettings:output_type -> taskguild.v1.SyncClaudeSettingsAgentResponse
+	122, // 164: taskguild.v1.AgentManagerService.DrainAgentManager:output_type -> taskguild.v1.DrainAgentManagerResponse
+	124, // 165: taskguild.v1.AgentManagerService.ListAgentManagers:output_type -> taskguild.v1.ListAgentManagersResponse
+	127, // 166: taskguild.v1.AgentManagerService.UploadSessionTranscript:output_type -> taskguild.v1.UploadSessionTranscriptResponse
+	129, // 167: taskguild.v1.AgentManagerService.DownloadSessionTranscript:output_type -> taskguild.v1.DownloadSessionTranscriptResponse
+	131, // 168: taskguild.v1.AgentManagerService.GetTaskHandoff:output_type -> taskguild.v1.GetTaskHandoffResponse
+	106, // 169: taskguild.v1.AgentManagerService.ReportTaskRollbackResult:output_type -> taskguild.v1.ReportTaskRollbackResultResponse
+	112, // 170: taskguild.v1.AgentManagerService.GetTaskDiff:output_type -> taskguild.v1.GetTaskDiffResponse
+	114, // 171: taskguild.v1.AgentManagerService.ReportTaskDiff:output_type -> taskguild.v1.ReportTaskDiffResponse
+	117, // 172: taskguild.v1.AgentManagerService.ReportMergeResult:output_type -> taskguild.v1.ReportMergeResultResponse
+	119, // 173: taskguild.v1.AgentManagerService.ReportModifiedFiles:output_type -> taskguild.v1.ReportModifiedFilesResponse
+	128, // [128:174] is the sub-list for method output_type
+	82,  // [82:128] is the sub-list for method input_type
+	82,  // [82:82] is the sub-list for extension type_name
+	82,  // [82:82] is the sub-list for extension extendee
+	0,   // [0:82] is the sub-list for field type_name
 }
 
 func init() { file_taskguild_v1_agent_manager_proto_init() }
@@ -6394,15 +8983,20 @@ func file_taskguild_v1_agent_manager_proto_init() {
 		(*AgentCommand_SyncSkills)(nil),
 		(*AgentCommand_CompareSkills)(nil),
 		(*AgentCommand_SyncClaudeSettings)(nil),
+		(*AgentCommand_Drain)(nil),
+		(*AgentCommand_CompactTask)(nil),
+		(*AgentCommand_RollbackTask)(nil),
+		(*AgentCommand_TaskDiff)(nil),
+		(*AgentCommand_MergeWorktree)(nil),
 	}
-	file_taskguild_v1_agent_manager_proto_msgTypes[92].OneofWrappers = []any{}
+	file_taskguild_v1_agent_manager_proto_msgTypes[93].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_taskguild_v1_agent_manager_proto_rawDesc), len(file_taskguild_v1_agent_manager_proto_rawDesc)),
 			NumEnums:      7,
-			NumMessages:   98,
+			NumMessages:   131,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,596 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: taskguild/v1/api_token.proto
+
+package taskguildv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ApiTokenScope int32
+
+const (
+	ApiTokenScope_API_TOKEN_SCOPE_UNSPECIFIED ApiTokenScope = 0
+	// Get*/List*/Subscribe* RPCs (except the agent-manager command stream).
+	ApiTokenScope_API_TOKEN_SCOPE_READ_ONLY ApiTokenScope = 1
+	// read-only plus answering interactions and sending messages.
+	ApiTokenScope_API_TOKEN_SCOPE_RESPOND_TO_INTERACTIONS ApiTokenScope = 2
+	// read-only plus AgentManagerService and the task/interaction updates an
+	// agent manager performs.
+	ApiTokenScope_API_TOKEN_SCOPE_AGENT_MANAGER ApiTokenScope = 3
+	// Every RPC, including token management.
+	ApiTokenScope_API_TOKEN_SCOPE_ADMIN ApiTokenScope = 4
+)
+
+// Enum value maps for ApiTokenScope.
+var (
+	ApiTokenScope_name = map[int32]string{
+		0: "API_TOKEN_SCOPE_UNSPECIFIED",
+		1: "API_TOKEN_SCOPE_READ_ONLY",
+		2: "API_TOKEN_SCOPE_RESPOND_TO_INTERACTIONS",
+		3: "API_TOKEN_SCOPE_AGENT_MANAGER",
+		4: "API_TOKEN_SCOPE_ADMIN",
+	}
+	ApiTokenScope_value = map[string]int32{
+		"API_TOKEN_SCOPE_UNSPECIFIED":             0,
+		"API_TOKEN_SCOPE_READ_ONLY":               1,
+		"API_TOKEN_SCOPE_RESPOND_TO_INTERACTIONS": 2,
+		"API_TOKEN_SCOPE_AGENT_MANAGER":           3,
+		"API_TOKEN_SCOPE_ADMIN":                   4,
+	}
+)
+
+func (x ApiTokenScope) Enum() *ApiTokenScope {
+	p := new(ApiTokenScope)
+	*p = x
+	return p
+}
+
+func (x ApiTokenScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApiTokenScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_taskguild_v1_api_token_proto_enumTypes[0].Descriptor()
+}
+
+func (ApiTokenScope) Type() protoreflect.EnumType {
+	return &file_taskguild_v1_api_token_proto_enumTypes[0]
+}
+
+func (x ApiTokenScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApiTokenScope.Descriptor instead.
+func (ApiTokenScope) EnumDescriptor() ([]byte, []int) {
+	return file_taskguild_v1_api_token_proto_rawDescGZIP(), []int{0}
+}
+
+type ApiToken struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes []ApiTokenScope        `protobuf:"varint,3,rep,packed,name=scopes,proto3,enum=taskguild.v1.ApiTokenScope" json:"scopes,omitempty"`
+	// project_ids restricts the token to these projects. Empty means all projects.
+	ProjectIds    []string               `protobuf:"bytes,4,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiToken) Reset() {
+	*x = ApiToken{}
+	mi := &file_taskguild_v1_api_token_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiToken) ProtoMessage() {}
+
+func (x *ApiToken) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_api_token_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiToken.ProtoReflect.Descriptor instead.
+func (*ApiToken) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_api_token_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApiToken) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApiToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiToken) GetScopes() []ApiTokenScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiToken) GetProjectIds() []string {
+	if x != nil {
+		return x.ProjectIds
+	}
+	return nil
+}
+
+func (x *ApiToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ApiToken) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *ApiToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateApiTokenRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes     []ApiTokenScope        `protobuf:"varint,2,rep,packed,name=scopes,proto3,enum=taskguild.v1.ApiTokenScope" json:"scopes,omitempty"`
+	ProjectIds []string               `protobuf:"bytes,3,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
+	// expires_at is optional; unset means the token never expires.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiTokenRequest) Reset() {
+	*x = CreateApiTokenRequest{}
+	mi := &file_taskguild_v1_api_token_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiTokenRequest) ProtoMessage() {}
+
+func (x *CreateApiTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_api_token_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiTokenRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_api_token_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateApiTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiTokenRequest) GetScopes() []ApiTokenScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateApiTokenRequest) GetProjectIds() []string {
+	if x != nil {
+		return x.ProjectIds
+	}
+	return nil
+}
+
+func (x *CreateApiTokenRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateApiTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token *ApiToken              `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// secret is the bearer value for X-Api-Key / Authorization. It is not stored
+	// and cannot be retrieved again.
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiTokenResponse) Reset() {
+	*x = CreateApiTokenResponse{}
+	mi := &file_taskguild_v1_api_token_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiTokenResponse) ProtoMessage() {}
+
+func (x *CreateApiTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_api_token_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiTokenResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_api_token_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateApiTokenResponse) GetToken() *ApiToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *CreateApiTokenResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ListApiTokensRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// include_revoked also returns revoked and expired tokens.
+	IncludeRevoked bool `protobuf:"varint,1,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListApiTokensRequest) Reset() {
+	*x = ListApiTokensRequest{}
+	mi := &file_taskguild_v1_api_token_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiTokensRequest) ProtoMessage() {}
+
+func (x *ListApiTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_api_token_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListApiTokensRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_api_token_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListApiTokensRequest) GetIncludeRevoked() bool {
+	if x != nil {
+		return x.IncludeRevoked
+	}
+	return false
+}
+
+type ListApiTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*ApiToken            `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiTokensResponse) Reset() {
+	*x = ListApiTokensResponse{}
+	mi := &file_taskguild_v1_api_token_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiTokensResponse) ProtoMessage() {}
+
+func (x *ListApiTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_api_token_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListApiTokensResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_api_token_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListApiTokensResponse) GetTokens() []*ApiToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type RevokeApiTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiTokenRequest) Reset() {
+	*x = RevokeApiTokenRequest{}
+	mi := &file_taskguild_v1_api_token_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiTokenRequest) ProtoMessage() {}
+
+func (x *RevokeApiTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_api_token_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiTokenRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_api_token_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RevokeApiTokenRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeApiTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *ApiToken              `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiTokenResponse) Reset() {
+	*x = RevokeApiTokenResponse{}
+	mi := &file_taskguild_v1_api_token_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiTokenResponse) ProtoMessage() {}
+
+func (x *RevokeApiTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_api_token_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiTokenResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_api_token_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RevokeApiTokenResponse) GetToken() *ApiToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+var File_taskguild_v1_api_token_proto protoreflect.FileDescriptor
+
+const file_taskguild_v1_api_token_proto_rawDesc = "" +
+	"\n" +
+	"\x1ctaskguild/v1/api_token.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb5\x02\n" +
+	"\bApiToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x123\n" +
+	"\x06scopes\x18\x03 \x03(\x0e2\x1b.taskguild.v1.ApiTokenScopeR\x06scopes\x12\x1f\n" +
+	"\vproject_ids\x18\x04 \x03(\tR\n" +
+	"projectIds\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xbc\x01\n" +
+	"\x15CreateApiTokenRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x123\n" +
+	"\x06scopes\x18\x02 \x03(\x0e2\x1b.taskguild.v1.ApiTokenScopeR\x06scopes\x12\x1f\n" +
+	"\vproject_ids\x18\x03 \x03(\tR\n" +
+	"projectIds\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"^\n" +
+	"\x16CreateApiTokenResponse\x12,\n" +
+	"\x05token\x18\x01 \x01(\v2\x16.taskguild.v1.ApiTokenR\x05token\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"?\n" +
+	"\x14ListApiTokensRequest\x12'\n" +
+	"\x0finclude_revoked\x18\x01 \x01(\bR\x0eincludeRevoked\"G\n" +
+	"\x15ListApiTokensResponse\x12.\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x16.taskguild.v1.ApiTokenR\x06tokens\"'\n" +
+	"\x15RevokeApiTokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"F\n" +
+	"\x16RevokeApiTokenResponse\x12,\n" +
+	"\x05token\x18\x01 \x01(\v2\x16.taskguild.v1.ApiTokenR\x05token*\xba\x01\n" +
+	"\rApiTokenScope\x12\x1f\n" +
+	"\x1bAPI_TOKEN_SCOPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19API_TOKEN_SCOPE_READ_ONLY\x10\x01\x12+\n" +
+	"'API_TOKEN_SCOPE_RESPOND_TO_INTERACTIONS\x10\x02\x12!\n" +
+	"\x1dAPI_TOKEN_SCOPE_AGENT_MANAGER\x10\x03\x12\x19\n" +
+	"\x15API_TOKEN_SCOPE_ADMIN\x10\x042\xa5\x02\n" +
+	"\x0fApiTokenService\x12[\n" +
+	"\x0eCreateApiToken\x12#.taskguild.v1.CreateApiTokenRequest\x1a$.taskguild.v1.CreateApiTokenResponse\x12X\n" +
+	"\rListApiTokens\x12\".taskguild.v1.ListApiTokensRequest\x1a#.taskguild.v1.ListApiTokensResponse\x12[\n" +
+	"\x0eRevokeApiToken\x12#.taskguild.v1.RevokeApiTokenRequest\x1a$.taskguild.v1.RevokeApiTokenResponseB\xb6\x01\n" +
+	"\x10com.taskguild.v1B\rApiTokenProtoP\x01ZBgithub.com/kazz187/taskguild/proto/gen/go/taskguild/v1;taskguildv1\xa2\x02\x03TXX\xaa\x02\fTaskguild.V1\xca\x02\fTaskguild\\V1\xe2\x02\x18Taskguild\\V1\\GPBMetadata\xea\x02\rTaskguild::V1b\x06proto3"
+
+var (
+	file_taskguild_v1_api_token_proto_rawDescOnce sync.Once
+	file_taskguild_v1_api_token_proto_rawDescData []byte
+)
+
+func file_taskguild_v1_api_token_proto_rawDescGZIP() []byte {
+	file_taskguild_v1_api_token_proto_rawDescOnce.Do(func() {
+		file_taskguild_v1_api_token_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_taskguild_v1_api_token_proto_rawDesc), len(file_taskguild_v1_api_token_proto_rawDesc)))
+	})
+	return file_taskguild_v1_api_token_proto_rawDescData
+}
+
+var file_taskguild_v1_api_token_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_taskguild_v1_api_token_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_taskguild_v1_api_token_proto_goTypes = []any{
+	(ApiTokenScope)(0),             // 0: taskguild.v1.ApiTokenScope
+	(*ApiToken)(nil),               // 1: taskguild.v1.ApiToken
+	(*CreateApiTokenRequest)(nil),  // 2: taskguild.v1.CreateApiTokenRequest
+	(*CreateApiTokenResponse)(nil), // 3: taskguild.v1.CreateApiTokenResponse
+	(*ListApiTokensRequest)(nil),   // 4: taskguild.v1.ListApiTokensRequest
+	(*ListApiTokensResponse)(nil),  // 5: taskguild.v1.ListApiTokensResponse
+	(*RevokeApiTokenRequest)(nil),  // 6: taskguild.v1.RevokeApiTokenRequest
+	(*RevokeApiTokenResponse)(nil), // 7: taskguild.v1.RevokeApiTokenResponse
+	(*timestamppb.Timestamp)(nil),  // 8: google.protobuf.Timestamp
+}
+var file_taskguild_v1_api_token_proto_depIdxs = []int32{
+	0,  // 0: taskguild.v1.ApiToken.scopes:type_name -> taskguild.v1.ApiTokenScope
+	8,  // 1: taskguild.v1.ApiToken.expires_at:type_name -> google.protobuf.Timestamp
+	8,  // 2: taskguild.v1.ApiToken.revoked_at:type_name -> google.protobuf.Timestamp
+	8,  // 3: taskguild.v1.ApiToken.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: taskguild.v1.CreateApiTokenRequest.scopes:type_name -> taskguild.v1.ApiTokenScope
+	8,  // 5: taskguild.v1.CreateApiTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: taskguild.v1.CreateApiTokenResponse.token:type_name -> taskguild.v1.ApiToken
+	1,  // 7: taskguild.v1.ListApiTokensResponse.tokens:type_name -> taskguild.v1.ApiToken
+	1,  // 8: taskguild.v1.RevokeApiTokenResponse.token:type_name -> taskguild.v1.ApiToken
+	2,  // 9: taskguild.v1.ApiTokenService.CreateApiToken:input_type -> taskguild.v1.CreateApiTokenRequest
+	4,  // 10: taskguild.v1.ApiTokenService.ListApiTokens:input_type -> taskguild.v1.ListApiTokensRequest
+	6,  // 11: taskguild.v1.ApiTokenService.RevokeApiToken:input_type -> taskguild.v1.RevokeApiTokenRequest
+	3,  // 12: taskguild.v1.ApiTokenService.CreateApiToken:output_type -> taskguild.v1.CreateApiTokenResponse
+	5,  // 13: taskguild.v1.ApiTokenService.ListApiTokens:output_type -> taskguild.v1.ListApiTokensResponse
+	7,  // 14: taskguild.v1.ApiTokenService.RevokeApiToken:output_type -> taskguild.v1.RevokeApiTokenResponse
+	12, // [12:15] is the sub-list for method output_type
+	9,  // [9:12] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_taskguild_v1_api_token_proto_init() }
+func file_taskguild_v1_api_token_proto_init() {
+	if File_taskguild_v1_api_token_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_taskguild_v1_api_token_proto_rawDesc), len(file_taskguild_v1_api_token_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_taskguild_v1_api_token_proto_goTypes,
+		DependencyIndexes: file_taskguild_v1_api_token_proto_depIdxs,
+		EnumInfos:         file_taskguild_v1_api_token_proto_enumTypes,
+		MessageInfos:      file_taskguild_v1_api_token_proto_msgTypes,
+	}.Build()
+	File_taskguild_v1_api_token_proto = out.File
+	file_taskguild_v1_api_token_proto_goTypes = nil
+	file_taskguild_v1_api_token_proto_depIdxs = nil
+}
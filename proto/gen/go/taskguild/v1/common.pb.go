@@ -135,6 +135,64 @@ func (x *PaginationResponse) GetOffset() int32 {
 	return 0
 }
 
+// PathPolicy restricts where edit tools (Write, Edit, NotebookEdit) and shell
+// redirects may write. Entries are directory roots or globs; relative entries
+// resolve against the task's working directory and "~/" against the home
+// directory. An entry matches a path at or below it.
+type PathPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When non-empty, writes outside every allowed root are never auto-allowed.
+	Allow []string `protobuf:"bytes,1,rep,name=allow,proto3" json:"allow,omitempty"`
+	// Writes matching a denied root are rejected.
+	Deny          []string `protobuf:"bytes,2,rep,name=deny,proto3" json:"deny,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PathPolicy) Reset() {
+	*x = PathPolicy{}
+	mi := &file_taskguild_v1_common_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PathPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathPolicy) ProtoMessage() {}
+
+func (x *PathPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_common_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathPolicy.ProtoReflect.Descriptor instead.
+func (*PathPolicy) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PathPolicy) GetAllow() []string {
+	if x != nil {
+		return x.Allow
+	}
+	return nil
+}
+
+func (x *PathPolicy) GetDeny() []string {
+	if x != nil {
+		return x.Deny
+	}
+	return nil
+}
+
 var File_taskguild_v1_common_proto protoreflect.FileDescriptor
 
 const file_taskguild_v1_common_proto_rawDesc = "" +
@@ -146,7 +204,11 @@ const file_taskguild_v1_common_proto_rawDesc = "" +
 	"\x12PaginationResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offsetB\xb4\x01\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"6\n" +
+	"\n" +
+	"PathPolicy\x12\x14\n" +
+	"\x05allow\x18\x01 \x03(\tR\x05allow\x12\x12\n" +
+	"\x04deny\x18\x02 \x03(\tR\x04denyB\xb4\x01\n" +
 	"\x10com.taskguild.v1B\vCommonProtoP\x01ZBgithub.com/kazz187/taskguild/proto/gen/go/taskguild/v1;taskguildv1\xa2\x02\x03TXX\xaa\x02\fTaskguild.V1\xca\x02\fTaskguild\\V1\xe2\x02\x18Taskguild\\V1\\GPBMetadata\xea\x02\rTaskguild::V1b\x06proto3"
 
 var (
@@ -161,10 +223,11 @@ func file_taskguild_v1_common_proto_rawDescGZIP() []byte {
 	return file_taskguild_v1_common_proto_rawDescData
 }
 
-var file_taskguild_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_taskguild_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_taskguild_v1_common_proto_goTypes = []any{
 	(*PaginationRequest)(nil),  // 0: taskguild.v1.PaginationRequest
 	(*PaginationResponse)(nil), // 1: taskguild.v1.PaginationResponse
+	(*PathPolicy)(nil),         // 2: taskguild.v1.PathPolicy
 }
 var file_taskguild_v1_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -185,7 +248,7 @@ func file_taskguild_v1_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_taskguild_v1_common_proto_rawDesc), len(file_taskguild_v1_common_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
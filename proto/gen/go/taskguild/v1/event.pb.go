@@ -44,6 +44,8 @@ const (
 	EventType_EVENT_TYPE_SCRIPT_COMPARISON       EventType = 16
 	EventType_EVENT_TYPE_AGENT_COMPARISON        EventType = 17
 	EventType_EVENT_TYPE_SKILL_COMPARISON        EventType = 18
+	EventType_EVENT_TYPE_AGENT_MANAGER_CHANGED   EventType = 19
+	EventType_EVENT_TYPE_TASK_ROLLBACK_RESULT    EventType = 20
 )
 
 // Enum value maps for EventType.
@@ -68,6 +70,8 @@ var (
 		16: "EVENT_TYPE_SCRIPT_COMPARISON",
 		17: "EVENT_TYPE_AGENT_COMPARISON",
 		18: "EVENT_TYPE_SKILL_COMPARISON",
+		19: "EVENT_TYPE_AGENT_MANAGER_CHANGED",
+		20: "EVENT_TYPE_TASK_ROLLBACK_RESULT",
 	}
 	EventType_value = map[string]int32{
 		"EVENT_TYPE_UNSPECIFIED":             0,
@@ -89,6 +93,8 @@ var (
 		"EVENT_TYPE_SCRIPT_COMPARISON":       16,
 		"EVENT_TYPE_AGENT_COMPARISON":        17,
 		"EVENT_TYPE_SKILL_COMPARISON":        18,
+		"EVENT_TYPE_AGENT_MANAGER_CHANGED":   19,
+		"EVENT_TYPE_TASK_ROLLBACK_RESULT":    20,
 	}
 )
 
@@ -276,7 +282,7 @@ const file_taskguild_v1_event_proto_rawDesc = "" +
 	"\vevent_types\x18\x01 \x03(\x0e2\x17.taskguild.v1.EventTypeR\n" +
 	"eventTypes\x12\x1d\n" +
 	"\n" +
-	"project_id\x18\x02 \x01(\tR\tprojectId*\xf7\x04\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId*\xc2\x05\n" +
 	"\tEventType\x12\x1a\n" +
 	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17EVENT_TYPE_TASK_CREATED\x10\x01\x12\x1b\n" +
@@ -297,7 +303,9 @@ const file_taskguild_v1_event_proto_rawDesc = "" +
 	"\x1aEVENT_TYPE_TASK_UNARCHIVED\x10\x0f\x12 \n" +
 	"\x1cEVENT_TYPE_SCRIPT_COMPARISON\x10\x10\x12\x1f\n" +
 	"\x1bEVENT_TYPE_AGENT_COMPARISON\x10\x11\x12\x1f\n" +
-	"\x1bEVENT_TYPE_SKILL_COMPARISON\x10\x122^\n" +
+	"\x1bEVENT_TYPE_SKILL_COMPARISON\x10\x12\x12$\n" +
+	" EVENT_TYPE_AGENT_MANAGER_CHANGED\x10\x13\x12#\n" +
+	"\x1fEVENT_TYPE_TASK_ROLLBACK_RESULT\x10\x142^\n" +
 	"\fEventService\x12N\n" +
 	"\x0fSubscribeEvents\x12$.taskguild.v1.SubscribeEventsRequest\x1a\x13.taskguild.v1.Event0\x01B\xb3\x01\n" +
 	"\x10com.taskguild.v1B\n" +
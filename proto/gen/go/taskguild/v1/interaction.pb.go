@@ -148,7 +148,10 @@ type Interaction struct {
 	RespondedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
 	// metadata holds optional structured data as a JSON string.
 	// For Bash permission requests, this contains parsed command information.
-	Metadata      string `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Metadata string `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// responded_by names who responded: the API token name, or
+	// "response_token" for one-time links from notifications.
+	RespondedBy   string `protobuf:"bytes,13,opt,name=responded_by,json=respondedBy,proto3" json:"responded_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -267,6 +270,13 @@ func (x *Interaction) GetMetadata() string {
 	return ""
 }
 
+func (x *Interaction) GetRespondedBy() string {
+	if x != nil {
+		return x.RespondedBy
+	}
+	return ""
+}
+
 type InteractionOption struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
@@ -934,7 +944,7 @@ var File_taskguild_v1_interaction_proto protoreflect.FileDescriptor
 
 const file_taskguild_v1_interaction_proto_rawDesc = "" +
 	"\n" +
-	"\x1etaskguild/v1/interaction.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19taskguild/v1/common.proto\"\xe2\x03\n" +
+	"\x1etaskguild/v1/interaction.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19taskguild/v1/common.proto\"\x85\x04\n" +
 	"\vInteraction\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x19\n" +
@@ -949,7 +959,8 @@ const file_taskguild_v1_interaction_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
 	"\fresponded_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vrespondedAt\x12\x1a\n" +
-	"\bmetadata\x18\f \x01(\tR\bmetadata\"a\n" +
+	"\bmetadata\x18\f \x01(\tR\bmetadata\x12!\n" +
+	"\fresponded_by\x18\r \x01(\tR\vrespondedBy\"a\n" +
 	"\x11InteractionOption\x12\x14\n" +
 	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value\x12 \n" +
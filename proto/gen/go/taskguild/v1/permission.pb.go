@@ -24,12 +24,23 @@ const (
 
 // PermissionSet represents a project-scoped set of permission rules.
 type PermissionSet struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Allow         []string               `protobuf:"bytes,2,rep,name=allow,proto3" json:"allow,omitempty"`
-	Ask           []string               `protobuf:"bytes,3,rep,name=ask,proto3" json:"ask,omitempty"`
-	Deny          []string               `protobuf:"bytes,4,rep,name=deny,proto3" json:"deny,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Allow     []string               `protobuf:"bytes,2,rep,name=allow,proto3" json:"allow,omitempty"`
+	Ask       []string               `protobuf:"bytes,3,rep,name=ask,proto3" json:"ask,omitempty"`
+	Deny      []string               `protobuf:"bytes,4,rep,name=deny,proto3" json:"deny,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Risk categories of Bash commands that are allowed without confirmation
+	// (e.g. "read_only", "worktree_write"). Only low-risk categories are accepted.
+	AutoAllowRisks []string `protobuf:"bytes,6,rep,name=auto_allow_risks,json=autoAllowRisks,proto3" json:"auto_allow_risks,omitempty"`
+	// Project-wide path policy for edit tools and shell redirects.
+	PathPolicy *PathPolicy `protobuf:"bytes,7,opt,name=path_policy,json=pathPolicy,proto3" json:"path_policy,omitempty"`
+	// Regular expressions whose matches are masked in task logs, interactions
+	// and turn logs, in addition to the built-in credential detectors. When a
+	// pattern has a capture group, only the first group is masked.
+	RedactionPatterns []string `protobuf:"bytes,8,rep,name=redaction_patterns,json=redactionPatterns,proto3" json:"redaction_patterns,omitempty"`
+	// Project-wide egress policy for WebFetch and shell network tools.
+	EgressPolicy  *EgressPolicy `protobuf:"bytes,9,opt,name=egress_policy,json=egressPolicy,proto3" json:"egress_policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -99,6 +110,92 @@ func (x *PermissionSet) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *PermissionSet) GetAutoAllowRisks() []string {
+	if x != nil {
+		return x.AutoAllowRisks
+	}
+	return nil
+}
+
+func (x *PermissionSet) GetPathPolicy() *PathPolicy {
+	if x != nil {
+		return x.PathPolicy
+	}
+	return nil
+}
+
+func (x *PermissionSet) GetRedactionPatterns() []string {
+	if x != nil {
+		return x.RedactionPatterns
+	}
+	return nil
+}
+
+func (x *PermissionSet) GetEgressPolicy() *EgressPolicy {
+	if x != nil {
+		return x.EgressPolicy
+	}
+	return nil
+}
+
+// EgressPolicy restricts the hosts WebFetch and shell network tools (curl,
+// wget, git remotes, package registries) may contact. An entry matches the
+// domain and its subdomains; "*" wildcards match the whole host.
+type EgressPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When non-empty, requests to other hosts always need confirmation and
+	// show the exact URL.
+	Allow []string `protobuf:"bytes,1,rep,name=allow,proto3" json:"allow,omitempty"`
+	// Requests to denied hosts are rejected.
+	Deny          []string `protobuf:"bytes,2,rep,name=deny,proto3" json:"deny,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EgressPolicy) Reset() {
+	*x = EgressPolicy{}
+	mi := &file_taskguild_v1_permission_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EgressPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EgressPolicy) ProtoMessage() {}
+
+func (x *EgressPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_permission_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EgressPolicy.ProtoReflect.Descriptor instead.
+func (*EgressPolicy) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EgressPolicy) GetAllow() []string {
+	if x != nil {
+		return x.Allow
+	}
+	return nil
+}
+
+func (x *EgressPolicy) GetDeny() []string {
+	if x != nil {
+		return x.Deny
+	}
+	return nil
+}
+
 type GetPermissionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
@@ -108,7 +205,7 @@ type GetPermissionsRequest struct {
 
 func (x *GetPermissionsRequest) Reset() {
 	*x = GetPermissionsRequest{}
-	mi := &file_taskguild_v1_permission_proto_msgTypes[1]
+	mi := &file_taskguild_v1_permission_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -120,7 +217,7 @@ func (x *GetPermissionsRequest) String() string {
 func (*GetPermissionsRequest) ProtoMessage() {}
 
 func (x *GetPermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_permission_proto_msgTypes[1]
+	mi := &file_taskguild_v1_permission_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -133,7 +230,7 @@ func (x *GetPermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPermissionsRequest.ProtoReflect.Descriptor instead.
 func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{1}
+	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetPermissionsRequest) GetProjectId() string {
@@ -152,7 +249,7 @@ type GetPermissionsResponse struct {
 
 func (x *GetPermissionsResponse) Reset() {
 	*x = GetPermissionsResponse{}
-	mi := &file_taskguild_v1_permission_proto_msgTypes[2]
+	mi := &file_taskguild_v1_permission_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -164,7 +261,7 @@ func (x *GetPermissionsResponse) String() string {
 func (*GetPermissionsResponse) ProtoMessage() {}
 
 func (x *GetPermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_permission_proto_msgTypes[2]
+	mi := &file_taskguild_v1_permission_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -177,7 +274,7 @@ func (x *GetPermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPermissionsResponse.ProtoReflect.Descriptor instead.
 func (*GetPermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{2}
+	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetPermissionsResponse) GetPermissions() *PermissionSet {
@@ -188,18 +285,22 @@ func (x *GetPermissionsResponse) GetPermissions() *PermissionSet {
 }
 
 type UpdatePermissionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Allow         []string               `protobuf:"bytes,2,rep,name=allow,proto3" json:"allow,omitempty"`
-	Ask           []string               `protobuf:"bytes,3,rep,name=ask,proto3" json:"ask,omitempty"`
-	Deny          []string               `protobuf:"bytes,4,rep,name=deny,proto3" json:"deny,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId         string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Allow             []string               `protobuf:"bytes,2,rep,name=allow,proto3" json:"allow,omitempty"`
+	Ask               []string               `protobuf:"bytes,3,rep,name=ask,proto3" json:"ask,omitempty"`
+	Deny              []string               `protobuf:"bytes,4,rep,name=deny,proto3" json:"deny,omitempty"`
+	AutoAllowRisks    []string               `protobuf:"bytes,5,rep,name=auto_allow_risks,json=autoAllowRisks,proto3" json:"auto_allow_risks,omitempty"`
+	PathPolicy        *PathPolicy            `protobuf:"bytes,6,opt,name=path_policy,json=pathPolicy,proto3" json:"path_policy,omitempty"`
+	RedactionPatterns []string               `protobuf:"bytes,7,rep,name=redaction_patterns,json=redactionPatterns,proto3" json:"redaction_patterns,omitempty"`
+	EgressPolicy      *EgressPolicy          `protobuf:"bytes,8,opt,name=egress_policy,json=egressPolicy,proto3" json:"egress_policy,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UpdatePermissionsRequest) Reset() {
 	*x = UpdatePermissionsRequest{}
-	mi := &file_taskguild_v1_permission_proto_msgTypes[3]
+	mi := &file_taskguild_v1_permission_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -211,7 +312,7 @@ func (x *UpdatePermissionsRequest) String() string {
 func (*UpdatePermissionsRequest) ProtoMessage() {}
 
 func (x *UpdatePermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_permission_proto_msgTypes[3]
+	mi := &file_taskguild_v1_permission_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -224,7 +325,7 @@ func (x *UpdatePermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePermissionsRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{3}
+	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdatePermissionsRequest) GetProjectId() string {
@@ -255,6 +356,34 @@ func (x *UpdatePermissionsRequest) GetDeny() []string {
 	return nil
 }
 
+func (x *UpdatePermissionsRequest) GetAutoAllowRisks() []string {
+	if x != nil {
+		return x.AutoAllowRisks
+	}
+	return nil
+}
+
+func (x *UpdatePermissionsRequest) GetPathPolicy() *PathPolicy {
+	if x != nil {
+		return x.PathPolicy
+	}
+	return nil
+}
+
+func (x *UpdatePermissionsRequest) GetRedactionPatterns() []string {
+	if x != nil {
+		return x.RedactionPatterns
+	}
+	return nil
+}
+
+func (x *UpdatePermissionsRequest) GetEgressPolicy() *EgressPolicy {
+	if x != nil {
+		return x.EgressPolicy
+	}
+	return nil
+}
+
 type UpdatePermissionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Permissions   *PermissionSet         `protobuf:"bytes,1,opt,name=permissions,proto3" json:"permissions,omitempty"`
@@ -264,7 +393,7 @@ type UpdatePermissionsResponse struct {
 
 func (x *UpdatePermissionsResponse) Reset() {
 	*x = UpdatePermissionsResponse{}
-	mi := &file_taskguild_v1_permission_proto_msgTypes[4]
+	mi := &file_taskguild_v1_permission_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -276,7 +405,7 @@ func (x *UpdatePermissionsResponse) String() string {
 func (*UpdatePermissionsResponse) ProtoMessage() {}
 
 func (x *UpdatePermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_permission_proto_msgTypes[4]
+	mi := &file_taskguild_v1_permission_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,7 +418,7 @@ func (x *UpdatePermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePermissionsResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{4}
+	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdatePermissionsResponse) GetPermissions() *PermissionSet {
@@ -309,7 +438,7 @@ type SyncPermissionsFromDirRequest struct {
 
 func (x *SyncPermissionsFromDirRequest) Reset() {
 	*x = SyncPermissionsFromDirRequest{}
-	mi := &file_taskguild_v1_permission_proto_msgTypes[5]
+	mi := &file_taskguild_v1_permission_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -321,7 +450,7 @@ func (x *SyncPermissionsFromDirRequest) String() string {
 func (*SyncPermissionsFromDirRequest) ProtoMessage() {}
 
 func (x *SyncPermissionsFromDirRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_permission_proto_msgTypes[5]
+	mi := &file_taskguild_v1_permission_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,7 +463,7 @@ func (x *SyncPermissionsFromDirRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncPermissionsFromDirRequest.ProtoReflect.Descriptor instead.
 func (*SyncPermissionsFromDirRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{5}
+	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SyncPermissionsFromDirRequest) GetProjectId() string {
@@ -360,7 +489,7 @@ type SyncPermissionsFromDirResponse struct {
 
 func (x *SyncPermissionsFromDirResponse) Reset() {
 	*x = SyncPermissionsFromDirResponse{}
-	mi := &file_taskguild_v1_permission_proto_msgTypes[6]
+	mi := &file_taskguild_v1_permission_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -372,7 +501,7 @@ func (x *SyncPermissionsFromDirResponse) String() string {
 func (*SyncPermissionsFromDirResponse) ProtoMessage() {}
 
 func (x *SyncPermissionsFromDirResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_permission_proto_msgTypes[6]
+	mi := &file_taskguild_v1_permission_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -385,7 +514,7 @@ func (x *SyncPermissionsFromDirResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncPermissionsFromDirResponse.ProtoReflect.Descriptor instead.
 func (*SyncPermissionsFromDirResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{6}
+	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SyncPermissionsFromDirResponse) GetPermissions() *PermissionSet {
@@ -395,11 +524,422 @@ func (x *SyncPermissionsFromDirResponse) GetPermissions() *PermissionSet {
 	return nil
 }
 
+type EvaluatePermissionRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// workflow_id and status_name select the workflow status whose permission
+	// mode, path policy, skills and status-scoped grants apply. Optional.
+	WorkflowId string `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	StatusName string `protobuf:"bytes,3,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
+	ToolName   string `protobuf:"bytes,4,opt,name=tool_name,json=toolName,proto3" json:"tool_name,omitempty"`    // e.g. "Bash", "Write", "Read"
+	ToolInput  string `protobuf:"bytes,5,opt,name=tool_input,json=toolInput,proto3" json:"tool_input,omitempty"` // tool input as a JSON object
+	// command is a shorthand for Bash: tool_input {"command": command}.
+	Command string `protobuf:"bytes,6,opt,name=command,proto3" json:"command,omitempty"`
+	// permission_mode overrides the mode resolved from the workflow status.
+	PermissionMode string `protobuf:"bytes,7,opt,name=permission_mode,json=permissionMode,proto3" json:"permission_mode,omitempty"`
+	// task_id selects task-scoped grants. Optional.
+	TaskId string `protobuf:"bytes,8,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// work_dir resolves relative write targets. Defaults to the working
+	// directory of the project's connected agent.
+	WorkDir       string `protobuf:"bytes,9,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluatePermissionRequest) Reset() {
+	*x = EvaluatePermissionRequest{}
+	mi := &file_taskguild_v1_permission_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluatePermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluatePermissionRequest) ProtoMessage() {}
+
+func (x *EvaluatePermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_permission_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluatePermissionRequest.ProtoReflect.Descriptor instead.
+func (*EvaluatePermissionRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EvaluatePermissionRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *EvaluatePermissionRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *EvaluatePermissionRequest) GetStatusName() string {
+	if x != nil {
+		return x.StatusName
+	}
+	return ""
+}
+
+func (x *EvaluatePermissionRequest) GetToolName() string {
+	if x != nil {
+		return x.ToolName
+	}
+	return ""
+}
+
+func (x *EvaluatePermissionRequest) GetToolInput() string {
+	if x != nil {
+		return x.ToolInput
+	}
+	return ""
+}
+
+func (x *EvaluatePermissionRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *EvaluatePermissionRequest) GetPermissionMode() string {
+	if x != nil {
+		return x.PermissionMode
+	}
+	return ""
+}
+
+func (x *EvaluatePermissionRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *EvaluatePermissionRequest) GetWorkDir() string {
+	if x != nil {
+		return x.WorkDir
+	}
+	return ""
+}
+
+// CommandEvaluation is the single-command check of one parsed command.
+type CommandEvaluation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Command          string                 `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Matched          bool                   `protobuf:"varint,2,opt,name=matched,proto3" json:"matched,omitempty"`
+	MatchedPattern   string                 `protobuf:"bytes,3,opt,name=matched_pattern,json=matchedPattern,proto3" json:"matched_pattern,omitempty"`
+	SuggestedPattern string                 `protobuf:"bytes,4,opt,name=suggested_pattern,json=suggestedPattern,proto3" json:"suggested_pattern,omitempty"`
+	Opaque           bool                   `protobuf:"varint,5,opt,name=opaque,proto3" json:"opaque,omitempty"`
+	OpaqueReason     string                 `protobuf:"bytes,6,opt,name=opaque_reason,json=opaqueReason,proto3" json:"opaque_reason,omitempty"`
+	Risk             string                 `protobuf:"bytes,7,opt,name=risk,proto3" json:"risk,omitempty"`
+	RiskReason       string                 `protobuf:"bytes,8,opt,name=risk_reason,json=riskReason,proto3" json:"risk_reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CommandEvaluation) Reset() {
+	*x = CommandEvaluation{}
+	mi := &file_taskguild_v1_permission_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandEvaluation) ProtoMessage() {}
+
+func (x *CommandEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_permission_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandEvaluation.ProtoReflect.Descriptor instead.
+func (*CommandEvaluation) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CommandEvaluation) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *CommandEvaluation) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *CommandEvaluation) GetMatchedPattern() string {
+	if x != nil {
+		return x.MatchedPattern
+	}
+	return ""
+}
+
+func (x *CommandEvaluation) GetSuggestedPattern() string {
+	if x != nil {
+		return x.SuggestedPattern
+	}
+	return ""
+}
+
+func (x *CommandEvaluation) GetOpaque() bool {
+	if x != nil {
+		return x.Opaque
+	}
+	return false
+}
+
+func (x *CommandEvaluation) GetOpaqueReason() string {
+	if x != nil {
+		return x.OpaqueReason
+	}
+	return ""
+}
+
+func (x *CommandEvaluation) GetRisk() string {
+	if x != nil {
+		return x.Risk
+	}
+	return ""
+}
+
+func (x *CommandEvaluation) GetRiskReason() string {
+	if x != nil {
+		return x.RiskReason
+	}
+	return ""
+}
+
+// RedirectEvaluation is the single-command check of one redirect.
+type RedirectEvaluation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Operator         string                 `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	Path             string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Matched          bool                   `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"`
+	MatchedPattern   string                 `protobuf:"bytes,4,opt,name=matched_pattern,json=matchedPattern,proto3" json:"matched_pattern,omitempty"`
+	SuggestedPattern string                 `protobuf:"bytes,5,opt,name=suggested_pattern,json=suggestedPattern,proto3" json:"suggested_pattern,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RedirectEvaluation) Reset() {
+	*x = RedirectEvaluation{}
+	mi := &file_taskguild_v1_permission_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedirectEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectEvaluation) ProtoMessage() {}
+
+func (x *RedirectEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_permission_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectEvaluation.ProtoReflect.Descriptor instead.
+func (*RedirectEvaluation) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RedirectEvaluation) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *RedirectEvaluation) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RedirectEvaluation) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *RedirectEvaluation) GetMatchedPattern() string {
+	if x != nil {
+		return x.MatchedPattern
+	}
+	return ""
+}
+
+func (x *RedirectEvaluation) GetSuggestedPattern() string {
+	if x != nil {
+		return x.SuggestedPattern
+	}
+	return ""
+}
+
+type EvaluatePermissionResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Decision string                 `protobuf:"bytes,1,opt,name=decision,proto3" json:"decision,omitempty"` // "allow", "ask" or "deny"
+	// source names the check that decided, e.g. "permission_deny_rule",
+	// "single_command_rules", "read_only_tool" or "permission_mode".
+	Source         string                `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Rule           string                `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"` // matched rule or pattern, if any
+	Reason         string                `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	PermissionMode string                `protobuf:"bytes,5,opt,name=permission_mode,json=permissionMode,proto3" json:"permission_mode,omitempty"` // the mode the call was evaluated in
+	Commands       []*CommandEvaluation  `protobuf:"bytes,6,rep,name=commands,proto3" json:"commands,omitempty"`
+	Redirects      []*RedirectEvaluation `protobuf:"bytes,7,rep,name=redirects,proto3" json:"redirects,omitempty"`
+	Risk           string                `protobuf:"bytes,8,opt,name=risk,proto3" json:"risk,omitempty"` // highest risk category of a Bash call
+	RiskLabel      string                `protobuf:"bytes,9,opt,name=risk_label,json=riskLabel,proto3" json:"risk_label,omitempty"`
+	RiskReason     string                `protobuf:"bytes,10,opt,name=risk_reason,json=riskReason,proto3" json:"risk_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EvaluatePermissionResponse) Reset() {
+	*x = EvaluatePermissionResponse{}
+	mi := &file_taskguild_v1_permission_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluatePermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluatePermissionResponse) ProtoMessage() {}
+
+func (x *EvaluatePermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_permission_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluatePermissionResponse.ProtoReflect.Descriptor instead.
+func (*EvaluatePermissionResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_permission_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EvaluatePermissionResponse) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *EvaluatePermissionResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *EvaluatePermissionResponse) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *EvaluatePermissionResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EvaluatePermissionResponse) GetPermissionMode() string {
+	if x != nil {
+		return x.PermissionMode
+	}
+	return ""
+}
+
+func (x *EvaluatePermissionResponse) GetCommands() []*CommandEvaluation {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *EvaluatePermissionResponse) GetRedirects() []*RedirectEvaluation {
+	if x != nil {
+		return x.Redirects
+	}
+	return nil
+}
+
+func (x *EvaluatePermissionResponse) GetRisk() string {
+	if x != nil {
+		return x.Risk
+	}
+	return ""
+}
+
+func (x *EvaluatePermissionResponse) GetRiskLabel() string {
+	if x != nil {
+		return x.RiskLabel
+	}
+	return ""
+}
+
+func (x *EvaluatePermissionResponse) GetRiskReason() string {
+	if x != nil {
+		return x.RiskReason
+	}
+	return ""
+}
+
 var File_taskguild_v1_permission_proto protoreflect.FileDescriptor
 
 const file_taskguild_v1_permission_proto_rawDesc = "" +
 	"\n" +
-	"\x1dtaskguild/v1/permission.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa5\x01\n" +
+	"\x1dtaskguild/v1/permission.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19taskguild/v1/common.proto\"\xfa\x02\n" +
 	"\rPermissionSet\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x14\n" +
@@ -407,18 +947,31 @@ const file_taskguild_v1_permission_proto_rawDesc = "" +
 	"\x03ask\x18\x03 \x03(\tR\x03ask\x12\x12\n" +
 	"\x04deny\x18\x04 \x03(\tR\x04deny\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"6\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12(\n" +
+	"\x10auto_allow_risks\x18\x06 \x03(\tR\x0eautoAllowRisks\x129\n" +
+	"\vpath_policy\x18\a \x01(\v2\x18.taskguild.v1.PathPolicyR\n" +
+	"pathPolicy\x12-\n" +
+	"\x12redaction_patterns\x18\b \x03(\tR\x11redactionPatterns\x12?\n" +
+	"\regress_policy\x18\t \x01(\v2\x1a.taskguild.v1.EgressPolicyR\fegressPolicy\"8\n" +
+	"\fEgressPolicy\x12\x14\n" +
+	"\x05allow\x18\x01 \x03(\tR\x05allow\x12\x12\n" +
+	"\x04deny\x18\x02 \x03(\tR\x04deny\"6\n" +
 	"\x15GetPermissionsRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\"W\n" +
 	"\x16GetPermissionsResponse\x12=\n" +
-	"\vpermissions\x18\x01 \x01(\v2\x1b.taskguild.v1.PermissionSetR\vpermissions\"u\n" +
+	"\vpermissions\x18\x01 \x01(\v2\x1b.taskguild.v1.PermissionSetR\vpermissions\"\xca\x02\n" +
 	"\x18UpdatePermissionsRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x14\n" +
 	"\x05allow\x18\x02 \x03(\tR\x05allow\x12\x10\n" +
 	"\x03ask\x18\x03 \x03(\tR\x03ask\x12\x12\n" +
-	"\x04deny\x18\x04 \x03(\tR\x04deny\"Z\n" +
+	"\x04deny\x18\x04 \x03(\tR\x04deny\x12(\n" +
+	"\x10auto_allow_risks\x18\x05 \x03(\tR\x0eautoAllowRisks\x129\n" +
+	"\vpath_policy\x18\x06 \x01(\v2\x18.taskguild.v1.PathPolicyR\n" +
+	"pathPolicy\x12-\n" +
+	"\x12redaction_patterns\x18\a \x03(\tR\x11redactionPatterns\x12?\n" +
+	"\regress_policy\x18\b \x01(\v2\x1a.taskguild.v1.EgressPolicyR\fegressPolicy\"Z\n" +
 	"\x19UpdatePermissionsResponse\x12=\n" +
 	"\vpermissions\x18\x01 \x01(\v2\x1b.taskguild.v1.PermissionSetR\vpermissions\"\\\n" +
 	"\x1dSyncPermissionsFromDirRequest\x12\x1d\n" +
@@ -426,11 +979,56 @@ const file_taskguild_v1_permission_proto_rawDesc = "" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1c\n" +
 	"\tdirectory\x18\x02 \x01(\tR\tdirectory\"_\n" +
 	"\x1eSyncPermissionsFromDirResponse\x12=\n" +
-	"\vpermissions\x18\x01 \x01(\v2\x1b.taskguild.v1.PermissionSetR\vpermissions2\xcb\x02\n" +
+	"\vpermissions\x18\x01 \x01(\v2\x1b.taskguild.v1.PermissionSetR\vpermissions\"\xaf\x02\n" +
+	"\x19EvaluatePermissionRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1f\n" +
+	"\vworkflow_id\x18\x02 \x01(\tR\n" +
+	"workflowId\x12\x1f\n" +
+	"\vstatus_name\x18\x03 \x01(\tR\n" +
+	"statusName\x12\x1b\n" +
+	"\ttool_name\x18\x04 \x01(\tR\btoolName\x12\x1d\n" +
+	"\n" +
+	"tool_input\x18\x05 \x01(\tR\ttoolInput\x12\x18\n" +
+	"\acommand\x18\x06 \x01(\tR\acommand\x12'\n" +
+	"\x0fpermission_mode\x18\a \x01(\tR\x0epermissionMode\x12\x17\n" +
+	"\atask_id\x18\b \x01(\tR\x06taskId\x12\x19\n" +
+	"\bwork_dir\x18\t \x01(\tR\aworkDir\"\x8f\x02\n" +
+	"\x11CommandEvaluation\x12\x18\n" +
+	"\acommand\x18\x01 \x01(\tR\acommand\x12\x18\n" +
+	"\amatched\x18\x02 \x01(\bR\amatched\x12'\n" +
+	"\x0fmatched_pattern\x18\x03 \x01(\tR\x0ematchedPattern\x12+\n" +
+	"\x11suggested_pattern\x18\x04 \x01(\tR\x10suggestedPattern\x12\x16\n" +
+	"\x06opaque\x18\x05 \x01(\bR\x06opaque\x12#\n" +
+	"\ropaque_reason\x18\x06 \x01(\tR\fopaqueReason\x12\x12\n" +
+	"\x04risk\x18\a \x01(\tR\x04risk\x12\x1f\n" +
+	"\vrisk_reason\x18\b \x01(\tR\n" +
+	"riskReason\"\xb4\x01\n" +
+	"\x12RedirectEvaluation\x12\x1a\n" +
+	"\boperator\x18\x01 \x01(\tR\boperator\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x18\n" +
+	"\amatched\x18\x03 \x01(\bR\amatched\x12'\n" +
+	"\x0fmatched_pattern\x18\x04 \x01(\tR\x0ematchedPattern\x12+\n" +
+	"\x11suggested_pattern\x18\x05 \x01(\tR\x10suggestedPattern\"\xf6\x02\n" +
+	"\x1aEvaluatePermissionResponse\x12\x1a\n" +
+	"\bdecision\x18\x01 \x01(\tR\bdecision\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x12\n" +
+	"\x04rule\x18\x03 \x01(\tR\x04rule\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12'\n" +
+	"\x0fpermission_mode\x18\x05 \x01(\tR\x0epermissionMode\x12;\n" +
+	"\bcommands\x18\x06 \x03(\v2\x1f.taskguild.v1.CommandEvaluationR\bcommands\x12>\n" +
+	"\tredirects\x18\a \x03(\v2 .taskguild.v1.RedirectEvaluationR\tredirects\x12\x12\n" +
+	"\x04risk\x18\b \x01(\tR\x04risk\x12\x1d\n" +
+	"\n" +
+	"risk_label\x18\t \x01(\tR\triskLabel\x12\x1f\n" +
+	"\vrisk_reason\x18\n" +
+	" \x01(\tR\n" +
+	"riskReason2\xb4\x03\n" +
 	"\x11PermissionService\x12[\n" +
 	"\x0eGetPermissions\x12#.taskguild.v1.GetPermissionsRequest\x1a$.taskguild.v1.GetPermissionsResponse\x12d\n" +
 	"\x11UpdatePermissions\x12&.taskguild.v1.UpdatePermissionsRequest\x1a'.taskguild.v1.UpdatePermissionsResponse\x12s\n" +
-	"\x16SyncPermissionsFromDir\x12+.taskguild.v1.SyncPermissionsFromDirRequest\x1a,.taskguild.v1.SyncPermissionsFromDirResponseB\xb8\x01\n" +
+	"\x16SyncPermissionsFromDir\x12+.taskguild.v1.SyncPermissionsFromDirRequest\x1a,.taskguild.v1.SyncPermissionsFromDirResponse\x12g\n" +
+	"\x12EvaluatePermission\x12'.taskguild.v1.EvaluatePermissionRequest\x1a(.taskguild.v1.EvaluatePermissionResponseB\xb8\x01\n" +
 	"\x10com.taskguild.v1B\x0fPermissionProtoP\x01ZBgithub.com/kazz187/taskguild/proto/gen/go/taskguild/v1;taskguildv1\xa2\x02\x03TXX\xaa\x02\fTaskguild.V1\xca\x02\fTaskguild\\V1\xe2\x02\x18Taskguild\\V1\\GPBMetadata\xea\x02\rTaskguild::V1b\x06proto3"
 
 var (
@@ -445,33 +1043,47 @@ func file_taskguild_v1_permission_proto_rawDescGZIP() []byte {
 	return file_taskguild_v1_permission_proto_rawDescData
 }
 
-var file_taskguild_v1_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_taskguild_v1_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_taskguild_v1_permission_proto_goTypes = []any{
 	(*PermissionSet)(nil),                  // 0: taskguild.v1.PermissionSet
-	(*GetPermissionsRequest)(nil),          // 1: taskguild.v1.GetPermissionsRequest
-	(*GetPermissionsResponse)(nil),         // 2: taskguild.v1.GetPermissionsResponse
-	(*UpdatePermissionsRequest)(nil),       // 3: taskguild.v1.UpdatePermissionsRequest
-	(*UpdatePermissionsResponse)(nil),      // 4: taskguild.v1.UpdatePermissionsResponse
-	(*SyncPermissionsFromDirRequest)(nil),  // 5: taskguild.v1.SyncPermissionsFromDirRequest
-	(*SyncPermissionsFromDirResponse)(nil), // 6: taskguild.v1.SyncPermissionsFromDirResponse
-	(*timestamppb.Timestamp)(nil),          // 7: google.protobuf.Timestamp
+	(*EgressPolicy)(nil),                   // 1: taskguild.v1.EgressPolicy
+	(*GetPermissionsRequest)(nil),          // 2: taskguild.v1.GetPermissionsRequest
+	(*GetPermissionsResponse)(nil),         // 3: taskguild.v1.GetPermissionsResponse
+	(*UpdatePermissionsRequest)(nil),       // 4: taskguild.v1.UpdatePermissionsRequest
+	(*UpdatePermissionsResponse)(nil),      // 5: taskguild.v1.UpdatePermissionsResponse
+	(*SyncPermissionsFromDirRequest)(nil),  // 6: taskguild.v1.SyncPermissionsFromDirRequest
+	(*SyncPermissionsFromDirResponse)(nil), // 7: taskguild.v1.SyncPermissionsFromDirResponse
+	(*EvaluatePermissionRequest)(nil),      // 8: taskguild.v1.EvaluatePermissionRequest
+	(*CommandEvaluation)(nil),              // 9: taskguild.v1.CommandEvaluation
+	(*RedirectEvaluation)(nil),             // 10: taskguild.v1.RedirectEvaluation
+	(*EvaluatePermissionResponse)(nil),     // 11: taskguild.v1.EvaluatePermissionResponse
+	(*timestamppb.Timestamp)(nil),          // 12: google.protobuf.Timestamp
+	(*PathPolicy)(nil),                     // 13: taskguild.v1.PathPolicy
 }
 var file_taskguild_v1_permission_proto_depIdxs = []int32{
-	7, // 0: taskguild.v1.PermissionSet.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 1: taskguild.v1.GetPermissionsResponse.permissions:type_name -> taskguild.v1.PermissionSet
-	0, // 2: taskguild.v1.UpdatePermissionsResponse.permissions:type_name -> taskguild.v1.PermissionSet
-	0, // 3: taskguild.v1.SyncPermissionsFromDirResponse.permissions:type_name -> taskguild.v1.PermissionSet
-	1, // 4: taskguild.v1.PermissionService.GetPermissions:input_type -> taskguild.v1.GetPermissionsRequest
-	3, // 5: taskguild.v1.PermissionService.UpdatePermissions:input_type -> taskguild.v1.UpdatePermissionsRequest
-	5, // 6: taskguild.v1.PermissionService.SyncPermissionsFromDir:input_type -> taskguild.v1.SyncPermissionsFromDirRequest
-	2, // 7: taskguild.v1.PermissionService.GetPermissions:output_type -> taskguild.v1.GetPermissionsResponse
-	4, // 8: taskguild.v1.PermissionService.UpdatePermissions:output_type -> taskguild.v1.UpdatePermissionsResponse
-	6, // 9: taskguild.v1.PermissionService.SyncPermissionsFromDir:output_type -> taskguild.v1.SyncPermissionsFromDirResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	12, // 0: taskguild.v1.PermissionSet.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 1: taskguild.v1.PermissionSet.path_policy:type_name -> taskguild.v1.PathPolicy
+	1,  // 2: taskguild.v1.PermissionSet.egress_policy:type_name -> taskguild.v1.EgressPolicy
+	0,  // 3: taskguild.v1.GetPermissionsResponse.permissions:type_name -> taskguild.v1.PermissionSet
+	13, // 4: taskguild.v1.UpdatePermissionsRequest.path_policy:type_name -> taskguild.v1.PathPolicy
+	1,  // 5: taskguild.v1.UpdatePermissionsRequest.egress_policy:type_name -> taskguild.v1.EgressPolicy
+	0,  // 6: taskguild.v1.UpdatePermissionsResponse.permissions:type_name -> taskguild.v1.PermissionSet
+	0,  // 7: taskguild.v1.SyncPermissionsFromDirResponse.permissions:type_name -> taskguild.v1.PermissionSet
+	9,  // 8: taskguild.v1.EvaluatePermissionResponse.commands:type_name -> taskguild.v1.CommandEvaluation
+	10, // 9: taskguild.v1.EvaluatePermissionResponse.redirects:type_name -> taskguild.v1.RedirectEvaluation
+	2,  // 10: taskguild.v1.PermissionService.GetPermissions:input_type -> taskguild.v1.GetPermissionsRequest
+	4,  // 11: taskguild.v1.PermissionService.UpdatePermissions:input_type -> taskguild.v1.UpdatePermissionsRequest
+	6,  // 12: taskguild.v1.PermissionService.SyncPermissionsFromDir:input_type -> taskguild.v1.SyncPermissionsFromDirRequest
+	8,  // 13: taskguild.v1.PermissionService.EvaluatePermission:input_type -> taskguild.v1.EvaluatePermissionRequest
+	3,  // 14: taskguild.v1.PermissionService.GetPermissions:output_type -> taskguild.v1.GetPermissionsResponse
+	5,  // 15: taskguild.v1.PermissionService.UpdatePermissions:output_type -> taskguild.v1.UpdatePermissionsResponse
+	7,  // 16: taskguild.v1.PermissionService.SyncPermissionsFromDir:output_type -> taskguild.v1.SyncPermissionsFromDirResponse
+	11, // 17: taskguild.v1.PermissionService.EvaluatePermission:output_type -> taskguild.v1.EvaluatePermissionResponse
+	14, // [14:18] is the sub-list for method output_type
+	10, // [10:14] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_taskguild_v1_permission_proto_init() }
@@ -479,13 +1091,14 @@ func file_taskguild_v1_permission_proto_init() {
 	if File_taskguild_v1_permission_proto != nil {
 		return
 	}
+	file_taskguild_v1_common_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_taskguild_v1_permission_proto_rawDesc), len(file_taskguild_v1_permission_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -33,8 +33,14 @@ type Project struct {
 	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	Order             int32                  `protobuf:"varint,8,opt,name=order,proto3" json:"order,omitempty"`
 	HiddenFromSidebar bool                   `protobuf:"varint,9,opt,name=hidden_from_sidebar,json=hiddenFromSidebar,proto3" json:"hidden_from_sidebar,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	MergeQueue        *MergeQueueConfig      `protobuf:"bytes,10,opt,name=merge_queue,json=mergeQueue,proto3" json:"merge_queue,omitempty"`
+	WorktreePolicy    *WorktreePolicy        `protobuf:"bytes,11,opt,name=worktree_policy,json=worktreePolicy,proto3" json:"worktree_policy,omitempty"`
+	// worktree_branch_template names the branch of a new worktree. {name} is
+	// replaced by the worktree name and {task_id} by the creating task's ID.
+	// Empty means "worktree-{name}".
+	WorktreeBranchTemplate string `protobuf:"bytes,12,opt,name=worktree_branch_template,json=worktreeBranchTemplate,proto3" json:"worktree_branch_template,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *Project) Reset() {
@@ -130,19 +136,178 @@ func (x *Project) GetHiddenFromSidebar() bool {
 	return false
 }
 
-type CreateProjectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	RepositoryUrl string                 `protobuf:"bytes,3,opt,name=repository_url,json=repositoryUrl,proto3" json:"repository_url,omitempty"`
-	DefaultBranch string                 `protobuf:"bytes,4,opt,name=default_branch,json=defaultBranch,proto3" json:"default_branch,omitempty"`
+func (x *Project) GetMergeQueue() *MergeQueueConfig {
+	if x != nil {
+		return x.MergeQueue
+	}
+	return nil
+}
+
+func (x *Project) GetWorktreePolicy() *WorktreePolicy {
+	if x != nil {
+		return x.WorktreePolicy
+	}
+	return nil
+}
+
+func (x *Project) GetWorktreeBranchTemplate() string {
+	if x != nil {
+		return x.WorktreeBranchTemplate
+	}
+	return ""
+}
+
+// WorktreePolicy configures automatic cleanup of a project's worktrees.
+// Worktrees with uncommitted changes or whose task is running are never
+// deleted.
+type WorktreePolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// delete_archived deletes the worktrees of archived tasks.
+	DeleteArchived bool `protobuf:"varint,1,opt,name=delete_archived,json=deleteArchived,proto3" json:"delete_archived,omitempty"`
+	// delete_merged_after_days deletes worktrees whose branch is merged into
+	// the default branch and that were not modified for this many days, once
+	// their task is done. 0 disables it.
+	DeleteMergedAfterDays int32 `protobuf:"varint,2,opt,name=delete_merged_after_days,json=deleteMergedAfterDays,proto3" json:"delete_merged_after_days,omitempty"`
+	// disk_quota_bytes raises a warning when the total disk usage of the
+	// project's worktrees exceeds it. 0 disables it.
+	DiskQuotaBytes int64 `protobuf:"varint,3,opt,name=disk_quota_bytes,json=diskQuotaBytes,proto3" json:"disk_quota_bytes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorktreePolicy) Reset() {
+	*x = WorktreePolicy{}
+	mi := &file_taskguild_v1_project_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorktreePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorktreePolicy) ProtoMessage() {}
+
+func (x *WorktreePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_project_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorktreePolicy.ProtoReflect.Descriptor instead.
+func (*WorktreePolicy) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WorktreePolicy) GetDeleteArchived() bool {
+	if x != nil {
+		return x.DeleteArchived
+	}
+	return false
+}
+
+func (x *WorktreePolicy) GetDeleteMergedAfterDays() int32 {
+	if x != nil {
+		return x.DeleteMergedAfterDays
+	}
+	return 0
+}
+
+func (x *WorktreePolicy) GetDiskQuotaBytes() int64 {
+	if x != nil {
+		return x.DiskQuotaBytes
+	}
+	return 0
+}
+
+// MergeQueueConfig configures the local merge queue of a project. When
+// enabled, the worktree branch of a task that reaches a terminal status is
+// rebased onto the default branch, verified and fast-forward merged.
+type MergeQueueConfig struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// verify_commands run (via sh -c) in the rebased worktree before merging.
+	// A non-zero exit fails the merge.
+	VerifyCommands []string `protobuf:"bytes,2,rep,name=verify_commands,json=verifyCommands,proto3" json:"verify_commands,omitempty"`
+	// push pushes the default branch to origin after merging.
+	Push          bool `protobuf:"varint,3,opt,name=push,proto3" json:"push,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *MergeQueueConfig) Reset() {
+	*x = MergeQueueConfig{}
+	mi := &file_taskguild_v1_project_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeQueueConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeQueueConfig) ProtoMessage() {}
+
+func (x *MergeQueueConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_project_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeQueueConfig.ProtoReflect.Descriptor instead.
+func (*MergeQueueConfig) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MergeQueueConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *MergeQueueConfig) GetVerifyCommands() []string {
+	if x != nil {
+		return x.VerifyCommands
+	}
+	return nil
+}
+
+func (x *MergeQueueConfig) GetPush() bool {
+	if x != nil {
+		return x.Push
+	}
+	return false
+}
+
+type CreateProjectRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Name                   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description            string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	RepositoryUrl          string                 `protobuf:"bytes,3,opt,name=repository_url,json=repositoryUrl,proto3" json:"repository_url,omitempty"`
+	DefaultBranch          string                 `protobuf:"bytes,4,opt,name=default_branch,json=defaultBranch,proto3" json:"default_branch,omitempty"`
+	MergeQueue             *MergeQueueConfig      `protobuf:"bytes,5,opt,name=merge_queue,json=mergeQueue,proto3" json:"merge_queue,omitempty"`
+	WorktreePolicy         *WorktreePolicy        `protobuf:"bytes,6,opt,name=worktree_policy,json=worktreePolicy,proto3" json:"worktree_policy,omitempty"`
+	WorktreeBranchTemplate string                 `protobuf:"bytes,7,opt,name=worktree_branch_template,json=worktreeBranchTemplate,proto3" json:"worktree_branch_template,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
 func (x *CreateProjectRequest) Reset() {
 	*x = CreateProjectRequest{}
-	mi := &file_taskguild_v1_project_proto_msgTypes[1]
+	mi := &file_taskguild_v1_project_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -154,7 +319,7 @@ func (x *CreateProjectRequest) String() string {
 func (*CreateProjectRequest) ProtoMessage() {}
 
 func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_project_proto_msgTypes[1]
+	mi := &file_taskguild_v1_project_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -167,7 +332,7 @@ func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
 func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{1}
+	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateProjectRequest) GetName() string {
@@ -198,6 +363,27 @@ func (x *CreateProjectRequest) GetDefaultBranch() string {
 	return ""
 }
 
+func (x *CreateProjectRequest) GetMergeQueue() *MergeQueueConfig {
+	if x != nil {
+		return x.MergeQueue
+	}
+	return nil
+}
+
+func (x *CreateProjectRequest) GetWorktreePolicy() *WorktreePolicy {
+	if x != nil {
+		return x.WorktreePolicy
+	}
+	return nil
+}
+
+func (x *CreateProjectRequest) GetWorktreeBranchTemplate() string {
+	if x != nil {
+		return x.WorktreeBranchTemplate
+	}
+	return ""
+}
+
 type CreateProjectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
@@ -207,7 +393,7 @@ type CreateProjectResponse struct {
 
 func (x *CreateProjectResponse) Reset() {
 	*x = CreateProjectResponse{}
-	mi := &file_taskguild_v1_project_proto_msgTypes[2]
+	mi := &file_taskguild_v1_project_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -219,7 +405,7 @@ func (x *CreateProjectResponse) String() string {
 func (*CreateProjectResponse) ProtoMessage() {}
 
 func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_project_proto_msgTypes[2]
+	mi := &file_taskguild_v1_project_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -232,7 +418,7 @@ func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
 func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{2}
+	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateProjectResponse) GetProject() *Project {
@@ -251,7 +437,7 @@ type GetProjectRequest struct {
 
 func (x *GetProjectRequest) Reset() {
 	*x = GetProjectRequest{}
-	mi := &file_taskguild_v1_project_proto_msgTypes[3]
+	mi := &file_taskguild_v1_project_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -263,7 +449,7 @@ func (x *GetProjectRequest) String() string {
 func (*GetProjectRequest) ProtoMessage() {}
 
 func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_project_proto_msgTypes[3]
+	mi := &file_taskguild_v1_project_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -276,7 +462,7 @@ func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
 func (*GetProjectRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{3}
+	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetProjectRequest) GetId() string {
@@ -295,7 +481,7 @@ type GetProjectResponse struct {
 
 func (x *GetProjectResponse) Reset() {
 	*x = GetProjectResponse{}
-	mi := &file_taskguild_v1_project_proto_msgTypes[4]
+	mi := &file_taskguild_v1_project_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -307,7 +493,7 @@ func (x *GetProjectResponse) String() string {
 func (*GetProjectResponse) ProtoMessage() {}
 
 func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_project_proto_msgTypes[4]
+	mi := &file_taskguild_v1_project_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -320,7 +506,7 @@ func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
 func (*GetProjectResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{4}
+	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetProjectResponse) GetProject() *Project {
@@ -339,7 +525,7 @@ type ListProjectsRequest struct {
 
 func (x *ListProjectsRequest) Reset() {
 	*x = ListProjectsRequest{}
-	mi := &file_taskguild_v1_project_proto_msgTypes[5]
+	mi := &file_taskguild_v1_project_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -351,7 +537,7 @@ func (x *ListProjectsRequest) String() string {
 func (*ListProjectsRequest) ProtoMessage() {}
 
 func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_project_proto_msgTypes[5]
+	mi := &file_taskguild_v1_project_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +550,7 @@ func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
 func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{5}
+	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListProjectsRequest) GetPagination() *PaginationRequest {
@@ -384,7 +570,7 @@ type ListProjectsResponse struct {
 
 func (x *ListProjectsResponse) Reset() {
 	*x = ListProjectsResponse{}
-	mi := &file_taskguild_v1_project_proto_msgTypes[6]
+	mi := &file_taskguild_v1_project_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -396,7 +582,7 @@ func (x *ListProjectsResponse) String() string {
 func (*ListProjectsResponse) ProtoMessage() {}
 
 func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_project_proto_msgTypes[6]
+	mi := &file_taskguild_v1_project_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +595,7 @@ func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
 func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{6}
+	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListProjectsResponse) GetProjects() []*Project {
@@ -434,13 +620,20 @@ type UpdateProjectRequest struct {
 	RepositoryUrl     string                 `protobuf:"bytes,4,opt,name=repository_url,json=repositoryUrl,proto3" json:"repository_url,omitempty"`
 	DefaultBranch     string                 `protobuf:"bytes,5,opt,name=default_branch,json=defaultBranch,proto3" json:"default_branch,omitempty"`
 	HiddenFromSidebar *bool                  `protobuf:"varint,6,opt,name=hidden_from_sidebar,json=hiddenFromSidebar,proto3,oneof" json:"hidden_from_sidebar,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// merge_queue replaces the merge queue configuration when set.
+	MergeQueue *MergeQueueConfig `protobuf:"bytes,7,opt,name=merge_queue,json=mergeQueue,proto3" json:"merge_queue,omitempty"`
+	// worktree_policy replaces the worktree policy when set.
+	WorktreePolicy *WorktreePolicy `protobuf:"bytes,8,opt,name=worktree_policy,json=worktreePolicy,proto3" json:"worktree_policy,omitempty"`
+	// worktree_branch_template replaces the template when set; empty restores
+	// the default.
+	WorktreeBranchTemplate *string `protobuf:"bytes,9,opt,name=worktree_branch_template,json=worktreeBranchTemplate,proto3,oneof" json:"worktree_branch_template,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *UpdateProjectRequest) Reset() {
 	*x = UpdateProjectRequest{}
-	mi := &file_taskguild_v1_project_proto_msgTypes[7]
+	mi := &file_taskguild_v1_project_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -452,7 +645,7 @@ func (x *UpdateProjectRequest) String() string {
 func (*UpdateProjectRequest) ProtoMessage() {}
 
 func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_project_proto_msgTypes[7]
+	mi := &file_taskguild_v1_project_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,7 +658,7 @@ func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{7}
+	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateProjectRequest) GetId() string {
@@ -510,6 +703,27 @@ func (x *UpdateProjectRequest) GetHiddenFromSidebar() bool {
 	return false
 }
 
+func (x *UpdateProjectRequest) GetMergeQueue() *MergeQueueConfig {
+	if x != nil {
+		return x.MergeQueue
+	}
+	return nil
+}
+
+func (x *UpdateProjectRequest) GetWorktreePolicy() *WorktreePolicy {
+	if x != nil {
+		return x.WorktreePolicy
+	}
+	return nil
+}
+
+func (x *UpdateProjectRequest) GetWorktreeBranchTemplate() string {
+	if x != nil && x.WorktreeBranchTemplate != nil {
+		return *x.WorktreeBranchTemplate
+	}
+	return ""
+}
+
 type UpdateProjectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
@@ -519,7 +733,7 @@ type UpdateProjectResponse struct {
 
 func (x *UpdateProjectResponse) Reset() {
 	*x = UpdateProjectResponse{}
-	mi := &file_taskguild_v1_project_proto_msgTypes[8]
+	mi := &file_taskguild_v1_project_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -531,7 +745,7 @@ func (x *UpdateProjectResponse) String() string {
 func (*UpdateProjectResponse) ProtoMessage() {}
 
 func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_project_proto_msgTypes[8]
+	mi := &file_taskguild_v1_project_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,7 +758,7 @@ func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{8}
+	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateProjectResponse) GetProject() *Project {
@@ -563,7 +777,7 @@ type DeleteProjectRequest struct {
 
 func (x *DeleteProjectRequest) Reset() {
 	*x = DeleteProjectRequest{}
-	mi := &file_taskguild_v1_project_proto_msgTypes[9]
+	mi := &file_taskguild_v1_project_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -575,7 +789,7 @@ func (x *DeleteProjectRequest) String() string {
 func (*DeleteProjectRequest) ProtoMessage() {}
 
 func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_project_proto_msgTypes[9]
+	mi := &file_taskguild_v1_project_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -588,7 +802,7 @@ func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{9}
+	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteProjectRequest) GetId() string {
@@ -606,7 +820,7 @@ type DeleteProjectResponse struct {
 
 func (x *DeleteProjectResponse) Reset() {
 	*x = DeleteProjectResponse{}
-	mi := &file_taskguild_v1_project_proto_msgTypes[10]
+	mi := &file_taskguild_v1_project_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -618,7 +832,7 @@ func (x *DeleteProjectResponse) String() string {
 func (*DeleteProjectResponse) ProtoMessage() {}
 
 func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_project_proto_msgTypes[10]
+	mi := &file_taskguild_v1_project_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,7 +845,7 @@ func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{10}
+	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{12}
 }
 
 type ReorderProjectsRequest struct {
@@ -643,7 +857,7 @@ type ReorderProjectsRequest struct {
 
 func (x *ReorderProjectsRequest) Reset() {
 	*x = ReorderProjectsRequest{}
-	mi := &file_taskguild_v1_project_proto_msgTypes[11]
+	mi := &file_taskguild_v1_project_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -655,7 +869,7 @@ func (x *ReorderProjectsRequest) String() string {
 func (*ReorderProjectsRequest) ProtoMessage() {}
 
 func (x *ReorderProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_project_proto_msgTypes[11]
+	mi := &file_taskguild_v1_project_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,7 +882,7 @@ func (x *ReorderProjectsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReorderProjectsRequest.ProtoReflect.Descriptor instead.
 func (*ReorderProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{11}
+	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ReorderProjectsRequest) GetProjectIds() []string {
@@ -687,7 +901,7 @@ type ReorderProjectsResponse struct {
 
 func (x *ReorderProjectsResponse) Reset() {
 	*x = ReorderProjectsResponse{}
-	mi := &file_taskguild_v1_project_proto_msgTypes[12]
+	mi := &file_taskguild_v1_project_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -699,7 +913,7 @@ func (x *ReorderProjectsResponse) String() string {
 func (*ReorderProjectsResponse) ProtoMessage() {}
 
 func (x *ReorderProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_project_proto_msgTypes[12]
+	mi := &file_taskguild_v1_project_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,7 +926,7 @@ func (x *ReorderProjectsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReorderProjectsResponse.ProtoReflect.Descriptor instead.
 func (*ReorderProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{12}
+	return file_taskguild_v1_project_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReorderProjectsResponse) GetProjects() []*Project {
@@ -726,7 +940,7 @@ var File_taskguild_v1_project_proto protoreflect.FileDescriptor
 
 const file_taskguild_v1_project_proto_rawDesc = "" +
 	"\n" +
-	"\x1ataskguild/v1/project.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19taskguild/v1/common.proto\"\xd9\x02\n" +
+	"\x1ataskguild/v1/project.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19taskguild/v1/common.proto\"\x9b\x04\n" +
 	"\aProject\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -738,12 +952,29 @@ const file_taskguild_v1_project_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x14\n" +
 	"\x05order\x18\b \x01(\x05R\x05order\x12.\n" +
-	"\x13hidden_from_sidebar\x18\t \x01(\bR\x11hiddenFromSidebar\"\x9a\x01\n" +
+	"\x13hidden_from_sidebar\x18\t \x01(\bR\x11hiddenFromSidebar\x12?\n" +
+	"\vmerge_queue\x18\n" +
+	" \x01(\v2\x1e.taskguild.v1.MergeQueueConfigR\n" +
+	"mergeQueue\x12E\n" +
+	"\x0fworktree_policy\x18\v \x01(\v2\x1c.taskguild.v1.WorktreePolicyR\x0eworktreePolicy\x128\n" +
+	"\x18worktree_branch_template\x18\f \x01(\tR\x16worktreeBranchTemplate\"\x9c\x01\n" +
+	"\x0eWorktreePolicy\x12'\n" +
+	"\x0fdelete_archived\x18\x01 \x01(\bR\x0edeleteArchived\x127\n" +
+	"\x18delete_merged_after_days\x18\x02 \x01(\x05R\x15deleteMergedAfterDays\x12(\n" +
+	"\x10disk_quota_bytes\x18\x03 \x01(\x03R\x0ediskQuotaBytes\"i\n" +
+	"\x10MergeQueueConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12'\n" +
+	"\x0fverify_commands\x18\x02 \x03(\tR\x0everifyCommands\x12\x12\n" +
+	"\x04push\x18\x03 \x01(\bR\x04push\"\xdc\x02\n" +
 	"\x14CreateProjectRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12%\n" +
 	"\x0erepository_url\x18\x03 \x01(\tR\rrepositoryUrl\x12%\n" +
-	"\x0edefault_branch\x18\x04 \x01(\tR\rdefaultBranch\"H\n" +
+	"\x0edefault_branch\x18\x04 \x01(\tR\rdefaultBranch\x12?\n" +
+	"\vmerge_queue\x18\x05 \x01(\v2\x1e.taskguild.v1.MergeQueueConfigR\n" +
+	"mergeQueue\x12E\n" +
+	"\x0fworktree_policy\x18\x06 \x01(\v2\x1c.taskguild.v1.WorktreePolicyR\x0eworktreePolicy\x128\n" +
+	"\x18worktree_branch_template\x18\a \x01(\tR\x16worktreeBranchTemplate\"H\n" +
 	"\x15CreateProjectResponse\x12/\n" +
 	"\aproject\x18\x01 \x01(\v2\x15.taskguild.v1.ProjectR\aproject\"#\n" +
 	"\x11GetProjectRequest\x12\x0e\n" +
@@ -758,15 +989,20 @@ const file_taskguild_v1_project_proto_rawDesc = "" +
 	"\bprojects\x18\x01 \x03(\v2\x15.taskguild.v1.ProjectR\bprojects\x12@\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2 .taskguild.v1.PaginationResponseR\n" +
-	"pagination\"\xf7\x01\n" +
+	"pagination\"\xdb\x03\n" +
 	"\x14UpdateProjectRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12%\n" +
 	"\x0erepository_url\x18\x04 \x01(\tR\rrepositoryUrl\x12%\n" +
 	"\x0edefault_branch\x18\x05 \x01(\tR\rdefaultBranch\x123\n" +
-	"\x13hidden_from_sidebar\x18\x06 \x01(\bH\x00R\x11hiddenFromSidebar\x88\x01\x01B\x16\n" +
-	"\x14_hidden_from_sidebar\"H\n" +
+	"\x13hidden_from_sidebar\x18\x06 \x01(\bH\x00R\x11hiddenFromSidebar\x88\x01\x01\x12?\n" +
+	"\vmerge_queue\x18\a \x01(\v2\x1e.taskguild.v1.MergeQueueConfigR\n" +
+	"mergeQueue\x12E\n" +
+	"\x0fworktree_policy\x18\b \x01(\v2\x1c.taskguild.v1.WorktreePolicyR\x0eworktreePolicy\x12=\n" +
+	"\x18worktree_branch_template\x18\t \x01(\tH\x01R\x16worktreeBranchTemplate\x88\x01\x01B\x16\n" +
+	"\x14_hidden_from_sidebarB\x1b\n" +
+	"\x19_worktree_branch_template\"H\n" +
 	"\x15UpdateProjectResponse\x12/\n" +
 	"\aproject\x18\x01 \x01(\v2\x15.taskguild.v1.ProjectR\aproject\"&\n" +
 	"\x14DeleteProjectRequest\x12\x0e\n" +
@@ -799,52 +1035,60 @@ func file_taskguild_v1_project_proto_rawDescGZIP() []byte {
 	return file_taskguild_v1_project_proto_rawDescData
 }
 
-var file_taskguild_v1_project_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_taskguild_v1_project_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_taskguild_v1_project_proto_goTypes = []any{
 	(*Project)(nil),                 // 0: taskguild.v1.Project
-	(*CreateProjectRequest)(nil),    // 1: taskguild.v1.CreateProjectRequest
-	(*CreateProjectResponse)(nil),   // 2: taskguild.v1.CreateProjectResponse
-	(*GetProjectRequest)(nil),       // 3: taskguild.v1.GetProjectRequest
-	(*GetProjectResponse)(nil),      // 4: taskguild.v1.GetProjectResponse
-	(*ListProjectsRequest)(nil),     // 5: taskguild.v1.ListProjectsRequest
-	(*ListProjectsResponse)(nil),    // 6: taskguild.v1.ListProjectsResponse
-	(*UpdateProjectRequest)(nil),    // 7: taskguild.v1.UpdateProjectRequest
-	(*UpdateProjectResponse)(nil),   // 8: taskguild.v1.UpdateProjectResponse
-	(*DeleteProjectRequest)(nil),    // 9: taskguild.v1.DeleteProjectRequest
-	(*DeleteProjectResponse)(nil),   // 10: taskguild.v1.DeleteProjectResponse
-	(*ReorderProjectsRequest)(nil),  // 11: taskguild.v1.ReorderProjectsRequest
-	(*ReorderProjectsResponse)(nil), // 12: taskguild.v1.ReorderProjectsResponse
-	(*timestamppb.Timestamp)(nil),   // 13: google.protobuf.Timestamp
-	(*PaginationRequest)(nil),       // 14: taskguild.v1.PaginationRequest
-	(*PaginationResponse)(nil),      // 15: taskguild.v1.PaginationResponse
+	(*WorktreePolicy)(nil),          // 1: taskguild.v1.WorktreePolicy
+	(*MergeQueueConfig)(nil),        // 2: taskguild.v1.MergeQueueConfig
+	(*CreateProjectRequest)(nil),    // 3: taskguild.v1.CreateProjectRequest
+	(*CreateProjectResponse)(nil),   // 4: taskguild.v1.CreateProjectResponse
+	(*GetProjectRequest)(nil),       // 5: taskguild.v1.GetProjectRequest
+	(*GetProjectResponse)(nil),      // 6: taskguild.v1.GetProjectResponse
+	(*ListProjectsRequest)(nil),     // 7: taskguild.v1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),    // 8: taskguild.v1.ListProjectsResponse
+	(*UpdateProjectRequest)(nil),    // 9: taskguild.v1.UpdateProjectRequest
+	(*UpdateProjectResponse)(nil),   // 10: taskguild.v1.UpdateProjectResponse
+	(*DeleteProjectRequest)(nil),    // 11: taskguild.v1.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil),   // 12: taskguild.v1.DeleteProjectResponse
+	(*ReorderProjectsRequest)(nil),  // 13: taskguild.v1.ReorderProjectsRequest
+	(*ReorderProjectsResponse)(nil), // 14: taskguild.v1.ReorderProjectsResponse
+	(*timestamppb.Timestamp)(nil),   // 15: google.protobuf.Timestamp
+	(*PaginationRequest)(nil),       // 16: taskguild.v1.PaginationRequest
+	(*PaginationResponse)(nil),      // 17: taskguild.v1.PaginationResponse
 }
 var file_taskguild_v1_project_proto_depIdxs = []int32{
-	13, // 0: taskguild.v1.Project.created_at:type_name -> google.protobuf.Timestamp
-	13, // 1: taskguild.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: taskguild.v1.CreateProjectResponse.project:type_name -> taskguild.v1.Project
-	0,  // 3: taskguild.v1.GetProjectResponse.project:type_name -> taskguild.v1.Project
-	14, // 4: taskguild.v1.ListProjectsRequest.pagination:type_name -> taskguild.v1.PaginationRequest
-	0,  // 5: taskguild.v1.ListProjectsResponse.projects:type_name -> taskguild.v1.Project
-	15, // 6: taskguild.v1.ListProjectsResponse.pagination:type_name -> taskguild.v1.PaginationResponse
-	0,  // 7: taskguild.v1.UpdateProjectResponse.project:type_name -> taskguild.v1.Project
-	0,  // 8: taskguild.v1.ReorderProjectsResponse.projects:type_name -> taskguild.v1.Project
-	1,  // 9: taskguild.v1.ProjectService.CreateProject:input_type -> taskguild.v1.CreateProjectRequest
-	3,  // 10: taskguild.v1.ProjectService.GetProject:input_type -> taskguild.v1.GetProjectRequest
-	5,  // 11: taskguild.v1.ProjectService.ListProjects:input_type -> taskguild.v1.ListProjectsRequest
-	7,  // 12: taskguild.v1.ProjectService.UpdateProject:input_type -> taskguild.v1.UpdateProjectRequest
-	9,  // 13: taskguild.v1.ProjectService.DeleteProject:input_type -> taskguild.v1.DeleteProjectRequest
-	11, // 14: taskguild.v1.ProjectService.ReorderProjects:input_type -> taskguild.v1.ReorderProjectsRequest
-	2,  // 15: taskguild.v1.ProjectService.CreateProject:output_type -> taskguild.v1.CreateProjectResponse
-	4,  // 16: taskguild.v1.ProjectService.GetProject:output_type -> taskguild.v1.GetProjectResponse
-	6,  // 17: taskguild.v1.ProjectService.ListProjects:output_type -> taskguild.v1.ListProjectsResponse
-	8,  // 18: taskguild.v1.ProjectService.UpdateProject:output_type -> taskguild.v1.UpdateProjectResponse
-	10, // 19: taskguild.v1.ProjectService.DeleteProject:output_type -> taskguild.v1.DeleteProjectResponse
-	12, // 20: taskguild.v1.ProjectService.ReorderProjects:output_type -> taskguild.v1.ReorderProjectsResponse
-	15, // [15:21] is the sub-list for method output_type
-	9,  // [9:15] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	15, // 0: taskguild.v1.Project.created_at:type_name -> google.protobuf.Timestamp
+	15, // 1: taskguild.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 2: taskguild.v1.Project.merge_queue:type_name -> taskguild.v1.MergeQueueConfig
+	1,  // 3: taskguild.v1.Project.worktree_policy:type_name -> taskguild.v1.WorktreePolicy
+	2,  // 4: taskguild.v1.CreateProjectRequest.merge_queue:type_name -> taskguild.v1.MergeQueueConfig
+	1,  // 5: taskguild.v1.CreateProjectRequest.worktree_policy:type_name -> taskguild.v1.WorktreePolicy
+	0,  // 6: taskguild.v1.CreateProjectResponse.project:type_name -> taskguild.v1.Project
+	0,  // 7: taskguild.v1.GetProjectResponse.project:type_name -> taskguild.v1.Project
+	16, // 8: taskguild.v1.ListProjectsRequest.pagination:type_name -> taskguild.v1.PaginationRequest
+	0,  // 9: taskguild.v1.ListProjectsResponse.projects:type_name -> taskguild.v1.Project
+	17, // 10: taskguild.v1.ListProjectsResponse.pagination:type_name -> taskguild.v1.PaginationResponse
+	2,  // 11: taskguild.v1.UpdateProjectRequest.merge_queue:type_name -> taskguild.v1.MergeQueueConfig
+	1,  // 12: taskguild.v1.UpdateProjectRequest.worktree_policy:type_name -> taskguild.v1.WorktreePolicy
+	0,  // 13: taskguild.v1.UpdateProjectResponse.project:type_name -> taskguild.v1.Project
+	0,  // 14: taskguild.v1.ReorderProjectsResponse.projects:type_name -> taskguild.v1.Project
+	3,  // 15: taskguild.v1.ProjectService.CreateProject:input_type -> taskguild.v1.CreateProjectRequest
+	5,  // 16: taskguild.v1.ProjectService.GetProject:input_type -> taskguild.v1.GetProjectRequest
+	7,  // 17: taskguild.v1.ProjectService.ListProjects:input_type -> taskguild.v1.ListProjectsRequest
+	9,  // 18: taskguild.v1.ProjectService.UpdateProject:input_type -> taskguild.v1.UpdateProjectRequest
+	11, // 19: taskguild.v1.ProjectService.DeleteProject:input_type -> taskguild.v1.DeleteProjectRequest
+	13, // 20: taskguild.v1.ProjectService.ReorderProjects:input_type -> taskguild.v1.ReorderProjectsRequest
+	4,  // 21: taskguild.v1.ProjectService.CreateProject:output_type -> taskguild.v1.CreateProjectResponse
+	6,  // 22: taskguild.v1.ProjectService.GetProject:output_type -> taskguild.v1.GetProjectResponse
+	8,  // 23: taskguild.v1.ProjectService.ListProjects:output_type -> taskguild.v1.ListProjectsResponse
+	10, // 24: taskguild.v1.ProjectService.UpdateProject:output_type -> taskguild.v1.UpdateProjectResponse
+	12, // 25: taskguild.v1.ProjectService.DeleteProject:output_type -> taskguild.v1.DeleteProjectResponse
+	14, // 26: taskguild.v1.ProjectService.ReorderProjects:output_type -> taskguild.v1.ReorderProjectsResponse
+	21, // [21:27] is the sub-list for method output_type
+	15, // [15:21] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_taskguild_v1_project_proto_init() }
@@ -853,14 +1097,14 @@ func file_taskguild_v1_project_proto_init() {
 		return
 	}
 	file_taskguild_v1_common_proto_init()
-	file_taskguild_v1_project_proto_msgTypes[7].OneofWrappers = []any{}
+	file_taskguild_v1_project_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_taskguild_v1_project_proto_rawDesc), len(file_taskguild_v1_project_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -47,10 +47,13 @@ type Schedule struct {
 	NextRunAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
 	LastError string                 `protobuf:"bytes,16,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
 	// timestamps
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// worktree base of the created tasks (see Task.worktree_base_ref).
+	WorktreeBaseRef    string `protobuf:"bytes,19,opt,name=worktree_base_ref,json=worktreeBaseRef,proto3" json:"worktree_base_ref,omitempty"`
+	WorktreeBaseTaskId string `protobuf:"bytes,20,opt,name=worktree_base_task_id,json=worktreeBaseTaskId,proto3" json:"worktree_base_task_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Schedule) Reset() {
@@ -209,22 +212,38 @@ func (x *Schedule) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Schedule) GetWorktreeBaseRef() string {
+	if x != nil {
+		return x.WorktreeBaseRef
+	}
+	return ""
+}
+
+func (x *Schedule) GetWorktreeBaseTaskId() string {
+	if x != nil {
+		return x.WorktreeBaseTaskId
+	}
+	return ""
+}
+
 type CreateScheduleRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId       string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	WorkflowId      string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	CronExpression  string                 `protobuf:"bytes,5,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
-	Enabled         bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	TaskTitle       string                 `protobuf:"bytes,7,opt,name=task_title,json=taskTitle,proto3" json:"task_title,omitempty"`
-	TaskDescription string                 `protobuf:"bytes,8,opt,name=task_description,json=taskDescription,proto3" json:"task_description,omitempty"`
-	StatusId        *string                `protobuf:"bytes,9,opt,name=status_id,json=statusId,proto3,oneof" json:"status_id,omitempty"`
-	UseWorktree     bool                   `protobuf:"varint,10,opt,name=use_worktree,json=useWorktree,proto3" json:"use_worktree,omitempty"`
-	Effort          string                 `protobuf:"bytes,11,opt,name=effort,proto3" json:"effort,omitempty"`
-	TaskMetadata    map[string]string      `protobuf:"bytes,12,rep,name=task_metadata,json=taskMetadata,proto3" json:"task_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId          string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	WorkflowId         string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CronExpression     string                 `protobuf:"bytes,5,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	Enabled            bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	TaskTitle          string                 `protobuf:"bytes,7,opt,name=task_title,json=taskTitle,proto3" json:"task_title,omitempty"`
+	TaskDescription    string                 `protobuf:"bytes,8,opt,name=task_description,json=taskDescription,proto3" json:"task_description,omitempty"`
+	StatusId           *string                `protobuf:"bytes,9,opt,name=status_id,json=statusId,proto3,oneof" json:"status_id,omitempty"`
+	UseWorktree        bool                   `protobuf:"varint,10,opt,name=use_worktree,json=useWorktree,proto3" json:"use_worktree,omitempty"`
+	Effort             string                 `protobuf:"bytes,11,opt,name=effort,proto3" json:"effort,omitempty"`
+	TaskMetadata       map[string]string      `protobuf:"bytes,12,rep,name=task_metadata,json=taskMetadata,proto3" json:"task_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	WorktreeBaseRef    string                 `protobuf:"bytes,13,opt,name=worktree_base_ref,json=worktreeBaseRef,proto3" json:"worktree_base_ref,omitempty"`
+	WorktreeBaseTaskId string                 `protobuf:"bytes,14,opt,name=worktree_base_task_id,json=worktreeBaseTaskId,proto3" json:"worktree_base_task_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *CreateScheduleRequest) Reset() {
@@ -341,6 +360,20 @@ func (x *CreateScheduleRequest) GetTaskMetadata() map[string]string {
 	return nil
 }
 
+func (x *CreateScheduleRequest) GetWorktreeBaseRef() string {
+	if x != nil {
+		return x.WorktreeBaseRef
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetWorktreeBaseTaskId() string {
+	if x != nil {
+		return x.WorktreeBaseTaskId
+	}
+	return ""
+}
+
 type CreateScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
@@ -578,20 +611,22 @@ func (x *ListSchedulesResponse) GetPagination() *PaginationResponse {
 }
 
 type UpdateScheduleRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	WorkflowId      string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	CronExpression  string                 `protobuf:"bytes,5,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
-	TaskTitle       string                 `protobuf:"bytes,6,opt,name=task_title,json=taskTitle,proto3" json:"task_title,omitempty"`
-	TaskDescription string                 `protobuf:"bytes,7,opt,name=task_description,json=taskDescription,proto3" json:"task_description,omitempty"`
-	StatusId        *string                `protobuf:"bytes,8,opt,name=status_id,json=statusId,proto3,oneof" json:"status_id,omitempty"`
-	UseWorktree     *bool                  `protobuf:"varint,9,opt,name=use_worktree,json=useWorktree,proto3,oneof" json:"use_worktree,omitempty"`
-	Effort          string                 `protobuf:"bytes,10,opt,name=effort,proto3" json:"effort,omitempty"`
-	TaskMetadata    map[string]string      `protobuf:"bytes,11,rep,name=task_metadata,json=taskMetadata,proto3" json:"task_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkflowId         string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CronExpression     string                 `protobuf:"bytes,5,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	TaskTitle          string                 `protobuf:"bytes,6,opt,name=task_title,json=taskTitle,proto3" json:"task_title,omitempty"`
+	TaskDescription    string                 `protobuf:"bytes,7,opt,name=task_description,json=taskDescription,proto3" json:"task_description,omitempty"`
+	StatusId           *string                `protobuf:"bytes,8,opt,name=status_id,json=statusId,proto3,oneof" json:"status_id,omitempty"`
+	UseWorktree        *bool                  `protobuf:"varint,9,opt,name=use_worktree,json=useWorktree,proto3,oneof" json:"use_worktree,omitempty"`
+	Effort             string                 `protobuf:"bytes,10,opt,name=effort,proto3" json:"effort,omitempty"`
+	TaskMetadata       map[string]string      `protobuf:"bytes,11,rep,name=task_metadata,json=taskMetadata,proto3" json:"task_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	WorktreeBaseRef    *string                `protobuf:"bytes,12,opt,name=worktree_base_ref,json=worktreeBaseRef,proto3,oneof" json:"worktree_base_ref,omitempty"`
+	WorktreeBaseTaskId *string                `protobuf:"bytes,13,opt,name=worktree_base_task_id,json=worktreeBaseTaskId,proto3,oneof" json:"worktree_base_task_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *UpdateScheduleRequest) Reset() {
@@ -701,6 +736,20 @@ func (x *UpdateScheduleRequest) GetTaskMetadata() map[string]string {
 	return nil
 }
 
+func (x *UpdateScheduleRequest) GetWorktreeBaseRef() string {
+	if x != nil && x.WorktreeBaseRef != nil {
+		return *x.WorktreeBaseRef
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetWorktreeBaseTaskId() string {
+	if x != nil && x.WorktreeBaseTaskId != nil {
+		return *x.WorktreeBaseTaskId
+	}
+	return ""
+}
+
 type UpdateScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
@@ -925,7 +974,7 @@ var File_taskguild_v1_schedule_proto protoreflect.FileDescriptor
 
 const file_taskguild_v1_schedule_proto_rawDesc = "" +
 	"\n" +
-	"\x1btaskguild/v1/schedule.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19taskguild/v1/common.proto\"\x92\x06\n" +
+	"\x1btaskguild/v1/schedule.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19taskguild/v1/common.proto\"\xf1\x06\n" +
 	"\bSchedule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -951,10 +1000,12 @@ const file_taskguild_v1_schedule_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a?\n" +
+	"updated_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12*\n" +
+	"\x11worktree_base_ref\x18\x13 \x01(\tR\x0fworktreeBaseRef\x121\n" +
+	"\x15worktree_base_task_id\x18\x14 \x01(\tR\x12worktreeBaseTaskId\x1a?\n" +
 	"\x11TaskMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa2\x04\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x81\x05\n" +
 	"\x15CreateScheduleRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1f\n" +
@@ -971,7 +1022,9 @@ const file_taskguild_v1_schedule_proto_rawDesc = "" +
 	"\fuse_worktree\x18\n" +
 	" \x01(\bR\vuseWorktree\x12\x16\n" +
 	"\x06effort\x18\v \x01(\tR\x06effort\x12Z\n" +
-	"\rtask_metadata\x18\f \x03(\v25.taskguild.v1.CreateScheduleRequest.TaskMetadataEntryR\ftaskMetadata\x1a?\n" +
+	"\rtask_metadata\x18\f \x03(\v25.taskguild.v1.CreateScheduleRequest.TaskMetadataEntryR\ftaskMetadata\x12*\n" +
+	"\x11worktree_base_ref\x18\r \x01(\tR\x0fworktreeBaseRef\x121\n" +
+	"\x15worktree_base_task_id\x18\x0e \x01(\tR\x12worktreeBaseTaskId\x1a?\n" +
 	"\x11TaskMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
@@ -993,7 +1046,7 @@ const file_taskguild_v1_schedule_proto_rawDesc = "" +
 	"\tschedules\x18\x01 \x03(\v2\x16.taskguild.v1.ScheduleR\tschedules\x12@\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2 .taskguild.v1.PaginationResponseR\n" +
-	"pagination\"\x8f\x04\n" +
+	"pagination\"\xa8\x05\n" +
 	"\x15UpdateScheduleRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vworkflow_id\x18\x02 \x01(\tR\n" +
@@ -1008,13 +1061,17 @@ const file_taskguild_v1_schedule_proto_rawDesc = "" +
 	"\fuse_worktree\x18\t \x01(\bH\x01R\vuseWorktree\x88\x01\x01\x12\x16\n" +
 	"\x06effort\x18\n" +
 	" \x01(\tR\x06effort\x12Z\n" +
-	"\rtask_metadata\x18\v \x03(\v25.taskguild.v1.UpdateScheduleRequest.TaskMetadataEntryR\ftaskMetadata\x1a?\n" +
+	"\rtask_metadata\x18\v \x03(\v25.taskguild.v1.UpdateScheduleRequest.TaskMetadataEntryR\ftaskMetadata\x12/\n" +
+	"\x11worktree_base_ref\x18\f \x01(\tH\x02R\x0fworktreeBaseRef\x88\x01\x01\x126\n" +
+	"\x15worktree_base_task_id\x18\r \x01(\tH\x03R\x12worktreeBaseTaskId\x88\x01\x01\x1a?\n" +
 	"\x11TaskMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
 	"\n" +
 	"_status_idB\x0f\n" +
-	"\r_use_worktree\"L\n" +
+	"\r_use_worktreeB\x14\n" +
+	"\x12_worktree_base_refB\x18\n" +
+	"\x16_worktree_base_task_id\"L\n" +
 	"\x16UpdateScheduleResponse\x122\n" +
 	"\bschedule\x18\x01 \x01(\v2\x16.taskguild.v1.ScheduleR\bschedule\"'\n" +
 	"\x15DeleteScheduleRequest\x12\x0e\n" +
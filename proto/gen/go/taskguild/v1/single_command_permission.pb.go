@@ -24,12 +24,33 @@ const (
 
 // SingleCommandPermission represents a single regex-based permission rule.
 type SingleCommandPermission struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Pattern       string                 `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"` // regex pattern (e.g. "^git\\s+status$")
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`       // "command" or "redirect"
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Pattern   string                 `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"` // regex pattern (e.g. "^git\\s+status$")
+	Type      string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`       // "command" or "redirect"
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// action is "allow" (default when empty) or "deny". Deny rules win over
+	// allow rules and reject the command without asking.
+	Action string `protobuf:"bytes,7,opt,name=action,proto3" json:"action,omitempty"`
+	// reason is shown to the agent when a deny rule rejects a command.
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	// scope limits where an allow rule applies: "project" (default), "task"
+	// or "status". Task and status grants are created from permission requests.
+	Scope string `protobuf:"bytes,9,opt,name=scope,proto3" json:"scope,omitempty"`
+	// task_id is the task a task-scoped grant applies to.
+	TaskId string `protobuf:"bytes,10,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// workflow_id and status_name identify the status a status-scoped grant
+	// applies to.
+	WorkflowId string `protobuf:"bytes,11,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	StatusName string `protobuf:"bytes,12,opt,name=status_name,json=statusName,proto3" json:"status_name,omitempty"`
+	// expires_at, when set, is when the grant stops applying. Expired grants
+	// are pruned automatically.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// origin_task_id is the task whose permission request created the grant.
+	OriginTaskId string `protobuf:"bytes,14,opt,name=origin_task_id,json=originTaskId,proto3" json:"origin_task_id,omitempty"`
+	// granted_by names who approved the permission request.
+	GrantedBy     string `protobuf:"bytes,15,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -99,6 +120,69 @@ func (x *SingleCommandPermission) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SingleCommandPermission) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SingleCommandPermission) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SingleCommandPermission) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *SingleCommandPermission) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *SingleCommandPermission) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *SingleCommandPermission) GetStatusName() string {
+	if x != nil {
+		return x.StatusName
+	}
+	return ""
+}
+
+func (x *SingleCommandPermission) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *SingleCommandPermission) GetOriginTaskId() string {
+	if x != nil {
+		return x.OriginTaskId
+	}
+	return ""
+}
+
+func (x *SingleCommandPermission) GetGrantedBy() string {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return ""
+}
+
 type ListSingleCommandPermissionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
@@ -188,10 +272,16 @@ func (x *ListSingleCommandPermissionsResponse) GetPermissions() []*SingleCommand
 }
 
 type CreateSingleCommandPermissionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Pattern       string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Pattern   string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Type      string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// action is "allow" or "deny". When unset, a new rule allows and an
+	// existing rule keeps its action.
+	Action *string `protobuf:"bytes,5,opt,name=action,proto3,oneof" json:"action,omitempty"`
+	// reason is shown when a deny rule blocks a command. When unset, an
+	// existing rule keeps its reason.
+	Reason        *string `protobuf:"bytes,6,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -247,6 +337,20 @@ func (x *CreateSingleCommandPermissionRequest) GetType() string {
 	return ""
 }
 
+func (x *CreateSingleCommandPermissionRequest) GetAction() string {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return ""
+}
+
+func (x *CreateSingleCommandPermissionRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
 type CreateSingleCommandPermissionResponse struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Permission    *SingleCommandPermission `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"`
@@ -292,10 +396,13 @@ func (x *CreateSingleCommandPermissionResponse) GetPermission() *SingleCommandPe
 }
 
 type UpdateSingleCommandPermissionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Pattern       string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pattern string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Type    string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// action and reason keep their stored values when unset.
+	Action        *string `protobuf:"bytes,5,opt,name=action,proto3,oneof" json:"action,omitempty"`
+	Reason        *string `protobuf:"bytes,6,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -351,6 +458,20 @@ func (x *UpdateSingleCommandPermissionRequest) GetType() string {
 	return ""
 }
 
+func (x *UpdateSingleCommandPermissionRequest) GetAction() string {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return ""
+}
+
+func (x *UpdateSingleCommandPermissionRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
 type UpdateSingleCommandPermissionResponse struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Permission    *SingleCommandPermission `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"`
@@ -475,11 +596,196 @@ func (*DeleteSingleCommandPermissionResponse) Descriptor() ([]byte, []int) {
 	return file_taskguild_v1_single_command_permission_proto_rawDescGZIP(), []int{8}
 }
 
+// PermissionSuggestion is a rule proposed from repeated manual approvals.
+type PermissionSuggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pattern       string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`      // wildcard pattern covering the approved variants
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`            // "command"
+	Approvals     int32                  `protobuf:"varint,3,opt,name=approvals,proto3" json:"approvals,omitempty"` // number of approvals the pattern covers
+	Examples      []string               `protobuf:"bytes,4,rep,name=examples,proto3" json:"examples,omitempty"`    // distinct approved commands, most frequent first
+	Risk          string                 `protobuf:"bytes,5,opt,name=risk,proto3" json:"risk,omitempty"`            // highest risk level among the examples
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionSuggestion) Reset() {
+	*x = PermissionSuggestion{}
+	mi := &file_taskguild_v1_single_command_permission_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionSuggestion) ProtoMessage() {}
+
+func (x *PermissionSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_single_command_permission_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionSuggestion.ProtoReflect.Descriptor instead.
+func (*PermissionSuggestion) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_single_command_permission_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PermissionSuggestion) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *PermissionSuggestion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PermissionSuggestion) GetApprovals() int32 {
+	if x != nil {
+		return x.Approvals
+	}
+	return 0
+}
+
+func (x *PermissionSuggestion) GetExamples() []string {
+	if x != nil {
+		return x.Examples
+	}
+	return nil
+}
+
+func (x *PermissionSuggestion) GetRisk() string {
+	if x != nil {
+		return x.Risk
+	}
+	return ""
+}
+
+type ListPermissionSuggestionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// min_approvals is the number of approvals needed before a command is
+	// suggested. Defaults to 3.
+	MinApprovals  int32 `protobuf:"varint,2,opt,name=min_approvals,json=minApprovals,proto3" json:"min_approvals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPermissionSuggestionsRequest) Reset() {
+	*x = ListPermissionSuggestionsRequest{}
+	mi := &file_taskguild_v1_single_command_permission_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPermissionSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPermissionSuggestionsRequest) ProtoMessage() {}
+
+func (x *ListPermissionSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_single_command_permission_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPermissionSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPermissionSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_single_command_permission_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListPermissionSuggestionsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListPermissionSuggestionsRequest) GetMinApprovals() int32 {
+	if x != nil {
+		return x.MinApprovals
+	}
+	return 0
+}
+
+type ListPermissionSuggestionsResponse struct {
+	state       protoimpl.MessageState  `protogen:"open.v1"`
+	Suggestions []*PermissionSuggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	// unused_rules are allow rules that matched no command in the retained
+	// history.
+	UnusedRules   []*SingleCommandPermission `protobuf:"bytes,2,rep,name=unused_rules,json=unusedRules,proto3" json:"unused_rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPermissionSuggestionsResponse) Reset() {
+	*x = ListPermissionSuggestionsResponse{}
+	mi := &file_taskguild_v1_single_command_permission_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPermissionSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPermissionSuggestionsResponse) ProtoMessage() {}
+
+func (x *ListPermissionSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_single_command_permission_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPermissionSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPermissionSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_single_command_permission_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListPermissionSuggestionsResponse) GetSuggestions() []*PermissionSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *ListPermissionSuggestionsResponse) GetUnusedRules() []*SingleCommandPermission {
+	if x != nil {
+		return x.UnusedRules
+	}
+	return nil
+}
+
 var File_taskguild_v1_single_command_permission_proto protoreflect.FileDescriptor
 
 const file_taskguild_v1_single_command_permission_proto_rawDesc = "" +
 	"\n" +
-	",taskguild/v1/single_command_permission.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb7\x01\n" +
+	",taskguild/v1/single_command_permission.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd8\x03\n" +
 	"\x17SingleCommandPermission\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -487,37 +793,73 @@ const file_taskguild_v1_single_command_permission_proto_rawDesc = "" +
 	"\apattern\x18\x03 \x01(\tR\apattern\x12\x12\n" +
 	"\x04type\x18\x04 \x01(\tR\x04type\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtJ\x04\b\x05\x10\x06\"D\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
+	"\x06action\x18\a \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12\x14\n" +
+	"\x05scope\x18\t \x01(\tR\x05scope\x12\x17\n" +
+	"\atask_id\x18\n" +
+	" \x01(\tR\x06taskId\x12\x1f\n" +
+	"\vworkflow_id\x18\v \x01(\tR\n" +
+	"workflowId\x12\x1f\n" +
+	"\vstatus_name\x18\f \x01(\tR\n" +
+	"statusName\x129\n" +
+	"\n" +
+	"expires_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12$\n" +
+	"\x0eorigin_task_id\x18\x0e \x01(\tR\foriginTaskId\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\x0f \x01(\tR\tgrantedByJ\x04\b\x05\x10\x06\"D\n" +
 	"#ListSingleCommandPermissionsRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\"o\n" +
 	"$ListSingleCommandPermissionsResponse\x12G\n" +
-	"\vpermissions\x18\x01 \x03(\v2%.taskguild.v1.SingleCommandPermissionR\vpermissions\"y\n" +
+	"\vpermissions\x18\x01 \x03(\v2%.taskguild.v1.SingleCommandPermissionR\vpermissions\"\xc9\x01\n" +
 	"$CreateSingleCommandPermissionRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x18\n" +
 	"\apattern\x18\x02 \x01(\tR\apattern\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04typeJ\x04\b\x04\x10\x05\"n\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1b\n" +
+	"\x06action\x18\x05 \x01(\tH\x00R\x06action\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\x06 \x01(\tH\x01R\x06reason\x88\x01\x01B\t\n" +
+	"\a_actionB\t\n" +
+	"\a_reasonJ\x04\b\x04\x10\x05\"n\n" +
 	"%CreateSingleCommandPermissionResponse\x12E\n" +
 	"\n" +
 	"permission\x18\x01 \x01(\v2%.taskguild.v1.SingleCommandPermissionR\n" +
-	"permission\"j\n" +
+	"permission\"\xba\x01\n" +
 	"$UpdateSingleCommandPermissionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\apattern\x18\x02 \x01(\tR\apattern\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04typeJ\x04\b\x04\x10\x05\"n\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1b\n" +
+	"\x06action\x18\x05 \x01(\tH\x00R\x06action\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\x06 \x01(\tH\x01R\x06reason\x88\x01\x01B\t\n" +
+	"\a_actionB\t\n" +
+	"\a_reasonJ\x04\b\x04\x10\x05\"n\n" +
 	"%UpdateSingleCommandPermissionResponse\x12E\n" +
 	"\n" +
 	"permission\x18\x01 \x01(\v2%.taskguild.v1.SingleCommandPermissionR\n" +
 	"permission\"6\n" +
 	"$DeleteSingleCommandPermissionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"'\n" +
-	"%DeleteSingleCommandPermissionResponse2\xc9\x04\n" +
+	"%DeleteSingleCommandPermissionResponse\"\x92\x01\n" +
+	"\x14PermissionSuggestion\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1c\n" +
+	"\tapprovals\x18\x03 \x01(\x05R\tapprovals\x12\x1a\n" +
+	"\bexamples\x18\x04 \x03(\tR\bexamples\x12\x12\n" +
+	"\x04risk\x18\x05 \x01(\tR\x04risk\"f\n" +
+	" ListPermissionSuggestionsRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12#\n" +
+	"\rmin_approvals\x18\x02 \x01(\x05R\fminApprovals\"\xb3\x01\n" +
+	"!ListPermissionSuggestionsResponse\x12D\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\".taskguild.v1.PermissionSuggestionR\vsuggestions\x12H\n" +
+	"\funused_rules\x18\x02 \x03(\v2%.taskguild.v1.SingleCommandPermissionR\vunusedRules2\xc7\x05\n" +
 	"\x1eSingleCommandPermissionService\x12\x85\x01\n" +
 	"\x1cListSingleCommandPermissions\x121.taskguild.v1.ListSingleCommandPermissionsRequest\x1a2.taskguild.v1.ListSingleCommandPermissionsResponse\x12\x88\x01\n" +
 	"\x1dCreateSingleCommandPermission\x122.taskguild.v1.CreateSingleCommandPermissionRequest\x1a3.taskguild.v1.CreateSingleCommandPermissionResponse\x12\x88\x01\n" +
 	"\x1dUpdateSingleCommandPermission\x122.taskguild.v1.UpdateSingleCommandPermissionRequest\x1a3.taskguild.v1.UpdateSingleCommandPermissionResponse\x12\x88\x01\n" +
-	"\x1dDeleteSingleCommandPermission\x122.taskguild.v1.DeleteSingleCommandPermissionRequest\x1a3.taskguild.v1.DeleteSingleCommandPermissionResponseB\xc5\x01\n" +
+	"\x1dDeleteSingleCommandPermission\x122.taskguild.v1.DeleteSingleCommandPermissionRequest\x1a3.taskguild.v1.DeleteSingleCommandPermissionResponse\x12|\n" +
+	"\x19ListPermissionSuggestions\x12..taskguild.v1.ListPermissionSuggestionsRequest\x1a/.taskguild.v1.ListPermissionSuggestionsResponseB\xc5\x01\n" +
 	"\x10com.taskguild.v1B\x1cSingleCommandPermissionProtoP\x01ZBgithub.com/kazz187/taskguild/proto/gen/go/taskguild/v1;taskguildv1\xa2\x02\x03TXX\xaa\x02\fTaskguild.V1\xca\x02\fTaskguild\\V1\xe2\x02\x18Taskguild\\V1\\GPBMetadata\xea\x02\rTaskguild::V1b\x06proto3"
 
 var (
@@ -532,7 +874,7 @@ func file_taskguild_v1_single_command_permission_proto_rawDescGZIP() []byte {
 	return file_taskguild_v1_single_command_permission_proto_rawDescData
 }
 
-var file_taskguild_v1_single_command_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_taskguild_v1_single_command_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_taskguild_v1_single_command_permission_proto_goTypes = []any{
 	(*SingleCommandPermission)(nil),               // 0: taskguild.v1.SingleCommandPermission
 	(*ListSingleCommandPermissionsRequest)(nil),   // 1: taskguild.v1.ListSingleCommandPermissionsRequest
@@ -543,26 +885,34 @@ var file_taskguild_v1_single_command_permission_proto_goTypes = []any{
 	(*UpdateSingleCommandPermissionResponse)(nil), // 6: taskguild.v1.UpdateSingleCommandPermissionResponse
 	(*DeleteSingleCommandPermissionRequest)(nil),  // 7: taskguild.v1.DeleteSingleCommandPermissionRequest
 	(*DeleteSingleCommandPermissionResponse)(nil), // 8: taskguild.v1.DeleteSingleCommandPermissionResponse
-	(*timestamppb.Timestamp)(nil),                 // 9: google.protobuf.Timestamp
+	(*PermissionSuggestion)(nil),                  // 9: taskguild.v1.PermissionSuggestion
+	(*ListPermissionSuggestionsRequest)(nil),      // 10: taskguild.v1.ListPermissionSuggestionsRequest
+	(*ListPermissionSuggestionsResponse)(nil),     // 11: taskguild.v1.ListPermissionSuggestionsResponse
+	(*timestamppb.Timestamp)(nil),                 // 12: google.protobuf.Timestamp
 }
 var file_taskguild_v1_single_command_permission_proto_depIdxs = []int32{
-	9, // 0: taskguild.v1.SingleCommandPermission.created_at:type_name -> google.protobuf.Timestamp
-	0, // 1: taskguild.v1.ListSingleCommandPermissionsResponse.permissions:type_name -> taskguild.v1.SingleCommandPermission
-	0, // 2: taskguild.v1.CreateSingleCommandPermissionResponse.permission:type_name -> taskguild.v1.SingleCommandPermission
-	0, // 3: taskguild.v1.UpdateSingleCommandPermissionResponse.permission:type_name -> taskguild.v1.SingleCommandPermission
-	1, // 4: taskguild.v1.SingleCommandPermissionService.ListSingleCommandPermissions:input_type -> taskguild.v1.ListSingleCommandPermissionsRequest
-	3, // 5: taskguild.v1.SingleCommandPermissionService.CreateSingleCommandPermission:input_type -> taskguild.v1.CreateSingleCommandPermissionRequest
-	5, // 6: taskguild.v1.SingleCommandPermissionService.UpdateSingleCommandPermission:input_type -> taskguild.v1.UpdateSingleCommandPermissionRequest
-	7, // 7: taskguild.v1.SingleCommandPermissionService.DeleteSingleCommandPermission:input_type -> taskguild.v1.DeleteSingleCommandPermissionRequest
-	2, // 8: taskguild.v1.SingleCommandPermissionService.ListSingleCommandPermissions:output_type -> taskguild.v1.ListSingleCommandPermissionsResponse
-	4, // 9: taskguild.v1.SingleCommandPermissionService.CreateSingleCommandPermission:output_type -> taskguild.v1.CreateSingleCommandPermissionResponse
-	6, // 10: taskguild.v1.SingleCommandPermissionService.UpdateSingleCommandPermission:output_type -> taskguild.v1.UpdateSingleCommandPermissionResponse
-	8, // 11: taskguild.v1.SingleCommandPermissionService.DeleteSingleCommandPermission:output_type -> taskguild.v1.DeleteSingleCommandPermissionResponse
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	12, // 0: taskguild.v1.SingleCommandPermission.created_at:type_name -> google.protobuf.Timestamp
+	12, // 1: taskguild.v1.SingleCommandPermission.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: taskguild.v1.ListSingleCommandPermissionsResponse.permissions:type_name -> taskguild.v1.SingleCommandPermission
+	0,  // 3: taskguild.v1.CreateSingleCommandPermissionResponse.permission:type_name -> taskguild.v1.SingleCommandPermission
+	0,  // 4: taskguild.v1.UpdateSingleCommandPermissionResponse.permission:type_name -> taskguild.v1.SingleCommandPermission
+	9,  // 5: taskguild.v1.ListPermissionSuggestionsResponse.suggestions:type_name -> taskguild.v1.PermissionSuggestion
+	0,  // 6: taskguild.v1.ListPermissionSuggestionsResponse.unused_rules:type_name -> taskguild.v1.SingleCommandPermission
+	1,  // 7: taskguild.v1.SingleCommandPermissionService.ListSingleCommandPermissions:input_type -> taskguild.v1.ListSingleCommandPermissionsRequest
+	3,  // 8: taskguild.v1.SingleCommandPermissionService.CreateSingleCommandPermission:input_type -> taskguild.v1.CreateSingleCommandPermissionRequest
+	5,  // 9: taskguild.v1.SingleCommandPermissionService.UpdateSingleCommandPermission:input_type -> taskguild.v1.UpdateSingleCommandPermissionRequest
+	7,  // 10: taskguild.v1.SingleCommandPermissionService.DeleteSingleCommandPermission:input_type -> taskguild.v1.DeleteSingleCommandPermissionRequest
+	10, // 11: taskguild.v1.SingleCommandPermissionService.ListPermissionSuggestions:input_type -> taskguild.v1.ListPermissionSuggestionsRequest
+	2,  // 12: taskguild.v1.SingleCommandPermissionService.ListSingleCommandPermissions:output_type -> taskguild.v1.ListSingleCommandPermissionsResponse
+	4,  // 13: taskguild.v1.SingleCommandPermissionService.CreateSingleCommandPermission:output_type -> taskguild.v1.CreateSingleCommandPermissionResponse
+	6,  // 14: taskguild.v1.SingleCommandPermissionService.UpdateSingleCommandPermission:output_type -> taskguild.v1.UpdateSingleCommandPermissionResponse
+	8,  // 15: taskguild.v1.SingleCommandPermissionService.DeleteSingleCommandPermission:output_type -> taskguild.v1.DeleteSingleCommandPermissionResponse
+	11, // 16: taskguild.v1.SingleCommandPermissionService.ListPermissionSuggestions:output_type -> taskguild.v1.ListPermissionSuggestionsResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_taskguild_v1_single_command_permission_proto_init() }
@@ -570,13 +920,15 @@ func file_taskguild_v1_single_command_permission_proto_init() {
 	if File_taskguild_v1_single_command_permission_proto != nil {
 		return
 	}
+	file_taskguild_v1_single_command_permission_proto_msgTypes[3].OneofWrappers = []any{}
+	file_taskguild_v1_single_command_permission_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_taskguild_v1_single_command_permission_proto_rawDesc), len(file_taskguild_v1_single_command_permission_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
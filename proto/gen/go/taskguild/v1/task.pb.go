@@ -95,9 +95,14 @@ type Task struct {
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	// overrides WorkflowStatus.effort when non-empty.
 	// Valid values: "low", "medium", "high", "xhigh", "max".
-	Effort        string `protobuf:"bytes,14,opt,name=effort,proto3" json:"effort,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Effort string `protobuf:"bytes,14,opt,name=effort,proto3" json:"effort,omitempty"`
+	// worktree base: the new worktree branch starts from worktree_base_ref (a
+	// branch, tag or commit) or from the worktree branch of
+	// worktree_base_task_id. Both empty means the project's default branch.
+	WorktreeBaseRef    string `protobuf:"bytes,15,opt,name=worktree_base_ref,json=worktreeBaseRef,proto3" json:"worktree_base_ref,omitempty"`
+	WorktreeBaseTaskId string `protobuf:"bytes,16,opt,name=worktree_base_task_id,json=worktreeBaseTaskId,proto3" json:"worktree_base_task_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Task) Reset() {
@@ -221,6 +226,20 @@ func (x *Task) GetEffort() string {
 	return ""
 }
 
+func (x *Task) GetWorktreeBaseRef() string {
+	if x != nil {
+		return x.WorktreeBaseRef
+	}
+	return ""
+}
+
+func (x *Task) GetWorktreeBaseTaskId() string {
+	if x != nil {
+		return x.WorktreeBaseTaskId
+	}
+	return ""
+}
+
 type CreateTaskRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// identity
@@ -237,9 +256,12 @@ type CreateTaskRequest struct {
 	StatusId *string `protobuf:"bytes,8,opt,name=status_id,json=statusId,proto3,oneof" json:"status_id,omitempty"`
 	// overrides WorkflowStatus.effort when non-empty.
 	// Valid values: "low", "medium", "high", "xhigh", "max".
-	Effort        string `protobuf:"bytes,9,opt,name=effort,proto3" json:"effort,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Effort string `protobuf:"bytes,9,opt,name=effort,proto3" json:"effort,omitempty"`
+	// worktree base (see Task). At most one may be set.
+	WorktreeBaseRef    string `protobuf:"bytes,10,opt,name=worktree_base_ref,json=worktreeBaseRef,proto3" json:"worktree_base_ref,omitempty"`
+	WorktreeBaseTaskId string `protobuf:"bytes,11,opt,name=worktree_base_task_id,json=worktreeBaseTaskId,proto3" json:"worktree_base_task_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *CreateTaskRequest) Reset() {
@@ -328,6 +350,20 @@ func (x *CreateTaskRequest) GetEffort() string {
 	return ""
 }
 
+func (x *CreateTaskRequest) GetWorktreeBaseRef() string {
+	if x != nil {
+		return x.WorktreeBaseRef
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetWorktreeBaseTaskId() string {
+	if x != nil {
+		return x.WorktreeBaseTaskId
+	}
+	return ""
+}
+
 type CreateTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
@@ -594,9 +630,12 @@ type UpdateTaskRequest struct {
 	// optional override of WorkflowStatus.effort.
 	// Empty string explicitly clears the override (falls back to WorkflowStatus).
 	// Valid non-empty values: "low", "medium", "high", "xhigh", "max".
-	Effort        *string `protobuf:"bytes,7,opt,name=effort,proto3,oneof" json:"effort,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Effort *string `protobuf:"bytes,7,opt,name=effort,proto3,oneof" json:"effort,omitempty"`
+	// worktree base (see Task); only used when the worktree is created.
+	WorktreeBaseRef    *string `protobuf:"bytes,8,opt,name=worktree_base_ref,json=worktreeBaseRef,proto3,oneof" json:"worktree_base_ref,omitempty"`
+	WorktreeBaseTaskId *string `protobuf:"bytes,9,opt,name=worktree_base_task_id,json=worktreeBaseTaskId,proto3,oneof" json:"worktree_base_task_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *UpdateTaskRequest) Reset() {
@@ -671,6 +710,20 @@ func (x *UpdateTaskRequest) GetEffort() string {
 	return ""
 }
 
+func (x *UpdateTaskRequest) GetWorktreeBaseRef() string {
+	if x != nil && x.WorktreeBaseRef != nil {
+		return *x.WorktreeBaseRef
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetWorktreeBaseTaskId() string {
+	if x != nil && x.WorktreeBaseTaskId != nil {
+		return *x.WorktreeBaseTaskId
+	}
+	return ""
+}
+
 type UpdateTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
@@ -1077,6 +1130,199 @@ func (x *ResumeTaskResponse) GetTask() *Task {
 	return nil
 }
 
+type CompactTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompactTaskRequest) Reset() {
+	*x = CompactTaskRequest{}
+	mi := &file_taskguild_v1_task_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompactTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactTaskRequest) ProtoMessage() {}
+
+func (x *CompactTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_task_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactTaskRequest.ProtoReflect.Descriptor instead.
+func (*CompactTaskRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CompactTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CompactTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompactTaskResponse) Reset() {
+	*x = CompactTaskResponse{}
+	mi := &file_taskguild_v1_task_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompactTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactTaskResponse) ProtoMessage() {}
+
+func (x *CompactTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_task_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactTaskResponse.ProtoReflect.Descriptor instead.
+func (*CompactTaskResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CompactTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type RollbackTaskRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CheckpointId string                 `protobuf:"bytes,2,opt,name=checkpoint_id,json=checkpointId,proto3" json:"checkpoint_id,omitempty"`
+	// resume restarts the task once the rollback succeeded.
+	Resume        bool `protobuf:"varint,3,opt,name=resume,proto3" json:"resume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackTaskRequest) Reset() {
+	*x = RollbackTaskRequest{}
+	mi := &file_taskguild_v1_task_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackTaskRequest) ProtoMessage() {}
+
+func (x *RollbackTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_task_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackTaskRequest.ProtoReflect.Descriptor instead.
+func (*RollbackTaskRequest) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RollbackTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RollbackTaskRequest) GetCheckpointId() string {
+	if x != nil {
+		return x.CheckpointId
+	}
+	return ""
+}
+
+func (x *RollbackTaskRequest) GetResume() bool {
+	if x != nil {
+		return x.Resume
+	}
+	return false
+}
+
+type RollbackTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackTaskResponse) Reset() {
+	*x = RollbackTaskResponse{}
+	mi := &file_taskguild_v1_task_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackTaskResponse) ProtoMessage() {}
+
+func (x *RollbackTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_task_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackTaskResponse.ProtoReflect.Descriptor instead.
+func (*RollbackTaskResponse) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RollbackTaskResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
 type ArchiveTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1086,7 +1332,7 @@ type ArchiveTaskRequest struct {
 
 func (x *ArchiveTaskRequest) Reset() {
 	*x = ArchiveTaskRequest{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[17]
+	mi := &file_taskguild_v1_task_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1098,7 +1344,7 @@ func (x *ArchiveTaskRequest) String() string {
 func (*ArchiveTaskRequest) ProtoMessage() {}
 
 func (x *ArchiveTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[17]
+	mi := &file_taskguild_v1_task_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,7 +1357,7 @@ func (x *ArchiveTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveTaskRequest.ProtoReflect.Descriptor instead.
 func (*ArchiveTaskRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{17}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ArchiveTaskRequest) GetId() string {
@@ -1130,7 +1376,7 @@ type ArchiveTaskResponse struct {
 
 func (x *ArchiveTaskResponse) Reset() {
 	*x = ArchiveTaskResponse{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[18]
+	mi := &file_taskguild_v1_task_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1142,7 +1388,7 @@ func (x *ArchiveTaskResponse) String() string {
 func (*ArchiveTaskResponse) ProtoMessage() {}
 
 func (x *ArchiveTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[18]
+	mi := &file_taskguild_v1_task_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1155,7 +1401,7 @@ func (x *ArchiveTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveTaskResponse.ProtoReflect.Descriptor instead.
 func (*ArchiveTaskResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{18}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ArchiveTaskResponse) GetTask() *Task {
@@ -1175,7 +1421,7 @@ type ArchiveTerminalTasksRequest struct {
 
 func (x *ArchiveTerminalTasksRequest) Reset() {
 	*x = ArchiveTerminalTasksRequest{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[19]
+	mi := &file_taskguild_v1_task_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1187,7 +1433,7 @@ func (x *ArchiveTerminalTasksRequest) String() string {
 func (*ArchiveTerminalTasksRequest) ProtoMessage() {}
 
 func (x *ArchiveTerminalTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[19]
+	mi := &file_taskguild_v1_task_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1200,7 +1446,7 @@ func (x *ArchiveTerminalTasksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveTerminalTasksRequest.ProtoReflect.Descriptor instead.
 func (*ArchiveTerminalTasksRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{19}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ArchiveTerminalTasksRequest) GetProjectId() string {
@@ -1227,7 +1473,7 @@ type ArchiveTerminalTasksResponse struct {
 
 func (x *ArchiveTerminalTasksResponse) Reset() {
 	*x = ArchiveTerminalTasksResponse{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[20]
+	mi := &file_taskguild_v1_task_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1239,7 +1485,7 @@ func (x *ArchiveTerminalTasksResponse) String() string {
 func (*ArchiveTerminalTasksResponse) ProtoMessage() {}
 
 func (x *ArchiveTerminalTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[20]
+	mi := &file_taskguild_v1_task_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1252,7 +1498,7 @@ func (x *ArchiveTerminalTasksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveTerminalTasksResponse.ProtoReflect.Descriptor instead.
 func (*ArchiveTerminalTasksResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{20}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ArchiveTerminalTasksResponse) GetArchivedTasks() []*Task {
@@ -1278,7 +1524,7 @@ type UnarchiveTaskRequest struct {
 
 func (x *UnarchiveTaskRequest) Reset() {
 	*x = UnarchiveTaskRequest{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[21]
+	mi := &file_taskguild_v1_task_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1290,7 +1536,7 @@ func (x *UnarchiveTaskRequest) String() string {
 func (*UnarchiveTaskRequest) ProtoMessage() {}
 
 func (x *UnarchiveTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[21]
+	mi := &file_taskguild_v1_task_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1303,7 +1549,7 @@ func (x *UnarchiveTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnarchiveTaskRequest.ProtoReflect.Descriptor instead.
 func (*UnarchiveTaskRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{21}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *UnarchiveTaskRequest) GetId() string {
@@ -1322,7 +1568,7 @@ type UnarchiveTaskResponse struct {
 
 func (x *UnarchiveTaskResponse) Reset() {
 	*x = UnarchiveTaskResponse{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[22]
+	mi := &file_taskguild_v1_task_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1334,7 +1580,7 @@ func (x *UnarchiveTaskResponse) String() string {
 func (*UnarchiveTaskResponse) ProtoMessage() {}
 
 func (x *UnarchiveTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[22]
+	mi := &file_taskguild_v1_task_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,7 +1593,7 @@ func (x *UnarchiveTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnarchiveTaskResponse.ProtoReflect.Descriptor instead.
 func (*UnarchiveTaskResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{22}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *UnarchiveTaskResponse) GetTask() *Task {
@@ -1368,7 +1614,7 @@ type ListArchivedTasksRequest struct {
 
 func (x *ListArchivedTasksRequest) Reset() {
 	*x = ListArchivedTasksRequest{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[23]
+	mi := &file_taskguild_v1_task_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1380,7 +1626,7 @@ func (x *ListArchivedTasksRequest) String() string {
 func (*ListArchivedTasksRequest) ProtoMessage() {}
 
 func (x *ListArchivedTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[23]
+	mi := &file_taskguild_v1_task_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1393,7 +1639,7 @@ func (x *ListArchivedTasksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListArchivedTasksRequest.ProtoReflect.Descriptor instead.
 func (*ListArchivedTasksRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{23}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListArchivedTasksRequest) GetProjectId() string {
@@ -1427,7 +1673,7 @@ type ListArchivedTasksResponse struct {
 
 func (x *ListArchivedTasksResponse) Reset() {
 	*x = ListArchivedTasksResponse{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[24]
+	mi := &file_taskguild_v1_task_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1439,7 +1685,7 @@ func (x *ListArchivedTasksResponse) String() string {
 func (*ListArchivedTasksResponse) ProtoMessage() {}
 
 func (x *ListArchivedTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[24]
+	mi := &file_taskguild_v1_task_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1452,7 +1698,7 @@ func (x *ListArchivedTasksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListArchivedTasksResponse.ProtoReflect.Descriptor instead.
 func (*ListArchivedTasksResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{24}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ListArchivedTasksResponse) GetTasks() []*Task {
@@ -1482,7 +1728,7 @@ type TaskImage struct {
 
 func (x *TaskImage) Reset() {
 	*x = TaskImage{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[25]
+	mi := &file_taskguild_v1_task_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1494,7 +1740,7 @@ func (x *TaskImage) String() string {
 func (*TaskImage) ProtoMessage() {}
 
 func (x *TaskImage) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[25]
+	mi := &file_taskguild_v1_task_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1507,7 +1753,7 @@ func (x *TaskImage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskImage.ProtoReflect.Descriptor instead.
 func (*TaskImage) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{25}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *TaskImage) GetId() string {
@@ -1557,7 +1803,7 @@ type UploadTaskImageRequest struct {
 
 func (x *UploadTaskImageRequest) Reset() {
 	*x = UploadTaskImageRequest{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[26]
+	mi := &file_taskguild_v1_task_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1569,7 +1815,7 @@ func (x *UploadTaskImageRequest) String() string {
 func (*UploadTaskImageRequest) ProtoMessage() {}
 
 func (x *UploadTaskImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[26]
+	mi := &file_taskguild_v1_task_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1582,7 +1828,7 @@ func (x *UploadTaskImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadTaskImageRequest.ProtoReflect.Descriptor instead.
 func (*UploadTaskImageRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{26}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UploadTaskImageRequest) GetTaskId() string {
@@ -1622,7 +1868,7 @@ type UploadTaskImageResponse struct {
 
 func (x *UploadTaskImageResponse) Reset() {
 	*x = UploadTaskImageResponse{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[27]
+	mi := &file_taskguild_v1_task_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1634,7 +1880,7 @@ func (x *UploadTaskImageResponse) String() string {
 func (*UploadTaskImageResponse) ProtoMessage() {}
 
 func (x *UploadTaskImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[27]
+	mi := &file_taskguild_v1_task_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1647,7 +1893,7 @@ func (x *UploadTaskImageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadTaskImageResponse.ProtoReflect.Descriptor instead.
 func (*UploadTaskImageResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{27}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UploadTaskImageResponse) GetImage() *TaskImage {
@@ -1667,7 +1913,7 @@ type GetTaskImageRequest struct {
 
 func (x *GetTaskImageRequest) Reset() {
 	*x = GetTaskImageRequest{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[28]
+	mi := &file_taskguild_v1_task_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1679,7 +1925,7 @@ func (x *GetTaskImageRequest) String() string {
 func (*GetTaskImageRequest) ProtoMessage() {}
 
 func (x *GetTaskImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[28]
+	mi := &file_taskguild_v1_task_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1692,7 +1938,7 @@ func (x *GetTaskImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTaskImageRequest.ProtoReflect.Descriptor instead.
 func (*GetTaskImageRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{28}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetTaskImageRequest) GetTaskId() string {
@@ -1719,7 +1965,7 @@ type GetTaskImageResponse struct {
 
 func (x *GetTaskImageResponse) Reset() {
 	*x = GetTaskImageResponse{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[29]
+	mi := &file_taskguild_v1_task_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1731,7 +1977,7 @@ func (x *GetTaskImageResponse) String() string {
 func (*GetTaskImageResponse) ProtoMessage() {}
 
 func (x *GetTaskImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[29]
+	mi := &file_taskguild_v1_task_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1744,7 +1990,7 @@ func (x *GetTaskImageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTaskImageResponse.ProtoReflect.Descriptor instead.
 func (*GetTaskImageResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{29}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetTaskImageResponse) GetImage() *TaskImage {
@@ -1770,7 +2016,7 @@ type ListTaskImagesRequest struct {
 
 func (x *ListTaskImagesRequest) Reset() {
 	*x = ListTaskImagesRequest{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[30]
+	mi := &file_taskguild_v1_task_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1782,7 +2028,7 @@ func (x *ListTaskImagesRequest) String() string {
 func (*ListTaskImagesRequest) ProtoMessage() {}
 
 func (x *ListTaskImagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[30]
+	mi := &file_taskguild_v1_task_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1795,7 +2041,7 @@ func (x *ListTaskImagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTaskImagesRequest.ProtoReflect.Descriptor instead.
 func (*ListTaskImagesRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{30}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ListTaskImagesRequest) GetTaskId() string {
@@ -1814,7 +2060,7 @@ type ListTaskImagesResponse struct {
 
 func (x *ListTaskImagesResponse) Reset() {
 	*x = ListTaskImagesResponse{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[31]
+	mi := &file_taskguild_v1_task_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1826,7 +2072,7 @@ func (x *ListTaskImagesResponse) String() string {
 func (*ListTaskImagesResponse) ProtoMessage() {}
 
 func (x *ListTaskImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[31]
+	mi := &file_taskguild_v1_task_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1839,7 +2085,7 @@ func (x *ListTaskImagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTaskImagesResponse.ProtoReflect.Descriptor instead.
 func (*ListTaskImagesResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{31}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ListTaskImagesResponse) GetImages() []*TaskImage {
@@ -1859,7 +2105,7 @@ type DeleteTaskImageRequest struct {
 
 func (x *DeleteTaskImageRequest) Reset() {
 	*x = DeleteTaskImageRequest{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[32]
+	mi := &file_taskguild_v1_task_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1871,7 +2117,7 @@ func (x *DeleteTaskImageRequest) String() string {
 func (*DeleteTaskImageRequest) ProtoMessage() {}
 
 func (x *DeleteTaskImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[32]
+	mi := &file_taskguild_v1_task_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,7 +2130,7 @@ func (x *DeleteTaskImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTaskImageRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTaskImageRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{32}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *DeleteTaskImageRequest) GetTaskId() string {
@@ -1909,7 +2155,7 @@ type DeleteTaskImageResponse struct {
 
 func (x *DeleteTaskImageResponse) Reset() {
 	*x = DeleteTaskImageResponse{}
-	mi := &file_taskguild_v1_task_proto_msgTypes[33]
+	mi := &file_taskguild_v1_task_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1921,7 +2167,7 @@ func (x *DeleteTaskImageResponse) String() string {
 func (*DeleteTaskImageResponse) ProtoMessage() {}
 
 func (x *DeleteTaskImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_task_proto_msgTypes[33]
+	mi := &file_taskguild_v1_task_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1934,14 +2180,14 @@ func (x *DeleteTaskImageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTaskImageResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTaskImageResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{33}
+	return file_taskguild_v1_task_proto_rawDescGZIP(), []int{37}
 }
 
 var File_taskguild_v1_task_proto protoreflect.FileDescriptor
 
 const file_taskguild_v1_task_proto_rawDesc = "" +
 	"\n" +
-	"\x17taskguild/v1/task.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19taskguild/v1/common.proto\"\xeb\x04\n" +
+	"\x17taskguild/v1/task.proto\x12\ftaskguild.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19taskguild/v1/common.proto\"\xca\x05\n" +
 	"\x04Task\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -1959,11 +2205,13 @@ const file_taskguild_v1_task_proto_rawDesc = "" +
 	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
-	"\x06effort\x18\x0e \x01(\tR\x06effort\x1a;\n" +
+	"\x06effort\x18\x0e \x01(\tR\x06effort\x12*\n" +
+	"\x11worktree_base_ref\x18\x0f \x01(\tR\x0fworktreeBaseRef\x121\n" +
+	"\x15worktree_base_task_id\x18\x10 \x01(\tR\x12worktreeBaseTaskId\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\n" +
-	"\x10\vR\x0fpermission_mode\"\x95\x03\n" +
+	"\x10\vR\x0fpermission_mode\"\xf4\x03\n" +
 	"\x11CreateTaskRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1f\n" +
@@ -1974,7 +2222,10 @@ const file_taskguild_v1_task_proto_rawDesc = "" +
 	"\fuse_worktree\x18\x05 \x01(\bR\vuseWorktree\x12I\n" +
 	"\bmetadata\x18\a \x03(\v2-.taskguild.v1.CreateTaskRequest.MetadataEntryR\bmetadata\x12 \n" +
 	"\tstatus_id\x18\b \x01(\tH\x00R\bstatusId\x88\x01\x01\x12\x16\n" +
-	"\x06effort\x18\t \x01(\tR\x06effort\x1a;\n" +
+	"\x06effort\x18\t \x01(\tR\x06effort\x12*\n" +
+	"\x11worktree_base_ref\x18\n" +
+	" \x01(\tR\x0fworktreeBaseRef\x121\n" +
+	"\x15worktree_base_task_id\x18\v \x01(\tR\x12worktreeBaseTaskId\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
@@ -1999,19 +2250,23 @@ const file_taskguild_v1_task_proto_rawDesc = "" +
 	"\x05tasks\x18\x01 \x03(\v2\x12.taskguild.v1.TaskR\x05tasks\x12@\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2 .taskguild.v1.PaginationResponseR\n" +
-	"pagination\"\xdb\x02\n" +
+	"pagination\"\xf4\x03\n" +
 	"\x11UpdateTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12&\n" +
 	"\fuse_worktree\x18\x04 \x01(\bH\x00R\vuseWorktree\x88\x01\x01\x12I\n" +
 	"\bmetadata\x18\x06 \x03(\v2-.taskguild.v1.UpdateTaskRequest.MetadataEntryR\bmetadata\x12\x1b\n" +
-	"\x06effort\x18\a \x01(\tH\x01R\x06effort\x88\x01\x01\x1a;\n" +
+	"\x06effort\x18\a \x01(\tH\x01R\x06effort\x88\x01\x01\x12/\n" +
+	"\x11worktree_base_ref\x18\b \x01(\tH\x02R\x0fworktreeBaseRef\x88\x01\x01\x126\n" +
+	"\x15worktree_base_task_id\x18\t \x01(\tH\x03R\x12worktreeBaseTaskId\x88\x01\x01\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0f\n" +
 	"\r_use_worktreeB\t\n" +
-	"\a_effortJ\x04\b\x05\x10\x06R\x0fpermission_mode\"<\n" +
+	"\a_effortB\x14\n" +
+	"\x12_worktree_base_refB\x18\n" +
+	"\x16_worktree_base_task_idJ\x04\b\x05\x10\x06R\x0fpermission_mode\"<\n" +
 	"\x12UpdateTaskResponse\x12&\n" +
 	"\x04task\x18\x01 \x01(\v2\x12.taskguild.v1.TaskR\x04task\"#\n" +
 	"\x11DeleteTaskRequest\x12\x0e\n" +
@@ -2031,6 +2286,17 @@ const file_taskguild_v1_task_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"<\n" +
 	"\x12ResumeTaskResponse\x12&\n" +
 	"\x04task\x18\x01 \x01(\v2\x12.taskguild.v1.TaskR\x04task\"$\n" +
+	"\x12CompactTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
+	"\x13CompactTaskResponse\x12&\n" +
+	"\x04task\x18\x01 \x01(\v2\x12.taskguild.v1.TaskR\x04task\"b\n" +
+	"\x13RollbackTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rcheckpoint_id\x18\x02 \x01(\tR\fcheckpointId\x12\x16\n" +
+	"\x06resume\x18\x03 \x01(\bR\x06resume\"5\n" +
+	"\x14RollbackTaskResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"$\n" +
 	"\x12ArchiveTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
 	"\x13ArchiveTaskResponse\x12&\n" +
@@ -2095,7 +2361,7 @@ const file_taskguild_v1_task_proto_rawDesc = "" +
 	"\"TASK_ASSIGNMENT_STATUS_UNSPECIFIED\x10\x00\x12%\n" +
 	"!TASK_ASSIGNMENT_STATUS_UNASSIGNED\x10\x01\x12\"\n" +
 	"\x1eTASK_ASSIGNMENT_STATUS_PENDING\x10\x02\x12#\n" +
-	"\x1fTASK_ASSIGNMENT_STATUS_ASSIGNED\x10\x032\x8c\v\n" +
+	"\x1fTASK_ASSIGNMENT_STATUS_ASSIGNED\x10\x032\xb7\f\n" +
 	"\vTaskService\x12O\n" +
 	"\n" +
 	"CreateTask\x12\x1f.taskguild.v1.CreateTaskRequest\x1a .taskguild.v1.CreateTaskResponse\x12F\n" +
@@ -2109,6 +2375,8 @@ const file_taskguild_v1_task_proto_rawDesc = "" +
 	"\bStopTask\x12\x1d.taskguild.v1.StopTaskRequest\x1a\x1e.taskguild.v1.StopTaskResponse\x12O\n" +
 	"\n" +
 	"ResumeTask\x12\x1f.taskguild.v1.ResumeTaskRequest\x1a .taskguild.v1.ResumeTaskResponse\x12R\n" +
+	"\vCompactTask\x12 .taskguild.v1.CompactTaskRequest\x1a!.taskguild.v1.CompactTaskResponse\x12U\n" +
+	"\fRollbackTask\x12!.taskguild.v1.RollbackTaskRequest\x1a\".taskguild.v1.RollbackTaskResponse\x12R\n" +
 	"\vArchiveTask\x12 .taskguild.v1.ArchiveTaskRequest\x1a!.taskguild.v1.ArchiveTaskResponse\x12m\n" +
 	"\x14ArchiveTerminalTasks\x12).taskguild.v1.ArchiveTerminalTasksRequest\x1a*.taskguild.v1.ArchiveTerminalTasksResponse\x12X\n" +
 	"\rUnarchiveTask\x12\".taskguild.v1.UnarchiveTaskRequest\x1a#.taskguild.v1.UnarchiveTaskResponse\x12d\n" +
@@ -2132,7 +2400,7 @@ func file_taskguild_v1_task_proto_rawDescGZIP() []byte {
 }
 
 var file_taskguild_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_taskguild_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_taskguild_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_taskguild_v1_task_proto_goTypes = []any{
 	(TaskAssignmentStatus)(0),            // 0: taskguild.v1.TaskAssignmentStatus
 	(*Task)(nil),                         // 1: taskguild.v1.Task
@@ -2152,94 +2420,103 @@ var file_taskguild_v1_task_proto_goTypes = []any{
 	(*StopTaskResponse)(nil),             // 15: taskguild.v1.StopTaskResponse
 	(*ResumeTaskRequest)(nil),            // 16: taskguild.v1.ResumeTaskRequest
 	(*ResumeTaskResponse)(nil),           // 17: taskguild.v1.ResumeTaskResponse
-	(*ArchiveTaskRequest)(nil),           // 18: taskguild.v1.ArchiveTaskRequest
-	(*ArchiveTaskResponse)(nil),          // 19: taskguild.v1.ArchiveTaskResponse
-	(*ArchiveTerminalTasksRequest)(nil),  // 20: taskguild.v1.ArchiveTerminalTasksRequest
-	(*ArchiveTerminalTasksResponse)(nil), // 21: taskguild.v1.ArchiveTerminalTasksResponse
-	(*UnarchiveTaskRequest)(nil),         // 22: taskguild.v1.UnarchiveTaskRequest
-	(*UnarchiveTaskResponse)(nil),        // 23: taskguild.v1.UnarchiveTaskResponse
-	(*ListArchivedTasksRequest)(nil),     // 24: taskguild.v1.ListArchivedTasksRequest
-	(*ListArchivedTasksResponse)(nil),    // 25: taskguild.v1.ListArchivedTasksResponse
-	(*TaskImage)(nil),                    // 26: taskguild.v1.TaskImage
-	(*UploadTaskImageRequest)(nil),       // 27: taskguild.v1.UploadTaskImageRequest
-	(*UploadTaskImageResponse)(nil),      // 28: taskguild.v1.UploadTaskImageResponse
-	(*GetTaskImageRequest)(nil),          // 29: taskguild.v1.GetTaskImageRequest
-	(*GetTaskImageResponse)(nil),         // 30: taskguild.v1.GetTaskImageResponse
-	(*ListTaskImagesRequest)(nil),        // 31: taskguild.v1.ListTaskImagesRequest
-	(*ListTaskImagesResponse)(nil),       // 32: taskguild.v1.ListTaskImagesResponse
-	(*DeleteTaskImageRequest)(nil),       // 33: taskguild.v1.DeleteTaskImageRequest
-	(*DeleteTaskImageResponse)(nil),      // 34: taskguild.v1.DeleteTaskImageResponse
-	nil,                                  // 35: taskguild.v1.Task.MetadataEntry
-	nil,                                  // 36: taskguild.v1.CreateTaskRequest.MetadataEntry
-	nil,                                  // 37: taskguild.v1.UpdateTaskRequest.MetadataEntry
-	(*timestamppb.Timestamp)(nil),        // 38: google.protobuf.Timestamp
-	(*PaginationRequest)(nil),            // 39: taskguild.v1.PaginationRequest
-	(*PaginationResponse)(nil),           // 40: taskguild.v1.PaginationResponse
+	(*CompactTaskRequest)(nil),           // 18: taskguild.v1.CompactTaskRequest
+	(*CompactTaskResponse)(nil),          // 19: taskguild.v1.CompactTaskResponse
+	(*RollbackTaskRequest)(nil),          // 20: taskguild.v1.RollbackTaskRequest
+	(*RollbackTaskResponse)(nil),         // 21: taskguild.v1.RollbackTaskResponse
+	(*ArchiveTaskRequest)(nil),           // 22: taskguild.v1.ArchiveTaskRequest
+	(*ArchiveTaskResponse)(nil),          // 23: taskguild.v1.ArchiveTaskResponse
+	(*ArchiveTerminalTasksRequest)(nil),  // 24: taskguild.v1.ArchiveTerminalTasksRequest
+	(*ArchiveTerminalTasksResponse)(nil), // 25: taskguild.v1.ArchiveTerminalTasksResponse
+	(*UnarchiveTaskRequest)(nil),         // 26: taskguild.v1.UnarchiveTaskRequest
+	(*UnarchiveTaskResponse)(nil),        // 27: taskguild.v1.UnarchiveTaskResponse
+	(*ListArchivedTasksRequest)(nil),     // 28: taskguild.v1.ListArchivedTasksRequest
+	(*ListArchivedTasksResponse)(nil),    // 29: taskguild.v1.ListArchivedTasksResponse
+	(*TaskImage)(nil),                    // 30: taskguild.v1.TaskImage
+	(*UploadTaskImageRequest)(nil),       // 31: taskguild.v1.UploadTaskImageRequest
+	(*UploadTaskImageResponse)(nil),      // 32: taskguild.v1.UploadTaskImageResponse
+	(*GetTaskImageRequest)(nil),          // 33: taskguild.v1.GetTaskImageRequest
+	(*GetTaskImageResponse)(nil),         // 34: taskguild.v1.GetTaskImageResponse
+	(*ListTaskImagesRequest)(nil),        // 35: taskguild.v1.ListTaskImagesRequest
+	(*ListTaskImagesResponse)(nil),       // 36: taskguild.v1.ListTaskImagesResponse
+	(*DeleteTaskImageRequest)(nil),       // 37: taskguild.v1.DeleteTaskImageRequest
+	(*DeleteTaskImageResponse)(nil),      // 38: taskguild.v1.DeleteTaskImageResponse
+	nil,                                  // 39: taskguild.v1.Task.MetadataEntry
+	nil,                                  // 40: taskguild.v1.CreateTaskRequest.MetadataEntry
+	nil,                                  // 41: taskguild.v1.UpdateTaskRequest.MetadataEntry
+	(*timestamppb.Timestamp)(nil),        // 42: google.protobuf.Timestamp
+	(*PaginationRequest)(nil),            // 43: taskguild.v1.PaginationRequest
+	(*PaginationResponse)(nil),           // 44: taskguild.v1.PaginationResponse
 }
 var file_taskguild_v1_task_proto_depIdxs = []int32{
 	0,  // 0: taskguild.v1.Task.assignment_status:type_name -> taskguild.v1.TaskAssignmentStatus
-	35, // 1: taskguild.v1.Task.metadata:type_name -> taskguild.v1.Task.MetadataEntry
-	38, // 2: taskguild.v1.Task.created_at:type_name -> google.protobuf.Timestamp
-	38, // 3: taskguild.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
-	36, // 4: taskguild.v1.CreateTaskRequest.metadata:type_name -> taskguild.v1.CreateTaskRequest.MetadataEntry
+	39, // 1: taskguild.v1.Task.metadata:type_name -> taskguild.v1.Task.MetadataEntry
+	42, // 2: taskguild.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	42, // 3: taskguild.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
+	40, // 4: taskguild.v1.CreateTaskRequest.metadata:type_name -> taskguild.v1.CreateTaskRequest.MetadataEntry
 	1,  // 5: taskguild.v1.CreateTaskResponse.task:type_name -> taskguild.v1.Task
 	1,  // 6: taskguild.v1.GetTaskResponse.task:type_name -> taskguild.v1.Task
-	39, // 7: taskguild.v1.ListTasksRequest.pagination:type_name -> taskguild.v1.PaginationRequest
+	43, // 7: taskguild.v1.ListTasksRequest.pagination:type_name -> taskguild.v1.PaginationRequest
 	1,  // 8: taskguild.v1.ListTasksResponse.tasks:type_name -> taskguild.v1.Task
-	40, // 9: taskguild.v1.ListTasksResponse.pagination:type_name -> taskguild.v1.PaginationResponse
-	37, // 10: taskguild.v1.UpdateTaskRequest.metadata:type_name -> taskguild.v1.UpdateTaskRequest.MetadataEntry
+	44, // 9: taskguild.v1.ListTasksResponse.pagination:type_name -> taskguild.v1.PaginationResponse
+	41, // 10: taskguild.v1.UpdateTaskRequest.metadata:type_name -> taskguild.v1.UpdateTaskRequest.MetadataEntry
 	1,  // 11: taskguild.v1.UpdateTaskResponse.task:type_name -> taskguild.v1.Task
 	1,  // 12: taskguild.v1.UpdateTaskStatusResponse.task:type_name -> taskguild.v1.Task
 	1,  // 13: taskguild.v1.StopTaskResponse.task:type_name -> taskguild.v1.Task
 	1,  // 14: taskguild.v1.ResumeTaskResponse.task:type_name -> taskguild.v1.Task
-	1,  // 15: taskguild.v1.ArchiveTaskResponse.task:type_name -> taskguild.v1.Task
-	1,  // 16: taskguild.v1.ArchiveTerminalTasksResponse.archived_tasks:type_name -> taskguild.v1.Task
-	1,  // 17: taskguild.v1.ArchiveTerminalTasksResponse.skipped_tasks:type_name -> taskguild.v1.Task
-	1,  // 18: taskguild.v1.UnarchiveTaskResponse.task:type_name -> taskguild.v1.Task
-	39, // 19: taskguild.v1.ListArchivedTasksRequest.pagination:type_name -> taskguild.v1.PaginationRequest
-	1,  // 20: taskguild.v1.ListArchivedTasksResponse.tasks:type_name -> taskguild.v1.Task
-	40, // 21: taskguild.v1.ListArchivedTasksResponse.pagination:type_name -> taskguild.v1.PaginationResponse
-	38, // 22: taskguild.v1.TaskImage.created_at:type_name -> google.protobuf.Timestamp
-	26, // 23: taskguild.v1.UploadTaskImageResponse.image:type_name -> taskguild.v1.TaskImage
-	26, // 24: taskguild.v1.GetTaskImageResponse.image:type_name -> taskguild.v1.TaskImage
-	26, // 25: taskguild.v1.ListTaskImagesResponse.images:type_name -> taskguild.v1.TaskImage
-	2,  // 26: taskguild.v1.TaskService.CreateTask:input_type -> taskguild.v1.CreateTaskRequest
-	4,  // 27: taskguild.v1.TaskService.GetTask:input_type -> taskguild.v1.GetTaskRequest
-	6,  // 28: taskguild.v1.TaskService.ListTasks:input_type -> taskguild.v1.ListTasksRequest
-	8,  // 29: taskguild.v1.TaskService.UpdateTask:input_type -> taskguild.v1.UpdateTaskRequest
-	10, // 30: taskguild.v1.TaskService.DeleteTask:input_type -> taskguild.v1.DeleteTaskRequest
-	12, // 31: taskguild.v1.TaskService.UpdateTaskStatus:input_type -> taskguild.v1.UpdateTaskStatusRequest
-	14, // 32: taskguild.v1.TaskService.StopTask:input_type -> taskguild.v1.StopTaskRequest
-	16, // 33: taskguild.v1.TaskService.ResumeTask:input_type -> taskguild.v1.ResumeTaskRequest
-	18, // 34: taskguild.v1.TaskService.ArchiveTask:input_type -> taskguild.v1.ArchiveTaskRequest
-	20, // 35: taskguild.v1.TaskService.ArchiveTerminalTasks:input_type -> taskguild.v1.ArchiveTerminalTasksRequest
-	22, // 36: taskguild.v1.TaskService.UnarchiveTask:input_type -> taskguild.v1.UnarchiveTaskRequest
-	24, // 37: taskguild.v1.TaskService.ListArchivedTasks:input_type -> taskguild.v1.ListArchivedTasksRequest
-	27, // 38: taskguild.v1.TaskService.UploadTaskImage:input_type -> taskguild.v1.UploadTaskImageRequest
-	29, // 39: taskguild.v1.TaskService.GetTaskImage:input_type -> taskguild.v1.GetTaskImageRequest
-	31, // 40: taskguild.v1.TaskService.ListTaskImages:input_type -> taskguild.v1.ListTaskImagesRequest
-	33, // 41: taskguild.v1.TaskService.DeleteTaskImage:input_type -> taskguild.v1.DeleteTaskImageRequest
-	3,  // 42: taskguild.v1.TaskService.CreateTask:output_type -> taskguild.v1.CreateTaskResponse
-	5,  // 43: taskguild.v1.TaskService.GetTask:output_type -> taskguild.v1.GetTaskResponse
-	7,  // 44: taskguild.v1.TaskService.ListTasks:output_type -> taskguild.v1.ListTasksResponse
-	9,  // 45: taskguild.v1.TaskService.UpdateTask:output_type -> taskguild.v1.UpdateTaskResponse
-	11, // 46: taskguild.v1.TaskService.DeleteTask:output_type -> taskguild.v1.DeleteTaskResponse
-	13, // 47: taskguild.v1.TaskService.UpdateTaskStatus:output_type -> taskguild.v1.UpdateTaskStatusResponse
-	15, // 48: taskguild.v1.TaskService.StopTask:output_type -> taskguild.v1.StopTaskResponse
-	17, // 49: taskguild.v1.TaskService.ResumeTask:output_type -> taskguild.v1.ResumeTaskResponse
-	19, // 50: taskguild.v1.TaskService.ArchiveTask:output_type -> taskguild.v1.ArchiveTaskResponse
-	21, // 51: taskguild.v1.TaskService.ArchiveTerminalTasks:output_type -> taskguild.v1.ArchiveTerminalTasksResponse
-	23, // 52: taskguild.v1.TaskService.UnarchiveTask:output_type -> taskguild.v1.UnarchiveTaskResponse
-	25, // 53: taskguild.v1.TaskService.ListArchivedTasks:output_type -> taskguild.v1.ListArchivedTasksResponse
-	28, // 54: taskguild.v1.TaskService.UploadTaskImage:output_type -> taskguild.v1.UploadTaskImageResponse
-	30, // 55: taskguild.v1.TaskService.GetTaskImage:output_type -> taskguild.v1.GetTaskImageResponse
-	32, // 56: taskguild.v1.TaskService.ListTaskImages:output_type -> taskguild.v1.ListTaskImagesResponse
-	34, // 57: taskguild.v1.TaskService.DeleteTaskImage:output_type -> taskguild.v1.DeleteTaskImageResponse
-	42, // [42:58] is the sub-list for method output_type
-	26, // [26:42] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	1,  // 15: taskguild.v1.CompactTaskResponse.task:type_name -> taskguild.v1.Task
+	1,  // 16: taskguild.v1.ArchiveTaskResponse.task:type_name -> taskguild.v1.Task
+	1,  // 17: taskguild.v1.ArchiveTerminalTasksResponse.archived_tasks:type_name -> taskguild.v1.Task
+	1,  // 18: taskguild.v1.ArchiveTerminalTasksResponse.skipped_tasks:type_name -> taskguild.v1.Task
+	1,  // 19: taskguild.v1.UnarchiveTaskResponse.task:type_name -> taskguild.v1.Task
+	43, // 20: taskguild.v1.ListArchivedTasksRequest.pagination:type_name -> taskguild.v1.PaginationRequest
+	1,  // 21: taskguild.v1.ListArchivedTasksResponse.tasks:type_name -> taskguild.v1.Task
+	44, // 22: taskguild.v1.ListArchivedTasksResponse.pagination:type_name -> taskguild.v1.PaginationResponse
+	42, // 23: taskguild.v1.TaskImage.created_at:type_name -> google.protobuf.Timestamp
+	30, // 24: taskguild.v1.UploadTaskImageResponse.image:type_name -> taskguild.v1.TaskImage
+	30, // 25: taskguild.v1.GetTaskImageResponse.image:type_name -> taskguild.v1.TaskImage
+	30, // 26: taskguild.v1.ListTaskImagesResponse.images:type_name -> taskguild.v1.TaskImage
+	2,  // 27: taskguild.v1.TaskService.CreateTask:input_type -> taskguild.v1.CreateTaskRequest
+	4,  // 28: taskguild.v1.TaskService.GetTask:input_type -> taskguild.v1.GetTaskRequest
+	6,  // 29: taskguild.v1.TaskService.ListTasks:input_type -> taskguild.v1.ListTasksRequest
+	8,  // 30: taskguild.v1.TaskService.UpdateTask:input_type -> taskguild.v1.UpdateTaskRequest
+	10, // 31: taskguild.v1.TaskService.DeleteTask:input_type -> taskguild.v1.DeleteTaskRequest
+	12, // 32: taskguild.v1.TaskService.UpdateTaskStatus:input_type -> taskguild.v1.UpdateTaskStatusRequest
+	14, // 33: taskguild.v1.TaskService.StopTask:input_type -> taskguild.v1.StopTaskRequest
+	16, // 34: taskguild.v1.TaskService.ResumeTask:input_type -> taskguild.v1.ResumeTaskRequest
+	18, // 35: taskguild.v1.TaskService.CompactTask:input_type -> taskguild.v1.CompactTaskRequest
+	20, // 36: taskguild.v1.TaskService.RollbackTask:input_type -> taskguild.v1.RollbackTaskRequest
+	22, // 37: taskguild.v1.TaskService.ArchiveTask:input_type -> taskguild.v1.ArchiveTaskRequest
+	24, // 38: taskguild.v1.TaskService.ArchiveTerminalTasks:input_type -> taskguild.v1.ArchiveTerminalTasksRequest
+	26, // 39: taskguild.v1.TaskService.UnarchiveTask:input_type -> taskguild.v1.UnarchiveTaskRequest
+	28, // 40: taskguild.v1.TaskService.ListArchivedTasks:input_type -> taskguild.v1.ListArchivedTasksRequest
+	31, // 41: taskguild.v1.TaskService.UploadTaskImage:input_type -> taskguild.v1.UploadTaskImageRequest
+	33, // 42: taskguild.v1.TaskService.GetTaskImage:input_type -> taskguild.v1.GetTaskImageRequest
+	35, // 43: taskguild.v1.TaskService.ListTaskImages:input_type -> taskguild.v1.ListTaskImagesRequest
+	37, // 44: taskguild.v1.TaskService.DeleteTaskImage:input_type -> taskguild.v1.DeleteTaskImageRequest
+	3,  // 45: taskguild.v1.TaskService.CreateTask:output_type -> taskguild.v1.CreateTaskResponse
+	5,  // 46: taskguild.v1.TaskService.GetTask:output_type -> taskguild.v1.GetTaskResponse
+	7,  // 47: taskguild.v1.TaskService.ListTasks:output_type -> taskguild.v1.ListTasksResponse
+	9,  // 48: taskguild.v1.TaskService.UpdateTask:output_type -> taskguild.v1.UpdateTaskResponse
+	11, // 49: taskguild.v1.TaskService.DeleteTask:output_type -> taskguild.v1.DeleteTaskResponse
+	13, // 50: taskguild.v1.TaskService.UpdateTaskStatus:output_type -> taskguild.v1.UpdateTaskStatusResponse
+	15, // 51: taskguild.v1.TaskService.StopTask:output_type -> taskguild.v1.StopTaskResponse
+	17, // 52: taskguild.v1.TaskService.ResumeTask:output_type -> taskguild.v1.ResumeTaskResponse
+	19, // 53: taskguild.v1.TaskService.CompactTask:output_type -> taskguild.v1.CompactTaskResponse
+	21, // 54: taskguild.v1.TaskService.RollbackTask:output_type -> taskguild.v1.RollbackTaskResponse
+	23, // 55: taskguild.v1.TaskService.ArchiveTask:output_type -> taskguild.v1.ArchiveTaskResponse
+	25, // 56: taskguild.v1.TaskService.ArchiveTerminalTasks:output_type -> taskguild.v1.ArchiveTerminalTasksResponse
+	27, // 57: taskguild.v1.TaskService.UnarchiveTask:output_type -> taskguild.v1.UnarchiveTaskResponse
+	29, // 58: taskguild.v1.TaskService.ListArchivedTasks:output_type -> taskguild.v1.ListArchivedTasksResponse
+	32, // 59: taskguild.v1.TaskService.UploadTaskImage:output_type -> taskguild.v1.UploadTaskImageResponse
+	34, // 60: taskguild.v1.TaskService.GetTaskImage:output_type -> taskguild.v1.GetTaskImageResponse
+	36, // 61: taskguild.v1.TaskService.ListTaskImages:output_type -> taskguild.v1.ListTaskImagesResponse
+	38, // 62: taskguild.v1.TaskService.DeleteTaskImage:output_type -> taskguild.v1.DeleteTaskImageResponse
+	45, // [45:63] is the sub-list for method output_type
+	27, // [27:45] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_taskguild_v1_task_proto_init() }
@@ -2256,7 +2533,7 @@ func file_taskguild_v1_task_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_taskguild_v1_task_proto_rawDesc), len(file_taskguild_v1_task_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   37,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -92,6 +92,10 @@ const (
 	TaskLogCategory_TASK_LOG_CATEGORY_AGENT_OUTPUT  TaskLogCategory = 9
 	TaskLogCategory_TASK_LOG_CATEGORY_DIRECTIVE     TaskLogCategory = 10
 	TaskLogCategory_TASK_LOG_CATEGORY_RESULT        TaskLogCategory = 11
+	// CHECKPOINT records a snapshot of the worktree taken after a turn.
+	TaskLogCategory_TASK_LOG_CATEGORY_CHECKPOINT TaskLogCategory = 12
+	// DIFF records a snapshot of the worktree diff taken at a status transition.
+	TaskLogCategory_TASK_LOG_CATEGORY_DIFF TaskLogCategory = 13
 )
 
 // Enum value maps for TaskLogCategory.
@@ -109,6 +113,8 @@ var (
 		9:  "TASK_LOG_CATEGORY_AGENT_OUTPUT",
 		10: "TASK_LOG_CATEGORY_DIRECTIVE",
 		11: "TASK_LOG_CATEGORY_RESULT",
+		12: "TASK_LOG_CATEGORY_CHECKPOINT",
+		13: "TASK_LOG_CATEGORY_DIFF",
 	}
 	TaskLogCategory_value = map[string]int32{
 		"TASK_LOG_CATEGORY_UNSPECIFIED":   0,
@@ -123,6 +129,8 @@ var (
 		"TASK_LOG_CATEGORY_AGENT_OUTPUT":  9,
 		"TASK_LOG_CATEGORY_DIRECTIVE":     10,
 		"TASK_LOG_CATEGORY_RESULT":        11,
+		"TASK_LOG_CATEGORY_CHECKPOINT":    12,
+		"TASK_LOG_CATEGORY_DIFF":          13,
 	}
 )
 
@@ -420,7 +428,7 @@ const file_taskguild_v1_task_log_proto_rawDesc = "" +
 	"\x13TASK_LOG_LEVEL_INFO\x10\x01\x12\x18\n" +
 	"\x14TASK_LOG_LEVEL_DEBUG\x10\x02\x12\x17\n" +
 	"\x13TASK_LOG_LEVEL_WARN\x10\x03\x12\x18\n" +
-	"\x14TASK_LOG_LEVEL_ERROR\x10\x04*\x93\x03\n" +
+	"\x14TASK_LOG_LEVEL_ERROR\x10\x04*\xd1\x03\n" +
 	"\x0fTaskLogCategory\x12!\n" +
 	"\x1dTASK_LOG_CATEGORY_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cTASK_LOG_CATEGORY_TURN_START\x10\x01\x12\x1e\n" +
@@ -434,7 +442,9 @@ const file_taskguild_v1_task_log_proto_rawDesc = "" +
 	"\x1eTASK_LOG_CATEGORY_AGENT_OUTPUT\x10\t\x12\x1f\n" +
 	"\x1bTASK_LOG_CATEGORY_DIRECTIVE\x10\n" +
 	"\x12\x1c\n" +
-	"\x18TASK_LOG_CATEGORY_RESULT\x10\v2g\n" +
+	"\x18TASK_LOG_CATEGORY_RESULT\x10\v\x12 \n" +
+	"\x1cTASK_LOG_CATEGORY_CHECKPOINT\x10\f\x12\x1a\n" +
+	"\x16TASK_LOG_CATEGORY_DIFF\x10\r2g\n" +
 	"\x0eTaskLogService\x12U\n" +
 	"\fListTaskLogs\x12!.taskguild.v1.ListTaskLogsRequest\x1a\".taskguild.v1.ListTaskLogsResponseB\xb5\x01\n" +
 	"\x10com.taskguild.v1B\fTaskLogProtoP\x01ZBgithub.com/kazz187/taskguild/proto/gen/go/taskguild/v1;taskguildv1\xa2\x02\x03TXX\xaa\x02\fTaskguild.V1\xca\x02\fTaskguild\\V1\xe2\x02\x18Taskguild\\V1\\GPBMetadata\xea\x02\rTaskguild::V1b\x06proto3"
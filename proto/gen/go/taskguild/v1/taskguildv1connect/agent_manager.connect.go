@@ -141,6 +141,36 @@ const (
 	// AgentManagerServiceSyncClaudeSettingsProcedure is the fully-qualified name of the
 	// AgentManagerService's SyncClaudeSettings RPC.
 	AgentManagerServiceSyncClaudeSettingsProcedure = "/taskguild.v1.AgentManagerService/SyncClaudeSettings"
+	// AgentManagerServiceDrainAgentManagerProcedure is the fully-qualified name of the
+	// AgentManagerService's DrainAgentManager RPC.
+	AgentManagerServiceDrainAgentManagerProcedure = "/taskguild.v1.AgentManagerService/DrainAgentManager"
+	// AgentManagerServiceListAgentManagersProcedure is the fully-qualified name of the
+	// AgentManagerService's ListAgentManagers RPC.
+	AgentManagerServiceListAgentManagersProcedure = "/taskguild.v1.AgentManagerService/ListAgentManagers"
+	// AgentManagerServiceUploadSessionTranscriptProcedure is the fully-qualified name of the
+	// AgentManagerService's UploadSessionTranscript RPC.
+	AgentManagerServiceUploadSessionTranscriptProcedure = "/taskguild.v1.AgentManagerService/UploadSessionTranscript"
+	// AgentManagerServiceDownloadSessionTranscriptProcedure is the fully-qualified name of the
+	// AgentManagerService's DownloadSessionTranscript RPC.
+	AgentManagerServiceDownloadSessionTranscriptProcedure = "/taskguild.v1.AgentManagerService/DownloadSessionTranscript"
+	// AgentManagerServiceGetTaskHandoffProcedure is the fully-qualified name of the
+	// AgentManagerService's GetTaskHandoff RPC.
+	AgentManagerServiceGetTaskHandoffProcedure = "/taskguild.v1.AgentManagerService/GetTaskHandoff"
+	// AgentManagerServiceReportTaskRollbackResultProcedure is the fully-qualified name of the
+	// AgentManagerService's ReportTaskRollbackResult RPC.
+	AgentManagerServiceReportTaskRollbackResultProcedure = "/taskguild.v1.AgentManagerService/ReportTaskRollbackResult"
+	// AgentManagerServiceGetTaskDiffProcedure is the fully-qualified name of the AgentManagerService's
+	// GetTaskDiff RPC.
+	AgentManagerServiceGetTaskDiffProcedure = "/taskguild.v1.AgentManagerService/GetTaskDiff"
+	// AgentManagerServiceReportTaskDiffProcedure is the fully-qualified name of the
+	// AgentManagerService's ReportTaskDiff RPC.
+	AgentManagerServiceReportTaskDiffProcedure = "/taskguild.v1.AgentManagerService/ReportTaskDiff"
+	// AgentManagerServiceReportMergeResultProcedure is the fully-qualified name of the
+	// AgentManagerService's ReportMergeResult RPC.
+	AgentManagerServiceReportMergeResultProcedure = "/taskguild.v1.AgentManagerService/ReportMergeResult"
+	// AgentManagerServiceReportModifiedFilesProcedure is the fully-qualified name of the
+	// AgentManagerService's ReportModifiedFiles RPC.
+	AgentManagerServiceReportModifiedFilesProcedure = "/taskguild.v1.AgentManagerService/ReportModifiedFiles"
 )
 
 // AgentManagerServiceClient is a client for the taskguild.v1.AgentManagerService service.
@@ -223,6 +253,34 @@ type AgentManagerServiceClient interface {
 	// SyncClaudeSettings merges local .claude/settings.json settings (language, etc.)
 	// with the backend's stored settings and returns the merged result.
 	SyncClaudeSettings(context.Context, *connect.Request[v1.SyncClaudeSettingsAgentRequest]) (*connect.Response[v1.SyncClaudeSettingsAgentResponse], error)
+	// DrainAgentManager tells a connected agent-manager to stop claiming new
+	// tasks and let running ones finish (called by frontend / CLI). Setting
+	// resume lifts a previous drain.
+	DrainAgentManager(context.Context, *connect.Request[v1.DrainAgentManagerRequest]) (*connect.Response[v1.DrainAgentManagerResponse], error)
+	// ListAgentManagers returns the connected agent-managers and their state.
+	ListAgentManagers(context.Context, *connect.Request[v1.ListAgentManagersRequest]) (*connect.Response[v1.ListAgentManagersResponse], error)
+	// UploadSessionTranscript stores a Claude session transcript so the task
+	// can resume its session on another agent-manager.
+	UploadSessionTranscript(context.Context, *connect.Request[v1.UploadSessionTranscriptRequest]) (*connect.Response[v1.UploadSessionTranscriptResponse], error)
+	// DownloadSessionTranscript returns a stored Claude session transcript.
+	DownloadSessionTranscript(context.Context, *connect.Request[v1.DownloadSessionTranscriptRequest]) (*connect.Response[v1.DownloadSessionTranscriptResponse], error)
+	// GetTaskHandoff returns the task logs an agent uses to build a handoff
+	// summary when a task continues in a fresh Claude session.
+	GetTaskHandoff(context.Context, *connect.Request[v1.GetTaskHandoffRequest]) (*connect.Response[v1.GetTaskHandoffResponse], error)
+	// ReportTaskRollbackResult reports the outcome of a checkpoint rollback
+	// from the agent.
+	ReportTaskRollbackResult(context.Context, *connect.Request[v1.ReportTaskRollbackResultRequest]) (*connect.Response[v1.ReportTaskRollbackResultResponse], error)
+	// GetTaskDiff returns the diff of a task's worktree branch against the
+	// project's default branch, computed by the agent-manager holding the
+	// worktree.
+	GetTaskDiff(context.Context, *connect.Request[v1.GetTaskDiffRequest]) (*connect.Response[v1.GetTaskDiffResponse], error)
+	// ReportTaskDiff reports the diff computed for a TaskDiffCommand.
+	ReportTaskDiff(context.Context, *connect.Request[v1.ReportTaskDiffRequest]) (*connect.Response[v1.ReportTaskDiffResponse], error)
+	// ReportMergeResult reports the outcome of a MergeWorktreeCommand.
+	ReportMergeResult(context.Context, *connect.Request[v1.ReportMergeResultRequest]) (*connect.Response[v1.ReportMergeResultResponse], error)
+	// ReportModifiedFiles reports the files a worktree task has modified so
+	// the server can warn about overlaps with other active tasks.
+	ReportModifiedFiles(context.Context, *connect.Request[v1.ReportModifiedFilesRequest]) (*connect.Response[v1.ReportModifiedFilesResponse], error)
 }
 
 // NewAgentManagerServiceClient constructs a client for the taskguild.v1.AgentManagerService
@@ -452,6 +510,66 @@ func NewAgentManagerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(agentManagerServiceMethods.ByName("SyncClaudeSettings")),
 			connect.WithClientOptions(opts...),
 		),
+		drainAgentManager: connect.NewClient[v1.DrainAgentManagerRequest, v1.DrainAgentManagerResponse](
+			httpClient,
+			baseURL+AgentManagerServiceDrainAgentManagerProcedure,
+			connect.WithSchema(agentManagerServiceMethods.ByName("DrainAgentManager")),
+			connect.WithClientOptions(opts...),
+		),
+		listAgentManagers: connect.NewClient[v1.ListAgentManagersRequest, v1.ListAgentManagersResponse](
+			httpClient,
+			baseURL+AgentManagerServiceListAgentManagersProcedure,
+			connect.WithSchema(agentManagerServiceMethods.ByName("ListAgentManagers")),
+			connect.WithClientOptions(opts...),
+		),
+		uploadSessionTranscript: connect.NewClient[v1.UploadSessionTranscriptRequest, v1.UploadSessionTranscriptResponse](
+			httpClient,
+			baseURL+AgentManagerServiceUploadSessionTranscriptProcedure,
+			connect.WithSchema(agentManagerServiceMethods.ByName("UploadSessionTranscript")),
+			connect.WithClientOptions(opts...),
+		),
+		downloadSessionTranscript: connect.NewClient[v1.DownloadSessionTranscriptRequest, v1.DownloadSessionTranscriptResponse](
+			httpClient,
+			baseURL+AgentManagerServiceDownloadSessionTranscriptProcedure,
+			connect.WithSchema(agentManagerServiceMethods.ByName("DownloadSessionTranscript")),
+			connect.WithClientOptions(opts...),
+		),
+		getTaskHandoff: connect.NewClient[v1.GetTaskHandoffRequest, v1.GetTaskHandoffResponse](
+			httpClient,
+			baseURL+AgentManagerServiceGetTaskHandoffProcedure,
+			connect.WithSchema(agentManagerServiceMethods.ByName("GetTaskHandoff")),
+			connect.WithClientOptions(opts...),
+		),
+		reportTaskRollbackResult: connect.NewClient[v1.ReportTaskRollbackResultRequest, v1.ReportTaskRollbackResultResponse](
+			httpClient,
+			baseURL+AgentManagerServiceReportTaskRollbackResultProcedure,
+			connect.WithSchema(agentManagerServiceMethods.ByName("ReportTaskRollbackResult")),
+			connect.WithClientOptions(opts...),
+		),
+		getTaskDiff: connect.NewClient[v1.GetTaskDiffRequest, v1.GetTaskDiffResponse](
+			httpClient,
+			baseURL+AgentManagerServiceGetTaskDiffProcedure,
+			connect.WithSchema(agentManagerServiceMethods.ByName("GetTaskDiff")),
+			connect.WithClientOptions(opts...),
+		),
+		reportTaskDiff: connect.NewClient[v1.ReportTaskDiffRequest, v1.ReportTaskDiffResponse](
+			httpClient,
+			baseURL+AgentManagerServiceReportTaskDiffProcedure,
+			connect.WithSchema(agentManagerServiceMethods.ByName("ReportTaskDiff")),
+			connect.WithClientOptions(opts...),
+		),
+		reportMergeResult: connect.NewClient[v1.ReportMergeResultRequest, v1.ReportMergeResultResponse](
+			httpClient,
+			baseURL+AgentManagerServiceReportMergeResultProcedure,
+			connect.WithSchema(agentManagerServiceMethods.ByName("ReportMergeResult")),
+			connect.WithClientOptions(opts...),
+		),
+		reportModifiedFiles: connect.NewClient[v1.ReportModifiedFilesRequest, v1.ReportModifiedFilesResponse](
+			httpClient,
+			baseURL+AgentManagerServiceReportModifiedFilesProcedure,
+			connect.WithSchema(agentManagerServiceMethods.ByName("ReportModifiedFiles")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -493,6 +611,16 @@ type agentManagerServiceClient struct {
 	getSkillComparison           *connect.Client[v1.GetSkillComparisonRequest, v1.GetSkillComparisonResponse]
 	resolveSkillConflict         *connect.Client[v1.ResolveSkillConflictRequest, v1.ResolveSkillConflictResponse]
 	syncClaudeSettings           *connect.Client[v1.SyncClaudeSettingsAgentRequest, v1.SyncClaudeSettingsAgentResponse]
+	drainAgentManager            *connect.Client[v1.DrainAgentManagerRequest, v1.DrainAgentManagerResponse]
+	listAgentManagers            *connect.Client[v1.ListAgentManagersRequest, v1.ListAgentManagersResponse]
+	uploadSessionTranscript      *connect.Client[v1.UploadSessionTranscriptRequest, v1.UploadSessionTranscriptResponse]
+	downloadSessionTranscript    *connect.Client[v1.DownloadSessionTranscriptRequest, v1.DownloadSessionTranscriptResponse]
+	getTaskHandoff               *connect.Client[v1.GetTaskHandoffRequest, v1.GetTaskHandoffResponse]
+	reportTaskRollbackResult     *connect.Client[v1.ReportTaskRollbackResultRequest, v1.ReportTaskRollbackResultResponse]
+	getTaskDiff                  *connect.Client[v1.GetTaskDiffRequest, v1.GetTaskDiffResponse]
+	reportTaskDiff               *connect.Client[v1.ReportTaskDiffRequest, v1.ReportTaskDiffResponse]
+	reportMergeResult            *connect.Client[v1.ReportMergeResultRequest, v1.ReportMergeResultResponse]
+	reportModifiedFiles          *connect.Client[v1.ReportModifiedFilesRequest, v1.ReportModifiedFilesResponse]
 }
 
 // Subscribe calls taskguild.v1.AgentManagerService.Subscribe.
@@ -675,6 +803,56 @@ func (c *agentManagerServiceClient) SyncClaudeSettings(ctx context.Context, req
 	return c.syncClaudeSettings.CallUnary(ctx, req)
 }
 
+// DrainAgentManager calls taskguild.v1.AgentManagerService.DrainAgentManager.
+func (c *agentManagerServiceClient) DrainAgentManager(ctx context.Context, req *connect.Request[v1.DrainAgentManagerRequest]) (*connect.Response[v1.DrainAgentManagerResponse], error) {
+	return c.drainAgentManager.CallUnary(ctx, req)
+}
+
+// ListAgentManagers calls taskguild.v1.AgentManagerService.ListAgentManagers.
+func (c *agentManagerServiceClient) ListAgentManagers(ctx context.Context, req *connect.Request[v1.ListAgentManagersRequest]) (*connect.Response[v1.ListAgentManagersResponse], error) {
+	return c.listAgentManagers.CallUnary(ctx, req)
+}
+
+// UploadSessionTranscript calls taskguild.v1.AgentManagerService.UploadSessionTranscript.
+func (c *agentManagerServiceClient) UploadSessionTranscript(ctx context.Context, req *connect.Request[v1.UploadSessionTranscriptRequest]) (*connect.Response[v1.UploadSessionTranscriptResponse], error) {
+	return c.uploadSessionTranscript.CallUnary(ctx, req)
+}
+
+// DownloadSessionTranscript calls taskguild.v1.AgentManagerService.DownloadSessionTranscript.
+func (c *agentManagerServiceClient) DownloadSessionTranscript(ctx context.Context, req *connect.Request[v1.DownloadSessionTranscriptRequest]) (*connect.Response[v1.DownloadSessionTranscriptResponse], error) {
+	return c.downloadSessionTranscript.CallUnary(ctx, req)
+}
+
+// GetTaskHandoff calls taskguild.v1.AgentManagerService.GetTaskHandoff.
+func (c *agentManagerServiceClient) GetTaskHandoff(ctx context.Context, req *connect.Request[v1.GetTaskHandoffRequest]) (*connect.Response[v1.GetTaskHandoffResponse], error) {
+	return c.getTaskHandoff.CallUnary(ctx, req)
+}
+
+// ReportTaskRollbackResult calls taskguild.v1.AgentManagerService.ReportTaskRollbackResult.
+func (c *agentManagerServiceClient) ReportTaskRollbackResult(ctx context.Context, req *connect.Request[v1.ReportTaskRollbackResultRequest]) (*connect.Response[v1.ReportTaskRollbackResultResponse], error) {
+	return c.reportTaskRollbackResult.CallUnary(ctx, req)
+}
+
+// GetTaskDiff calls taskguild.v1.AgentManagerService.GetTaskDiff.
+func (c *agentManagerServiceClient) GetTaskDiff(ctx context.Context, req *connect.Request[v1.GetTaskDiffRequest]) (*connect.Response[v1.GetTaskDiffResponse], error) {
+	return c.getTaskDiff.CallUnary(ctx, req)
+}
+
+// ReportTaskDiff calls taskguild.v1.AgentManagerService.ReportTaskDiff.
+func (c *agentManagerServiceClient) ReportTaskDiff(ctx context.Context, req *connect.Request[v1.ReportTaskDiffRequest]) (*connect.Response[v1.ReportTaskDiffResponse], error) {
+	return c.reportTaskDiff.CallUnary(ctx, req)
+}
+
+// ReportMergeResult calls taskguild.v1.AgentManagerService.ReportMergeResult.
+func (c *agentManagerServiceClient) ReportMergeResult(ctx context.Context, req *connect.Request[v1.ReportMergeResultRequest]) (*connect.Response[v1.ReportMergeResultResponse], error) {
+	return c.reportMergeResult.CallUnary(ctx, req)
+}
+
+// ReportModifiedFiles calls taskguild.v1.AgentManagerService.ReportModifiedFiles.
+func (c *agentManagerServiceClient) ReportModifiedFiles(ctx context.Context, req *connect.Request[v1.ReportModifiedFilesRequest]) (*connect.Response[v1.ReportModifiedFilesResponse], error) {
+	return c.reportModifiedFiles.CallUnary(ctx, req)
+}
+
 // AgentManagerServiceHandler is an implementation of the taskguild.v1.AgentManagerService service.
 type AgentManagerServiceHandler interface {
 	// Subscribe opens a server-stream for receiving commands from the backend.
@@ -755,6 +933,34 @@ type AgentManagerServiceHandler interface {
 	// SyncClaudeSettings merges local .claude/settings.json settings (language, etc.)
 	// with the backend's stored settings and returns the merged result.
 	SyncClaudeSettings(context.Context, *connect.Request[v1.SyncClaudeSettingsAgentRequest]) (*connect.Response[v1.SyncClaudeSettingsAgentResponse], error)
+	// DrainAgentManager tells a connected agent-manager to stop claiming new
+	// tasks and let running ones finish (called by frontend / CLI). Setting
+	// resume lifts a previous drain.
+	DrainAgentManager(context.Context, *connect.Request[v1.DrainAgentManagerRequest]) (*connect.Response[v1.DrainAgentManagerResponse], error)
+	// ListAgentManagers returns the connected agent-managers and their state.
+	ListAgentManagers(context.Context, *connect.Request[v1.ListAgentManagersRequest]) (*connect.Response[v1.ListAgentManagersResponse], error)
+	// UploadSessionTranscript stores a Claude session transcript so the task
+	// can resume its session on another agent-manager.
+	UploadSessionTranscript(context.Context, *connect.Request[v1.UploadSessionTranscriptRequest]) (*connect.Response[v1.UploadSessionTranscriptResponse], error)
+	// DownloadSessionTranscript returns a stored Claude session transcript.
+	DownloadSessionTranscript(context.Context, *connect.Request[v1.DownloadSessionTranscriptRequest]) (*connect.Response[v1.DownloadSessionTranscriptResponse], error)
+	// GetTaskHandoff returns the task logs an agent uses to build a handoff
+	// summary when a task continues in a fresh Claude session.
+	GetTaskHandoff(context.Context, *connect.Request[v1.GetTaskHandoffRequest]) (*connect.Response[v1.GetTaskHandoffResponse], error)
+	// ReportTaskRollbackResult reports the outcome of a checkpoint rollback
+	// from the agent.
+	ReportTaskRollbackResult(context.Context, *connect.Request[v1.ReportTaskRollbackResultRequest]) (*connect.Response[v1.ReportTaskRollbackResultResponse], error)
+	// GetTaskDiff returns the diff of a task's worktree branch against the
+	// project's default branch, computed by the agent-manager holding the
+	// worktree.
+	GetTaskDiff(context.Context, *connect.Request[v1.GetTaskDiffRequest]) (*connect.Response[v1.GetTaskDiffResponse], error)
+	// ReportTaskDiff reports the diff computed for a TaskDiffCommand.
+	ReportTaskDiff(context.Context, *connect.Request[v1.ReportTaskDiffRequest]) (*connect.Response[v1.ReportTaskDiffResponse], error)
+	// ReportMergeResult reports the outcome of a MergeWorktreeCommand.
+	ReportMergeResult(context.Context, *connect.Request[v1.ReportMergeResultRequest]) (*connect.Response[v1.ReportMergeResultResponse], error)
+	// ReportModifiedFiles reports the files a worktree task has modified so
+	// the server can warn about overlaps with other active tasks.
+	ReportModifiedFiles(context.Context, *connect.Request[v1.ReportModifiedFilesRequest]) (*connect.Response[v1.ReportModifiedFilesResponse], error)
 }
 
 // NewAgentManagerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -980,6 +1186,66 @@ func NewAgentManagerServiceHandler(svc AgentManagerServiceHandler, opts ...conne
 		connect.WithSchema(agentManagerServiceMethods.ByName("SyncClaudeSettings")),
 		connect.WithHandlerOptions(opts...),
 	)
+	agentManagerServiceDrainAgentManagerHandler := connect.NewUnaryHandler(
+		AgentManagerServiceDrainAgentManagerProcedure,
+		svc.DrainAgentManager,
+		connect.WithSchema(agentManagerServiceMethods.ByName("DrainAgentManager")),
+		connect.WithHandlerOptions(opts...),
+	)
+	agentManagerServiceListAgentManagersHandler := connect.NewUnaryHandler(
+		AgentManagerServiceListAgentManagersProcedure,
+		svc.ListAgentManagers,
+		connect.WithSchema(agentManagerServiceMethods.ByName("ListAgentManagers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	agentManagerServiceUploadSessionTranscriptHandler := connect.NewUnaryHandler(
+		AgentManagerServiceUploadSessionTranscriptProcedure,
+		svc.UploadSessionTranscript,
+		connect.WithSchema(agentManagerServiceMethods.ByName("UploadSessionTranscript")),
+		connect.WithHandlerOptions(opts...),
+	)
+	agentManagerServiceDownloadSessionTranscriptHandler := connect.NewUnaryHandler(
+		AgentManagerServiceDownloadSessionTranscriptProcedure,
+		svc.DownloadSessionTranscript,
+		connect.WithSchema(agentManagerServiceMethods.ByName("DownloadSessionTranscript")),
+		connect.WithHandlerOptions(opts...),
+	)
+	agentManagerServiceGetTaskHandoffHandler := connect.NewUnaryHandler(
+		AgentManagerServiceGetTaskHandoffProcedure,
+		svc.GetTaskHandoff,
+		connect.WithSchema(agentManagerServiceMethods.ByName("GetTaskHandoff")),
+		connect.WithHandlerOptions(opts...),
+	)
+	agentManagerServiceReportTaskRollbackResultHandler := connect.NewUnaryHandler(
+		AgentManagerServiceReportTaskRollbackResultProcedure,
+		svc.ReportTaskRollbackResult,
+		connect.WithSchema(agentManagerServiceMethods.ByName("ReportTaskRollbackResult")),
+		connect.WithHandlerOptions(opts...),
+	)
+	agentManagerServiceGetTaskDiffHandler := connect.NewUnaryHandler(
+		AgentManagerServiceGetTaskDiffProcedure,
+		svc.GetTaskDiff,
+		connect.WithSchema(agentManagerServiceMethods.ByName("GetTaskDiff")),
+		connect.WithHandlerOptions(opts...),
+	)
+	agentManagerServiceReportTaskDiffHandler := connect.NewUnaryHandler(
+		AgentManagerServiceReportTaskDiffProcedure,
+		svc.ReportTaskDiff,
+		connect.WithSchema(agentManagerServiceMethods.ByName("ReportTaskDiff")),
+		connect.WithHandlerOptions(opts...),
+	)
+	agentManagerServiceReportMergeResultHandler := connect.NewUnaryHandler(
+		AgentManagerServiceReportMergeResultProcedure,
+		svc.ReportMergeResult,
+		connect.WithSchema(agentManagerServiceMethods.ByName("ReportMergeResult")),
+		connect.WithHandlerOptions(opts...),
+	)
+	agentManagerServiceReportModifiedFilesHandler := connect.NewUnaryHandler(
+		AgentManagerServiceReportModifiedFilesProcedure,
+		svc.ReportModifiedFiles,
+		connect.WithSchema(agentManagerServiceMethods.ByName("ReportModifiedFiles")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/taskguild.v1.AgentManagerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AgentManagerServiceSubscribeProcedure:
@@ -1054,6 +1320,26 @@ func NewAgentManagerServiceHandler(svc AgentManagerServiceHandler, opts ...conne
 			agentManagerServiceResolveSkillConflictHandler.ServeHTTP(w, r)
 		case AgentManagerServiceSyncClaudeSettingsProcedure:
 			agentManagerServiceSyncClaudeSettingsHandler.ServeHTTP(w, r)
+		case AgentManagerServiceDrainAgentManagerProcedure:
+			agentManagerServiceDrainAgentManagerHandler.ServeHTTP(w, r)
+		case AgentManagerServiceListAgentManagersProcedure:
+			agentManagerServiceListAgentManagersHandler.ServeHTTP(w, r)
+		case AgentManagerServiceUploadSessionTranscriptProcedure:
+			agentManagerServiceUploadSessionTranscriptHandler.ServeHTTP(w, r)
+		case AgentManagerServiceDownloadSessionTranscriptProcedure:
+			agentManagerServiceDownloadSessionTranscriptHandler.ServeHTTP(w, r)
+		case AgentManagerServiceGetTaskHandoffProcedure:
+			agentManagerServiceGetTaskHandoffHandler.ServeHTTP(w, r)
+		case AgentManagerServiceReportTaskRollbackResultProcedure:
+			agentManagerServiceReportTaskRollbackResultHandler.ServeHTTP(w, r)
+		case AgentManagerServiceGetTaskDiffProcedure:
+			agentManagerServiceGetTaskDiffHandler.ServeHTTP(w, r)
+		case AgentManagerServiceReportTaskDiffProcedure:
+			agentManagerServiceReportTaskDiffHandler.ServeHTTP(w, r)
+		case AgentManagerServiceReportMergeResultProcedure:
+			agentManagerServiceReportMergeResultHandler.ServeHTTP(w, r)
+		case AgentManagerServiceReportModifiedFilesProcedure:
+			agentManagerServiceReportModifiedFilesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1206,3 +1492,43 @@ func (UnimplementedAgentManagerServiceHandler) ResolveSkillConflict(context.Cont
 func (UnimplementedAgentManagerServiceHandler) SyncClaudeSettings(context.Context, *connect.Request[v1.SyncClaudeSettingsAgentRequest]) (*connect.Response[v1.SyncClaudeSettingsAgentResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.AgentManagerService.SyncClaudeSettings is not implemented"))
 }
+
+func (UnimplementedAgentManagerServiceHandler) DrainAgentManager(context.Context, *connect.Request[v1.DrainAgentManagerRequest]) (*connect.Response[v1.DrainAgentManagerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.AgentManagerService.DrainAgentManager is not implemented"))
+}
+
+func (UnimplementedAgentManagerServiceHandler) ListAgentManagers(context.Context, *connect.Request[v1.ListAgentManagersRequest]) (*connect.Response[v1.ListAgentManagersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.AgentManagerService.ListAgentManagers is not implemented"))
+}
+
+func (UnimplementedAgentManagerServiceHandler) UploadSessionTranscript(context.Context, *connect.Request[v1.UploadSessionTranscriptRequest]) (*connect.Response[v1.UploadSessionTranscriptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.AgentManagerService.UploadSessionTranscript is not implemented"))
+}
+
+func (UnimplementedAgentManagerServiceHandler) DownloadSessionTranscript(context.Context, *connect.Request[v1.DownloadSessionTranscriptRequest]) (*connect.Response[v1.DownloadSessionTranscriptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.AgentManagerService.DownloadSessionTranscript is not implemented"))
+}
+
+func (UnimplementedAgentManagerServiceHandler) GetTaskHandoff(context.Context, *connect.Request[v1.GetTaskHandoffRequest]) (*connect.Response[v1.GetTaskHandoffResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.AgentManagerService.GetTaskHandoff is not implemented"))
+}
+
+func (UnimplementedAgentManagerServiceHandler) ReportTaskRollbackResult(context.Context, *connect.Request[v1.ReportTaskRollbackResultRequest]) (*connect.Response[v1.ReportTaskRollbackResultResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.AgentManagerService.ReportTaskRollbackResult is not implemented"))
+}
+
+func (UnimplementedAgentManagerServiceHandler) GetTaskDiff(context.Context, *connect.Request[v1.GetTaskDiffRequest]) (*connect.Response[v1.GetTaskDiffResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.AgentManagerService.GetTaskDiff is not implemented"))
+}
+
+func (UnimplementedAgentManagerServiceHandler) ReportTaskDiff(context.Context, *connect.Request[v1.ReportTaskDiffRequest]) (*connect.Response[v1.ReportTaskDiffResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.AgentManagerService.ReportTaskDiff is not implemented"))
+}
+
+func (UnimplementedAgentManagerServiceHandler) ReportMergeResult(context.Context, *connect.Request[v1.ReportMergeResultRequest]) (*connect.Response[v1.ReportMergeResultResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.AgentManagerService.ReportMergeResult is not implemented"))
+}
+
+func (UnimplementedAgentManagerServiceHandler) ReportModifiedFiles(context.Context, *connect.Request[v1.ReportModifiedFilesRequest]) (*connect.Response[v1.ReportModifiedFilesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.AgentManagerService.ReportModifiedFiles is not implemented"))
+}
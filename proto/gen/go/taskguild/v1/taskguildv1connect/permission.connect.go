@@ -42,6 +42,9 @@ const (
 	// PermissionServiceSyncPermissionsFromDirProcedure is the fully-qualified name of the
 	// PermissionService's SyncPermissionsFromDir RPC.
 	PermissionServiceSyncPermissionsFromDirProcedure = "/taskguild.v1.PermissionService/SyncPermissionsFromDir"
+	// PermissionServiceEvaluatePermissionProcedure is the fully-qualified name of the
+	// PermissionService's EvaluatePermission RPC.
+	PermissionServiceEvaluatePermissionProcedure = "/taskguild.v1.PermissionService/EvaluatePermission"
 )
 
 // PermissionServiceClient is a client for the taskguild.v1.PermissionService service.
@@ -54,6 +57,9 @@ type PermissionServiceClient interface {
 	// SyncPermissionsFromDir reads .claude/settings.json from the given directory
 	// and merges its permission rules (allow/ask/deny) into the stored set.
 	SyncPermissionsFromDir(context.Context, *connect.Request[v1.SyncPermissionsFromDirRequest]) (*connect.Response[v1.SyncPermissionsFromDirResponse], error)
+	// EvaluatePermission simulates the agent's permission check for a tool
+	// call and reports the decision and the rule that produced it.
+	EvaluatePermission(context.Context, *connect.Request[v1.EvaluatePermissionRequest]) (*connect.Response[v1.EvaluatePermissionResponse], error)
 }
 
 // NewPermissionServiceClient constructs a client for the taskguild.v1.PermissionService service. By
@@ -85,6 +91,12 @@ func NewPermissionServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(permissionServiceMethods.ByName("SyncPermissionsFromDir")),
 			connect.WithClientOptions(opts...),
 		),
+		evaluatePermission: connect.NewClient[v1.EvaluatePermissionRequest, v1.EvaluatePermissionResponse](
+			httpClient,
+			baseURL+PermissionServiceEvaluatePermissionProcedure,
+			connect.WithSchema(permissionServiceMethods.ByName("EvaluatePermission")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -93,6 +105,7 @@ type permissionServiceClient struct {
 	getPermissions         *connect.Client[v1.GetPermissionsRequest, v1.GetPermissionsResponse]
 	updatePermissions      *connect.Client[v1.UpdatePermissionsRequest, v1.UpdatePermissionsResponse]
 	syncPermissionsFromDir *connect.Client[v1.SyncPermissionsFromDirRequest, v1.SyncPermissionsFromDirResponse]
+	evaluatePermission     *connect.Client[v1.EvaluatePermissionRequest, v1.EvaluatePermissionResponse]
 }
 
 // GetPermissions calls taskguild.v1.PermissionService.GetPermissions.
@@ -110,6 +123,11 @@ func (c *permissionServiceClient) SyncPermissionsFromDir(ctx context.Context, re
 	return c.syncPermissionsFromDir.CallUnary(ctx, req)
 }
 
+// EvaluatePermission calls taskguild.v1.PermissionService.EvaluatePermission.
+func (c *permissionServiceClient) EvaluatePermission(ctx context.Context, req *connect.Request[v1.EvaluatePermissionRequest]) (*connect.Response[v1.EvaluatePermissionResponse], error) {
+	return c.evaluatePermission.CallUnary(ctx, req)
+}
+
 // PermissionServiceHandler is an implementation of the taskguild.v1.PermissionService service.
 type PermissionServiceHandler interface {
 	// GetPermissions returns the permission set for a project.
@@ -120,6 +138,9 @@ type PermissionServiceHandler interface {
 	// SyncPermissionsFromDir reads .claude/settings.json from the given directory
 	// and merges its permission rules (allow/ask/deny) into the stored set.
 	SyncPermissionsFromDir(context.Context, *connect.Request[v1.SyncPermissionsFromDirRequest]) (*connect.Response[v1.SyncPermissionsFromDirResponse], error)
+	// EvaluatePermission simulates the agent's permission check for a tool
+	// call and reports the decision and the rule that produced it.
+	EvaluatePermission(context.Context, *connect.Request[v1.EvaluatePermissionRequest]) (*connect.Response[v1.EvaluatePermissionResponse], error)
 }
 
 // NewPermissionServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -147,6 +168,12 @@ func NewPermissionServiceHandler(svc PermissionServiceHandler, opts ...connect.H
 		connect.WithSchema(permissionServiceMethods.ByName("SyncPermissionsFromDir")),
 		connect.WithHandlerOptions(opts...),
 	)
+	permissionServiceEvaluatePermissionHandler := connect.NewUnaryHandler(
+		PermissionServiceEvaluatePermissionProcedure,
+		svc.EvaluatePermission,
+		connect.WithSchema(permissionServiceMethods.ByName("EvaluatePermission")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/taskguild.v1.PermissionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PermissionServiceGetPermissionsProcedure:
@@ -155,6 +182,8 @@ func NewPermissionServiceHandler(svc PermissionServiceHandler, opts ...connect.H
 			permissionServiceUpdatePermissionsHandler.ServeHTTP(w, r)
 		case PermissionServiceSyncPermissionsFromDirProcedure:
 			permissionServiceSyncPermissionsFromDirHandler.ServeHTTP(w, r)
+		case PermissionServiceEvaluatePermissionProcedure:
+			permissionServiceEvaluatePermissionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -175,3 +204,7 @@ func (UnimplementedPermissionServiceHandler) UpdatePermissions(context.Context,
 func (UnimplementedPermissionServiceHandler) SyncPermissionsFromDir(context.Context, *connect.Request[v1.SyncPermissionsFromDirRequest]) (*connect.Response[v1.SyncPermissionsFromDirResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.PermissionService.SyncPermissionsFromDir is not implemented"))
 }
+
+func (UnimplementedPermissionServiceHandler) EvaluatePermission(context.Context, *connect.Request[v1.EvaluatePermissionRequest]) (*connect.Response[v1.EvaluatePermissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.PermissionService.EvaluatePermission is not implemented"))
+}
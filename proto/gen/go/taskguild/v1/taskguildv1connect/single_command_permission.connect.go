@@ -46,6 +46,9 @@ const (
 	// SingleCommandPermissionServiceDeleteSingleCommandPermissionProcedure is the fully-qualified name
 	// of the SingleCommandPermissionService's DeleteSingleCommandPermission RPC.
 	SingleCommandPermissionServiceDeleteSingleCommandPermissionProcedure = "/taskguild.v1.SingleCommandPermissionService/DeleteSingleCommandPermission"
+	// SingleCommandPermissionServiceListPermissionSuggestionsProcedure is the fully-qualified name of
+	// the SingleCommandPermissionService's ListPermissionSuggestions RPC.
+	SingleCommandPermissionServiceListPermissionSuggestionsProcedure = "/taskguild.v1.SingleCommandPermissionService/ListPermissionSuggestions"
 )
 
 // SingleCommandPermissionServiceClient is a client for the
@@ -59,6 +62,10 @@ type SingleCommandPermissionServiceClient interface {
 	UpdateSingleCommandPermission(context.Context, *connect.Request[v1.UpdateSingleCommandPermissionRequest]) (*connect.Response[v1.UpdateSingleCommandPermissionResponse], error)
 	// DeleteSingleCommandPermission removes a permission rule.
 	DeleteSingleCommandPermission(context.Context, *connect.Request[v1.DeleteSingleCommandPermissionRequest]) (*connect.Response[v1.DeleteSingleCommandPermissionResponse], error)
+	// ListPermissionSuggestions mines the project's approved permission
+	// requests for commands worth turning into rules, and reports allow rules
+	// that no recent command has matched.
+	ListPermissionSuggestions(context.Context, *connect.Request[v1.ListPermissionSuggestionsRequest]) (*connect.Response[v1.ListPermissionSuggestionsResponse], error)
 }
 
 // NewSingleCommandPermissionServiceClient constructs a client for the
@@ -97,6 +104,12 @@ func NewSingleCommandPermissionServiceClient(httpClient connect.HTTPClient, base
 			connect.WithSchema(singleCommandPermissionServiceMethods.ByName("DeleteSingleCommandPermission")),
 			connect.WithClientOptions(opts...),
 		),
+		listPermissionSuggestions: connect.NewClient[v1.ListPermissionSuggestionsRequest, v1.ListPermissionSuggestionsResponse](
+			httpClient,
+			baseURL+SingleCommandPermissionServiceListPermissionSuggestionsProcedure,
+			connect.WithSchema(singleCommandPermissionServiceMethods.ByName("ListPermissionSuggestions")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -106,6 +119,7 @@ type singleCommandPermissionServiceClient struct {
 	createSingleCommandPermission *connect.Client[v1.CreateSingleCommandPermissionRequest, v1.CreateSingleCommandPermissionResponse]
 	updateSingleCommandPermission *connect.Client[v1.UpdateSingleCommandPermissionRequest, v1.UpdateSingleCommandPermissionResponse]
 	deleteSingleCommandPermission *connect.Client[v1.DeleteSingleCommandPermissionRequest, v1.DeleteSingleCommandPermissionResponse]
+	listPermissionSuggestions     *connect.Client[v1.ListPermissionSuggestionsRequest, v1.ListPermissionSuggestionsResponse]
 }
 
 // ListSingleCommandPermissions calls
@@ -132,6 +146,12 @@ func (c *singleCommandPermissionServiceClient) DeleteSingleCommandPermission(ctx
 	return c.deleteSingleCommandPermission.CallUnary(ctx, req)
 }
 
+// ListPermissionSuggestions calls
+// taskguild.v1.SingleCommandPermissionService.ListPermissionSuggestions.
+func (c *singleCommandPermissionServiceClient) ListPermissionSuggestions(ctx context.Context, req *connect.Request[v1.ListPermissionSuggestionsRequest]) (*connect.Response[v1.ListPermissionSuggestionsResponse], error) {
+	return c.listPermissionSuggestions.CallUnary(ctx, req)
+}
+
 // SingleCommandPermissionServiceHandler is an implementation of the
 // taskguild.v1.SingleCommandPermissionService service.
 type SingleCommandPermissionServiceHandler interface {
@@ -143,6 +163,10 @@ type SingleCommandPermissionServiceHandler interface {
 	UpdateSingleCommandPermission(context.Context, *connect.Request[v1.UpdateSingleCommandPermissionRequest]) (*connect.Response[v1.UpdateSingleCommandPermissionResponse], error)
 	// DeleteSingleCommandPermission removes a permission rule.
 	DeleteSingleCommandPermission(context.Context, *connect.Request[v1.DeleteSingleCommandPermissionRequest]) (*connect.Response[v1.DeleteSingleCommandPermissionResponse], error)
+	// ListPermissionSuggestions mines the project's approved permission
+	// requests for commands worth turning into rules, and reports allow rules
+	// that no recent command has matched.
+	ListPermissionSuggestions(context.Context, *connect.Request[v1.ListPermissionSuggestionsRequest]) (*connect.Response[v1.ListPermissionSuggestionsResponse], error)
 }
 
 // NewSingleCommandPermissionServiceHandler builds an HTTP handler from the service implementation.
@@ -176,6 +200,12 @@ func NewSingleCommandPermissionServiceHandler(svc SingleCommandPermissionService
 		connect.WithSchema(singleCommandPermissionServiceMethods.ByName("DeleteSingleCommandPermission")),
 		connect.WithHandlerOptions(opts...),
 	)
+	singleCommandPermissionServiceListPermissionSuggestionsHandler := connect.NewUnaryHandler(
+		SingleCommandPermissionServiceListPermissionSuggestionsProcedure,
+		svc.ListPermissionSuggestions,
+		connect.WithSchema(singleCommandPermissionServiceMethods.ByName("ListPermissionSuggestions")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/taskguild.v1.SingleCommandPermissionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SingleCommandPermissionServiceListSingleCommandPermissionsProcedure:
@@ -186,6 +216,8 @@ func NewSingleCommandPermissionServiceHandler(svc SingleCommandPermissionService
 			singleCommandPermissionServiceUpdateSingleCommandPermissionHandler.ServeHTTP(w, r)
 		case SingleCommandPermissionServiceDeleteSingleCommandPermissionProcedure:
 			singleCommandPermissionServiceDeleteSingleCommandPermissionHandler.ServeHTTP(w, r)
+		case SingleCommandPermissionServiceListPermissionSuggestionsProcedure:
+			singleCommandPermissionServiceListPermissionSuggestionsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -210,3 +242,7 @@ func (UnimplementedSingleCommandPermissionServiceHandler) UpdateSingleCommandPer
 func (UnimplementedSingleCommandPermissionServiceHandler) DeleteSingleCommandPermission(context.Context, *connect.Request[v1.DeleteSingleCommandPermissionRequest]) (*connect.Response[v1.DeleteSingleCommandPermissionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.SingleCommandPermissionService.DeleteSingleCommandPermission is not implemented"))
 }
+
+func (UnimplementedSingleCommandPermissionServiceHandler) ListPermissionSuggestions(context.Context, *connect.Request[v1.ListPermissionSuggestionsRequest]) (*connect.Response[v1.ListPermissionSuggestionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.SingleCommandPermissionService.ListPermissionSuggestions is not implemented"))
+}
@@ -50,6 +50,11 @@ const (
 	TaskServiceStopTaskProcedure = "/taskguild.v1.TaskService/StopTask"
 	// TaskServiceResumeTaskProcedure is the fully-qualified name of the TaskService's ResumeTask RPC.
 	TaskServiceResumeTaskProcedure = "/taskguild.v1.TaskService/ResumeTask"
+	// TaskServiceCompactTaskProcedure is the fully-qualified name of the TaskService's CompactTask RPC.
+	TaskServiceCompactTaskProcedure = "/taskguild.v1.TaskService/CompactTask"
+	// TaskServiceRollbackTaskProcedure is the fully-qualified name of the TaskService's RollbackTask
+	// RPC.
+	TaskServiceRollbackTaskProcedure = "/taskguild.v1.TaskService/RollbackTask"
 	// TaskServiceArchiveTaskProcedure is the fully-qualified name of the TaskService's ArchiveTask RPC.
 	TaskServiceArchiveTaskProcedure = "/taskguild.v1.TaskService/ArchiveTask"
 	// TaskServiceArchiveTerminalTasksProcedure is the fully-qualified name of the TaskService's
@@ -86,6 +91,14 @@ type TaskServiceClient interface {
 	// Task lifecycle control
 	StopTask(context.Context, *connect.Request[v1.StopTaskRequest]) (*connect.Response[v1.StopTaskResponse], error)
 	ResumeTask(context.Context, *connect.Request[v1.ResumeTaskRequest]) (*connect.Response[v1.ResumeTaskResponse], error)
+	// CompactTask continues the task in a fresh Claude session seeded with a
+	// handoff summary of its history. A running task is compacted before its
+	// next turn; otherwise on its next run.
+	CompactTask(context.Context, *connect.Request[v1.CompactTaskRequest]) (*connect.Response[v1.CompactTaskResponse], error)
+	// RollbackTask restores the worktree of a stopped task to a checkpoint
+	// (see TASK_LOG_CATEGORY_CHECKPOINT) and rewinds its session. The result
+	// is published as EVENT_TYPE_TASK_ROLLBACK_RESULT.
+	RollbackTask(context.Context, *connect.Request[v1.RollbackTaskRequest]) (*connect.Response[v1.RollbackTaskResponse], error)
 	// Archive operations
 	ArchiveTask(context.Context, *connect.Request[v1.ArchiveTaskRequest]) (*connect.Response[v1.ArchiveTaskResponse], error)
 	ArchiveTerminalTasks(context.Context, *connect.Request[v1.ArchiveTerminalTasksRequest]) (*connect.Response[v1.ArchiveTerminalTasksResponse], error)
@@ -157,6 +170,18 @@ func NewTaskServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(taskServiceMethods.ByName("ResumeTask")),
 			connect.WithClientOptions(opts...),
 		),
+		compactTask: connect.NewClient[v1.CompactTaskRequest, v1.CompactTaskResponse](
+			httpClient,
+			baseURL+TaskServiceCompactTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("CompactTask")),
+			connect.WithClientOptions(opts...),
+		),
+		rollbackTask: connect.NewClient[v1.RollbackTaskRequest, v1.RollbackTaskResponse](
+			httpClient,
+			baseURL+TaskServiceRollbackTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("RollbackTask")),
+			connect.WithClientOptions(opts...),
+		),
 		archiveTask: connect.NewClient[v1.ArchiveTaskRequest, v1.ArchiveTaskResponse](
 			httpClient,
 			baseURL+TaskServiceArchiveTaskProcedure,
@@ -218,6 +243,8 @@ type taskServiceClient struct {
 	updateTaskStatus     *connect.Client[v1.UpdateTaskStatusRequest, v1.UpdateTaskStatusResponse]
 	stopTask             *connect.Client[v1.StopTaskRequest, v1.StopTaskResponse]
 	resumeTask           *connect.Client[v1.ResumeTaskRequest, v1.ResumeTaskResponse]
+	compactTask          *connect.Client[v1.CompactTaskRequest, v1.CompactTaskResponse]
+	rollbackTask         *connect.Client[v1.RollbackTaskRequest, v1.RollbackTaskResponse]
 	archiveTask          *connect.Client[v1.ArchiveTaskRequest, v1.ArchiveTaskResponse]
 	archiveTerminalTasks *connect.Client[v1.ArchiveTerminalTasksRequest, v1.ArchiveTerminalTasksResponse]
 	unarchiveTask        *connect.Client[v1.UnarchiveTaskRequest, v1.UnarchiveTaskResponse]
@@ -268,6 +295,16 @@ func (c *taskServiceClient) ResumeTask(ctx context.Context, req *connect.Request
 	return c.resumeTask.CallUnary(ctx, req)
 }
 
+// CompactTask calls taskguild.v1.TaskService.CompactTask.
+func (c *taskServiceClient) CompactTask(ctx context.Context, req *connect.Request[v1.CompactTaskRequest]) (*connect.Response[v1.CompactTaskResponse], error) {
+	return c.compactTask.CallUnary(ctx, req)
+}
+
+// RollbackTask calls taskguild.v1.TaskService.RollbackTask.
+func (c *taskServiceClient) RollbackTask(ctx context.Context, req *connect.Request[v1.RollbackTaskRequest]) (*connect.Response[v1.RollbackTaskResponse], error) {
+	return c.rollbackTask.CallUnary(ctx, req)
+}
+
 // ArchiveTask calls taskguild.v1.TaskService.ArchiveTask.
 func (c *taskServiceClient) ArchiveTask(ctx context.Context, req *connect.Request[v1.ArchiveTaskRequest]) (*connect.Response[v1.ArchiveTaskResponse], error) {
 	return c.archiveTask.CallUnary(ctx, req)
@@ -319,6 +356,14 @@ type TaskServiceHandler interface {
 	// Task lifecycle control
 	StopTask(context.Context, *connect.Request[v1.StopTaskRequest]) (*connect.Response[v1.StopTaskResponse], error)
 	ResumeTask(context.Context, *connect.Request[v1.ResumeTaskRequest]) (*connect.Response[v1.ResumeTaskResponse], error)
+	// CompactTask continues the task in a fresh Claude session seeded with a
+	// handoff summary of its history. A running task is compacted before its
+	// next turn; otherwise on its next run.
+	CompactTask(context.Context, *connect.Request[v1.CompactTaskRequest]) (*connect.Response[v1.CompactTaskResponse], error)
+	// RollbackTask restores the worktree of a stopped task to a checkpoint
+	// (see TASK_LOG_CATEGORY_CHECKPOINT) and rewinds its session. The result
+	// is published as EVENT_TYPE_TASK_ROLLBACK_RESULT.
+	RollbackTask(context.Context, *connect.Request[v1.RollbackTaskRequest]) (*connect.Response[v1.RollbackTaskResponse], error)
 	// Archive operations
 	ArchiveTask(context.Context, *connect.Request[v1.ArchiveTaskRequest]) (*connect.Response[v1.ArchiveTaskResponse], error)
 	ArchiveTerminalTasks(context.Context, *connect.Request[v1.ArchiveTerminalTasksRequest]) (*connect.Response[v1.ArchiveTerminalTasksResponse], error)
@@ -386,6 +431,18 @@ func NewTaskServiceHandler(svc TaskServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(taskServiceMethods.ByName("ResumeTask")),
 		connect.WithHandlerOptions(opts...),
 	)
+	taskServiceCompactTaskHandler := connect.NewUnaryHandler(
+		TaskServiceCompactTaskProcedure,
+		svc.CompactTask,
+		connect.WithSchema(taskServiceMethods.ByName("CompactTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceRollbackTaskHandler := connect.NewUnaryHandler(
+		TaskServiceRollbackTaskProcedure,
+		svc.RollbackTask,
+		connect.WithSchema(taskServiceMethods.ByName("RollbackTask")),
+		connect.WithHandlerOptions(opts...),
+	)
 	taskServiceArchiveTaskHandler := connect.NewUnaryHandler(
 		TaskServiceArchiveTaskProcedure,
 		svc.ArchiveTask,
@@ -452,6 +509,10 @@ func NewTaskServiceHandler(svc TaskServiceHandler, opts ...connect.HandlerOption
 			taskServiceStopTaskHandler.ServeHTTP(w, r)
 		case TaskServiceResumeTaskProcedure:
 			taskServiceResumeTaskHandler.ServeHTTP(w, r)
+		case TaskServiceCompactTaskProcedure:
+			taskServiceCompactTaskHandler.ServeHTTP(w, r)
+		case TaskServiceRollbackTaskProcedure:
+			taskServiceRollbackTaskHandler.ServeHTTP(w, r)
 		case TaskServiceArchiveTaskProcedure:
 			taskServiceArchiveTaskHandler.ServeHTTP(w, r)
 		case TaskServiceArchiveTerminalTasksProcedure:
@@ -509,6 +570,14 @@ func (UnimplementedTaskServiceHandler) ResumeTask(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.TaskService.ResumeTask is not implemented"))
 }
 
+func (UnimplementedTaskServiceHandler) CompactTask(context.Context, *connect.Request[v1.CompactTaskRequest]) (*connect.Response[v1.CompactTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.TaskService.CompactTask is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) RollbackTask(context.Context, *connect.Request[v1.RollbackTaskRequest]) (*connect.Response[v1.RollbackTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.TaskService.RollbackTask is not implemented"))
+}
+
 func (UnimplementedTaskServiceHandler) ArchiveTask(context.Context, *connect.Request[v1.ArchiveTaskRequest]) (*connect.Response[v1.ArchiveTaskResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("taskguild.v1.TaskService.ArchiveTask is not implemented"))
 }
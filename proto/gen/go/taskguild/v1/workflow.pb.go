@@ -398,7 +398,16 @@ type WorkflowStatus struct {
 	EnableSkillHarness             bool `protobuf:"varint,17,opt,name=enable_skill_harness,json=enableSkillHarness,proto3" json:"enable_skill_harness,omitempty"`
 	SkillHarnessExplicitlyDisabled bool `protobuf:"varint,18,opt,name=skill_harness_explicitly_disabled,json=skillHarnessExplicitlyDisabled,proto3" json:"skill_harness_explicitly_disabled,omitempty"`
 	// Effort controls thinking depth. Valid values: "low", "medium", "high", "xhigh", "max".
-	Effort        string `protobuf:"bytes,19,opt,name=effort,proto3" json:"effort,omitempty"`
+	Effort string `protobuf:"bytes,19,opt,name=effort,proto3" json:"effort,omitempty"`
+	// Models to fall back to, in order, when the primary model is overloaded
+	// or rate-limited (e.g. ["sonnet", "haiku"]).
+	FallbackModels []string `protobuf:"bytes,20,rep,name=fallback_models,json=fallbackModels,proto3" json:"fallback_models,omitempty"`
+	// Linux sandbox for Bash commands spawned by the agent. Statuses using
+	// bypassPermissions are always sandboxed.
+	Sandbox *SandboxConfig `protobuf:"bytes,21,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+	// Path policy for edit tools and shell redirects in this status. A
+	// non-empty allow list replaces the project's; deny lists are combined.
+	PathPolicy    *PathPolicy `protobuf:"bytes,22,opt,name=path_policy,json=pathPolicy,proto3" json:"path_policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -553,6 +562,113 @@ func (x *WorkflowStatus) GetEffort() string {
 	return ""
 }
 
+func (x *WorkflowStatus) GetFallbackModels() []string {
+	if x != nil {
+		return x.FallbackModels
+	}
+	return nil
+}
+
+func (x *WorkflowStatus) GetSandbox() *SandboxConfig {
+	if x != nil {
+		return x.Sandbox
+	}
+	return nil
+}
+
+func (x *WorkflowStatus) GetPathPolicy() *PathPolicy {
+	if x != nil {
+		return x.PathPolicy
+	}
+	return nil
+}
+
+// SandboxConfig confines agent-spawned shell commands with bubblewrap:
+// the working tree is writable and the rest of the filesystem is read-only.
+type SandboxConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Enabled         bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	AllowNetwork    bool                   `protobuf:"varint,2,opt,name=allow_network,json=allowNetwork,proto3" json:"allow_network,omitempty"`
+	CpuLimitSeconds int32                  `protobuf:"varint,3,opt,name=cpu_limit_seconds,json=cpuLimitSeconds,proto3" json:"cpu_limit_seconds,omitempty"` // 0 = unlimited
+	MemoryLimitMb   int32                  `protobuf:"varint,4,opt,name=memory_limit_mb,json=memoryLimitMb,proto3" json:"memory_limit_mb,omitempty"`       // 0 = unlimited
+	TimeoutSeconds  int32                  `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`      // 0 = unlimited
+	WritablePaths   []string               `protobuf:"bytes,6,rep,name=writable_paths,json=writablePaths,proto3" json:"writable_paths,omitempty"`          // extra writable paths (e.g. ~/.cache)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SandboxConfig) Reset() {
+	*x = SandboxConfig{}
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SandboxConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SandboxConfig) ProtoMessage() {}
+
+func (x *SandboxConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SandboxConfig.ProtoReflect.Descriptor instead.
+func (*SandboxConfig) Descriptor() ([]byte, []int) {
+	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SandboxConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SandboxConfig) GetAllowNetwork() bool {
+	if x != nil {
+		return x.AllowNetwork
+	}
+	return false
+}
+
+func (x *SandboxConfig) GetCpuLimitSeconds() int32 {
+	if x != nil {
+		return x.CpuLimitSeconds
+	}
+	return 0
+}
+
+func (x *SandboxConfig) GetMemoryLimitMb() int32 {
+	if x != nil {
+		return x.MemoryLimitMb
+	}
+	return 0
+}
+
+func (x *SandboxConfig) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *SandboxConfig) GetWritablePaths() []string {
+	if x != nil {
+		return x.WritablePaths
+	}
+	return nil
+}
+
 // AgentConfig defines how an agent should behave for a specific status.
 type AgentConfig struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -570,7 +686,7 @@ type AgentConfig struct {
 
 func (x *AgentConfig) Reset() {
 	*x = AgentConfig{}
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[3]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -582,7 +698,7 @@ func (x *AgentConfig) String() string {
 func (*AgentConfig) ProtoMessage() {}
 
 func (x *AgentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[3]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,7 +711,7 @@ func (x *AgentConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentConfig.ProtoReflect.Descriptor instead.
 func (*AgentConfig) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{3}
+	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AgentConfig) GetId() string {
@@ -660,7 +776,7 @@ type CreateWorkflowRequest struct {
 
 func (x *CreateWorkflowRequest) Reset() {
 	*x = CreateWorkflowRequest{}
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[4]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -672,7 +788,7 @@ func (x *CreateWorkflowRequest) String() string {
 func (*CreateWorkflowRequest) ProtoMessage() {}
 
 func (x *CreateWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[4]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -685,7 +801,7 @@ func (x *CreateWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*CreateWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{4}
+	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateWorkflowRequest) GetProjectId() string {
@@ -753,7 +869,7 @@ type CreateWorkflowResponse struct {
 
 func (x *CreateWorkflowResponse) Reset() {
 	*x = CreateWorkflowResponse{}
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[5]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -765,7 +881,7 @@ func (x *CreateWorkflowResponse) String() string {
 func (*CreateWorkflowResponse) ProtoMessage() {}
 
 func (x *CreateWorkflowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[5]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,7 +894,7 @@ func (x *CreateWorkflowResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateWorkflowResponse.ProtoReflect.Descriptor instead.
 func (*CreateWorkflowResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{5}
+	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateWorkflowResponse) GetWorkflow() *Workflow {
@@ -797,7 +913,7 @@ type GetWorkflowRequest struct {
 
 func (x *GetWorkflowRequest) Reset() {
 	*x = GetWorkflowRequest{}
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[6]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -809,7 +925,7 @@ func (x *GetWorkflowRequest) String() string {
 func (*GetWorkflowRequest) ProtoMessage() {}
 
 func (x *GetWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[6]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,7 +938,7 @@ func (x *GetWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*GetWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{6}
+	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetWorkflowRequest) GetId() string {
@@ -841,7 +957,7 @@ type GetWorkflowResponse struct {
 
 func (x *GetWorkflowResponse) Reset() {
 	*x = GetWorkflowResponse{}
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[7]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -853,7 +969,7 @@ func (x *GetWorkflowResponse) String() string {
 func (*GetWorkflowResponse) ProtoMessage() {}
 
 func (x *GetWorkflowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[7]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -866,7 +982,7 @@ func (x *GetWorkflowResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorkflowResponse.ProtoReflect.Descriptor instead.
 func (*GetWorkflowResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{7}
+	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetWorkflowResponse) GetWorkflow() *Workflow {
@@ -886,7 +1002,7 @@ type ListWorkflowsRequest struct {
 
 func (x *ListWorkflowsRequest) Reset() {
 	*x = ListWorkflowsRequest{}
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[8]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -898,7 +1014,7 @@ func (x *ListWorkflowsRequest) String() string {
 func (*ListWorkflowsRequest) ProtoMessage() {}
 
 func (x *ListWorkflowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[8]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -911,7 +1027,7 @@ func (x *ListWorkflowsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWorkflowsRequest.ProtoReflect.Descriptor instead.
 func (*ListWorkflowsRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{8}
+	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListWorkflowsRequest) GetProjectId() string {
@@ -938,7 +1054,7 @@ type ListWorkflowsResponse struct {
 
 func (x *ListWorkflowsResponse) Reset() {
 	*x = ListWorkflowsResponse{}
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[9]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -950,7 +1066,7 @@ func (x *ListWorkflowsResponse) String() string {
 func (*ListWorkflowsResponse) ProtoMessage() {}
 
 func (x *ListWorkflowsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[9]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -963,7 +1079,7 @@ func (x *ListWorkflowsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWorkflowsResponse.ProtoReflect.Descriptor instead.
 func (*ListWorkflowsResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{9}
+	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListWorkflowsResponse) GetWorkflows() []*Workflow {
@@ -1000,7 +1116,7 @@ type UpdateWorkflowRequest struct {
 
 func (x *UpdateWorkflowRequest) Reset() {
 	*x = UpdateWorkflowRequest{}
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[10]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1012,7 +1128,7 @@ func (x *UpdateWorkflowRequest) String() string {
 func (*UpdateWorkflowRequest) ProtoMessage() {}
 
 func (x *UpdateWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[10]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1025,7 +1141,7 @@ func (x *UpdateWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*UpdateWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{10}
+	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateWorkflowRequest) GetId() string {
@@ -1093,7 +1209,7 @@ type UpdateWorkflowResponse struct {
 
 func (x *UpdateWorkflowResponse) Reset() {
 	*x = UpdateWorkflowResponse{}
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[11]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1105,7 +1221,7 @@ func (x *UpdateWorkflowResponse) String() string {
 func (*UpdateWorkflowResponse) ProtoMessage() {}
 
 func (x *UpdateWorkflowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[11]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1118,7 +1234,7 @@ func (x *UpdateWorkflowResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateWorkflowResponse.ProtoReflect.Descriptor instead.
 func (*UpdateWorkflowResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{11}
+	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateWorkflowResponse) GetWorkflow() *Workflow {
@@ -1137,7 +1253,7 @@ type DeleteWorkflowRequest struct {
 
 func (x *DeleteWorkflowRequest) Reset() {
 	*x = DeleteWorkflowRequest{}
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[12]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1149,7 +1265,7 @@ func (x *DeleteWorkflowRequest) String() string {
 func (*DeleteWorkflowRequest) ProtoMessage() {}
 
 func (x *DeleteWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[12]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,7 +1278,7 @@ func (x *DeleteWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*DeleteWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{12}
+	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteWorkflowRequest) GetId() string {
@@ -1180,7 +1296,7 @@ type DeleteWorkflowResponse struct {
 
 func (x *DeleteWorkflowResponse) Reset() {
 	*x = DeleteWorkflowResponse{}
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[13]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1192,7 +1308,7 @@ func (x *DeleteWorkflowResponse) String() string {
 func (*DeleteWorkflowResponse) ProtoMessage() {}
 
 func (x *DeleteWorkflowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_taskguild_v1_workflow_proto_msgTypes[13]
+	mi := &file_taskguild_v1_workflow_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,7 +1321,7 @@ func (x *DeleteWorkflowResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteWorkflowResponse.ProtoReflect.Descriptor instead.
 func (*DeleteWorkflowResponse) Descriptor() ([]byte, []int) {
-	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{13}
+	return file_taskguild_v1_workflow_proto_rawDescGZIP(), []int{14}
 }
 
 var File_taskguild_v1_workflow_proto protoreflect.FileDescriptor
@@ -1241,7 +1357,7 @@ const file_taskguild_v1_workflow_proto_rawDesc = "" +
 	"\taction_id\x18\a \x01(\tR\bactionId\x12\x1d\n" +
 	"\n" +
 	"skill_name\x18\b \x01(\tR\tskillName\x12\x12\n" +
-	"\x04args\x18\t \x01(\tR\x04args\"\xaf\x05\n" +
+	"\x04args\x18\t \x01(\tR\x04args\"\xca\x06\n" +
 	"\x0eWorkflowStatus\x12\x12\n" +
 	"\x02id\x18\x01 \x01(\tB\x02\x18\x01R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -1261,9 +1377,20 @@ const file_taskguild_v1_workflow_proto_rawDesc = "" +
 	"\tskill_ids\x18\x10 \x03(\tR\bskillIds\x120\n" +
 	"\x14enable_skill_harness\x18\x11 \x01(\bR\x12enableSkillHarness\x12I\n" +
 	"!skill_harness_explicitly_disabled\x18\x12 \x01(\bR\x1eskillHarnessExplicitlyDisabled\x12\x16\n" +
-	"\x06effort\x18\x13 \x01(\tR\x06effortJ\x04\b\t\x10\n" +
+	"\x06effort\x18\x13 \x01(\tR\x06effort\x12'\n" +
+	"\x0ffallback_models\x18\x14 \x03(\tR\x0efallbackModels\x125\n" +
+	"\asandbox\x18\x15 \x01(\v2\x1b.taskguild.v1.SandboxConfigR\asandbox\x129\n" +
+	"\vpath_policy\x18\x16 \x01(\v2\x18.taskguild.v1.PathPolicyR\n" +
+	"pathPolicyJ\x04\b\t\x10\n" +
 	"J\x04\b\n" +
-	"\x10\vR\x17enable_agent_md_harnessR$agent_md_harness_explicitly_disabled\"\xca\x01\n" +
+	"\x10\vR\x17enable_agent_md_harnessR$agent_md_harness_explicitly_disabled\"\xf2\x01\n" +
+	"\rSandboxConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12#\n" +
+	"\rallow_network\x18\x02 \x01(\bR\fallowNetwork\x12*\n" +
+	"\x11cpu_limit_seconds\x18\x03 \x01(\x05R\x0fcpuLimitSeconds\x12&\n" +
+	"\x0fmemory_limit_mb\x18\x04 \x01(\x05R\rmemoryLimitMb\x12'\n" +
+	"\x0ftimeout_seconds\x18\x05 \x01(\x05R\x0etimeoutSeconds\x12%\n" +
+	"\x0ewritable_paths\x18\x06 \x03(\tR\rwritablePaths\"\xca\x01\n" +
 	"\vAgentConfig\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
 	"\x12workflow_status_id\x18\x02 \x01(\tR\x10workflowStatusId\x12\x12\n" +
@@ -1344,61 +1471,65 @@ func file_taskguild_v1_workflow_proto_rawDescGZIP() []byte {
 }
 
 var file_taskguild_v1_workflow_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_taskguild_v1_workflow_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_taskguild_v1_workflow_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_taskguild_v1_workflow_proto_goTypes = []any{
 	(HookTrigger)(0),               // 0: taskguild.v1.HookTrigger
 	(HookActionType)(0),            // 1: taskguild.v1.HookActionType
 	(*Workflow)(nil),               // 2: taskguild.v1.Workflow
 	(*StatusHook)(nil),             // 3: taskguild.v1.StatusHook
 	(*WorkflowStatus)(nil),         // 4: taskguild.v1.WorkflowStatus
-	(*AgentConfig)(nil),            // 5: taskguild.v1.AgentConfig
-	(*CreateWorkflowRequest)(nil),  // 6: taskguild.v1.CreateWorkflowRequest
-	(*CreateWorkflowResponse)(nil), // 7: taskguild.v1.CreateWorkflowResponse
-	(*GetWorkflowRequest)(nil),     // 8: taskguild.v1.GetWorkflowRequest
-	(*GetWorkflowResponse)(nil),    // 9: taskguild.v1.GetWorkflowResponse
-	(*ListWorkflowsRequest)(nil),   // 10: taskguild.v1.ListWorkflowsRequest
-	(*ListWorkflowsResponse)(nil),  // 11: taskguild.v1.ListWorkflowsResponse
-	(*UpdateWorkflowRequest)(nil),  // 12: taskguild.v1.UpdateWorkflowRequest
-	(*UpdateWorkflowResponse)(nil), // 13: taskguild.v1.UpdateWorkflowResponse
-	(*DeleteWorkflowRequest)(nil),  // 14: taskguild.v1.DeleteWorkflowRequest
-	(*DeleteWorkflowResponse)(nil), // 15: taskguild.v1.DeleteWorkflowResponse
-	(*timestamppb.Timestamp)(nil),  // 16: google.protobuf.Timestamp
-	(*PaginationRequest)(nil),      // 17: taskguild.v1.PaginationRequest
-	(*PaginationResponse)(nil),     // 18: taskguild.v1.PaginationResponse
+	(*SandboxConfig)(nil),          // 5: taskguild.v1.SandboxConfig
+	(*AgentConfig)(nil),            // 6: taskguild.v1.AgentConfig
+	(*CreateWorkflowRequest)(nil),  // 7: taskguild.v1.CreateWorkflowRequest
+	(*CreateWorkflowResponse)(nil), // 8: taskguild.v1.CreateWorkflowResponse
+	(*GetWorkflowRequest)(nil),     // 9: taskguild.v1.GetWorkflowRequest
+	(*GetWorkflowResponse)(nil),    // 10: taskguild.v1.GetWorkflowResponse
+	(*ListWorkflowsRequest)(nil),   // 11: taskguild.v1.ListWorkflowsRequest
+	(*ListWorkflowsResponse)(nil),  // 12: taskguild.v1.ListWorkflowsResponse
+	(*UpdateWorkflowRequest)(nil),  // 13: taskguild.v1.UpdateWorkflowRequest
+	(*UpdateWorkflowResponse)(nil), // 14: taskguild.v1.UpdateWorkflowResponse
+	(*DeleteWorkflowRequest)(nil),  // 15: taskguild.v1.DeleteWorkflowRequest
+	(*DeleteWorkflowResponse)(nil), // 16: taskguild.v1.DeleteWorkflowResponse
+	(*timestamppb.Timestamp)(nil),  // 17: google.protobuf.Timestamp
+	(*PathPolicy)(nil),             // 18: taskguild.v1.PathPolicy
+	(*PaginationRequest)(nil),      // 19: taskguild.v1.PaginationRequest
+	(*PaginationResponse)(nil),     // 20: taskguild.v1.PaginationResponse
 }
 var file_taskguild_v1_workflow_proto_depIdxs = []int32{
 	4,  // 0: taskguild.v1.Workflow.statuses:type_name -> taskguild.v1.WorkflowStatus
-	5,  // 1: taskguild.v1.Workflow.agent_configs:type_name -> taskguild.v1.AgentConfig
-	16, // 2: taskguild.v1.Workflow.created_at:type_name -> google.protobuf.Timestamp
-	16, // 3: taskguild.v1.Workflow.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 1: taskguild.v1.Workflow.agent_configs:type_name -> taskguild.v1.AgentConfig
+	17, // 2: taskguild.v1.Workflow.created_at:type_name -> google.protobuf.Timestamp
+	17, // 3: taskguild.v1.Workflow.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 4: taskguild.v1.StatusHook.trigger:type_name -> taskguild.v1.HookTrigger
 	1,  // 5: taskguild.v1.StatusHook.action_type:type_name -> taskguild.v1.HookActionType
 	3,  // 6: taskguild.v1.WorkflowStatus.hooks:type_name -> taskguild.v1.StatusHook
-	4,  // 7: taskguild.v1.CreateWorkflowRequest.statuses:type_name -> taskguild.v1.WorkflowStatus
-	5,  // 8: taskguild.v1.CreateWorkflowRequest.agent_configs:type_name -> taskguild.v1.AgentConfig
-	2,  // 9: taskguild.v1.CreateWorkflowResponse.workflow:type_name -> taskguild.v1.Workflow
-	2,  // 10: taskguild.v1.GetWorkflowResponse.workflow:type_name -> taskguild.v1.Workflow
-	17, // 11: taskguild.v1.ListWorkflowsRequest.pagination:type_name -> taskguild.v1.PaginationRequest
-	2,  // 12: taskguild.v1.ListWorkflowsResponse.workflows:type_name -> taskguild.v1.Workflow
-	18, // 13: taskguild.v1.ListWorkflowsResponse.pagination:type_name -> taskguild.v1.PaginationResponse
-	4,  // 14: taskguild.v1.UpdateWorkflowRequest.statuses:type_name -> taskguild.v1.WorkflowStatus
-	5,  // 15: taskguild.v1.UpdateWorkflowRequest.agent_configs:type_name -> taskguild.v1.AgentConfig
-	2,  // 16: taskguild.v1.UpdateWorkflowResponse.workflow:type_name -> taskguild.v1.Workflow
-	6,  // 17: taskguild.v1.WorkflowService.CreateWorkflow:input_type -> taskguild.v1.CreateWorkflowRequest
-	8,  // 18: taskguild.v1.WorkflowService.GetWorkflow:input_type -> taskguild.v1.GetWorkflowRequest
-	10, // 19: taskguild.v1.WorkflowService.ListWorkflows:input_type -> taskguild.v1.ListWorkflowsRequest
-	12, // 20: taskguild.v1.WorkflowService.UpdateWorkflow:input_type -> taskguild.v1.UpdateWorkflowRequest
-	14, // 21: taskguild.v1.WorkflowService.DeleteWorkflow:input_type -> taskguild.v1.DeleteWorkflowRequest
-	7,  // 22: taskguild.v1.WorkflowService.CreateWorkflow:output_type -> taskguild.v1.CreateWorkflowResponse
-	9,  // 23: taskguild.v1.WorkflowService.GetWorkflow:output_type -> taskguild.v1.GetWorkflowResponse
-	11, // 24: taskguild.v1.WorkflowService.ListWorkflows:output_type -> taskguild.v1.ListWorkflowsResponse
-	13, // 25: taskguild.v1.WorkflowService.UpdateWorkflow:output_type -> taskguild.v1.UpdateWorkflowResponse
-	15, // 26: taskguild.v1.WorkflowService.DeleteWorkflow:output_type -> taskguild.v1.DeleteWorkflowResponse
-	22, // [22:27] is the sub-list for method output_type
-	17, // [17:22] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	5,  // 7: taskguild.v1.WorkflowStatus.sandbox:type_name -> taskguild.v1.SandboxConfig
+	18, // 8: taskguild.v1.WorkflowStatus.path_policy:type_name -> taskguild.v1.PathPolicy
+	4,  // 9: taskguild.v1.CreateWorkflowRequest.statuses:type_name -> taskguild.v1.WorkflowStatus
+	6,  // 10: taskguild.v1.CreateWorkflowRequest.agent_configs:type_name -> taskguild.v1.AgentConfig
+	2,  // 11: taskguild.v1.CreateWorkflowResponse.workflow:type_name -> taskguild.v1.Workflow
+	2,  // 12: taskguild.v1.GetWorkflowResponse.workflow:type_name -> taskguild.v1.Workflow
+	19, // 13: taskguild.v1.ListWorkflowsRequest.pagination:type_name -> taskguild.v1.PaginationRequest
+	2,  // 14: taskguild.v1.ListWorkflowsResponse.workflows:type_name -> taskguild.v1.Workflow
+	20, // 15: taskguild.v1.ListWorkflowsResponse.pagination:type_name -> taskguild.v1.PaginationResponse
+	4,  // 16: taskguild.v1.UpdateWorkflowRequest.statuses:type_name -> taskguild.v1.WorkflowStatus
+	6,  // 17: taskguild.v1.UpdateWorkflowRequest.agent_configs:type_name -> taskguild.v1.AgentConfig
+	2,  // 18: taskguild.v1.UpdateWorkflowResponse.workflow:type_name -> taskguild.v1.Workflow
+	7,  // 19: taskguild.v1.WorkflowService.CreateWorkflow:input_type -> taskguild.v1.CreateWorkflowRequest
+	9,  // 20: taskguild.v1.WorkflowService.GetWorkflow:input_type -> taskguild.v1.GetWorkflowRequest
+	11, // 21: taskguild.v1.WorkflowService.ListWorkflows:input_type -> taskguild.v1.ListWorkflowsRequest
+	13, // 22: taskguild.v1.WorkflowService.UpdateWorkflow:input_type -> taskguild.v1.UpdateWorkflowRequest
+	15, // 23: taskguild.v1.WorkflowService.DeleteWorkflow:input_type -> taskguild.v1.DeleteWorkflowRequest
+	8,  // 24: taskguild.v1.WorkflowService.CreateWorkflow:output_type -> taskguild.v1.CreateWorkflowResponse
+	10, // 25: taskguild.v1.WorkflowService.GetWorkflow:output_type -> taskguild.v1.GetWorkflowResponse
+	12, // 26: taskguild.v1.WorkflowService.ListWorkflows:output_type -> taskguild.v1.ListWorkflowsResponse
+	14, // 27: taskguild.v1.WorkflowService.UpdateWorkflow:output_type -> taskguild.v1.UpdateWorkflowResponse
+	16, // 28: taskguild.v1.WorkflowService.DeleteWorkflow:output_type -> taskguild.v1.DeleteWorkflowResponse
+	24, // [24:29] is the sub-list for method output_type
+	19, // [19:24] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_taskguild_v1_workflow_proto_init() }
@@ -1413,7 +1544,7 @@ func file_taskguild_v1_workflow_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_taskguild_v1_workflow_proto_rawDesc), len(file_taskguild_v1_workflow_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   14,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -254,3 +254,81 @@ export const resolveSkillConflict = AgentManagerService.method.resolveSkillConfl
  * @generated from rpc taskguild.v1.AgentManagerService.SyncClaudeSettings
  */
 export const syncClaudeSettings = AgentManagerService.method.syncClaudeSettings;
+
+/**
+ * DrainAgentManager tells a connected agent-manager to stop claiming new
+ * tasks and let running ones finish (called by frontend / CLI). Setting
+ * resume lifts a previous drain.
+ *
+ * @generated from rpc taskguild.v1.AgentManagerService.DrainAgentManager
+ */
+export const drainAgentManager = AgentManagerService.method.drainAgentManager;
+
+/**
+ * ListAgentManagers returns the connected agent-managers and their state.
+ *
+ * @generated from rpc taskguild.v1.AgentManagerService.ListAgentManagers
+ */
+export const listAgentManagers = AgentManagerService.method.listAgentManagers;
+
+/**
+ * UploadSessionTranscript stores a Claude session transcript so the task
+ * can resume its session on another agent-manager.
+ *
+ * @generated from rpc taskguild.v1.AgentManagerService.UploadSessionTranscript
+ */
+export const uploadSessionTranscript = AgentManagerService.method.uploadSessionTranscript;
+
+/**
+ * DownloadSessionTranscript returns a stored Claude session transcript.
+ *
+ * @generated from rpc taskguild.v1.AgentManagerService.DownloadSessionTranscript
+ */
+export const downloadSessionTranscript = AgentManagerService.method.downloadSessionTranscript;
+
+/**
+ * GetTaskHandoff returns the task logs an agent uses to build a handoff
+ * summary when a task continues in a fresh Claude session.
+ *
+ * @generated from rpc taskguild.v1.AgentManagerService.GetTaskHandoff
+ */
+export const getTaskHandoff = AgentManagerService.method.getTaskHandoff;
+
+/**
+ * ReportTaskRollbackResult reports the outcome of a checkpoint rollback
+ * from the agent.
+ *
+ * @generated from rpc taskguild.v1.AgentManagerService.ReportTaskRollbackResult
+ */
+export const reportTaskRollbackResult = AgentManagerService.method.reportTaskRollbackResult;
+
+/**
+ * GetTaskDiff returns the diff of a task's worktree branch against the
+ * project's default branch, computed by the agent-manager holding the
+ * worktree.
+ *
+ * @generated from rpc taskguild.v1.AgentManagerService.GetTaskDiff
+ */
+export const getTaskDiff = AgentManagerService.method.getTaskDiff;
+
+/**
+ * ReportTaskDiff reports the diff computed for a TaskDiffCommand.
+ *
+ * @generated from rpc taskguild.v1.AgentManagerService.ReportTaskDiff
+ */
+export const reportTaskDiff = AgentManagerService.method.reportTaskDiff;
+
+/**
+ * ReportMergeResult reports the outcome of a MergeWorktreeCommand.
+ *
+ * @generated from rpc taskguild.v1.AgentManagerService.ReportMergeResult
+ */
+export const reportMergeResult = AgentManagerService.method.reportMergeResult;
+
+/**
+ * ReportModifiedFiles reports the files a worktree task has modified so
+ * the server can warn about overlaps with other active tasks.
+ *
+ * @generated from rpc taskguild.v1.AgentManagerService.ReportModifiedFiles
+ */
+export const reportModifiedFiles = AgentManagerService.method.reportModifiedFiles;
@@ -20,7 +20,7 @@ import type { SkillDefinition } from "./skill_pb.ts";
 import { file_taskguild_v1_skill } from "./skill_pb.ts";
 import type { Attribution, ClaudeSettings } from "./claude_settings_pb.ts";
 import { file_taskguild_v1_claude_settings } from "./claude_settings_pb.ts";
-import type { TaskLogCategory, TaskLogLevel } from "./task_log_pb.ts";
+import type { TaskLog, TaskLogCategory, TaskLogLevel } from "./task_log_pb.ts";
 import { file_taskguild_v1_task_log } from "./task_log_pb.ts";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -28,7 +28,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file taskguild/v1/agent_manager.proto.
  */
 export const file_taskguild_v1_agent_manager: GenFile = /*@__PURE__*/
-  fileDesc("CiB0YXNrZ3VpbGQvdjEvYWdlbnRfbWFuYWdlci5wcm90bxIMdGFza2d1aWxkLnYxIq4BChxBZ2VudE1hbmFnZXJTdWJzY3JpYmVSZXF1ZXN0EhgKEGFnZW50X21hbmFnZXJfaWQYASABKAkSFAoMcHJvamVjdF9uYW1lGAIgASgJEhwKFG1heF9jb25jdXJyZW50X3Rhc2tzGAMgASgFEhcKD2FjdGl2ZV90YXNrX2lkcxgEIAMoCRIVCg1hZ2VudF92ZXJzaW9uGAUgASgJEhAKCHdvcmtfZGlyGAYgASgJItwICgxBZ2VudENvbW1hbmQSPAoOdGFza19hdmFpbGFibGUYASABKAsyIi50YXNrZ3VpbGQudjEuVGFza0F2YWlsYWJsZUNvbW1hbmRIABI2Cgthc3NpZ25fdGFzaxgCIAEoCzIfLnRhc2tndWlsZC52MS5Bc3NpZ25UYXNrQ29tbWFuZEgAEjYKC2NhbmNlbF90YXNrGAMgASgLMh8udGFza2d1aWxkLnYxLkNhbmNlbFRhc2tDb21tYW5kSAASSAoUaW50ZXJhY3Rpb25fcmVzcG9uc2UYBCABKAsyKC50YXNrZ3VpbGQudjEuSW50ZXJhY3Rpb25SZXNwb25zZUNvbW1hbmRIABI2CgtzeW5jX2FnZW50cxgFIAEoCzIfLnRhc2tndWlsZC52MS5TeW5jQWdlbnRzQ29tbWFuZEgAEkAKEHN5bmNfcGVybWlzc2lvbnMYBiABKAsyJC50YXNrZ3VpbGQudjEuU3luY1Blcm1pc3Npb25zQ29tbWFuZEgAEjwKDmxpc3Rfd29ya3RyZWVzGAcgASgLMiIudGFza2d1aWxkLnYxLkxpc3RXb3JrdHJlZXNDb21tYW5kSAASPgoPZGVsZXRlX3dvcmt0cmVlGAggASgLMiMudGFza2d1aWxkLnYxLkRlbGV0ZVdvcmt0cmVlQ29tbWFuZEgAEjkKDWdpdF9wdWxsX21haW4YCSABKAsyIC50YXNrZ3VpbGQudjEuR2l0UHVsbE1haW5Db21tYW5kSAASOAoMc3luY19zY3JpcHRzGAogASgLMiAudGFza2d1aWxkLnYxLlN5bmNTY3JpcHRzQ29tbWFuZEgAEjwKDmV4ZWN1dGVfc2NyaXB0GAsgASgLMiIudGFza2d1aWxkLnYxLkV4ZWN1dGVTY3JpcHRDb21tYW5kSAASKQoEcGluZxgMIAEoCzIZLnRhc2tndWlsZC52MS5QaW5nQ29tbWFuZEgAEj4KD2NvbXBhcmVfc2NyaXB0cxgNIAEoCzIjLnRhc2tndWlsZC52MS5Db21wYXJlU2NyaXB0c0NvbW1hbmRIABI2CgtzdG9wX3NjcmlwdBgOIAEoCzIfLnRhc2tndWlsZC52MS5TdG9wU2NyaXB0Q29tbWFuZEgAEjwKDmNvbXBhcmVfYWdlbnRzGA8gASgLMiIudGFza2d1aWxkLnYxLkNvbXBhcmVBZ2VudHNDb21tYW5kSAASNgoLc3luY19za2lsbHMYECABKAsyHy50YXNrZ3VpbGQudjEuU3luY1NraWxsc0NvbW1hbmRIABI8Cg5jb21wYXJlX3NraWxscxgRIAEoCzIiLnRhc2tndWlsZC52MS5Db21wYXJlU2tpbGxzQ29tbWFuZEgAEkcKFHN5bmNfY2xhdWRlX3NldHRpbmdzGBIgASgLMicudGFza2d1aWxkLnYxLlN5bmNDbGF1ZGVTZXR0aW5nc0NvbW1hbmRIAEIJCgdjb21tYW5kIg0KC1BpbmdDb21tYW5kIsQBChRUYXNrQXZhaWxhYmxlQ29tbWFuZBIPCgd0YXNrX2lkGAEgASgJEg0KBXRpdGxlGAIgASgJEhcKD2FnZW50X2NvbmZpZ19pZBgDIAEoCRJCCghtZXRhZGF0YRgEIAMoCzIwLnRhc2tndWlsZC52MS5UYXNrQXZhaWxhYmxlQ29tbWFuZC5NZXRhZGF0YUVudHJ5Gi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASLeAQoRQXNzaWduVGFza0NvbW1hbmQSDwoHdGFza19pZBgBIAEoCRIXCg9hZ2VudF9jb25maWdfaWQYAiABKAkSFAoMaW5zdHJ1Y3Rpb25zGAMgASgJEhcKD3dvcmt0cmVlX2JyYW5jaBgEIAEoCRI/CghtZXRhZGF0YRgFIAMoCzItLnRhc2tndWlsZC52MS5Bc3NpZ25UYXNrQ29tbWFuZC5NZXRhZGF0YUVudHJ5Gi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASI0ChFDYW5jZWxUYXNrQ29tbWFuZBIPCgd0YXNrX2lkGAEgASgJEg4KBnJlYXNvbhgCIAEoCSJGChpJbnRlcmFjdGlvblJlc3BvbnNlQ29tbWFuZBIWCg5pbnRlcmFjdGlvbl9pZBgBIAEoCRIQCghyZXNwb25zZRgCIAEoCSI4ChFTeW5jQWdlbnRzQ29tbWFuZBIjChtmb3JjZV9vdmVyd3JpdGVfYWdlbnRfbmFtZXMYASADKAkiGAoWU3luY1Blcm1pc3Npb25zQ29tbWFuZCIqChRMaXN0V29ya3RyZWVzQ29tbWFuZBISCgpyZXF1ZXN0X2lkGAEgASgJIj0KEENsYWltVGFza1JlcXVlc3QSDwoHdGFza19pZBgBIAEoCRIYChBhZ2VudF9tYW5hZ2VyX2lkGAIgASgJIsUBChFDbGFpbVRhc2tSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhcKD2FnZW50X2NvbmZpZ19pZBgCIAEoCRIUCgxpbnN0cnVjdGlvbnMYAyABKAkSPwoIbWV0YWRhdGEYBCADKAsyLS50YXNrZ3VpbGQudjEuQ2xhaW1UYXNrUmVzcG9uc2UuTWV0YWRhdGFFbnRyeRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiYAoXUmVwb3J0VGFza1Jlc3VsdFJlcXVlc3QSDwoHdGFza19pZBgBIAEoCRIPCgdzdW1tYXJ5GAMgASgJEhUKDWVycm9yX21lc3NhZ2UYBCABKAlKBAgCEANSBnN0YXR1cyIaChhSZXBvcnRUYXNrUmVzdWx0UmVzcG9uc2UigQEKGFJlcG9ydEFnZW50U3RhdHVzUmVxdWVzdBIYChBhZ2VudF9tYW5hZ2VyX2lkGAEgASgJEg8KB3Rhc2tfaWQYAiABKAkSKQoGc3RhdHVzGAMgASgOMhkudGFza2d1aWxkLnYxLkFnZW50U3RhdHVzEg8KB21lc3NhZ2UYBCABKAkiGwoZUmVwb3J0QWdlbnRTdGF0dXNSZXNwb25zZSJxChBIZWFydGJlYXRSZXF1ZXN0EhgKEGFnZW50X21hbmFnZXJfaWQYASABKAkSFAoMYWN0aXZlX3Rhc2tzGAIgASgFEi0KCXRpbWVzdGFtcBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiEwoRSGVhcnRiZWF0UmVzcG9uc2Ui0gEKGENyZWF0ZUludGVyYWN0aW9uUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJEhAKCGFnZW50X2lkGAIgASgJEisKBHR5cGUYAyABKA4yHS50YXNrZ3VpbGQudjEuSW50ZXJhY3Rpb25UeXBlEg0KBXRpdGxlGAQgASgJEhMKC2Rlc2NyaXB0aW9uGAUgASgJEjAKB29wdGlvbnMYBiADKAsyHy50YXNrZ3VpbGQudjEuSW50ZXJhY3Rpb25PcHRpb24SEAoIbWV0YWRhdGEYByABKAkiSwoZQ3JlYXRlSW50ZXJhY3Rpb25SZXNwb25zZRIuCgtpbnRlcmFjdGlvbhgBIAEoCzIZLnRhc2tndWlsZC52MS5JbnRlcmFjdGlvbiI3Ch1HZXRJbnRlcmFjdGlvblJlc3BvbnNlUmVxdWVzdBIWCg5pbnRlcmFjdGlvbl9pZBgBIAEoCSJQCh5HZXRJbnRlcmFjdGlvblJlc3BvbnNlUmVzcG9uc2USLgoLaW50ZXJhY3Rpb24YASABKAsyGS50YXNrZ3VpbGQudjEuSW50ZXJhY3Rpb24iKQoRU3luY0FnZW50c1JlcXVlc3QSFAoMcHJvamVjdF9uYW1lGAEgASgJIkMKElN5bmNBZ2VudHNSZXNwb25zZRItCgZhZ2VudHMYASADKAsyHS50YXNrZ3VpbGQudjEuQWdlbnREZWZpbml0aW9uImoKFlN5bmNQZXJtaXNzaW9uc1JlcXVlc3QSFAoMcHJvamVjdF9uYW1lGAEgASgJEhMKC2xvY2FsX2FsbG93GAIgAygJEhEKCWxvY2FsX2FzaxgDIAMoCRISCgpsb2NhbF9kZW55GAQgAygJIksKF1N5bmNQZXJtaXNzaW9uc1Jlc3BvbnNlEjAKC3Blcm1pc3Npb25zGAEgASgLMhsudGFza2d1aWxkLnYxLlBlcm1pc3Npb25TZXQiiQIKFFJlcG9ydFRhc2tMb2dSZXF1ZXN0Eg8KB3Rhc2tfaWQYASABKAkSKQoFbGV2ZWwYAiABKA4yGi50YXNrZ3VpbGQudjEuVGFza0xvZ0xldmVsEi8KCGNhdGVnb3J5GAMgASgOMh0udGFza2d1aWxkLnYxLlRhc2tMb2dDYXRlZ29yeRIPCgdtZXNzYWdlGAQgASgJEkIKCG1ldGFkYXRhGAUgAygLMjAudGFza2d1aWxkLnYxLlJlcG9ydFRhc2tMb2dSZXF1ZXN0Lk1ldGFkYXRhRW50cnkaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIhcKFVJlcG9ydFRhc2tMb2dSZXNwb25zZSJpCgxXb3JrdHJlZUluZm8SDAoEbmFtZRgBIAEoCRIOCgZicmFuY2gYAiABKAkSDwoHdGFza19pZBgDIAEoCRITCgtoYXNfY2hhbmdlcxgEIAEoCBIVCg1jaGFuZ2VkX2ZpbGVzGAUgAygJIlEKFURlbGV0ZVdvcmt0cmVlQ29tbWFuZBISCgpyZXF1ZXN0X2lkGAEgASgJEhUKDXdvcmt0cmVlX25hbWUYAiABKAkSDQoFZm9yY2UYAyABKAgidAoZUmVwb3J0V29ya3RyZWVMaXN0UmVxdWVzdBISCgpyZXF1ZXN0X2lkGAEgASgJEhQKDHByb2plY3RfbmFtZRgCIAEoCRItCgl3b3JrdHJlZXMYAyADKAsyGi50YXNrZ3VpbGQudjEuV29ya3RyZWVJbmZvIhwKGlJlcG9ydFdvcmt0cmVlTGlzdFJlc3BvbnNlIjAKGlJlcXVlc3RXb3JrdHJlZUxpc3RSZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkiMQobUmVxdWVzdFdvcmt0cmVlTGlzdFJlc3BvbnNlEhIKCnJlcXVlc3RfaWQYASABKAkiLAoWR2V0V29ya3RyZWVMaXN0UmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJIkgKF0dldFdvcmt0cmVlTGlzdFJlc3BvbnNlEi0KCXdvcmt0cmVlcxgBIAMoCzIaLnRhc2tndWlsZC52MS5Xb3JrdHJlZUluZm8iWAocUmVxdWVzdFdvcmt0cmVlRGVsZXRlUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEhUKDXdvcmt0cmVlX25hbWUYAiABKAkSDQoFZm9yY2UYAyABKAgiMwodUmVxdWVzdFdvcmt0cmVlRGVsZXRlUmVzcG9uc2USEgoKcmVxdWVzdF9pZBgBIAEoCSKMAQohUmVwb3J0V29ya3RyZWVEZWxldGVSZXN1bHRSZXF1ZXN0EhIKCnJlcXVlc3RfaWQYASABKAkSFAoMcHJvamVjdF9uYW1lGAIgASgJEhUKDXdvcmt0cmVlX25hbWUYAyABKAkSDwoHc3VjY2VzcxgEIAEoCBIVCg1lcnJvcl9tZXNzYWdlGAUgASgJIiQKIlJlcG9ydFdvcmt0cmVlRGVsZXRlUmVzdWx0UmVzcG9uc2UiKAoSR2l0UHVsbE1haW5Db21tYW5kEhIKCnJlcXVlc3RfaWQYASABKAkiLwoZUmVxdWVzdEdpdFB1bGxNYWluUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJIjAKGlJlcXVlc3RHaXRQdWxsTWFpblJlc3BvbnNlEhIKCnJlcXVlc3RfaWQYASABKAkiggEKHlJlcG9ydEdpdFB1bGxNYWluUmVzdWx0UmVxdWVzdBISCgpyZXF1ZXN0X2lkGAEgASgJEhQKDHByb2plY3RfbmFtZRgCIAEoCRIPCgdzdWNjZXNzGAMgASgIEg4KBm91dHB1dBgEIAEoCRIVCg1lcnJvcl9tZXNzYWdlGAUgASgJIiEKH1JlcG9ydEdpdFB1bGxNYWluUmVzdWx0UmVzcG9uc2UiOAoSU3luY1NjcmlwdHNDb21tYW5kEiIKGmZvcmNlX292ZXJ3cml0ZV9zY3JpcHRfaWRzGAEgAygJIlwKFUNvbXBhcmVTY3JpcHRzQ29tbWFuZBISCgpyZXF1ZXN0X2lkGAEgASgJEi8KB3NjcmlwdHMYAiADKAsyHi50YXNrZ3VpbGQudjEuU2NyaXB0RGVmaW5pdGlvbiJgChRFeGVjdXRlU2NyaXB0Q29tbWFuZBISCgpyZXF1ZXN0X2lkGAEgASgJEhEKCXNjcmlwdF9pZBgCIAEoCRIQCghmaWxlbmFtZRgDIAEoCRIPCgdjb250ZW50GAQgASgJIioKElN5bmNTY3JpcHRzUmVxdWVzdBIUCgxwcm9qZWN0X25hbWUYASABKAkiRgoTU3luY1NjcmlwdHNSZXNwb25zZRIvCgdzY3JpcHRzGAEgAygLMh4udGFza2d1aWxkLnYxLlNjcmlwdERlZmluaXRpb24ihAIKIlJlcG9ydFNjcmlwdEV4ZWN1dGlvblJlc3VsdFJlcXVlc3QSEgoKcmVxdWVzdF9pZBgBIAEoCRIUCgxwcm9qZWN0X25hbWUYAiABKAkSEQoJc2NyaXB0X2lkGAMgASgJEg8KB3N1Y2Nlc3MYBCABKAgSEQoJZXhpdF9jb2RlGAUgASgFEhUKDWVycm9yX21lc3NhZ2UYCCABKAkSMQoLbG9nX2VudHJpZXMYCSADKAsyHC50YXNrZ3VpbGQudjEuU2NyaXB0TG9nRW50cnkSFwoPc3RvcHBlZF9ieV91c2VyGAogASgISgQIBhAHSgQIBxAIUgZzdGRvdXRSBnN0ZGVyciIlCiNSZXBvcnRTY3JpcHRFeGVjdXRpb25SZXN1bHRSZXNwb25zZSKhAQoeUmVwb3J0U2NyaXB0T3V0cHV0Q2h1bmtSZXF1ZXN0EhIKCnJlcXVlc3RfaWQYASABKAkSFAoMcHJvamVjdF9uYW1lGAIgASgJEi0KB2VudHJpZXMYBSADKAsyHC50YXNrZ3VpbGQudjEuU2NyaXB0TG9nRW50cnlKBAgDEARKBAgEEAVSDHN0ZG91dF9jaHVua1IMc3RkZXJyX2NodW5rIiEKH1JlcG9ydFNjcmlwdE91dHB1dENodW5rUmVzcG9uc2UiJwoRU3RvcFNjcmlwdENvbW1hbmQSEgoKcmVxdWVzdF9pZBgBIAEoCSKmAQoKU2NyaXB0RGlmZhIRCglzY3JpcHRfaWQYASABKAkSEwoLc2NyaXB0X25hbWUYAiABKAkSEAoIZmlsZW5hbWUYAyABKAkSFgoOc2VydmVyX2NvbnRlbnQYBCABKAkSFQoNYWdlbnRfY29udGVudBgFIAEoCRIvCglkaWZmX3R5cGUYBiABKA4yHC50YXNrZ3VpbGQudjEuU2NyaXB0RGlmZlR5cGUiNAoeUmVxdWVzdFNjcmlwdENvbXBhcmlzb25SZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkiNQofUmVxdWVzdFNjcmlwdENvbXBhcmlzb25SZXNwb25zZRISCgpyZXF1ZXN0X2lkGAEgASgJInIKHVJlcG9ydFNjcmlwdENvbXBhcmlzb25SZXF1ZXN0EhIKCnJlcXVlc3RfaWQYASABKAkSFAoMcHJvamVjdF9uYW1lGAIgASgJEicKBWRpZmZzGAMgAygLMhgudGFza2d1aWxkLnYxLlNjcmlwdERpZmYiIAoeUmVwb3J0U2NyaXB0Q29tcGFyaXNvblJlc3BvbnNlIjAKGkdldFNjcmlwdENvbXBhcmlzb25SZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkiRgobR2V0U2NyaXB0Q29tcGFyaXNvblJlc3BvbnNlEicKBWRpZmZzGAEgAygLMhgudGFza2d1aWxkLnYxLlNjcmlwdERpZmYiuQEKHFJlc29sdmVTY3JpcHRDb25mbGljdFJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIRCglzY3JpcHRfaWQYAiABKAkSEwoLc2NyaXB0X25hbWUYAyABKAkSEAoIZmlsZW5hbWUYBCABKAkSNAoGY2hvaWNlGAUgASgOMiQudGFza2d1aWxkLnYxLlNjcmlwdFJlc29sdXRpb25DaG9pY2USFQoNYWdlbnRfY29udGVudBgGIAEoCSJPCh1SZXNvbHZlU2NyaXB0Q29uZmxpY3RSZXNwb25zZRIuCgZzY3JpcHQYASABKAsyHi50YXNrZ3VpbGQudjEuU2NyaXB0RGVmaW5pdGlvbiJZChRDb21wYXJlQWdlbnRzQ29tbWFuZBISCgpyZXF1ZXN0X2lkGAEgASgJEi0KBmFnZW50cxgCIAMoCzIdLnRhc2tndWlsZC52MS5BZ2VudERlZmluaXRpb24iogEKCUFnZW50RGlmZhIQCghhZ2VudF9pZBgBIAEoCRISCgphZ2VudF9uYW1lGAIgASgJEhAKCGZpbGVuYW1lGAMgASgJEhYKDnNlcnZlcl9jb250ZW50GAQgASgJEhUKDWFnZW50X2NvbnRlbnQYBSABKAkSLgoJZGlmZl90eXBlGAYgASgOMhsudGFza2d1aWxkLnYxLkFnZW50RGlmZlR5cGUiMwodUmVxdWVzdEFnZW50Q29tcGFyaXNvblJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCSI0Ch5SZXF1ZXN0QWdlbnRDb21wYXJpc29uUmVzcG9uc2USEgoKcmVxdWVzdF9pZBgBIAEoCSJwChxSZXBvcnRBZ2VudENvbXBhcmlzb25SZXF1ZXN0EhIKCnJlcXVlc3RfaWQYASABKAkSFAoMcHJvamVjdF9uYW1lGAIgASgJEiYKBWRpZmZzGAMgAygLMhcudGFza2d1aWxkLnYxLkFnZW50RGlmZiIfCh1SZXBvcnRBZ2VudENvbXBhcmlzb25SZXNwb25zZSIvChlHZXRBZ2VudENvbXBhcmlzb25SZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkiRAoaR2V0QWdlbnRDb21wYXJpc29uUmVzcG9uc2USJgoFZGlmZnMYASADKAsyFy50YXNrZ3VpbGQudjEuQWdlbnREaWZmIrUBChtSZXNvbHZlQWdlbnRDb25mbGljdFJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIQCghhZ2VudF9pZBgCIAEoCRISCgphZ2VudF9uYW1lGAMgASgJEhAKCGZpbGVuYW1lGAQgASgJEjMKBmNob2ljZRgFIAEoDjIjLnRhc2tndWlsZC52MS5BZ2VudFJlc29sdXRpb25DaG9pY2USFQoNYWdlbnRfY29udGVudBgGIAEoCSJMChxSZXNvbHZlQWdlbnRDb25mbGljdFJlc3BvbnNlEiwKBWFnZW50GAEgASgLMh0udGFza2d1aWxkLnYxLkFnZW50RGVmaW5pdGlvbiI2ChFTeW5jU2tpbGxzQ29tbWFuZBIhChlmb3JjZV9vdmVyd3JpdGVfc2tpbGxfaWRzGAEgAygJIlkKFENvbXBhcmVTa2lsbHNDb21tYW5kEhIKCnJlcXVlc3RfaWQYASABKAkSLQoGc2tpbGxzGAIgAygLMh0udGFza2d1aWxkLnYxLlNraWxsRGVmaW5pdGlvbiIpChFTeW5jU2tpbGxzUmVxdWVzdBIUCgxwcm9qZWN0X25hbWUYASABKAkiQwoSU3luY1NraWxsc1Jlc3BvbnNlEi0KBnNraWxscxgBIAMoCzIdLnRhc2tndWlsZC52MS5Ta2lsbERlZmluaXRpb24iogEKCVNraWxsRGlmZhIQCghza2lsbF9pZBgBIAEoCRISCgpza2lsbF9uYW1lGAIgASgJEhAKCGZpbGVuYW1lGAMgASgJEhYKDnNlcnZlcl9jb250ZW50GAQgASgJEhUKDWFnZW50X2NvbnRlbnQYBSABKAkSLgoJZGlmZl90eXBlGAYgASgOMhsudGFza2d1aWxkLnYxLlNraWxsRGlmZlR5cGUiMwodUmVxdWVzdFNraWxsQ29tcGFyaXNvblJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCSI0Ch5SZXF1ZXN0U2tpbGxDb21wYXJpc29uUmVzcG9uc2USEgoKcmVxdWVzdF9pZBgBIAEoCSJwChxSZXBvcnRTa2lsbENvbXBhcmlzb25SZXF1ZXN0EhIKCnJlcXVlc3RfaWQYASABKAkSFAoMcHJvamVjdF9uYW1lGAIgASgJEiYKBWRpZmZzGAMgAygLMhcudGFza2d1aWxkLnYxLlNraWxsRGlmZiIfCh1SZXBvcnRTa2lsbENvbXBhcmlzb25SZXNwb25zZSIvChlHZXRTa2lsbENvbXBhcmlzb25SZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkiRAoaR2V0U2tpbGxDb21wYXJpc29uUmVzcG9uc2USJgoFZGlmZnMYASADKAsyFy50YXNrZ3VpbGQudjEuU2tpbGxEaWZmIrUBChtSZXNvbHZlU2tpbGxDb25mbGljdFJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIQCghza2lsbF9pZBgCIAEoCRISCgpza2lsbF9uYW1lGAMgASgJEhAKCGZpbGVuYW1lGAQgASgJEjMKBmNob2ljZRgFIAEoDjIjLnRhc2tndWlsZC52MS5Ta2lsbFJlc29sdXRpb25DaG9pY2USFQoNYWdlbnRfY29udGVudBgGIAEoCSJMChxSZXNvbHZlU2tpbGxDb25mbGljdFJlc3BvbnNlEiwKBXNraWxsGAEgASgLMh0udGFza2d1aWxkLnYxLlNraWxsRGVmaW5pdGlvbiJACihMaXN0U2luZ2xlQ29tbWFuZFBlcm1pc3Npb25zQWdlbnRSZXF1ZXN0EhQKDHByb2plY3RfbmFtZRgBIAEoCSJnCilMaXN0U2luZ2xlQ29tbWFuZFBlcm1pc3Npb25zQWdlbnRSZXNwb25zZRI6CgtwZXJtaXNzaW9ucxgBIAMoCzIlLnRhc2tndWlsZC52MS5TaW5nbGVDb21tYW5kUGVybWlzc2lvbiJeCiFBZGRTaW5nbGVDb21tYW5kUGVybWlzc2lvblJlcXVlc3QSFAoMcHJvamVjdF9uYW1lGAEgASgJEg8KB3BhdHRlcm4YAiABKAkSDAoEdHlwZRgDIAEoCUoECAQQBSJfCiJBZGRTaW5nbGVDb21tYW5kUGVybWlzc2lvblJlc3BvbnNlEjkKCnBlcm1pc3Npb24YASABKAsyJS50YXNrZ3VpbGQudjEuU2luZ2xlQ29tbWFuZFBlcm1pc3Npb24iGwoZU3luY0NsYXVkZVNldHRpbmdzQ29tbWFuZCKcAQoeU3luY0NsYXVkZVNldHRpbmdzQWdlbnRSZXF1ZXN0EhQKDHByb2plY3RfbmFtZRgBIAEoCRIbCg5sb2NhbF9sYW5ndWFnZRgCIAEoCUgAiAEBEjQKEWxvY2FsX2F0dHJpYnV0aW9uGAMgASgLMhkudGFza2d1aWxkLnYxLkF0dHJpYnV0aW9uQhEKD19sb2NhbF9sYW5ndWFnZSJRCh9TeW5jQ2xhdWRlU2V0dGluZ3NBZ2VudFJlc3BvbnNlEi4KCHNldHRpbmdzGAEgASgLMhwudGFza2d1aWxkLnYxLkNsYXVkZVNldHRpbmdzKo4BCgtBZ2VudFN0YXR1cxIcChhBR0VOVF9TVEFUVVNfVU5TUEVDSUZJRUQQABIVChFBR0VOVF9TVEFUVVNfSURMRRABEhgKFEFHRU5UX1NUQVRVU19SVU5OSU5HEAISGAoUQUdFTlRfU1RBVFVTX1dBSVRJTkcQAxIWChJBR0VOVF9TVEFUVVNfRVJST1IQBCqUAQoOU2NyaXB0RGlmZlR5cGUSIAocU0NSSVBUX0RJRkZfVFlQRV9VTlNQRUNJRklFRBAAEh0KGVNDUklQVF9ESUZGX1RZUEVfTU9ESUZJRUQQARIfChtTQ1JJUFRfRElGRl9UWVBFX0FHRU5UX09OTFkQAhIgChxTQ1JJUFRfRElGRl9UWVBFX1NFUlZFUl9PTkxZEAMqiwEKFlNjcmlwdFJlc29sdXRpb25DaG9pY2USKAokU0NSSVBUX1JFU09MVVRJT05fQ0hPSUNFX1VOU1BFQ0lGSUVEEAASIwofU0NSSVBUX1JFU09MVVRJT05fQ0hPSUNFX1NFUlZFUhABEiIKHlNDUklQVF9SRVNPTFVUSU9OX0NIT0lDRV9BR0VOVBACKo8BCg1BZ2VudERpZmZUeXBlEh8KG0FHRU5UX0RJRkZfVFlQRV9VTlNQRUNJRklFRBAAEhwKGEFHRU5UX0RJRkZfVFlQRV9NT0RJRklFRBABEh4KGkFHRU5UX0RJRkZfVFlQRV9BR0VOVF9PTkxZEAISHwobQUdFTlRfRElGRl9UWVBFX1NFUlZFUl9PTkxZEAMqhwEKFUFnZW50UmVzb2x1dGlvbkNob2ljZRInCiNBR0VOVF9SRVNPTFVUSU9OX0NIT0lDRV9VTlNQRUNJRklFRBAAEiIKHkFHRU5UX1JFU09MVVRJT05fQ0hPSUNFX1NFUlZFUhABEiEKHUFHRU5UX1JFU09MVVRJT05fQ0hPSUNFX0FHRU5UEAIqjwEKDVNraWxsRGlmZlR5cGUSHwobU0tJTExfRElGRl9UWVBFX1VOU1BFQ0lGSUVEEAASHAoYU0tJTExfRElGRl9UWVBFX01PRElGSUVEEAESHgoaU0tJTExfRElGRl9UWVBFX0FHRU5UX09OTFkQAhIfChtTS0lMTF9ESUZGX1RZUEVfU0VSVkVSX09OTFkQAyqHAQoVU2tpbGxSZXNvbHV0aW9uQ2hvaWNlEicKI1NLSUxMX1JFU09MVVRJT05fQ0hPSUNFX1VOU1BFQ0lGSUVEEAASIgoeU0tJTExfUkVTT0xVVElPTl9DSE9JQ0VfU0VSVkVSEAESIQodU0tJTExfUkVTT0xVVElPTl9DSE9JQ0VfQUdFTlQQAjKxHgoTQWdlbnRNYW5hZ2VyU2VydmljZRJVCglTdWJzY3JpYmUSKi50YXNrZ3VpbGQudjEuQWdlbnRNYW5hZ2VyU3Vic2NyaWJlUmVxdWVzdBoaLnRhc2tndWlsZC52MS5BZ2VudENvbW1hbmQwARJMCglDbGFpbVRhc2sSHi50YXNrZ3VpbGQudjEuQ2xhaW1UYXNrUmVxdWVzdBofLnRhc2tndWlsZC52MS5DbGFpbVRhc2tSZXNwb25zZRJhChBSZXBvcnRUYXNrUmVzdWx0EiUudGFza2d1aWxkLnYxLlJlcG9ydFRhc2tSZXN1bHRSZXF1ZXN0GiYudGFza2d1aWxkLnYxLlJlcG9ydFRhc2tSZXN1bHRSZXNwb25zZRJkChFSZXBvcnRBZ2VudFN0YXR1cxImLnRhc2tndWlsZC52MS5SZXBvcnRBZ2VudFN0YXR1c1JlcXVlc3QaJy50YXNrZ3VpbGQudjEuUmVwb3J0QWdlbnRTdGF0dXNSZXNwb25zZRJMCglIZWFydGJlYXQSHi50YXNrZ3VpbGQudjEuSGVhcnRiZWF0UmVxdWVzdBofLnRhc2tndWlsZC52MS5IZWFydGJlYXRSZXNwb25zZRJkChFDcmVhdGVJbnRlcmFjdGlvbhImLnRhc2tndWlsZC52MS5DcmVhdGVJbnRlcmFjdGlvblJlcXVlc3QaJy50YXNrZ3VpbGQudjEuQ3JlYXRlSW50ZXJhY3Rpb25SZXNwb25zZRJzChZHZXRJbnRlcmFjdGlvblJlc3BvbnNlEisudGFza2d1aWxkLnYxLkdldEludGVyYWN0aW9uUmVzcG9uc2VSZXF1ZXN0GiwudGFza2d1aWxkLnYxLkdldEludGVyYWN0aW9uUmVzcG9uc2VSZXNwb25zZRJPCgpTeW5jQWdlbnRzEh8udGFza2d1aWxkLnYxLlN5bmNBZ2VudHNSZXF1ZXN0GiAudGFza2d1aWxkLnYxLlN5bmNBZ2VudHNSZXNwb25zZRJYCg1SZXBvcnRUYXNrTG9nEiIudGFza2d1aWxkLnYxLlJlcG9ydFRhc2tMb2dSZXF1ZXN0GiMudGFza2d1aWxkLnYxLlJlcG9ydFRhc2tMb2dSZXNwb25zZRJeCg9TeW5jUGVybWlzc2lvbnMSJC50YXNrZ3VpbGQudjEuU3luY1Blcm1pc3Npb25zUmVxdWVzdBolLnRhc2tndWlsZC52MS5TeW5jUGVybWlzc2lvbnNSZXNwb25zZRJnChJSZXBvcnRXb3JrdHJlZUxpc3QSJy50YXNrZ3VpbGQudjEuUmVwb3J0V29ya3RyZWVMaXN0UmVxdWVzdBooLnRhc2tndWlsZC52MS5SZXBvcnRXb3JrdHJlZUxpc3RSZXNwb25zZRJqChNSZXF1ZXN0V29ya3RyZWVMaXN0EigudGFza2d1aWxkLnYxLlJlcXVlc3RXb3JrdHJlZUxpc3RSZXF1ZXN0GikudGFza2d1aWxkLnYxLlJlcXVlc3RXb3JrdHJlZUxpc3RSZXNwb25zZRJeCg9HZXRXb3JrdHJlZUxpc3QSJC50YXNrZ3VpbGQudjEuR2V0V29ya3RyZWVMaXN0UmVxdWVzdBolLnRhc2tndWlsZC52MS5HZXRXb3JrdHJlZUxpc3RSZXNwb25zZRJwChVSZXF1ZXN0V29ya3RyZWVEZWxldGUSKi50YXNrZ3VpbGQudjEuUmVxdWVzdFdvcmt0cmVlRGVsZXRlUmVxdWVzdBorLnRhc2tndWlsZC52MS5SZXF1ZXN0V29ya3RyZWVEZWxldGVSZXNwb25zZRJ/ChpSZXBvcnRXb3JrdHJlZURlbGV0ZVJlc3VsdBIvLnRhc2tndWlsZC52MS5SZXBvcnRXb3JrdHJlZURlbGV0ZVJlc3VsdFJlcXVlc3QaMC50YXNrZ3VpbGQudjEuUmVwb3J0V29ya3RyZWVEZWxldGVSZXN1bHRSZXNwb25zZRJnChJSZXF1ZXN0R2l0UHVsbE1haW4SJy50YXNrZ3VpbGQudjEuUmVxdWVzdEdpdFB1bGxNYWluUmVxdWVzdBooLnRhc2tndWlsZC52MS5SZXF1ZXN0R2l0UHVsbE1haW5SZXNwb25zZRJ2ChdSZXBvcnRHaXRQdWxsTWFpblJlc3VsdBIsLnRhc2tndWlsZC52MS5SZXBvcnRHaXRQdWxsTWFpblJlc3VsdFJlcXVlc3QaLS50YXNrZ3VpbGQudjEuUmVwb3J0R2l0UHVsbE1haW5SZXN1bHRSZXNwb25zZRJSCgtTeW5jU2NyaXB0cxIgLnRhc2tndWlsZC52MS5TeW5jU2NyaXB0c1JlcXVlc3QaIS50YXNrZ3VpbGQudjEuU3luY1NjcmlwdHNSZXNwb25zZRKCAQobUmVwb3J0U2NyaXB0RXhlY3V0aW9uUmVzdWx0EjAudGFza2d1aWxkLnYxLlJlcG9ydFNjcmlwdEV4ZWN1dGlvblJlc3VsdFJlcXVlc3QaMS50YXNrZ3VpbGQudjEuUmVwb3J0U2NyaXB0RXhlY3V0aW9uUmVzdWx0UmVzcG9uc2USdgoXUmVwb3J0U2NyaXB0T3V0cHV0Q2h1bmsSLC50YXNrZ3VpbGQudjEuUmVwb3J0U2NyaXB0T3V0cHV0Q2h1bmtSZXF1ZXN0Gi0udGFza2d1aWxkLnYxLlJlcG9ydFNjcmlwdE91dHB1dENodW5rUmVzcG9uc2USdgoXUmVxdWVzdFNjcmlwdENvbXBhcmlzb24SLC50YXNrZ3VpbGQudjEuUmVxdWVzdFNjcmlwdENvbXBhcmlzb25SZXF1ZXN0Gi0udGFza2d1aWxkLnYxLlJlcXVlc3RTY3JpcHRDb21wYXJpc29uUmVzcG9uc2UScwoWUmVwb3J0U2NyaXB0Q29tcGFyaXNvbhIrLnRhc2tndWlsZC52MS5SZXBvcnRTY3JpcHRDb21wYXJpc29uUmVxdWVzdBosLnRhc2tndWlsZC52MS5SZXBvcnRTY3JpcHRDb21wYXJpc29uUmVzcG9uc2USagoTR2V0U2NyaXB0Q29tcGFyaXNvbhIoLnRhc2tndWlsZC52MS5HZXRTY3JpcHRDb21wYXJpc29uUmVxdWVzdBopLnRhc2tndWlsZC52MS5HZXRTY3JpcHRDb21wYXJpc29uUmVzcG9uc2UScAoVUmVzb2x2ZVNjcmlwdENvbmZsaWN0EioudGFza2d1aWxkLnYxLlJlc29sdmVTY3JpcHRDb25mbGljdFJlcXVlc3QaKy50YXNrZ3VpbGQudjEuUmVzb2x2ZVNjcmlwdENvbmZsaWN0UmVzcG9uc2UScwoWUmVxdWVzdEFnZW50Q29tcGFyaXNvbhIrLnRhc2tndWlsZC52MS5SZXF1ZXN0QWdlbnRDb21wYXJpc29uUmVxdWVzdBosLnRhc2tndWlsZC52MS5SZXF1ZXN0QWdlbnRDb21wYXJpc29uUmVzcG9uc2UScAoVUmVwb3J0QWdlbnRDb21wYXJpc29uEioudGFza2d1aWxkLnYxLlJlcG9ydEFnZW50Q29tcGFyaXNvblJlcXVlc3QaKy50YXNrZ3VpbGQudjEuUmVwb3J0QWdlbnRDb21wYXJpc29uUmVzcG9uc2USZwoSR2V0QWdlbnRDb21wYXJpc29uEicudGFza2d1aWxkLnYxLkdldEFnZW50Q29tcGFyaXNvblJlcXVlc3QaKC50YXNrZ3VpbGQudjEuR2V0QWdlbnRDb21wYXJpc29uUmVzcG9uc2USbQoUUmVzb2x2ZUFnZW50Q29uZmxpY3QSKS50YXNrZ3VpbGQudjEuUmVzb2x2ZUFnZW50Q29uZmxpY3RSZXF1ZXN0GioudGFza2d1aWxkLnYxLlJlc29sdmVBZ2VudENvbmZsaWN0UmVzcG9uc2USjwEKHExpc3RTaW5nbGVDb21tYW5kUGVybWlzc2lvbnMSNi50YXNrZ3VpbGQudjEuTGlzdFNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uc0FnZW50UmVxdWVzdBo3LnRhc2tndWlsZC52MS5MaXN0U2luZ2xlQ29tbWFuZFBlcm1pc3Npb25zQWdlbnRSZXNwb25zZRJ/ChpBZGRTaW5nbGVDb21tYW5kUGVybWlzc2lvbhIvLnRhc2tndWlsZC52MS5BZGRTaW5nbGVDb21tYW5kUGVybWlzc2lvblJlcXVlc3QaMC50YXNrZ3VpbGQudjEuQWRkU2luZ2xlQ29tbWFuZFBlcm1pc3Npb25SZXNwb25zZRJPCgpTeW5jU2tpbGxzEh8udGFza2d1aWxkLnYxLlN5bmNTa2lsbHNSZXF1ZXN0GiAudGFza2d1aWxkLnYxLlN5bmNTa2lsbHNSZXNwb25zZRJzChZSZXF1ZXN0U2tpbGxDb21wYXJpc29uEisudGFza2d1aWxkLnYxLlJlcXVlc3RTa2lsbENvbXBhcmlzb25SZXF1ZXN0GiwudGFza2d1aWxkLnYxLlJlcXVlc3RTa2lsbENvbXBhcmlzb25SZXNwb25zZRJwChVSZXBvcnRTa2lsbENvbXBhcmlzb24SKi50YXNrZ3VpbGQudjEuUmVwb3J0U2tpbGxDb21wYXJpc29uUmVxdWVzdBorLnRhc2tndWlsZC52MS5SZXBvcnRTa2lsbENvbXBhcmlzb25SZXNwb25zZRJnChJHZXRTa2lsbENvbXBhcmlzb24SJy50YXNrZ3VpbGQudjEuR2V0U2tpbGxDb21wYXJpc29uUmVxdWVzdBooLnRhc2tndWlsZC52MS5HZXRTa2lsbENvbXBhcmlzb25SZXNwb25zZRJtChRSZXNvbHZlU2tpbGxDb25mbGljdBIpLnRhc2tndWlsZC52MS5SZXNvbHZlU2tpbGxDb25mbGljdFJlcXVlc3QaKi50YXNrZ3VpbGQudjEuUmVzb2x2ZVNraWxsQ29uZmxpY3RSZXNwb25zZRJxChJTeW5jQ2xhdWRlU2V0dGluZ3MSLC50YXNrZ3VpbGQudjEuU3luY0NsYXVkZVNldHRpbmdzQWdlbnRSZXF1ZXN0Gi0udGFza2d1aWxkLnYxLlN5bmNDbGF1ZGVTZXR0aW5nc0FnZW50UmVzcG9uc2VCugEKEGNvbS50YXNrZ3VpbGQudjFCEUFnZW50TWFuYWdlclByb3RvUAFaQmdpdGh1Yi5jb20va2F6ejE4Ny90YXNrZ3VpbGQvcHJvdG8vZ2VuL2dvL3Rhc2tndWlsZC92MTt0YXNrZ3VpbGR2MaICA1RYWKoCDFRhc2tndWlsZC5WMcoCDFRhc2tndWlsZFxWMeICGFRhc2tndWlsZFxWMVxHUEJNZXRhZGF0YeoCDVRhc2tndWlsZDo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_taskguild_v1_agent, file_taskguild_v1_interaction, file_taskguild_v1_permission, file_taskguild_v1_script, file_taskguild_v1_single_command_permission, file_taskguild_v1_skill, file_taskguild_v1_claude_settings, file_taskguild_v1_task_log]);
+  fileDesc("CiB0YXNrZ3VpbGQvdjEvYWdlbnRfbWFuYWdlci5wcm90bxIMdGFza2d1aWxkLnYxIu8BChxBZ2VudE1hbmFnZXJTdWJzY3JpYmVSZXF1ZXN0EhgKEGFnZW50X21hbmFnZXJfaWQYASABKAkSFAoMcHJvamVjdF9uYW1lGAIgASgJEhwKFG1heF9jb25jdXJyZW50X3Rhc2tzGAMgASgFEhcKD2FjdGl2ZV90YXNrX2lkcxgEIAMoCRIVCg1hZ2VudF92ZXJzaW9uGAUgASgJEhAKCHdvcmtfZGlyGAYgASgJEi0KCHByb2plY3RzGAcgAygLMhsudGFza2d1aWxkLnYxLlNlcnZlZFByb2plY3QSEAoIZHJhaW5pbmcYCCABKAgihwsKDEFnZW50Q29tbWFuZBI8Cg50YXNrX2F2YWlsYWJsZRgBIAEoCzIiLnRhc2tndWlsZC52MS5UYXNrQXZhaWxhYmxlQ29tbWFuZEgAEjYKC2Fzc2lnbl90YXNrGAIgASgLMh8udGFza2d1aWxkLnYxLkFzc2lnblRhc2tDb21tYW5kSAASNgoLY2FuY2VsX3Rhc2sYAyABKAsyHy50YXNrZ3VpbGQudjEuQ2FuY2VsVGFza0NvbW1hbmRIABJIChRpbnRlcmFjdGlvbl9yZXNwb25zZRgEIAEoCzIoLnRhc2tndWlsZC52MS5JbnRlcmFjdGlvblJlc3BvbnNlQ29tbWFuZEgAEjYKC3N5bmNfYWdlbnRzGAUgASgLMh8udGFza2d1aWxkLnYxLlN5bmNBZ2VudHNDb21tYW5kSAASQAoQc3luY19wZXJtaXNzaW9ucxgGIAEoCzIkLnRhc2tndWlsZC52MS5TeW5jUGVybWlzc2lvbnNDb21tYW5kSAASPAoObGlzdF93b3JrdHJlZXMYByABKAsyIi50YXNrZ3VpbGQudjEuTGlzdFdvcmt0cmVlc0NvbW1hbmRIABI+Cg9kZWxldGVfd29ya3RyZWUYCCABKAsyIy50YXNrZ3VpbGQudjEuRGVsZXRlV29ya3RyZWVDb21tYW5kSAASOQoNZ2l0X3B1bGxfbWFpbhgJIAEoCzIgLnRhc2tndWlsZC52MS5HaXRQdWxsTWFpbkNvbW1hbmRIABI4CgxzeW5jX3NjcmlwdHMYCiABKAsyIC50YXNrZ3VpbGQudjEuU3luY1NjcmlwdHNDb21tYW5kSAASPAoOZXhlY3V0ZV9zY3JpcHQYCyABKAsyIi50YXNrZ3VpbGQudjEuRXhlY3V0ZVNjcmlwdENvbW1hbmRIABIpCgRwaW5nGAwgASgLMhkudGFza2d1aWxkLnYxLlBpbmdDb21tYW5kSAASPgoPY29tcGFyZV9zY3JpcHRzGA0gASgLMiMudGFza2d1aWxkLnYxLkNvbXBhcmVTY3JpcHRzQ29tbWFuZEgAEjYKC3N0b3Bfc2NyaXB0GA4gASgLMh8udGFza2d1aWxkLnYxLlN0b3BTY3JpcHRDb21tYW5kSAASPAoOY29tcGFyZV9hZ2VudHMYDyABKAsyIi50YXNrZ3VpbGQudjEuQ29tcGFyZUFnZW50c0NvbW1hbmRIABI2CgtzeW5jX3NraWxscxgQIAEoCzIfLnRhc2tndWlsZC52MS5TeW5jU2tpbGxzQ29tbWFuZEgAEjwKDmNvbXBhcmVfc2tpbGxzGBEgASgLMiIudGFza2d1aWxkLnYxLkNvbXBhcmVTa2lsbHNDb21tYW5kSAASRwoUc3luY19jbGF1ZGVfc2V0dGluZ3MYEiABKAsyJy50YXNrZ3VpbGQudjEuU3luY0NsYXVkZVNldHRpbmdzQ29tbWFuZEgAEisKBWRyYWluGBMgASgLMhoudGFza2d1aWxkLnYxLkRyYWluQ29tbWFuZEgAEjgKDGNvbXBhY3RfdGFzaxgUIAEoCzIgLnRhc2tndWlsZC52MS5Db21wYWN0VGFza0NvbW1hbmRIABI6Cg1yb2xsYmFja190YXNrGBUgASgLMiEudGFza2d1aWxkLnYxLlJvbGxiYWNrVGFza0NvbW1hbmRIABIyCgl0YXNrX2RpZmYYFiABKAsyHS50YXNrZ3VpbGQudjEuVGFza0RpZmZDb21tYW5kSAASPAoObWVyZ2Vfd29ya3RyZWUYFyABKAsyIi50YXNrZ3VpbGQudjEuTWVyZ2VXb3JrdHJlZUNvbW1hbmRIABIUCgxwcm9qZWN0X25hbWUYZCABKAlCCQoHY29tbWFuZCJlCg1TZXJ2ZWRQcm9qZWN0EhQKDHByb2plY3RfbmFtZRgBIAEoCRIQCgh3b3JrX2RpchgCIAEoCRIcChRtYXhfY29uY3VycmVudF90YXNrcxgDIAEoBRIOCgZsYWJlbHMYBCADKAkiDQoLUGluZ0NvbW1hbmQixAEKFFRhc2tBdmFpbGFibGVDb21tYW5kEg8KB3Rhc2tfaWQYASABKAkSDQoFdGl0bGUYAiABKAkSFwoPYWdlbnRfY29uZmlnX2lkGAMgASgJEkIKCG1ldGFkYXRhGAQgAygLMjAudGFza2d1aWxkLnYxLlRhc2tBdmFpbGFibGVDb21tYW5kLk1ldGFkYXRhRW50cnkaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIt4BChFBc3NpZ25UYXNrQ29tbWFuZBIPCgd0YXNrX2lkGAEgASgJEhcKD2FnZW50X2NvbmZpZ19pZBgCIAEoCRIUCgxpbnN0cnVjdGlvbnMYAyABKAkSFwoPd29ya3RyZWVfYnJhbmNoGAQgASgJEj8KCG1ldGFkYXRhGAUgAygLMi0udGFza2d1aWxkLnYxLkFzc2lnblRhc2tDb21tYW5kLk1ldGFkYXRhRW50cnkaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIjQKEUNhbmNlbFRhc2tDb21tYW5kEg8KB3Rhc2tfaWQYASABKAkSDgoGcmVhc29uGAIgASgJIkYKGkludGVyYWN0aW9uUmVzcG9uc2VDb21tYW5kEhYKDmludGVyYWN0aW9uX2lkGAEgASgJEhAKCHJlc3BvbnNlGAIgASgJIjgKEVN5bmNBZ2VudHNDb21tYW5kEiMKG2ZvcmNlX292ZXJ3cml0ZV9hZ2VudF9uYW1lcxgBIAMoCSIYChZTeW5jUGVybWlzc2lvbnNDb21tYW5kIj8KFExpc3RXb3JrdHJlZXNDb21tYW5kEhIKCnJlcXVlc3RfaWQYASABKAkSEwoLYmFzZV9icmFuY2gYAiABKAkiPQoQQ2xhaW1UYXNrUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJEhgKEGFnZW50X21hbmFnZXJfaWQYAiABKAkitAIKEUNsYWltVGFza1Jlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSFwoPYWdlbnRfY29uZmlnX2lkGAIgASgJEhQKDGluc3RydWN0aW9ucxgDIAEoCRI/CghtZXRhZGF0YRgEIAMoCzItLnRhc2tndWlsZC52MS5DbGFpbVRhc2tSZXNwb25zZS5NZXRhZGF0YUVudHJ5Ej0KB3NlY3JldHMYBSADKAsyLC50YXNrZ3VpbGQudjEuQ2xhaW1UYXNrUmVzcG9uc2UuU2VjcmV0c0VudHJ5Gi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARouCgxTZWNyZXRzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJzChdSZXBvcnRUYXNrUmVzdWx0UmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJEg8KB3N1bW1hcnkYAyABKAkSFQoNZXJyb3JfbWVzc2FnZRgEIAEoCRIRCglyZXN1bHRfaWQYBSABKAlKBAgCEANSBnN0YXR1cyIaChhSZXBvcnRUYXNrUmVzdWx0UmVzcG9uc2UigQEKGFJlcG9ydEFnZW50U3RhdHVzUmVxdWVzdBIYChBhZ2VudF9tYW5hZ2VyX2lkGAEgASgJEg8KB3Rhc2tfaWQYAiABKAkSKQoGc3RhdHVzGAMgASgOMhkudGFza2d1aWxkLnYxLkFnZW50U3RhdHVzEg8KB21lc3NhZ2UYBCABKAkiGwoZUmVwb3J0QWdlbnRTdGF0dXNSZXNwb25zZSKDAQoQSGVhcnRiZWF0UmVxdWVzdBIYChBhZ2VudF9tYW5hZ2VyX2lkGAEgASgJEhQKDGFjdGl2ZV90YXNrcxgCIAEoBRItCgl0aW1lc3RhbXAYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCGRyYWluaW5nGAQgASgIIhMKEUhlYXJ0YmVhdFJlc3BvbnNlItIBChhDcmVhdGVJbnRlcmFjdGlvblJlcXVlc3QSDwoHdGFza19pZBgBIAEoCRIQCghhZ2VudF9pZBgCIAEoCRIrCgR0eXBlGAMgASgOMh0udGFza2d1aWxkLnYxLkludGVyYWN0aW9uVHlwZRINCgV0aXRsZRgEIAEoCRITCgtkZXNjcmlwdGlvbhgFIAEoCRIwCgdvcHRpb25zGAYgAygLMh8udGFza2d1aWxkLnYxLkludGVyYWN0aW9uT3B0aW9uEhAKCG1ldGFkYXRhGAcgASgJIksKGUNyZWF0ZUludGVyYWN0aW9uUmVzcG9uc2USLgoLaW50ZXJhY3Rpb24YASABKAsyGS50YXNrZ3VpbGQudjEuSW50ZXJhY3Rpb24iNwodR2V0SW50ZXJhY3Rpb25SZXNwb25zZVJlcXVlc3QSFgoOaW50ZXJhY3Rpb25faWQYASABKAkiUAoeR2V0SW50ZXJhY3Rpb25SZXNwb25zZVJlc3BvbnNlEi4KC2ludGVyYWN0aW9uGAEgASgLMhkudGFza2d1aWxkLnYxLkludGVyYWN0aW9uIikKEVN5bmNBZ2VudHNSZXF1ZXN0EhQKDHByb2plY3RfbmFtZRgBIAEoCSJDChJTeW5jQWdlbnRzUmVzcG9uc2USLQoGYWdlbnRzGAEgAygLMh0udGFza2d1aWxkLnYxLkFnZW50RGVmaW5pdGlvbiJqChZTeW5jUGVybWlzc2lvbnNSZXF1ZXN0EhQKDHByb2plY3RfbmFtZRgBIAEoCRITCgtsb2NhbF9hbGxvdxgCIAMoCRIRCglsb2NhbF9hc2sYAyADKAkSEgoKbG9jYWxfZGVueRgEIAMoCSJLChdTeW5jUGVybWlzc2lvbnNSZXNwb25zZRIwCgtwZXJtaXNzaW9ucxgBIAEoCzIbLnRhc2tndWlsZC52MS5QZXJtaXNzaW9uU2V0IskCChRSZXBvcnRUYXNrTG9nUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJEikKBWxldmVsGAIgASgOMhoudGFza2d1aWxkLnYxLlRhc2tMb2dMZXZlbBIvCghjYXRlZ29yeRgDIAEoDjIdLnRhc2tndWlsZC52MS5UYXNrTG9nQ2F0ZWdvcnkSDwoHbWVzc2FnZRgEIAEoCRJCCghtZXRhZGF0YRgFIAMoCzIwLnRhc2tndWlsZC52MS5SZXBvcnRUYXNrTG9nUmVxdWVzdC5NZXRhZGF0YUVudHJ5Eg4KBmxvZ19pZBgGIAEoCRIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiFwoVUmVwb3J0VGFza0xvZ1Jlc3BvbnNlIoMCCgxXb3JrdHJlZUluZm8SDAoEbmFtZRgBIAEoCRIOCgZicmFuY2gYAiABKAkSDwoHdGFza19pZBgDIAEoCRITCgtoYXNfY2hhbmdlcxgEIAEoCBIVCg1jaGFuZ2VkX2ZpbGVzGAUgAygJEhIKCnNpemVfYnl0ZXMYBiABKAMSNAoQbGFzdF9tb2RpZmllZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDgoGbWVyZ2VkGAggASgIEhIKCnRhc2tfdGl0bGUYCSABKAkSEwoLdGFza19zdGF0dXMYCiABKAkSFQoNdGFza19hcmNoaXZlZBgLIAEoCCJmChVEZWxldGVXb3JrdHJlZUNvbW1hbmQSEgoKcmVxdWVzdF9pZBgBIAEoCRIVCg13b3JrdHJlZV9uYW1lGAIgASgJEg0KBWZvcmNlGAMgASgIEhMKC2tlZXBfYnJhbmNoGAQgASgIInQKGVJlcG9ydFdvcmt0cmVlTGlzdFJlcXVlc3QSEgoKcmVxdWVzdF9pZBgBIAEoCRIUCgxwcm9qZWN0X25hbWUYAiABKAkSLQoJd29ya3RyZWVzGAMgAygLMhoudGFza2d1aWxkLnYxLldvcmt0cmVlSW5mbyIcChpSZXBvcnRXb3JrdHJlZUxpc3RSZXNwb25zZSIwChpSZXF1ZXN0V29ya3RyZWVMaXN0UmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJIjEKG1JlcXVlc3RXb3JrdHJlZUxpc3RSZXNwb25zZRISCgpyZXF1ZXN0X2lkGAEgASgJIiwKFkdldFdvcmt0cmVlTGlzdFJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCSJ8ChdHZXRXb3JrdHJlZUxpc3RSZXNwb25zZRItCgl3b3JrdHJlZXMYASADKAsyGi50YXNrZ3VpbGQudjEuV29ya3RyZWVJbmZvEhgKEHRvdGFsX3NpemVfYnl0ZXMYAiABKAMSGAoQZGlza19xdW90YV9ieXRlcxgDIAEoAyJYChxSZXF1ZXN0V29ya3RyZWVEZWxldGVSZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkSFQoNd29ya3RyZWVfbmFtZRgCIAEoCRINCgVmb3JjZRgDIAEoCCIzCh1SZXF1ZXN0V29ya3RyZWVEZWxldGVSZXNwb25zZRISCgpyZXF1ZXN0X2lkGAEgASgJIowBCiFSZXBvcnRXb3JrdHJlZURlbGV0ZVJlc3VsdFJlcXVlc3QSEgoKcmVxdWVzdF9pZBgBIAEoCRIUCgxwcm9qZWN0X25hbWUYAiABKAkSFQoNd29ya3RyZWVfbmFtZRgDIAEoCRIPCgdzdWNjZXNzGAQgASgIEhUKDWVycm9yX21lc3NhZ2UYBSABKAkiJAoiUmVwb3J0V29ya3RyZWVEZWxldGVSZXN1bHRSZXNwb25zZSIoChJHaXRQdWxsTWFpbkNvbW1hbmQSEgoKcmVxdWVzdF9pZBgBIAEoCSIvChlSZXF1ZXN0R2l0UHVsbE1haW5SZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkiMAoaUmVxdWVzdEdpdFB1bGxNYWluUmVzcG9uc2USEgoKcmVxdWVzdF9pZBgBIAEoCSKCAQoeUmVwb3J0R2l0UHVsbE1haW5SZXN1bHRSZXF1ZXN0EhIKCnJlcXVlc3RfaWQYASABKAkSFAoMcHJvamVjdF9uYW1lGAIgASgJEg8KB3N1Y2Nlc3MYAyABKAgSDgoGb3V0cHV0GAQgASgJEhUKDWVycm9yX21lc3NhZ2UYBSABKAkiIQofUmVwb3J0R2l0UHVsbE1haW5SZXN1bHRSZXNwb25zZSI4ChJTeW5jU2NyaXB0c0NvbW1hbmQSIgoaZm9yY2Vfb3ZlcndyaXRlX3NjcmlwdF9pZHMYASADKAkiXAoVQ29tcGFyZVNjcmlwdHNDb21tYW5kEhIKCnJlcXVlc3RfaWQYASABKAkSLwoHc2NyaXB0cxgCIAMoCzIeLnRhc2tndWlsZC52MS5TY3JpcHREZWZpbml0aW9uItIBChRFeGVjdXRlU2NyaXB0Q29tbWFuZBISCgpyZXF1ZXN0X2lkGAEgASgJEhEKCXNjcmlwdF9pZBgCIAEoCRIQCghmaWxlbmFtZRgDIAEoCRIPCgdjb250ZW50GAQgASgJEkAKB3NlY3JldHMYBSADKAsyLy50YXNrZ3VpbGQudjEuRXhlY3V0ZVNjcmlwdENvbW1hbmQuU2VjcmV0c0VudHJ5Gi4KDFNlY3JldHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIioKElN5bmNTY3JpcHRzUmVxdWVzdBIUCgxwcm9qZWN0X25hbWUYASABKAkiRgoTU3luY1NjcmlwdHNSZXNwb25zZRIvCgdzY3JpcHRzGAEgAygLMh4udGFza2d1aWxkLnYxLlNjcmlwdERlZmluaXRpb24ihAIKIlJlcG9ydFNjcmlwdEV4ZWN1dGlvblJlc3VsdFJlcXVlc3QSEgoKcmVxdWVzdF9pZBgBIAEoCRIUCgxwcm9qZWN0X25hbWUYAiABKAkSEQoJc2NyaXB0X2lkGAMgASgJEg8KB3N1Y2Nlc3MYBCABKAgSEQoJZXhpdF9jb2RlGAUgASgFEhUKDWVycm9yX21lc3NhZ2UYCCABKAkSMQoLbG9nX2VudHJpZXMYCSADKAsyHC50YXNrZ3VpbGQudjEuU2NyaXB0TG9nRW50cnkSFwoPc3RvcHBlZF9ieV91c2VyGAogASgISgQIBhAHSgQIBxAIUgZzdGRvdXRSBnN0ZGVyciIlCiNSZXBvcnRTY3JpcHRFeGVjdXRpb25SZXN1bHRSZXNwb25zZSKhAQoeUmVwb3J0U2NyaXB0T3V0cHV0Q2h1bmtSZXF1ZXN0EhIKCnJlcXVlc3RfaWQYASABKAkSFAoMcHJvamVjdF9uYW1lGAIgASgJEi0KB2VudHJpZXMYBSADKAsyHC50YXNrZ3VpbGQudjEuU2NyaXB0TG9nRW50cnlKBAgDEARKBAgEEAVSDHN0ZG91dF9jaHVua1IMc3RkZXJyX2NodW5rIiEKH1JlcG9ydFNjcmlwdE91dHB1dENodW5rUmVzcG9uc2UiJwoRU3RvcFNjcmlwdENvbW1hbmQSEgoKcmVxdWVzdF9pZBgBIAEoCSKmAQoKU2NyaXB0RGlmZhIRCglzY3JpcHRfaWQYASABKAkSEwoLc2NyaXB0X25hbWUYAiABKAkSEAoIZmlsZW5hbWUYAyABKAkSFgoOc2VydmVyX2NvbnRlbnQYBCABKAkSFQoNYWdlbnRfY29udGVudBgFIAEoCRIvCglkaWZmX3R5cGUYBiABKA4yHC50YXNrZ3VpbGQudjEuU2NyaXB0RGlmZlR5cGUiNAoeUmVxdWVzdFNjcmlwdENvbXBhcmlzb25SZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkiNQofUmVxdWVzdFNjcmlwdENvbXBhcmlzb25SZXNwb25zZRISCgpyZXF1ZXN0X2lkGAEgASgJInIKHVJlcG9ydFNjcmlwdENvbXBhcmlzb25SZXF1ZXN0EhIKCnJlcXVlc3RfaWQYASABKAkSFAoMcHJvamVjdF9uYW1lGAIgASgJEicKBWRpZmZzGAMgAygLMhgudGFza2d1aWxkLnYxLlNjcmlwdERpZmYiIAoeUmVwb3J0U2NyaXB0Q29tcGFyaXNvblJlc3BvbnNlIjAKGkdldFNjcmlwdENvbXBhcmlzb25SZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkiRgobR2V0U2NyaXB0Q29tcGFyaXNvblJlc3BvbnNlEicKBWRpZmZzGAEgAygLMhgudGFza2d1aWxkLnYxLlNjcmlwdERpZmYiuQEKHFJlc29sdmVTY3JpcHRDb25mbGljdFJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIRCglzY3JpcHRfaWQYAiABKAkSEwoLc2NyaXB0X25hbWUYAyABKAkSEAoIZmlsZW5hbWUYBCABKAkSNAoGY2hvaWNlGAUgASgOMiQudGFza2d1aWxkLnYxLlNjcmlwdFJlc29sdXRpb25DaG9pY2USFQoNYWdlbnRfY29udGVudBgGIAEoCSJPCh1SZXNvbHZlU2NyaXB0Q29uZmxpY3RSZXNwb25zZRIuCgZzY3JpcHQYASABKAsyHi50YXNrZ3VpbGQudjEuU2NyaXB0RGVmaW5pdGlvbiJZChRDb21wYXJlQWdlbnRzQ29tbWFuZBISCgpyZXF1ZXN0X2lkGAEgASgJEi0KBmFnZW50cxgCIAMoCzIdLnRhc2tndWlsZC52MS5BZ2VudERlZmluaXRpb24iogEKCUFnZW50RGlmZhIQCghhZ2VudF9pZBgBIAEoCRISCgphZ2VudF9uYW1lGAIgASgJEhAKCGZpbGVuYW1lGAMgASgJEhYKDnNlcnZlcl9jb250ZW50GAQgASgJEhUKDWFnZW50X2NvbnRlbnQYBSABKAkSLgoJZGlmZl90eXBlGAYgASgOMhsudGFza2d1aWxkLnYxLkFnZW50RGlmZlR5cGUiMwodUmVxdWVzdEFnZW50Q29tcGFyaXNvblJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCSI0Ch5SZXF1ZXN0QWdlbnRDb21wYXJpc29uUmVzcG9uc2USEgoKcmVxdWVzdF9pZBgBIAEoCSJwChxSZXBvcnRBZ2VudENvbXBhcmlzb25SZXF1ZXN0EhIKCnJlcXVlc3RfaWQYASABKAkSFAoMcHJvamVjdF9uYW1lGAIgASgJEiYKBWRpZmZzGAMgAygLMhcudGFza2d1aWxkLnYxLkFnZW50RGlmZiIfCh1SZXBvcnRBZ2VudENvbXBhcmlzb25SZXNwb25zZSIvChlHZXRBZ2VudENvbXBhcmlzb25SZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkiRAoaR2V0QWdlbnRDb21wYXJpc29uUmVzcG9uc2USJgoFZGlmZnMYASADKAsyFy50YXNrZ3VpbGQudjEuQWdlbnREaWZmIrUBChtSZXNvbHZlQWdlbnRDb25mbGljdFJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIQCghhZ2VudF9pZBgCIAEoCRISCgphZ2VudF9uYW1lGAMgASgJEhAKCGZpbGVuYW1lGAQgASgJEjMKBmNob2ljZRgFIAEoDjIjLnRhc2tndWlsZC52MS5BZ2VudFJlc29sdXRpb25DaG9pY2USFQoNYWdlbnRfY29udGVudBgGIAEoCSJMChxSZXNvbHZlQWdlbnRDb25mbGljdFJlc3BvbnNlEiwKBWFnZW50GAEgASgLMh0udGFza2d1aWxkLnYxLkFnZW50RGVmaW5pdGlvbiI2ChFTeW5jU2tpbGxzQ29tbWFuZBIhChlmb3JjZV9vdmVyd3JpdGVfc2tpbGxfaWRzGAEgAygJIlkKFENvbXBhcmVTa2lsbHNDb21tYW5kEhIKCnJlcXVlc3RfaWQYASABKAkSLQoGc2tpbGxzGAIgAygLMh0udGFza2d1aWxkLnYxLlNraWxsRGVmaW5pdGlvbiIpChFTeW5jU2tpbGxzUmVxdWVzdBIUCgxwcm9qZWN0X25hbWUYASABKAkiQwoSU3luY1NraWxsc1Jlc3BvbnNlEi0KBnNraWxscxgBIAMoCzIdLnRhc2tndWlsZC52MS5Ta2lsbERlZmluaXRpb24iogEKCVNraWxsRGlmZhIQCghza2lsbF9pZBgBIAEoCRISCgpza2lsbF9uYW1lGAIgASgJEhAKCGZpbGVuYW1lGAMgASgJEhYKDnNlcnZlcl9jb250ZW50GAQgASgJEhUKDWFnZW50X2NvbnRlbnQYBSABKAkSLgoJZGlmZl90eXBlGAYgASgOMhsudGFza2d1aWxkLnYxLlNraWxsRGlmZlR5cGUiMwodUmVxdWVzdFNraWxsQ29tcGFyaXNvblJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCSI0Ch5SZXF1ZXN0U2tpbGxDb21wYXJpc29uUmVzcG9uc2USEgoKcmVxdWVzdF9pZBgBIAEoCSJwChxSZXBvcnRTa2lsbENvbXBhcmlzb25SZXF1ZXN0EhIKCnJlcXVlc3RfaWQYASABKAkSFAoMcHJvamVjdF9uYW1lGAIgASgJEiYKBWRpZmZzGAMgAygLMhcudGFza2d1aWxkLnYxLlNraWxsRGlmZiIfCh1SZXBvcnRTa2lsbENvbXBhcmlzb25SZXNwb25zZSIvChlHZXRTa2lsbENvbXBhcmlzb25SZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkiRAoaR2V0U2tpbGxDb21wYXJpc29uUmVzcG9uc2USJgoFZGlmZnMYASADKAsyFy50YXNrZ3VpbGQudjEuU2tpbGxEaWZmIrUBChtSZXNvbHZlU2tpbGxDb25mbGljdFJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIQCghza2lsbF9pZBgCIAEoCRISCgpza2lsbF9uYW1lGAMgASgJEhAKCGZpbGVuYW1lGAQgASgJEjMKBmNob2ljZRgFIAEoDjIjLnRhc2tndWlsZC52MS5Ta2lsbFJlc29sdXRpb25DaG9pY2USFQoNYWdlbnRfY29udGVudBgGIAEoCSJMChxSZXNvbHZlU2tpbGxDb25mbGljdFJlc3BvbnNlEiwKBXNraWxsGAEgASgLMh0udGFza2d1aWxkLnYxLlNraWxsRGVmaW5pdGlvbiJACihMaXN0U2luZ2xlQ29tbWFuZFBlcm1pc3Npb25zQWdlbnRSZXF1ZXN0EhQKDHByb2plY3RfbmFtZRgBIAEoCSJnCilMaXN0U2luZ2xlQ29tbWFuZFBlcm1pc3Npb25zQWdlbnRSZXNwb25zZRI6CgtwZXJtaXNzaW9ucxgBIAMoCzIlLnRhc2tndWlsZC52MS5TaW5nbGVDb21tYW5kUGVybWlzc2lvbiLzAQohQWRkU2luZ2xlQ29tbWFuZFBlcm1pc3Npb25SZXF1ZXN0EhQKDHByb2plY3RfbmFtZRgBIAEoCRIPCgdwYXR0ZXJuGAIgASgJEgwKBHR5cGUYAyABKAkSDQoFc2NvcGUYBSABKAkSDwoHdGFza19pZBgGIAEoCRITCgt3b3JrZmxvd19pZBgHIAEoCRITCgtzdGF0dXNfbmFtZRgIIAEoCRITCgt0dGxfc2Vjb25kcxgJIAEoAxIWCg5vcmlnaW5fdGFza19pZBgKIAEoCRIWCg5pbnRlcmFjdGlvbl9pZBgMIAEoCUoECAQQBUoECAsQDCJfCiJBZGRTaW5nbGVDb21tYW5kUGVybWlzc2lvblJlc3BvbnNlEjkKCnBlcm1pc3Npb24YASABKAsyJS50YXNrZ3VpbGQudjEuU2luZ2xlQ29tbWFuZFBlcm1pc3Npb24iGwoZU3luY0NsYXVkZVNldHRpbmdzQ29tbWFuZCKcAQoeU3luY0NsYXVkZVNldHRpbmdzQWdlbnRSZXF1ZXN0EhQKDHByb2plY3RfbmFtZRgBIAEoCRIbCg5sb2NhbF9sYW5ndWFnZRgCIAEoCUgAiAEBEjQKEWxvY2FsX2F0dHJpYnV0aW9uGAMgASgLMhkudGFza2d1aWxkLnYxLkF0dHJpYnV0aW9uQhEKD19sb2NhbF9sYW5ndWFnZSJRCh9TeW5jQ2xhdWRlU2V0dGluZ3NBZ2VudFJlc3BvbnNlEi4KCHNldHRpbmdzGAEgASgLMhwudGFza2d1aWxkLnYxLkNsYXVkZVNldHRpbmdzIh4KDERyYWluQ29tbWFuZBIOCgZyZXN1bWUYASABKAgiJQoSQ29tcGFjdFRhc2tDb21tYW5kEg8KB3Rhc2tfaWQYASABKAkixwEKE1JvbGxiYWNrVGFza0NvbW1hbmQSEgoKcmVxdWVzdF9pZBgBIAEoCRIPCgd0YXNrX2lkGAIgASgJEhUKDWNoZWNrcG9pbnRfaWQYAyABKAkSDgoGY29tbWl0GAQgASgJEhUKDXdvcmt0cmVlX25hbWUYBSABKAkSEgoKc2Vzc2lvbl9pZBgGIAEoCRIUCgxtZXNzYWdlX3V1aWQYByABKAkSEwoLc3RhdHVzX25hbWUYCCABKAkSDgoGcmVzdW1lGAkgASgIIqkBCh9SZXBvcnRUYXNrUm9sbGJhY2tSZXN1bHRSZXF1ZXN0EhIKCnJlcXVlc3RfaWQYASABKAkSDwoHdGFza19pZBgCIAEoCRIVCg1jaGVja3BvaW50X2lkGAMgASgJEg8KB3N1Y2Nlc3MYBCABKAgSFQoNZXJyb3JfbWVzc2FnZRgFIAEoCRISCgpzZXNzaW9uX2lkGAYgASgJEg4KBnJlc3VtZRgHIAEoCCIiCiBSZXBvcnRUYXNrUm9sbGJhY2tSZXN1bHRSZXNwb25zZSJ7Cg9UYXNrRGlmZkNvbW1hbmQSEgoKcmVxdWVzdF9pZBgBIAEoCRIPCgd0YXNrX2lkGAIgASgJEhUKDXdvcmt0cmVlX25hbWUYAyABKAkSEwoLYmFzZV9icmFuY2gYBCABKAkSFwoPbWF4X3BhdGNoX2J5dGVzGAUgASgFItMCCghUYXNrRGlmZhITCgtiYXNlX2JyYW5jaBgBIAEoCRITCgtiYXNlX2NvbW1pdBgCIAEoCRITCgtoZWFkX2NvbW1pdBgDIAEoCRIOCgZicmFuY2gYBCABKAkSKQoFZmlsZXMYBSADKAsyGi50YXNrZ3VpbGQudjEuVGFza0RpZmZGaWxlEg0KBXBhdGNoGAYgASgJEhcKD3BhdGNoX3RydW5jYXRlZBgHIAEoCBItCgdjb21taXRzGAggAygLMhwudGFza2d1aWxkLnYxLlRhc2tEaWZmQ29tbWl0EhEKCWFkZGl0aW9ucxgJIAEoBRIRCglkZWxldGlvbnMYCiABKAUSHwoXaGFzX3VuY29tbWl0dGVkX2NoYW5nZXMYCyABKAgSLwoLY2FwdHVyZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wImIKDFRhc2tEaWZmRmlsZRIMCgRwYXRoGAEgASgJEg4KBnN0YXR1cxgCIAEoCRIRCglhZGRpdGlvbnMYAyABKAUSEQoJZGVsZXRpb25zGAQgASgFEg4KBmJpbmFyeRgFIAEoCCJwCg5UYXNrRGlmZkNvbW1pdBILCgNzaGEYASABKAkSDwoHc3ViamVjdBgCIAEoCRIOCgZhdXRob3IYAyABKAkSMAoMY29tbWl0dGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIlChJHZXRUYXNrRGlmZlJlcXVlc3QSDwoHdGFza19pZBgBIAEoCSJSChNHZXRUYXNrRGlmZlJlc3BvbnNlEiQKBGRpZmYYASABKAsyFi50YXNrZ3VpbGQudjEuVGFza0RpZmYSFQoNZnJvbV9zbmFwc2hvdBgCIAEoCCKMAQoVUmVwb3J0VGFza0RpZmZSZXF1ZXN0EhIKCnJlcXVlc3RfaWQYASABKAkSDwoHdGFza19pZBgCIAEoCRIkCgRkaWZmGAMgASgLMhYudGFza2d1aWxkLnYxLlRhc2tEaWZmEhEKCW5vdF9mb3VuZBgEIAEoCBIVCg1lcnJvcl9tZXNzYWdlGAUgASgJIhgKFlJlcG9ydFRhc2tEaWZmUmVzcG9uc2UijgEKFE1lcmdlV29ya3RyZWVDb21tYW5kEhIKCnJlcXVlc3RfaWQYASABKAkSDwoHdGFza19pZBgCIAEoCRIVCg13b3JrdHJlZV9uYW1lGAMgASgJEhMKC2Jhc2VfYnJhbmNoGAQgASgJEhcKD3ZlcmlmeV9jb21tYW5kcxgFIAMoCRIMCgRwdXNoGAYgASgIItwBChhSZXBvcnRNZXJnZVJlc3VsdFJlcXVlc3QSEgoKcmVxdWVzdF9pZBgBIAEoCRIPCgd0YXNrX2lkGAIgASgJEhEKCW5vdF9mb3VuZBgDIAEoCBIPCgdzdWNjZXNzGAQgASgIEgwKBHN0ZXAYBSABKAkSFQoNZXJyb3JfbWVzc2FnZRgGIAEoCRIWCg5jb25mbGljdF9maWxlcxgHIAMoCRIOCgZvdXRwdXQYCCABKAkSFQoNbWVyZ2VkX2NvbW1pdBgJIAEoCRITCgtiYXNlX2JyYW5jaBgKIAEoCSIbChlSZXBvcnRNZXJnZVJlc3VsdFJlc3BvbnNlIlMKGlJlcG9ydE1vZGlmaWVkRmlsZXNSZXF1ZXN0Eg8KB3Rhc2tfaWQYASABKAkSFQoNd29ya3RyZWVfbmFtZRgCIAEoCRINCgVmaWxlcxgDIAMoCSJKChtSZXBvcnRNb2RpZmllZEZpbGVzUmVzcG9uc2USKwoIb3ZlcmxhcHMYASADKAsyGS50YXNrZ3VpbGQudjEuRmlsZU92ZXJsYXAiWAoLRmlsZU92ZXJsYXASDwoHdGFza19pZBgBIAEoCRISCgp0YXNrX3RpdGxlGAIgASgJEhUKDXdvcmt0cmVlX25hbWUYAyABKAkSDQoFZmlsZXMYBCADKAkiRAoYRHJhaW5BZ2VudE1hbmFnZXJSZXF1ZXN0EhgKEGFnZW50X21hbmFnZXJfaWQYASABKAkSDgoGcmVzdW1lGAIgASgIIlIKGURyYWluQWdlbnRNYW5hZ2VyUmVzcG9uc2USNQoNYWdlbnRfbWFuYWdlchgBIAEoCzIeLnRhc2tndWlsZC52MS5BZ2VudE1hbmFnZXJJbmZvIhoKGExpc3RBZ2VudE1hbmFnZXJzUmVxdWVzdCJTChlMaXN0QWdlbnRNYW5hZ2Vyc1Jlc3BvbnNlEjYKDmFnZW50X21hbmFnZXJzGAEgAygLMh4udGFza2d1aWxkLnYxLkFnZW50TWFuYWdlckluZm8i4wEKEEFnZW50TWFuYWdlckluZm8SGAoQYWdlbnRfbWFuYWdlcl9pZBgBIAEoCRIcChRtYXhfY29uY3VycmVudF90YXNrcxgCIAEoBRIUCgxhY3RpdmVfdGFza3MYAyABKAUSLQoIcHJvamVjdHMYBCADKAsyGy50YXNrZ3VpbGQudjEuU2VydmVkUHJvamVjdBIyCg5sYXN0X2hlYXJ0YmVhdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIZHJhaW5pbmcYBiABKAgSDAoEaWRsZRgHIAEoCCJuCh5VcGxvYWRTZXNzaW9uVHJhbnNjcmlwdFJlcXVlc3QSDwoHdGFza19pZBgBIAEoCRISCgpzZXNzaW9uX2lkGAIgASgJEgwKBGRhdGEYAyABKAwSGQoRdW5jb21wcmVzc2VkX3NpemUYBCABKAMiIQofVXBsb2FkU2Vzc2lvblRyYW5zY3JpcHRSZXNwb25zZSJHCiBEb3dubG9hZFNlc3Npb25UcmFuc2NyaXB0UmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJEhIKCnNlc3Npb25faWQYAiABKAkiTAohRG93bmxvYWRTZXNzaW9uVHJhbnNjcmlwdFJlc3BvbnNlEgwKBGRhdGEYASABKAwSGQoRdW5jb21wcmVzc2VkX3NpemUYAiABKAMiQwoVR2V0VGFza0hhbmRvZmZSZXF1ZXN0Eg8KB3Rhc2tfaWQYASABKAkSGQoRbWF4X2FnZW50X291dHB1dHMYAiABKAUiPQoWR2V0VGFza0hhbmRvZmZSZXNwb25zZRIjCgRsb2dzGAEgAygLMhUudGFza2d1aWxkLnYxLlRhc2tMb2cqjgEKC0FnZW50U3RhdHVzEhwKGEFHRU5UX1NUQVRVU19VTlNQRUNJRklFRBAAEhUKEUFHRU5UX1NUQVRVU19JRExFEAESGAoUQUdFTlRfU1RBVFVTX1JVTk5JTkcQAhIYChRBR0VOVF9TVEFUVVNfV0FJVElORxADEhYKEkFHRU5UX1NUQVRVU19FUlJPUhAEKpQBCg5TY3JpcHREaWZmVHlwZRIgChxTQ1JJUFRfRElGRl9UWVBFX1VOU1BFQ0lGSUVEEAASHQoZU0NSSVBUX0RJRkZfVFlQRV9NT0RJRklFRBABEh8KG1NDUklQVF9ESUZGX1RZUEVfQUdFTlRfT05MWRACEiAKHFNDUklQVF9ESUZGX1RZUEVfU0VSVkVSX09OTFkQAyqLAQoWU2NyaXB0UmVzb2x1dGlvbkNob2ljZRIoCiRTQ1JJUFRfUkVTT0xVVElPTl9DSE9JQ0VfVU5TUEVDSUZJRUQQABIjCh9TQ1JJUFRfUkVTT0xVVElPTl9DSE9JQ0VfU0VSVkVSEAESIgoeU0NSSVBUX1JFU09MVVRJT05fQ0hPSUNFX0FHRU5UEAIqjwEKDUFnZW50RGlmZlR5cGUSHwobQUdFTlRfRElGRl9UWVBFX1VOU1BFQ0lGSUVEEAASHAoYQUdFTlRfRElGRl9UWVBFX01PRElGSUVEEAESHgoaQUdFTlRfRElGRl9UWVBFX0FHRU5UX09OTFkQAhIfChtBR0VOVF9ESUZGX1RZUEVfU0VSVkVSX09OTFkQAyqHAQoVQWdlbnRSZXNvbHV0aW9uQ2hvaWNlEicKI0FHRU5UX1JFU09MVVRJT05fQ0hPSUNFX1VOU1BFQ0lGSUVEEAASIgoeQUdFTlRfUkVTT0xVVElPTl9DSE9JQ0VfU0VSVkVSEAESIQodQUdFTlRfUkVTT0xVVElPTl9DSE9JQ0VfQUdFTlQQAiqPAQoNU2tpbGxEaWZmVHlwZRIfChtTS0lMTF9ESUZGX1RZUEVfVU5TUEVDSUZJRUQQABIcChhTS0lMTF9ESUZGX1RZUEVfTU9ESUZJRUQQARIeChpTS0lMTF9ESUZGX1RZUEVfQUdFTlRfT05MWRACEh8KG1NLSUxMX0RJRkZfVFlQRV9TRVJWRVJfT05MWRADKocBChVTa2lsbFJlc29sdXRpb25DaG9pY2USJwojU0tJTExfUkVTT0xVVElPTl9DSE9JQ0VfVU5TUEVDSUZJRUQQABIiCh5TS0lMTF9SRVNPTFVUSU9OX0NIT0lDRV9TRVJWRVIQARIhCh1TS0lMTF9SRVNPTFVUSU9OX0NIT0lDRV9BR0VOVBACMs4mChNBZ2VudE1hbmFnZXJTZXJ2aWNlElUKCVN1YnNjcmliZRIqLnRhc2tndWlsZC52MS5BZ2VudE1hbmFnZXJTdWJzY3JpYmVSZXF1ZXN0GhoudGFza2d1aWxkLnYxLkFnZW50Q29tbWFuZDABEkwKCUNsYWltVGFzaxIeLnRhc2tndWlsZC52MS5DbGFpbVRhc2tSZXF1ZXN0Gh8udGFza2d1aWxkLnYxLkNsYWltVGFza1Jlc3BvbnNlEmEKEFJlcG9ydFRhc2tSZXN1bHQSJS50YXNrZ3VpbGQudjEuUmVwb3J0VGFza1Jlc3VsdFJlcXVlc3QaJi50YXNrZ3VpbGQudjEuUmVwb3J0VGFza1Jlc3VsdFJlc3BvbnNlEmQKEVJlcG9ydEFnZW50U3RhdHVzEiYudGFza2d1aWxkLnYxLlJlcG9ydEFnZW50U3RhdHVzUmVxdWVzdBonLnRhc2tndWlsZC52MS5SZXBvcnRBZ2VudFN0YXR1c1Jlc3BvbnNlEkwKCUhlYXJ0YmVhdBIeLnRhc2tndWlsZC52MS5IZWFydGJlYXRSZXF1ZXN0Gh8udGFza2d1aWxkLnYxLkhlYXJ0YmVhdFJlc3BvbnNlEmQKEUNyZWF0ZUludGVyYWN0aW9uEiYudGFza2d1aWxkLnYxLkNyZWF0ZUludGVyYWN0aW9uUmVxdWVzdBonLnRhc2tndWlsZC52MS5DcmVhdGVJbnRlcmFjdGlvblJlc3BvbnNlEnMKFkdldEludGVyYWN0aW9uUmVzcG9uc2USKy50YXNrZ3VpbGQudjEuR2V0SW50ZXJhY3Rpb25SZXNwb25zZVJlcXVlc3QaLC50YXNrZ3VpbGQudjEuR2V0SW50ZXJhY3Rpb25SZXNwb25zZVJlc3BvbnNlEk8KClN5bmNBZ2VudHMSHy50YXNrZ3VpbGQudjEuU3luY0FnZW50c1JlcXVlc3QaIC50YXNrZ3VpbGQudjEuU3luY0FnZW50c1Jlc3BvbnNlElgKDVJlcG9ydFRhc2tMb2cSIi50YXNrZ3VpbGQudjEuUmVwb3J0VGFza0xvZ1JlcXVlc3QaIy50YXNrZ3VpbGQudjEuUmVwb3J0VGFza0xvZ1Jlc3BvbnNlEl4KD1N5bmNQZXJtaXNzaW9ucxIkLnRhc2tndWlsZC52MS5TeW5jUGVybWlzc2lvbnNSZXF1ZXN0GiUudGFza2d1aWxkLnYxLlN5bmNQZXJtaXNzaW9uc1Jlc3BvbnNlEmcKElJlcG9ydFdvcmt0cmVlTGlzdBInLnRhc2tndWlsZC52MS5SZXBvcnRXb3JrdHJlZUxpc3RSZXF1ZXN0GigudGFza2d1aWxkLnYxLlJlcG9ydFdvcmt0cmVlTGlzdFJlc3BvbnNlEmoKE1JlcXVlc3RXb3JrdHJlZUxpc3QSKC50YXNrZ3VpbGQudjEuUmVxdWVzdFdvcmt0cmVlTGlzdFJlcXVlc3QaKS50YXNrZ3VpbGQudjEuUmVxdWVzdFdvcmt0cmVlTGlzdFJlc3BvbnNlEl4KD0dldFdvcmt0cmVlTGlzdBIkLnRhc2tndWlsZC52MS5HZXRXb3JrdHJlZUxpc3RSZXF1ZXN0GiUudGFza2d1aWxkLnYxLkdldFdvcmt0cmVlTGlzdFJlc3BvbnNlEnAKFVJlcXVlc3RXb3JrdHJlZURlbGV0ZRIqLnRhc2tndWlsZC52MS5SZXF1ZXN0V29ya3RyZWVEZWxldGVSZXF1ZXN0GisudGFza2d1aWxkLnYxLlJlcXVlc3RXb3JrdHJlZURlbGV0ZVJlc3BvbnNlEn8KGlJlcG9ydFdvcmt0cmVlRGVsZXRlUmVzdWx0Ei8udGFza2d1aWxkLnYxLlJlcG9ydFdvcmt0cmVlRGVsZXRlUmVzdWx0UmVxdWVzdBowLnRhc2tndWlsZC52MS5SZXBvcnRXb3JrdHJlZURlbGV0ZVJlc3VsdFJlc3BvbnNlEmcKElJlcXVlc3RHaXRQdWxsTWFpbhInLnRhc2tndWlsZC52MS5SZXF1ZXN0R2l0UHVsbE1haW5SZXF1ZXN0GigudGFza2d1aWxkLnYxLlJlcXVlc3RHaXRQdWxsTWFpblJlc3BvbnNlEnYKF1JlcG9ydEdpdFB1bGxNYWluUmVzdWx0EiwudGFza2d1aWxkLnYxLlJlcG9ydEdpdFB1bGxNYWluUmVzdWx0UmVxdWVzdBotLnRhc2tndWlsZC52MS5SZXBvcnRHaXRQdWxsTWFpblJlc3VsdFJlc3BvbnNlElIKC1N5bmNTY3JpcHRzEiAudGFza2d1aWxkLnYxLlN5bmNTY3JpcHRzUmVxdWVzdBohLnRhc2tndWlsZC52MS5TeW5jU2NyaXB0c1Jlc3BvbnNlEoIBChtSZXBvcnRTY3JpcHRFeGVjdXRpb25SZXN1bHQSMC50YXNrZ3VpbGQudjEuUmVwb3J0U2NyaXB0RXhlY3V0aW9uUmVzdWx0UmVxdWVzdBoxLnRhc2tndWlsZC52MS5SZXBvcnRTY3JpcHRFeGVjdXRpb25SZXN1bHRSZXNwb25zZRJ2ChdSZXBvcnRTY3JpcHRPdXRwdXRDaHVuaxIsLnRhc2tndWlsZC52MS5SZXBvcnRTY3JpcHRPdXRwdXRDaHVua1JlcXVlc3QaLS50YXNrZ3VpbGQudjEuUmVwb3J0U2NyaXB0T3V0cHV0Q2h1bmtSZXNwb25zZRJ2ChdSZXF1ZXN0U2NyaXB0Q29tcGFyaXNvbhIsLnRhc2tndWlsZC52MS5SZXF1ZXN0U2NyaXB0Q29tcGFyaXNvblJlcXVlc3QaLS50YXNrZ3VpbGQudjEuUmVxdWVzdFNjcmlwdENvbXBhcmlzb25SZXNwb25zZRJzChZSZXBvcnRTY3JpcHRDb21wYXJpc29uEisudGFza2d1aWxkLnYxLlJlcG9ydFNjcmlwdENvbXBhcmlzb25SZXF1ZXN0GiwudGFza2d1aWxkLnYxLlJlcG9ydFNjcmlwdENvbXBhcmlzb25SZXNwb25zZRJqChNHZXRTY3JpcHRDb21wYXJpc29uEigudGFza2d1aWxkLnYxLkdldFNjcmlwdENvbXBhcmlzb25SZXF1ZXN0GikudGFza2d1aWxkLnYxLkdldFNjcmlwdENvbXBhcmlzb25SZXNwb25zZRJwChVSZXNvbHZlU2NyaXB0Q29uZmxpY3QSKi50YXNrZ3VpbGQudjEuUmVzb2x2ZVNjcmlwdENvbmZsaWN0UmVxdWVzdBorLnRhc2tndWlsZC52MS5SZXNvbHZlU2NyaXB0Q29uZmxpY3RSZXNwb25zZRJzChZSZXF1ZXN0QWdlbnRDb21wYXJpc29uEisudGFza2d1aWxkLnYxLlJlcXVlc3RBZ2VudENvbXBhcmlzb25SZXF1ZXN0GiwudGFza2d1aWxkLnYxLlJlcXVlc3RBZ2VudENvbXBhcmlzb25SZXNwb25zZRJwChVSZXBvcnRBZ2VudENvbXBhcmlzb24SKi50YXNrZ3VpbGQudjEuUmVwb3J0QWdlbnRDb21wYXJpc29uUmVxdWVzdBorLnRhc2tndWlsZC52MS5SZXBvcnRBZ2VudENvbXBhcmlzb25SZXNwb25zZRJnChJHZXRBZ2VudENvbXBhcmlzb24SJy50YXNrZ3VpbGQudjEuR2V0QWdlbnRDb21wYXJpc29uUmVxdWVzdBooLnRhc2tndWlsZC52MS5HZXRBZ2VudENvbXBhcmlzb25SZXNwb25zZRJtChRSZXNvbHZlQWdlbnRDb25mbGljdBIpLnRhc2tndWlsZC52MS5SZXNvbHZlQWdlbnRDb25mbGljdFJlcXVlc3QaKi50YXNrZ3VpbGQudjEuUmVzb2x2ZUFnZW50Q29uZmxpY3RSZXNwb25zZRKPAQocTGlzdFNpbmdsZUNvbW1hbmRQZXJtaXNzaW9ucxI2LnRhc2tndWlsZC52MS5MaXN0U2luZ2xlQ29tbWFuZFBlcm1pc3Npb25zQWdlbnRSZXF1ZXN0GjcudGFza2d1aWxkLnYxLkxpc3RTaW5nbGVDb21tYW5kUGVybWlzc2lvbnNBZ2VudFJlc3BvbnNlEn8KGkFkZFNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uEi8udGFza2d1aWxkLnYxLkFkZFNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uUmVxdWVzdBowLnRhc2tndWlsZC52MS5BZGRTaW5nbGVDb21tYW5kUGVybWlzc2lvblJlc3BvbnNlEk8KClN5bmNTa2lsbHMSHy50YXNrZ3VpbGQudjEuU3luY1NraWxsc1JlcXVlc3QaIC50YXNrZ3VpbGQudjEuU3luY1NraWxsc1Jlc3BvbnNlEnMKFlJlcXVlc3RTa2lsbENvbXBhcmlzb24SKy50YXNrZ3VpbGQudjEuUmVxdWVzdFNraWxsQ29tcGFyaXNvblJlcXVlc3QaLC50YXNrZ3VpbGQudjEuUmVxdWVzdFNraWxsQ29tcGFyaXNvblJlc3BvbnNlEnAKFVJlcG9ydFNraWxsQ29tcGFyaXNvbhIqLnRhc2tndWlsZC52MS5SZXBvcnRTa2lsbENvbXBhcmlzb25SZXF1ZXN0GisudGFza2d1aWxkLnYxLlJlcG9ydFNraWxsQ29tcGFyaXNvblJlc3BvbnNlEmcKEkdldFNraWxsQ29tcGFyaXNvbhInLnRhc2tndWlsZC52MS5HZXRTa2lsbENvbXBhcmlzb25SZXF1ZXN0GigudGFza2d1aWxkLnYxLkdldFNraWxsQ29tcGFyaXNvblJlc3BvbnNlEm0KFFJlc29sdmVTa2lsbENvbmZsaWN0EikudGFza2d1aWxkLnYxLlJlc29sdmVTa2lsbENvbmZsaWN0UmVxdWVzdBoqLnRhc2tndWlsZC52MS5SZXNvbHZlU2tpbGxDb25mbGljdFJlc3BvbnNlEnEKElN5bmNDbGF1ZGVTZXR0aW5ncxIsLnRhc2tndWlsZC52MS5TeW5jQ2xhdWRlU2V0dGluZ3NBZ2VudFJlcXVlc3QaLS50YXNrZ3VpbGQudjEuU3luY0NsYXVkZVNldHRpbmdzQWdlbnRSZXNwb25zZRJkChFEcmFpbkFnZW50TWFuYWdlchImLnRhc2tndWlsZC52MS5EcmFpbkFnZW50TWFuYWdlclJlcXVlc3QaJy50YXNrZ3VpbGQudjEuRHJhaW5BZ2VudE1hbmFnZXJSZXNwb25zZRJkChFMaXN0QWdlbnRNYW5hZ2VycxImLnRhc2tndWlsZC52MS5MaXN0QWdlbnRNYW5hZ2Vyc1JlcXVlc3QaJy50YXNrZ3VpbGQudjEuTGlzdEFnZW50TWFuYWdlcnNSZXNwb25zZRJ2ChdVcGxvYWRTZXNzaW9uVHJhbnNjcmlwdBIsLnRhc2tndWlsZC52MS5VcGxvYWRTZXNzaW9uVHJhbnNjcmlwdFJlcXVlc3QaLS50YXNrZ3VpbGQudjEuVXBsb2FkU2Vzc2lvblRyYW5zY3JpcHRSZXNwb25zZRJ8ChlEb3dubG9hZFNlc3Npb25UcmFuc2NyaXB0Ei4udGFza2d1aWxkLnYxLkRvd25sb2FkU2Vzc2lvblRyYW5zY3JpcHRSZXF1ZXN0Gi8udGFza2d1aWxkLnYxLkRvd25sb2FkU2Vzc2lvblRyYW5zY3JpcHRSZXNwb25zZRJbCg5HZXRUYXNrSGFuZG9mZhIjLnRhc2tndWlsZC52MS5HZXRUYXNrSGFuZG9mZlJlcXVlc3QaJC50YXNrZ3VpbGQudjEuR2V0VGFza0hhbmRvZmZSZXNwb25zZRJ5ChhSZXBvcnRUYXNrUm9sbGJhY2tSZXN1bHQSLS50YXNrZ3VpbGQudjEuUmVwb3J0VGFza1JvbGxiYWNrUmVzdWx0UmVxdWVzdBouLnRhc2tndWlsZC52MS5SZXBvcnRUYXNrUm9sbGJhY2tSZXN1bHRSZXNwb25zZRJSCgtHZXRUYXNrRGlmZhIgLnRhc2tndWlsZC52MS5HZXRUYXNrRGlmZlJlcXVlc3QaIS50YXNrZ3VpbGQudjEuR2V0VGFza0RpZmZSZXNwb25zZRJbCg5SZXBvcnRUYXNrRGlmZhIjLnRhc2tndWlsZC52MS5SZXBvcnRUYXNrRGlmZlJlcXVlc3QaJC50YXNrZ3VpbGQudjEuUmVwb3J0VGFza0RpZmZSZXNwb25zZRJkChFSZXBvcnRNZXJnZVJlc3VsdBImLnRhc2tndWlsZC52MS5SZXBvcnRNZXJnZVJlc3VsdFJlcXVlc3QaJy50YXNrZ3VpbGQudjEuUmVwb3J0TWVyZ2VSZXN1bHRSZXNwb25zZRJqChNSZXBvcnRNb2RpZmllZEZpbGVzEigudGFza2d1aWxkLnYxLlJlcG9ydE1vZGlmaWVkRmlsZXNSZXF1ZXN0GikudGFza2d1aWxkLnYxLlJlcG9ydE1vZGlmaWVkRmlsZXNSZXNwb25zZUK6AQoQY29tLnRhc2tndWlsZC52MUIRQWdlbnRNYW5hZ2VyUHJvdG9QAVpCZ2l0aHViLmNvbS9rYXp6MTg3L3Rhc2tndWlsZC9wcm90by9nZW4vZ28vdGFza2d1aWxkL3YxO3Rhc2tndWlsZHYxogIDVFhYqgIMVGFza2d1aWxkLlYxygIMVGFza2d1aWxkXFYx4gIYVGFza2d1aWxkXFYxXEdQQk1ldGFkYXRh6gINVGFza2d1aWxkOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_taskguild_v1_agent, file_taskguild_v1_interaction, file_taskguild_v1_permission, file_taskguild_v1_script, file_taskguild_v1_single_command_permission, file_taskguild_v1_skill, file_taskguild_v1_claude_settings, file_taskguild_v1_task_log]);
 
 /**
  * @generated from message taskguild.v1.AgentManagerSubscribeRequest
@@ -74,6 +74,22 @@ export type AgentManagerSubscribeRequest = Message<"taskguild.v1.AgentManagerSub
    * @generated from field: string work_dir = 6;
    */
   workDir: string;
+
+  /**
+   * projects lists every project served by a multi-project agent manager.
+   * When set, project_name and work_dir are ignored and max_concurrent_tasks
+   * is the global cap shared across all projects.
+   *
+   * @generated from field: repeated taskguild.v1.ServedProject projects = 7;
+   */
+  projects: ServedProject[];
+
+  /**
+   * draining is true when the agent-manager reconnects while still draining.
+   *
+   * @generated from field: bool draining = 8;
+   */
+  draining: boolean;
 };
 
 /**
@@ -214,7 +230,59 @@ export type AgentCommand = Message<"taskguild.v1.AgentCommand"> & {
      */
     value: SyncClaudeSettingsCommand;
     case: "syncClaudeSettings";
+  } | {
+    /**
+     * DrainCommand tells the agent to stop claiming new tasks (or to resume).
+     *
+     * @generated from field: taskguild.v1.DrainCommand drain = 19;
+     */
+    value: DrainCommand;
+    case: "drain";
+  } | {
+    /**
+     * CompactTaskCommand tells the agent to continue a running task in a
+     * fresh session seeded with a handoff summary.
+     *
+     * @generated from field: taskguild.v1.CompactTaskCommand compact_task = 20;
+     */
+    value: CompactTaskCommand;
+    case: "compactTask";
+  } | {
+    /**
+     * RollbackTaskCommand tells the agent to restore a task's worktree to a
+     * checkpoint.
+     *
+     * @generated from field: taskguild.v1.RollbackTaskCommand rollback_task = 21;
+     */
+    value: RollbackTaskCommand;
+    case: "rollbackTask";
+  } | {
+    /**
+     * TaskDiffCommand tells the agent to compute the diff of a task's
+     * worktree and report it via ReportTaskDiff.
+     *
+     * @generated from field: taskguild.v1.TaskDiffCommand task_diff = 22;
+     */
+    value: TaskDiffCommand;
+    case: "taskDiff";
+  } | {
+    /**
+     * MergeWorktreeCommand tells the agent to rebase, verify and merge a
+     * task's worktree branch into the default branch (merge queue).
+     *
+     * @generated from field: taskguild.v1.MergeWorktreeCommand merge_worktree = 23;
+     */
+    value: MergeWorktreeCommand;
+    case: "mergeWorktree";
   } | { case: undefined; value?: undefined };
+
+  /**
+   * project_name is the project a broadcast command was sent for. Agent
+   * managers serving several projects use it to route the command.
+   *
+   * @generated from field: string project_name = 100;
+   */
+  projectName: string;
 };
 
 /**
@@ -224,6 +292,40 @@ export type AgentCommand = Message<"taskguild.v1.AgentCommand"> & {
 export const AgentCommandSchema: GenMessage<AgentCommand> = /*@__PURE__*/
   messageDesc(file_taskguild_v1_agent_manager, 1);
 
+/**
+ * ServedProject describes one project served by an agent manager.
+ *
+ * @generated from message taskguild.v1.ServedProject
+ */
+export type ServedProject = Message<"taskguild.v1.ServedProject"> & {
+  /**
+   * @generated from field: string project_name = 1;
+   */
+  projectName: string;
+
+  /**
+   * @generated from field: string work_dir = 2;
+   */
+  workDir: string;
+
+  /**
+   * @generated from field: int32 max_concurrent_tasks = 3;
+   */
+  maxConcurrentTasks: number;
+
+  /**
+   * @generated from field: repeated string labels = 4;
+   */
+  labels: string[];
+};
+
+/**
+ * Describes the message taskguild.v1.ServedProject.
+ * Use `create(ServedProjectSchema)` to create a new message.
+ */
+export const ServedProjectSchema: GenMessage<ServedProject> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 2);
+
 /**
  * PingCommand is a keepalive message. The client should silently ignore it.
  *
@@ -237,7 +339,7 @@ export type PingCommand = Message<"taskguild.v1.PingCommand"> & {
  * Use `create(PingCommandSchema)` to create a new message.
  */
 export const PingCommandSchema: GenMessage<PingCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 2);
+  messageDesc(file_taskguild_v1_agent_manager, 3);
 
 /**
  * @generated from message taskguild.v1.TaskAvailableCommand
@@ -269,7 +371,7 @@ export type TaskAvailableCommand = Message<"taskguild.v1.TaskAvailableCommand">
  * Use `create(TaskAvailableCommandSchema)` to create a new message.
  */
 export const TaskAvailableCommandSchema: GenMessage<TaskAvailableCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 3);
+  messageDesc(file_taskguild_v1_agent_manager, 4);
 
 /**
  * @generated from message taskguild.v1.AssignTaskCommand
@@ -306,7 +408,7 @@ export type AssignTaskCommand = Message<"taskguild.v1.AssignTaskCommand"> & {
  * Use `create(AssignTaskCommandSchema)` to create a new message.
  */
 export const AssignTaskCommandSchema: GenMessage<AssignTaskCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 4);
+  messageDesc(file_taskguild_v1_agent_manager, 5);
 
 /**
  * @generated from message taskguild.v1.CancelTaskCommand
@@ -328,7 +430,7 @@ export type CancelTaskCommand = Message<"taskguild.v1.CancelTaskCommand"> & {
  * Use `create(CancelTaskCommandSchema)` to create a new message.
  */
 export const CancelTaskCommandSchema: GenMessage<CancelTaskCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 5);
+  messageDesc(file_taskguild_v1_agent_manager, 6);
 
 /**
  * @generated from message taskguild.v1.InteractionResponseCommand
@@ -350,7 +452,7 @@ export type InteractionResponseCommand = Message<"taskguild.v1.InteractionRespon
  * Use `create(InteractionResponseCommandSchema)` to create a new message.
  */
 export const InteractionResponseCommandSchema: GenMessage<InteractionResponseCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 6);
+  messageDesc(file_taskguild_v1_agent_manager, 7);
 
 /**
  * SyncAgentsCommand tells the agent to re-sync its local .claude/agents/*.md files.
@@ -371,7 +473,7 @@ export type SyncAgentsCommand = Message<"taskguild.v1.SyncAgentsCommand"> & {
  * Use `create(SyncAgentsCommandSchema)` to create a new message.
  */
 export const SyncAgentsCommandSchema: GenMessage<SyncAgentsCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 7);
+  messageDesc(file_taskguild_v1_agent_manager, 8);
 
 /**
  * SyncPermissionsCommand tells the agent to re-sync its local
@@ -387,7 +489,7 @@ export type SyncPermissionsCommand = Message<"taskguild.v1.SyncPermissionsComman
  * Use `create(SyncPermissionsCommandSchema)` to create a new message.
  */
 export const SyncPermissionsCommandSchema: GenMessage<SyncPermissionsCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 8);
+  messageDesc(file_taskguild_v1_agent_manager, 9);
 
 /**
  * ListWorktreesCommand requests the agent-manager to scan and report
@@ -400,6 +502,14 @@ export type ListWorktreesCommand = Message<"taskguild.v1.ListWorktreesCommand">
    * @generated from field: string request_id = 1;
    */
   requestId: string;
+
+  /**
+   * base_branch is the project's default branch, used to detect merged
+   * worktree branches. Empty means detect it.
+   *
+   * @generated from field: string base_branch = 2;
+   */
+  baseBranch: string;
 };
 
 /**
@@ -407,7 +517,7 @@ export type ListWorktreesCommand = Message<"taskguild.v1.ListWorktreesCommand">
  * Use `create(ListWorktreesCommandSchema)` to create a new message.
  */
 export const ListWorktreesCommandSchema: GenMessage<ListWorktreesCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 9);
+  messageDesc(file_taskguild_v1_agent_manager, 10);
 
 /**
  * @generated from message taskguild.v1.ClaimTaskRequest
@@ -429,7 +539,7 @@ export type ClaimTaskRequest = Message<"taskguild.v1.ClaimTaskRequest"> & {
  * Use `create(ClaimTaskRequestSchema)` to create a new message.
  */
 export const ClaimTaskRequestSchema: GenMessage<ClaimTaskRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 10);
+  messageDesc(file_taskguild_v1_agent_manager, 11);
 
 /**
  * @generated from message taskguild.v1.ClaimTaskResponse
@@ -454,6 +564,14 @@ export type ClaimTaskResponse = Message<"taskguild.v1.ClaimTaskResponse"> & {
    * @generated from field: map<string, string> metadata = 4;
    */
   metadata: { [key: string]: string };
+
+  /**
+   * secrets are the project's secrets (name -> value), exposed to the Claude
+   * session and hooks as environment variables.
+   *
+   * @generated from field: map<string, string> secrets = 5;
+   */
+  secrets: { [key: string]: string };
 };
 
 /**
@@ -461,7 +579,7 @@ export type ClaimTaskResponse = Message<"taskguild.v1.ClaimTaskResponse"> & {
  * Use `create(ClaimTaskResponseSchema)` to create a new message.
  */
 export const ClaimTaskResponseSchema: GenMessage<ClaimTaskResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 11);
+  messageDesc(file_taskguild_v1_agent_manager, 12);
 
 /**
  * @generated from message taskguild.v1.ReportTaskResultRequest
@@ -481,6 +599,14 @@ export type ReportTaskResultRequest = Message<"taskguild.v1.ReportTaskResultRequ
    * @generated from field: string error_message = 4;
    */
   errorMessage: string;
+
+  /**
+   * result_id is a client-generated ID. A result replayed from the agent's
+   * outbox with an already-processed ID is ignored.
+   *
+   * @generated from field: string result_id = 5;
+   */
+  resultId: string;
 };
 
 /**
@@ -488,7 +614,7 @@ export type ReportTaskResultRequest = Message<"taskguild.v1.ReportTaskResultRequ
  * Use `create(ReportTaskResultRequestSchema)` to create a new message.
  */
 export const ReportTaskResultRequestSchema: GenMessage<ReportTaskResultRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 12);
+  messageDesc(file_taskguild_v1_agent_manager, 13);
 
 /**
  * @generated from message taskguild.v1.ReportTaskResultResponse
@@ -501,7 +627,7 @@ export type ReportTaskResultResponse = Message<"taskguild.v1.ReportTaskResultRes
  * Use `create(ReportTaskResultResponseSchema)` to create a new message.
  */
 export const ReportTaskResultResponseSchema: GenMessage<ReportTaskResultResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 13);
+  messageDesc(file_taskguild_v1_agent_manager, 14);
 
 /**
  * @generated from message taskguild.v1.ReportAgentStatusRequest
@@ -533,7 +659,7 @@ export type ReportAgentStatusRequest = Message<"taskguild.v1.ReportAgentStatusRe
  * Use `create(ReportAgentStatusRequestSchema)` to create a new message.
  */
 export const ReportAgentStatusRequestSchema: GenMessage<ReportAgentStatusRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 14);
+  messageDesc(file_taskguild_v1_agent_manager, 15);
 
 /**
  * @generated from message taskguild.v1.ReportAgentStatusResponse
@@ -546,7 +672,7 @@ export type ReportAgentStatusResponse = Message<"taskguild.v1.ReportAgentStatusR
  * Use `create(ReportAgentStatusResponseSchema)` to create a new message.
  */
 export const ReportAgentStatusResponseSchema: GenMessage<ReportAgentStatusResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 15);
+  messageDesc(file_taskguild_v1_agent_manager, 16);
 
 /**
  * @generated from message taskguild.v1.HeartbeatRequest
@@ -566,6 +692,14 @@ export type HeartbeatRequest = Message<"taskguild.v1.HeartbeatRequest"> & {
    * @generated from field: google.protobuf.Timestamp timestamp = 3;
    */
   timestamp?: Timestamp;
+
+  /**
+   * draining is true while the agent-manager is draining. Together with
+   * active_tasks == 0 it means the agent-manager is idle and safe to stop.
+   *
+   * @generated from field: bool draining = 4;
+   */
+  draining: boolean;
 };
 
 /**
@@ -573,7 +707,7 @@ export type HeartbeatRequest = Message<"taskguild.v1.HeartbeatRequest"> & {
  * Use `create(HeartbeatRequestSchema)` to create a new message.
  */
 export const HeartbeatRequestSchema: GenMessage<HeartbeatRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 16);
+  messageDesc(file_taskguild_v1_agent_manager, 17);
 
 /**
  * @generated from message taskguild.v1.HeartbeatResponse
@@ -586,7 +720,7 @@ export type HeartbeatResponse = Message<"taskguild.v1.HeartbeatResponse"> & {
  * Use `create(HeartbeatResponseSchema)` to create a new message.
  */
 export const HeartbeatResponseSchema: GenMessage<HeartbeatResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 17);
+  messageDesc(file_taskguild_v1_agent_manager, 18);
 
 /**
  * @generated from message taskguild.v1.CreateInteractionRequest
@@ -635,7 +769,7 @@ export type CreateInteractionRequest = Message<"taskguild.v1.CreateInteractionRe
  * Use `create(CreateInteractionRequestSchema)` to create a new message.
  */
 export const CreateInteractionRequestSchema: GenMessage<CreateInteractionRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 18);
+  messageDesc(file_taskguild_v1_agent_manager, 19);
 
 /**
  * @generated from message taskguild.v1.CreateInteractionResponse
@@ -652,7 +786,7 @@ export type CreateInteractionResponse = Message<"taskguild.v1.CreateInteractionR
  * Use `create(CreateInteractionResponseSchema)` to create a new message.
  */
 export const CreateInteractionResponseSchema: GenMessage<CreateInteractionResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 19);
+  messageDesc(file_taskguild_v1_agent_manager, 20);
 
 /**
  * @generated from message taskguild.v1.GetInteractionResponseRequest
@@ -669,7 +803,7 @@ export type GetInteractionResponseRequest = Message<"taskguild.v1.GetInteraction
  * Use `create(GetInteractionResponseRequestSchema)` to create a new message.
  */
 export const GetInteractionResponseRequestSchema: GenMessage<GetInteractionResponseRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 20);
+  messageDesc(file_taskguild_v1_agent_manager, 21);
 
 /**
  * @generated from message taskguild.v1.GetInteractionResponseResponse
@@ -686,7 +820,7 @@ export type GetInteractionResponseResponse = Message<"taskguild.v1.GetInteractio
  * Use `create(GetInteractionResponseResponseSchema)` to create a new message.
  */
 export const GetInteractionResponseResponseSchema: GenMessage<GetInteractionResponseResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 21);
+  messageDesc(file_taskguild_v1_agent_manager, 22);
 
 /**
  * @generated from message taskguild.v1.SyncAgentsRequest
@@ -703,7 +837,7 @@ export type SyncAgentsRequest = Message<"taskguild.v1.SyncAgentsRequest"> & {
  * Use `create(SyncAgentsRequestSchema)` to create a new message.
  */
 export const SyncAgentsRequestSchema: GenMessage<SyncAgentsRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 22);
+  messageDesc(file_taskguild_v1_agent_manager, 23);
 
 /**
  * @generated from message taskguild.v1.SyncAgentsResponse
@@ -720,7 +854,7 @@ export type SyncAgentsResponse = Message<"taskguild.v1.SyncAgentsResponse"> & {
  * Use `create(SyncAgentsResponseSchema)` to create a new message.
  */
 export const SyncAgentsResponseSchema: GenMessage<SyncAgentsResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 23);
+  messageDesc(file_taskguild_v1_agent_manager, 24);
 
 /**
  * @generated from message taskguild.v1.SyncPermissionsRequest
@@ -752,7 +886,7 @@ export type SyncPermissionsRequest = Message<"taskguild.v1.SyncPermissionsReques
  * Use `create(SyncPermissionsRequestSchema)` to create a new message.
  */
 export const SyncPermissionsRequestSchema: GenMessage<SyncPermissionsRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 24);
+  messageDesc(file_taskguild_v1_agent_manager, 25);
 
 /**
  * @generated from message taskguild.v1.SyncPermissionsResponse
@@ -769,7 +903,7 @@ export type SyncPermissionsResponse = Message<"taskguild.v1.SyncPermissionsRespo
  * Use `create(SyncPermissionsResponseSchema)` to create a new message.
  */
 export const SyncPermissionsResponseSchema: GenMessage<SyncPermissionsResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 25);
+  messageDesc(file_taskguild_v1_agent_manager, 26);
 
 /**
  * @generated from message taskguild.v1.ReportTaskLogRequest
@@ -799,6 +933,21 @@ export type ReportTaskLogRequest = Message<"taskguild.v1.ReportTaskLogRequest">
    * @generated from field: map<string, string> metadata = 5;
    */
   metadata: { [key: string]: string };
+
+  /**
+   * log_id is an optional client-generated ULID used as the TaskLog ID, so
+   * logs replayed from the agent's outbox are stored at most once.
+   *
+   * @generated from field: string log_id = 6;
+   */
+  logId: string;
+
+  /**
+   * created_at is when the agent produced the log (defaults to receipt time).
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
 };
 
 /**
@@ -806,7 +955,7 @@ export type ReportTaskLogRequest = Message<"taskguild.v1.ReportTaskLogRequest">
  * Use `create(ReportTaskLogRequestSchema)` to create a new message.
  */
 export const ReportTaskLogRequestSchema: GenMessage<ReportTaskLogRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 26);
+  messageDesc(file_taskguild_v1_agent_manager, 27);
 
 /**
  * @generated from message taskguild.v1.ReportTaskLogResponse
@@ -819,7 +968,7 @@ export type ReportTaskLogResponse = Message<"taskguild.v1.ReportTaskLogResponse"
  * Use `create(ReportTaskLogResponseSchema)` to create a new message.
  */
 export const ReportTaskLogResponseSchema: GenMessage<ReportTaskLogResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 27);
+  messageDesc(file_taskguild_v1_agent_manager, 28);
 
 /**
  * @generated from message taskguild.v1.WorktreeInfo
@@ -859,6 +1008,44 @@ export type WorktreeInfo = Message<"taskguild.v1.WorktreeInfo"> & {
    * @generated from field: repeated string changed_files = 5;
    */
   changedFiles: string[];
+
+  /**
+   * disk usage of the worktree directory
+   *
+   * @generated from field: int64 size_bytes = 6;
+   */
+  sizeBytes: bigint;
+
+  /**
+   * newest file modification time in the worktree
+   *
+   * @generated from field: google.protobuf.Timestamp last_modified_at = 7;
+   */
+  lastModifiedAt?: Timestamp;
+
+  /**
+   * branch is merged into the default branch
+   *
+   * @generated from field: bool merged = 8;
+   */
+  merged: boolean;
+
+  /**
+   * The owning task is filled in by the server from task metadata.
+   *
+   * @generated from field: string task_title = 9;
+   */
+  taskTitle: string;
+
+  /**
+   * @generated from field: string task_status = 10;
+   */
+  taskStatus: string;
+
+  /**
+   * @generated from field: bool task_archived = 11;
+   */
+  taskArchived: boolean;
 };
 
 /**
@@ -866,7 +1053,7 @@ export type WorktreeInfo = Message<"taskguild.v1.WorktreeInfo"> & {
  * Use `create(WorktreeInfoSchema)` to create a new message.
  */
 export const WorktreeInfoSchema: GenMessage<WorktreeInfo> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 28);
+  messageDesc(file_taskguild_v1_agent_manager, 29);
 
 /**
  * DeleteWorktreeCommand tells the agent to remove a git worktree and its branch.
@@ -892,6 +1079,13 @@ export type DeleteWorktreeCommand = Message<"taskguild.v1.DeleteWorktreeCommand"
    * @generated from field: bool force = 3;
    */
   force: boolean;
+
+  /**
+   * if true, keep the worktree's branch (used for unmerged work)
+   *
+   * @generated from field: bool keep_branch = 4;
+   */
+  keepBranch: boolean;
 };
 
 /**
@@ -899,7 +1093,7 @@ export type DeleteWorktreeCommand = Message<"taskguild.v1.DeleteWorktreeCommand"
  * Use `create(DeleteWorktreeCommandSchema)` to create a new message.
  */
 export const DeleteWorktreeCommandSchema: GenMessage<DeleteWorktreeCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 29);
+  messageDesc(file_taskguild_v1_agent_manager, 30);
 
 /**
  * @generated from message taskguild.v1.ReportWorktreeListRequest
@@ -926,7 +1120,7 @@ export type ReportWorktreeListRequest = Message<"taskguild.v1.ReportWorktreeList
  * Use `create(ReportWorktreeListRequestSchema)` to create a new message.
  */
 export const ReportWorktreeListRequestSchema: GenMessage<ReportWorktreeListRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 30);
+  messageDesc(file_taskguild_v1_agent_manager, 31);
 
 /**
  * @generated from message taskguild.v1.ReportWorktreeListResponse
@@ -939,7 +1133,7 @@ export type ReportWorktreeListResponse = Message<"taskguild.v1.ReportWorktreeLis
  * Use `create(ReportWorktreeListResponseSchema)` to create a new message.
  */
 export const ReportWorktreeListResponseSchema: GenMessage<ReportWorktreeListResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 31);
+  messageDesc(file_taskguild_v1_agent_manager, 32);
 
 /**
  * @generated from message taskguild.v1.RequestWorktreeListRequest
@@ -956,7 +1150,7 @@ export type RequestWorktreeListRequest = Message<"taskguild.v1.RequestWorktreeLi
  * Use `create(RequestWorktreeListRequestSchema)` to create a new message.
  */
 export const RequestWorktreeListRequestSchema: GenMessage<RequestWorktreeListRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 32);
+  messageDesc(file_taskguild_v1_agent_manager, 33);
 
 /**
  * @generated from message taskguild.v1.RequestWorktreeListResponse
@@ -973,7 +1167,7 @@ export type RequestWorktreeListResponse = Message<"taskguild.v1.RequestWorktreeL
  * Use `create(RequestWorktreeListResponseSchema)` to create a new message.
  */
 export const RequestWorktreeListResponseSchema: GenMessage<RequestWorktreeListResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 33);
+  messageDesc(file_taskguild_v1_agent_manager, 34);
 
 /**
  * @generated from message taskguild.v1.GetWorktreeListRequest
@@ -990,7 +1184,7 @@ export type GetWorktreeListRequest = Message<"taskguild.v1.GetWorktreeListReques
  * Use `create(GetWorktreeListRequestSchema)` to create a new message.
  */
 export const GetWorktreeListRequestSchema: GenMessage<GetWorktreeListRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 34);
+  messageDesc(file_taskguild_v1_agent_manager, 35);
 
 /**
  * @generated from message taskguild.v1.GetWorktreeListResponse
@@ -1000,6 +1194,18 @@ export type GetWorktreeListResponse = Message<"taskguild.v1.GetWorktreeListRespo
    * @generated from field: repeated taskguild.v1.WorktreeInfo worktrees = 1;
    */
   worktrees: WorktreeInfo[];
+
+  /**
+   * @generated from field: int64 total_size_bytes = 2;
+   */
+  totalSizeBytes: bigint;
+
+  /**
+   * disk_quota_bytes is the project's worktree disk quota (0 if unset).
+   *
+   * @generated from field: int64 disk_quota_bytes = 3;
+   */
+  diskQuotaBytes: bigint;
 };
 
 /**
@@ -1007,7 +1213,7 @@ export type GetWorktreeListResponse = Message<"taskguild.v1.GetWorktreeListRespo
  * Use `create(GetWorktreeListResponseSchema)` to create a new message.
  */
 export const GetWorktreeListResponseSchema: GenMessage<GetWorktreeListResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 35);
+  messageDesc(file_taskguild_v1_agent_manager, 36);
 
 /**
  * @generated from message taskguild.v1.RequestWorktreeDeleteRequest
@@ -1034,7 +1240,7 @@ export type RequestWorktreeDeleteRequest = Message<"taskguild.v1.RequestWorktree
  * Use `create(RequestWorktreeDeleteRequestSchema)` to create a new message.
  */
 export const RequestWorktreeDeleteRequestSchema: GenMessage<RequestWorktreeDeleteRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 36);
+  messageDesc(file_taskguild_v1_agent_manager, 37);
 
 /**
  * @generated from message taskguild.v1.RequestWorktreeDeleteResponse
@@ -1051,7 +1257,7 @@ export type RequestWorktreeDeleteResponse = Message<"taskguild.v1.RequestWorktre
  * Use `create(RequestWorktreeDeleteResponseSchema)` to create a new message.
  */
 export const RequestWorktreeDeleteResponseSchema: GenMessage<RequestWorktreeDeleteResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 37);
+  messageDesc(file_taskguild_v1_agent_manager, 38);
 
 /**
  * @generated from message taskguild.v1.ReportWorktreeDeleteResultRequest
@@ -1088,7 +1294,7 @@ export type ReportWorktreeDeleteResultRequest = Message<"taskguild.v1.ReportWork
  * Use `create(ReportWorktreeDeleteResultRequestSchema)` to create a new message.
  */
 export const ReportWorktreeDeleteResultRequestSchema: GenMessage<ReportWorktreeDeleteResultRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 38);
+  messageDesc(file_taskguild_v1_agent_manager, 39);
 
 /**
  * @generated from message taskguild.v1.ReportWorktreeDeleteResultResponse
@@ -1101,7 +1307,7 @@ export type ReportWorktreeDeleteResultResponse = Message<"taskguild.v1.ReportWor
  * Use `create(ReportWorktreeDeleteResultResponseSchema)` to create a new message.
  */
 export const ReportWorktreeDeleteResultResponseSchema: GenMessage<ReportWorktreeDeleteResultResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 39);
+  messageDesc(file_taskguild_v1_agent_manager, 40);
 
 /**
  * GitPullMainCommand tells the agent to run `git pull origin main`
@@ -1121,7 +1327,7 @@ export type GitPullMainCommand = Message<"taskguild.v1.GitPullMainCommand"> & {
  * Use `create(GitPullMainCommandSchema)` to create a new message.
  */
 export const GitPullMainCommandSchema: GenMessage<GitPullMainCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 40);
+  messageDesc(file_taskguild_v1_agent_manager, 41);
 
 /**
  * @generated from message taskguild.v1.RequestGitPullMainRequest
@@ -1138,7 +1344,7 @@ export type RequestGitPullMainRequest = Message<"taskguild.v1.RequestGitPullMain
  * Use `create(RequestGitPullMainRequestSchema)` to create a new message.
  */
 export const RequestGitPullMainRequestSchema: GenMessage<RequestGitPullMainRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 41);
+  messageDesc(file_taskguild_v1_agent_manager, 42);
 
 /**
  * @generated from message taskguild.v1.RequestGitPullMainResponse
@@ -1155,7 +1361,7 @@ export type RequestGitPullMainResponse = Message<"taskguild.v1.RequestGitPullMai
  * Use `create(RequestGitPullMainResponseSchema)` to create a new message.
  */
 export const RequestGitPullMainResponseSchema: GenMessage<RequestGitPullMainResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 42);
+  messageDesc(file_taskguild_v1_agent_manager, 43);
 
 /**
  * @generated from message taskguild.v1.ReportGitPullMainResultRequest
@@ -1196,7 +1402,7 @@ export type ReportGitPullMainResultRequest = Message<"taskguild.v1.ReportGitPull
  * Use `create(ReportGitPullMainResultRequestSchema)` to create a new message.
  */
 export const ReportGitPullMainResultRequestSchema: GenMessage<ReportGitPullMainResultRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 43);
+  messageDesc(file_taskguild_v1_agent_manager, 44);
 
 /**
  * @generated from message taskguild.v1.ReportGitPullMainResultResponse
@@ -1209,7 +1415,7 @@ export type ReportGitPullMainResultResponse = Message<"taskguild.v1.ReportGitPul
  * Use `create(ReportGitPullMainResultResponseSchema)` to create a new message.
  */
 export const ReportGitPullMainResultResponseSchema: GenMessage<ReportGitPullMainResultResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 44);
+  messageDesc(file_taskguild_v1_agent_manager, 45);
 
 /**
  * SyncScriptsCommand tells the agent to re-sync its local .taskguild/scripts/* files.
@@ -1230,7 +1436,7 @@ export type SyncScriptsCommand = Message<"taskguild.v1.SyncScriptsCommand"> & {
  * Use `create(SyncScriptsCommandSchema)` to create a new message.
  */
 export const SyncScriptsCommandSchema: GenMessage<SyncScriptsCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 45);
+  messageDesc(file_taskguild_v1_agent_manager, 46);
 
 /**
  * CompareScriptsCommand tells the agent to compare its local .taskguild/scripts/*
@@ -1257,7 +1463,7 @@ export type CompareScriptsCommand = Message<"taskguild.v1.CompareScriptsCommand"
  * Use `create(CompareScriptsCommandSchema)` to create a new message.
  */
 export const CompareScriptsCommandSchema: GenMessage<CompareScriptsCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 46);
+  messageDesc(file_taskguild_v1_agent_manager, 47);
 
 /**
  * ExecuteScriptCommand tells the agent to execute a specific script.
@@ -1289,6 +1495,14 @@ export type ExecuteScriptCommand = Message<"taskguild.v1.ExecuteScriptCommand">
    * @generated from field: string content = 4;
    */
   content: string;
+
+  /**
+   * secrets are the project's secrets (name -> value), exposed to the script
+   * as environment variables.
+   *
+   * @generated from field: map<string, string> secrets = 5;
+   */
+  secrets: { [key: string]: string };
 };
 
 /**
@@ -1296,7 +1510,7 @@ export type ExecuteScriptCommand = Message<"taskguild.v1.ExecuteScriptCommand">
  * Use `create(ExecuteScriptCommandSchema)` to create a new message.
  */
 export const ExecuteScriptCommandSchema: GenMessage<ExecuteScriptCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 47);
+  messageDesc(file_taskguild_v1_agent_manager, 48);
 
 /**
  * @generated from message taskguild.v1.SyncScriptsRequest
@@ -1313,7 +1527,7 @@ export type SyncScriptsRequest = Message<"taskguild.v1.SyncScriptsRequest"> & {
  * Use `create(SyncScriptsRequestSchema)` to create a new message.
  */
 export const SyncScriptsRequestSchema: GenMessage<SyncScriptsRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 48);
+  messageDesc(file_taskguild_v1_agent_manager, 49);
 
 /**
  * @generated from message taskguild.v1.SyncScriptsResponse
@@ -1330,7 +1544,7 @@ export type SyncScriptsResponse = Message<"taskguild.v1.SyncScriptsResponse"> &
  * Use `create(SyncScriptsResponseSchema)` to create a new message.
  */
 export const SyncScriptsResponseSchema: GenMessage<SyncScriptsResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 49);
+  messageDesc(file_taskguild_v1_agent_manager, 50);
 
 /**
  * @generated from message taskguild.v1.ReportScriptExecutionResultRequest
@@ -1390,7 +1604,7 @@ export type ReportScriptExecutionResultRequest = Message<"taskguild.v1.ReportScr
  * Use `create(ReportScriptExecutionResultRequestSchema)` to create a new message.
  */
 export const ReportScriptExecutionResultRequestSchema: GenMessage<ReportScriptExecutionResultRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 50);
+  messageDesc(file_taskguild_v1_agent_manager, 51);
 
 /**
  * @generated from message taskguild.v1.ReportScriptExecutionResultResponse
@@ -1403,7 +1617,7 @@ export type ReportScriptExecutionResultResponse = Message<"taskguild.v1.ReportSc
  * Use `create(ReportScriptExecutionResultResponseSchema)` to create a new message.
  */
 export const ReportScriptExecutionResultResponseSchema: GenMessage<ReportScriptExecutionResultResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 51);
+  messageDesc(file_taskguild_v1_agent_manager, 52);
 
 /**
  * ReportScriptOutputChunk reports a chunk of real-time script output.
@@ -1434,7 +1648,7 @@ export type ReportScriptOutputChunkRequest = Message<"taskguild.v1.ReportScriptO
  * Use `create(ReportScriptOutputChunkRequestSchema)` to create a new message.
  */
 export const ReportScriptOutputChunkRequestSchema: GenMessage<ReportScriptOutputChunkRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 52);
+  messageDesc(file_taskguild_v1_agent_manager, 53);
 
 /**
  * @generated from message taskguild.v1.ReportScriptOutputChunkResponse
@@ -1447,7 +1661,7 @@ export type ReportScriptOutputChunkResponse = Message<"taskguild.v1.ReportScript
  * Use `create(ReportScriptOutputChunkResponseSchema)` to create a new message.
  */
 export const ReportScriptOutputChunkResponseSchema: GenMessage<ReportScriptOutputChunkResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 53);
+  messageDesc(file_taskguild_v1_agent_manager, 54);
 
 /**
  * StopScriptCommand tells the agent to stop a running script execution.
@@ -1466,7 +1680,7 @@ export type StopScriptCommand = Message<"taskguild.v1.StopScriptCommand"> & {
  * Use `create(StopScriptCommandSchema)` to create a new message.
  */
 export const StopScriptCommandSchema: GenMessage<StopScriptCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 54);
+  messageDesc(file_taskguild_v1_agent_manager, 55);
 
 /**
  * @generated from message taskguild.v1.ScriptDiff
@@ -1510,7 +1724,7 @@ export type ScriptDiff = Message<"taskguild.v1.ScriptDiff"> & {
  * Use `create(ScriptDiffSchema)` to create a new message.
  */
 export const ScriptDiffSchema: GenMessage<ScriptDiff> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 55);
+  messageDesc(file_taskguild_v1_agent_manager, 56);
 
 /**
  * RequestScriptComparison triggers a comparison on connected agents (called by frontend).
@@ -1529,7 +1743,7 @@ export type RequestScriptComparisonRequest = Message<"taskguild.v1.RequestScript
  * Use `create(RequestScriptComparisonRequestSchema)` to create a new message.
  */
 export const RequestScriptComparisonRequestSchema: GenMessage<RequestScriptComparisonRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 56);
+  messageDesc(file_taskguild_v1_agent_manager, 57);
 
 /**
  * @generated from message taskguild.v1.RequestScriptComparisonResponse
@@ -1546,7 +1760,7 @@ export type RequestScriptComparisonResponse = Message<"taskguild.v1.RequestScrip
  * Use `create(RequestScriptComparisonResponseSchema)` to create a new message.
  */
 export const RequestScriptComparisonResponseSchema: GenMessage<RequestScriptComparisonResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 57);
+  messageDesc(file_taskguild_v1_agent_manager, 58);
 
 /**
  * ReportScriptComparison is sent by the agent after comparing local scripts with server versions.
@@ -1575,7 +1789,7 @@ export type ReportScriptComparisonRequest = Message<"taskguild.v1.ReportScriptCo
  * Use `create(ReportScriptComparisonRequestSchema)` to create a new message.
  */
 export const ReportScriptComparisonRequestSchema: GenMessage<ReportScriptComparisonRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 58);
+  messageDesc(file_taskguild_v1_agent_manager, 59);
 
 /**
  * @generated from message taskguild.v1.ReportScriptComparisonResponse
@@ -1588,7 +1802,7 @@ export type ReportScriptComparisonResponse = Message<"taskguild.v1.ReportScriptC
  * Use `create(ReportScriptComparisonResponseSchema)` to create a new message.
  */
 export const ReportScriptComparisonResponseSchema: GenMessage<ReportScriptComparisonResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 59);
+  messageDesc(file_taskguild_v1_agent_manager, 60);
 
 /**
  * GetScriptComparison returns the cached comparison result for a project.
@@ -1607,7 +1821,7 @@ export type GetScriptComparisonRequest = Message<"taskguild.v1.GetScriptComparis
  * Use `create(GetScriptComparisonRequestSchema)` to create a new message.
  */
 export const GetScriptComparisonRequestSchema: GenMessage<GetScriptComparisonRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 60);
+  messageDesc(file_taskguild_v1_agent_manager, 61);
 
 /**
  * @generated from message taskguild.v1.GetScriptComparisonResponse
@@ -1624,7 +1838,7 @@ export type GetScriptComparisonResponse = Message<"taskguild.v1.GetScriptCompari
  * Use `create(GetScriptComparisonResponseSchema)` to create a new message.
  */
 export const GetScriptComparisonResponseSchema: GenMessage<GetScriptComparisonResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 61);
+  messageDesc(file_taskguild_v1_agent_manager, 62);
 
 /**
  * ResolveScriptConflict resolves a single script conflict between server and agent.
@@ -1672,7 +1886,7 @@ export type ResolveScriptConflictRequest = Message<"taskguild.v1.ResolveScriptCo
  * Use `create(ResolveScriptConflictRequestSchema)` to create a new message.
  */
 export const ResolveScriptConflictRequestSchema: GenMessage<ResolveScriptConflictRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 62);
+  messageDesc(file_taskguild_v1_agent_manager, 63);
 
 /**
  * @generated from message taskguild.v1.ResolveScriptConflictResponse
@@ -1691,7 +1905,7 @@ export type ResolveScriptConflictResponse = Message<"taskguild.v1.ResolveScriptC
  * Use `create(ResolveScriptConflictResponseSchema)` to create a new message.
  */
 export const ResolveScriptConflictResponseSchema: GenMessage<ResolveScriptConflictResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 63);
+  messageDesc(file_taskguild_v1_agent_manager, 64);
 
 /**
  * CompareAgentsCommand tells the agent to compare its local .claude/agents/*.md
@@ -1718,7 +1932,7 @@ export type CompareAgentsCommand = Message<"taskguild.v1.CompareAgentsCommand">
  * Use `create(CompareAgentsCommandSchema)` to create a new message.
  */
 export const CompareAgentsCommandSchema: GenMessage<CompareAgentsCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 64);
+  messageDesc(file_taskguild_v1_agent_manager, 65);
 
 /**
  * @generated from message taskguild.v1.AgentDiff
@@ -1762,7 +1976,7 @@ export type AgentDiff = Message<"taskguild.v1.AgentDiff"> & {
  * Use `create(AgentDiffSchema)` to create a new message.
  */
 export const AgentDiffSchema: GenMessage<AgentDiff> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 65);
+  messageDesc(file_taskguild_v1_agent_manager, 66);
 
 /**
  * RequestAgentComparison triggers a comparison on connected agents (called by frontend).
@@ -1781,7 +1995,7 @@ export type RequestAgentComparisonRequest = Message<"taskguild.v1.RequestAgentCo
  * Use `create(RequestAgentComparisonRequestSchema)` to create a new message.
  */
 export const RequestAgentComparisonRequestSchema: GenMessage<RequestAgentComparisonRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 66);
+  messageDesc(file_taskguild_v1_agent_manager, 67);
 
 /**
  * @generated from message taskguild.v1.RequestAgentComparisonResponse
@@ -1798,7 +2012,7 @@ export type RequestAgentComparisonResponse = Message<"taskguild.v1.RequestAgentC
  * Use `create(RequestAgentComparisonResponseSchema)` to create a new message.
  */
 export const RequestAgentComparisonResponseSchema: GenMessage<RequestAgentComparisonResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 67);
+  messageDesc(file_taskguild_v1_agent_manager, 68);
 
 /**
  * ReportAgentComparison is sent by the agent after comparing local agents with server versions.
@@ -1827,7 +2041,7 @@ export type ReportAgentComparisonRequest = Message<"taskguild.v1.ReportAgentComp
  * Use `create(ReportAgentComparisonRequestSchema)` to create a new message.
  */
 export const ReportAgentComparisonRequestSchema: GenMessage<ReportAgentComparisonRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 68);
+  messageDesc(file_taskguild_v1_agent_manager, 69);
 
 /**
  * @generated from message taskguild.v1.ReportAgentComparisonResponse
@@ -1840,7 +2054,7 @@ export type ReportAgentComparisonResponse = Message<"taskguild.v1.ReportAgentCom
  * Use `create(ReportAgentComparisonResponseSchema)` to create a new message.
  */
 export const ReportAgentComparisonResponseSchema: GenMessage<ReportAgentComparisonResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 69);
+  messageDesc(file_taskguild_v1_agent_manager, 70);
 
 /**
  * GetAgentComparison returns the cached comparison result for a project.
@@ -1859,7 +2073,7 @@ export type GetAgentComparisonRequest = Message<"taskguild.v1.GetAgentComparison
  * Use `create(GetAgentComparisonRequestSchema)` to create a new message.
  */
 export const GetAgentComparisonRequestSchema: GenMessage<GetAgentComparisonRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 70);
+  messageDesc(file_taskguild_v1_agent_manager, 71);
 
 /**
  * @generated from message taskguild.v1.GetAgentComparisonResponse
@@ -1876,7 +2090,7 @@ export type GetAgentComparisonResponse = Message<"taskguild.v1.GetAgentCompariso
  * Use `create(GetAgentComparisonResponseSchema)` to create a new message.
  */
 export const GetAgentComparisonResponseSchema: GenMessage<GetAgentComparisonResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 71);
+  messageDesc(file_taskguild_v1_agent_manager, 72);
 
 /**
  * ResolveAgentConflict resolves a single agent conflict between server and agent.
@@ -1924,7 +2138,7 @@ export type ResolveAgentConflictRequest = Message<"taskguild.v1.ResolveAgentConf
  * Use `create(ResolveAgentConflictRequestSchema)` to create a new message.
  */
 export const ResolveAgentConflictRequestSchema: GenMessage<ResolveAgentConflictRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 72);
+  messageDesc(file_taskguild_v1_agent_manager, 73);
 
 /**
  * @generated from message taskguild.v1.ResolveAgentConflictResponse
@@ -1943,7 +2157,7 @@ export type ResolveAgentConflictResponse = Message<"taskguild.v1.ResolveAgentCon
  * Use `create(ResolveAgentConflictResponseSchema)` to create a new message.
  */
 export const ResolveAgentConflictResponseSchema: GenMessage<ResolveAgentConflictResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 73);
+  messageDesc(file_taskguild_v1_agent_manager, 74);
 
 /**
  * SyncSkillsCommand tells the agent to re-sync its local .claude/skills/ files.
@@ -1964,7 +2178,7 @@ export type SyncSkillsCommand = Message<"taskguild.v1.SyncSkillsCommand"> & {
  * Use `create(SyncSkillsCommandSchema)` to create a new message.
  */
 export const SyncSkillsCommandSchema: GenMessage<SyncSkillsCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 74);
+  messageDesc(file_taskguild_v1_agent_manager, 75);
 
 /**
  * CompareSkillsCommand tells the agent to compare its local .claude/skills/
@@ -1991,7 +2205,7 @@ export type CompareSkillsCommand = Message<"taskguild.v1.CompareSkillsCommand">
  * Use `create(CompareSkillsCommandSchema)` to create a new message.
  */
 export const CompareSkillsCommandSchema: GenMessage<CompareSkillsCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 75);
+  messageDesc(file_taskguild_v1_agent_manager, 76);
 
 /**
  * @generated from message taskguild.v1.SyncSkillsRequest
@@ -2008,7 +2222,7 @@ export type SyncSkillsRequest = Message<"taskguild.v1.SyncSkillsRequest"> & {
  * Use `create(SyncSkillsRequestSchema)` to create a new message.
  */
 export const SyncSkillsRequestSchema: GenMessage<SyncSkillsRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 76);
+  messageDesc(file_taskguild_v1_agent_manager, 77);
 
 /**
  * @generated from message taskguild.v1.SyncSkillsResponse
@@ -2025,7 +2239,7 @@ export type SyncSkillsResponse = Message<"taskguild.v1.SyncSkillsResponse"> & {
  * Use `create(SyncSkillsResponseSchema)` to create a new message.
  */
 export const SyncSkillsResponseSchema: GenMessage<SyncSkillsResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 77);
+  messageDesc(file_taskguild_v1_agent_manager, 78);
 
 /**
  * @generated from message taskguild.v1.SkillDiff
@@ -2071,7 +2285,7 @@ export type SkillDiff = Message<"taskguild.v1.SkillDiff"> & {
  * Use `create(SkillDiffSchema)` to create a new message.
  */
 export const SkillDiffSchema: GenMessage<SkillDiff> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 78);
+  messageDesc(file_taskguild_v1_agent_manager, 79);
 
 /**
  * RequestSkillComparison triggers a comparison on connected agents (called by frontend).
@@ -2090,7 +2304,7 @@ export type RequestSkillComparisonRequest = Message<"taskguild.v1.RequestSkillCo
  * Use `create(RequestSkillComparisonRequestSchema)` to create a new message.
  */
 export const RequestSkillComparisonRequestSchema: GenMessage<RequestSkillComparisonRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 79);
+  messageDesc(file_taskguild_v1_agent_manager, 80);
 
 /**
  * @generated from message taskguild.v1.RequestSkillComparisonResponse
@@ -2103,296 +2317,1278 @@ export type RequestSkillComparisonResponse = Message<"taskguild.v1.RequestSkillC
 };
 
 /**
- * Describes the message taskguild.v1.RequestSkillComparisonResponse.
- * Use `create(RequestSkillComparisonResponseSchema)` to create a new message.
+ * Describes the message taskguild.v1.RequestSkillComparisonResponse.
+ * Use `create(RequestSkillComparisonResponseSchema)` to create a new message.
+ */
+export const RequestSkillComparisonResponseSchema: GenMessage<RequestSkillComparisonResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 81);
+
+/**
+ * ReportSkillComparison is sent by the agent after comparing local skills with server versions.
+ *
+ * @generated from message taskguild.v1.ReportSkillComparisonRequest
+ */
+export type ReportSkillComparisonRequest = Message<"taskguild.v1.ReportSkillComparisonRequest"> & {
+  /**
+   * @generated from field: string request_id = 1;
+   */
+  requestId: string;
+
+  /**
+   * @generated from field: string project_name = 2;
+   */
+  projectName: string;
+
+  /**
+   * @generated from field: repeated taskguild.v1.SkillDiff diffs = 3;
+   */
+  diffs: SkillDiff[];
+};
+
+/**
+ * Describes the message taskguild.v1.ReportSkillComparisonRequest.
+ * Use `create(ReportSkillComparisonRequestSchema)` to create a new message.
+ */
+export const ReportSkillComparisonRequestSchema: GenMessage<ReportSkillComparisonRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 82);
+
+/**
+ * @generated from message taskguild.v1.ReportSkillComparisonResponse
+ */
+export type ReportSkillComparisonResponse = Message<"taskguild.v1.ReportSkillComparisonResponse"> & {
+};
+
+/**
+ * Describes the message taskguild.v1.ReportSkillComparisonResponse.
+ * Use `create(ReportSkillComparisonResponseSchema)` to create a new message.
+ */
+export const ReportSkillComparisonResponseSchema: GenMessage<ReportSkillComparisonResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 83);
+
+/**
+ * GetSkillComparison returns the cached comparison result for a project.
+ *
+ * @generated from message taskguild.v1.GetSkillComparisonRequest
+ */
+export type GetSkillComparisonRequest = Message<"taskguild.v1.GetSkillComparisonRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message taskguild.v1.GetSkillComparisonRequest.
+ * Use `create(GetSkillComparisonRequestSchema)` to create a new message.
+ */
+export const GetSkillComparisonRequestSchema: GenMessage<GetSkillComparisonRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 84);
+
+/**
+ * @generated from message taskguild.v1.GetSkillComparisonResponse
+ */
+export type GetSkillComparisonResponse = Message<"taskguild.v1.GetSkillComparisonResponse"> & {
+  /**
+   * @generated from field: repeated taskguild.v1.SkillDiff diffs = 1;
+   */
+  diffs: SkillDiff[];
+};
+
+/**
+ * Describes the message taskguild.v1.GetSkillComparisonResponse.
+ * Use `create(GetSkillComparisonResponseSchema)` to create a new message.
+ */
+export const GetSkillComparisonResponseSchema: GenMessage<GetSkillComparisonResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 85);
+
+/**
+ * ResolveSkillConflict resolves a single skill conflict between server and agent.
+ *
+ * @generated from message taskguild.v1.ResolveSkillConflictRequest
+ */
+export type ResolveSkillConflictRequest = Message<"taskguild.v1.ResolveSkillConflictRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * empty if agent-only (will create new)
+   *
+   * @generated from field: string skill_id = 2;
+   */
+  skillId: string;
+
+  /**
+   * @generated from field: string skill_name = 3;
+   */
+  skillName: string;
+
+  /**
+   * @generated from field: string filename = 4;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: taskguild.v1.SkillResolutionChoice choice = 5;
+   */
+  choice: SkillResolutionChoice;
+
+  /**
+   * only needed when choice = AGENT
+   *
+   * @generated from field: string agent_content = 6;
+   */
+  agentContent: string;
+};
+
+/**
+ * Describes the message taskguild.v1.ResolveSkillConflictRequest.
+ * Use `create(ResolveSkillConflictRequestSchema)` to create a new message.
+ */
+export const ResolveSkillConflictRequestSchema: GenMessage<ResolveSkillConflictRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 86);
+
+/**
+ * @generated from message taskguild.v1.ResolveSkillConflictResponse
+ */
+export type ResolveSkillConflictResponse = Message<"taskguild.v1.ResolveSkillConflictResponse"> & {
+  /**
+   * the resulting skill after resolution
+   *
+   * @generated from field: taskguild.v1.SkillDefinition skill = 1;
+   */
+  skill?: SkillDefinition;
+};
+
+/**
+ * Describes the message taskguild.v1.ResolveSkillConflictResponse.
+ * Use `create(ResolveSkillConflictResponseSchema)` to create a new message.
+ */
+export const ResolveSkillConflictResponseSchema: GenMessage<ResolveSkillConflictResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 87);
+
+/**
+ * @generated from message taskguild.v1.ListSingleCommandPermissionsAgentRequest
+ */
+export type ListSingleCommandPermissionsAgentRequest = Message<"taskguild.v1.ListSingleCommandPermissionsAgentRequest"> & {
+  /**
+   * @generated from field: string project_name = 1;
+   */
+  projectName: string;
+};
+
+/**
+ * Describes the message taskguild.v1.ListSingleCommandPermissionsAgentRequest.
+ * Use `create(ListSingleCommandPermissionsAgentRequestSchema)` to create a new message.
+ */
+export const ListSingleCommandPermissionsAgentRequestSchema: GenMessage<ListSingleCommandPermissionsAgentRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 88);
+
+/**
+ * @generated from message taskguild.v1.ListSingleCommandPermissionsAgentResponse
+ */
+export type ListSingleCommandPermissionsAgentResponse = Message<"taskguild.v1.ListSingleCommandPermissionsAgentResponse"> & {
+  /**
+   * @generated from field: repeated taskguild.v1.SingleCommandPermission permissions = 1;
+   */
+  permissions: SingleCommandPermission[];
+};
+
+/**
+ * Describes the message taskguild.v1.ListSingleCommandPermissionsAgentResponse.
+ * Use `create(ListSingleCommandPermissionsAgentResponseSchema)` to create a new message.
+ */
+export const ListSingleCommandPermissionsAgentResponseSchema: GenMessage<ListSingleCommandPermissionsAgentResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 89);
+
+/**
+ * @generated from message taskguild.v1.AddSingleCommandPermissionRequest
+ */
+export type AddSingleCommandPermissionRequest = Message<"taskguild.v1.AddSingleCommandPermissionRequest"> & {
+  /**
+   * @generated from field: string project_name = 1;
+   */
+  projectName: string;
+
+  /**
+   * @generated from field: string pattern = 2;
+   */
+  pattern: string;
+
+  /**
+   * "command" or "redirect"
+   *
+   * @generated from field: string type = 3;
+   */
+  type: string;
+
+  /**
+   * scope is "project" (default), "task" or "status". See
+   * SingleCommandPermission for the meaning of the scope fields.
+   *
+   * @generated from field: string scope = 5;
+   */
+  scope: string;
+
+  /**
+   * @generated from field: string task_id = 6;
+   */
+  taskId: string;
+
+  /**
+   * @generated from field: string workflow_id = 7;
+   */
+  workflowId: string;
+
+  /**
+   * @generated from field: string status_name = 8;
+   */
+  statusName: string;
+
+  /**
+   * ttl_seconds, when positive, makes the grant expire after that long.
+   *
+   * @generated from field: int64 ttl_seconds = 9;
+   */
+  ttlSeconds: bigint;
+
+  /**
+   * @generated from field: string origin_task_id = 10;
+   */
+  originTaskId: string;
+
+  /**
+   * interaction_id is the permission request the grant was approved from.
+   * The server records its responder as granted_by and its task as
+   * origin_task_id.
+   *
+   * @generated from field: string interaction_id = 12;
+   */
+  interactionId: string;
+};
+
+/**
+ * Describes the message taskguild.v1.AddSingleCommandPermissionRequest.
+ * Use `create(AddSingleCommandPermissionRequestSchema)` to create a new message.
+ */
+export const AddSingleCommandPermissionRequestSchema: GenMessage<AddSingleCommandPermissionRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 90);
+
+/**
+ * @generated from message taskguild.v1.AddSingleCommandPermissionResponse
+ */
+export type AddSingleCommandPermissionResponse = Message<"taskguild.v1.AddSingleCommandPermissionResponse"> & {
+  /**
+   * @generated from field: taskguild.v1.SingleCommandPermission permission = 1;
+   */
+  permission?: SingleCommandPermission;
+};
+
+/**
+ * Describes the message taskguild.v1.AddSingleCommandPermissionResponse.
+ * Use `create(AddSingleCommandPermissionResponseSchema)` to create a new message.
+ */
+export const AddSingleCommandPermissionResponseSchema: GenMessage<AddSingleCommandPermissionResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 91);
+
+/**
+ * SyncClaudeSettingsCommand tells the agent to re-sync its local
+ * .claude/settings.json settings (language, etc.).
+ *
+ * @generated from message taskguild.v1.SyncClaudeSettingsCommand
+ */
+export type SyncClaudeSettingsCommand = Message<"taskguild.v1.SyncClaudeSettingsCommand"> & {
+};
+
+/**
+ * Describes the message taskguild.v1.SyncClaudeSettingsCommand.
+ * Use `create(SyncClaudeSettingsCommandSchema)` to create a new message.
+ */
+export const SyncClaudeSettingsCommandSchema: GenMessage<SyncClaudeSettingsCommand> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 92);
+
+/**
+ * @generated from message taskguild.v1.SyncClaudeSettingsAgentRequest
+ */
+export type SyncClaudeSettingsAgentRequest = Message<"taskguild.v1.SyncClaudeSettingsAgentRequest"> & {
+  /**
+   * @generated from field: string project_name = 1;
+   */
+  projectName: string;
+
+  /**
+   * @generated from field: optional string local_language = 2;
+   */
+  localLanguage?: string;
+
+  /**
+   * @generated from field: taskguild.v1.Attribution local_attribution = 3;
+   */
+  localAttribution?: Attribution;
+};
+
+/**
+ * Describes the message taskguild.v1.SyncClaudeSettingsAgentRequest.
+ * Use `create(SyncClaudeSettingsAgentRequestSchema)` to create a new message.
+ */
+export const SyncClaudeSettingsAgentRequestSchema: GenMessage<SyncClaudeSettingsAgentRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 93);
+
+/**
+ * @generated from message taskguild.v1.SyncClaudeSettingsAgentResponse
+ */
+export type SyncClaudeSettingsAgentResponse = Message<"taskguild.v1.SyncClaudeSettingsAgentResponse"> & {
+  /**
+   * @generated from field: taskguild.v1.ClaudeSettings settings = 1;
+   */
+  settings?: ClaudeSettings;
+};
+
+/**
+ * Describes the message taskguild.v1.SyncClaudeSettingsAgentResponse.
+ * Use `create(SyncClaudeSettingsAgentResponseSchema)` to create a new message.
+ */
+export const SyncClaudeSettingsAgentResponseSchema: GenMessage<SyncClaudeSettingsAgentResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 94);
+
+/**
+ * DrainCommand tells the agent to stop claiming new tasks and let running
+ * tasks finish. resume = true lifts the drain.
+ *
+ * @generated from message taskguild.v1.DrainCommand
+ */
+export type DrainCommand = Message<"taskguild.v1.DrainCommand"> & {
+  /**
+   * @generated from field: bool resume = 1;
+   */
+  resume: boolean;
+};
+
+/**
+ * Describes the message taskguild.v1.DrainCommand.
+ * Use `create(DrainCommandSchema)` to create a new message.
+ */
+export const DrainCommandSchema: GenMessage<DrainCommand> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 95);
+
+/**
+ * @generated from message taskguild.v1.CompactTaskCommand
+ */
+export type CompactTaskCommand = Message<"taskguild.v1.CompactTaskCommand"> & {
+  /**
+   * @generated from field: string task_id = 1;
+   */
+  taskId: string;
+};
+
+/**
+ * Describes the message taskguild.v1.CompactTaskCommand.
+ * Use `create(CompactTaskCommandSchema)` to create a new message.
+ */
+export const CompactTaskCommandSchema: GenMessage<CompactTaskCommand> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 96);
+
+/**
+ * RollbackTaskCommand restores the worktree of a task to a checkpoint taken
+ * after one of its turns and rewinds the Claude session to that turn.
+ *
+ * @generated from message taskguild.v1.RollbackTaskCommand
+ */
+export type RollbackTaskCommand = Message<"taskguild.v1.RollbackTaskCommand"> & {
+  /**
+   * @generated from field: string request_id = 1;
+   */
+  requestId: string;
+
+  /**
+   * @generated from field: string task_id = 2;
+   */
+  taskId: string;
+
+  /**
+   * @generated from field: string checkpoint_id = 3;
+   */
+  checkpointId: string;
+
+  /**
+   * commit is the checkpoint commit (stored under a hidden ref).
+   *
+   * @generated from field: string commit = 4;
+   */
+  commit: string;
+
+  /**
+   * @generated from field: string worktree_name = 5;
+   */
+  worktreeName: string;
+
+  /**
+   * session_id and message_uuid identify the session state at the
+   * checkpoint. Empty if unknown.
+   *
+   * @generated from field: string session_id = 6;
+   */
+  sessionId: string;
+
+  /**
+   * @generated from field: string message_uuid = 7;
+   */
+  messageUuid: string;
+
+  /**
+   * status_name is the workflow status the session belongs to.
+   *
+   * @generated from field: string status_name = 8;
+   */
+  statusName: string;
+
+  /**
+   * @generated from field: bool resume = 9;
+   */
+  resume: boolean;
+};
+
+/**
+ * Describes the message taskguild.v1.RollbackTaskCommand.
+ * Use `create(RollbackTaskCommandSchema)` to create a new message.
+ */
+export const RollbackTaskCommandSchema: GenMessage<RollbackTaskCommand> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 97);
+
+/**
+ * @generated from message taskguild.v1.ReportTaskRollbackResultRequest
+ */
+export type ReportTaskRollbackResultRequest = Message<"taskguild.v1.ReportTaskRollbackResultRequest"> & {
+  /**
+   * @generated from field: string request_id = 1;
+   */
+  requestId: string;
+
+  /**
+   * @generated from field: string task_id = 2;
+   */
+  taskId: string;
+
+  /**
+   * @generated from field: string checkpoint_id = 3;
+   */
+  checkpointId: string;
+
+  /**
+   * @generated from field: bool success = 4;
+   */
+  success: boolean;
+
+  /**
+   * @generated from field: string error_message = 5;
+   */
+  errorMessage: string;
+
+  /**
+   * session_id is the session the task resumes from after the rollback.
+   *
+   * @generated from field: string session_id = 6;
+   */
+  sessionId: string;
+
+  /**
+   * resume echoes RollbackTaskCommand.resume.
+   *
+   * @generated from field: bool resume = 7;
+   */
+  resume: boolean;
+};
+
+/**
+ * Describes the message taskguild.v1.ReportTaskRollbackResultRequest.
+ * Use `create(ReportTaskRollbackResultRequestSchema)` to create a new message.
+ */
+export const ReportTaskRollbackResultRequestSchema: GenMessage<ReportTaskRollbackResultRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 98);
+
+/**
+ * @generated from message taskguild.v1.ReportTaskRollbackResultResponse
+ */
+export type ReportTaskRollbackResultResponse = Message<"taskguild.v1.ReportTaskRollbackResultResponse"> & {
+};
+
+/**
+ * Describes the message taskguild.v1.ReportTaskRollbackResultResponse.
+ * Use `create(ReportTaskRollbackResultResponseSchema)` to create a new message.
+ */
+export const ReportTaskRollbackResultResponseSchema: GenMessage<ReportTaskRollbackResultResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 99);
+
+/**
+ * TaskDiffCommand asks the agent-manager to compute the diff of a task's
+ * worktree against base_branch. Agents without the worktree reply with
+ * not_found.
+ *
+ * @generated from message taskguild.v1.TaskDiffCommand
+ */
+export type TaskDiffCommand = Message<"taskguild.v1.TaskDiffCommand"> & {
+  /**
+   * @generated from field: string request_id = 1;
+   */
+  requestId: string;
+
+  /**
+   * @generated from field: string task_id = 2;
+   */
+  taskId: string;
+
+  /**
+   * @generated from field: string worktree_name = 3;
+   */
+  worktreeName: string;
+
+  /**
+   * base_branch is the project's default branch. Empty means detect it.
+   *
+   * @generated from field: string base_branch = 4;
+   */
+  baseBranch: string;
+
+  /**
+   * max_patch_bytes limits the size of TaskDiff.patch. 0 means no limit.
+   *
+   * @generated from field: int32 max_patch_bytes = 5;
+   */
+  maxPatchBytes: number;
+};
+
+/**
+ * Describes the message taskguild.v1.TaskDiffCommand.
+ * Use `create(TaskDiffCommandSchema)` to create a new message.
+ */
+export const TaskDiffCommandSchema: GenMessage<TaskDiffCommand> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 100);
+
+/**
+ * TaskDiff is the diff of a worktree against the merge base with the base
+ * branch. It includes uncommitted changes in the worktree.
+ *
+ * @generated from message taskguild.v1.TaskDiff
+ */
+export type TaskDiff = Message<"taskguild.v1.TaskDiff"> & {
+  /**
+   * @generated from field: string base_branch = 1;
+   */
+  baseBranch: string;
+
+  /**
+   * merge base the diff is computed against
+   *
+   * @generated from field: string base_commit = 2;
+   */
+  baseCommit: string;
+
+  /**
+   * @generated from field: string head_commit = 3;
+   */
+  headCommit: string;
+
+  /**
+   * worktree branch name
+   *
+   * @generated from field: string branch = 4;
+   */
+  branch: string;
+
+  /**
+   * @generated from field: repeated taskguild.v1.TaskDiffFile files = 5;
+   */
+  files: TaskDiffFile[];
+
+  /**
+   * unified diff
+   *
+   * @generated from field: string patch = 6;
+   */
+  patch: string;
+
+  /**
+   * @generated from field: bool patch_truncated = 7;
+   */
+  patchTruncated: boolean;
+
+  /**
+   * commits since the merge base, newest first
+   *
+   * @generated from field: repeated taskguild.v1.TaskDiffCommit commits = 8;
+   */
+  commits: TaskDiffCommit[];
+
+  /**
+   * @generated from field: int32 additions = 9;
+   */
+  additions: number;
+
+  /**
+   * @generated from field: int32 deletions = 10;
+   */
+  deletions: number;
+
+  /**
+   * @generated from field: bool has_uncommitted_changes = 11;
+   */
+  hasUncommittedChanges: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp captured_at = 12;
+   */
+  capturedAt?: Timestamp;
+};
+
+/**
+ * Describes the message taskguild.v1.TaskDiff.
+ * Use `create(TaskDiffSchema)` to create a new message.
+ */
+export const TaskDiffSchema: GenMessage<TaskDiff> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 101);
+
+/**
+ * @generated from message taskguild.v1.TaskDiffFile
+ */
+export type TaskDiffFile = Message<"taskguild.v1.TaskDiffFile"> & {
+  /**
+   * @generated from field: string path = 1;
+   */
+  path: string;
+
+  /**
+   * git status letter: "A", "M", "D" or "T"
+   *
+   * @generated from field: string status = 2;
+   */
+  status: string;
+
+  /**
+   * @generated from field: int32 additions = 3;
+   */
+  additions: number;
+
+  /**
+   * @generated from field: int32 deletions = 4;
+   */
+  deletions: number;
+
+  /**
+   * @generated from field: bool binary = 5;
+   */
+  binary: boolean;
+};
+
+/**
+ * Describes the message taskguild.v1.TaskDiffFile.
+ * Use `create(TaskDiffFileSchema)` to create a new message.
+ */
+export const TaskDiffFileSchema: GenMessage<TaskDiffFile> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 102);
+
+/**
+ * @generated from message taskguild.v1.TaskDiffCommit
+ */
+export type TaskDiffCommit = Message<"taskguild.v1.TaskDiffCommit"> & {
+  /**
+   * @generated from field: string sha = 1;
+   */
+  sha: string;
+
+  /**
+   * @generated from field: string subject = 2;
+   */
+  subject: string;
+
+  /**
+   * @generated from field: string author = 3;
+   */
+  author: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp committed_at = 4;
+   */
+  committedAt?: Timestamp;
+};
+
+/**
+ * Describes the message taskguild.v1.TaskDiffCommit.
+ * Use `create(TaskDiffCommitSchema)` to create a new message.
+ */
+export const TaskDiffCommitSchema: GenMessage<TaskDiffCommit> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 103);
+
+/**
+ * @generated from message taskguild.v1.GetTaskDiffRequest
+ */
+export type GetTaskDiffRequest = Message<"taskguild.v1.GetTaskDiffRequest"> & {
+  /**
+   * @generated from field: string task_id = 1;
+   */
+  taskId: string;
+};
+
+/**
+ * Describes the message taskguild.v1.GetTaskDiffRequest.
+ * Use `create(GetTaskDiffRequestSchema)` to create a new message.
+ */
+export const GetTaskDiffRequestSchema: GenMessage<GetTaskDiffRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 104);
+
+/**
+ * @generated from message taskguild.v1.GetTaskDiffResponse
+ */
+export type GetTaskDiffResponse = Message<"taskguild.v1.GetTaskDiffResponse"> & {
+  /**
+   * @generated from field: taskguild.v1.TaskDiff diff = 1;
+   */
+  diff?: TaskDiff;
+
+  /**
+   * from_snapshot is true when no agent-manager could compute the diff and
+   * it was taken from the latest diff snapshot in the task logs.
+   *
+   * @generated from field: bool from_snapshot = 2;
+   */
+  fromSnapshot: boolean;
+};
+
+/**
+ * Describes the message taskguild.v1.GetTaskDiffResponse.
+ * Use `create(GetTaskDiffResponseSchema)` to create a new message.
+ */
+export const GetTaskDiffResponseSchema: GenMessage<GetTaskDiffResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 105);
+
+/**
+ * @generated from message taskguild.v1.ReportTaskDiffRequest
+ */
+export type ReportTaskDiffRequest = Message<"taskguild.v1.ReportTaskDiffRequest"> & {
+  /**
+   * @generated from field: string request_id = 1;
+   */
+  requestId: string;
+
+  /**
+   * @generated from field: string task_id = 2;
+   */
+  taskId: string;
+
+  /**
+   * @generated from field: taskguild.v1.TaskDiff diff = 3;
+   */
+  diff?: TaskDiff;
+
+  /**
+   * not_found is true if the worktree does not exist on this agent-manager.
+   *
+   * @generated from field: bool not_found = 4;
+   */
+  notFound: boolean;
+
+  /**
+   * @generated from field: string error_message = 5;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message taskguild.v1.ReportTaskDiffRequest.
+ * Use `create(ReportTaskDiffRequestSchema)` to create a new message.
+ */
+export const ReportTaskDiffRequestSchema: GenMessage<ReportTaskDiffRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 106);
+
+/**
+ * @generated from message taskguild.v1.ReportTaskDiffResponse
+ */
+export type ReportTaskDiffResponse = Message<"taskguild.v1.ReportTaskDiffResponse"> & {
+};
+
+/**
+ * Describes the message taskguild.v1.ReportTaskDiffResponse.
+ * Use `create(ReportTaskDiffResponseSchema)` to create a new message.
+ */
+export const ReportTaskDiffResponseSchema: GenMessage<ReportTaskDiffResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 107);
+
+/**
+ * MergeWorktreeCommand asks the agent-manager holding a task's worktree to
+ * rebase its branch onto base_branch, run the verification commands and
+ * fast-forward base_branch to it. Agents without the worktree reply with
+ * not_found.
+ *
+ * @generated from message taskguild.v1.MergeWorktreeCommand
+ */
+export type MergeWorktreeCommand = Message<"taskguild.v1.MergeWorktreeCommand"> & {
+  /**
+   * @generated from field: string request_id = 1;
+   */
+  requestId: string;
+
+  /**
+   * @generated from field: string task_id = 2;
+   */
+  taskId: string;
+
+  /**
+   * @generated from field: string worktree_name = 3;
+   */
+  worktreeName: string;
+
+  /**
+   * base_branch is the task's worktree base ref, or the project's default
+   * branch when the task has none. Empty means detect it.
+   *
+   * @generated from field: string base_branch = 4;
+   */
+  baseBranch: string;
+
+  /**
+   * @generated from field: repeated string verify_commands = 5;
+   */
+  verifyCommands: string[];
+
+  /**
+   * @generated from field: bool push = 6;
+   */
+  push: boolean;
+};
+
+/**
+ * Describes the message taskguild.v1.MergeWorktreeCommand.
+ * Use `create(MergeWorktreeCommandSchema)` to create a new message.
+ */
+export const MergeWorktreeCommandSchema: GenMessage<MergeWorktreeCommand> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 108);
+
+/**
+ * @generated from message taskguild.v1.ReportMergeResultRequest
+ */
+export type ReportMergeResultRequest = Message<"taskguild.v1.ReportMergeResultRequest"> & {
+  /**
+   * @generated from field: string request_id = 1;
+   */
+  requestId: string;
+
+  /**
+   * @generated from field: string task_id = 2;
+   */
+  taskId: string;
+
+  /**
+   * @generated from field: bool not_found = 3;
+   */
+  notFound: boolean;
+
+  /**
+   * @generated from field: bool success = 4;
+   */
+  success: boolean;
+
+  /**
+   * step is the step that failed: "prepare", "rebase", "verify", "merge" or
+   * "push". A push failure leaves the branch merged locally.
+   *
+   * @generated from field: string step = 5;
+   */
+  step: string;
+
+  /**
+   * @generated from field: string error_message = 6;
+   */
+  errorMessage: string;
+
+  /**
+   * conflict_files lists the conflicting files when the rebase failed.
+   *
+   * @generated from field: repeated string conflict_files = 7;
+   */
+  conflictFiles: string[];
+
+  /**
+   * output is the (truncated) output of the failed step.
+   *
+   * @generated from field: string output = 8;
+   */
+  output: string;
+
+  /**
+   * merged_commit is the new head of the base branch.
+   *
+   * @generated from field: string merged_commit = 9;
+   */
+  mergedCommit: string;
+
+  /**
+   * @generated from field: string base_branch = 10;
+   */
+  baseBranch: string;
+};
+
+/**
+ * Describes the message taskguild.v1.ReportMergeResultRequest.
+ * Use `create(ReportMergeResultRequestSchema)` to create a new message.
+ */
+export const ReportMergeResultRequestSchema: GenMessage<ReportMergeResultRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 109);
+
+/**
+ * @generated from message taskguild.v1.ReportMergeResultResponse
+ */
+export type ReportMergeResultResponse = Message<"taskguild.v1.ReportMergeResultResponse"> & {
+};
+
+/**
+ * Describes the message taskguild.v1.ReportMergeResultResponse.
+ * Use `create(ReportMergeResultResponseSchema)` to create a new message.
+ */
+export const ReportMergeResultResponseSchema: GenMessage<ReportMergeResultResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 110);
+
+/**
+ * ReportModifiedFilesRequest lists the files (relative to the worktree root)
+ * a task has changed compared to its base, including files written by the
+ * Edit / Write tools.
+ *
+ * @generated from message taskguild.v1.ReportModifiedFilesRequest
+ */
+export type ReportModifiedFilesRequest = Message<"taskguild.v1.ReportModifiedFilesRequest"> & {
+  /**
+   * @generated from field: string task_id = 1;
+   */
+  taskId: string;
+
+  /**
+   * @generated from field: string worktree_name = 2;
+   */
+  worktreeName: string;
+
+  /**
+   * @generated from field: repeated string files = 3;
+   */
+  files: string[];
+};
+
+/**
+ * Describes the message taskguild.v1.ReportModifiedFilesRequest.
+ * Use `create(ReportModifiedFilesRequestSchema)` to create a new message.
+ */
+export const ReportModifiedFilesRequestSchema: GenMessage<ReportModifiedFilesRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 111);
+
+/**
+ * @generated from message taskguild.v1.ReportModifiedFilesResponse
+ */
+export type ReportModifiedFilesResponse = Message<"taskguild.v1.ReportModifiedFilesResponse"> & {
+  /**
+   * overlaps lists the other active tasks modifying some of the same files.
+   *
+   * @generated from field: repeated taskguild.v1.FileOverlap overlaps = 1;
+   */
+  overlaps: FileOverlap[];
+};
+
+/**
+ * Describes the message taskguild.v1.ReportModifiedFilesResponse.
+ * Use `create(ReportModifiedFilesResponseSchema)` to create a new message.
  */
-export const RequestSkillComparisonResponseSchema: GenMessage<RequestSkillComparisonResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 80);
+export const ReportModifiedFilesResponseSchema: GenMessage<ReportModifiedFilesResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 112);
 
 /**
- * ReportSkillComparison is sent by the agent after comparing local skills with server versions.
- *
- * @generated from message taskguild.v1.ReportSkillComparisonRequest
+ * @generated from message taskguild.v1.FileOverlap
  */
-export type ReportSkillComparisonRequest = Message<"taskguild.v1.ReportSkillComparisonRequest"> & {
+export type FileOverlap = Message<"taskguild.v1.FileOverlap"> & {
   /**
-   * @generated from field: string request_id = 1;
+   * @generated from field: string task_id = 1;
    */
-  requestId: string;
+  taskId: string;
 
   /**
-   * @generated from field: string project_name = 2;
+   * @generated from field: string task_title = 2;
    */
-  projectName: string;
+  taskTitle: string;
 
   /**
-   * @generated from field: repeated taskguild.v1.SkillDiff diffs = 3;
+   * @generated from field: string worktree_name = 3;
    */
-  diffs: SkillDiff[];
+  worktreeName: string;
+
+  /**
+   * @generated from field: repeated string files = 4;
+   */
+  files: string[];
 };
 
 /**
- * Describes the message taskguild.v1.ReportSkillComparisonRequest.
- * Use `create(ReportSkillComparisonRequestSchema)` to create a new message.
+ * Describes the message taskguild.v1.FileOverlap.
+ * Use `create(FileOverlapSchema)` to create a new message.
  */
-export const ReportSkillComparisonRequestSchema: GenMessage<ReportSkillComparisonRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 81);
+export const FileOverlapSchema: GenMessage<FileOverlap> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 113);
 
 /**
- * @generated from message taskguild.v1.ReportSkillComparisonResponse
+ * @generated from message taskguild.v1.DrainAgentManagerRequest
  */
-export type ReportSkillComparisonResponse = Message<"taskguild.v1.ReportSkillComparisonResponse"> & {
+export type DrainAgentManagerRequest = Message<"taskguild.v1.DrainAgentManagerRequest"> & {
+  /**
+   * @generated from field: string agent_manager_id = 1;
+   */
+  agentManagerId: string;
+
+  /**
+   * @generated from field: bool resume = 2;
+   */
+  resume: boolean;
 };
 
 /**
- * Describes the message taskguild.v1.ReportSkillComparisonResponse.
- * Use `create(ReportSkillComparisonResponseSchema)` to create a new message.
+ * Describes the message taskguild.v1.DrainAgentManagerRequest.
+ * Use `create(DrainAgentManagerRequestSchema)` to create a new message.
  */
-export const ReportSkillComparisonResponseSchema: GenMessage<ReportSkillComparisonResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 82);
+export const DrainAgentManagerRequestSchema: GenMessage<DrainAgentManagerRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 114);
 
 /**
- * GetSkillComparison returns the cached comparison result for a project.
- *
- * @generated from message taskguild.v1.GetSkillComparisonRequest
+ * @generated from message taskguild.v1.DrainAgentManagerResponse
  */
-export type GetSkillComparisonRequest = Message<"taskguild.v1.GetSkillComparisonRequest"> & {
+export type DrainAgentManagerResponse = Message<"taskguild.v1.DrainAgentManagerResponse"> & {
   /**
-   * @generated from field: string project_id = 1;
+   * @generated from field: taskguild.v1.AgentManagerInfo agent_manager = 1;
    */
-  projectId: string;
+  agentManager?: AgentManagerInfo;
 };
 
 /**
- * Describes the message taskguild.v1.GetSkillComparisonRequest.
- * Use `create(GetSkillComparisonRequestSchema)` to create a new message.
+ * Describes the message taskguild.v1.DrainAgentManagerResponse.
+ * Use `create(DrainAgentManagerResponseSchema)` to create a new message.
  */
-export const GetSkillComparisonRequestSchema: GenMessage<GetSkillComparisonRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 83);
+export const DrainAgentManagerResponseSchema: GenMessage<DrainAgentManagerResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 115);
 
 /**
- * @generated from message taskguild.v1.GetSkillComparisonResponse
+ * @generated from message taskguild.v1.ListAgentManagersRequest
  */
-export type GetSkillComparisonResponse = Message<"taskguild.v1.GetSkillComparisonResponse"> & {
+export type ListAgentManagersRequest = Message<"taskguild.v1.ListAgentManagersRequest"> & {
+};
+
+/**
+ * Describes the message taskguild.v1.ListAgentManagersRequest.
+ * Use `create(ListAgentManagersRequestSchema)` to create a new message.
+ */
+export const ListAgentManagersRequestSchema: GenMessage<ListAgentManagersRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 116);
+
+/**
+ * @generated from message taskguild.v1.ListAgentManagersResponse
+ */
+export type ListAgentManagersResponse = Message<"taskguild.v1.ListAgentManagersResponse"> & {
   /**
-   * @generated from field: repeated taskguild.v1.SkillDiff diffs = 1;
+   * @generated from field: repeated taskguild.v1.AgentManagerInfo agent_managers = 1;
    */
-  diffs: SkillDiff[];
+  agentManagers: AgentManagerInfo[];
 };
 
 /**
- * Describes the message taskguild.v1.GetSkillComparisonResponse.
- * Use `create(GetSkillComparisonResponseSchema)` to create a new message.
+ * Describes the message taskguild.v1.ListAgentManagersResponse.
+ * Use `create(ListAgentManagersResponseSchema)` to create a new message.
  */
-export const GetSkillComparisonResponseSchema: GenMessage<GetSkillComparisonResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 84);
+export const ListAgentManagersResponseSchema: GenMessage<ListAgentManagersResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 117);
 
 /**
- * ResolveSkillConflict resolves a single skill conflict between server and agent.
+ * AgentManagerInfo describes a connected agent-manager.
  *
- * @generated from message taskguild.v1.ResolveSkillConflictRequest
+ * @generated from message taskguild.v1.AgentManagerInfo
  */
-export type ResolveSkillConflictRequest = Message<"taskguild.v1.ResolveSkillConflictRequest"> & {
+export type AgentManagerInfo = Message<"taskguild.v1.AgentManagerInfo"> & {
   /**
-   * @generated from field: string project_id = 1;
+   * @generated from field: string agent_manager_id = 1;
    */
-  projectId: string;
+  agentManagerId: string;
 
   /**
-   * empty if agent-only (will create new)
-   *
-   * @generated from field: string skill_id = 2;
+   * @generated from field: int32 max_concurrent_tasks = 2;
    */
-  skillId: string;
+  maxConcurrentTasks: number;
 
   /**
-   * @generated from field: string skill_name = 3;
+   * @generated from field: int32 active_tasks = 3;
    */
-  skillName: string;
+  activeTasks: number;
 
   /**
-   * @generated from field: string filename = 4;
+   * @generated from field: repeated taskguild.v1.ServedProject projects = 4;
    */
-  filename: string;
+  projects: ServedProject[];
 
   /**
-   * @generated from field: taskguild.v1.SkillResolutionChoice choice = 5;
+   * @generated from field: google.protobuf.Timestamp last_heartbeat = 5;
    */
-  choice: SkillResolutionChoice;
+  lastHeartbeat?: Timestamp;
 
   /**
-   * only needed when choice = AGENT
+   * draining is true once a drain was requested and until it is resumed.
    *
-   * @generated from field: string agent_content = 6;
+   * @generated from field: bool draining = 6;
    */
-  agentContent: string;
-};
-
-/**
- * Describes the message taskguild.v1.ResolveSkillConflictRequest.
- * Use `create(ResolveSkillConflictRequestSchema)` to create a new message.
- */
-export const ResolveSkillConflictRequestSchema: GenMessage<ResolveSkillConflictRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 85);
+  draining: boolean;
 
-/**
- * @generated from message taskguild.v1.ResolveSkillConflictResponse
- */
-export type ResolveSkillConflictResponse = Message<"taskguild.v1.ResolveSkillConflictResponse"> & {
   /**
-   * the resulting skill after resolution
+   * idle is true when a draining agent-manager has no running tasks left
+   * and can be stopped safely.
    *
-   * @generated from field: taskguild.v1.SkillDefinition skill = 1;
+   * @generated from field: bool idle = 7;
    */
-  skill?: SkillDefinition;
+  idle: boolean;
 };
 
 /**
- * Describes the message taskguild.v1.ResolveSkillConflictResponse.
- * Use `create(ResolveSkillConflictResponseSchema)` to create a new message.
+ * Describes the message taskguild.v1.AgentManagerInfo.
+ * Use `create(AgentManagerInfoSchema)` to create a new message.
  */
-export const ResolveSkillConflictResponseSchema: GenMessage<ResolveSkillConflictResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 86);
+export const AgentManagerInfoSchema: GenMessage<AgentManagerInfo> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 118);
 
 /**
- * @generated from message taskguild.v1.ListSingleCommandPermissionsAgentRequest
+ * @generated from message taskguild.v1.UploadSessionTranscriptRequest
  */
-export type ListSingleCommandPermissionsAgentRequest = Message<"taskguild.v1.ListSingleCommandPermissionsAgentRequest"> & {
+export type UploadSessionTranscriptRequest = Message<"taskguild.v1.UploadSessionTranscriptRequest"> & {
   /**
-   * @generated from field: string project_name = 1;
+   * @generated from field: string task_id = 1;
    */
-  projectName: string;
+  taskId: string;
+
+  /**
+   * @generated from field: string session_id = 2;
+   */
+  sessionId: string;
+
+  /**
+   * data is the gzip-compressed JSONL transcript.
+   *
+   * @generated from field: bytes data = 3;
+   */
+  data: Uint8Array;
+
+  /**
+   * @generated from field: int64 uncompressed_size = 4;
+   */
+  uncompressedSize: bigint;
 };
 
 /**
- * Describes the message taskguild.v1.ListSingleCommandPermissionsAgentRequest.
- * Use `create(ListSingleCommandPermissionsAgentRequestSchema)` to create a new message.
+ * Describes the message taskguild.v1.UploadSessionTranscriptRequest.
+ * Use `create(UploadSessionTranscriptRequestSchema)` to create a new message.
  */
-export const ListSingleCommandPermissionsAgentRequestSchema: GenMessage<ListSingleCommandPermissionsAgentRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 87);
+export const UploadSessionTranscriptRequestSchema: GenMessage<UploadSessionTranscriptRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 119);
 
 /**
- * @generated from message taskguild.v1.ListSingleCommandPermissionsAgentResponse
+ * @generated from message taskguild.v1.UploadSessionTranscriptResponse
  */
-export type ListSingleCommandPermissionsAgentResponse = Message<"taskguild.v1.ListSingleCommandPermissionsAgentResponse"> & {
-  /**
-   * @generated from field: repeated taskguild.v1.SingleCommandPermission permissions = 1;
-   */
-  permissions: SingleCommandPermission[];
+export type UploadSessionTranscriptResponse = Message<"taskguild.v1.UploadSessionTranscriptResponse"> & {
 };
 
 /**
- * Describes the message taskguild.v1.ListSingleCommandPermissionsAgentResponse.
- * Use `create(ListSingleCommandPermissionsAgentResponseSchema)` to create a new message.
+ * Describes the message taskguild.v1.UploadSessionTranscriptResponse.
+ * Use `create(UploadSessionTranscriptResponseSchema)` to create a new message.
  */
-export const ListSingleCommandPermissionsAgentResponseSchema: GenMessage<ListSingleCommandPermissionsAgentResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 88);
+export const UploadSessionTranscriptResponseSchema: GenMessage<UploadSessionTranscriptResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 120);
 
 /**
- * @generated from message taskguild.v1.AddSingleCommandPermissionRequest
+ * @generated from message taskguild.v1.DownloadSessionTranscriptRequest
  */
-export type AddSingleCommandPermissionRequest = Message<"taskguild.v1.AddSingleCommandPermissionRequest"> & {
+export type DownloadSessionTranscriptRequest = Message<"taskguild.v1.DownloadSessionTranscriptRequest"> & {
   /**
-   * @generated from field: string project_name = 1;
-   */
-  projectName: string;
-
-  /**
-   * @generated from field: string pattern = 2;
+   * @generated from field: string task_id = 1;
    */
-  pattern: string;
+  taskId: string;
 
   /**
-   * "command" or "redirect"
-   *
-   * @generated from field: string type = 3;
+   * @generated from field: string session_id = 2;
    */
-  type: string;
+  sessionId: string;
 };
 
 /**
- * Describes the message taskguild.v1.AddSingleCommandPermissionRequest.
- * Use `create(AddSingleCommandPermissionRequestSchema)` to create a new message.
+ * Describes the message taskguild.v1.DownloadSessionTranscriptRequest.
+ * Use `create(DownloadSessionTranscriptRequestSchema)` to create a new message.
  */
-export const AddSingleCommandPermissionRequestSchema: GenMessage<AddSingleCommandPermissionRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 89);
+export const DownloadSessionTranscriptRequestSchema: GenMessage<DownloadSessionTranscriptRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 121);
 
 /**
- * @generated from message taskguild.v1.AddSingleCommandPermissionResponse
+ * @generated from message taskguild.v1.DownloadSessionTranscriptResponse
  */
-export type AddSingleCommandPermissionResponse = Message<"taskguild.v1.AddSingleCommandPermissionResponse"> & {
+export type DownloadSessionTranscriptResponse = Message<"taskguild.v1.DownloadSessionTranscriptResponse"> & {
   /**
-   * @generated from field: taskguild.v1.SingleCommandPermission permission = 1;
+   * data is the gzip-compressed JSONL transcript.
+   *
+   * @generated from field: bytes data = 1;
    */
-  permission?: SingleCommandPermission;
-};
-
-/**
- * Describes the message taskguild.v1.AddSingleCommandPermissionResponse.
- * Use `create(AddSingleCommandPermissionResponseSchema)` to create a new message.
- */
-export const AddSingleCommandPermissionResponseSchema: GenMessage<AddSingleCommandPermissionResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 90);
+  data: Uint8Array;
 
-/**
- * SyncClaudeSettingsCommand tells the agent to re-sync its local
- * .claude/settings.json settings (language, etc.).
- *
- * @generated from message taskguild.v1.SyncClaudeSettingsCommand
- */
-export type SyncClaudeSettingsCommand = Message<"taskguild.v1.SyncClaudeSettingsCommand"> & {
+  /**
+   * @generated from field: int64 uncompressed_size = 2;
+   */
+  uncompressedSize: bigint;
 };
 
 /**
- * Describes the message taskguild.v1.SyncClaudeSettingsCommand.
- * Use `create(SyncClaudeSettingsCommandSchema)` to create a new message.
+ * Describes the message taskguild.v1.DownloadSessionTranscriptResponse.
+ * Use `create(DownloadSessionTranscriptResponseSchema)` to create a new message.
  */
-export const SyncClaudeSettingsCommandSchema: GenMessage<SyncClaudeSettingsCommand> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 91);
+export const DownloadSessionTranscriptResponseSchema: GenMessage<DownloadSessionTranscriptResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 122);
 
 /**
- * @generated from message taskguild.v1.SyncClaudeSettingsAgentRequest
+ * @generated from message taskguild.v1.GetTaskHandoffRequest
  */
-export type SyncClaudeSettingsAgentRequest = Message<"taskguild.v1.SyncClaudeSettingsAgentRequest"> & {
-  /**
-   * @generated from field: string project_name = 1;
-   */
-  projectName: string;
-
+export type GetTaskHandoffRequest = Message<"taskguild.v1.GetTaskHandoffRequest"> & {
   /**
-   * @generated from field: optional string local_language = 2;
+   * @generated from field: string task_id = 1;
    */
-  localLanguage?: string;
+  taskId: string;
 
   /**
-   * @generated from field: taskguild.v1.Attribution local_attribution = 3;
+   * max_agent_outputs limits the number of most recent agent outputs
+   * returned. Defaults to 5.
+   *
+   * @generated from field: int32 max_agent_outputs = 2;
    */
-  localAttribution?: Attribution;
+  maxAgentOutputs: number;
 };
 
 /**
- * Describes the message taskguild.v1.SyncClaudeSettingsAgentRequest.
- * Use `create(SyncClaudeSettingsAgentRequestSchema)` to create a new message.
+ * Describes the message taskguild.v1.GetTaskHandoffRequest.
+ * Use `create(GetTaskHandoffRequestSchema)` to create a new message.
  */
-export const SyncClaudeSettingsAgentRequestSchema: GenMessage<SyncClaudeSettingsAgentRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 92);
+export const GetTaskHandoffRequestSchema: GenMessage<GetTaskHandoffRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 123);
 
 /**
- * @generated from message taskguild.v1.SyncClaudeSettingsAgentResponse
+ * @generated from message taskguild.v1.GetTaskHandoffResponse
  */
-export type SyncClaudeSettingsAgentResponse = Message<"taskguild.v1.SyncClaudeSettingsAgentResponse"> & {
+export type GetTaskHandoffResponse = Message<"taskguild.v1.GetTaskHandoffResponse"> & {
   /**
-   * @generated from field: taskguild.v1.ClaudeSettings settings = 1;
+   * logs are the RESULT and DIRECTIVE logs of the task plus its most recent
+   * AGENT_OUTPUT logs, in chronological order.
+   *
+   * @generated from field: repeated taskguild.v1.TaskLog logs = 1;
    */
-  settings?: ClaudeSettings;
+  logs: TaskLog[];
 };
 
 /**
- * Describes the message taskguild.v1.SyncClaudeSettingsAgentResponse.
- * Use `create(SyncClaudeSettingsAgentResponseSchema)` to create a new message.
+ * Describes the message taskguild.v1.GetTaskHandoffResponse.
+ * Use `create(GetTaskHandoffResponseSchema)` to create a new message.
  */
-export const SyncClaudeSettingsAgentResponseSchema: GenMessage<SyncClaudeSettingsAgentResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_agent_manager, 93);
+export const GetTaskHandoffResponseSchema: GenMessage<GetTaskHandoffResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_agent_manager, 124);
 
 /**
  * @generated from enum taskguild.v1.AgentStatus
@@ -3000,6 +4196,114 @@ export const AgentManagerService: GenService<{
     input: typeof SyncClaudeSettingsAgentRequestSchema;
     output: typeof SyncClaudeSettingsAgentResponseSchema;
   },
+  /**
+   * DrainAgentManager tells a connected agent-manager to stop claiming new
+   * tasks and let running ones finish (called by frontend / CLI). Setting
+   * resume lifts a previous drain.
+   *
+   * @generated from rpc taskguild.v1.AgentManagerService.DrainAgentManager
+   */
+  drainAgentManager: {
+    methodKind: "unary";
+    input: typeof DrainAgentManagerRequestSchema;
+    output: typeof DrainAgentManagerResponseSchema;
+  },
+  /**
+   * ListAgentManagers returns the connected agent-managers and their state.
+   *
+   * @generated from rpc taskguild.v1.AgentManagerService.ListAgentManagers
+   */
+  listAgentManagers: {
+    methodKind: "unary";
+    input: typeof ListAgentManagersRequestSchema;
+    output: typeof ListAgentManagersResponseSchema;
+  },
+  /**
+   * UploadSessionTranscript stores a Claude session transcript so the task
+   * can resume its session on another agent-manager.
+   *
+   * @generated from rpc taskguild.v1.AgentManagerService.UploadSessionTranscript
+   */
+  uploadSessionTranscript: {
+    methodKind: "unary";
+    input: typeof UploadSessionTranscriptRequestSchema;
+    output: typeof UploadSessionTranscriptResponseSchema;
+  },
+  /**
+   * DownloadSessionTranscript returns a stored Claude session transcript.
+   *
+   * @generated from rpc taskguild.v1.AgentManagerService.DownloadSessionTranscript
+   */
+  downloadSessionTranscript: {
+    methodKind: "unary";
+    input: typeof DownloadSessionTranscriptRequestSchema;
+    output: typeof DownloadSessionTranscriptResponseSchema;
+  },
+  /**
+   * GetTaskHandoff returns the task logs an agent uses to build a handoff
+   * summary when a task continues in a fresh Claude session.
+   *
+   * @generated from rpc taskguild.v1.AgentManagerService.GetTaskHandoff
+   */
+  getTaskHandoff: {
+    methodKind: "unary";
+    input: typeof GetTaskHandoffRequestSchema;
+    output: typeof GetTaskHandoffResponseSchema;
+  },
+  /**
+   * ReportTaskRollbackResult reports the outcome of a checkpoint rollback
+   * from the agent.
+   *
+   * @generated from rpc taskguild.v1.AgentManagerService.ReportTaskRollbackResult
+   */
+  reportTaskRollbackResult: {
+    methodKind: "unary";
+    input: typeof ReportTaskRollbackResultRequestSchema;
+    output: typeof ReportTaskRollbackResultResponseSchema;
+  },
+  /**
+   * GetTaskDiff returns the diff of a task's worktree branch against the
+   * project's default branch, computed by the agent-manager holding the
+   * worktree.
+   *
+   * @generated from rpc taskguild.v1.AgentManagerService.GetTaskDiff
+   */
+  getTaskDiff: {
+    methodKind: "unary";
+    input: typeof GetTaskDiffRequestSchema;
+    output: typeof GetTaskDiffResponseSchema;
+  },
+  /**
+   * ReportTaskDiff reports the diff computed for a TaskDiffCommand.
+   *
+   * @generated from rpc taskguild.v1.AgentManagerService.ReportTaskDiff
+   */
+  reportTaskDiff: {
+    methodKind: "unary";
+    input: typeof ReportTaskDiffRequestSchema;
+    output: typeof ReportTaskDiffResponseSchema;
+  },
+  /**
+   * ReportMergeResult reports the outcome of a MergeWorktreeCommand.
+   *
+   * @generated from rpc taskguild.v1.AgentManagerService.ReportMergeResult
+   */
+  reportMergeResult: {
+    methodKind: "unary";
+    input: typeof ReportMergeResultRequestSchema;
+    output: typeof ReportMergeResultResponseSchema;
+  },
+  /**
+   * ReportModifiedFiles reports the files a worktree task has modified so
+   * the server can warn about overlaps with other active tasks.
+   *
+   * @generated from rpc taskguild.v1.AgentManagerService.ReportModifiedFiles
+   */
+  reportModifiedFiles: {
+    methodKind: "unary";
+    input: typeof ReportModifiedFilesRequestSchema;
+    output: typeof ReportModifiedFilesResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_taskguild_v1_agent_manager, 0);
 
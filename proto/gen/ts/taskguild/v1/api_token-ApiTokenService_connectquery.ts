@@ -0,0 +1,22 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "import_extension=.ts,target=ts"
+// @generated from file taskguild/v1/api_token.proto (package taskguild.v1, syntax proto3)
+/* eslint-disable */
+
+import { ApiTokenService } from "./api_token_pb.ts";
+
+/**
+ * CreateApiToken issues a new token. The secret is only returned here.
+ *
+ * @generated from rpc taskguild.v1.ApiTokenService.CreateApiToken
+ */
+export const createApiToken = ApiTokenService.method.createApiToken;
+
+/**
+ * @generated from rpc taskguild.v1.ApiTokenService.ListApiTokens
+ */
+export const listApiTokens = ApiTokenService.method.listApiTokens;
+
+/**
+ * @generated from rpc taskguild.v1.ApiTokenService.RevokeApiToken
+ */
+export const revokeApiToken = ApiTokenService.method.revokeApiToken;
@@ -0,0 +1,274 @@
+// @generated by protoc-gen-es v2.9.0 with parameter "import_extension=.ts,target=ts"
+// @generated from file taskguild/v1/api_token.proto (package taskguild.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file taskguild/v1/api_token.proto.
+ */
+export const file_taskguild_v1_api_token: GenFile = /*@__PURE__*/
+  fileDesc("Chx0YXNrZ3VpbGQvdjEvYXBpX3Rva2VuLnByb3RvEgx0YXNrZ3VpbGQudjEi9gEKCEFwaVRva2VuEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSKwoGc2NvcGVzGAMgAygOMhsudGFza2d1aWxkLnYxLkFwaVRva2VuU2NvcGUSEwoLcHJvamVjdF9pZHMYBCADKAkSLgoKZXhwaXJlc19hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKcmV2b2tlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAilwEKFUNyZWF0ZUFwaVRva2VuUmVxdWVzdBIMCgRuYW1lGAEgASgJEisKBnNjb3BlcxgCIAMoDjIbLnRhc2tndWlsZC52MS5BcGlUb2tlblNjb3BlEhMKC3Byb2plY3RfaWRzGAMgAygJEi4KCmV4cGlyZXNfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIk8KFkNyZWF0ZUFwaVRva2VuUmVzcG9uc2USJQoFdG9rZW4YASABKAsyFi50YXNrZ3VpbGQudjEuQXBpVG9rZW4SDgoGc2VjcmV0GAIgASgJIi8KFExpc3RBcGlUb2tlbnNSZXF1ZXN0EhcKD2luY2x1ZGVfcmV2b2tlZBgBIAEoCCI/ChVMaXN0QXBpVG9rZW5zUmVzcG9uc2USJgoGdG9rZW5zGAEgAygLMhYudGFza2d1aWxkLnYxLkFwaVRva2VuIiMKFVJldm9rZUFwaVRva2VuUmVxdWVzdBIKCgJpZBgBIAEoCSI/ChZSZXZva2VBcGlUb2tlblJlc3BvbnNlEiUKBXRva2VuGAEgASgLMhYudGFza2d1aWxkLnYxLkFwaVRva2VuKroBCg1BcGlUb2tlblNjb3BlEh8KG0FQSV9UT0tFTl9TQ09QRV9VTlNQRUNJRklFRBAAEh0KGUFQSV9UT0tFTl9TQ09QRV9SRUFEX09OTFkQARIrCidBUElfVE9LRU5fU0NPUEVfUkVTUE9ORF9UT19JTlRFUkFDVElPTlMQAhIhCh1BUElfVE9LRU5fU0NPUEVfQUdFTlRfTUFOQUdFUhADEhkKFUFQSV9UT0tFTl9TQ09QRV9BRE1JThAEMqUCCg9BcGlUb2tlblNlcnZpY2USWwoOQ3JlYXRlQXBpVG9rZW4SIy50YXNrZ3VpbGQudjEuQ3JlYXRlQXBpVG9rZW5SZXF1ZXN0GiQudGFza2d1aWxkLnYxLkNyZWF0ZUFwaVRva2VuUmVzcG9uc2USWAoNTGlzdEFwaVRva2VucxIiLnRhc2tndWlsZC52MS5MaXN0QXBpVG9rZW5zUmVxdWVzdBojLnRhc2tndWlsZC52MS5MaXN0QXBpVG9rZW5zUmVzcG9uc2USWwoOUmV2b2tlQXBpVG9rZW4SIy50YXNrZ3VpbGQudjEuUmV2b2tlQXBpVG9rZW5SZXF1ZXN0GiQudGFza2d1aWxkLnYxLlJldm9rZUFwaVRva2VuUmVzcG9uc2VCtgEKEGNvbS50YXNrZ3VpbGQudjFCDUFwaVRva2VuUHJvdG9QAVpCZ2l0aHViLmNvbS9rYXp6MTg3L3Rhc2tndWlsZC9wcm90by9nZW4vZ28vdGFza2d1aWxkL3YxO3Rhc2tndWlsZHYxogIDVFhYqgIMVGFza2d1aWxkLlYxygIMVGFza2d1aWxkXFYx4gIYVGFza2d1aWxkXFYxXEdQQk1ldGFkYXRh6gINVGFza2d1aWxkOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message taskguild.v1.ApiToken
+ */
+export type ApiToken = Message<"taskguild.v1.ApiToken"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated taskguild.v1.ApiTokenScope scopes = 3;
+   */
+  scopes: ApiTokenScope[];
+
+  /**
+   * project_ids restricts the token to these projects. Empty means all projects.
+   *
+   * @generated from field: repeated string project_ids = 4;
+   */
+  projectIds: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 5;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp revoked_at = 6;
+   */
+  revokedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message taskguild.v1.ApiToken.
+ * Use `create(ApiTokenSchema)` to create a new message.
+ */
+export const ApiTokenSchema: GenMessage<ApiToken> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_api_token, 0);
+
+/**
+ * @generated from message taskguild.v1.CreateApiTokenRequest
+ */
+export type CreateApiTokenRequest = Message<"taskguild.v1.CreateApiTokenRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated taskguild.v1.ApiTokenScope scopes = 2;
+   */
+  scopes: ApiTokenScope[];
+
+  /**
+   * @generated from field: repeated string project_ids = 3;
+   */
+  projectIds: string[];
+
+  /**
+   * expires_at is optional; unset means the token never expires.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 4;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message taskguild.v1.CreateApiTokenRequest.
+ * Use `create(CreateApiTokenRequestSchema)` to create a new message.
+ */
+export const CreateApiTokenRequestSchema: GenMessage<CreateApiTokenRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_api_token, 1);
+
+/**
+ * @generated from message taskguild.v1.CreateApiTokenResponse
+ */
+export type CreateApiTokenResponse = Message<"taskguild.v1.CreateApiTokenResponse"> & {
+  /**
+   * @generated from field: taskguild.v1.ApiToken token = 1;
+   */
+  token?: ApiToken;
+
+  /**
+   * secret is the bearer value for X-Api-Key / Authorization. It is not stored
+   * and cannot be retrieved again.
+   *
+   * @generated from field: string secret = 2;
+   */
+  secret: string;
+};
+
+/**
+ * Describes the message taskguild.v1.CreateApiTokenResponse.
+ * Use `create(CreateApiTokenResponseSchema)` to create a new message.
+ */
+export const CreateApiTokenResponseSchema: GenMessage<CreateApiTokenResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_api_token, 2);
+
+/**
+ * @generated from message taskguild.v1.ListApiTokensRequest
+ */
+export type ListApiTokensRequest = Message<"taskguild.v1.ListApiTokensRequest"> & {
+  /**
+   * include_revoked also returns revoked and expired tokens.
+   *
+   * @generated from field: bool include_revoked = 1;
+   */
+  includeRevoked: boolean;
+};
+
+/**
+ * Describes the message taskguild.v1.ListApiTokensRequest.
+ * Use `create(ListApiTokensRequestSchema)` to create a new message.
+ */
+export const ListApiTokensRequestSchema: GenMessage<ListApiTokensRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_api_token, 3);
+
+/**
+ * @generated from message taskguild.v1.ListApiTokensResponse
+ */
+export type ListApiTokensResponse = Message<"taskguild.v1.ListApiTokensResponse"> & {
+  /**
+   * @generated from field: repeated taskguild.v1.ApiToken tokens = 1;
+   */
+  tokens: ApiToken[];
+};
+
+/**
+ * Describes the message taskguild.v1.ListApiTokensResponse.
+ * Use `create(ListApiTokensResponseSchema)` to create a new message.
+ */
+export const ListApiTokensResponseSchema: GenMessage<ListApiTokensResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_api_token, 4);
+
+/**
+ * @generated from message taskguild.v1.RevokeApiTokenRequest
+ */
+export type RevokeApiTokenRequest = Message<"taskguild.v1.RevokeApiTokenRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message taskguild.v1.RevokeApiTokenRequest.
+ * Use `create(RevokeApiTokenRequestSchema)` to create a new message.
+ */
+export const RevokeApiTokenRequestSchema: GenMessage<RevokeApiTokenRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_api_token, 5);
+
+/**
+ * @generated from message taskguild.v1.RevokeApiTokenResponse
+ */
+export type RevokeApiTokenResponse = Message<"taskguild.v1.RevokeApiTokenResponse"> & {
+  /**
+   * @generated from field: taskguild.v1.ApiToken token = 1;
+   */
+  token?: ApiToken;
+};
+
+/**
+ * Describes the message taskguild.v1.RevokeApiTokenResponse.
+ * Use `create(RevokeApiTokenResponseSchema)` to create a new message.
+ */
+export const RevokeApiTokenResponseSchema: GenMessage<RevokeApiTokenResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_api_token, 6);
+
+/**
+ * @generated from enum taskguild.v1.ApiTokenScope
+ */
+export enum ApiTokenScope {
+  /**
+   * @generated from enum value: API_TOKEN_SCOPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Get*\/List*\/Subscribe* RPCs (except the agent-manager command stream).
+   *
+   * @generated from enum value: API_TOKEN_SCOPE_READ_ONLY = 1;
+   */
+  READ_ONLY = 1,
+
+  /**
+   * read-only plus answering interactions and sending messages.
+   *
+   * @generated from enum value: API_TOKEN_SCOPE_RESPOND_TO_INTERACTIONS = 2;
+   */
+  RESPOND_TO_INTERACTIONS = 2,
+
+  /**
+   * read-only plus AgentManagerService and the task/interaction updates an
+   * agent manager performs.
+   *
+   * @generated from enum value: API_TOKEN_SCOPE_AGENT_MANAGER = 3;
+   */
+  AGENT_MANAGER = 3,
+
+  /**
+   * Every RPC, including token management.
+   *
+   * @generated from enum value: API_TOKEN_SCOPE_ADMIN = 4;
+   */
+  ADMIN = 4,
+}
+
+/**
+ * Describes the enum taskguild.v1.ApiTokenScope.
+ */
+export const ApiTokenScopeSchema: GenEnum<ApiTokenScope> = /*@__PURE__*/
+  enumDesc(file_taskguild_v1_api_token, 0);
+
+/**
+ * ApiTokenService manages named API tokens. Every RPC requires the admin scope.
+ *
+ * @generated from service taskguild.v1.ApiTokenService
+ */
+export const ApiTokenService: GenService<{
+  /**
+   * CreateApiToken issues a new token. The secret is only returned here.
+   *
+   * @generated from rpc taskguild.v1.ApiTokenService.CreateApiToken
+   */
+  createApiToken: {
+    methodKind: "unary";
+    input: typeof CreateApiTokenRequestSchema;
+    output: typeof CreateApiTokenResponseSchema;
+  },
+  /**
+   * @generated from rpc taskguild.v1.ApiTokenService.ListApiTokens
+   */
+  listApiTokens: {
+    methodKind: "unary";
+    input: typeof ListApiTokensRequestSchema;
+    output: typeof ListApiTokensResponseSchema;
+  },
+  /**
+   * @generated from rpc taskguild.v1.ApiTokenService.RevokeApiToken
+   */
+  revokeApiToken: {
+    methodKind: "unary";
+    input: typeof RevokeApiTokenRequestSchema;
+    output: typeof RevokeApiTokenResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_taskguild_v1_api_token, 0);
+
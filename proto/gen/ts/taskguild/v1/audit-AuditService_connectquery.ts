@@ -0,0 +1,10 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "import_extension=.ts,target=ts"
+// @generated from file taskguild/v1/audit.proto (package taskguild.v1, syntax proto3)
+/* eslint-disable */
+
+import { AuditService } from "./audit_pb.ts";
+
+/**
+ * @generated from rpc taskguild.v1.AuditService.ListAuditEvents
+ */
+export const listAuditEvents = AuditService.method.listAuditEvents;
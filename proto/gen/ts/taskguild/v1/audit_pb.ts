@@ -0,0 +1,177 @@
+// @generated by protoc-gen-es v2.9.0 with parameter "import_extension=.ts,target=ts"
+// @generated from file taskguild/v1/audit.proto (package taskguild.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { PaginationRequest, PaginationResponse } from "./common_pb.ts";
+import { file_taskguild_v1_common } from "./common_pb.ts";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file taskguild/v1/audit.proto.
+ */
+export const file_taskguild_v1_audit: GenFile = /*@__PURE__*/
+  fileDesc("Chh0YXNrZ3VpbGQvdjEvYXVkaXQucHJvdG8SDHRhc2tndWlsZC52MSL2AQoKQXVkaXRFdmVudBIKCgJpZBgBIAEoCRIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCgh0b2tlbl9pZBgDIAEoCRINCgVhY3RvchgEIAEoCRIRCglwZWVyX2FkZHIYBSABKAkSEQoJcHJvY2VkdXJlGAYgASgJEhIKCnByb2plY3RfaWQYByABKAkSEwoLcmVzb3VyY2VfaWQYCCABKAkSFwoPcmVxdWVzdF9zdW1tYXJ5GAkgASgJEgwKBGNvZGUYCiABKAkSFQoNZXJyb3JfbWVzc2FnZRgLIAEoCSKJAQoWTGlzdEF1ZGl0RXZlbnRzUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEhMKC3Jlc291cmNlX2lkGAIgASgJEhEKCXByb2NlZHVyZRgDIAEoCRIzCgpwYWdpbmF0aW9uGAQgASgLMh8udGFza2d1aWxkLnYxLlBhZ2luYXRpb25SZXF1ZXN0InkKF0xpc3RBdWRpdEV2ZW50c1Jlc3BvbnNlEigKBmV2ZW50cxgBIAMoCzIYLnRhc2tndWlsZC52MS5BdWRpdEV2ZW50EjQKCnBhZ2luYXRpb24YAiABKAsyIC50YXNrZ3VpbGQudjEuUGFnaW5hdGlvblJlc3BvbnNlMm4KDEF1ZGl0U2VydmljZRJeCg9MaXN0QXVkaXRFdmVudHMSJC50YXNrZ3VpbGQudjEuTGlzdEF1ZGl0RXZlbnRzUmVxdWVzdBolLnRhc2tndWlsZC52MS5MaXN0QXVkaXRFdmVudHNSZXNwb25zZUKzAQoQY29tLnRhc2tndWlsZC52MUIKQXVkaXRQcm90b1ABWkJnaXRodWIuY29tL2thenoxODcvdGFza2d1aWxkL3Byb3RvL2dlbi9nby90YXNrZ3VpbGQvdjE7dGFza2d1aWxkdjGiAgNUWFiqAgxUYXNrZ3VpbGQuVjHKAgxUYXNrZ3VpbGRcVjHiAhhUYXNrZ3VpbGRcVjFcR1BCTWV0YWRhdGHqAg1UYXNrZ3VpbGQ6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_taskguild_v1_common]);
+
+/**
+ * @generated from message taskguild.v1.AuditEvent
+ */
+export type AuditEvent = Message<"taskguild.v1.AuditEvent"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 2;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * token_id is empty for the shared TASKGUILD_API_KEY and for calls
+   * authenticated by an interaction token.
+   *
+   * @generated from field: string token_id = 3;
+   */
+  tokenId: string;
+
+  /**
+   * actor names the caller: the API token name, "TASKGUILD_API_KEY" or
+   * "interaction_token".
+   *
+   * @generated from field: string actor = 4;
+   */
+  actor: string;
+
+  /**
+   * @generated from field: string peer_addr = 5;
+   */
+  peerAddr: string;
+
+  /**
+   * procedure is the full RPC name, e.g. "/taskguild.v1.TaskService/UpdateTaskStatus".
+   *
+   * @generated from field: string procedure = 6;
+   */
+  procedure: string;
+
+  /**
+   * @generated from field: string project_id = 7;
+   */
+  projectId: string;
+
+  /**
+   * resource_id is the primary entity the call targeted (task, interaction,
+   * workflow, ...), when the request names one.
+   *
+   * @generated from field: string resource_id = 8;
+   */
+  resourceId: string;
+
+  /**
+   * request_summary is the request as JSON with secrets and binary payloads
+   * removed, truncated.
+   *
+   * @generated from field: string request_summary = 9;
+   */
+  requestSummary: string;
+
+  /**
+   * code is "ok" or the Connect error code (e.g. "permission_denied").
+   *
+   * @generated from field: string code = 10;
+   */
+  code: string;
+
+  /**
+   * @generated from field: string error_message = 11;
+   */
+  errorMessage: string;
+};
+
+/**
+ * Describes the message taskguild.v1.AuditEvent.
+ * Use `create(AuditEventSchema)` to create a new message.
+ */
+export const AuditEventSchema: GenMessage<AuditEvent> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_audit, 0);
+
+/**
+ * @generated from message taskguild.v1.ListAuditEventsRequest
+ */
+export type ListAuditEventsRequest = Message<"taskguild.v1.ListAuditEventsRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string resource_id = 2;
+   */
+  resourceId: string;
+
+  /**
+   * procedure filters by exact RPC name or by method name suffix
+   * (e.g. "UpdateTaskStatus").
+   *
+   * @generated from field: string procedure = 3;
+   */
+  procedure: string;
+
+  /**
+   * @generated from field: taskguild.v1.PaginationRequest pagination = 4;
+   */
+  pagination?: PaginationRequest;
+};
+
+/**
+ * Describes the message taskguild.v1.ListAuditEventsRequest.
+ * Use `create(ListAuditEventsRequestSchema)` to create a new message.
+ */
+export const ListAuditEventsRequestSchema: GenMessage<ListAuditEventsRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_audit, 1);
+
+/**
+ * @generated from message taskguild.v1.ListAuditEventsResponse
+ */
+export type ListAuditEventsResponse = Message<"taskguild.v1.ListAuditEventsResponse"> & {
+  /**
+   * Newest first.
+   *
+   * @generated from field: repeated taskguild.v1.AuditEvent events = 1;
+   */
+  events: AuditEvent[];
+
+  /**
+   * @generated from field: taskguild.v1.PaginationResponse pagination = 2;
+   */
+  pagination?: PaginationResponse;
+};
+
+/**
+ * Describes the message taskguild.v1.ListAuditEventsResponse.
+ * Use `create(ListAuditEventsResponseSchema)` to create a new message.
+ */
+export const ListAuditEventsResponseSchema: GenMessage<ListAuditEventsResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_audit, 2);
+
+/**
+ * AuditService exposes the append-only record of mutating API calls.
+ *
+ * @generated from service taskguild.v1.AuditService
+ */
+export const AuditService: GenService<{
+  /**
+   * @generated from rpc taskguild.v1.AuditService.ListAuditEvents
+   */
+  listAuditEvents: {
+    methodKind: "unary";
+    input: typeof ListAuditEventsRequestSchema;
+    output: typeof ListAuditEventsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_taskguild_v1_audit, 0);
+
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file taskguild/v1/common.proto.
  */
 export const file_taskguild_v1_common: GenFile = /*@__PURE__*/
-  fileDesc("Chl0YXNrZ3VpbGQvdjEvY29tbW9uLnByb3RvEgx0YXNrZ3VpbGQudjEiMgoRUGFnaW5hdGlvblJlcXVlc3QSDQoFbGltaXQYASABKAUSDgoGb2Zmc2V0GAIgASgFIkIKElBhZ2luYXRpb25SZXNwb25zZRINCgV0b3RhbBgBIAEoBRINCgVsaW1pdBgCIAEoBRIOCgZvZmZzZXQYAyABKAVCtAEKEGNvbS50YXNrZ3VpbGQudjFCC0NvbW1vblByb3RvUAFaQmdpdGh1Yi5jb20va2F6ejE4Ny90YXNrZ3VpbGQvcHJvdG8vZ2VuL2dvL3Rhc2tndWlsZC92MTt0YXNrZ3VpbGR2MaICA1RYWKoCDFRhc2tndWlsZC5WMcoCDFRhc2tndWlsZFxWMeICGFRhc2tndWlsZFxWMVxHUEJNZXRhZGF0YeoCDVRhc2tndWlsZDo6VjFiBnByb3RvMw");
+  fileDesc("Chl0YXNrZ3VpbGQvdjEvY29tbW9uLnByb3RvEgx0YXNrZ3VpbGQudjEiMgoRUGFnaW5hdGlvblJlcXVlc3QSDQoFbGltaXQYASABKAUSDgoGb2Zmc2V0GAIgASgFIkIKElBhZ2luYXRpb25SZXNwb25zZRINCgV0b3RhbBgBIAEoBRINCgVsaW1pdBgCIAEoBRIOCgZvZmZzZXQYAyABKAUiKQoKUGF0aFBvbGljeRINCgVhbGxvdxgBIAMoCRIMCgRkZW55GAIgAygJQrQBChBjb20udGFza2d1aWxkLnYxQgtDb21tb25Qcm90b1ABWkJnaXRodWIuY29tL2thenoxODcvdGFza2d1aWxkL3Byb3RvL2dlbi9nby90YXNrZ3VpbGQvdjE7dGFza2d1aWxkdjGiAgNUWFiqAgxUYXNrZ3VpbGQuVjHKAgxUYXNrZ3VpbGRcVjHiAhhUYXNrZ3VpbGRcVjFcR1BCTWV0YWRhdGHqAg1UYXNrZ3VpbGQ6OlYxYgZwcm90bzM");
 
 /**
  * Pagination request parameters.
@@ -65,3 +65,34 @@ export type PaginationResponse = Message<"taskguild.v1.PaginationResponse"> & {
 export const PaginationResponseSchema: GenMessage<PaginationResponse> = /*@__PURE__*/
   messageDesc(file_taskguild_v1_common, 1);
 
+/**
+ * PathPolicy restricts where edit tools (Write, Edit, NotebookEdit) and shell
+ * redirects may write. Entries are directory roots or globs; relative entries
+ * resolve against the task's working directory and "~/" against the home
+ * directory. An entry matches a path at or below it.
+ *
+ * @generated from message taskguild.v1.PathPolicy
+ */
+export type PathPolicy = Message<"taskguild.v1.PathPolicy"> & {
+  /**
+   * When non-empty, writes outside every allowed root are never auto-allowed.
+   *
+   * @generated from field: repeated string allow = 1;
+   */
+  allow: string[];
+
+  /**
+   * Writes matching a denied root are rejected.
+   *
+   * @generated from field: repeated string deny = 2;
+   */
+  deny: string[];
+};
+
+/**
+ * Describes the message taskguild.v1.PathPolicy.
+ * Use `create(PathPolicySchema)` to create a new message.
+ */
+export const PathPolicySchema: GenMessage<PathPolicy> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_common, 2);
+
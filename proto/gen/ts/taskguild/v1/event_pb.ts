@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file taskguild/v1/event.proto.
  */
 export const file_taskguild_v1_event: GenFile = /*@__PURE__*/
-  fileDesc("Chh0YXNrZ3VpbGQvdjEvZXZlbnQucHJvdG8SDHRhc2tndWlsZC52MSL2AQoFRXZlbnQSCgoCaWQYASABKAkSJQoEdHlwZRgCIAEoDjIXLnRhc2tndWlsZC52MS5FdmVudFR5cGUSEwoLcmVzb3VyY2VfaWQYAyABKAkSDwoHcGF5bG9hZBgEIAEoCRIzCghtZXRhZGF0YRgFIAMoCzIhLnRhc2tndWlsZC52MS5FdmVudC5NZXRhZGF0YUVudHJ5Ei4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wGi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJaChZTdWJzY3JpYmVFdmVudHNSZXF1ZXN0EiwKC2V2ZW50X3R5cGVzGAEgAygOMhcudGFza2d1aWxkLnYxLkV2ZW50VHlwZRISCgpwcm9qZWN0X2lkGAIgASgJKvcECglFdmVudFR5cGUSGgoWRVZFTlRfVFlQRV9VTlNQRUNJRklFRBAAEhsKF0VWRU5UX1RZUEVfVEFTS19DUkVBVEVEEAESGwoXRVZFTlRfVFlQRV9UQVNLX1VQREFURUQQAhIiCh5FVkVOVF9UWVBFX1RBU0tfU1RBVFVTX0NIQU5HRUQQAxIbChdFVkVOVF9UWVBFX1RBU0tfREVMRVRFRBAEEh0KGUVWRU5UX1RZUEVfQUdFTlRfQVNTSUdORUQQBRIjCh9FVkVOVF9UWVBFX0FHRU5UX1NUQVRVU19DSEFOR0VEEAYSIgoeRVZFTlRfVFlQRV9JTlRFUkFDVElPTl9DUkVBVEVEEAcSJAogRVZFTlRfVFlQRV9JTlRFUkFDVElPTl9SRVNQT05ERUQQCBIXChNFVkVOVF9UWVBFX1RBU0tfTE9HEAkSHAoYRVZFTlRfVFlQRV9XT1JLVFJFRV9MSVNUEAoSHwobRVZFTlRfVFlQRV9XT1JLVFJFRV9ERUxFVEVEEAsSIwofRVZFTlRfVFlQRV9HSVRfUFVMTF9NQUlOX1JFU1VMVBAMEiYKIkVWRU5UX1RZUEVfU0NSSVBUX0VYRUNVVElPTl9SRVNVTFQQDRIcChhFVkVOVF9UWVBFX1RBU0tfQVJDSElWRUQQDhIeChpFVkVOVF9UWVBFX1RBU0tfVU5BUkNISVZFRBAPEiAKHEVWRU5UX1RZUEVfU0NSSVBUX0NPTVBBUklTT04QEBIfChtFVkVOVF9UWVBFX0FHRU5UX0NPTVBBUklTT04QERIfChtFVkVOVF9UWVBFX1NLSUxMX0NPTVBBUklTT04QEjJeCgxFdmVudFNlcnZpY2USTgoPU3Vic2NyaWJlRXZlbnRzEiQudGFza2d1aWxkLnYxLlN1YnNjcmliZUV2ZW50c1JlcXVlc3QaEy50YXNrZ3VpbGQudjEuRXZlbnQwAUKzAQoQY29tLnRhc2tndWlsZC52MUIKRXZlbnRQcm90b1ABWkJnaXRodWIuY29tL2thenoxODcvdGFza2d1aWxkL3Byb3RvL2dlbi9nby90YXNrZ3VpbGQvdjE7dGFza2d1aWxkdjGiAgNUWFiqAgxUYXNrZ3VpbGQuVjHKAgxUYXNrZ3VpbGRcVjHiAhhUYXNrZ3VpbGRcVjFcR1BCTWV0YWRhdGHqAg1UYXNrZ3VpbGQ6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("Chh0YXNrZ3VpbGQvdjEvZXZlbnQucHJvdG8SDHRhc2tndWlsZC52MSL2AQoFRXZlbnQSCgoCaWQYASABKAkSJQoEdHlwZRgCIAEoDjIXLnRhc2tndWlsZC52MS5FdmVudFR5cGUSEwoLcmVzb3VyY2VfaWQYAyABKAkSDwoHcGF5bG9hZBgEIAEoCRIzCghtZXRhZGF0YRgFIAMoCzIhLnRhc2tndWlsZC52MS5FdmVudC5NZXRhZGF0YUVudHJ5Ei4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wGi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJaChZTdWJzY3JpYmVFdmVudHNSZXF1ZXN0EiwKC2V2ZW50X3R5cGVzGAEgAygOMhcudGFza2d1aWxkLnYxLkV2ZW50VHlwZRISCgpwcm9qZWN0X2lkGAIgASgJKsIFCglFdmVudFR5cGUSGgoWRVZFTlRfVFlQRV9VTlNQRUNJRklFRBAAEhsKF0VWRU5UX1RZUEVfVEFTS19DUkVBVEVEEAESGwoXRVZFTlRfVFlQRV9UQVNLX1VQREFURUQQAhIiCh5FVkVOVF9UWVBFX1RBU0tfU1RBVFVTX0NIQU5HRUQQAxIbChdFVkVOVF9UWVBFX1RBU0tfREVMRVRFRBAEEh0KGUVWRU5UX1RZUEVfQUdFTlRfQVNTSUdORUQQBRIjCh9FVkVOVF9UWVBFX0FHRU5UX1NUQVRVU19DSEFOR0VEEAYSIgoeRVZFTlRfVFlQRV9JTlRFUkFDVElPTl9DUkVBVEVEEAcSJAogRVZFTlRfVFlQRV9JTlRFUkFDVElPTl9SRVNQT05ERUQQCBIXChNFVkVOVF9UWVBFX1RBU0tfTE9HEAkSHAoYRVZFTlRfVFlQRV9XT1JLVFJFRV9MSVNUEAoSHwobRVZFTlRfVFlQRV9XT1JLVFJFRV9ERUxFVEVEEAsSIwofRVZFTlRfVFlQRV9HSVRfUFVMTF9NQUlOX1JFU1VMVBAMEiYKIkVWRU5UX1RZUEVfU0NSSVBUX0VYRUNVVElPTl9SRVNVTFQQDRIcChhFVkVOVF9UWVBFX1RBU0tfQVJDSElWRUQQDhIeChpFVkVOVF9UWVBFX1RBU0tfVU5BUkNISVZFRBAPEiAKHEVWRU5UX1RZUEVfU0NSSVBUX0NPTVBBUklTT04QEBIfChtFVkVOVF9UWVBFX0FHRU5UX0NPTVBBUklTT04QERIfChtFVkVOVF9UWVBFX1NLSUxMX0NPTVBBUklTT04QEhIkCiBFVkVOVF9UWVBFX0FHRU5UX01BTkFHRVJfQ0hBTkdFRBATEiMKH0VWRU5UX1RZUEVfVEFTS19ST0xMQkFDS19SRVNVTFQQFDJeCgxFdmVudFNlcnZpY2USTgoPU3Vic2NyaWJlRXZlbnRzEiQudGFza2d1aWxkLnYxLlN1YnNjcmliZUV2ZW50c1JlcXVlc3QaEy50YXNrZ3VpbGQudjEuRXZlbnQwAUKzAQoQY29tLnRhc2tndWlsZC52MUIKRXZlbnRQcm90b1ABWkJnaXRodWIuY29tL2thenoxODcvdGFza2d1aWxkL3Byb3RvL2dlbi9nby90YXNrZ3VpbGQvdjE7dGFza2d1aWxkdjGiAgNUWFiqAgxUYXNrZ3VpbGQuVjHKAgxUYXNrZ3VpbGRcVjHiAhhUYXNrZ3VpbGRcVjFcR1BCTWV0YWRhdGHqAg1UYXNrZ3VpbGQ6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message taskguild.v1.Event
@@ -178,6 +178,16 @@ export enum EventType {
    * @generated from enum value: EVENT_TYPE_SKILL_COMPARISON = 18;
    */
   SKILL_COMPARISON = 18,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_AGENT_MANAGER_CHANGED = 19;
+   */
+  AGENT_MANAGER_CHANGED = 19,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_TASK_ROLLBACK_RESULT = 20;
+   */
+  TASK_ROLLBACK_RESULT = 20,
 }
 
 /**
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file taskguild/v1/interaction.proto.
  */
 export const file_taskguild_v1_interaction: GenFile = /*@__PURE__*/
-  fileDesc("Ch50YXNrZ3VpbGQvdjEvaW50ZXJhY3Rpb24ucHJvdG8SDHRhc2tndWlsZC52MSL2AgoLSW50ZXJhY3Rpb24SCgoCaWQYASABKAkSDwoHdGFza19pZBgCIAEoCRIQCghhZ2VudF9pZBgDIAEoCRIrCgR0eXBlGAQgASgOMh0udGFza2d1aWxkLnYxLkludGVyYWN0aW9uVHlwZRIvCgZzdGF0dXMYBSABKA4yHy50YXNrZ3VpbGQudjEuSW50ZXJhY3Rpb25TdGF0dXMSDQoFdGl0bGUYBiABKAkSEwoLZGVzY3JpcHRpb24YByABKAkSMAoHb3B0aW9ucxgIIAMoCzIfLnRhc2tndWlsZC52MS5JbnRlcmFjdGlvbk9wdGlvbhIQCghyZXNwb25zZRgJIAEoCRIuCgpjcmVhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxyZXNwb25kZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCG1ldGFkYXRhGAwgASgJIkYKEUludGVyYWN0aW9uT3B0aW9uEg0KBWxhYmVsGAEgASgJEg0KBXZhbHVlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJIkIKEEludGVyYWN0aW9uRXZlbnQSLgoLaW50ZXJhY3Rpb24YASABKAsyGS50YXNrZ3VpbGQudjEuSW50ZXJhY3Rpb24iqwEKF0xpc3RJbnRlcmFjdGlvbnNSZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkSDwoHdGFza19pZBgCIAEoCRI2Cg1zdGF0dXNfZmlsdGVyGAMgASgOMh8udGFza2d1aWxkLnYxLkludGVyYWN0aW9uU3RhdHVzEjMKCnBhZ2luYXRpb24YBCABKAsyHy50YXNrZ3VpbGQudjEuUGFnaW5hdGlvblJlcXVlc3QijgMKGExpc3RJbnRlcmFjdGlvbnNSZXNwb25zZRIvCgxpbnRlcmFjdGlvbnMYASADKAsyGS50YXNrZ3VpbGQudjEuSW50ZXJhY3Rpb24SNAoKcGFnaW5hdGlvbhgCIAEoCzIgLnRhc2tndWlsZC52MS5QYWdpbmF0aW9uUmVzcG9uc2USSwoLdGFza190aXRsZXMYAyADKAsyNi50YXNrZ3VpbGQudjEuTGlzdEludGVyYWN0aW9uc1Jlc3BvbnNlLlRhc2tUaXRsZXNFbnRyeRJUChB0YXNrX3Byb2plY3RfaWRzGAQgAygLMjoudGFza2d1aWxkLnYxLkxpc3RJbnRlcmFjdGlvbnNSZXNwb25zZS5UYXNrUHJvamVjdElkc0VudHJ5GjEKD1Rhc2tUaXRsZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjUKE1Rhc2tQcm9qZWN0SWRzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASI7ChtSZXNwb25kVG9JbnRlcmFjdGlvblJlcXVlc3QSCgoCaWQYASABKAkSEAoIcmVzcG9uc2UYAiABKAkiTgocUmVzcG9uZFRvSW50ZXJhY3Rpb25SZXNwb25zZRIuCgtpbnRlcmFjdGlvbhgBIAEoCzIZLnRhc2tndWlsZC52MS5JbnRlcmFjdGlvbiI2ChJTZW5kTWVzc2FnZVJlcXVlc3QSDwoHdGFza19pZBgBIAEoCRIPCgdtZXNzYWdlGAIgASgJIkUKE1NlbmRNZXNzYWdlUmVzcG9uc2USLgoLaW50ZXJhY3Rpb24YASABKAsyGS50YXNrZ3VpbGQudjEuSW50ZXJhY3Rpb24iLwocU3Vic2NyaWJlSW50ZXJhY3Rpb25zUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJIkUKIlJlc3BvbmRUb0ludGVyYWN0aW9uQnlUb2tlblJlcXVlc3QSDQoFdG9rZW4YASABKAkSEAoIcmVzcG9uc2UYAiABKAkiVQojUmVzcG9uZFRvSW50ZXJhY3Rpb25CeVRva2VuUmVzcG9uc2USLgoLaW50ZXJhY3Rpb24YASABKAsyGS50YXNrZ3VpbGQudjEuSW50ZXJhY3Rpb24iJgoYRXhwaXJlSW50ZXJhY3Rpb25SZXF1ZXN0EgoKAmlkGAEgASgJIksKGUV4cGlyZUludGVyYWN0aW9uUmVzcG9uc2USLgoLaW50ZXJhY3Rpb24YASABKAsyGS50YXNrZ3VpbGQudjEuSW50ZXJhY3Rpb24qwQEKD0ludGVyYWN0aW9uVHlwZRIgChxJTlRFUkFDVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASJwojSU5URVJBQ1RJT05fVFlQRV9QRVJNSVNTSU9OX1JFUVVFU1QQARIdChlJTlRFUkFDVElPTl9UWVBFX1FVRVNUSU9OEAISIQodSU5URVJBQ1RJT05fVFlQRV9OT1RJRklDQVRJT04QAxIhCh1JTlRFUkFDVElPTl9UWVBFX1VTRVJfTUVTU0FHRRAEKpkBChFJbnRlcmFjdGlvblN0YXR1cxIiCh5JTlRFUkFDVElPTl9TVEFUVVNfVU5TUEVDSUZJRUQQABIeChpJTlRFUkFDVElPTl9TVEFUVVNfUEVORElORxABEiAKHElOVEVSQUNUSU9OX1NUQVRVU19SRVNQT05ERUQQAhIeChpJTlRFUkFDVElPTl9TVEFUVVNfRVhQSVJFRBADMowFChJJbnRlcmFjdGlvblNlcnZpY2USYQoQTGlzdEludGVyYWN0aW9ucxIlLnRhc2tndWlsZC52MS5MaXN0SW50ZXJhY3Rpb25zUmVxdWVzdBomLnRhc2tndWlsZC52MS5MaXN0SW50ZXJhY3Rpb25zUmVzcG9uc2USbQoUUmVzcG9uZFRvSW50ZXJhY3Rpb24SKS50YXNrZ3VpbGQudjEuUmVzcG9uZFRvSW50ZXJhY3Rpb25SZXF1ZXN0GioudGFza2d1aWxkLnYxLlJlc3BvbmRUb0ludGVyYWN0aW9uUmVzcG9uc2USggEKG1Jlc3BvbmRUb0ludGVyYWN0aW9uQnlUb2tlbhIwLnRhc2tndWlsZC52MS5SZXNwb25kVG9JbnRlcmFjdGlvbkJ5VG9rZW5SZXF1ZXN0GjEudGFza2d1aWxkLnYxLlJlc3BvbmRUb0ludGVyYWN0aW9uQnlUb2tlblJlc3BvbnNlEmQKEUV4cGlyZUludGVyYWN0aW9uEiYudGFza2d1aWxkLnYxLkV4cGlyZUludGVyYWN0aW9uUmVxdWVzdBonLnRhc2tndWlsZC52MS5FeHBpcmVJbnRlcmFjdGlvblJlc3BvbnNlElIKC1NlbmRNZXNzYWdlEiAudGFza2d1aWxkLnYxLlNlbmRNZXNzYWdlUmVxdWVzdBohLnRhc2tndWlsZC52MS5TZW5kTWVzc2FnZVJlc3BvbnNlEmUKFVN1YnNjcmliZUludGVyYWN0aW9ucxIqLnRhc2tndWlsZC52MS5TdWJzY3JpYmVJbnRlcmFjdGlvbnNSZXF1ZXN0Gh4udGFza2d1aWxkLnYxLkludGVyYWN0aW9uRXZlbnQwAUK5AQoQY29tLnRhc2tndWlsZC52MUIQSW50ZXJhY3Rpb25Qcm90b1ABWkJnaXRodWIuY29tL2thenoxODcvdGFza2d1aWxkL3Byb3RvL2dlbi9nby90YXNrZ3VpbGQvdjE7dGFza2d1aWxkdjGiAgNUWFiqAgxUYXNrZ3VpbGQuVjHKAgxUYXNrZ3VpbGRcVjHiAhhUYXNrZ3VpbGRcVjFcR1BCTWV0YWRhdGHqAg1UYXNrZ3VpbGQ6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_taskguild_v1_common]);
+  fileDesc("Ch50YXNrZ3VpbGQvdjEvaW50ZXJhY3Rpb24ucHJvdG8SDHRhc2tndWlsZC52MSKMAwoLSW50ZXJhY3Rpb24SCgoCaWQYASABKAkSDwoHdGFza19pZBgCIAEoCRIQCghhZ2VudF9pZBgDIAEoCRIrCgR0eXBlGAQgASgOMh0udGFza2d1aWxkLnYxLkludGVyYWN0aW9uVHlwZRIvCgZzdGF0dXMYBSABKA4yHy50YXNrZ3VpbGQudjEuSW50ZXJhY3Rpb25TdGF0dXMSDQoFdGl0bGUYBiABKAkSEwoLZGVzY3JpcHRpb24YByABKAkSMAoHb3B0aW9ucxgIIAMoCzIfLnRhc2tndWlsZC52MS5JbnRlcmFjdGlvbk9wdGlvbhIQCghyZXNwb25zZRgJIAEoCRIuCgpjcmVhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxyZXNwb25kZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCG1ldGFkYXRhGAwgASgJEhQKDHJlc3BvbmRlZF9ieRgNIAEoCSJGChFJbnRlcmFjdGlvbk9wdGlvbhINCgVsYWJlbBgBIAEoCRINCgV2YWx1ZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCSJCChBJbnRlcmFjdGlvbkV2ZW50Ei4KC2ludGVyYWN0aW9uGAEgASgLMhkudGFza2d1aWxkLnYxLkludGVyYWN0aW9uIqsBChdMaXN0SW50ZXJhY3Rpb25zUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEg8KB3Rhc2tfaWQYAiABKAkSNgoNc3RhdHVzX2ZpbHRlchgDIAEoDjIfLnRhc2tndWlsZC52MS5JbnRlcmFjdGlvblN0YXR1cxIzCgpwYWdpbmF0aW9uGAQgASgLMh8udGFza2d1aWxkLnYxLlBhZ2luYXRpb25SZXF1ZXN0Io4DChhMaXN0SW50ZXJhY3Rpb25zUmVzcG9uc2USLwoMaW50ZXJhY3Rpb25zGAEgAygLMhkudGFza2d1aWxkLnYxLkludGVyYWN0aW9uEjQKCnBhZ2luYXRpb24YAiABKAsyIC50YXNrZ3VpbGQudjEuUGFnaW5hdGlvblJlc3BvbnNlEksKC3Rhc2tfdGl0bGVzGAMgAygLMjYudGFza2d1aWxkLnYxLkxpc3RJbnRlcmFjdGlvbnNSZXNwb25zZS5UYXNrVGl0bGVzRW50cnkSVAoQdGFza19wcm9qZWN0X2lkcxgEIAMoCzI6LnRhc2tndWlsZC52MS5MaXN0SW50ZXJhY3Rpb25zUmVzcG9uc2UuVGFza1Byb2plY3RJZHNFbnRyeRoxCg9UYXNrVGl0bGVzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARo1ChNUYXNrUHJvamVjdElkc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiOwobUmVzcG9uZFRvSW50ZXJhY3Rpb25SZXF1ZXN0EgoKAmlkGAEgASgJEhAKCHJlc3BvbnNlGAIgASgJIk4KHFJlc3BvbmRUb0ludGVyYWN0aW9uUmVzcG9uc2USLgoLaW50ZXJhY3Rpb24YASABKAsyGS50YXNrZ3VpbGQudjEuSW50ZXJhY3Rpb24iNgoSU2VuZE1lc3NhZ2VSZXF1ZXN0Eg8KB3Rhc2tfaWQYASABKAkSDwoHbWVzc2FnZRgCIAEoCSJFChNTZW5kTWVzc2FnZVJlc3BvbnNlEi4KC2ludGVyYWN0aW9uGAEgASgLMhkudGFza2d1aWxkLnYxLkludGVyYWN0aW9uIi8KHFN1YnNjcmliZUludGVyYWN0aW9uc1JlcXVlc3QSDwoHdGFza19pZBgBIAEoCSJFCiJSZXNwb25kVG9JbnRlcmFjdGlvbkJ5VG9rZW5SZXF1ZXN0Eg0KBXRva2VuGAEgASgJEhAKCHJlc3BvbnNlGAIgASgJIlUKI1Jlc3BvbmRUb0ludGVyYWN0aW9uQnlUb2tlblJlc3BvbnNlEi4KC2ludGVyYWN0aW9uGAEgASgLMhkudGFza2d1aWxkLnYxLkludGVyYWN0aW9uIiYKGEV4cGlyZUludGVyYWN0aW9uUmVxdWVzdBIKCgJpZBgBIAEoCSJLChlFeHBpcmVJbnRlcmFjdGlvblJlc3BvbnNlEi4KC2ludGVyYWN0aW9uGAEgASgLMhkudGFza2d1aWxkLnYxLkludGVyYWN0aW9uKsEBCg9JbnRlcmFjdGlvblR5cGUSIAocSU5URVJBQ1RJT05fVFlQRV9VTlNQRUNJRklFRBAAEicKI0lOVEVSQUNUSU9OX1RZUEVfUEVSTUlTU0lPTl9SRVFVRVNUEAESHQoZSU5URVJBQ1RJT05fVFlQRV9RVUVTVElPThACEiEKHUlOVEVSQUNUSU9OX1RZUEVfTk9USUZJQ0FUSU9OEAMSIQodSU5URVJBQ1RJT05fVFlQRV9VU0VSX01FU1NBR0UQBCqZAQoRSW50ZXJhY3Rpb25TdGF0dXMSIgoeSU5URVJBQ1RJT05fU1RBVFVTX1VOU1BFQ0lGSUVEEAASHgoaSU5URVJBQ1RJT05fU1RBVFVTX1BFTkRJTkcQARIgChxJTlRFUkFDVElPTl9TVEFUVVNfUkVTUE9OREVEEAISHgoaSU5URVJBQ1RJT05fU1RBVFVTX0VYUElSRUQQAzKMBQoSSW50ZXJhY3Rpb25TZXJ2aWNlEmEKEExpc3RJbnRlcmFjdGlvbnMSJS50YXNrZ3VpbGQudjEuTGlzdEludGVyYWN0aW9uc1JlcXVlc3QaJi50YXNrZ3VpbGQudjEuTGlzdEludGVyYWN0aW9uc1Jlc3BvbnNlEm0KFFJlc3BvbmRUb0ludGVyYWN0aW9uEikudGFza2d1aWxkLnYxLlJlc3BvbmRUb0ludGVyYWN0aW9uUmVxdWVzdBoqLnRhc2tndWlsZC52MS5SZXNwb25kVG9JbnRlcmFjdGlvblJlc3BvbnNlEoIBChtSZXNwb25kVG9JbnRlcmFjdGlvbkJ5VG9rZW4SMC50YXNrZ3VpbGQudjEuUmVzcG9uZFRvSW50ZXJhY3Rpb25CeVRva2VuUmVxdWVzdBoxLnRhc2tndWlsZC52MS5SZXNwb25kVG9JbnRlcmFjdGlvbkJ5VG9rZW5SZXNwb25zZRJkChFFeHBpcmVJbnRlcmFjdGlvbhImLnRhc2tndWlsZC52MS5FeHBpcmVJbnRlcmFjdGlvblJlcXVlc3QaJy50YXNrZ3VpbGQudjEuRXhwaXJlSW50ZXJhY3Rpb25SZXNwb25zZRJSCgtTZW5kTWVzc2FnZRIgLnRhc2tndWlsZC52MS5TZW5kTWVzc2FnZVJlcXVlc3QaIS50YXNrZ3VpbGQudjEuU2VuZE1lc3NhZ2VSZXNwb25zZRJlChVTdWJzY3JpYmVJbnRlcmFjdGlvbnMSKi50YXNrZ3VpbGQudjEuU3Vic2NyaWJlSW50ZXJhY3Rpb25zUmVxdWVzdBoeLnRhc2tndWlsZC52MS5JbnRlcmFjdGlvbkV2ZW50MAFCuQEKEGNvbS50YXNrZ3VpbGQudjFCEEludGVyYWN0aW9uUHJvdG9QAVpCZ2l0aHViLmNvbS9rYXp6MTg3L3Rhc2tndWlsZC9wcm90by9nZW4vZ28vdGFza2d1aWxkL3YxO3Rhc2tndWlsZHYxogIDVFhYqgIMVGFza2d1aWxkLlYxygIMVGFza2d1aWxkXFYx4gIYVGFza2d1aWxkXFYxXEdQQk1ldGFkYXRh6gINVGFza2d1aWxkOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_taskguild_v1_common]);
 
 /**
  * @generated from message taskguild.v1.Interaction
@@ -90,6 +90,14 @@ export type Interaction = Message<"taskguild.v1.Interaction"> & {
    * @generated from field: string metadata = 12;
    */
   metadata: string;
+
+  /**
+   * responded_by names who responded: the API token name, or
+   * "response_token" for one-time links from notifications.
+   *
+   * @generated from field: string responded_by = 13;
+   */
+  respondedBy: string;
 };
 
 /**
@@ -26,3 +26,11 @@ export const updatePermissions = PermissionService.method.updatePermissions;
  * @generated from rpc taskguild.v1.PermissionService.SyncPermissionsFromDir
  */
 export const syncPermissionsFromDir = PermissionService.method.syncPermissionsFromDir;
+
+/**
+ * EvaluatePermission simulates the agent's permission check for a tool
+ * call and reports the decision and the rule that produced it.
+ *
+ * @generated from rpc taskguild.v1.PermissionService.EvaluatePermission
+ */
+export const evaluatePermission = PermissionService.method.evaluatePermission;
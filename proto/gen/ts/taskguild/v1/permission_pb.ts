@@ -6,13 +6,15 @@ import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegen
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { PathPolicy } from "./common_pb.ts";
+import { file_taskguild_v1_common } from "./common_pb.ts";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file taskguild/v1/permission.proto.
  */
 export const file_taskguild_v1_permission: GenFile = /*@__PURE__*/
-  fileDesc("Ch10YXNrZ3VpbGQvdjEvcGVybWlzc2lvbi5wcm90bxIMdGFza2d1aWxkLnYxIn0KDVBlcm1pc3Npb25TZXQSEgoKcHJvamVjdF9pZBgBIAEoCRINCgVhbGxvdxgCIAMoCRILCgNhc2sYAyADKAkSDAoEZGVueRgEIAMoCRIuCgp1cGRhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIrChVHZXRQZXJtaXNzaW9uc1JlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCSJKChZHZXRQZXJtaXNzaW9uc1Jlc3BvbnNlEjAKC3Blcm1pc3Npb25zGAEgASgLMhsudGFza2d1aWxkLnYxLlBlcm1pc3Npb25TZXQiWAoYVXBkYXRlUGVybWlzc2lvbnNSZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkSDQoFYWxsb3cYAiADKAkSCwoDYXNrGAMgAygJEgwKBGRlbnkYBCADKAkiTQoZVXBkYXRlUGVybWlzc2lvbnNSZXNwb25zZRIwCgtwZXJtaXNzaW9ucxgBIAEoCzIbLnRhc2tndWlsZC52MS5QZXJtaXNzaW9uU2V0IkYKHVN5bmNQZXJtaXNzaW9uc0Zyb21EaXJSZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkSEQoJZGlyZWN0b3J5GAIgASgJIlIKHlN5bmNQZXJtaXNzaW9uc0Zyb21EaXJSZXNwb25zZRIwCgtwZXJtaXNzaW9ucxgBIAEoCzIbLnRhc2tndWlsZC52MS5QZXJtaXNzaW9uU2V0MssCChFQZXJtaXNzaW9uU2VydmljZRJbCg5HZXRQZXJtaXNzaW9ucxIjLnRhc2tndWlsZC52MS5HZXRQZXJtaXNzaW9uc1JlcXVlc3QaJC50YXNrZ3VpbGQudjEuR2V0UGVybWlzc2lvbnNSZXNwb25zZRJkChFVcGRhdGVQZXJtaXNzaW9ucxImLnRhc2tndWlsZC52MS5VcGRhdGVQZXJtaXNzaW9uc1JlcXVlc3QaJy50YXNrZ3VpbGQudjEuVXBkYXRlUGVybWlzc2lvbnNSZXNwb25zZRJzChZTeW5jUGVybWlzc2lvbnNGcm9tRGlyEisudGFza2d1aWxkLnYxLlN5bmNQZXJtaXNzaW9uc0Zyb21EaXJSZXF1ZXN0GiwudGFza2d1aWxkLnYxLlN5bmNQZXJtaXNzaW9uc0Zyb21EaXJSZXNwb25zZUK4AQoQY29tLnRhc2tndWlsZC52MUIPUGVybWlzc2lvblByb3RvUAFaQmdpdGh1Yi5jb20va2F6ejE4Ny90YXNrZ3VpbGQvcHJvdG8vZ2VuL2dvL3Rhc2tndWlsZC92MTt0YXNrZ3VpbGR2MaICA1RYWKoCDFRhc2tndWlsZC5WMcoCDFRhc2tndWlsZFxWMeICGFRhc2tndWlsZFxWMVxHUEJNZXRhZGF0YeoCDVRhc2tndWlsZDo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+  fileDesc("Ch10YXNrZ3VpbGQvdjEvcGVybWlzc2lvbi5wcm90bxIMdGFza2d1aWxkLnYxIpUCCg1QZXJtaXNzaW9uU2V0EhIKCnByb2plY3RfaWQYASABKAkSDQoFYWxsb3cYAiADKAkSCwoDYXNrGAMgAygJEgwKBGRlbnkYBCADKAkSLgoKdXBkYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASGAoQYXV0b19hbGxvd19yaXNrcxgGIAMoCRItCgtwYXRoX3BvbGljeRgHIAEoCzIYLnRhc2tndWlsZC52MS5QYXRoUG9saWN5EhoKEnJlZGFjdGlvbl9wYXR0ZXJucxgIIAMoCRIxCg1lZ3Jlc3NfcG9saWN5GAkgASgLMhoudGFza2d1aWxkLnYxLkVncmVzc1BvbGljeSIrCgxFZ3Jlc3NQb2xpY3kSDQoFYWxsb3cYASADKAkSDAoEZGVueRgCIAMoCSIrChVHZXRQZXJtaXNzaW9uc1JlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCSJKChZHZXRQZXJtaXNzaW9uc1Jlc3BvbnNlEjAKC3Blcm1pc3Npb25zGAEgASgLMhsudGFza2d1aWxkLnYxLlBlcm1pc3Npb25TZXQi8AEKGFVwZGF0ZVBlcm1pc3Npb25zUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEg0KBWFsbG93GAIgAygJEgsKA2FzaxgDIAMoCRIMCgRkZW55GAQgAygJEhgKEGF1dG9fYWxsb3dfcmlza3MYBSADKAkSLQoLcGF0aF9wb2xpY3kYBiABKAsyGC50YXNrZ3VpbGQudjEuUGF0aFBvbGljeRIaChJyZWRhY3Rpb25fcGF0dGVybnMYByADKAkSMQoNZWdyZXNzX3BvbGljeRgIIAEoCzIaLnRhc2tndWlsZC52MS5FZ3Jlc3NQb2xpY3kiTQoZVXBkYXRlUGVybWlzc2lvbnNSZXNwb25zZRIwCgtwZXJtaXNzaW9ucxgBIAEoCzIbLnRhc2tndWlsZC52MS5QZXJtaXNzaW9uU2V0IkYKHVN5bmNQZXJtaXNzaW9uc0Zyb21EaXJSZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkSEQoJZGlyZWN0b3J5GAIgASgJIlIKHlN5bmNQZXJtaXNzaW9uc0Zyb21EaXJSZXNwb25zZRIwCgtwZXJtaXNzaW9ucxgBIAEoCzIbLnRhc2tndWlsZC52MS5QZXJtaXNzaW9uU2V0Is0BChlFdmFsdWF0ZVBlcm1pc3Npb25SZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkSEwoLd29ya2Zsb3dfaWQYAiABKAkSEwoLc3RhdHVzX25hbWUYAyABKAkSEQoJdG9vbF9uYW1lGAQgASgJEhIKCnRvb2xfaW5wdXQYBSABKAkSDwoHY29tbWFuZBgGIAEoCRIXCg9wZXJtaXNzaW9uX21vZGUYByABKAkSDwoHdGFza19pZBgIIAEoCRIQCgh3b3JrX2RpchgJIAEoCSKzAQoRQ29tbWFuZEV2YWx1YXRpb24SDwoHY29tbWFuZBgBIAEoCRIPCgdtYXRjaGVkGAIgASgIEhcKD21hdGNoZWRfcGF0dGVybhgDIAEoCRIZChFzdWdnZXN0ZWRfcGF0dGVybhgEIAEoCRIOCgZvcGFxdWUYBSABKAgSFQoNb3BhcXVlX3JlYXNvbhgGIAEoCRIMCgRyaXNrGAcgASgJEhMKC3Jpc2tfcmVhc29uGAggASgJInkKElJlZGlyZWN0RXZhbHVhdGlvbhIQCghvcGVyYXRvchgBIAEoCRIMCgRwYXRoGAIgASgJEg8KB21hdGNoZWQYAyABKAgSFwoPbWF0Y2hlZF9wYXR0ZXJuGAQgASgJEhkKEXN1Z2dlc3RlZF9wYXR0ZXJuGAUgASgJIpQCChpFdmFsdWF0ZVBlcm1pc3Npb25SZXNwb25zZRIQCghkZWNpc2lvbhgBIAEoCRIOCgZzb3VyY2UYAiABKAkSDAoEcnVsZRgDIAEoCRIOCgZyZWFzb24YBCABKAkSFwoPcGVybWlzc2lvbl9tb2RlGAUgASgJEjEKCGNvbW1hbmRzGAYgAygLMh8udGFza2d1aWxkLnYxLkNvbW1hbmRFdmFsdWF0aW9uEjMKCXJlZGlyZWN0cxgHIAMoCzIgLnRhc2tndWlsZC52MS5SZWRpcmVjdEV2YWx1YXRpb24SDAoEcmlzaxgIIAEoCRISCgpyaXNrX2xhYmVsGAkgASgJEhMKC3Jpc2tfcmVhc29uGAogASgJMrQDChFQZXJtaXNzaW9uU2VydmljZRJbCg5HZXRQZXJtaXNzaW9ucxIjLnRhc2tndWlsZC52MS5HZXRQZXJtaXNzaW9uc1JlcXVlc3QaJC50YXNrZ3VpbGQudjEuR2V0UGVybWlzc2lvbnNSZXNwb25zZRJkChFVcGRhdGVQZXJtaXNzaW9ucxImLnRhc2tndWlsZC52MS5VcGRhdGVQZXJtaXNzaW9uc1JlcXVlc3QaJy50YXNrZ3VpbGQudjEuVXBkYXRlUGVybWlzc2lvbnNSZXNwb25zZRJzChZTeW5jUGVybWlzc2lvbnNGcm9tRGlyEisudGFza2d1aWxkLnYxLlN5bmNQZXJtaXNzaW9uc0Zyb21EaXJSZXF1ZXN0GiwudGFza2d1aWxkLnYxLlN5bmNQZXJtaXNzaW9uc0Zyb21EaXJSZXNwb25zZRJnChJFdmFsdWF0ZVBlcm1pc3Npb24SJy50YXNrZ3VpbGQudjEuRXZhbHVhdGVQZXJtaXNzaW9uUmVxdWVzdBooLnRhc2tndWlsZC52MS5FdmFsdWF0ZVBlcm1pc3Npb25SZXNwb25zZUK4AQoQY29tLnRhc2tndWlsZC52MUIPUGVybWlzc2lvblByb3RvUAFaQmdpdGh1Yi5jb20va2F6ejE4Ny90YXNrZ3VpbGQvcHJvdG8vZ2VuL2dvL3Rhc2tndWlsZC92MTt0YXNrZ3VpbGR2MaICA1RYWKoCDFRhc2tndWlsZC5WMcoCDFRhc2tndWlsZFxWMeICGFRhc2tndWlsZFxWMVxHUEJNZXRhZGF0YeoCDVRhc2tndWlsZDo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_taskguild_v1_common]);
 
 /**
  * PermissionSet represents a project-scoped set of permission rules.
@@ -44,6 +46,37 @@ export type PermissionSet = Message<"taskguild.v1.PermissionSet"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 5;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * Risk categories of Bash commands that are allowed without confirmation
+   * (e.g. "read_only", "worktree_write"). Only low-risk categories are accepted.
+   *
+   * @generated from field: repeated string auto_allow_risks = 6;
+   */
+  autoAllowRisks: string[];
+
+  /**
+   * Project-wide path policy for edit tools and shell redirects.
+   *
+   * @generated from field: taskguild.v1.PathPolicy path_policy = 7;
+   */
+  pathPolicy?: PathPolicy;
+
+  /**
+   * Regular expressions whose matches are masked in task logs, interactions
+   * and turn logs, in addition to the built-in credential detectors. When a
+   * pattern has a capture group, only the first group is masked.
+   *
+   * @generated from field: repeated string redaction_patterns = 8;
+   */
+  redactionPatterns: string[];
+
+  /**
+   * Project-wide egress policy for WebFetch and shell network tools.
+   *
+   * @generated from field: taskguild.v1.EgressPolicy egress_policy = 9;
+   */
+  egressPolicy?: EgressPolicy;
 };
 
 /**
@@ -53,6 +86,37 @@ export type PermissionSet = Message<"taskguild.v1.PermissionSet"> & {
 export const PermissionSetSchema: GenMessage<PermissionSet> = /*@__PURE__*/
   messageDesc(file_taskguild_v1_permission, 0);
 
+/**
+ * EgressPolicy restricts the hosts WebFetch and shell network tools (curl,
+ * wget, git remotes, package registries) may contact. An entry matches the
+ * domain and its subdomains; "*" wildcards match the whole host.
+ *
+ * @generated from message taskguild.v1.EgressPolicy
+ */
+export type EgressPolicy = Message<"taskguild.v1.EgressPolicy"> & {
+  /**
+   * When non-empty, requests to other hosts always need confirmation and
+   * show the exact URL.
+   *
+   * @generated from field: repeated string allow = 1;
+   */
+  allow: string[];
+
+  /**
+   * Requests to denied hosts are rejected.
+   *
+   * @generated from field: repeated string deny = 2;
+   */
+  deny: string[];
+};
+
+/**
+ * Describes the message taskguild.v1.EgressPolicy.
+ * Use `create(EgressPolicySchema)` to create a new message.
+ */
+export const EgressPolicySchema: GenMessage<EgressPolicy> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_permission, 1);
+
 /**
  * @generated from message taskguild.v1.GetPermissionsRequest
  */
@@ -68,7 +132,7 @@ export type GetPermissionsRequest = Message<"taskguild.v1.GetPermissionsRequest"
  * Use `create(GetPermissionsRequestSchema)` to create a new message.
  */
 export const GetPermissionsRequestSchema: GenMessage<GetPermissionsRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_permission, 1);
+  messageDesc(file_taskguild_v1_permission, 2);
 
 /**
  * @generated from message taskguild.v1.GetPermissionsResponse
@@ -85,7 +149,7 @@ export type GetPermissionsResponse = Message<"taskguild.v1.GetPermissionsRespons
  * Use `create(GetPermissionsResponseSchema)` to create a new message.
  */
 export const GetPermissionsResponseSchema: GenMessage<GetPermissionsResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_permission, 2);
+  messageDesc(file_taskguild_v1_permission, 3);
 
 /**
  * @generated from message taskguild.v1.UpdatePermissionsRequest
@@ -110,6 +174,26 @@ export type UpdatePermissionsRequest = Message<"taskguild.v1.UpdatePermissionsRe
    * @generated from field: repeated string deny = 4;
    */
   deny: string[];
+
+  /**
+   * @generated from field: repeated string auto_allow_risks = 5;
+   */
+  autoAllowRisks: string[];
+
+  /**
+   * @generated from field: taskguild.v1.PathPolicy path_policy = 6;
+   */
+  pathPolicy?: PathPolicy;
+
+  /**
+   * @generated from field: repeated string redaction_patterns = 7;
+   */
+  redactionPatterns: string[];
+
+  /**
+   * @generated from field: taskguild.v1.EgressPolicy egress_policy = 8;
+   */
+  egressPolicy?: EgressPolicy;
 };
 
 /**
@@ -117,7 +201,7 @@ export type UpdatePermissionsRequest = Message<"taskguild.v1.UpdatePermissionsRe
  * Use `create(UpdatePermissionsRequestSchema)` to create a new message.
  */
 export const UpdatePermissionsRequestSchema: GenMessage<UpdatePermissionsRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_permission, 3);
+  messageDesc(file_taskguild_v1_permission, 4);
 
 /**
  * @generated from message taskguild.v1.UpdatePermissionsResponse
@@ -134,7 +218,7 @@ export type UpdatePermissionsResponse = Message<"taskguild.v1.UpdatePermissionsR
  * Use `create(UpdatePermissionsResponseSchema)` to create a new message.
  */
 export const UpdatePermissionsResponseSchema: GenMessage<UpdatePermissionsResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_permission, 4);
+  messageDesc(file_taskguild_v1_permission, 5);
 
 /**
  * @generated from message taskguild.v1.SyncPermissionsFromDirRequest
@@ -158,7 +242,7 @@ export type SyncPermissionsFromDirRequest = Message<"taskguild.v1.SyncPermission
  * Use `create(SyncPermissionsFromDirRequestSchema)` to create a new message.
  */
 export const SyncPermissionsFromDirRequestSchema: GenMessage<SyncPermissionsFromDirRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_permission, 5);
+  messageDesc(file_taskguild_v1_permission, 6);
 
 /**
  * @generated from message taskguild.v1.SyncPermissionsFromDirResponse
@@ -177,7 +261,246 @@ export type SyncPermissionsFromDirResponse = Message<"taskguild.v1.SyncPermissio
  * Use `create(SyncPermissionsFromDirResponseSchema)` to create a new message.
  */
 export const SyncPermissionsFromDirResponseSchema: GenMessage<SyncPermissionsFromDirResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_permission, 6);
+  messageDesc(file_taskguild_v1_permission, 7);
+
+/**
+ * @generated from message taskguild.v1.EvaluatePermissionRequest
+ */
+export type EvaluatePermissionRequest = Message<"taskguild.v1.EvaluatePermissionRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * workflow_id and status_name select the workflow status whose permission
+   * mode, path policy, skills and status-scoped grants apply. Optional.
+   *
+   * @generated from field: string workflow_id = 2;
+   */
+  workflowId: string;
+
+  /**
+   * @generated from field: string status_name = 3;
+   */
+  statusName: string;
+
+  /**
+   * e.g. "Bash", "Write", "Read"
+   *
+   * @generated from field: string tool_name = 4;
+   */
+  toolName: string;
+
+  /**
+   * tool input as a JSON object
+   *
+   * @generated from field: string tool_input = 5;
+   */
+  toolInput: string;
+
+  /**
+   * command is a shorthand for Bash: tool_input {"command": command}.
+   *
+   * @generated from field: string command = 6;
+   */
+  command: string;
+
+  /**
+   * permission_mode overrides the mode resolved from the workflow status.
+   *
+   * @generated from field: string permission_mode = 7;
+   */
+  permissionMode: string;
+
+  /**
+   * task_id selects task-scoped grants. Optional.
+   *
+   * @generated from field: string task_id = 8;
+   */
+  taskId: string;
+
+  /**
+   * work_dir resolves relative write targets. Defaults to the working
+   * directory of the project's connected agent.
+   *
+   * @generated from field: string work_dir = 9;
+   */
+  workDir: string;
+};
+
+/**
+ * Describes the message taskguild.v1.EvaluatePermissionRequest.
+ * Use `create(EvaluatePermissionRequestSchema)` to create a new message.
+ */
+export const EvaluatePermissionRequestSchema: GenMessage<EvaluatePermissionRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_permission, 8);
+
+/**
+ * CommandEvaluation is the single-command check of one parsed command.
+ *
+ * @generated from message taskguild.v1.CommandEvaluation
+ */
+export type CommandEvaluation = Message<"taskguild.v1.CommandEvaluation"> & {
+  /**
+   * @generated from field: string command = 1;
+   */
+  command: string;
+
+  /**
+   * @generated from field: bool matched = 2;
+   */
+  matched: boolean;
+
+  /**
+   * @generated from field: string matched_pattern = 3;
+   */
+  matchedPattern: string;
+
+  /**
+   * @generated from field: string suggested_pattern = 4;
+   */
+  suggestedPattern: string;
+
+  /**
+   * @generated from field: bool opaque = 5;
+   */
+  opaque: boolean;
+
+  /**
+   * @generated from field: string opaque_reason = 6;
+   */
+  opaqueReason: string;
+
+  /**
+   * @generated from field: string risk = 7;
+   */
+  risk: string;
+
+  /**
+   * @generated from field: string risk_reason = 8;
+   */
+  riskReason: string;
+};
+
+/**
+ * Describes the message taskguild.v1.CommandEvaluation.
+ * Use `create(CommandEvaluationSchema)` to create a new message.
+ */
+export const CommandEvaluationSchema: GenMessage<CommandEvaluation> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_permission, 9);
+
+/**
+ * RedirectEvaluation is the single-command check of one redirect.
+ *
+ * @generated from message taskguild.v1.RedirectEvaluation
+ */
+export type RedirectEvaluation = Message<"taskguild.v1.RedirectEvaluation"> & {
+  /**
+   * @generated from field: string operator = 1;
+   */
+  operator: string;
+
+  /**
+   * @generated from field: string path = 2;
+   */
+  path: string;
+
+  /**
+   * @generated from field: bool matched = 3;
+   */
+  matched: boolean;
+
+  /**
+   * @generated from field: string matched_pattern = 4;
+   */
+  matchedPattern: string;
+
+  /**
+   * @generated from field: string suggested_pattern = 5;
+   */
+  suggestedPattern: string;
+};
+
+/**
+ * Describes the message taskguild.v1.RedirectEvaluation.
+ * Use `create(RedirectEvaluationSchema)` to create a new message.
+ */
+export const RedirectEvaluationSchema: GenMessage<RedirectEvaluation> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_permission, 10);
+
+/**
+ * @generated from message taskguild.v1.EvaluatePermissionResponse
+ */
+export type EvaluatePermissionResponse = Message<"taskguild.v1.EvaluatePermissionResponse"> & {
+  /**
+   * "allow", "ask" or "deny"
+   *
+   * @generated from field: string decision = 1;
+   */
+  decision: string;
+
+  /**
+   * source names the check that decided, e.g. "permission_deny_rule",
+   * "single_command_rules", "read_only_tool" or "permission_mode".
+   *
+   * @generated from field: string source = 2;
+   */
+  source: string;
+
+  /**
+   * matched rule or pattern, if any
+   *
+   * @generated from field: string rule = 3;
+   */
+  rule: string;
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
+
+  /**
+   * the mode the call was evaluated in
+   *
+   * @generated from field: string permission_mode = 5;
+   */
+  permissionMode: string;
+
+  /**
+   * @generated from field: repeated taskguild.v1.CommandEvaluation commands = 6;
+   */
+  commands: CommandEvaluation[];
+
+  /**
+   * @generated from field: repeated taskguild.v1.RedirectEvaluation redirects = 7;
+   */
+  redirects: RedirectEvaluation[];
+
+  /**
+   * highest risk category of a Bash call
+   *
+   * @generated from field: string risk = 8;
+   */
+  risk: string;
+
+  /**
+   * @generated from field: string risk_label = 9;
+   */
+  riskLabel: string;
+
+  /**
+   * @generated from field: string risk_reason = 10;
+   */
+  riskReason: string;
+};
+
+/**
+ * Describes the message taskguild.v1.EvaluatePermissionResponse.
+ * Use `create(EvaluatePermissionResponseSchema)` to create a new message.
+ */
+export const EvaluatePermissionResponseSchema: GenMessage<EvaluatePermissionResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_permission, 11);
 
 /**
  * PermissionService manages project-scoped permission rules (allow/ask/deny)
@@ -218,6 +541,17 @@ export const PermissionService: GenService<{
     input: typeof SyncPermissionsFromDirRequestSchema;
     output: typeof SyncPermissionsFromDirResponseSchema;
   },
+  /**
+   * EvaluatePermission simulates the agent's permission check for a tool
+   * call and reports the decision and the rule that produced it.
+   *
+   * @generated from rpc taskguild.v1.PermissionService.EvaluatePermission
+   */
+  evaluatePermission: {
+    methodKind: "unary";
+    input: typeof EvaluatePermissionRequestSchema;
+    output: typeof EvaluatePermissionResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_taskguild_v1_permission, 0);
 
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file taskguild/v1/project.proto.
  */
 export const file_taskguild_v1_project: GenFile = /*@__PURE__*/
-  fileDesc("Chp0YXNrZ3VpbGQvdjEvcHJvamVjdC5wcm90bxIMdGFza2d1aWxkLnYxIvQBCgdQcm9qZWN0EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSFgoOcmVwb3NpdG9yeV91cmwYBCABKAkSFgoOZGVmYXVsdF9icmFuY2gYBSABKAkSLgoKY3JlYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFb3JkZXIYCCABKAUSGwoTaGlkZGVuX2Zyb21fc2lkZWJhchgJIAEoCCJpChRDcmVhdGVQcm9qZWN0UmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEhYKDnJlcG9zaXRvcnlfdXJsGAMgASgJEhYKDmRlZmF1bHRfYnJhbmNoGAQgASgJIj8KFUNyZWF0ZVByb2plY3RSZXNwb25zZRImCgdwcm9qZWN0GAEgASgLMhUudGFza2d1aWxkLnYxLlByb2plY3QiHwoRR2V0UHJvamVjdFJlcXVlc3QSCgoCaWQYASABKAkiPAoSR2V0UHJvamVjdFJlc3BvbnNlEiYKB3Byb2plY3QYASABKAsyFS50YXNrZ3VpbGQudjEuUHJvamVjdCJKChNMaXN0UHJvamVjdHNSZXF1ZXN0EjMKCnBhZ2luYXRpb24YASABKAsyHy50YXNrZ3VpbGQudjEuUGFnaW5hdGlvblJlcXVlc3QidQoUTGlzdFByb2plY3RzUmVzcG9uc2USJwoIcHJvamVjdHMYASADKAsyFS50YXNrZ3VpbGQudjEuUHJvamVjdBI0CgpwYWdpbmF0aW9uGAIgASgLMiAudGFza2d1aWxkLnYxLlBhZ2luYXRpb25SZXNwb25zZSKvAQoUVXBkYXRlUHJvamVjdFJlcXVlc3QSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIWCg5yZXBvc2l0b3J5X3VybBgEIAEoCRIWCg5kZWZhdWx0X2JyYW5jaBgFIAEoCRIgChNoaWRkZW5fZnJvbV9zaWRlYmFyGAYgASgISACIAQFCFgoUX2hpZGRlbl9mcm9tX3NpZGViYXIiPwoVVXBkYXRlUHJvamVjdFJlc3BvbnNlEiYKB3Byb2plY3QYASABKAsyFS50YXNrZ3VpbGQudjEuUHJvamVjdCIiChREZWxldGVQcm9qZWN0UmVxdWVzdBIKCgJpZBgBIAEoCSIXChVEZWxldGVQcm9qZWN0UmVzcG9uc2UiLQoWUmVvcmRlclByb2plY3RzUmVxdWVzdBITCgtwcm9qZWN0X2lkcxgBIAMoCSJCChdSZW9yZGVyUHJvamVjdHNSZXNwb25zZRInCghwcm9qZWN0cxgBIAMoCzIVLnRhc2tndWlsZC52MS5Qcm9qZWN0MqYECg5Qcm9qZWN0U2VydmljZRJYCg1DcmVhdGVQcm9qZWN0EiIudGFza2d1aWxkLnYxLkNyZWF0ZVByb2plY3RSZXF1ZXN0GiMudGFza2d1aWxkLnYxLkNyZWF0ZVByb2plY3RSZXNwb25zZRJPCgpHZXRQcm9qZWN0Eh8udGFza2d1aWxkLnYxLkdldFByb2plY3RSZXF1ZXN0GiAudGFza2d1aWxkLnYxLkdldFByb2plY3RSZXNwb25zZRJVCgxMaXN0UHJvamVjdHMSIS50YXNrZ3VpbGQudjEuTGlzdFByb2plY3RzUmVxdWVzdBoiLnRhc2tndWlsZC52MS5MaXN0UHJvamVjdHNSZXNwb25zZRJYCg1VcGRhdGVQcm9qZWN0EiIudGFza2d1aWxkLnYxLlVwZGF0ZVByb2plY3RSZXF1ZXN0GiMudGFza2d1aWxkLnYxLlVwZGF0ZVByb2plY3RSZXNwb25zZRJYCg1EZWxldGVQcm9qZWN0EiIudGFza2d1aWxkLnYxLkRlbGV0ZVByb2plY3RSZXF1ZXN0GiMudGFza2d1aWxkLnYxLkRlbGV0ZVByb2plY3RSZXNwb25zZRJeCg9SZW9yZGVyUHJvamVjdHMSJC50YXNrZ3VpbGQudjEuUmVvcmRlclByb2plY3RzUmVxdWVzdBolLnRhc2tndWlsZC52MS5SZW9yZGVyUHJvamVjdHNSZXNwb25zZUK1AQoQY29tLnRhc2tndWlsZC52MUIMUHJvamVjdFByb3RvUAFaQmdpdGh1Yi5jb20va2F6ejE4Ny90YXNrZ3VpbGQvcHJvdG8vZ2VuL2dvL3Rhc2tndWlsZC92MTt0YXNrZ3VpbGR2MaICA1RYWKoCDFRhc2tndWlsZC5WMcoCDFRhc2tndWlsZFxWMeICGFRhc2tndWlsZFxWMVxHUEJNZXRhZGF0YeoCDVRhc2tndWlsZDo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_taskguild_v1_common]);
+  fileDesc("Chp0YXNrZ3VpbGQvdjEvcHJvamVjdC5wcm90bxIMdGFza2d1aWxkLnYxIoIDCgdQcm9qZWN0EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSFgoOcmVwb3NpdG9yeV91cmwYBCABKAkSFgoOZGVmYXVsdF9icmFuY2gYBSABKAkSLgoKY3JlYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFb3JkZXIYCCABKAUSGwoTaGlkZGVuX2Zyb21fc2lkZWJhchgJIAEoCBIzCgttZXJnZV9xdWV1ZRgKIAEoCzIeLnRhc2tndWlsZC52MS5NZXJnZVF1ZXVlQ29uZmlnEjUKD3dvcmt0cmVlX3BvbGljeRgLIAEoCzIcLnRhc2tndWlsZC52MS5Xb3JrdHJlZVBvbGljeRIgChh3b3JrdHJlZV9icmFuY2hfdGVtcGxhdGUYDCABKAkiZQoOV29ya3RyZWVQb2xpY3kSFwoPZGVsZXRlX2FyY2hpdmVkGAEgASgIEiAKGGRlbGV0ZV9tZXJnZWRfYWZ0ZXJfZGF5cxgCIAEoBRIYChBkaXNrX3F1b3RhX2J5dGVzGAMgASgDIkoKEE1lcmdlUXVldWVDb25maWcSDwoHZW5hYmxlZBgBIAEoCBIXCg92ZXJpZnlfY29tbWFuZHMYAiADKAkSDAoEcHVzaBgDIAEoCCL3AQoUQ3JlYXRlUHJvamVjdFJlcXVlc3QSDAoEbmFtZRgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRIWCg5yZXBvc2l0b3J5X3VybBgDIAEoCRIWCg5kZWZhdWx0X2JyYW5jaBgEIAEoCRIzCgttZXJnZV9xdWV1ZRgFIAEoCzIeLnRhc2tndWlsZC52MS5NZXJnZVF1ZXVlQ29uZmlnEjUKD3dvcmt0cmVlX3BvbGljeRgGIAEoCzIcLnRhc2tndWlsZC52MS5Xb3JrdHJlZVBvbGljeRIgChh3b3JrdHJlZV9icmFuY2hfdGVtcGxhdGUYByABKAkiPwoVQ3JlYXRlUHJvamVjdFJlc3BvbnNlEiYKB3Byb2plY3QYASABKAsyFS50YXNrZ3VpbGQudjEuUHJvamVjdCIfChFHZXRQcm9qZWN0UmVxdWVzdBIKCgJpZBgBIAEoCSI8ChJHZXRQcm9qZWN0UmVzcG9uc2USJgoHcHJvamVjdBgBIAEoCzIVLnRhc2tndWlsZC52MS5Qcm9qZWN0IkoKE0xpc3RQcm9qZWN0c1JlcXVlc3QSMwoKcGFnaW5hdGlvbhgBIAEoCzIfLnRhc2tndWlsZC52MS5QYWdpbmF0aW9uUmVxdWVzdCJ1ChRMaXN0UHJvamVjdHNSZXNwb25zZRInCghwcm9qZWN0cxgBIAMoCzIVLnRhc2tndWlsZC52MS5Qcm9qZWN0EjQKCnBhZ2luYXRpb24YAiABKAsyIC50YXNrZ3VpbGQudjEuUGFnaW5hdGlvblJlc3BvbnNlIt8CChRVcGRhdGVQcm9qZWN0UmVxdWVzdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhYKDnJlcG9zaXRvcnlfdXJsGAQgASgJEhYKDmRlZmF1bHRfYnJhbmNoGAUgASgJEiAKE2hpZGRlbl9mcm9tX3NpZGViYXIYBiABKAhIAIgBARIzCgttZXJnZV9xdWV1ZRgHIAEoCzIeLnRhc2tndWlsZC52MS5NZXJnZVF1ZXVlQ29uZmlnEjUKD3dvcmt0cmVlX3BvbGljeRgIIAEoCzIcLnRhc2tndWlsZC52MS5Xb3JrdHJlZVBvbGljeRIlChh3b3JrdHJlZV9icmFuY2hfdGVtcGxhdGUYCSABKAlIAYgBAUIWChRfaGlkZGVuX2Zyb21fc2lkZWJhckIbChlfd29ya3RyZWVfYnJhbmNoX3RlbXBsYXRlIj8KFVVwZGF0ZVByb2plY3RSZXNwb25zZRImCgdwcm9qZWN0GAEgASgLMhUudGFza2d1aWxkLnYxLlByb2plY3QiIgoURGVsZXRlUHJvamVjdFJlcXVlc3QSCgoCaWQYASABKAkiFwoVRGVsZXRlUHJvamVjdFJlc3BvbnNlIi0KFlJlb3JkZXJQcm9qZWN0c1JlcXVlc3QSEwoLcHJvamVjdF9pZHMYASADKAkiQgoXUmVvcmRlclByb2plY3RzUmVzcG9uc2USJwoIcHJvamVjdHMYASADKAsyFS50YXNrZ3VpbGQudjEuUHJvamVjdDKmBAoOUHJvamVjdFNlcnZpY2USWAoNQ3JlYXRlUHJvamVjdBIiLnRhc2tndWlsZC52MS5DcmVhdGVQcm9qZWN0UmVxdWVzdBojLnRhc2tndWlsZC52MS5DcmVhdGVQcm9qZWN0UmVzcG9uc2USTwoKR2V0UHJvamVjdBIfLnRhc2tndWlsZC52MS5HZXRQcm9qZWN0UmVxdWVzdBogLnRhc2tndWlsZC52MS5HZXRQcm9qZWN0UmVzcG9uc2USVQoMTGlzdFByb2plY3RzEiEudGFza2d1aWxkLnYxLkxpc3RQcm9qZWN0c1JlcXVlc3QaIi50YXNrZ3VpbGQudjEuTGlzdFByb2plY3RzUmVzcG9uc2USWAoNVXBkYXRlUHJvamVjdBIiLnRhc2tndWlsZC52MS5VcGRhdGVQcm9qZWN0UmVxdWVzdBojLnRhc2tndWlsZC52MS5VcGRhdGVQcm9qZWN0UmVzcG9uc2USWAoNRGVsZXRlUHJvamVjdBIiLnRhc2tndWlsZC52MS5EZWxldGVQcm9qZWN0UmVxdWVzdBojLnRhc2tndWlsZC52MS5EZWxldGVQcm9qZWN0UmVzcG9uc2USXgoPUmVvcmRlclByb2plY3RzEiQudGFza2d1aWxkLnYxLlJlb3JkZXJQcm9qZWN0c1JlcXVlc3QaJS50YXNrZ3VpbGQudjEuUmVvcmRlclByb2plY3RzUmVzcG9uc2VCtQEKEGNvbS50YXNrZ3VpbGQudjFCDFByb2plY3RQcm90b1ABWkJnaXRodWIuY29tL2thenoxODcvdGFza2d1aWxkL3Byb3RvL2dlbi9nby90YXNrZ3VpbGQvdjE7dGFza2d1aWxkdjGiAgNUWFiqAgxUYXNrZ3VpbGQuVjHKAgxUYXNrZ3VpbGRcVjHiAhhUYXNrZ3VpbGRcVjFcR1BCTWV0YWRhdGHqAg1UYXNrZ3VpbGQ6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_taskguild_v1_common]);
 
 /**
  * @generated from message taskguild.v1.Project
@@ -64,6 +64,25 @@ export type Project = Message<"taskguild.v1.Project"> & {
    * @generated from field: bool hidden_from_sidebar = 9;
    */
   hiddenFromSidebar: boolean;
+
+  /**
+   * @generated from field: taskguild.v1.MergeQueueConfig merge_queue = 10;
+   */
+  mergeQueue?: MergeQueueConfig;
+
+  /**
+   * @generated from field: taskguild.v1.WorktreePolicy worktree_policy = 11;
+   */
+  worktreePolicy?: WorktreePolicy;
+
+  /**
+   * worktree_branch_template names the branch of a new worktree. {name} is
+   * replaced by the worktree name and {task_id} by the creating task's ID.
+   * Empty means "worktree-{name}".
+   *
+   * @generated from field: string worktree_branch_template = 12;
+   */
+  worktreeBranchTemplate: string;
 };
 
 /**
@@ -73,6 +92,82 @@ export type Project = Message<"taskguild.v1.Project"> & {
 export const ProjectSchema: GenMessage<Project> = /*@__PURE__*/
   messageDesc(file_taskguild_v1_project, 0);
 
+/**
+ * WorktreePolicy configures automatic cleanup of a project's worktrees.
+ * Worktrees with uncommitted changes or whose task is running are never
+ * deleted.
+ *
+ * @generated from message taskguild.v1.WorktreePolicy
+ */
+export type WorktreePolicy = Message<"taskguild.v1.WorktreePolicy"> & {
+  /**
+   * delete_archived deletes the worktrees of archived tasks.
+   *
+   * @generated from field: bool delete_archived = 1;
+   */
+  deleteArchived: boolean;
+
+  /**
+   * delete_merged_after_days deletes worktrees whose branch is merged into
+   * the default branch and that were not modified for this many days, once
+   * their task is done. 0 disables it.
+   *
+   * @generated from field: int32 delete_merged_after_days = 2;
+   */
+  deleteMergedAfterDays: number;
+
+  /**
+   * disk_quota_bytes raises a warning when the total disk usage of the
+   * project's worktrees exceeds it. 0 disables it.
+   *
+   * @generated from field: int64 disk_quota_bytes = 3;
+   */
+  diskQuotaBytes: bigint;
+};
+
+/**
+ * Describes the message taskguild.v1.WorktreePolicy.
+ * Use `create(WorktreePolicySchema)` to create a new message.
+ */
+export const WorktreePolicySchema: GenMessage<WorktreePolicy> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_project, 1);
+
+/**
+ * MergeQueueConfig configures the local merge queue of a project. When
+ * enabled, the worktree branch of a task that reaches a terminal status is
+ * rebased onto the default branch, verified and fast-forward merged.
+ *
+ * @generated from message taskguild.v1.MergeQueueConfig
+ */
+export type MergeQueueConfig = Message<"taskguild.v1.MergeQueueConfig"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * verify_commands run (via sh -c) in the rebased worktree before merging.
+   * A non-zero exit fails the merge.
+   *
+   * @generated from field: repeated string verify_commands = 2;
+   */
+  verifyCommands: string[];
+
+  /**
+   * push pushes the default branch to origin after merging.
+   *
+   * @generated from field: bool push = 3;
+   */
+  push: boolean;
+};
+
+/**
+ * Describes the message taskguild.v1.MergeQueueConfig.
+ * Use `create(MergeQueueConfigSchema)` to create a new message.
+ */
+export const MergeQueueConfigSchema: GenMessage<MergeQueueConfig> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_project, 2);
+
 /**
  * @generated from message taskguild.v1.CreateProjectRequest
  */
@@ -96,6 +191,21 @@ export type CreateProjectRequest = Message<"taskguild.v1.CreateProjectRequest">
    * @generated from field: string default_branch = 4;
    */
   defaultBranch: string;
+
+  /**
+   * @generated from field: taskguild.v1.MergeQueueConfig merge_queue = 5;
+   */
+  mergeQueue?: MergeQueueConfig;
+
+  /**
+   * @generated from field: taskguild.v1.WorktreePolicy worktree_policy = 6;
+   */
+  worktreePolicy?: WorktreePolicy;
+
+  /**
+   * @generated from field: string worktree_branch_template = 7;
+   */
+  worktreeBranchTemplate: string;
 };
 
 /**
@@ -103,7 +213,7 @@ export type CreateProjectRequest = Message<"taskguild.v1.CreateProjectRequest">
  * Use `create(CreateProjectRequestSchema)` to create a new message.
  */
 export const CreateProjectRequestSchema: GenMessage<CreateProjectRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_project, 1);
+  messageDesc(file_taskguild_v1_project, 3);
 
 /**
  * @generated from message taskguild.v1.CreateProjectResponse
@@ -120,7 +230,7 @@ export type CreateProjectResponse = Message<"taskguild.v1.CreateProjectResponse"
  * Use `create(CreateProjectResponseSchema)` to create a new message.
  */
 export const CreateProjectResponseSchema: GenMessage<CreateProjectResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_project, 2);
+  messageDesc(file_taskguild_v1_project, 4);
 
 /**
  * @generated from message taskguild.v1.GetProjectRequest
@@ -137,7 +247,7 @@ export type GetProjectRequest = Message<"taskguild.v1.GetProjectRequest"> & {
  * Use `create(GetProjectRequestSchema)` to create a new message.
  */
 export const GetProjectRequestSchema: GenMessage<GetProjectRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_project, 3);
+  messageDesc(file_taskguild_v1_project, 5);
 
 /**
  * @generated from message taskguild.v1.GetProjectResponse
@@ -154,7 +264,7 @@ export type GetProjectResponse = Message<"taskguild.v1.GetProjectResponse"> & {
  * Use `create(GetProjectResponseSchema)` to create a new message.
  */
 export const GetProjectResponseSchema: GenMessage<GetProjectResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_project, 4);
+  messageDesc(file_taskguild_v1_project, 6);
 
 /**
  * @generated from message taskguild.v1.ListProjectsRequest
@@ -171,7 +281,7 @@ export type ListProjectsRequest = Message<"taskguild.v1.ListProjectsRequest"> &
  * Use `create(ListProjectsRequestSchema)` to create a new message.
  */
 export const ListProjectsRequestSchema: GenMessage<ListProjectsRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_project, 5);
+  messageDesc(file_taskguild_v1_project, 7);
 
 /**
  * @generated from message taskguild.v1.ListProjectsResponse
@@ -193,7 +303,7 @@ export type ListProjectsResponse = Message<"taskguild.v1.ListProjectsResponse">
  * Use `create(ListProjectsResponseSchema)` to create a new message.
  */
 export const ListProjectsResponseSchema: GenMessage<ListProjectsResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_project, 6);
+  messageDesc(file_taskguild_v1_project, 8);
 
 /**
  * @generated from message taskguild.v1.UpdateProjectRequest
@@ -228,6 +338,28 @@ export type UpdateProjectRequest = Message<"taskguild.v1.UpdateProjectRequest">
    * @generated from field: optional bool hidden_from_sidebar = 6;
    */
   hiddenFromSidebar?: boolean;
+
+  /**
+   * merge_queue replaces the merge queue configuration when set.
+   *
+   * @generated from field: taskguild.v1.MergeQueueConfig merge_queue = 7;
+   */
+  mergeQueue?: MergeQueueConfig;
+
+  /**
+   * worktree_policy replaces the worktree policy when set.
+   *
+   * @generated from field: taskguild.v1.WorktreePolicy worktree_policy = 8;
+   */
+  worktreePolicy?: WorktreePolicy;
+
+  /**
+   * worktree_branch_template replaces the template when set; empty restores
+   * the default.
+   *
+   * @generated from field: optional string worktree_branch_template = 9;
+   */
+  worktreeBranchTemplate?: string;
 };
 
 /**
@@ -235,7 +367,7 @@ export type UpdateProjectRequest = Message<"taskguild.v1.UpdateProjectRequest">
  * Use `create(UpdateProjectRequestSchema)` to create a new message.
  */
 export const UpdateProjectRequestSchema: GenMessage<UpdateProjectRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_project, 7);
+  messageDesc(file_taskguild_v1_project, 9);
 
 /**
  * @generated from message taskguild.v1.UpdateProjectResponse
@@ -252,7 +384,7 @@ export type UpdateProjectResponse = Message<"taskguild.v1.UpdateProjectResponse"
  * Use `create(UpdateProjectResponseSchema)` to create a new message.
  */
 export const UpdateProjectResponseSchema: GenMessage<UpdateProjectResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_project, 8);
+  messageDesc(file_taskguild_v1_project, 10);
 
 /**
  * @generated from message taskguild.v1.DeleteProjectRequest
@@ -269,7 +401,7 @@ export type DeleteProjectRequest = Message<"taskguild.v1.DeleteProjectRequest">
  * Use `create(DeleteProjectRequestSchema)` to create a new message.
  */
 export const DeleteProjectRequestSchema: GenMessage<DeleteProjectRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_project, 9);
+  messageDesc(file_taskguild_v1_project, 11);
 
 /**
  * @generated from message taskguild.v1.DeleteProjectResponse
@@ -282,7 +414,7 @@ export type DeleteProjectResponse = Message<"taskguild.v1.DeleteProjectResponse"
  * Use `create(DeleteProjectResponseSchema)` to create a new message.
  */
 export const DeleteProjectResponseSchema: GenMessage<DeleteProjectResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_project, 10);
+  messageDesc(file_taskguild_v1_project, 12);
 
 /**
  * @generated from message taskguild.v1.ReorderProjectsRequest
@@ -299,7 +431,7 @@ export type ReorderProjectsRequest = Message<"taskguild.v1.ReorderProjectsReques
  * Use `create(ReorderProjectsRequestSchema)` to create a new message.
  */
 export const ReorderProjectsRequestSchema: GenMessage<ReorderProjectsRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_project, 11);
+  messageDesc(file_taskguild_v1_project, 13);
 
 /**
  * @generated from message taskguild.v1.ReorderProjectsResponse
@@ -316,7 +448,7 @@ export type ReorderProjectsResponse = Message<"taskguild.v1.ReorderProjectsRespo
  * Use `create(ReorderProjectsResponseSchema)` to create a new message.
  */
 export const ReorderProjectsResponseSchema: GenMessage<ReorderProjectsResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_project, 12);
+  messageDesc(file_taskguild_v1_project, 14);
 
 /**
  * @generated from service taskguild.v1.ProjectService
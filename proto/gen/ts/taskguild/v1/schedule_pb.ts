@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file taskguild/v1/schedule.proto.
  */
 export const file_taskguild_v1_schedule: GenFile = /*@__PURE__*/
-  fileDesc("Cht0YXNrZ3VpbGQvdjEvc2NoZWR1bGUucHJvdG8SDHRhc2tndWlsZC52MSK/BAoIU2NoZWR1bGUSCgoCaWQYASABKAkSEgoKcHJvamVjdF9pZBgCIAEoCRITCgt3b3JrZmxvd19pZBgDIAEoCRIMCgRuYW1lGAQgASgJEhMKC2Rlc2NyaXB0aW9uGAUgASgJEhcKD2Nyb25fZXhwcmVzc2lvbhgGIAEoCRIPCgdlbmFibGVkGAcgASgIEhIKCnRhc2tfdGl0bGUYCCABKAkSGAoQdGFza19kZXNjcmlwdGlvbhgJIAEoCRIRCglzdGF0dXNfaWQYCiABKAkSFAoMdXNlX3dvcmt0cmVlGAsgASgIEg4KBmVmZm9ydBgMIAEoCRI/Cg10YXNrX21ldGFkYXRhGA0gAygLMigudGFza2d1aWxkLnYxLlNjaGVkdWxlLlRhc2tNZXRhZGF0YUVudHJ5Ei8KC2xhc3RfcnVuX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgtuZXh0X3J1bl9hdBgPIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKbGFzdF9lcnJvchgQIAEoCRIuCgpjcmVhdGVkX2F0GBEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GBIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBozChFUYXNrTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIooDChVDcmVhdGVTY2hlZHVsZVJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRITCgt3b3JrZmxvd19pZBgCIAEoCRIMCgRuYW1lGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEhcKD2Nyb25fZXhwcmVzc2lvbhgFIAEoCRIPCgdlbmFibGVkGAYgASgIEhIKCnRhc2tfdGl0bGUYByABKAkSGAoQdGFza19kZXNjcmlwdGlvbhgIIAEoCRIWCglzdGF0dXNfaWQYCSABKAlIAIgBARIUCgx1c2Vfd29ya3RyZWUYCiABKAgSDgoGZWZmb3J0GAsgASgJEkwKDXRhc2tfbWV0YWRhdGEYDCADKAsyNS50YXNrZ3VpbGQudjEuQ3JlYXRlU2NoZWR1bGVSZXF1ZXN0LlRhc2tNZXRhZGF0YUVudHJ5GjMKEVRhc2tNZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCDAoKX3N0YXR1c19pZCJCChZDcmVhdGVTY2hlZHVsZVJlc3BvbnNlEigKCHNjaGVkdWxlGAEgASgLMhYudGFza2d1aWxkLnYxLlNjaGVkdWxlIiAKEkdldFNjaGVkdWxlUmVxdWVzdBIKCgJpZBgBIAEoCSI/ChNHZXRTY2hlZHVsZVJlc3BvbnNlEigKCHNjaGVkdWxlGAEgASgLMhYudGFza2d1aWxkLnYxLlNjaGVkdWxlIl8KFExpc3RTY2hlZHVsZXNSZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkSMwoKcGFnaW5hdGlvbhgCIAEoCzIfLnRhc2tndWlsZC52MS5QYWdpbmF0aW9uUmVxdWVzdCJ4ChVMaXN0U2NoZWR1bGVzUmVzcG9uc2USKQoJc2NoZWR1bGVzGAEgAygLMhYudGFza2d1aWxkLnYxLlNjaGVkdWxlEjQKCnBhZ2luYXRpb24YAiABKAsyIC50YXNrZ3VpbGQudjEuUGFnaW5hdGlvblJlc3BvbnNlIocDChVVcGRhdGVTY2hlZHVsZVJlcXVlc3QSCgoCaWQYASABKAkSEwoLd29ya2Zsb3dfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRIXCg9jcm9uX2V4cHJlc3Npb24YBSABKAkSEgoKdGFza190aXRsZRgGIAEoCRIYChB0YXNrX2Rlc2NyaXB0aW9uGAcgASgJEhYKCXN0YXR1c19pZBgIIAEoCUgAiAEBEhkKDHVzZV93b3JrdHJlZRgJIAEoCEgBiAEBEg4KBmVmZm9ydBgKIAEoCRJMCg10YXNrX21ldGFkYXRhGAsgAygLMjUudGFza2d1aWxkLnYxLlVwZGF0ZVNjaGVkdWxlUmVxdWVzdC5UYXNrTWV0YWRhdGFFbnRyeRozChFUYXNrTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQgwKCl9zdGF0dXNfaWRCDwoNX3VzZV93b3JrdHJlZSJCChZVcGRhdGVTY2hlZHVsZVJlc3BvbnNlEigKCHNjaGVkdWxlGAEgASgLMhYudGFza2d1aWxkLnYxLlNjaGVkdWxlIiMKFURlbGV0ZVNjaGVkdWxlUmVxdWVzdBIKCgJpZBgBIAEoCSIYChZEZWxldGVTY2hlZHVsZVJlc3BvbnNlIjgKGVNldFNjaGVkdWxlRW5hYmxlZFJlcXVlc3QSCgoCaWQYASABKAkSDwoHZW5hYmxlZBgCIAEoCCJGChpTZXRTY2hlZHVsZUVuYWJsZWRSZXNwb25zZRIoCghzY2hlZHVsZRgBIAEoCzIWLnRhc2tndWlsZC52MS5TY2hlZHVsZTK/BAoPU2NoZWR1bGVTZXJ2aWNlElsKDkNyZWF0ZVNjaGVkdWxlEiMudGFza2d1aWxkLnYxLkNyZWF0ZVNjaGVkdWxlUmVxdWVzdBokLnRhc2tndWlsZC52MS5DcmVhdGVTY2hlZHVsZVJlc3BvbnNlElIKC0dldFNjaGVkdWxlEiAudGFza2d1aWxkLnYxLkdldFNjaGVkdWxlUmVxdWVzdBohLnRhc2tndWlsZC52MS5HZXRTY2hlZHVsZVJlc3BvbnNlElgKDUxpc3RTY2hlZHVsZXMSIi50YXNrZ3VpbGQudjEuTGlzdFNjaGVkdWxlc1JlcXVlc3QaIy50YXNrZ3VpbGQudjEuTGlzdFNjaGVkdWxlc1Jlc3BvbnNlElsKDlVwZGF0ZVNjaGVkdWxlEiMudGFza2d1aWxkLnYxLlVwZGF0ZVNjaGVkdWxlUmVxdWVzdBokLnRhc2tndWlsZC52MS5VcGRhdGVTY2hlZHVsZVJlc3BvbnNlElsKDkRlbGV0ZVNjaGVkdWxlEiMudGFza2d1aWxkLnYxLkRlbGV0ZVNjaGVkdWxlUmVxdWVzdBokLnRhc2tndWlsZC52MS5EZWxldGVTY2hlZHVsZVJlc3BvbnNlEmcKElNldFNjaGVkdWxlRW5hYmxlZBInLnRhc2tndWlsZC52MS5TZXRTY2hlZHVsZUVuYWJsZWRSZXF1ZXN0GigudGFza2d1aWxkLnYxLlNldFNjaGVkdWxlRW5hYmxlZFJlc3BvbnNlQrYBChBjb20udGFza2d1aWxkLnYxQg1TY2hlZHVsZVByb3RvUAFaQmdpdGh1Yi5jb20va2F6ejE4Ny90YXNrZ3VpbGQvcHJvdG8vZ2VuL2dvL3Rhc2tndWlsZC92MTt0YXNrZ3VpbGR2MaICA1RYWKoCDFRhc2tndWlsZC5WMcoCDFRhc2tndWlsZFxWMeICGFRhc2tndWlsZFxWMVxHUEJNZXRhZGF0YeoCDVRhc2tndWlsZDo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_taskguild_v1_common]);
+  fileDesc("Cht0YXNrZ3VpbGQvdjEvc2NoZWR1bGUucHJvdG8SDHRhc2tndWlsZC52MSL5BAoIU2NoZWR1bGUSCgoCaWQYASABKAkSEgoKcHJvamVjdF9pZBgCIAEoCRITCgt3b3JrZmxvd19pZBgDIAEoCRIMCgRuYW1lGAQgASgJEhMKC2Rlc2NyaXB0aW9uGAUgASgJEhcKD2Nyb25fZXhwcmVzc2lvbhgGIAEoCRIPCgdlbmFibGVkGAcgASgIEhIKCnRhc2tfdGl0bGUYCCABKAkSGAoQdGFza19kZXNjcmlwdGlvbhgJIAEoCRIRCglzdGF0dXNfaWQYCiABKAkSFAoMdXNlX3dvcmt0cmVlGAsgASgIEg4KBmVmZm9ydBgMIAEoCRI/Cg10YXNrX21ldGFkYXRhGA0gAygLMigudGFza2d1aWxkLnYxLlNjaGVkdWxlLlRhc2tNZXRhZGF0YUVudHJ5Ei8KC2xhc3RfcnVuX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIvCgtuZXh0X3J1bl9hdBgPIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKbGFzdF9lcnJvchgQIAEoCRIuCgpjcmVhdGVkX2F0GBEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GBIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIZChF3b3JrdHJlZV9iYXNlX3JlZhgTIAEoCRIdChV3b3JrdHJlZV9iYXNlX3Rhc2tfaWQYFCABKAkaMwoRVGFza01ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASLEAwoVQ3JlYXRlU2NoZWR1bGVSZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkSEwoLd29ya2Zsb3dfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRIXCg9jcm9uX2V4cHJlc3Npb24YBSABKAkSDwoHZW5hYmxlZBgGIAEoCBISCgp0YXNrX3RpdGxlGAcgASgJEhgKEHRhc2tfZGVzY3JpcHRpb24YCCABKAkSFgoJc3RhdHVzX2lkGAkgASgJSACIAQESFAoMdXNlX3dvcmt0cmVlGAogASgIEg4KBmVmZm9ydBgLIAEoCRJMCg10YXNrX21ldGFkYXRhGAwgAygLMjUudGFza2d1aWxkLnYxLkNyZWF0ZVNjaGVkdWxlUmVxdWVzdC5UYXNrTWV0YWRhdGFFbnRyeRIZChF3b3JrdHJlZV9iYXNlX3JlZhgNIAEoCRIdChV3b3JrdHJlZV9iYXNlX3Rhc2tfaWQYDiABKAkaMwoRVGFza01ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIMCgpfc3RhdHVzX2lkIkIKFkNyZWF0ZVNjaGVkdWxlUmVzcG9uc2USKAoIc2NoZWR1bGUYASABKAsyFi50YXNrZ3VpbGQudjEuU2NoZWR1bGUiIAoSR2V0U2NoZWR1bGVSZXF1ZXN0EgoKAmlkGAEgASgJIj8KE0dldFNjaGVkdWxlUmVzcG9uc2USKAoIc2NoZWR1bGUYASABKAsyFi50YXNrZ3VpbGQudjEuU2NoZWR1bGUiXwoUTGlzdFNjaGVkdWxlc1JlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIzCgpwYWdpbmF0aW9uGAIgASgLMh8udGFza2d1aWxkLnYxLlBhZ2luYXRpb25SZXF1ZXN0IngKFUxpc3RTY2hlZHVsZXNSZXNwb25zZRIpCglzY2hlZHVsZXMYASADKAsyFi50YXNrZ3VpbGQudjEuU2NoZWR1bGUSNAoKcGFnaW5hdGlvbhgCIAEoCzIgLnRhc2tndWlsZC52MS5QYWdpbmF0aW9uUmVzcG9uc2Ui+wMKFVVwZGF0ZVNjaGVkdWxlUmVxdWVzdBIKCgJpZBgBIAEoCRITCgt3b3JrZmxvd19pZBgCIAEoCRIMCgRuYW1lGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEhcKD2Nyb25fZXhwcmVzc2lvbhgFIAEoCRISCgp0YXNrX3RpdGxlGAYgASgJEhgKEHRhc2tfZGVzY3JpcHRpb24YByABKAkSFgoJc3RhdHVzX2lkGAggASgJSACIAQESGQoMdXNlX3dvcmt0cmVlGAkgASgISAGIAQESDgoGZWZmb3J0GAogASgJEkwKDXRhc2tfbWV0YWRhdGEYCyADKAsyNS50YXNrZ3VpbGQudjEuVXBkYXRlU2NoZWR1bGVSZXF1ZXN0LlRhc2tNZXRhZGF0YUVudHJ5Eh4KEXdvcmt0cmVlX2Jhc2VfcmVmGAwgASgJSAKIAQESIgoVd29ya3RyZWVfYmFzZV90YXNrX2lkGA0gASgJSAOIAQEaMwoRVGFza01ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIMCgpfc3RhdHVzX2lkQg8KDV91c2Vfd29ya3RyZWVCFAoSX3dvcmt0cmVlX2Jhc2VfcmVmQhgKFl93b3JrdHJlZV9iYXNlX3Rhc2tfaWQiQgoWVXBkYXRlU2NoZWR1bGVSZXNwb25zZRIoCghzY2hlZHVsZRgBIAEoCzIWLnRhc2tndWlsZC52MS5TY2hlZHVsZSIjChVEZWxldGVTY2hlZHVsZVJlcXVlc3QSCgoCaWQYASABKAkiGAoWRGVsZXRlU2NoZWR1bGVSZXNwb25zZSI4ChlTZXRTY2hlZHVsZUVuYWJsZWRSZXF1ZXN0EgoKAmlkGAEgASgJEg8KB2VuYWJsZWQYAiABKAgiRgoaU2V0U2NoZWR1bGVFbmFibGVkUmVzcG9uc2USKAoIc2NoZWR1bGUYASABKAsyFi50YXNrZ3VpbGQudjEuU2NoZWR1bGUyvwQKD1NjaGVkdWxlU2VydmljZRJbCg5DcmVhdGVTY2hlZHVsZRIjLnRhc2tndWlsZC52MS5DcmVhdGVTY2hlZHVsZVJlcXVlc3QaJC50YXNrZ3VpbGQudjEuQ3JlYXRlU2NoZWR1bGVSZXNwb25zZRJSCgtHZXRTY2hlZHVsZRIgLnRhc2tndWlsZC52MS5HZXRTY2hlZHVsZVJlcXVlc3QaIS50YXNrZ3VpbGQudjEuR2V0U2NoZWR1bGVSZXNwb25zZRJYCg1MaXN0U2NoZWR1bGVzEiIudGFza2d1aWxkLnYxLkxpc3RTY2hlZHVsZXNSZXF1ZXN0GiMudGFza2d1aWxkLnYxLkxpc3RTY2hlZHVsZXNSZXNwb25zZRJbCg5VcGRhdGVTY2hlZHVsZRIjLnRhc2tndWlsZC52MS5VcGRhdGVTY2hlZHVsZVJlcXVlc3QaJC50YXNrZ3VpbGQudjEuVXBkYXRlU2NoZWR1bGVSZXNwb25zZRJbCg5EZWxldGVTY2hlZHVsZRIjLnRhc2tndWlsZC52MS5EZWxldGVTY2hlZHVsZVJlcXVlc3QaJC50YXNrZ3VpbGQudjEuRGVsZXRlU2NoZWR1bGVSZXNwb25zZRJnChJTZXRTY2hlZHVsZUVuYWJsZWQSJy50YXNrZ3VpbGQudjEuU2V0U2NoZWR1bGVFbmFibGVkUmVxdWVzdBooLnRhc2tndWlsZC52MS5TZXRTY2hlZHVsZUVuYWJsZWRSZXNwb25zZUK2AQoQY29tLnRhc2tndWlsZC52MUINU2NoZWR1bGVQcm90b1ABWkJnaXRodWIuY29tL2thenoxODcvdGFza2d1aWxkL3Byb3RvL2dlbi9nby90YXNrZ3VpbGQvdjE7dGFza2d1aWxkdjGiAgNUWFiqAgxUYXNrZ3VpbGQuVjHKAgxUYXNrZ3VpbGRcVjHiAhhUYXNrZ3VpbGRcVjFcR1BCTWV0YWRhdGHqAg1UYXNrZ3VpbGQ6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_taskguild_v1_common]);
 
 /**
  * Schedule defines a cron-based recurring task creation.
@@ -133,6 +133,18 @@ export type Schedule = Message<"taskguild.v1.Schedule"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 18;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * worktree base of the created tasks (see Task.worktree_base_ref).
+   *
+   * @generated from field: string worktree_base_ref = 19;
+   */
+  worktreeBaseRef: string;
+
+  /**
+   * @generated from field: string worktree_base_task_id = 20;
+   */
+  worktreeBaseTaskId: string;
 };
 
 /**
@@ -205,6 +217,16 @@ export type CreateScheduleRequest = Message<"taskguild.v1.CreateScheduleRequest"
    * @generated from field: map<string, string> task_metadata = 12;
    */
   taskMetadata: { [key: string]: string };
+
+  /**
+   * @generated from field: string worktree_base_ref = 13;
+   */
+  worktreeBaseRef: string;
+
+  /**
+   * @generated from field: string worktree_base_task_id = 14;
+   */
+  worktreeBaseTaskId: string;
 };
 
 /**
@@ -367,6 +389,16 @@ export type UpdateScheduleRequest = Message<"taskguild.v1.UpdateScheduleRequest"
    * @generated from field: map<string, string> task_metadata = 11;
    */
   taskMetadata: { [key: string]: string };
+
+  /**
+   * @generated from field: optional string worktree_base_ref = 12;
+   */
+  worktreeBaseRef?: string;
+
+  /**
+   * @generated from field: optional string worktree_base_task_id = 13;
+   */
+  worktreeBaseTaskId?: string;
 };
 
 /**
@@ -0,0 +1,24 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "import_extension=.ts,target=ts"
+// @generated from file taskguild/v1/secret.proto (package taskguild.v1, syntax proto3)
+/* eslint-disable */
+
+import { SecretService } from "./secret_pb.ts";
+
+/**
+ * ListSecrets returns the names of a project's secrets, without values.
+ *
+ * @generated from rpc taskguild.v1.SecretService.ListSecrets
+ */
+export const listSecrets = SecretService.method.listSecrets;
+
+/**
+ * SetSecret creates a secret or replaces its value.
+ *
+ * @generated from rpc taskguild.v1.SecretService.SetSecret
+ */
+export const setSecret = SecretService.method.setSecret;
+
+/**
+ * @generated from rpc taskguild.v1.SecretService.DeleteSecret
+ */
+export const deleteSecret = SecretService.method.deleteSecret;
@@ -0,0 +1,211 @@
+// @generated by protoc-gen-es v2.9.0 with parameter "import_extension=.ts,target=ts"
+// @generated from file taskguild/v1/secret.proto (package taskguild.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file taskguild/v1/secret.proto.
+ */
+export const file_taskguild_v1_secret: GenFile = /*@__PURE__*/
+  fileDesc("Chl0YXNrZ3VpbGQvdjEvc2VjcmV0LnByb3RvEgx0YXNrZ3VpbGQudjEiigEKBlNlY3JldBISCgpwcm9qZWN0X2lkGAEgASgJEgwKBG5hbWUYAiABKAkSLgoKY3JlYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiKAoSTGlzdFNlY3JldHNSZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkiTQoTTGlzdFNlY3JldHNSZXNwb25zZRIlCgdzZWNyZXRzGAEgAygLMhQudGFza2d1aWxkLnYxLlNlY3JldBIPCgdlbmFibGVkGAIgASgIIkMKEFNldFNlY3JldFJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEg0KBXZhbHVlGAMgASgJIjkKEVNldFNlY3JldFJlc3BvbnNlEiQKBnNlY3JldBgBIAEoCzIULnRhc2tndWlsZC52MS5TZWNyZXQiNwoTRGVsZXRlU2VjcmV0UmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEgwKBG5hbWUYAiABKAkiFgoURGVsZXRlU2VjcmV0UmVzcG9uc2UyiAIKDVNlY3JldFNlcnZpY2USUgoLTGlzdFNlY3JldHMSIC50YXNrZ3VpbGQudjEuTGlzdFNlY3JldHNSZXF1ZXN0GiEudGFza2d1aWxkLnYxLkxpc3RTZWNyZXRzUmVzcG9uc2USTAoJU2V0U2VjcmV0Eh4udGFza2d1aWxkLnYxLlNldFNlY3JldFJlcXVlc3QaHy50YXNrZ3VpbGQudjEuU2V0U2VjcmV0UmVzcG9uc2USVQoMRGVsZXRlU2VjcmV0EiEudGFza2d1aWxkLnYxLkRlbGV0ZVNlY3JldFJlcXVlc3QaIi50YXNrZ3VpbGQudjEuRGVsZXRlU2VjcmV0UmVzcG9uc2VCtAEKEGNvbS50YXNrZ3VpbGQudjFCC1NlY3JldFByb3RvUAFaQmdpdGh1Yi5jb20va2F6ejE4Ny90YXNrZ3VpbGQvcHJvdG8vZ2VuL2dvL3Rhc2tndWlsZC92MTt0YXNrZ3VpbGR2MaICA1RYWKoCDFRhc2tndWlsZC5WMcoCDFRhc2tndWlsZFxWMeICGFRhc2tndWlsZFxWMVxHUEJNZXRhZGF0YeoCDVRhc2tndWlsZDo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message taskguild.v1.Secret
+ */
+export type Secret = Message<"taskguild.v1.Secret"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * name is the environment variable the value is exposed as (e.g. NPM_TOKEN).
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 4;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message taskguild.v1.Secret.
+ * Use `create(SecretSchema)` to create a new message.
+ */
+export const SecretSchema: GenMessage<Secret> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_secret, 0);
+
+/**
+ * @generated from message taskguild.v1.ListSecretsRequest
+ */
+export type ListSecretsRequest = Message<"taskguild.v1.ListSecretsRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+};
+
+/**
+ * Describes the message taskguild.v1.ListSecretsRequest.
+ * Use `create(ListSecretsRequestSchema)` to create a new message.
+ */
+export const ListSecretsRequestSchema: GenMessage<ListSecretsRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_secret, 1);
+
+/**
+ * @generated from message taskguild.v1.ListSecretsResponse
+ */
+export type ListSecretsResponse = Message<"taskguild.v1.ListSecretsResponse"> & {
+  /**
+   * @generated from field: repeated taskguild.v1.Secret secrets = 1;
+   */
+  secrets: Secret[];
+
+  /**
+   * enabled is false when the server has no master key configured; secrets
+   * can then be neither set nor delivered.
+   *
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+};
+
+/**
+ * Describes the message taskguild.v1.ListSecretsResponse.
+ * Use `create(ListSecretsResponseSchema)` to create a new message.
+ */
+export const ListSecretsResponseSchema: GenMessage<ListSecretsResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_secret, 2);
+
+/**
+ * @generated from message taskguild.v1.SetSecretRequest
+ */
+export type SetSecretRequest = Message<"taskguild.v1.SetSecretRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string value = 3;
+   */
+  value: string;
+};
+
+/**
+ * Describes the message taskguild.v1.SetSecretRequest.
+ * Use `create(SetSecretRequestSchema)` to create a new message.
+ */
+export const SetSecretRequestSchema: GenMessage<SetSecretRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_secret, 3);
+
+/**
+ * @generated from message taskguild.v1.SetSecretResponse
+ */
+export type SetSecretResponse = Message<"taskguild.v1.SetSecretResponse"> & {
+  /**
+   * @generated from field: taskguild.v1.Secret secret = 1;
+   */
+  secret?: Secret;
+};
+
+/**
+ * Describes the message taskguild.v1.SetSecretResponse.
+ * Use `create(SetSecretResponseSchema)` to create a new message.
+ */
+export const SetSecretResponseSchema: GenMessage<SetSecretResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_secret, 4);
+
+/**
+ * @generated from message taskguild.v1.DeleteSecretRequest
+ */
+export type DeleteSecretRequest = Message<"taskguild.v1.DeleteSecretRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message taskguild.v1.DeleteSecretRequest.
+ * Use `create(DeleteSecretRequestSchema)` to create a new message.
+ */
+export const DeleteSecretRequestSchema: GenMessage<DeleteSecretRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_secret, 5);
+
+/**
+ * @generated from message taskguild.v1.DeleteSecretResponse
+ */
+export type DeleteSecretResponse = Message<"taskguild.v1.DeleteSecretResponse"> & {
+};
+
+/**
+ * Describes the message taskguild.v1.DeleteSecretResponse.
+ * Use `create(DeleteSecretResponseSchema)` to create a new message.
+ */
+export const DeleteSecretResponseSchema: GenMessage<DeleteSecretResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_secret, 6);
+
+/**
+ * SecretService manages a project's secrets. Values are encrypted at rest with
+ * the server master key (TASKGUILD_SECRET_MASTER_KEY) and are never returned
+ * by this service; agent managers receive them when they claim a task or run
+ * a script and expose them as environment variables.
+ *
+ * @generated from service taskguild.v1.SecretService
+ */
+export const SecretService: GenService<{
+  /**
+   * ListSecrets returns the names of a project's secrets, without values.
+   *
+   * @generated from rpc taskguild.v1.SecretService.ListSecrets
+   */
+  listSecrets: {
+    methodKind: "unary";
+    input: typeof ListSecretsRequestSchema;
+    output: typeof ListSecretsResponseSchema;
+  },
+  /**
+   * SetSecret creates a secret or replaces its value.
+   *
+   * @generated from rpc taskguild.v1.SecretService.SetSecret
+   */
+  setSecret: {
+    methodKind: "unary";
+    input: typeof SetSecretRequestSchema;
+    output: typeof SetSecretResponseSchema;
+  },
+  /**
+   * @generated from rpc taskguild.v1.SecretService.DeleteSecret
+   */
+  deleteSecret: {
+    methodKind: "unary";
+    input: typeof DeleteSecretRequestSchema;
+    output: typeof DeleteSecretResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_taskguild_v1_secret, 0);
+
@@ -31,3 +31,12 @@ export const updateSingleCommandPermission = SingleCommandPermissionService.meth
  * @generated from rpc taskguild.v1.SingleCommandPermissionService.DeleteSingleCommandPermission
  */
 export const deleteSingleCommandPermission = SingleCommandPermissionService.method.deleteSingleCommandPermission;
+
+/**
+ * ListPermissionSuggestions mines the project's approved permission
+ * requests for commands worth turning into rules, and reports allow rules
+ * that no recent command has matched.
+ *
+ * @generated from rpc taskguild.v1.SingleCommandPermissionService.ListPermissionSuggestions
+ */
+export const listPermissionSuggestions = SingleCommandPermissionService.method.listPermissionSuggestions;
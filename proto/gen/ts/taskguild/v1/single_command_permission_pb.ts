@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file taskguild/v1/single_command_permission.proto.
  */
 export const file_taskguild_v1_single_command_permission: GenFile = /*@__PURE__*/
-  fileDesc("Cix0YXNrZ3VpbGQvdjEvc2luZ2xlX2NvbW1hbmRfcGVybWlzc2lvbi5wcm90bxIMdGFza2d1aWxkLnYxIo4BChdTaW5nbGVDb21tYW5kUGVybWlzc2lvbhIKCgJpZBgBIAEoCRISCgpwcm9qZWN0X2lkGAIgASgJEg8KB3BhdHRlcm4YAyABKAkSDAoEdHlwZRgEIAEoCRIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEoECAUQBiI5CiNMaXN0U2luZ2xlQ29tbWFuZFBlcm1pc3Npb25zUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJImIKJExpc3RTaW5nbGVDb21tYW5kUGVybWlzc2lvbnNSZXNwb25zZRI6CgtwZXJtaXNzaW9ucxgBIAMoCzIlLnRhc2tndWlsZC52MS5TaW5nbGVDb21tYW5kUGVybWlzc2lvbiJfCiRDcmVhdGVTaW5nbGVDb21tYW5kUGVybWlzc2lvblJlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIPCgdwYXR0ZXJuGAIgASgJEgwKBHR5cGUYAyABKAlKBAgEEAUiYgolQ3JlYXRlU2luZ2xlQ29tbWFuZFBlcm1pc3Npb25SZXNwb25zZRI5CgpwZXJtaXNzaW9uGAEgASgLMiUudGFza2d1aWxkLnYxLlNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uIlcKJFVwZGF0ZVNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uUmVxdWVzdBIKCgJpZBgBIAEoCRIPCgdwYXR0ZXJuGAIgASgJEgwKBHR5cGUYAyABKAlKBAgEEAUiYgolVXBkYXRlU2luZ2xlQ29tbWFuZFBlcm1pc3Npb25SZXNwb25zZRI5CgpwZXJtaXNzaW9uGAEgASgLMiUudGFza2d1aWxkLnYxLlNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uIjIKJERlbGV0ZVNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uUmVxdWVzdBIKCgJpZBgBIAEoCSInCiVEZWxldGVTaW5nbGVDb21tYW5kUGVybWlzc2lvblJlc3BvbnNlMskECh5TaW5nbGVDb21tYW5kUGVybWlzc2lvblNlcnZpY2UShQEKHExpc3RTaW5nbGVDb21tYW5kUGVybWlzc2lvbnMSMS50YXNrZ3VpbGQudjEuTGlzdFNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uc1JlcXVlc3QaMi50YXNrZ3VpbGQudjEuTGlzdFNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uc1Jlc3BvbnNlEogBCh1DcmVhdGVTaW5nbGVDb21tYW5kUGVybWlzc2lvbhIyLnRhc2tndWlsZC52MS5DcmVhdGVTaW5nbGVDb21tYW5kUGVybWlzc2lvblJlcXVlc3QaMy50YXNrZ3VpbGQudjEuQ3JlYXRlU2luZ2xlQ29tbWFuZFBlcm1pc3Npb25SZXNwb25zZRKIAQodVXBkYXRlU2luZ2xlQ29tbWFuZFBlcm1pc3Npb24SMi50YXNrZ3VpbGQudjEuVXBkYXRlU2luZ2xlQ29tbWFuZFBlcm1pc3Npb25SZXF1ZXN0GjMudGFza2d1aWxkLnYxLlVwZGF0ZVNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uUmVzcG9uc2USiAEKHURlbGV0ZVNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uEjIudGFza2d1aWxkLnYxLkRlbGV0ZVNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uUmVxdWVzdBozLnRhc2tndWlsZC52MS5EZWxldGVTaW5nbGVDb21tYW5kUGVybWlzc2lvblJlc3BvbnNlQsUBChBjb20udGFza2d1aWxkLnYxQhxTaW5nbGVDb21tYW5kUGVybWlzc2lvblByb3RvUAFaQmdpdGh1Yi5jb20va2F6ejE4Ny90YXNrZ3VpbGQvcHJvdG8vZ2VuL2dvL3Rhc2tndWlsZC92MTt0YXNrZ3VpbGR2MaICA1RYWKoCDFRhc2tndWlsZC5WMcoCDFRhc2tndWlsZFxWMeICGFRhc2tndWlsZFxWMVxHUEJNZXRhZGF0YeoCDVRhc2tndWlsZDo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+  fileDesc("Cix0YXNrZ3VpbGQvdjEvc2luZ2xlX2NvbW1hbmRfcGVybWlzc2lvbi5wcm90bxIMdGFza2d1aWxkLnYxItQCChdTaW5nbGVDb21tYW5kUGVybWlzc2lvbhIKCgJpZBgBIAEoCRISCgpwcm9qZWN0X2lkGAIgASgJEg8KB3BhdHRlcm4YAyABKAkSDAoEdHlwZRgEIAEoCRIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIOCgZhY3Rpb24YByABKAkSDgoGcmVhc29uGAggASgJEg0KBXNjb3BlGAkgASgJEg8KB3Rhc2tfaWQYCiABKAkSEwoLd29ya2Zsb3dfaWQYCyABKAkSEwoLc3RhdHVzX25hbWUYDCABKAkSLgoKZXhwaXJlc19hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFgoOb3JpZ2luX3Rhc2tfaWQYDiABKAkSEgoKZ3JhbnRlZF9ieRgPIAEoCUoECAUQBiI5CiNMaXN0U2luZ2xlQ29tbWFuZFBlcm1pc3Npb25zUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJImIKJExpc3RTaW5nbGVDb21tYW5kUGVybWlzc2lvbnNSZXNwb25zZRI6CgtwZXJtaXNzaW9ucxgBIAMoCzIlLnRhc2tndWlsZC52MS5TaW5nbGVDb21tYW5kUGVybWlzc2lvbiKfAQokQ3JlYXRlU2luZ2xlQ29tbWFuZFBlcm1pc3Npb25SZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkSDwoHcGF0dGVybhgCIAEoCRIMCgR0eXBlGAMgASgJEhMKBmFjdGlvbhgFIAEoCUgAiAEBEhMKBnJlYXNvbhgGIAEoCUgBiAEBQgkKB19hY3Rpb25CCQoHX3JlYXNvbkoECAQQBSJiCiVDcmVhdGVTaW5nbGVDb21tYW5kUGVybWlzc2lvblJlc3BvbnNlEjkKCnBlcm1pc3Npb24YASABKAsyJS50YXNrZ3VpbGQudjEuU2luZ2xlQ29tbWFuZFBlcm1pc3Npb24ilwEKJFVwZGF0ZVNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uUmVxdWVzdBIKCgJpZBgBIAEoCRIPCgdwYXR0ZXJuGAIgASgJEgwKBHR5cGUYAyABKAkSEwoGYWN0aW9uGAUgASgJSACIAQESEwoGcmVhc29uGAYgASgJSAGIAQFCCQoHX2FjdGlvbkIJCgdfcmVhc29uSgQIBBAFImIKJVVwZGF0ZVNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uUmVzcG9uc2USOQoKcGVybWlzc2lvbhgBIAEoCzIlLnRhc2tndWlsZC52MS5TaW5nbGVDb21tYW5kUGVybWlzc2lvbiIyCiREZWxldGVTaW5nbGVDb21tYW5kUGVybWlzc2lvblJlcXVlc3QSCgoCaWQYASABKAkiJwolRGVsZXRlU2luZ2xlQ29tbWFuZFBlcm1pc3Npb25SZXNwb25zZSJoChRQZXJtaXNzaW9uU3VnZ2VzdGlvbhIPCgdwYXR0ZXJuGAEgASgJEgwKBHR5cGUYAiABKAkSEQoJYXBwcm92YWxzGAMgASgFEhAKCGV4YW1wbGVzGAQgAygJEgwKBHJpc2sYBSABKAkiTQogTGlzdFBlcm1pc3Npb25TdWdnZXN0aW9uc1JlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIVCg1taW5fYXBwcm92YWxzGAIgASgFIpkBCiFMaXN0UGVybWlzc2lvblN1Z2dlc3Rpb25zUmVzcG9uc2USNwoLc3VnZ2VzdGlvbnMYASADKAsyIi50YXNrZ3VpbGQudjEuUGVybWlzc2lvblN1Z2dlc3Rpb24SOwoMdW51c2VkX3J1bGVzGAIgAygLMiUudGFza2d1aWxkLnYxLlNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uMscFCh5TaW5nbGVDb21tYW5kUGVybWlzc2lvblNlcnZpY2UShQEKHExpc3RTaW5nbGVDb21tYW5kUGVybWlzc2lvbnMSMS50YXNrZ3VpbGQudjEuTGlzdFNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uc1JlcXVlc3QaMi50YXNrZ3VpbGQudjEuTGlzdFNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uc1Jlc3BvbnNlEogBCh1DcmVhdGVTaW5nbGVDb21tYW5kUGVybWlzc2lvbhIyLnRhc2tndWlsZC52MS5DcmVhdGVTaW5nbGVDb21tYW5kUGVybWlzc2lvblJlcXVlc3QaMy50YXNrZ3VpbGQudjEuQ3JlYXRlU2luZ2xlQ29tbWFuZFBlcm1pc3Npb25SZXNwb25zZRKIAQodVXBkYXRlU2luZ2xlQ29tbWFuZFBlcm1pc3Npb24SMi50YXNrZ3VpbGQudjEuVXBkYXRlU2luZ2xlQ29tbWFuZFBlcm1pc3Npb25SZXF1ZXN0GjMudGFza2d1aWxkLnYxLlVwZGF0ZVNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uUmVzcG9uc2USiAEKHURlbGV0ZVNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uEjIudGFza2d1aWxkLnYxLkRlbGV0ZVNpbmdsZUNvbW1hbmRQZXJtaXNzaW9uUmVxdWVzdBozLnRhc2tndWlsZC52MS5EZWxldGVTaW5nbGVDb21tYW5kUGVybWlzc2lvblJlc3BvbnNlEnwKGUxpc3RQZXJtaXNzaW9uU3VnZ2VzdGlvbnMSLi50YXNrZ3VpbGQudjEuTGlzdFBlcm1pc3Npb25TdWdnZXN0aW9uc1JlcXVlc3QaLy50YXNrZ3VpbGQudjEuTGlzdFBlcm1pc3Npb25TdWdnZXN0aW9uc1Jlc3BvbnNlQsUBChBjb20udGFza2d1aWxkLnYxQhxTaW5nbGVDb21tYW5kUGVybWlzc2lvblByb3RvUAFaQmdpdGh1Yi5jb20va2F6ejE4Ny90YXNrZ3VpbGQvcHJvdG8vZ2VuL2dvL3Rhc2tndWlsZC92MTt0YXNrZ3VpbGR2MaICA1RYWKoCDFRhc2tndWlsZC5WMcoCDFRhc2tndWlsZFxWMeICGFRhc2tndWlsZFxWMVxHUEJNZXRhZGF0YeoCDVRhc2tndWlsZDo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
 
 /**
  * SingleCommandPermission represents a single regex-based permission rule.
@@ -48,6 +48,71 @@ export type SingleCommandPermission = Message<"taskguild.v1.SingleCommandPermiss
    * @generated from field: google.protobuf.Timestamp created_at = 6;
    */
   createdAt?: Timestamp;
+
+  /**
+   * action is "allow" (default when empty) or "deny". Deny rules win over
+   * allow rules and reject the command without asking.
+   *
+   * @generated from field: string action = 7;
+   */
+  action: string;
+
+  /**
+   * reason is shown to the agent when a deny rule rejects a command.
+   *
+   * @generated from field: string reason = 8;
+   */
+  reason: string;
+
+  /**
+   * scope limits where an allow rule applies: "project" (default), "task"
+   * or "status". Task and status grants are created from permission requests.
+   *
+   * @generated from field: string scope = 9;
+   */
+  scope: string;
+
+  /**
+   * task_id is the task a task-scoped grant applies to.
+   *
+   * @generated from field: string task_id = 10;
+   */
+  taskId: string;
+
+  /**
+   * workflow_id and status_name identify the status a status-scoped grant
+   * applies to.
+   *
+   * @generated from field: string workflow_id = 11;
+   */
+  workflowId: string;
+
+  /**
+   * @generated from field: string status_name = 12;
+   */
+  statusName: string;
+
+  /**
+   * expires_at, when set, is when the grant stops applying. Expired grants
+   * are pruned automatically.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 13;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * origin_task_id is the task whose permission request created the grant.
+   *
+   * @generated from field: string origin_task_id = 14;
+   */
+  originTaskId: string;
+
+  /**
+   * granted_by names who approved the permission request.
+   *
+   * @generated from field: string granted_by = 15;
+   */
+  grantedBy: string;
 };
 
 /**
@@ -109,6 +174,22 @@ export type CreateSingleCommandPermissionRequest = Message<"taskguild.v1.CreateS
    * @generated from field: string type = 3;
    */
   type: string;
+
+  /**
+   * action is "allow" or "deny". When unset, a new rule allows and an
+   * existing rule keeps its action.
+   *
+   * @generated from field: optional string action = 5;
+   */
+  action?: string;
+
+  /**
+   * reason is shown when a deny rule blocks a command. When unset, an
+   * existing rule keeps its reason.
+   *
+   * @generated from field: optional string reason = 6;
+   */
+  reason?: string;
 };
 
 /**
@@ -153,6 +234,18 @@ export type UpdateSingleCommandPermissionRequest = Message<"taskguild.v1.UpdateS
    * @generated from field: string type = 3;
    */
   type: string;
+
+  /**
+   * action and reason keep their stored values when unset.
+   *
+   * @generated from field: optional string action = 5;
+   */
+  action?: string;
+
+  /**
+   * @generated from field: optional string reason = 6;
+   */
+  reason?: string;
 };
 
 /**
@@ -209,6 +302,105 @@ export type DeleteSingleCommandPermissionResponse = Message<"taskguild.v1.Delete
 export const DeleteSingleCommandPermissionResponseSchema: GenMessage<DeleteSingleCommandPermissionResponse> = /*@__PURE__*/
   messageDesc(file_taskguild_v1_single_command_permission, 8);
 
+/**
+ * PermissionSuggestion is a rule proposed from repeated manual approvals.
+ *
+ * @generated from message taskguild.v1.PermissionSuggestion
+ */
+export type PermissionSuggestion = Message<"taskguild.v1.PermissionSuggestion"> & {
+  /**
+   * wildcard pattern covering the approved variants
+   *
+   * @generated from field: string pattern = 1;
+   */
+  pattern: string;
+
+  /**
+   * "command"
+   *
+   * @generated from field: string type = 2;
+   */
+  type: string;
+
+  /**
+   * number of approvals the pattern covers
+   *
+   * @generated from field: int32 approvals = 3;
+   */
+  approvals: number;
+
+  /**
+   * distinct approved commands, most frequent first
+   *
+   * @generated from field: repeated string examples = 4;
+   */
+  examples: string[];
+
+  /**
+   * highest risk level among the examples
+   *
+   * @generated from field: string risk = 5;
+   */
+  risk: string;
+};
+
+/**
+ * Describes the message taskguild.v1.PermissionSuggestion.
+ * Use `create(PermissionSuggestionSchema)` to create a new message.
+ */
+export const PermissionSuggestionSchema: GenMessage<PermissionSuggestion> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_single_command_permission, 9);
+
+/**
+ * @generated from message taskguild.v1.ListPermissionSuggestionsRequest
+ */
+export type ListPermissionSuggestionsRequest = Message<"taskguild.v1.ListPermissionSuggestionsRequest"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * min_approvals is the number of approvals needed before a command is
+   * suggested. Defaults to 3.
+   *
+   * @generated from field: int32 min_approvals = 2;
+   */
+  minApprovals: number;
+};
+
+/**
+ * Describes the message taskguild.v1.ListPermissionSuggestionsRequest.
+ * Use `create(ListPermissionSuggestionsRequestSchema)` to create a new message.
+ */
+export const ListPermissionSuggestionsRequestSchema: GenMessage<ListPermissionSuggestionsRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_single_command_permission, 10);
+
+/**
+ * @generated from message taskguild.v1.ListPermissionSuggestionsResponse
+ */
+export type ListPermissionSuggestionsResponse = Message<"taskguild.v1.ListPermissionSuggestionsResponse"> & {
+  /**
+   * @generated from field: repeated taskguild.v1.PermissionSuggestion suggestions = 1;
+   */
+  suggestions: PermissionSuggestion[];
+
+  /**
+   * unused_rules are allow rules that matched no command in the retained
+   * history.
+   *
+   * @generated from field: repeated taskguild.v1.SingleCommandPermission unused_rules = 2;
+   */
+  unusedRules: SingleCommandPermission[];
+};
+
+/**
+ * Describes the message taskguild.v1.ListPermissionSuggestionsResponse.
+ * Use `create(ListPermissionSuggestionsResponseSchema)` to create a new message.
+ */
+export const ListPermissionSuggestionsResponseSchema: GenMessage<ListPermissionSuggestionsResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_single_command_permission, 11);
+
 /**
  * SingleCommandPermissionService manages regex-based permission rules for
  * individual shell commands. Each rule matches against a single parsed command
@@ -257,6 +449,18 @@ export const SingleCommandPermissionService: GenService<{
     input: typeof DeleteSingleCommandPermissionRequestSchema;
     output: typeof DeleteSingleCommandPermissionResponseSchema;
   },
+  /**
+   * ListPermissionSuggestions mines the project's approved permission
+   * requests for commands worth turning into rules, and reports allow rules
+   * that no recent command has matched.
+   *
+   * @generated from rpc taskguild.v1.SingleCommandPermissionService.ListPermissionSuggestions
+   */
+  listPermissionSuggestions: {
+    methodKind: "unary";
+    input: typeof ListPermissionSuggestionsRequestSchema;
+    output: typeof ListPermissionSuggestionsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_taskguild_v1_single_command_permission, 0);
 
@@ -46,6 +46,24 @@ export const stopTask = TaskService.method.stopTask;
  */
 export const resumeTask = TaskService.method.resumeTask;
 
+/**
+ * CompactTask continues the task in a fresh Claude session seeded with a
+ * handoff summary of its history. A running task is compacted before its
+ * next turn; otherwise on its next run.
+ *
+ * @generated from rpc taskguild.v1.TaskService.CompactTask
+ */
+export const compactTask = TaskService.method.compactTask;
+
+/**
+ * RollbackTask restores the worktree of a stopped task to a checkpoint
+ * (see TASK_LOG_CATEGORY_CHECKPOINT) and rewinds its session. The result
+ * is published as EVENT_TYPE_TASK_ROLLBACK_RESULT.
+ *
+ * @generated from rpc taskguild.v1.TaskService.RollbackTask
+ */
+export const rollbackTask = TaskService.method.rollbackTask;
+
 /**
  * Archive operations
  *
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file taskguild/v1/task_log.proto.
  */
 export const file_taskguild_v1_task_log: GenFile = /*@__PURE__*/
-  fileDesc("Cht0YXNrZ3VpbGQvdjEvdGFza19sb2cucHJvdG8SDHRhc2tndWlsZC52MSKrAgoHVGFza0xvZxIKCgJpZBgBIAEoCRIPCgd0YXNrX2lkGAIgASgJEikKBWxldmVsGAMgASgOMhoudGFza2d1aWxkLnYxLlRhc2tMb2dMZXZlbBIvCghjYXRlZ29yeRgEIAEoDjIdLnRhc2tndWlsZC52MS5UYXNrTG9nQ2F0ZWdvcnkSDwoHbWVzc2FnZRgFIAEoCRI1CghtZXRhZGF0YRgGIAMoCzIjLnRhc2tndWlsZC52MS5UYXNrTG9nLk1ldGFkYXRhRW50cnkSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIm8KE0xpc3RUYXNrTG9nc1JlcXVlc3QSDwoHdGFza19pZBgBIAEoCRIzCgpwYWdpbmF0aW9uGAIgASgLMh8udGFza2d1aWxkLnYxLlBhZ2luYXRpb25SZXF1ZXN0EhIKCnByb2plY3RfaWQYAyABKAki9gIKFExpc3RUYXNrTG9nc1Jlc3BvbnNlEiMKBGxvZ3MYASADKAsyFS50YXNrZ3VpbGQudjEuVGFza0xvZxI0CgpwYWdpbmF0aW9uGAIgASgLMiAudGFza2d1aWxkLnYxLlBhZ2luYXRpb25SZXNwb25zZRJHCgt0YXNrX3RpdGxlcxgDIAMoCzIyLnRhc2tndWlsZC52MS5MaXN0VGFza0xvZ3NSZXNwb25zZS5UYXNrVGl0bGVzRW50cnkSUAoQdGFza19wcm9qZWN0X2lkcxgEIAMoCzI2LnRhc2tndWlsZC52MS5MaXN0VGFza0xvZ3NSZXNwb25zZS5UYXNrUHJvamVjdElkc0VudHJ5GjEKD1Rhc2tUaXRsZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjUKE1Rhc2tQcm9qZWN0SWRzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASqUAQoMVGFza0xvZ0xldmVsEh4KGlRBU0tfTE9HX0xFVkVMX1VOU1BFQ0lGSUVEEAASFwoTVEFTS19MT0dfTEVWRUxfSU5GTxABEhgKFFRBU0tfTE9HX0xFVkVMX0RFQlVHEAISFwoTVEFTS19MT0dfTEVWRUxfV0FSThADEhgKFFRBU0tfTE9HX0xFVkVMX0VSUk9SEAQqkwMKD1Rhc2tMb2dDYXRlZ29yeRIhCh1UQVNLX0xPR19DQVRFR09SWV9VTlNQRUNJRklFRBAAEiAKHFRBU0tfTE9HX0NBVEVHT1JZX1RVUk5fU1RBUlQQARIeChpUQVNLX0xPR19DQVRFR09SWV9UVVJOX0VORBACEiMKH1RBU0tfTE9HX0NBVEVHT1JZX1NUQVRVU19DSEFOR0UQAxIaChZUQVNLX0xPR19DQVRFR09SWV9IT09LEAQSHAoYVEFTS19MT0dfQ0FURUdPUllfU1RERVJSEAUSGwoXVEFTS19MT0dfQ0FURUdPUllfRVJST1IQBhIcChhUQVNLX0xPR19DQVRFR09SWV9TWVNURU0QBxIeChpUQVNLX0xPR19DQVRFR09SWV9UT09MX1VTRRAIEiIKHlRBU0tfTE9HX0NBVEVHT1JZX0FHRU5UX09VVFBVVBAJEh8KG1RBU0tfTE9HX0NBVEVHT1JZX0RJUkVDVElWRRAKEhwKGFRBU0tfTE9HX0NBVEVHT1JZX1JFU1VMVBALMmcKDlRhc2tMb2dTZXJ2aWNlElUKDExpc3RUYXNrTG9ncxIhLnRhc2tndWlsZC52MS5MaXN0VGFza0xvZ3NSZXF1ZXN0GiIudGFza2d1aWxkLnYxLkxpc3RUYXNrTG9nc1Jlc3BvbnNlQrUBChBjb20udGFza2d1aWxkLnYxQgxUYXNrTG9nUHJvdG9QAVpCZ2l0aHViLmNvbS9rYXp6MTg3L3Rhc2tndWlsZC9wcm90by9nZW4vZ28vdGFza2d1aWxkL3YxO3Rhc2tndWlsZHYxogIDVFhYqgIMVGFza2d1aWxkLlYxygIMVGFza2d1aWxkXFYx4gIYVGFza2d1aWxkXFYxXEdQQk1ldGFkYXRh6gINVGFza2d1aWxkOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_taskguild_v1_common]);
+  fileDesc("Cht0YXNrZ3VpbGQvdjEvdGFza19sb2cucHJvdG8SDHRhc2tndWlsZC52MSKrAgoHVGFza0xvZxIKCgJpZBgBIAEoCRIPCgd0YXNrX2lkGAIgASgJEikKBWxldmVsGAMgASgOMhoudGFza2d1aWxkLnYxLlRhc2tMb2dMZXZlbBIvCghjYXRlZ29yeRgEIAEoDjIdLnRhc2tndWlsZC52MS5UYXNrTG9nQ2F0ZWdvcnkSDwoHbWVzc2FnZRgFIAEoCRI1CghtZXRhZGF0YRgGIAMoCzIjLnRhc2tndWlsZC52MS5UYXNrTG9nLk1ldGFkYXRhRW50cnkSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIm8KE0xpc3RUYXNrTG9nc1JlcXVlc3QSDwoHdGFza19pZBgBIAEoCRIzCgpwYWdpbmF0aW9uGAIgASgLMh8udGFza2d1aWxkLnYxLlBhZ2luYXRpb25SZXF1ZXN0EhIKCnByb2plY3RfaWQYAyABKAki9gIKFExpc3RUYXNrTG9nc1Jlc3BvbnNlEiMKBGxvZ3MYASADKAsyFS50YXNrZ3VpbGQudjEuVGFza0xvZxI0CgpwYWdpbmF0aW9uGAIgASgLMiAudGFza2d1aWxkLnYxLlBhZ2luYXRpb25SZXNwb25zZRJHCgt0YXNrX3RpdGxlcxgDIAMoCzIyLnRhc2tndWlsZC52MS5MaXN0VGFza0xvZ3NSZXNwb25zZS5UYXNrVGl0bGVzRW50cnkSUAoQdGFza19wcm9qZWN0X2lkcxgEIAMoCzI2LnRhc2tndWlsZC52MS5MaXN0VGFza0xvZ3NSZXNwb25zZS5UYXNrUHJvamVjdElkc0VudHJ5GjEKD1Rhc2tUaXRsZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjUKE1Rhc2tQcm9qZWN0SWRzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASqUAQoMVGFza0xvZ0xldmVsEh4KGlRBU0tfTE9HX0xFVkVMX1VOU1BFQ0lGSUVEEAASFwoTVEFTS19MT0dfTEVWRUxfSU5GTxABEhgKFFRBU0tfTE9HX0xFVkVMX0RFQlVHEAISFwoTVEFTS19MT0dfTEVWRUxfV0FSThADEhgKFFRBU0tfTE9HX0xFVkVMX0VSUk9SEAQq0QMKD1Rhc2tMb2dDYXRlZ29yeRIhCh1UQVNLX0xPR19DQVRFR09SWV9VTlNQRUNJRklFRBAAEiAKHFRBU0tfTE9HX0NBVEVHT1JZX1RVUk5fU1RBUlQQARIeChpUQVNLX0xPR19DQVRFR09SWV9UVVJOX0VORBACEiMKH1RBU0tfTE9HX0NBVEVHT1JZX1NUQVRVU19DSEFOR0UQAxIaChZUQVNLX0xPR19DQVRFR09SWV9IT09LEAQSHAoYVEFTS19MT0dfQ0FURUdPUllfU1RERVJSEAUSGwoXVEFTS19MT0dfQ0FURUdPUllfRVJST1IQBhIcChhUQVNLX0xPR19DQVRFR09SWV9TWVNURU0QBxIeChpUQVNLX0xPR19DQVRFR09SWV9UT09MX1VTRRAIEiIKHlRBU0tfTE9HX0NBVEVHT1JZX0FHRU5UX09VVFBVVBAJEh8KG1RBU0tfTE9HX0NBVEVHT1JZX0RJUkVDVElWRRAKEhwKGFRBU0tfTE9HX0NBVEVHT1JZX1JFU1VMVBALEiAKHFRBU0tfTE9HX0NBVEVHT1JZX0NIRUNLUE9JTlQQDBIaChZUQVNLX0xPR19DQVRFR09SWV9ESUZGEA0yZwoOVGFza0xvZ1NlcnZpY2USVQoMTGlzdFRhc2tMb2dzEiEudGFza2d1aWxkLnYxLkxpc3RUYXNrTG9nc1JlcXVlc3QaIi50YXNrZ3VpbGQudjEuTGlzdFRhc2tMb2dzUmVzcG9uc2VCtQEKEGNvbS50YXNrZ3VpbGQudjFCDFRhc2tMb2dQcm90b1ABWkJnaXRodWIuY29tL2thenoxODcvdGFza2d1aWxkL3Byb3RvL2dlbi9nby90YXNrZ3VpbGQvdjE7dGFza2d1aWxkdjGiAgNUWFiqAgxUYXNrZ3VpbGQuVjHKAgxUYXNrZ3VpbGRcVjHiAhhUYXNrZ3VpbGRcVjFcR1BCTWV0YWRhdGHqAg1UYXNrZ3VpbGQ6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_taskguild_v1_common]);
 
 /**
  * @generated from message taskguild.v1.TaskLog
@@ -228,6 +228,20 @@ export enum TaskLogCategory {
    * @generated from enum value: TASK_LOG_CATEGORY_RESULT = 11;
    */
   RESULT = 11,
+
+  /**
+   * CHECKPOINT records a snapshot of the worktree taken after a turn.
+   *
+   * @generated from enum value: TASK_LOG_CATEGORY_CHECKPOINT = 12;
+   */
+  CHECKPOINT = 12,
+
+  /**
+   * DIFF records a snapshot of the worktree diff taken at a status transition.
+   *
+   * @generated from enum value: TASK_LOG_CATEGORY_DIFF = 13;
+   */
+  DIFF = 13,
 }
 
 /**
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file taskguild/v1/task.proto.
  */
 export const file_taskguild_v1_task: GenFile = /*@__PURE__*/
-  fileDesc("Chd0YXNrZ3VpbGQvdjEvdGFzay5wcm90bxIMdGFza2d1aWxkLnYxIs4DCgRUYXNrEgoKAmlkGAEgASgJEhIKCnByb2plY3RfaWQYAiABKAkSEwoLd29ya2Zsb3dfaWQYAyABKAkSDQoFdGl0bGUYBCABKAkSEwoLZGVzY3JpcHRpb24YBSABKAkSEQoJc3RhdHVzX2lkGAYgASgJEj0KEWFzc2lnbm1lbnRfc3RhdHVzGAcgASgOMiIudGFza2d1aWxkLnYxLlRhc2tBc3NpZ25tZW50U3RhdHVzEhkKEWFzc2lnbmVkX2FnZW50X2lkGAggASgJEhQKDHVzZV93b3JrdHJlZRgJIAEoCBIyCghtZXRhZGF0YRgLIAMoCzIgLnRhc2tndWlsZC52MS5UYXNrLk1ldGFkYXRhRW50cnkSLgoKY3JlYXRlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDgoGZWZmb3J0GA4gASgJGi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUoECAoQC1IPcGVybWlzc2lvbl9tb2RlIrUCChFDcmVhdGVUYXNrUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEhMKC3dvcmtmbG93X2lkGAIgASgJEg0KBXRpdGxlGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEhQKDHVzZV93b3JrdHJlZRgFIAEoCBI/CghtZXRhZGF0YRgHIAMoCzItLnRhc2tndWlsZC52MS5DcmVhdGVUYXNrUmVxdWVzdC5NZXRhZGF0YUVudHJ5EhYKCXN0YXR1c19pZBgIIAEoCUgAiAEBEg4KBmVmZm9ydBgJIAEoCRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCDAoKX3N0YXR1c19pZEoECAYQB1IPcGVybWlzc2lvbl9tb2RlIjYKEkNyZWF0ZVRhc2tSZXNwb25zZRIgCgR0YXNrGAEgASgLMhIudGFza2d1aWxkLnYxLlRhc2siHAoOR2V0VGFza1JlcXVlc3QSCgoCaWQYASABKAkiMwoPR2V0VGFza1Jlc3BvbnNlEiAKBHRhc2sYASABKAsyEi50YXNrZ3VpbGQudjEuVGFzayKDAQoQTGlzdFRhc2tzUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEhMKC3dvcmtmbG93X2lkGAIgASgJEhEKCXN0YXR1c19pZBgDIAEoCRIzCgpwYWdpbmF0aW9uGAQgASgLMh8udGFza2d1aWxkLnYxLlBhZ2luYXRpb25SZXF1ZXN0ImwKEUxpc3RUYXNrc1Jlc3BvbnNlEiEKBXRhc2tzGAEgAygLMhIudGFza2d1aWxkLnYxLlRhc2sSNAoKcGFnaW5hdGlvbhgCIAEoCzIgLnRhc2tndWlsZC52MS5QYWdpbmF0aW9uUmVzcG9uc2UimAIKEVVwZGF0ZVRhc2tSZXF1ZXN0EgoKAmlkGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhkKDHVzZV93b3JrdHJlZRgEIAEoCEgAiAEBEj8KCG1ldGFkYXRhGAYgAygLMi0udGFza2d1aWxkLnYxLlVwZGF0ZVRhc2tSZXF1ZXN0Lk1ldGFkYXRhRW50cnkSEwoGZWZmb3J0GAcgASgJSAGIAQEaLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQg8KDV91c2Vfd29ya3RyZWVCCQoHX2VmZm9ydEoECAUQBlIPcGVybWlzc2lvbl9tb2RlIjYKElVwZGF0ZVRhc2tSZXNwb25zZRIgCgR0YXNrGAEgASgLMhIudGFza2d1aWxkLnYxLlRhc2siHwoRRGVsZXRlVGFza1JlcXVlc3QSCgoCaWQYASABKAkiFAoSRGVsZXRlVGFza1Jlc3BvbnNlIkcKF1VwZGF0ZVRhc2tTdGF0dXNSZXF1ZXN0EgoKAmlkGAEgASgJEhEKCXN0YXR1c19pZBgCIAEoCRINCgVmb3JjZRgDIAEoCCI8ChhVcGRhdGVUYXNrU3RhdHVzUmVzcG9uc2USIAoEdGFzaxgBIAEoCzISLnRhc2tndWlsZC52MS5UYXNrIh0KD1N0b3BUYXNrUmVxdWVzdBIKCgJpZBgBIAEoCSI0ChBTdG9wVGFza1Jlc3BvbnNlEiAKBHRhc2sYASABKAsyEi50YXNrZ3VpbGQudjEuVGFzayIfChFSZXN1bWVUYXNrUmVxdWVzdBIKCgJpZBgBIAEoCSI2ChJSZXN1bWVUYXNrUmVzcG9uc2USIAoEdGFzaxgBIAEoCzISLnRhc2tndWlsZC52MS5UYXNrIiAKEkFyY2hpdmVUYXNrUmVxdWVzdBIKCgJpZBgBIAEoCSI3ChNBcmNoaXZlVGFza1Jlc3BvbnNlEiAKBHRhc2sYASABKAsyEi50YXNrZ3VpbGQudjEuVGFzayJGChtBcmNoaXZlVGVybWluYWxUYXNrc1JlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRITCgt3b3JrZmxvd19pZBgCIAEoCSJ1ChxBcmNoaXZlVGVybWluYWxUYXNrc1Jlc3BvbnNlEioKDmFyY2hpdmVkX3Rhc2tzGAEgAygLMhIudGFza2d1aWxkLnYxLlRhc2sSKQoNc2tpcHBlZF90YXNrcxgCIAMoCzISLnRhc2tndWlsZC52MS5UYXNrIiIKFFVuYXJjaGl2ZVRhc2tSZXF1ZXN0EgoKAmlkGAEgASgJIjkKFVVuYXJjaGl2ZVRhc2tSZXNwb25zZRIgCgR0YXNrGAEgASgLMhIudGFza2d1aWxkLnYxLlRhc2sieAoYTGlzdEFyY2hpdmVkVGFza3NSZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkSEwoLd29ya2Zsb3dfaWQYAiABKAkSMwoKcGFnaW5hdGlvbhgDIAEoCzIfLnRhc2tndWlsZC52MS5QYWdpbmF0aW9uUmVxdWVzdCJ0ChlMaXN0QXJjaGl2ZWRUYXNrc1Jlc3BvbnNlEiEKBXRhc2tzGAEgAygLMhIudGFza2d1aWxkLnYxLlRhc2sSNAoKcGFnaW5hdGlvbhgCIAEoCzIgLnRhc2tndWlsZC52MS5QYWdpbmF0aW9uUmVzcG9uc2UigQEKCVRhc2tJbWFnZRIKCgJpZBgBIAEoCRIQCghmaWxlbmFtZRgCIAEoCRISCgptZWRpYV90eXBlGAMgASgJEhIKCnNpemVfYnl0ZXMYBCABKAMSLgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiXQoWVXBsb2FkVGFza0ltYWdlUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJEhAKCGZpbGVuYW1lGAIgASgJEhIKCm1lZGlhX3R5cGUYAyABKAkSDAoEZGF0YRgEIAEoDCJBChdVcGxvYWRUYXNrSW1hZ2VSZXNwb25zZRImCgVpbWFnZRgBIAEoCzIXLnRhc2tndWlsZC52MS5UYXNrSW1hZ2UiOAoTR2V0VGFza0ltYWdlUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJEhAKCGltYWdlX2lkGAIgASgJIkwKFEdldFRhc2tJbWFnZVJlc3BvbnNlEiYKBWltYWdlGAEgASgLMhcudGFza2d1aWxkLnYxLlRhc2tJbWFnZRIMCgRkYXRhGAIgASgMIigKFUxpc3RUYXNrSW1hZ2VzUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJIkEKFkxpc3RUYXNrSW1hZ2VzUmVzcG9uc2USJwoGaW1hZ2VzGAEgAygLMhcudGFza2d1aWxkLnYxLlRhc2tJbWFnZSI7ChZEZWxldGVUYXNrSW1hZ2VSZXF1ZXN0Eg8KB3Rhc2tfaWQYASABKAkSEAoIaW1hZ2VfaWQYAiABKAkiGQoXRGVsZXRlVGFza0ltYWdlUmVzcG9uc2UqrgEKFFRhc2tBc3NpZ25tZW50U3RhdHVzEiYKIlRBU0tfQVNTSUdOTUVOVF9TVEFUVVNfVU5TUEVDSUZJRUQQABIlCiFUQVNLX0FTU0lHTk1FTlRfU1RBVFVTX1VOQVNTSUdORUQQARIiCh5UQVNLX0FTU0lHTk1FTlRfU1RBVFVTX1BFTkRJTkcQAhIjCh9UQVNLX0FTU0lHTk1FTlRfU1RBVFVTX0FTU0lHTkVEEAMyjAsKC1Rhc2tTZXJ2aWNlEk8KCkNyZWF0ZVRhc2sSHy50YXNrZ3VpbGQudjEuQ3JlYXRlVGFza1JlcXVlc3QaIC50YXNrZ3VpbGQudjEuQ3JlYXRlVGFza1Jlc3BvbnNlEkYKB0dldFRhc2sSHC50YXNrZ3VpbGQudjEuR2V0VGFza1JlcXVlc3QaHS50YXNrZ3VpbGQudjEuR2V0VGFza1Jlc3BvbnNlEkwKCUxpc3RUYXNrcxIeLnRhc2tndWlsZC52MS5MaXN0VGFza3NSZXF1ZXN0Gh8udGFza2d1aWxkLnYxLkxpc3RUYXNrc1Jlc3BvbnNlEk8KClVwZGF0ZVRhc2sSHy50YXNrZ3VpbGQudjEuVXBkYXRlVGFza1JlcXVlc3QaIC50YXNrZ3VpbGQudjEuVXBkYXRlVGFza1Jlc3BvbnNlEk8KCkRlbGV0ZVRhc2sSHy50YXNrZ3VpbGQudjEuRGVsZXRlVGFza1JlcXVlc3QaIC50YXNrZ3VpbGQudjEuRGVsZXRlVGFza1Jlc3BvbnNlEmEKEFVwZGF0ZVRhc2tTdGF0dXMSJS50YXNrZ3VpbGQudjEuVXBkYXRlVGFza1N0YXR1c1JlcXVlc3QaJi50YXNrZ3VpbGQudjEuVXBkYXRlVGFza1N0YXR1c1Jlc3BvbnNlEkkKCFN0b3BUYXNrEh0udGFza2d1aWxkLnYxLlN0b3BUYXNrUmVxdWVzdBoeLnRhc2tndWlsZC52MS5TdG9wVGFza1Jlc3BvbnNlEk8KClJlc3VtZVRhc2sSHy50YXNrZ3VpbGQudjEuUmVzdW1lVGFza1JlcXVlc3QaIC50YXNrZ3VpbGQudjEuUmVzdW1lVGFza1Jlc3BvbnNlElIKC0FyY2hpdmVUYXNrEiAudGFza2d1aWxkLnYxLkFyY2hpdmVUYXNrUmVxdWVzdBohLnRhc2tndWlsZC52MS5BcmNoaXZlVGFza1Jlc3BvbnNlEm0KFEFyY2hpdmVUZXJtaW5hbFRhc2tzEikudGFza2d1aWxkLnYxLkFyY2hpdmVUZXJtaW5hbFRhc2tzUmVxdWVzdBoqLnRhc2tndWlsZC52MS5BcmNoaXZlVGVybWluYWxUYXNrc1Jlc3BvbnNlElgKDVVuYXJjaGl2ZVRhc2sSIi50YXNrZ3VpbGQudjEuVW5hcmNoaXZlVGFza1JlcXVlc3QaIy50YXNrZ3VpbGQudjEuVW5hcmNoaXZlVGFza1Jlc3BvbnNlEmQKEUxpc3RBcmNoaXZlZFRhc2tzEiYudGFza2d1aWxkLnYxLkxpc3RBcmNoaXZlZFRhc2tzUmVxdWVzdBonLnRhc2tndWlsZC52MS5MaXN0QXJjaGl2ZWRUYXNrc1Jlc3BvbnNlEl4KD1VwbG9hZFRhc2tJbWFnZRIkLnRhc2tndWlsZC52MS5VcGxvYWRUYXNrSW1hZ2VSZXF1ZXN0GiUudGFza2d1aWxkLnYxLlVwbG9hZFRhc2tJbWFnZVJlc3BvbnNlElUKDEdldFRhc2tJbWFnZRIhLnRhc2tndWlsZC52MS5HZXRUYXNrSW1hZ2VSZXF1ZXN0GiIudGFza2d1aWxkLnYxLkdldFRhc2tJbWFnZVJlc3BvbnNlElsKDkxpc3RUYXNrSW1hZ2VzEiMudGFza2d1aWxkLnYxLkxpc3RUYXNrSW1hZ2VzUmVxdWVzdBokLnRhc2tndWlsZC52MS5MaXN0VGFza0ltYWdlc1Jlc3BvbnNlEl4KD0RlbGV0ZVRhc2tJbWFnZRIkLnRhc2tndWlsZC52MS5EZWxldGVUYXNrSW1hZ2VSZXF1ZXN0GiUudGFza2d1aWxkLnYxLkRlbGV0ZVRhc2tJbWFnZVJlc3BvbnNlQrIBChBjb20udGFza2d1aWxkLnYxQglUYXNrUHJvdG9QAVpCZ2l0aHViLmNvbS9rYXp6MTg3L3Rhc2tndWlsZC9wcm90by9nZW4vZ28vdGFza2d1aWxkL3YxO3Rhc2tndWlsZHYxogIDVFhYqgIMVGFza2d1aWxkLlYxygIMVGFza2d1aWxkXFYx4gIYVGFza2d1aWxkXFYxXEdQQk1ldGFkYXRh6gINVGFza2d1aWxkOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_taskguild_v1_common]);
+  fileDesc("Chd0YXNrZ3VpbGQvdjEvdGFzay5wcm90bxIMdGFza2d1aWxkLnYxIogECgRUYXNrEgoKAmlkGAEgASgJEhIKCnByb2plY3RfaWQYAiABKAkSEwoLd29ya2Zsb3dfaWQYAyABKAkSDQoFdGl0bGUYBCABKAkSEwoLZGVzY3JpcHRpb24YBSABKAkSEQoJc3RhdHVzX2lkGAYgASgJEj0KEWFzc2lnbm1lbnRfc3RhdHVzGAcgASgOMiIudGFza2d1aWxkLnYxLlRhc2tBc3NpZ25tZW50U3RhdHVzEhkKEWFzc2lnbmVkX2FnZW50X2lkGAggASgJEhQKDHVzZV93b3JrdHJlZRgJIAEoCBIyCghtZXRhZGF0YRgLIAMoCzIgLnRhc2tndWlsZC52MS5UYXNrLk1ldGFkYXRhRW50cnkSLgoKY3JlYXRlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDgoGZWZmb3J0GA4gASgJEhkKEXdvcmt0cmVlX2Jhc2VfcmVmGA8gASgJEh0KFXdvcmt0cmVlX2Jhc2VfdGFza19pZBgQIAEoCRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFKBAgKEAtSD3Blcm1pc3Npb25fbW9kZSLvAgoRQ3JlYXRlVGFza1JlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRITCgt3b3JrZmxvd19pZBgCIAEoCRINCgV0aXRsZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRIUCgx1c2Vfd29ya3RyZWUYBSABKAgSPwoIbWV0YWRhdGEYByADKAsyLS50YXNrZ3VpbGQudjEuQ3JlYXRlVGFza1JlcXVlc3QuTWV0YWRhdGFFbnRyeRIWCglzdGF0dXNfaWQYCCABKAlIAIgBARIOCgZlZmZvcnQYCSABKAkSGQoRd29ya3RyZWVfYmFzZV9yZWYYCiABKAkSHQoVd29ya3RyZWVfYmFzZV90YXNrX2lkGAsgASgJGi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIMCgpfc3RhdHVzX2lkSgQIBhAHUg9wZXJtaXNzaW9uX21vZGUiNgoSQ3JlYXRlVGFza1Jlc3BvbnNlEiAKBHRhc2sYASABKAsyEi50YXNrZ3VpbGQudjEuVGFzayIcCg5HZXRUYXNrUmVxdWVzdBIKCgJpZBgBIAEoCSIzCg9HZXRUYXNrUmVzcG9uc2USIAoEdGFzaxgBIAEoCzISLnRhc2tndWlsZC52MS5UYXNrIoMBChBMaXN0VGFza3NSZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkSEwoLd29ya2Zsb3dfaWQYAiABKAkSEQoJc3RhdHVzX2lkGAMgASgJEjMKCnBhZ2luYXRpb24YBCABKAsyHy50YXNrZ3VpbGQudjEuUGFnaW5hdGlvblJlcXVlc3QibAoRTGlzdFRhc2tzUmVzcG9uc2USIQoFdGFza3MYASADKAsyEi50YXNrZ3VpbGQudjEuVGFzaxI0CgpwYWdpbmF0aW9uGAIgASgLMiAudGFza2d1aWxkLnYxLlBhZ2luYXRpb25SZXNwb25zZSKMAwoRVXBkYXRlVGFza1JlcXVlc3QSCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSGQoMdXNlX3dvcmt0cmVlGAQgASgISACIAQESPwoIbWV0YWRhdGEYBiADKAsyLS50YXNrZ3VpbGQudjEuVXBkYXRlVGFza1JlcXVlc3QuTWV0YWRhdGFFbnRyeRITCgZlZmZvcnQYByABKAlIAYgBARIeChF3b3JrdHJlZV9iYXNlX3JlZhgIIAEoCUgCiAEBEiIKFXdvcmt0cmVlX2Jhc2VfdGFza19pZBgJIAEoCUgDiAEBGi8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIPCg1fdXNlX3dvcmt0cmVlQgkKB19lZmZvcnRCFAoSX3dvcmt0cmVlX2Jhc2VfcmVmQhgKFl93b3JrdHJlZV9iYXNlX3Rhc2tfaWRKBAgFEAZSD3Blcm1pc3Npb25fbW9kZSI2ChJVcGRhdGVUYXNrUmVzcG9uc2USIAoEdGFzaxgBIAEoCzISLnRhc2tndWlsZC52MS5UYXNrIh8KEURlbGV0ZVRhc2tSZXF1ZXN0EgoKAmlkGAEgASgJIhQKEkRlbGV0ZVRhc2tSZXNwb25zZSJHChdVcGRhdGVUYXNrU3RhdHVzUmVxdWVzdBIKCgJpZBgBIAEoCRIRCglzdGF0dXNfaWQYAiABKAkSDQoFZm9yY2UYAyABKAgiPAoYVXBkYXRlVGFza1N0YXR1c1Jlc3BvbnNlEiAKBHRhc2sYASABKAsyEi50YXNrZ3VpbGQudjEuVGFzayIdCg9TdG9wVGFza1JlcXVlc3QSCgoCaWQYASABKAkiNAoQU3RvcFRhc2tSZXNwb25zZRIgCgR0YXNrGAEgASgLMhIudGFza2d1aWxkLnYxLlRhc2siHwoRUmVzdW1lVGFza1JlcXVlc3QSCgoCaWQYASABKAkiNgoSUmVzdW1lVGFza1Jlc3BvbnNlEiAKBHRhc2sYASABKAsyEi50YXNrZ3VpbGQudjEuVGFzayIgChJDb21wYWN0VGFza1JlcXVlc3QSCgoCaWQYASABKAkiNwoTQ29tcGFjdFRhc2tSZXNwb25zZRIgCgR0YXNrGAEgASgLMhIudGFza2d1aWxkLnYxLlRhc2siSAoTUm9sbGJhY2tUYXNrUmVxdWVzdBIKCgJpZBgBIAEoCRIVCg1jaGVja3BvaW50X2lkGAIgASgJEg4KBnJlc3VtZRgDIAEoCCIqChRSb2xsYmFja1Rhc2tSZXNwb25zZRISCgpyZXF1ZXN0X2lkGAEgASgJIiAKEkFyY2hpdmVUYXNrUmVxdWVzdBIKCgJpZBgBIAEoCSI3ChNBcmNoaXZlVGFza1Jlc3BvbnNlEiAKBHRhc2sYASABKAsyEi50YXNrZ3VpbGQudjEuVGFzayJGChtBcmNoaXZlVGVybWluYWxUYXNrc1JlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRITCgt3b3JrZmxvd19pZBgCIAEoCSJ1ChxBcmNoaXZlVGVybWluYWxUYXNrc1Jlc3BvbnNlEioKDmFyY2hpdmVkX3Rhc2tzGAEgAygLMhIudGFza2d1aWxkLnYxLlRhc2sSKQoNc2tpcHBlZF90YXNrcxgCIAMoCzISLnRhc2tndWlsZC52MS5UYXNrIiIKFFVuYXJjaGl2ZVRhc2tSZXF1ZXN0EgoKAmlkGAEgASgJIjkKFVVuYXJjaGl2ZVRhc2tSZXNwb25zZRIgCgR0YXNrGAEgASgLMhIudGFza2d1aWxkLnYxLlRhc2sieAoYTGlzdEFyY2hpdmVkVGFza3NSZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkSEwoLd29ya2Zsb3dfaWQYAiABKAkSMwoKcGFnaW5hdGlvbhgDIAEoCzIfLnRhc2tndWlsZC52MS5QYWdpbmF0aW9uUmVxdWVzdCJ0ChlMaXN0QXJjaGl2ZWRUYXNrc1Jlc3BvbnNlEiEKBXRhc2tzGAEgAygLMhIudGFza2d1aWxkLnYxLlRhc2sSNAoKcGFnaW5hdGlvbhgCIAEoCzIgLnRhc2tndWlsZC52MS5QYWdpbmF0aW9uUmVzcG9uc2UigQEKCVRhc2tJbWFnZRIKCgJpZBgBIAEoCRIQCghmaWxlbmFtZRgCIAEoCRISCgptZWRpYV90eXBlGAMgASgJEhIKCnNpemVfYnl0ZXMYBCABKAMSLgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiXQoWVXBsb2FkVGFza0ltYWdlUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJEhAKCGZpbGVuYW1lGAIgASgJEhIKCm1lZGlhX3R5cGUYAyABKAkSDAoEZGF0YRgEIAEoDCJBChdVcGxvYWRUYXNrSW1hZ2VSZXNwb25zZRImCgVpbWFnZRgBIAEoCzIXLnRhc2tndWlsZC52MS5UYXNrSW1hZ2UiOAoTR2V0VGFza0ltYWdlUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJEhAKCGltYWdlX2lkGAIgASgJIkwKFEdldFRhc2tJbWFnZVJlc3BvbnNlEiYKBWltYWdlGAEgASgLMhcudGFza2d1aWxkLnYxLlRhc2tJbWFnZRIMCgRkYXRhGAIgASgMIigKFUxpc3RUYXNrSW1hZ2VzUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJIkEKFkxpc3RUYXNrSW1hZ2VzUmVzcG9uc2USJwoGaW1hZ2VzGAEgAygLMhcudGFza2d1aWxkLnYxLlRhc2tJbWFnZSI7ChZEZWxldGVUYXNrSW1hZ2VSZXF1ZXN0Eg8KB3Rhc2tfaWQYASABKAkSEAoIaW1hZ2VfaWQYAiABKAkiGQoXRGVsZXRlVGFza0ltYWdlUmVzcG9uc2UqrgEKFFRhc2tBc3NpZ25tZW50U3RhdHVzEiYKIlRBU0tfQVNTSUdOTUVOVF9TVEFUVVNfVU5TUEVDSUZJRUQQABIlCiFUQVNLX0FTU0lHTk1FTlRfU1RBVFVTX1VOQVNTSUdORUQQARIiCh5UQVNLX0FTU0lHTk1FTlRfU1RBVFVTX1BFTkRJTkcQAhIjCh9UQVNLX0FTU0lHTk1FTlRfU1RBVFVTX0FTU0lHTkVEEAMytwwKC1Rhc2tTZXJ2aWNlEk8KCkNyZWF0ZVRhc2sSHy50YXNrZ3VpbGQudjEuQ3JlYXRlVGFza1JlcXVlc3QaIC50YXNrZ3VpbGQudjEuQ3JlYXRlVGFza1Jlc3BvbnNlEkYKB0dldFRhc2sSHC50YXNrZ3VpbGQudjEuR2V0VGFza1JlcXVlc3QaHS50YXNrZ3VpbGQudjEuR2V0VGFza1Jlc3BvbnNlEkwKCUxpc3RUYXNrcxIeLnRhc2tndWlsZC52MS5MaXN0VGFza3NSZXF1ZXN0Gh8udGFza2d1aWxkLnYxLkxpc3RUYXNrc1Jlc3BvbnNlEk8KClVwZGF0ZVRhc2sSHy50YXNrZ3VpbGQudjEuVXBkYXRlVGFza1JlcXVlc3QaIC50YXNrZ3VpbGQudjEuVXBkYXRlVGFza1Jlc3BvbnNlEk8KCkRlbGV0ZVRhc2sSHy50YXNrZ3VpbGQudjEuRGVsZXRlVGFza1JlcXVlc3QaIC50YXNrZ3VpbGQudjEuRGVsZXRlVGFza1Jlc3BvbnNlEmEKEFVwZGF0ZVRhc2tTdGF0dXMSJS50YXNrZ3VpbGQudjEuVXBkYXRlVGFza1N0YXR1c1JlcXVlc3QaJi50YXNrZ3VpbGQudjEuVXBkYXRlVGFza1N0YXR1c1Jlc3BvbnNlEkkKCFN0b3BUYXNrEh0udGFza2d1aWxkLnYxLlN0b3BUYXNrUmVxdWVzdBoeLnRhc2tndWlsZC52MS5TdG9wVGFza1Jlc3BvbnNlEk8KClJlc3VtZVRhc2sSHy50YXNrZ3VpbGQudjEuUmVzdW1lVGFza1JlcXVlc3QaIC50YXNrZ3VpbGQudjEuUmVzdW1lVGFza1Jlc3BvbnNlElIKC0NvbXBhY3RUYXNrEiAudGFza2d1aWxkLnYxLkNvbXBhY3RUYXNrUmVxdWVzdBohLnRhc2tndWlsZC52MS5Db21wYWN0VGFza1Jlc3BvbnNlElUKDFJvbGxiYWNrVGFzaxIhLnRhc2tndWlsZC52MS5Sb2xsYmFja1Rhc2tSZXF1ZXN0GiIudGFza2d1aWxkLnYxLlJvbGxiYWNrVGFza1Jlc3BvbnNlElIKC0FyY2hpdmVUYXNrEiAudGFza2d1aWxkLnYxLkFyY2hpdmVUYXNrUmVxdWVzdBohLnRhc2tndWlsZC52MS5BcmNoaXZlVGFza1Jlc3BvbnNlEm0KFEFyY2hpdmVUZXJtaW5hbFRhc2tzEikudGFza2d1aWxkLnYxLkFyY2hpdmVUZXJtaW5hbFRhc2tzUmVxdWVzdBoqLnRhc2tndWlsZC52MS5BcmNoaXZlVGVybWluYWxUYXNrc1Jlc3BvbnNlElgKDVVuYXJjaGl2ZVRhc2sSIi50YXNrZ3VpbGQudjEuVW5hcmNoaXZlVGFza1JlcXVlc3QaIy50YXNrZ3VpbGQudjEuVW5hcmNoaXZlVGFza1Jlc3BvbnNlEmQKEUxpc3RBcmNoaXZlZFRhc2tzEiYudGFza2d1aWxkLnYxLkxpc3RBcmNoaXZlZFRhc2tzUmVxdWVzdBonLnRhc2tndWlsZC52MS5MaXN0QXJjaGl2ZWRUYXNrc1Jlc3BvbnNlEl4KD1VwbG9hZFRhc2tJbWFnZRIkLnRhc2tndWlsZC52MS5VcGxvYWRUYXNrSW1hZ2VSZXF1ZXN0GiUudGFza2d1aWxkLnYxLlVwbG9hZFRhc2tJbWFnZVJlc3BvbnNlElUKDEdldFRhc2tJbWFnZRIhLnRhc2tndWlsZC52MS5HZXRUYXNrSW1hZ2VSZXF1ZXN0GiIudGFza2d1aWxkLnYxLkdldFRhc2tJbWFnZVJlc3BvbnNlElsKDkxpc3RUYXNrSW1hZ2VzEiMudGFza2d1aWxkLnYxLkxpc3RUYXNrSW1hZ2VzUmVxdWVzdBokLnRhc2tndWlsZC52MS5MaXN0VGFza0ltYWdlc1Jlc3BvbnNlEl4KD0RlbGV0ZVRhc2tJbWFnZRIkLnRhc2tndWlsZC52MS5EZWxldGVUYXNrSW1hZ2VSZXF1ZXN0GiUudGFza2d1aWxkLnYxLkRlbGV0ZVRhc2tJbWFnZVJlc3BvbnNlQrIBChBjb20udGFza2d1aWxkLnYxQglUYXNrUHJvdG9QAVpCZ2l0aHViLmNvbS9rYXp6MTg3L3Rhc2tndWlsZC9wcm90by9nZW4vZ28vdGFza2d1aWxkL3YxO3Rhc2tndWlsZHYxogIDVFhYqgIMVGFza2d1aWxkLlYxygIMVGFza2d1aWxkXFYx4gIYVGFza2d1aWxkXFYxXEdQQk1ldGFkYXRh6gINVGFza2d1aWxkOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_taskguild_v1_common]);
 
 /**
  * @generated from message taskguild.v1.Task
@@ -101,6 +101,20 @@ export type Task = Message<"taskguild.v1.Task"> & {
    * @generated from field: string effort = 14;
    */
   effort: string;
+
+  /**
+   * worktree base: the new worktree branch starts from worktree_base_ref (a
+   * branch, tag or commit) or from the worktree branch of
+   * worktree_base_task_id. Both empty means the project's default branch.
+   *
+   * @generated from field: string worktree_base_ref = 15;
+   */
+  worktreeBaseRef: string;
+
+  /**
+   * @generated from field: string worktree_base_task_id = 16;
+   */
+  worktreeBaseTaskId: string;
 };
 
 /**
@@ -166,6 +180,18 @@ export type CreateTaskRequest = Message<"taskguild.v1.CreateTaskRequest"> & {
    * @generated from field: string effort = 9;
    */
   effort: string;
+
+  /**
+   * worktree base (see Task). At most one may be set.
+   *
+   * @generated from field: string worktree_base_ref = 10;
+   */
+  worktreeBaseRef: string;
+
+  /**
+   * @generated from field: string worktree_base_task_id = 11;
+   */
+  worktreeBaseTaskId: string;
 };
 
 /**
@@ -325,6 +351,18 @@ export type UpdateTaskRequest = Message<"taskguild.v1.UpdateTaskRequest"> & {
    * @generated from field: optional string effort = 7;
    */
   effort?: string;
+
+  /**
+   * worktree base (see Task); only used when the worktree is created.
+   *
+   * @generated from field: optional string worktree_base_ref = 8;
+   */
+  worktreeBaseRef?: string;
+
+  /**
+   * @generated from field: optional string worktree_base_task_id = 9;
+   */
+  worktreeBaseTaskId?: string;
 };
 
 /**
@@ -496,6 +534,86 @@ export type ResumeTaskResponse = Message<"taskguild.v1.ResumeTaskResponse"> & {
 export const ResumeTaskResponseSchema: GenMessage<ResumeTaskResponse> = /*@__PURE__*/
   messageDesc(file_taskguild_v1_task, 16);
 
+/**
+ * @generated from message taskguild.v1.CompactTaskRequest
+ */
+export type CompactTaskRequest = Message<"taskguild.v1.CompactTaskRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message taskguild.v1.CompactTaskRequest.
+ * Use `create(CompactTaskRequestSchema)` to create a new message.
+ */
+export const CompactTaskRequestSchema: GenMessage<CompactTaskRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_task, 17);
+
+/**
+ * @generated from message taskguild.v1.CompactTaskResponse
+ */
+export type CompactTaskResponse = Message<"taskguild.v1.CompactTaskResponse"> & {
+  /**
+   * @generated from field: taskguild.v1.Task task = 1;
+   */
+  task?: Task;
+};
+
+/**
+ * Describes the message taskguild.v1.CompactTaskResponse.
+ * Use `create(CompactTaskResponseSchema)` to create a new message.
+ */
+export const CompactTaskResponseSchema: GenMessage<CompactTaskResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_task, 18);
+
+/**
+ * @generated from message taskguild.v1.RollbackTaskRequest
+ */
+export type RollbackTaskRequest = Message<"taskguild.v1.RollbackTaskRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string checkpoint_id = 2;
+   */
+  checkpointId: string;
+
+  /**
+   * resume restarts the task once the rollback succeeded.
+   *
+   * @generated from field: bool resume = 3;
+   */
+  resume: boolean;
+};
+
+/**
+ * Describes the message taskguild.v1.RollbackTaskRequest.
+ * Use `create(RollbackTaskRequestSchema)` to create a new message.
+ */
+export const RollbackTaskRequestSchema: GenMessage<RollbackTaskRequest> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_task, 19);
+
+/**
+ * @generated from message taskguild.v1.RollbackTaskResponse
+ */
+export type RollbackTaskResponse = Message<"taskguild.v1.RollbackTaskResponse"> & {
+  /**
+   * @generated from field: string request_id = 1;
+   */
+  requestId: string;
+};
+
+/**
+ * Describes the message taskguild.v1.RollbackTaskResponse.
+ * Use `create(RollbackTaskResponseSchema)` to create a new message.
+ */
+export const RollbackTaskResponseSchema: GenMessage<RollbackTaskResponse> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_task, 20);
+
 /**
  * @generated from message taskguild.v1.ArchiveTaskRequest
  */
@@ -511,7 +629,7 @@ export type ArchiveTaskRequest = Message<"taskguild.v1.ArchiveTaskRequest"> & {
  * Use `create(ArchiveTaskRequestSchema)` to create a new message.
  */
 export const ArchiveTaskRequestSchema: GenMessage<ArchiveTaskRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 17);
+  messageDesc(file_taskguild_v1_task, 21);
 
 /**
  * @generated from message taskguild.v1.ArchiveTaskResponse
@@ -528,7 +646,7 @@ export type ArchiveTaskResponse = Message<"taskguild.v1.ArchiveTaskResponse"> &
  * Use `create(ArchiveTaskResponseSchema)` to create a new message.
  */
 export const ArchiveTaskResponseSchema: GenMessage<ArchiveTaskResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 18);
+  messageDesc(file_taskguild_v1_task, 22);
 
 /**
  * @generated from message taskguild.v1.ArchiveTerminalTasksRequest
@@ -550,7 +668,7 @@ export type ArchiveTerminalTasksRequest = Message<"taskguild.v1.ArchiveTerminalT
  * Use `create(ArchiveTerminalTasksRequestSchema)` to create a new message.
  */
 export const ArchiveTerminalTasksRequestSchema: GenMessage<ArchiveTerminalTasksRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 19);
+  messageDesc(file_taskguild_v1_task, 23);
 
 /**
  * @generated from message taskguild.v1.ArchiveTerminalTasksResponse
@@ -576,7 +694,7 @@ export type ArchiveTerminalTasksResponse = Message<"taskguild.v1.ArchiveTerminal
  * Use `create(ArchiveTerminalTasksResponseSchema)` to create a new message.
  */
 export const ArchiveTerminalTasksResponseSchema: GenMessage<ArchiveTerminalTasksResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 20);
+  messageDesc(file_taskguild_v1_task, 24);
 
 /**
  * @generated from message taskguild.v1.UnarchiveTaskRequest
@@ -593,7 +711,7 @@ export type UnarchiveTaskRequest = Message<"taskguild.v1.UnarchiveTaskRequest">
  * Use `create(UnarchiveTaskRequestSchema)` to create a new message.
  */
 export const UnarchiveTaskRequestSchema: GenMessage<UnarchiveTaskRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 21);
+  messageDesc(file_taskguild_v1_task, 25);
 
 /**
  * @generated from message taskguild.v1.UnarchiveTaskResponse
@@ -610,7 +728,7 @@ export type UnarchiveTaskResponse = Message<"taskguild.v1.UnarchiveTaskResponse"
  * Use `create(UnarchiveTaskResponseSchema)` to create a new message.
  */
 export const UnarchiveTaskResponseSchema: GenMessage<UnarchiveTaskResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 22);
+  messageDesc(file_taskguild_v1_task, 26);
 
 /**
  * @generated from message taskguild.v1.ListArchivedTasksRequest
@@ -637,7 +755,7 @@ export type ListArchivedTasksRequest = Message<"taskguild.v1.ListArchivedTasksRe
  * Use `create(ListArchivedTasksRequestSchema)` to create a new message.
  */
 export const ListArchivedTasksRequestSchema: GenMessage<ListArchivedTasksRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 23);
+  messageDesc(file_taskguild_v1_task, 27);
 
 /**
  * @generated from message taskguild.v1.ListArchivedTasksResponse
@@ -659,7 +777,7 @@ export type ListArchivedTasksResponse = Message<"taskguild.v1.ListArchivedTasksR
  * Use `create(ListArchivedTasksResponseSchema)` to create a new message.
  */
 export const ListArchivedTasksResponseSchema: GenMessage<ListArchivedTasksResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 24);
+  messageDesc(file_taskguild_v1_task, 28);
 
 /**
  * @generated from message taskguild.v1.TaskImage
@@ -696,7 +814,7 @@ export type TaskImage = Message<"taskguild.v1.TaskImage"> & {
  * Use `create(TaskImageSchema)` to create a new message.
  */
 export const TaskImageSchema: GenMessage<TaskImage> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 25);
+  messageDesc(file_taskguild_v1_task, 29);
 
 /**
  * @generated from message taskguild.v1.UploadTaskImageRequest
@@ -728,7 +846,7 @@ export type UploadTaskImageRequest = Message<"taskguild.v1.UploadTaskImageReques
  * Use `create(UploadTaskImageRequestSchema)` to create a new message.
  */
 export const UploadTaskImageRequestSchema: GenMessage<UploadTaskImageRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 26);
+  messageDesc(file_taskguild_v1_task, 30);
 
 /**
  * @generated from message taskguild.v1.UploadTaskImageResponse
@@ -745,7 +863,7 @@ export type UploadTaskImageResponse = Message<"taskguild.v1.UploadTaskImageRespo
  * Use `create(UploadTaskImageResponseSchema)` to create a new message.
  */
 export const UploadTaskImageResponseSchema: GenMessage<UploadTaskImageResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 27);
+  messageDesc(file_taskguild_v1_task, 31);
 
 /**
  * @generated from message taskguild.v1.GetTaskImageRequest
@@ -767,7 +885,7 @@ export type GetTaskImageRequest = Message<"taskguild.v1.GetTaskImageRequest"> &
  * Use `create(GetTaskImageRequestSchema)` to create a new message.
  */
 export const GetTaskImageRequestSchema: GenMessage<GetTaskImageRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 28);
+  messageDesc(file_taskguild_v1_task, 32);
 
 /**
  * @generated from message taskguild.v1.GetTaskImageResponse
@@ -789,7 +907,7 @@ export type GetTaskImageResponse = Message<"taskguild.v1.GetTaskImageResponse">
  * Use `create(GetTaskImageResponseSchema)` to create a new message.
  */
 export const GetTaskImageResponseSchema: GenMessage<GetTaskImageResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 29);
+  messageDesc(file_taskguild_v1_task, 33);
 
 /**
  * @generated from message taskguild.v1.ListTaskImagesRequest
@@ -806,7 +924,7 @@ export type ListTaskImagesRequest = Message<"taskguild.v1.ListTaskImagesRequest"
  * Use `create(ListTaskImagesRequestSchema)` to create a new message.
  */
 export const ListTaskImagesRequestSchema: GenMessage<ListTaskImagesRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 30);
+  messageDesc(file_taskguild_v1_task, 34);
 
 /**
  * @generated from message taskguild.v1.ListTaskImagesResponse
@@ -823,7 +941,7 @@ export type ListTaskImagesResponse = Message<"taskguild.v1.ListTaskImagesRespons
  * Use `create(ListTaskImagesResponseSchema)` to create a new message.
  */
 export const ListTaskImagesResponseSchema: GenMessage<ListTaskImagesResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 31);
+  messageDesc(file_taskguild_v1_task, 35);
 
 /**
  * @generated from message taskguild.v1.DeleteTaskImageRequest
@@ -845,7 +963,7 @@ export type DeleteTaskImageRequest = Message<"taskguild.v1.DeleteTaskImageReques
  * Use `create(DeleteTaskImageRequestSchema)` to create a new message.
  */
 export const DeleteTaskImageRequestSchema: GenMessage<DeleteTaskImageRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 32);
+  messageDesc(file_taskguild_v1_task, 36);
 
 /**
  * @generated from message taskguild.v1.DeleteTaskImageResponse
@@ -858,7 +976,7 @@ export type DeleteTaskImageResponse = Message<"taskguild.v1.DeleteTaskImageRespo
  * Use `create(DeleteTaskImageResponseSchema)` to create a new message.
  */
 export const DeleteTaskImageResponseSchema: GenMessage<DeleteTaskImageResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_task, 33);
+  messageDesc(file_taskguild_v1_task, 37);
 
 /**
  * @generated from enum taskguild.v1.TaskAssignmentStatus
@@ -961,6 +1079,30 @@ export const TaskService: GenService<{
     input: typeof ResumeTaskRequestSchema;
     output: typeof ResumeTaskResponseSchema;
   },
+  /**
+   * CompactTask continues the task in a fresh Claude session seeded with a
+   * handoff summary of its history. A running task is compacted before its
+   * next turn; otherwise on its next run.
+   *
+   * @generated from rpc taskguild.v1.TaskService.CompactTask
+   */
+  compactTask: {
+    methodKind: "unary";
+    input: typeof CompactTaskRequestSchema;
+    output: typeof CompactTaskResponseSchema;
+  },
+  /**
+   * RollbackTask restores the worktree of a stopped task to a checkpoint
+   * (see TASK_LOG_CATEGORY_CHECKPOINT) and rewinds its session. The result
+   * is published as EVENT_TYPE_TASK_ROLLBACK_RESULT.
+   *
+   * @generated from rpc taskguild.v1.TaskService.RollbackTask
+   */
+  rollbackTask: {
+    methodKind: "unary";
+    input: typeof RollbackTaskRequestSchema;
+    output: typeof RollbackTaskResponseSchema;
+  },
   /**
    * Archive operations
    *
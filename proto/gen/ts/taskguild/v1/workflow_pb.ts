@@ -6,7 +6,7 @@ import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobu
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
-import type { PaginationRequest, PaginationResponse } from "./common_pb.ts";
+import type { PaginationRequest, PaginationResponse, PathPolicy } from "./common_pb.ts";
 import { file_taskguild_v1_common } from "./common_pb.ts";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file taskguild/v1/workflow.proto.
  */
 export const file_taskguild_v1_workflow: GenFile = /*@__PURE__*/
-  fileDesc("Cht0YXNrZ3VpbGQvdjEvd29ya2Zsb3cucHJvdG8SDHRhc2tndWlsZC52MSLlAgoIV29ya2Zsb3cSCgoCaWQYASABKAkSEgoKcHJvamVjdF9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEi4KCHN0YXR1c2VzGAUgAygLMhwudGFza2d1aWxkLnYxLldvcmtmbG93U3RhdHVzEjAKDWFnZW50X2NvbmZpZ3MYBiADKAsyGS50YXNrZ3VpbGQudjEuQWdlbnRDb25maWcSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHwoXZGVmYXVsdF9wZXJtaXNzaW9uX21vZGUYCSABKAkSHAoUZGVmYXVsdF91c2Vfd29ya3RyZWUYCiABKAgSFQoNY3VzdG9tX3Byb21wdBgLIAEoCSLbAQoKU3RhdHVzSG9vaxIKCgJpZBgBIAEoCRIQCghza2lsbF9pZBgCIAEoCRIqCgd0cmlnZ2VyGAMgASgOMhkudGFza2d1aWxkLnYxLkhvb2tUcmlnZ2VyEg0KBW9yZGVyGAQgASgFEgwKBG5hbWUYBSABKAkSMQoLYWN0aW9uX3R5cGUYBiABKA4yHC50YXNrZ3VpbGQudjEuSG9va0FjdGlvblR5cGUSEQoJYWN0aW9uX2lkGAcgASgJEhIKCnNraWxsX25hbWUYCCABKAkSDAoEYXJncxgJIAEoCSLfAwoOV29ya2Zsb3dTdGF0dXMSDgoCaWQYASABKAlCAhgBEgwKBG5hbWUYAiABKAkSDQoFb3JkZXIYAyABKAUSEgoKaXNfaW5pdGlhbBgEIAEoCBITCgtpc190ZXJtaW5hbBgFIAEoCBIWCg50cmFuc2l0aW9uc190bxgGIAMoCRIQCghhZ2VudF9pZBgHIAEoCRInCgVob29rcxgIIAMoCzIYLnRhc2tndWlsZC52MS5TdGF0dXNIb29rEhcKD3Blcm1pc3Npb25fbW9kZRgLIAEoCRIcChRpbmhlcml0X3Nlc3Npb25fZnJvbRgMIAEoCRINCgVtb2RlbBgNIAEoCRINCgV0b29scxgOIAMoCRIYChBkaXNhbGxvd2VkX3Rvb2xzGA8gAygJEhEKCXNraWxsX2lkcxgQIAMoCRIcChRlbmFibGVfc2tpbGxfaGFybmVzcxgRIAEoCBIpCiFza2lsbF9oYXJuZXNzX2V4cGxpY2l0bHlfZGlzYWJsZWQYEiABKAgSDgoGZWZmb3J0GBMgASgJSgQICRAKSgQIChALUhdlbmFibGVfYWdlbnRfbWRfaGFybmVzc1IkYWdlbnRfbWRfaGFybmVzc19leHBsaWNpdGx5X2Rpc2FibGVkIoUBCgtBZ2VudENvbmZpZxIKCgJpZBgBIAEoCRIaChJ3b3JrZmxvd19zdGF0dXNfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRIUCgxpbnN0cnVjdGlvbnMYBSABKAkSFQoNYWxsb3dlZF90b29scxgGIAMoCSKGAgoVQ3JlYXRlV29ya2Zsb3dSZXF1ZXN0EhIKCnByb2plY3RfaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIuCghzdGF0dXNlcxgEIAMoCzIcLnRhc2tndWlsZC52MS5Xb3JrZmxvd1N0YXR1cxIwCg1hZ2VudF9jb25maWdzGAUgAygLMhkudGFza2d1aWxkLnYxLkFnZW50Q29uZmlnEh8KF2RlZmF1bHRfcGVybWlzc2lvbl9tb2RlGAYgASgJEhwKFGRlZmF1bHRfdXNlX3dvcmt0cmVlGAcgASgIEhUKDWN1c3RvbV9wcm9tcHQYCCABKAkiQgoWQ3JlYXRlV29ya2Zsb3dSZXNwb25zZRIoCgh3b3JrZmxvdxgBIAEoCzIWLnRhc2tndWlsZC52MS5Xb3JrZmxvdyIgChJHZXRXb3JrZmxvd1JlcXVlc3QSCgoCaWQYASABKAkiPwoTR2V0V29ya2Zsb3dSZXNwb25zZRIoCgh3b3JrZmxvdxgBIAEoCzIWLnRhc2tndWlsZC52MS5Xb3JrZmxvdyJfChRMaXN0V29ya2Zsb3dzUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEjMKCnBhZ2luYXRpb24YAiABKAsyHy50YXNrZ3VpbGQudjEuUGFnaW5hdGlvblJlcXVlc3QieAoVTGlzdFdvcmtmbG93c1Jlc3BvbnNlEikKCXdvcmtmbG93cxgBIAMoCzIWLnRhc2tndWlsZC52MS5Xb3JrZmxvdxI0CgpwYWdpbmF0aW9uGAIgASgLMiAudGFza2d1aWxkLnYxLlBhZ2luYXRpb25SZXNwb25zZSL+AQoVVXBkYXRlV29ya2Zsb3dSZXF1ZXN0EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSLgoIc3RhdHVzZXMYBCADKAsyHC50YXNrZ3VpbGQudjEuV29ya2Zsb3dTdGF0dXMSMAoNYWdlbnRfY29uZmlncxgFIAMoCzIZLnRhc2tndWlsZC52MS5BZ2VudENvbmZpZxIfChdkZWZhdWx0X3Blcm1pc3Npb25fbW9kZRgGIAEoCRIcChRkZWZhdWx0X3VzZV93b3JrdHJlZRgHIAEoCBIVCg1jdXN0b21fcHJvbXB0GAggASgJIkIKFlVwZGF0ZVdvcmtmbG93UmVzcG9uc2USKAoId29ya2Zsb3cYASABKAsyFi50YXNrZ3VpbGQudjEuV29ya2Zsb3ciIwoVRGVsZXRlV29ya2Zsb3dSZXF1ZXN0EgoKAmlkGAEgASgJIhgKFkRlbGV0ZVdvcmtmbG93UmVzcG9uc2UqzwEKC0hvb2tUcmlnZ2VyEhwKGEhPT0tfVFJJR0dFUl9VTlNQRUNJRklFRBAAEiYKIkhPT0tfVFJJR0dFUl9CRUZPUkVfVEFTS19FWEVDVVRJT04QARIlCiFIT09LX1RSSUdHRVJfQUZURVJfVEFTS19FWEVDVVRJT04QAhIoCiRIT09LX1RSSUdHRVJfQUZURVJfV09SS1RSRUVfQ1JFQVRJT04QAxIpCiVIT09LX1RSSUdHRVJfQkVGT1JFX1dPUktUUkVFX0NSRUFUSU9OEAQqjgEKDkhvb2tBY3Rpb25UeXBlEiAKHEhPT0tfQUNUSU9OX1RZUEVfVU5TUEVDSUZJRUQQABIaChZIT09LX0FDVElPTl9UWVBFX1NLSUxMEAESGwoXSE9PS19BQ1RJT05fVFlQRV9TQ1JJUFQQAhIhCh1IT09LX0FDVElPTl9UWVBFX0NVU1RPTV9TS0lMTBADMtYDCg9Xb3JrZmxvd1NlcnZpY2USWwoOQ3JlYXRlV29ya2Zsb3cSIy50YXNrZ3VpbGQudjEuQ3JlYXRlV29ya2Zsb3dSZXF1ZXN0GiQudGFza2d1aWxkLnYxLkNyZWF0ZVdvcmtmbG93UmVzcG9uc2USUgoLR2V0V29ya2Zsb3cSIC50YXNrZ3VpbGQudjEuR2V0V29ya2Zsb3dSZXF1ZXN0GiEudGFza2d1aWxkLnYxLkdldFdvcmtmbG93UmVzcG9uc2USWAoNTGlzdFdvcmtmbG93cxIiLnRhc2tndWlsZC52MS5MaXN0V29ya2Zsb3dzUmVxdWVzdBojLnRhc2tndWlsZC52MS5MaXN0V29ya2Zsb3dzUmVzcG9uc2USWwoOVXBkYXRlV29ya2Zsb3cSIy50YXNrZ3VpbGQudjEuVXBkYXRlV29ya2Zsb3dSZXF1ZXN0GiQudGFza2d1aWxkLnYxLlVwZGF0ZVdvcmtmbG93UmVzcG9uc2USWwoORGVsZXRlV29ya2Zsb3cSIy50YXNrZ3VpbGQudjEuRGVsZXRlV29ya2Zsb3dSZXF1ZXN0GiQudGFza2d1aWxkLnYxLkRlbGV0ZVdvcmtmbG93UmVzcG9uc2VCtgEKEGNvbS50YXNrZ3VpbGQudjFCDVdvcmtmbG93UHJvdG9QAVpCZ2l0aHViLmNvbS9rYXp6MTg3L3Rhc2tndWlsZC9wcm90by9nZW4vZ28vdGFza2d1aWxkL3YxO3Rhc2tndWlsZHYxogIDVFhYqgIMVGFza2d1aWxkLlYxygIMVGFza2d1aWxkXFYx4gIYVGFza2d1aWxkXFYxXEdQQk1ldGFkYXRh6gINVGFza2d1aWxkOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_taskguild_v1_common]);
+  fileDesc("Cht0YXNrZ3VpbGQvdjEvd29ya2Zsb3cucHJvdG8SDHRhc2tndWlsZC52MSLlAgoIV29ya2Zsb3cSCgoCaWQYASABKAkSEgoKcHJvamVjdF9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEi4KCHN0YXR1c2VzGAUgAygLMhwudGFza2d1aWxkLnYxLldvcmtmbG93U3RhdHVzEjAKDWFnZW50X2NvbmZpZ3MYBiADKAsyGS50YXNrZ3VpbGQudjEuQWdlbnRDb25maWcSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHwoXZGVmYXVsdF9wZXJtaXNzaW9uX21vZGUYCSABKAkSHAoUZGVmYXVsdF91c2Vfd29ya3RyZWUYCiABKAgSFQoNY3VzdG9tX3Byb21wdBgLIAEoCSLbAQoKU3RhdHVzSG9vaxIKCgJpZBgBIAEoCRIQCghza2lsbF9pZBgCIAEoCRIqCgd0cmlnZ2VyGAMgASgOMhkudGFza2d1aWxkLnYxLkhvb2tUcmlnZ2VyEg0KBW9yZGVyGAQgASgFEgwKBG5hbWUYBSABKAkSMQoLYWN0aW9uX3R5cGUYBiABKA4yHC50YXNrZ3VpbGQudjEuSG9va0FjdGlvblR5cGUSEQoJYWN0aW9uX2lkGAcgASgJEhIKCnNraWxsX25hbWUYCCABKAkSDAoEYXJncxgJIAEoCSLVBAoOV29ya2Zsb3dTdGF0dXMSDgoCaWQYASABKAlCAhgBEgwKBG5hbWUYAiABKAkSDQoFb3JkZXIYAyABKAUSEgoKaXNfaW5pdGlhbBgEIAEoCBITCgtpc190ZXJtaW5hbBgFIAEoCBIWCg50cmFuc2l0aW9uc190bxgGIAMoCRIQCghhZ2VudF9pZBgHIAEoCRInCgVob29rcxgIIAMoCzIYLnRhc2tndWlsZC52MS5TdGF0dXNIb29rEhcKD3Blcm1pc3Npb25fbW9kZRgLIAEoCRIcChRpbmhlcml0X3Nlc3Npb25fZnJvbRgMIAEoCRINCgVtb2RlbBgNIAEoCRINCgV0b29scxgOIAMoCRIYChBkaXNhbGxvd2VkX3Rvb2xzGA8gAygJEhEKCXNraWxsX2lkcxgQIAMoCRIcChRlbmFibGVfc2tpbGxfaGFybmVzcxgRIAEoCBIpCiFza2lsbF9oYXJuZXNzX2V4cGxpY2l0bHlfZGlzYWJsZWQYEiABKAgSDgoGZWZmb3J0GBMgASgJEhcKD2ZhbGxiYWNrX21vZGVscxgUIAMoCRIsCgdzYW5kYm94GBUgASgLMhsudGFza2d1aWxkLnYxLlNhbmRib3hDb25maWcSLQoLcGF0aF9wb2xpY3kYFiABKAsyGC50YXNrZ3VpbGQudjEuUGF0aFBvbGljeUoECAkQCkoECAoQC1IXZW5hYmxlX2FnZW50X21kX2hhcm5lc3NSJGFnZW50X21kX2hhcm5lc3NfZXhwbGljaXRseV9kaXNhYmxlZCKcAQoNU2FuZGJveENvbmZpZxIPCgdlbmFibGVkGAEgASgIEhUKDWFsbG93X25ldHdvcmsYAiABKAgSGQoRY3B1X2xpbWl0X3NlY29uZHMYAyABKAUSFwoPbWVtb3J5X2xpbWl0X21iGAQgASgFEhcKD3RpbWVvdXRfc2Vjb25kcxgFIAEoBRIWCg53cml0YWJsZV9wYXRocxgGIAMoCSKFAQoLQWdlbnRDb25maWcSCgoCaWQYASABKAkSGgoSd29ya2Zsb3dfc3RhdHVzX2lkGAIgASgJEgwKBG5hbWUYAyABKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSFAoMaW5zdHJ1Y3Rpb25zGAUgASgJEhUKDWFsbG93ZWRfdG9vbHMYBiADKAkihgIKFUNyZWF0ZVdvcmtmbG93UmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSLgoIc3RhdHVzZXMYBCADKAsyHC50YXNrZ3VpbGQudjEuV29ya2Zsb3dTdGF0dXMSMAoNYWdlbnRfY29uZmlncxgFIAMoCzIZLnRhc2tndWlsZC52MS5BZ2VudENvbmZpZxIfChdkZWZhdWx0X3Blcm1pc3Npb25fbW9kZRgGIAEoCRIcChRkZWZhdWx0X3VzZV93b3JrdHJlZRgHIAEoCBIVCg1jdXN0b21fcHJvbXB0GAggASgJIkIKFkNyZWF0ZVdvcmtmbG93UmVzcG9uc2USKAoId29ya2Zsb3cYASABKAsyFi50YXNrZ3VpbGQudjEuV29ya2Zsb3ciIAoSR2V0V29ya2Zsb3dSZXF1ZXN0EgoKAmlkGAEgASgJIj8KE0dldFdvcmtmbG93UmVzcG9uc2USKAoId29ya2Zsb3cYASABKAsyFi50YXNrZ3VpbGQudjEuV29ya2Zsb3ciXwoUTGlzdFdvcmtmbG93c1JlcXVlc3QSEgoKcHJvamVjdF9pZBgBIAEoCRIzCgpwYWdpbmF0aW9uGAIgASgLMh8udGFza2d1aWxkLnYxLlBhZ2luYXRpb25SZXF1ZXN0IngKFUxpc3RXb3JrZmxvd3NSZXNwb25zZRIpCgl3b3JrZmxvd3MYASADKAsyFi50YXNrZ3VpbGQudjEuV29ya2Zsb3cSNAoKcGFnaW5hdGlvbhgCIAEoCzIgLnRhc2tndWlsZC52MS5QYWdpbmF0aW9uUmVzcG9uc2Ui/gEKFVVwZGF0ZVdvcmtmbG93UmVxdWVzdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEi4KCHN0YXR1c2VzGAQgAygLMhwudGFza2d1aWxkLnYxLldvcmtmbG93U3RhdHVzEjAKDWFnZW50X2NvbmZpZ3MYBSADKAsyGS50YXNrZ3VpbGQudjEuQWdlbnRDb25maWcSHwoXZGVmYXVsdF9wZXJtaXNzaW9uX21vZGUYBiABKAkSHAoUZGVmYXVsdF91c2Vfd29ya3RyZWUYByABKAgSFQoNY3VzdG9tX3Byb21wdBgIIAEoCSJCChZVcGRhdGVXb3JrZmxvd1Jlc3BvbnNlEigKCHdvcmtmbG93GAEgASgLMhYudGFza2d1aWxkLnYxLldvcmtmbG93IiMKFURlbGV0ZVdvcmtmbG93UmVxdWVzdBIKCgJpZBgBIAEoCSIYChZEZWxldGVXb3JrZmxvd1Jlc3BvbnNlKs8BCgtIb29rVHJpZ2dlchIcChhIT09LX1RSSUdHRVJfVU5TUEVDSUZJRUQQABImCiJIT09LX1RSSUdHRVJfQkVGT1JFX1RBU0tfRVhFQ1VUSU9OEAESJQohSE9PS19UUklHR0VSX0FGVEVSX1RBU0tfRVhFQ1VUSU9OEAISKAokSE9PS19UUklHR0VSX0FGVEVSX1dPUktUUkVFX0NSRUFUSU9OEAMSKQolSE9PS19UUklHR0VSX0JFRk9SRV9XT1JLVFJFRV9DUkVBVElPThAEKo4BCg5Ib29rQWN0aW9uVHlwZRIgChxIT09LX0FDVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASGgoWSE9PS19BQ1RJT05fVFlQRV9TS0lMTBABEhsKF0hPT0tfQUNUSU9OX1RZUEVfU0NSSVBUEAISIQodSE9PS19BQ1RJT05fVFlQRV9DVVNUT01fU0tJTEwQAzLWAwoPV29ya2Zsb3dTZXJ2aWNlElsKDkNyZWF0ZVdvcmtmbG93EiMudGFza2d1aWxkLnYxLkNyZWF0ZVdvcmtmbG93UmVxdWVzdBokLnRhc2tndWlsZC52MS5DcmVhdGVXb3JrZmxvd1Jlc3BvbnNlElIKC0dldFdvcmtmbG93EiAudGFza2d1aWxkLnYxLkdldFdvcmtmbG93UmVxdWVzdBohLnRhc2tndWlsZC52MS5HZXRXb3JrZmxvd1Jlc3BvbnNlElgKDUxpc3RXb3JrZmxvd3MSIi50YXNrZ3VpbGQudjEuTGlzdFdvcmtmbG93c1JlcXVlc3QaIy50YXNrZ3VpbGQudjEuTGlzdFdvcmtmbG93c1Jlc3BvbnNlElsKDlVwZGF0ZVdvcmtmbG93EiMudGFza2d1aWxkLnYxLlVwZGF0ZVdvcmtmbG93UmVxdWVzdBokLnRhc2tndWlsZC52MS5VcGRhdGVXb3JrZmxvd1Jlc3BvbnNlElsKDkRlbGV0ZVdvcmtmbG93EiMudGFza2d1aWxkLnYxLkRlbGV0ZVdvcmtmbG93UmVxdWVzdBokLnRhc2tndWlsZC52MS5EZWxldGVXb3JrZmxvd1Jlc3BvbnNlQrYBChBjb20udGFza2d1aWxkLnYxQg1Xb3JrZmxvd1Byb3RvUAFaQmdpdGh1Yi5jb20va2F6ejE4Ny90YXNrZ3VpbGQvcHJvdG8vZ2VuL2dvL3Rhc2tndWlsZC92MTt0YXNrZ3VpbGR2MaICA1RYWKoCDFRhc2tndWlsZC5WMcoCDFRhc2tndWlsZFxWMeICGFRhc2tndWlsZFxWMVxHUEJNZXRhZGF0YeoCDVRhc2tndWlsZDo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_taskguild_v1_common]);
 
 /**
  * Workflow defines a project's task lifecycle with custom statuses and agent configurations.
@@ -291,6 +291,30 @@ export type WorkflowStatus = Message<"taskguild.v1.WorkflowStatus"> & {
    * @generated from field: string effort = 19;
    */
   effort: string;
+
+  /**
+   * Models to fall back to, in order, when the primary model is overloaded
+   * or rate-limited (e.g. ["sonnet", "haiku"]).
+   *
+   * @generated from field: repeated string fallback_models = 20;
+   */
+  fallbackModels: string[];
+
+  /**
+   * Linux sandbox for Bash commands spawned by the agent. Statuses using
+   * bypassPermissions are always sandboxed.
+   *
+   * @generated from field: taskguild.v1.SandboxConfig sandbox = 21;
+   */
+  sandbox?: SandboxConfig;
+
+  /**
+   * Path policy for edit tools and shell redirects in this status. A
+   * non-empty allow list replaces the project's; deny lists are combined.
+   *
+   * @generated from field: taskguild.v1.PathPolicy path_policy = 22;
+   */
+  pathPolicy?: PathPolicy;
 };
 
 /**
@@ -300,6 +324,59 @@ export type WorkflowStatus = Message<"taskguild.v1.WorkflowStatus"> & {
 export const WorkflowStatusSchema: GenMessage<WorkflowStatus> = /*@__PURE__*/
   messageDesc(file_taskguild_v1_workflow, 2);
 
+/**
+ * SandboxConfig confines agent-spawned shell commands with bubblewrap:
+ * the working tree is writable and the rest of the filesystem is read-only.
+ *
+ * @generated from message taskguild.v1.SandboxConfig
+ */
+export type SandboxConfig = Message<"taskguild.v1.SandboxConfig"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: bool allow_network = 2;
+   */
+  allowNetwork: boolean;
+
+  /**
+   * 0 = unlimited
+   *
+   * @generated from field: int32 cpu_limit_seconds = 3;
+   */
+  cpuLimitSeconds: number;
+
+  /**
+   * 0 = unlimited
+   *
+   * @generated from field: int32 memory_limit_mb = 4;
+   */
+  memoryLimitMb: number;
+
+  /**
+   * 0 = unlimited
+   *
+   * @generated from field: int32 timeout_seconds = 5;
+   */
+  timeoutSeconds: number;
+
+  /**
+   * extra writable paths (e.g. ~/.cache)
+   *
+   * @generated from field: repeated string writable_paths = 6;
+   */
+  writablePaths: string[];
+};
+
+/**
+ * Describes the message taskguild.v1.SandboxConfig.
+ * Use `create(SandboxConfigSchema)` to create a new message.
+ */
+export const SandboxConfigSchema: GenMessage<SandboxConfig> = /*@__PURE__*/
+  messageDesc(file_taskguild_v1_workflow, 3);
+
 /**
  * AgentConfig defines how an agent should behave for a specific status.
  *
@@ -350,7 +427,7 @@ export type AgentConfig = Message<"taskguild.v1.AgentConfig"> & {
  * Use `create(AgentConfigSchema)` to create a new message.
  */
 export const AgentConfigSchema: GenMessage<AgentConfig> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_workflow, 3);
+  messageDesc(file_taskguild_v1_workflow, 4);
 
 /**
  * @generated from message taskguild.v1.CreateWorkflowRequest
@@ -410,7 +487,7 @@ export type CreateWorkflowRequest = Message<"taskguild.v1.CreateWorkflowRequest"
  * Use `create(CreateWorkflowRequestSchema)` to create a new message.
  */
 export const CreateWorkflowRequestSchema: GenMessage<CreateWorkflowRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_workflow, 4);
+  messageDesc(file_taskguild_v1_workflow, 5);
 
 /**
  * @generated from message taskguild.v1.CreateWorkflowResponse
@@ -427,7 +504,7 @@ export type CreateWorkflowResponse = Message<"taskguild.v1.CreateWorkflowRespons
  * Use `create(CreateWorkflowResponseSchema)` to create a new message.
  */
 export const CreateWorkflowResponseSchema: GenMessage<CreateWorkflowResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_workflow, 5);
+  messageDesc(file_taskguild_v1_workflow, 6);
 
 /**
  * @generated from message taskguild.v1.GetWorkflowRequest
@@ -444,7 +521,7 @@ export type GetWorkflowRequest = Message<"taskguild.v1.GetWorkflowRequest"> & {
  * Use `create(GetWorkflowRequestSchema)` to create a new message.
  */
 export const GetWorkflowRequestSchema: GenMessage<GetWorkflowRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_workflow, 6);
+  messageDesc(file_taskguild_v1_workflow, 7);
 
 /**
  * @generated from message taskguild.v1.GetWorkflowResponse
@@ -461,7 +538,7 @@ export type GetWorkflowResponse = Message<"taskguild.v1.GetWorkflowResponse"> &
  * Use `create(GetWorkflowResponseSchema)` to create a new message.
  */
 export const GetWorkflowResponseSchema: GenMessage<GetWorkflowResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_workflow, 7);
+  messageDesc(file_taskguild_v1_workflow, 8);
 
 /**
  * @generated from message taskguild.v1.ListWorkflowsRequest
@@ -483,7 +560,7 @@ export type ListWorkflowsRequest = Message<"taskguild.v1.ListWorkflowsRequest">
  * Use `create(ListWorkflowsRequestSchema)` to create a new message.
  */
 export const ListWorkflowsRequestSchema: GenMessage<ListWorkflowsRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_workflow, 8);
+  messageDesc(file_taskguild_v1_workflow, 9);
 
 /**
  * @generated from message taskguild.v1.ListWorkflowsResponse
@@ -505,7 +582,7 @@ export type ListWorkflowsResponse = Message<"taskguild.v1.ListWorkflowsResponse"
  * Use `create(ListWorkflowsResponseSchema)` to create a new message.
  */
 export const ListWorkflowsResponseSchema: GenMessage<ListWorkflowsResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_workflow, 9);
+  messageDesc(file_taskguild_v1_workflow, 10);
 
 /**
  * @generated from message taskguild.v1.UpdateWorkflowRequest
@@ -565,7 +642,7 @@ export type UpdateWorkflowRequest = Message<"taskguild.v1.UpdateWorkflowRequest"
  * Use `create(UpdateWorkflowRequestSchema)` to create a new message.
  */
 export const UpdateWorkflowRequestSchema: GenMessage<UpdateWorkflowRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_workflow, 10);
+  messageDesc(file_taskguild_v1_workflow, 11);
 
 /**
  * @generated from message taskguild.v1.UpdateWorkflowResponse
@@ -582,7 +659,7 @@ export type UpdateWorkflowResponse = Message<"taskguild.v1.UpdateWorkflowRespons
  * Use `create(UpdateWorkflowResponseSchema)` to create a new message.
  */
 export const UpdateWorkflowResponseSchema: GenMessage<UpdateWorkflowResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_workflow, 11);
+  messageDesc(file_taskguild_v1_workflow, 12);
 
 /**
  * @generated from message taskguild.v1.DeleteWorkflowRequest
@@ -599,7 +676,7 @@ export type DeleteWorkflowRequest = Message<"taskguild.v1.DeleteWorkflowRequest"
  * Use `create(DeleteWorkflowRequestSchema)` to create a new message.
  */
 export const DeleteWorkflowRequestSchema: GenMessage<DeleteWorkflowRequest> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_workflow, 12);
+  messageDesc(file_taskguild_v1_workflow, 13);
 
 /**
  * @generated from message taskguild.v1.DeleteWorkflowResponse
@@ -612,7 +689,7 @@ export type DeleteWorkflowResponse = Message<"taskguild.v1.DeleteWorkflowRespons
  * Use `create(DeleteWorkflowResponseSchema)` to create a new message.
  */
 export const DeleteWorkflowResponseSchema: GenMessage<DeleteWorkflowResponse> = /*@__PURE__*/
-  messageDesc(file_taskguild_v1_workflow, 13);
+  messageDesc(file_taskguild_v1_workflow, 14);
 
 /**
  * @generated from enum taskguild.v1.HookTrigger